@@ -11,11 +11,21 @@ import (
 
 	"github.com/yourusername/go-enterprise-api/internal/config"
 	"github.com/yourusername/go-enterprise-api/internal/database"
+	"github.com/yourusername/go-enterprise-api/internal/lifecycle"
 	"github.com/yourusername/go-enterprise-api/internal/models"
+	"github.com/yourusername/go-enterprise-api/internal/repository"
 	"github.com/yourusername/go-enterprise-api/internal/routes"
 	"github.com/yourusername/go-enterprise-api/pkg/logger"
 )
 
+// @title Go Enterprise API
+// @version 1.0
+// @description REST API for users, posts, comments and their administration.
+// @BasePath /api/v1
+// @securityDefinitions.apikey BearerAuth
+// @in header
+// @name Authorization
+// @description Access token, sent as "Bearer <token>"
 func main() {
 	// Load configuration
 	cfg, err := config.Load()
@@ -26,9 +36,20 @@ func main() {
 
 	// Initialize logger
 	logger.Init(logger.Config{
-		Level:  cfg.Log.Level,
-		Format: cfg.Log.Format,
-		Debug:  cfg.App.Debug,
+		Level:         cfg.Log.Level,
+		Format:        cfg.Log.Format,
+		Debug:         cfg.App.Debug,
+		Outputs:       cfg.Log.Outputs,
+		File:          cfg.Log.File,
+		AccessOutputs: cfg.Log.AccessOutputs,
+		AccessFile:    cfg.Log.AccessFile,
+		Rotation: logger.Rotation{
+			MaxSizeMB:  cfg.Log.FileMaxSizeMB,
+			MaxBackups: cfg.Log.FileMaxBackups,
+			MaxAgeDays: cfg.Log.FileMaxAgeDays,
+			Compress:   cfg.Log.FileCompress,
+			Interval:   cfg.Log.FileRotateInterval,
+		},
 	})
 	defer logger.Sync()
 
@@ -48,27 +69,46 @@ func main() {
 			logger.Error("Failed to close database connection", logger.Err(err))
 		}
 	}()
+	if err := repository.EnableRetries(db.DB, cfg.Database.RetryMaxAttempts, cfg.Database.RetryDelay); err != nil {
+		logger.Fatal("Failed to enable database retries", logger.Err(err))
+	}
+	if cfg.Tenancy.Enabled {
+		if err := repository.EnableTenancy(db.DB); err != nil {
+			logger.Fatal("Failed to enable tenancy", logger.Err(err))
+		}
+	}
 
 	// Run migrations
 	logger.Info("Running database migrations...")
-	if err := db.Migrate(
-		&models.User{},
-		&models.Post{},
-		&models.Tag{},
-	); err != nil {
+	if err := db.Migrate(models.All()...); err != nil {
 		logger.Fatal("Failed to run migrations", logger.Err(err))
 	}
 	logger.Info("Database migrations completed")
 
+	// Background components, stopped on shutdown
+	lc := lifecycle.New()
+
+	// Fetch the secrets from the secrets provider again periodically, so
+	// rotated ones are used without a restart
+	if refresher := cfg.SecretsRefresher(); refresher != nil {
+		lc.Go("secrets refresher", refresher.Run)
+	}
+
 	// Setup routes
-	router := routes.Setup(cfg, db)
+	router := routes.Setup(cfg, db, lc)
 
-	// Create HTTP server
+	// Create HTTP server. Writing a response may take longer than the
+	// longest request deadline, so requests running past it still get a 504.
+	writeTimeout := 15 * time.Second
+	if cfg.RequestTimeout.Enabled {
+		longest := max(cfg.RequestTimeout.Read, cfg.RequestTimeout.Write, cfg.RequestTimeout.Export)
+		writeTimeout = max(writeTimeout, longest+5*time.Second)
+	}
 	srv := &http.Server{
 		Addr:         ":" + cfg.App.Port,
 		Handler:      router,
 		ReadTimeout:  15 * time.Second,
-		WriteTimeout: 15 * time.Second,
+		WriteTimeout: writeTimeout,
 		IdleTimeout:  60 * time.Second,
 	}
 
@@ -98,5 +138,11 @@ func main() {
 		logger.Error("Server forced to shutdown", logger.Err(err))
 	}
 
+	// Stop the background components once no request can use them, within
+	// the same deadline
+	if err := lc.Shutdown(ctx); err != nil {
+		logger.Error("Background components forced to stop", logger.Err(err))
+	}
+
 	logger.Info("Server exited properly")
 }
@@ -0,0 +1,127 @@
+// Command backfill runs backfill jobs from the command line.
+//
+// Usage:
+//
+//	backfill -list
+//	backfill -job post-reading-stats [-batch-size 500] [-delay 100ms] [-restart]
+//
+// Interrupting a run with Ctrl+C saves its checkpoint so that running the
+// same job again resumes where it stopped.
+package main
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"os"
+	"os/signal"
+	"syscall"
+
+	"github.com/yourusername/go-enterprise-api/internal/backfill"
+	"github.com/yourusername/go-enterprise-api/internal/config"
+	"github.com/yourusername/go-enterprise-api/internal/database"
+	"github.com/yourusername/go-enterprise-api/internal/models"
+	"github.com/yourusername/go-enterprise-api/internal/repository"
+	"github.com/yourusername/go-enterprise-api/internal/search"
+	"github.com/yourusername/go-enterprise-api/internal/services"
+	apperrors "github.com/yourusername/go-enterprise-api/pkg/errors"
+	"github.com/yourusername/go-enterprise-api/pkg/logger"
+)
+
+func main() {
+	list := flag.Bool("list", false, "List the available jobs and their progress")
+	job := flag.String("job", "", "Name of the job to run")
+	batchSize := flag.Int("batch-size", 0, "Rows per batch (default BACKFILL_BATCH_SIZE)")
+	delay := flag.Duration("delay", 0, "Pause between batches (default BACKFILL_BATCH_DELAY)")
+	restart := flag.Bool("restart", false, "Ignore the checkpoint and start from the first row")
+	flag.Parse()
+
+	if !*list && *job == "" {
+		flag.Usage()
+		os.Exit(2)
+	}
+
+	// Load configuration
+	cfg, err := config.Load()
+	if err != nil {
+		fmt.Printf("Failed to load configuration: %v\n", err)
+		os.Exit(1)
+	}
+
+	// Initialize logger
+	logger.Init(logger.Config{
+		Level:  cfg.Log.Level,
+		Format: cfg.Log.Format,
+		Debug:  cfg.App.Debug,
+	})
+	defer logger.Sync()
+
+	// Connect to database
+	db, err := database.New(cfg)
+	if err != nil {
+		logger.Fatal("Failed to connect to database", logger.Err(err))
+	}
+	defer func() {
+		if err := db.Close(); err != nil {
+			logger.Error("Failed to close database connection", logger.Err(err))
+		}
+	}()
+	if err := repository.EnableRetries(db.DB, cfg.Database.RetryMaxAttempts, cfg.Database.RetryDelay); err != nil {
+		logger.Fatal("Failed to enable database retries", logger.Err(err))
+	}
+
+	if err := db.Migrate(&models.BackfillCheckpoint{}); err != nil {
+		logger.Fatal("Failed to run migrations", logger.Err(err))
+	}
+
+	indexer, err := search.New(&cfg.Search)
+	if err != nil {
+		logger.Fatal("Failed to initialize search backend", logger.Err(err))
+	}
+
+	backfillService := services.NewBackfillService(
+		repository.NewBackfillRepository(db.DB),
+		backfill.DefaultJobs(db.DB, indexer),
+		&cfg.Backfill,
+	)
+
+	// Cancel the run on interrupt so its checkpoint is saved
+	ctx, stop := signal.NotifyContext(context.Background(), syscall.SIGINT, syscall.SIGTERM)
+	defer stop()
+
+	if *list {
+		jobs, err := backfillService.List(ctx)
+		if err != nil {
+			logger.Fatal("Failed to list backfill jobs", logger.Err(err))
+		}
+		for _, j := range jobs {
+			status := "never run"
+			if j.Checkpoint != nil {
+				status = fmt.Sprintf("%s, %d rows processed", j.Checkpoint.Status, j.Checkpoint.Processed)
+			}
+			fmt.Printf("%-20s %s (%s)\n", j.Name, j.Description, status)
+		}
+		return
+	}
+
+	checkpoint, err := backfillService.Run(ctx, *job, services.BackfillOptions{
+		BatchSize:  *batchSize,
+		BatchDelay: *delay,
+		Restart:    *restart,
+	})
+	if err != nil {
+		if errors.Is(err, context.Canceled) {
+			fmt.Printf("Interrupted after %d rows; run again to resume\n", checkpoint.Processed)
+			os.Exit(130)
+		}
+		var appErr *apperrors.AppError
+		if errors.As(err, &appErr) && appErr.Details != "" {
+			err = errors.New(appErr.Details)
+		}
+		fmt.Printf("Backfill failed: %v\n", err)
+		os.Exit(1)
+	}
+
+	fmt.Printf("Backfill %s completed: %d rows in %d batches\n", checkpoint.Job, checkpoint.Processed, checkpoint.Batches)
+}
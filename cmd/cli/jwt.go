@@ -0,0 +1,56 @@
+package main
+
+import (
+	"crypto/rand"
+	"encoding/base64"
+	"fmt"
+
+	"github.com/spf13/cobra"
+)
+
+// jwtKeyBytes is the number of random bytes of a generated JWT signing key
+const jwtKeyBytes = 48
+
+func newRotateJWTKeyCommand() *cobra.Command {
+	return &cobra.Command{
+		Use:   "rotate-jwt-key",
+		Short: "Replace the key tokens are signed with",
+		Long: "Generate a new key for signing tokens. When SECRETS_JWT_SECRET names a secret, " +
+			"the key is stored in the secrets provider; otherwise it is printed to be set as JWT_SECRET. " +
+			"Tokens signed with the previous key stop working, so every user has to log in again.",
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			cfg, err := loadConfig()
+			if err != nil {
+				return err
+			}
+
+			raw := make([]byte, jwtKeyBytes)
+			if _, err := rand.Read(raw); err != nil {
+				return err
+			}
+			key := base64.RawURLEncoding.EncodeToString(raw)
+
+			provider, err := cfg.SecretsProvider()
+			if err != nil {
+				return err
+			}
+			if provider == nil || cfg.Secrets.JWTSecret == "" {
+				fmt.Printf("New key: %s\n", key)
+				fmt.Println("Set it as JWT_SECRET and restart the API servers")
+				return nil
+			}
+
+			if err := provider.Put(cmd.Context(), cfg.Secrets.JWTSecret, key); err != nil {
+				return fmt.Errorf("failed to store the key: %w", err)
+			}
+			fmt.Printf("New key stored in %s (%s)\n", cfg.Secrets.JWTSecret, cfg.Secrets.Provider)
+			if cfg.Secrets.RefreshInterval > 0 {
+				fmt.Printf("API servers sign tokens with it within %s\n", cfg.Secrets.RefreshInterval)
+			} else {
+				fmt.Println("Restart the API servers to sign tokens with it")
+			}
+			return nil
+		},
+	}
+}
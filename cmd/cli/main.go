@@ -0,0 +1,97 @@
+// Command cli runs operator tasks against the database and services of the
+// API, for when the HTTP API cannot be used.
+//
+// Usage:
+//
+//	cli create-admin --email admin@example.com [--first-name Ada] [--last-name Lovelace] [--password-stdin]
+//	cli reset-password <email or ID> [--password-stdin]
+//	cli list-users [--search text] [--page 1] [--page-size 20]
+//	cli reindex-search [--queue]
+//	cli run-migrations
+//	cli purge-trash [--older-than 720h]
+//	cli rotate-jwt-key
+//
+// It reads the same configuration as the API. Generated passwords and keys
+// are printed once and not stored anywhere else.
+package main
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"os/signal"
+	"syscall"
+
+	"github.com/spf13/cobra"
+	"github.com/yourusername/go-enterprise-api/internal/config"
+	"github.com/yourusername/go-enterprise-api/internal/database"
+	"github.com/yourusername/go-enterprise-api/internal/repository"
+	"github.com/yourusername/go-enterprise-api/pkg/logger"
+)
+
+func main() {
+	root := &cobra.Command{
+		Use:           "cli",
+		Short:         "Operator tasks for the API",
+		SilenceUsage:  true,
+		SilenceErrors: true,
+	}
+	root.AddCommand(
+		newCreateAdminCommand(),
+		newResetPasswordCommand(),
+		newListUsersCommand(),
+		newReindexSearchCommand(),
+		newRunMigrationsCommand(),
+		newPurgeTrashCommand(),
+		newRotateJWTKeyCommand(),
+	)
+
+	ctx, stop := signal.NotifyContext(context.Background(), syscall.SIGINT, syscall.SIGTERM)
+	defer stop()
+
+	if err := root.ExecuteContext(ctx); err != nil {
+		fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+		stop()
+		os.Exit(1)
+	}
+}
+
+// loadConfig loads the configuration and initializes the logger
+func loadConfig() (*config.Config, error) {
+	cfg, err := config.Load()
+	if err != nil {
+		return nil, fmt.Errorf("failed to load configuration: %w", err)
+	}
+
+	logger.Init(logger.Config{
+		Level:  cfg.Log.Level,
+		Format: cfg.Log.Format,
+		Debug:  cfg.App.Debug,
+	})
+	return cfg, nil
+}
+
+// connect loads the configuration and connects to the database. The
+// returned function closes the connection.
+func connect() (*config.Config, *database.Database, func(), error) {
+	cfg, err := loadConfig()
+	if err != nil {
+		return nil, nil, nil, err
+	}
+
+	db, err := database.New(cfg)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+	closeDB := func() {
+		if err := db.Close(); err != nil {
+			logger.Error("Failed to close database connection", logger.Err(err))
+		}
+		logger.Sync()
+	}
+	if err := repository.EnableRetries(db.DB, cfg.Database.RetryMaxAttempts, cfg.Database.RetryDelay); err != nil {
+		closeDB()
+		return nil, nil, nil, fmt.Errorf("failed to enable database retries: %w", err)
+	}
+	return cfg, db, closeDB, nil
+}
@@ -0,0 +1,31 @@
+package main
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+	"github.com/yourusername/go-enterprise-api/internal/models"
+)
+
+func newRunMigrationsCommand() *cobra.Command {
+	return &cobra.Command{
+		Use:   "run-migrations",
+		Short: "Create or update the database schema",
+		Long: "Create or update the database schema as the API does when it starts. " +
+			"Tables and columns are added; nothing is dropped.",
+		Args: cobra.NoArgs,
+		RunE: func(_ *cobra.Command, _ []string) error {
+			_, db, closeDB, err := connect()
+			if err != nil {
+				return err
+			}
+			defer closeDB()
+
+			if err := db.Migrate(models.All()...); err != nil {
+				return fmt.Errorf("failed to run migrations: %w", err)
+			}
+			fmt.Printf("Schema of %d models migrated\n", len(models.All()))
+			return nil
+		},
+	}
+}
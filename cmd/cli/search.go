@@ -0,0 +1,72 @@
+package main
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/spf13/cobra"
+	"github.com/yourusername/go-enterprise-api/internal/events"
+	"github.com/yourusername/go-enterprise-api/internal/jobs"
+	"github.com/yourusername/go-enterprise-api/internal/repository"
+	"github.com/yourusername/go-enterprise-api/internal/search"
+	"github.com/yourusername/go-enterprise-api/internal/services"
+)
+
+func newReindexSearchCommand() *cobra.Command {
+	var queue bool
+
+	cmd := &cobra.Command{
+		Use:   "reindex-search",
+		Short: "Rebuild the search index from all published posts",
+		Long: "Rebuild the search index from all published posts. With --queue, the rebuild " +
+			"is queued for the job workers of the API instead of run here.",
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			cfg, db, closeDB, err := connect()
+			if err != nil {
+				return err
+			}
+			defer closeDB()
+
+			indexer, err := search.New(&cfg.Search)
+			if err != nil {
+				return fmt.Errorf("failed to initialize search backend: %w", err)
+			}
+			if indexer == nil {
+				return errors.New("posts are searched in the database, so there is no index to rebuild; set SEARCH_DRIVER")
+			}
+
+			jobRepo := repository.NewJobRepository(db.DB)
+			postService := services.NewPostService(
+				repository.NewPostRepository(db.DB),
+				repository.NewChangeRepository(db.DB),
+				repository.NewSlugRedirectRepository(db.DB),
+				repository.NewTagRepository(db.DB),
+				indexer,
+				events.New(),
+				jobs.New(jobRepo, &cfg.Jobs),
+				cfg.Posts.MaxProfilePins,
+				cfg.Posts.DefaultLicense,
+				cfg.Posts.RequireAltText,
+			)
+
+			if queue {
+				job, err := postService.Reindex(cmd.Context())
+				if err != nil {
+					return err
+				}
+				fmt.Printf("Search reindex queued as job %s\n", job.ID)
+				return nil
+			}
+
+			indexed, err := postService.RebuildIndex(cmd.Context())
+			if err != nil {
+				return fmt.Errorf("rebuild stopped after %d posts: %w", indexed, err)
+			}
+			fmt.Printf("Indexed %d posts in %s\n", indexed, indexer.Name())
+			return nil
+		},
+	}
+	cmd.Flags().BoolVar(&queue, "queue", false, "Queue the rebuild for the job workers instead of running it")
+	return cmd
+}
@@ -0,0 +1,44 @@
+package main
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/spf13/cobra"
+	"github.com/yourusername/go-enterprise-api/internal/repository"
+	"github.com/yourusername/go-enterprise-api/internal/services"
+)
+
+func newPurgeTrashCommand() *cobra.Command {
+	var olderThan time.Duration
+
+	cmd := &cobra.Command{
+		Use:   "purge-trash",
+		Short: "Permanently delete posts and comments deleted long ago",
+		Long: "Permanently delete the posts and comments deleted more than --older-than ago, " +
+			"with their notifications, tags and statistics. Posts on legal hold are kept.",
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			if olderThan <= 0 {
+				return errors.New("--older-than must be positive")
+			}
+
+			_, db, closeDB, err := connect()
+			if err != nil {
+				return err
+			}
+			defer closeDB()
+
+			trashService := services.NewTrashService(repository.NewTrashRepository(db.DB))
+			report, err := trashService.Purge(cmd.Context(), olderThan)
+			if report != nil {
+				fmt.Printf("Purged %d posts and %d comments deleted before %s\n",
+					report.Posts, report.Comments, report.Before.Format(time.RFC3339))
+			}
+			return err
+		},
+	}
+	cmd.Flags().DurationVar(&olderThan, "older-than", 30*24*time.Hour, "Only purge records deleted longer ago than this")
+	return cmd
+}
@@ -0,0 +1,260 @@
+package main
+
+import (
+	"bufio"
+	"crypto/rand"
+	"errors"
+	"fmt"
+	"io"
+	"math/big"
+	"os"
+	"strings"
+	"text/tabwriter"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/spf13/cobra"
+	"github.com/yourusername/go-enterprise-api/internal/database"
+	"github.com/yourusername/go-enterprise-api/internal/events"
+	"github.com/yourusername/go-enterprise-api/internal/models"
+	"github.com/yourusername/go-enterprise-api/internal/repository"
+	"github.com/yourusername/go-enterprise-api/internal/services"
+	apperrors "github.com/yourusername/go-enterprise-api/pkg/errors"
+	"github.com/yourusername/go-enterprise-api/pkg/validator"
+)
+
+// generatedPasswordLength is the length of the passwords generated for
+// operators who do not give one
+const generatedPasswordLength = 20
+
+// passwordAlphabet holds the characters of generated passwords
+const passwordAlphabet = "ABCDEFGHJKLMNPQRSTUVWXYZabcdefghijkmnopqrstuvwxyz23456789!#$%&*+-=?@^_"
+
+func newCreateAdminCommand() *cobra.Command {
+	var email, firstName, lastName string
+	var passwordStdin bool
+
+	cmd := &cobra.Command{
+		Use:   "create-admin",
+		Short: "Create an active admin with a verified email",
+		Long: "Create an active admin with a verified email. Unless --password-stdin is given, " +
+			"a random password is generated and printed once.",
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			email = strings.TrimSpace(email)
+			password, generated, err := readPassword(passwordStdin)
+			if err != nil {
+				return err
+			}
+
+			v := validator.New()
+			v.Email("email", email, "")
+			v.Password("password", password)
+			if errs := v.Validate(); errs != nil {
+				return validationError(errs)
+			}
+
+			_, db, closeDB, err := connect()
+			if err != nil {
+				return err
+			}
+			defer closeDB()
+
+			userRepo := repository.NewUserRepository(db.DB)
+			exists, err := userRepo.ExistsByEmail(cmd.Context(), email)
+			if err != nil {
+				return err
+			}
+			if exists {
+				return fmt.Errorf("a user with the email %s already exists; use reset-password to replace their password", email)
+			}
+
+			now := time.Now().UTC()
+			user := &models.User{
+				Email:           email,
+				Password:        password,
+				FirstName:       firstName,
+				LastName:        lastName,
+				Role:            models.RoleAdmin,
+				Status:          models.StatusActive,
+				Type:            models.UserTypeHuman,
+				EmailVerifiedAt: &now,
+			}
+			if err := userRepo.Create(cmd.Context(), user); err != nil {
+				return err
+			}
+
+			fmt.Printf("Admin %s created with ID %s\n", user.Email, user.ID)
+			if generated {
+				fmt.Printf("Password: %s\n", password)
+			}
+			return nil
+		},
+	}
+	cmd.Flags().StringVar(&email, "email", "", "Email of the admin (required)")
+	cmd.Flags().StringVar(&firstName, "first-name", "", "First name of the admin")
+	cmd.Flags().StringVar(&lastName, "last-name", "", "Last name of the admin")
+	cmd.Flags().BoolVar(&passwordStdin, "password-stdin", false, "Read the password from the first line of stdin")
+	_ = cmd.MarkFlagRequired("email")
+	return cmd
+}
+
+func newResetPasswordCommand() *cobra.Command {
+	var passwordStdin bool
+
+	cmd := &cobra.Command{
+		Use:   "reset-password <email or ID>",
+		Short: "Replace a user's password and end their sessions",
+		Long: "Replace a user's password and end their sessions. Unless --password-stdin is given, " +
+			"a random password is generated and printed once.",
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			password, generated, err := readPassword(passwordStdin)
+			if err != nil {
+				return err
+			}
+			if errs := validator.New().Password("password", password).Validate(); errs != nil {
+				return validationError(errs)
+			}
+
+			cfg, db, closeDB, err := connect()
+			if err != nil {
+				return err
+			}
+			defer closeDB()
+
+			userRepo := repository.NewUserRepository(db.DB)
+			user, err := findUser(cmd, userRepo, args[0])
+			if err != nil {
+				return err
+			}
+
+			authService := services.NewAuthService(userRepo, repository.NewAPIKeyRepository(db.DB), events.New(), cfg)
+			if err := authService.ResetPassword(cmd.Context(), user.ID, password); err != nil {
+				return err
+			}
+
+			fmt.Printf("Password of %s reset; their sessions have ended\n", user.Email)
+			if generated {
+				fmt.Printf("Password: %s\n", password)
+			}
+			return nil
+		},
+	}
+	cmd.Flags().BoolVar(&passwordStdin, "password-stdin", false, "Read the password from the first line of stdin")
+	return cmd
+}
+
+func newListUsersCommand() *cobra.Command {
+	var search string
+	var page, pageSize int
+
+	cmd := &cobra.Command{
+		Use:   "list-users",
+		Short: "List users, without service accounts",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			if page < 1 {
+				return errors.New("--page must be positive")
+			}
+			if pageSize < 1 || pageSize > database.MaxPageSize {
+				return fmt.Errorf("--page-size must be between 1 and %d", database.MaxPageSize)
+			}
+
+			_, db, closeDB, err := connect()
+			if err != nil {
+				return err
+			}
+			defer closeDB()
+
+			userRepo := repository.NewUserRepository(db.DB)
+			var users []models.User
+			var total int64
+			if search != "" {
+				users, total, err = userRepo.SearchUsers(cmd.Context(), search, page, pageSize)
+			} else {
+				users, total, err = userRepo.FindAll(cmd.Context(), page, pageSize)
+			}
+			if err != nil {
+				return err
+			}
+
+			w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+			fmt.Fprintln(w, "ID\tEMAIL\tNAME\tROLE\tSTATUS\tCREATED")
+			for _, user := range users {
+				fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%s\t%s\n",
+					user.ID,
+					user.Email,
+					strings.TrimSpace(user.FirstName+" "+user.LastName),
+					user.Role,
+					user.Status,
+					user.CreatedAt.UTC().Format(time.RFC3339),
+				)
+			}
+			if err := w.Flush(); err != nil {
+				return err
+			}
+			fmt.Printf("Page %d: %d of %d users\n", page, len(users), total)
+			return nil
+		},
+	}
+	cmd.Flags().StringVar(&search, "search", "", "Only list users whose name or email contains the text")
+	cmd.Flags().IntVar(&page, "page", 1, "Page to list")
+	cmd.Flags().IntVar(&pageSize, "page-size", 20, "Users per page (at most 100)")
+	return cmd
+}
+
+// findUser finds a user by ID, or by email if the argument is not an ID
+func findUser(cmd *cobra.Command, userRepo repository.UserRepository, emailOrID string) (*models.User, error) {
+	if id, err := uuid.Parse(emailOrID); err == nil {
+		return userRepo.FindByID(cmd.Context(), id)
+	}
+	return userRepo.FindByEmail(cmd.Context(), strings.TrimSpace(emailOrID))
+}
+
+// readPassword reads the password from stdin if asked, or generates one.
+// It reports whether the password was generated.
+func readPassword(fromStdin bool) (string, bool, error) {
+	if !fromStdin {
+		password, err := generatePassword()
+		return password, true, err
+	}
+
+	line, err := bufio.NewReader(os.Stdin).ReadString('\n')
+	if err != nil && !errors.Is(err, io.EOF) {
+		return "", false, fmt.Errorf("failed to read the password: %w", err)
+	}
+	password := strings.TrimRight(line, "\r\n")
+	if password == "" {
+		return "", false, errors.New("no password on stdin")
+	}
+	return password, false, nil
+}
+
+// generatePassword generates a random password meeting the strength rules
+// of the API
+func generatePassword() (string, error) {
+	max := big.NewInt(int64(len(passwordAlphabet)))
+	for {
+		b := make([]byte, generatedPasswordLength)
+		for i := range b {
+			n, err := rand.Int(rand.Reader, max)
+			if err != nil {
+				return "", err
+			}
+			b[i] = passwordAlphabet[n.Int64()]
+		}
+		if password := string(b); validator.ValidatePassword(password) {
+			return password, nil
+		}
+	}
+}
+
+// validationError joins the messages of failed validations
+func validationError(errs *apperrors.ValidationErrors) error {
+	messages := make([]string, 0, len(errs.Errors))
+	for _, e := range errs.Errors {
+		messages = append(messages, e.Field+": "+e.Message)
+	}
+	return errors.New(strings.Join(messages, "; "))
+}
@@ -0,0 +1,128 @@
+// Command seed creates the initial admin from SEED_ADMIN_* and, for
+// development, demo tags and sample posts.
+//
+// Usage:
+//
+//	seed [-demo=false]
+//
+// Seeding is idempotent: the admin, tags and posts are matched by email and
+// slug, and existing ones are left as they are, so running it again does
+// not duplicate data. Demo content is refused in production.
+package main
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"os"
+	"os/signal"
+	"syscall"
+
+	"github.com/yourusername/go-enterprise-api/internal/config"
+	"github.com/yourusername/go-enterprise-api/internal/database"
+	"github.com/yourusername/go-enterprise-api/internal/models"
+	"github.com/yourusername/go-enterprise-api/internal/repository"
+	"github.com/yourusername/go-enterprise-api/internal/search"
+	"github.com/yourusername/go-enterprise-api/internal/seed"
+	"github.com/yourusername/go-enterprise-api/pkg/logger"
+	"github.com/yourusername/go-enterprise-api/pkg/validator"
+)
+
+func main() {
+	demo := flag.Bool("demo", true, "Seed demo tags and sample posts (refused in production)")
+	flag.Parse()
+
+	// Load configuration
+	cfg, err := config.Load()
+	if err != nil {
+		fmt.Printf("Failed to load configuration: %v\n", err)
+		os.Exit(1)
+	}
+
+	if *demo && cfg.IsProduction() {
+		fmt.Println("Demo content is not seeded in production; run with -demo=false to only create the admin")
+		os.Exit(2)
+	}
+
+	opts := seed.Options{Demo: *demo}
+	if cfg.Seed.AdminEmail != "" {
+		v := validator.New()
+		v.Email("SEED_ADMIN_EMAIL", cfg.Seed.AdminEmail, "")
+		v.Password("SEED_ADMIN_PASSWORD", cfg.Seed.AdminPassword)
+		if errs := v.Validate(); errs != nil {
+			for _, e := range errs.Errors {
+				fmt.Printf("Invalid seed admin: %s: %s\n", e.Field, e.Message)
+			}
+			os.Exit(1)
+		}
+		opts.Admin = &seed.Admin{
+			Email:     cfg.Seed.AdminEmail,
+			Password:  cfg.Seed.AdminPassword,
+			FirstName: cfg.Seed.AdminFirstName,
+			LastName:  cfg.Seed.AdminLastName,
+		}
+	} else if !*demo {
+		fmt.Println("Nothing to seed: set SEED_ADMIN_EMAIL and SEED_ADMIN_PASSWORD, or seed demo content")
+		os.Exit(2)
+	}
+
+	// Initialize logger
+	logger.Init(logger.Config{
+		Level:  cfg.Log.Level,
+		Format: cfg.Log.Format,
+		Debug:  cfg.App.Debug,
+	})
+	defer logger.Sync()
+
+	// Connect to database
+	db, err := database.New(cfg)
+	if err != nil {
+		logger.Fatal("Failed to connect to database", logger.Err(err))
+	}
+	defer func() {
+		if err := db.Close(); err != nil {
+			logger.Error("Failed to close database connection", logger.Err(err))
+		}
+	}()
+	if err := repository.EnableRetries(db.DB, cfg.Database.RetryMaxAttempts, cfg.Database.RetryDelay); err != nil {
+		logger.Fatal("Failed to enable database retries", logger.Err(err))
+	}
+
+	// A fresh instance may not have its schema yet
+	if err := db.Migrate(
+		&models.User{},
+		&models.Tag{},
+		&models.TagAlias{},
+		&models.Post{},
+	); err != nil {
+		logger.Fatal("Failed to run migrations", logger.Err(err))
+	}
+
+	ctx, stop := signal.NotifyContext(context.Background(), syscall.SIGINT, syscall.SIGTERM)
+	defer stop()
+
+	report, err := seed.Run(ctx, db.DB, opts)
+	if err != nil {
+		if errors.Is(err, seed.ErrNoAdmin) {
+			err = errors.New("no admin to write the demo posts; set SEED_ADMIN_EMAIL and SEED_ADMIN_PASSWORD")
+		}
+		fmt.Printf("Seeding failed: %v\n", err)
+		os.Exit(1)
+	}
+
+	if opts.Admin != nil {
+		if report.AdminCreated {
+			fmt.Printf("Admin %s created\n", report.AdminEmail)
+		} else {
+			fmt.Printf("Admin %s already exists, left unchanged\n", report.AdminEmail)
+		}
+	}
+	if *demo {
+		fmt.Printf("Tags: %d created, %d already existed\n", report.TagsCreated, report.TagsExisting)
+		fmt.Printf("Posts: %d created, %d already existed\n", report.PostsCreated, report.PostsExisting)
+		if report.PostsCreated > 0 && cfg.Search.Driver != "" && cfg.Search.Driver != search.DriverDatabase {
+			fmt.Println("Run `backfill -job search-reindex` to index the new posts")
+		}
+	}
+}
@@ -0,0 +1,172 @@
+// Command sitearchive exports the whole site to a site archive, or imports
+// one into a site without content, from the command line.
+//
+// Usage:
+//
+//	sitearchive -export site.zip
+//	sitearchive -import site.zip
+//
+// Imports queue a search reindex when a search backend is configured; the
+// API's job workers run it.
+package main
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"os"
+	"os/signal"
+	"syscall"
+
+	"github.com/yourusername/go-enterprise-api/internal/config"
+	"github.com/yourusername/go-enterprise-api/internal/database"
+	"github.com/yourusername/go-enterprise-api/internal/jobs"
+	"github.com/yourusername/go-enterprise-api/internal/models"
+	"github.com/yourusername/go-enterprise-api/internal/repository"
+	"github.com/yourusername/go-enterprise-api/internal/search"
+	"github.com/yourusername/go-enterprise-api/internal/services"
+	"github.com/yourusername/go-enterprise-api/internal/sitearchive"
+	apperrors "github.com/yourusername/go-enterprise-api/pkg/errors"
+	"github.com/yourusername/go-enterprise-api/pkg/logger"
+)
+
+func main() {
+	exportPath := flag.String("export", "", "Write an archive of the site to this file")
+	importPath := flag.String("import", "", "Import the site archive in this file")
+	flag.Parse()
+
+	if (*exportPath == "") == (*importPath == "") {
+		flag.Usage()
+		os.Exit(2)
+	}
+
+	// Load configuration
+	cfg, err := config.Load()
+	if err != nil {
+		fmt.Printf("Failed to load configuration: %v\n", err)
+		os.Exit(1)
+	}
+
+	// Initialize logger
+	logger.Init(logger.Config{
+		Level:  cfg.Log.Level,
+		Format: cfg.Log.Format,
+		Debug:  cfg.App.Debug,
+	})
+	defer logger.Sync()
+
+	// Connect to database
+	db, err := database.New(cfg)
+	if err != nil {
+		logger.Fatal("Failed to connect to database", logger.Err(err))
+	}
+	defer func() {
+		if err := db.Close(); err != nil {
+			logger.Error("Failed to close database connection", logger.Err(err))
+		}
+	}()
+	if err := repository.EnableRetries(db.DB, cfg.Database.RetryMaxAttempts, cfg.Database.RetryDelay); err != nil {
+		logger.Fatal("Failed to enable database retries", logger.Err(err))
+	}
+
+	// A fresh instance may not have its schema yet
+	if err := db.Migrate(
+		&models.User{},
+		&models.UserFollow{},
+		&models.Tag{},
+		&models.TagAlias{},
+		&models.Post{},
+		&models.SlugRedirect{},
+		&models.Comment{},
+		&models.Job{},
+	); err != nil {
+		logger.Fatal("Failed to run migrations", logger.Err(err))
+	}
+
+	indexer, err := search.New(&cfg.Search)
+	if err != nil {
+		logger.Fatal("Failed to initialize search backend", logger.Err(err))
+	}
+
+	siteArchiveService := services.NewSiteArchiveService(
+		repository.NewSiteRepository(db.DB),
+		indexer,
+		jobs.New(repository.NewJobRepository(db.DB), &cfg.Jobs),
+	)
+
+	ctx, stop := signal.NotifyContext(context.Background(), syscall.SIGINT, syscall.SIGTERM)
+	defer stop()
+
+	if *exportPath != "" {
+		if err := export(ctx, siteArchiveService, *exportPath); err != nil {
+			fail("Export", err)
+		}
+		fmt.Printf("Site exported to %s\n", *exportPath)
+		return
+	}
+
+	report, err := importArchive(ctx, siteArchiveService, *importPath)
+	if err != nil {
+		fail("Import", err)
+	}
+
+	fmt.Printf("Site imported from %s (archive version %d, exported by %s at %s)\n",
+		*importPath, report.Archive.Version, report.Archive.AppVersion, report.Archive.ExportedAt.Format("2006-01-02 15:04:05 MST"))
+	for _, file := range []string{
+		sitearchive.FileUsers,
+		sitearchive.FileFollows,
+		sitearchive.FileTags,
+		sitearchive.FileTagAliases,
+		sitearchive.FilePosts,
+		sitearchive.FileSlugRedirects,
+		sitearchive.FileComments,
+	} {
+		fmt.Printf("  %-22s %d\n", file, report.Imported[file])
+	}
+	fmt.Printf("  %-22s %d\n", "matched users", report.MatchedUsers)
+	if report.ReindexJobID != nil {
+		fmt.Printf("Search reindex queued as job %s\n", report.ReindexJobID)
+	}
+}
+
+// export writes an archive of the site to path, removing the file if the
+// export fails
+func export(ctx context.Context, siteArchiveService services.SiteArchiveService, path string) error {
+	file, err := os.Create(path)
+	if err != nil {
+		return err
+	}
+
+	if err := siteArchiveService.Export(ctx, file); err != nil {
+		file.Close()
+		os.Remove(path)
+		return err
+	}
+	return file.Close()
+}
+
+// importArchive imports the site archive at path
+func importArchive(ctx context.Context, siteArchiveService services.SiteArchiveService, path string) (*services.SiteImportReport, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+
+	info, err := file.Stat()
+	if err != nil {
+		return nil, err
+	}
+	return siteArchiveService.Import(ctx, file, info.Size())
+}
+
+// fail reports a failed export or import and exits
+func fail(action string, err error) {
+	var appErr *apperrors.AppError
+	if errors.As(err, &appErr) && appErr.Details != "" {
+		err = errors.New(appErr.Details)
+	}
+	fmt.Printf("%s failed: %v\n", action, err)
+	os.Exit(1)
+}
@@ -0,0 +1,86 @@
+// Command staticsite renders all public posts, tag pages and author pages
+// into a static site and stores it in the configured storage backend, the
+// same way as the admin endpoint, without going through the job queue.
+//
+// Usage:
+//
+//	staticsite
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"os"
+	"os/signal"
+	"syscall"
+
+	"github.com/yourusername/go-enterprise-api/internal/config"
+	"github.com/yourusername/go-enterprise-api/internal/database"
+	"github.com/yourusername/go-enterprise-api/internal/repository"
+	"github.com/yourusername/go-enterprise-api/internal/services"
+	"github.com/yourusername/go-enterprise-api/internal/storage"
+	"github.com/yourusername/go-enterprise-api/pkg/logger"
+)
+
+func main() {
+	flag.Parse()
+
+	// Load configuration
+	cfg, err := config.Load()
+	if err != nil {
+		fmt.Printf("Failed to load configuration: %v\n", err)
+		os.Exit(1)
+	}
+
+	// Initialize logger
+	logger.Init(logger.Config{
+		Level:  cfg.Log.Level,
+		Format: cfg.Log.Format,
+		Debug:  cfg.App.Debug,
+	})
+	defer logger.Sync()
+
+	// Connect to database
+	db, err := database.New(cfg)
+	if err != nil {
+		logger.Fatal("Failed to connect to database", logger.Err(err))
+	}
+	defer func() {
+		if err := db.Close(); err != nil {
+			logger.Error("Failed to close database connection", logger.Err(err))
+		}
+	}()
+	if err := repository.EnableRetries(db.DB, cfg.Database.RetryMaxAttempts, cfg.Database.RetryDelay); err != nil {
+		logger.Fatal("Failed to enable database retries", logger.Err(err))
+	}
+
+	store, err := storage.New(&cfg.Storage)
+	if err != nil {
+		logger.Fatal("Failed to initialize storage", logger.Err(err))
+	}
+
+	staticSiteService := services.NewStaticSiteService(
+		repository.NewPostRepository(db.DB),
+		store,
+		nil,
+		services.NewBrandingService(repository.NewBrandingRepository(db.DB), cfg.App.Name),
+		&cfg.StaticSite,
+	)
+
+	ctx, stop := signal.NotifyContext(context.Background(), syscall.SIGINT, syscall.SIGTERM)
+	defer stop()
+
+	report, err := staticSiteService.Export(ctx)
+	if err != nil {
+		fmt.Printf("Export failed: %v\n", err)
+		os.Exit(1)
+	}
+
+	fmt.Printf("Static site exported to %s storage under %q\n", report.Storage, report.Prefix)
+	fmt.Printf("  %-10s %d\n", "posts", report.Posts)
+	fmt.Printf("  %-10s %d\n", "tags", report.Tags)
+	fmt.Printf("  %-10s %d\n", "authors", report.Authors)
+	fmt.Printf("  %-10s %d\n", "files", report.Files)
+	fmt.Printf("  %-10s %d\n", "removed", report.Removed)
+}
@@ -0,0 +1,11 @@
+// Package docs holds the OpenAPI document of the API. It is generated from
+// the swagger annotations of the handlers by `make swagger`, which runs
+// before every build.
+package docs
+
+import _ "embed"
+
+// OpenAPI is the generated OpenAPI (Swagger 2.0) document
+//
+//go:embed swagger.json
+var OpenAPI []byte
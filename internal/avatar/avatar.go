@@ -0,0 +1,133 @@
+package avatar
+
+import (
+	"crypto/sha256"
+	"fmt"
+	"html"
+	"strings"
+	"sync"
+	"unicode"
+	"unicode/utf8"
+
+	"github.com/google/uuid"
+	"github.com/yourusername/go-enterprise-api/internal/config"
+)
+
+// Supported fallback avatar styles
+const (
+	StyleInitials  = "initials"
+	StyleIdenticon = "identicon"
+)
+
+// maxCacheEntries bounds the number of generated avatars kept in memory
+const maxCacheEntries = 1000
+
+// identiconGrid is the number of cells per side of an identicon
+const identiconGrid = 5
+
+// Generator renders deterministic SVG fallback avatars and caches them
+type Generator struct {
+	style string
+	size  int
+
+	mu    sync.RWMutex
+	cache map[string][]byte
+}
+
+// NewGenerator creates a new avatar generator
+func NewGenerator(cfg *config.AvatarConfig) *Generator {
+	return &Generator{
+		style: cfg.Style,
+		size:  cfg.Size,
+		cache: make(map[string][]byte),
+	}
+}
+
+// Generate returns the SVG fallback avatar for a user and its cache key.
+// The same inputs always produce the same image.
+func (g *Generator) Generate(id uuid.UUID, firstName, lastName, email string) ([]byte, string) {
+	initials := Initials(firstName, lastName, email)
+	key := fmt.Sprintf("%x", sha256.Sum256([]byte(g.style+"|"+id.String()+"|"+initials)))[:16]
+
+	g.mu.RLock()
+	svg, ok := g.cache[key]
+	g.mu.RUnlock()
+	if ok {
+		return svg, key
+	}
+
+	if g.style == StyleIdenticon {
+		svg = g.identicon(id)
+	} else {
+		svg = g.initials(id, initials)
+	}
+
+	g.mu.Lock()
+	if len(g.cache) >= maxCacheEntries {
+		g.cache = make(map[string][]byte)
+	}
+	g.cache[key] = svg
+	g.mu.Unlock()
+
+	return svg, key
+}
+
+// Initials returns up to two uppercase initials for a user, falling back to
+// the first letter of the email and finally "?"
+func Initials(firstName, lastName, email string) string {
+	var b strings.Builder
+	for _, name := range []string{firstName, lastName} {
+		if r, _ := utf8.DecodeRuneInString(strings.TrimSpace(name)); r != utf8.RuneError && unicode.IsLetter(r) {
+			b.WriteRune(unicode.ToUpper(r))
+		}
+	}
+	if b.Len() == 0 {
+		if r, _ := utf8.DecodeRuneInString(email); r != utf8.RuneError && (unicode.IsLetter(r) || unicode.IsDigit(r)) {
+			b.WriteRune(unicode.ToUpper(r))
+		}
+	}
+	if b.Len() == 0 {
+		return "?"
+	}
+	return b.String()
+}
+
+// initials renders the initials on a background color derived from the user ID
+func (g *Generator) initials(id uuid.UUID, initials string) []byte {
+	hue := int(id[0])<<8 | int(id[1])
+	return []byte(fmt.Sprintf(
+		`<svg xmlns="http://www.w3.org/2000/svg" width="%[1]d" height="%[1]d" viewBox="0 0 100 100">`+
+			`<rect width="100" height="100" fill="hsl(%[2]d,55%%,45%%)"/>`+
+			`<text x="50" y="50" dy=".35em" text-anchor="middle" font-family="Helvetica,Arial,sans-serif" font-size="42" fill="#ffffff">%[3]s</text>`+
+			`</svg>`,
+		g.size, hue%360, html.EscapeString(initials),
+	))
+}
+
+// identicon renders a horizontally symmetric grid pattern derived from the user ID
+func (g *Generator) identicon(id uuid.UUID) []byte {
+	hash := sha256.Sum256(id[:])
+	hue := (int(hash[0])<<8 | int(hash[1])) % 360
+	cell := 100 / identiconGrid
+
+	var b strings.Builder
+	fmt.Fprintf(&b, `<svg xmlns="http://www.w3.org/2000/svg" width="%[1]d" height="%[1]d" viewBox="0 0 100 100">`, g.size)
+	b.WriteString(`<rect width="100" height="100" fill="#f0f0f0"/>`)
+	fmt.Fprintf(&b, `<g fill="hsl(%d,60%%,50%%)">`, hue)
+
+	half := (identiconGrid + 1) / 2
+	for row := 0; row < identiconGrid; row++ {
+		for col := 0; col < half; col++ {
+			if hash[2+row*half+col]%2 == 0 {
+				continue
+			}
+			fmt.Fprintf(&b, `<rect x="%d" y="%d" width="%d" height="%d"/>`, col*cell, row*cell, cell, cell)
+			if mirror := identiconGrid - 1 - col; mirror != col {
+				fmt.Fprintf(&b, `<rect x="%d" y="%d" width="%d" height="%d"/>`, mirror*cell, row*cell, cell, cell)
+			}
+		}
+	}
+
+	b.WriteString(`</g></svg>`)
+	return []byte(b.String())
+}
@@ -0,0 +1,81 @@
+// Package backfill defines resumable jobs that walk large tables in keyset
+// batches, such as search reindexing, counter recomputation and filling in
+// newly added columns.
+package backfill
+
+import (
+	"context"
+
+	"gorm.io/gorm"
+)
+
+// Job is a backfill that processes a table in ordered batches
+type Job interface {
+	// Name identifies the job and its checkpoint
+	Name() string
+	// Description explains what the job does
+	Description() string
+	// Batch processes up to size rows ordered after cursor ("" for the first
+	// batch) and returns the cursor of the last processed row and the number
+	// of rows processed. A batch of zero rows means the job is done.
+	Batch(ctx context.Context, cursor string, size int) (string, int, error)
+}
+
+// KeysetJob is a Job over the rows of a model ordered by primary key.
+// Keyset pagination keeps every batch query cheap regardless of how far the
+// job has progressed, unlike OFFSET pagination.
+type KeysetJob[T any] struct {
+	name        string
+	description string
+	db          *gorm.DB
+	scope       func(tx *gorm.DB) *gorm.DB
+	key         func(row *T) string
+	process     func(ctx context.Context, tx *gorm.DB, rows []T) error
+}
+
+// NewKeysetJob creates a keyset job. scope may be nil or narrow the rows and
+// add preloads; key returns a row's primary key; process handles one batch.
+func NewKeysetJob[T any](name, description string, db *gorm.DB, scope func(tx *gorm.DB) *gorm.DB, key func(row *T) string, process func(ctx context.Context, tx *gorm.DB, rows []T) error) *KeysetJob[T] {
+	return &KeysetJob[T]{
+		name:        name,
+		description: description,
+		db:          db,
+		scope:       scope,
+		key:         key,
+		process:     process,
+	}
+}
+
+// Name returns the job name
+func (j *KeysetJob[T]) Name() string {
+	return j.name
+}
+
+// Description returns the job description
+func (j *KeysetJob[T]) Description() string {
+	return j.description
+}
+
+// Batch loads the next batch of rows after cursor and processes it
+func (j *KeysetJob[T]) Batch(ctx context.Context, cursor string, size int) (string, int, error) {
+	query := j.db.WithContext(ctx)
+	if j.scope != nil {
+		query = j.scope(query)
+	}
+	if cursor != "" {
+		query = query.Where("id > ?", cursor)
+	}
+
+	var rows []T
+	if err := query.Order("id").Limit(size).Find(&rows).Error; err != nil {
+		return cursor, 0, err
+	}
+	if len(rows) == 0 {
+		return cursor, 0, nil
+	}
+
+	if err := j.process(ctx, j.db.WithContext(ctx), rows); err != nil {
+		return cursor, 0, err
+	}
+	return j.key(&rows[len(rows)-1]), len(rows), nil
+}
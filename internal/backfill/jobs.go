@@ -0,0 +1,129 @@
+package backfill
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/yourusername/go-enterprise-api/internal/models"
+	"github.com/yourusername/go-enterprise-api/internal/search"
+	"gorm.io/gorm"
+)
+
+// Built-in job names
+const (
+	JobSearchReindex    = "search-reindex"
+	JobPostReadingStats = "post-reading-stats"
+	JobPostViewCounts   = "post-view-counts"
+	JobPostAltText      = "post-alt-text"
+)
+
+// DefaultJobs returns the built-in backfill jobs. The search reindex job is
+// only available when an external search backend is configured.
+func DefaultJobs(db *gorm.DB, indexer search.SearchIndexer) []Job {
+	jobs := []Job{
+		NewKeysetJob(JobPostReadingStats,
+			"Recompute the word count and reading time of every post",
+			db, nil, postKey, recomputeReadingStats),
+		NewKeysetJob(JobPostViewCounts,
+			"Raise post view counters that are lower than the views recorded in the daily analytics",
+			db, nil, postKey, repairViewCounts),
+		NewKeysetJob(JobPostAltText,
+			"Recount the images without alt text in every post",
+			db, nil, postKey, recountMissingAltText),
+	}
+
+	if indexer != nil {
+		jobs = append(jobs, NewKeysetJob(JobSearchReindex,
+			"Index every published post in the "+indexer.Name()+" search backend",
+			db,
+			func(tx *gorm.DB) *gorm.DB {
+				return tx.Preload("Tags").Where("status = ? AND shadowed = ?", models.PostStatusPublished, false)
+			},
+			postKey,
+			func(ctx context.Context, tx *gorm.DB, posts []models.Post) error {
+				return indexer.IndexBatch(ctx, posts)
+			}))
+	}
+
+	return jobs
+}
+
+// postKey returns the keyset cursor of a post
+func postKey(post *models.Post) string {
+	return post.ID.String()
+}
+
+// recomputeReadingStats writes the reading stats of posts whose stored
+// values are stale. UpdateColumns leaves updated_at untouched since the
+// posts themselves did not change.
+func recomputeReadingStats(ctx context.Context, tx *gorm.DB, posts []models.Post) error {
+	for _, post := range posts {
+		words, minutes := models.ReadingStats(post.Content)
+		if words == post.WordCount && minutes == post.ReadingTime {
+			continue
+		}
+		err := tx.Model(&models.Post{}).Where("id = ?", post.ID).UpdateColumns(map[string]interface{}{
+			"word_count":   words,
+			"reading_time": minutes,
+		}).Error
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// recountMissingAltText writes the count of images without alt text of
+// posts whose stored count is stale, leaving updated_at untouched
+func recountMissingAltText(ctx context.Context, tx *gorm.DB, posts []models.Post) error {
+	for _, post := range posts {
+		missing := post.CountMissingAltText()
+		if missing == post.MissingAltText {
+			continue
+		}
+		err := tx.Model(&models.Post{}).Where("id = ?", post.ID).UpdateColumn("missing_alt_text", missing).Error
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// repairViewCounts raises view counters to the total of the daily analytics,
+// leaving out views still waiting to be rolled up into the counter. Counters
+// are never lowered because views counted before analytics existed only
+// appear in the counter.
+func repairViewCounts(ctx context.Context, tx *gorm.DB, posts []models.Post) error {
+	ids := make([]uuid.UUID, len(posts))
+	for i, post := range posts {
+		ids[i] = post.ID
+	}
+
+	var totals []struct {
+		PostID uuid.UUID
+		Views  int64
+	}
+	err := tx.Model(&models.PostDailyStat{}).
+		Select("post_id, SUM(views - pending_views) AS views").
+		Where("post_id IN ?", ids).
+		Group("post_id").
+		Scan(&totals).Error
+	if err != nil {
+		return err
+	}
+
+	counts := make(map[uuid.UUID]int, len(posts))
+	for _, post := range posts {
+		counts[post.ID] = post.ViewCount
+	}
+	for _, total := range totals {
+		if total.Views <= int64(counts[total.PostID]) {
+			continue
+		}
+		err := tx.Model(&models.Post{}).Where("id = ?", total.PostID).UpdateColumn("view_count", total.Views).Error
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
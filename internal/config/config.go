@@ -2,10 +2,16 @@ package config
 
 import (
 	"fmt"
+	"net"
+	"sort"
+	"strconv"
 	"strings"
 	"time"
 
+	"github.com/go-sql-driver/mysql"
 	"github.com/spf13/viper"
+	"github.com/yourusername/go-enterprise-api/internal/models"
+	"github.com/yourusername/go-enterprise-api/internal/secrets"
 )
 
 // Config holds all configuration for the application
@@ -16,14 +22,58 @@ type Config struct {
 	Log      LogConfig
 	RateLimit RateLimitConfig
 	CORS     CORSConfig
+	Search   SearchConfig
+	Admin    AdminConfig
+	Features FeatureConfig
+	Posts    PostConfig
+	Avatar   AvatarConfig
+	Dormancy DormancyConfig
+	AccountDeletion AccountDeletionConfig
+	Age      AgeConfig
+	Presence PresenceConfig
+	GeoIP    GeoIPConfig
+	Backfill BackfillConfig
+	Comments CommentConfig
+	Spam     SpamConfig
+	ImageProxy ImageProxyConfig
+	Mail     MailConfig
+	Webhooks WebhookConfig
+	Jobs     JobsConfig
+	ReadKeys ReadKeyConfig
+	Metrics  MetricsConfig
+	Health   HealthConfig
+	Docs     DocsConfig
+	Storage  StorageConfig
+	Media    MediaConfig
+	Malware  MalwareConfig
+	StaticSite StaticSiteConfig
+	HTTPCache  HTTPCacheConfig
+	Idempotency IdempotencyConfig
+	RequestTimeout RequestTimeoutConfig
+	API        APIConfig
+	GraphQL    GraphQLConfig
+	WebSocket  WebSocketConfig
+	Debug      DebugConfig
+	Tenancy    TenancyConfig
+	Seed       SeedConfig
+	Secrets    SecretsConfig
+
+	// refresher keeps the secrets fetched from the secrets provider up to
+	// date; nil for the env provider
+	refresher *secrets.Refresher
 }
 
 // AppConfig holds application-specific configuration
 type AppConfig struct {
-	Name  string
-	Env   string
-	Port  string
-	Debug bool
+	Name          string
+	Env           string
+	Port          string
+	Debug         bool
+	MaxUploadSize int64
+	StrictJSON    bool
+	ReadOnly      bool
+	Timezone      string
+	TimeFormat    string
 }
 
 // DatabaseConfig holds database configuration
@@ -35,6 +85,21 @@ type DatabaseConfig struct {
 	User     string
 	Password string
 	SSLMode  string
+	// Charset is the connection character set of MySQL databases
+	Charset string
+	// ReplicaHosts are the read replicas, as host or host:port, reached
+	// with the credentials of the primary
+	ReplicaHosts []string
+	// Connection pool limits of the primary and of each replica. A
+	// MaxOpenConns, ConnMaxLifetime or ConnMaxIdleTime of 0 means no limit.
+	MaxIdleConns    int
+	MaxOpenConns    int
+	ConnMaxLifetime time.Duration
+	ConnMaxIdleTime time.Duration
+	// RetryMaxAttempts is how many times an operation failing with a
+	// transient error is attempted in total; 1 disables retries
+	RetryMaxAttempts int
+	RetryDelay       time.Duration
 }
 
 // JWTConfig holds JWT configuration
@@ -48,12 +113,37 @@ type JWTConfig struct {
 type LogConfig struct {
 	Level  string
 	Format string
+	// Bodies adds request and response bodies of up to BodyMaxBytes to the
+	// request log, with the values of fields whose name contains one of
+	// RedactFields replaced
+	Bodies       bool
+	BodyMaxBytes int
+	RedactFields []string
+	// Outputs lists where application logs are written ("stdout", "file"
+	// or both); AccessOutputs does the same for request logs, which go with
+	// the application logs when it is empty
+	Outputs       []string
+	File          string
+	AccessOutputs []string
+	AccessFile    string
+	// Log files are rotated when they grow past FileMaxSizeMB and every
+	// FileRotateInterval (0 disables time-based rotation)
+	FileMaxSizeMB      int
+	FileMaxBackups     int
+	FileMaxAgeDays     int
+	FileCompress       bool
+	FileRotateInterval time.Duration
 }
 
-// RateLimitConfig holds rate limiting configuration
+// RateLimitConfig holds rate limiting configuration. Store selects where
+// requests are counted: memory counts per process, redis across all
+// replicas sharing RedisURL.
 type RateLimitConfig struct {
-	Requests int
-	Duration time.Duration
+	Requests     int
+	Duration     time.Duration
+	Store        string
+	RedisURL     string
+	RedisTimeout time.Duration
 }
 
 // CORSConfig holds CORS configuration
@@ -63,17 +153,369 @@ type CORSConfig struct {
 	AllowedHeaders []string
 }
 
-// Load reads configuration from environment variables
+// SearchConfig holds external search engine configuration
+type SearchConfig struct {
+	Driver  string
+	URL     string
+	APIKey  string
+	Index   string
+	Timeout time.Duration
+}
+
+// PostConfig holds post-related configuration
+type PostConfig struct {
+	PreviewTokenTTL time.Duration
+	MaxProfilePins  int
+	DefaultLicense  models.License
+	RequireAltText  bool
+}
+
+// AdminConfig holds admin tooling configuration. Users created by an admin
+// with an invitation have InviteTTL to choose their password.
+type AdminConfig struct {
+	UndoRetention time.Duration
+	InviteTTL     time.Duration
+}
+
+// AvatarConfig holds avatar configuration. Style and Size shape the
+// generated fallback avatar; uploaded avatars are stored under Prefix in
+// each of UploadSizes, ascending.
+type AvatarConfig struct {
+	Style       string
+	Size        int
+	Prefix      string
+	UploadSizes []int
+}
+
+// DormancyConfig holds the dormant account lifecycle policy.
+// A zero AnonymizeAfterDays disables anonymization.
+type DormancyConfig struct {
+	Enabled             bool
+	Interval            time.Duration
+	NoticeAfterDays     int
+	DeactivateAfterDays int
+	AnonymizeAfterDays  int
+}
+
+// AccountDeletionConfig holds the self-service account deletion policy.
+// Accounts are erased GraceDays after their owner asked for it. Posts kept
+// on erasure go to the user whose email is Successor, or stay with the
+// anonymized account when Successor is empty.
+type AccountDeletionConfig struct {
+	GraceDays int
+	Interval  time.Duration
+	Successor string
+}
+
+// AgeConfig holds age gating configuration.
+// A zero MinimumAge disables the registration age check.
+type AgeConfig struct {
+	MinimumAge int
+	AdultAge   int
+}
+
+// PresenceConfig holds last-seen tracking configuration. A user's last-seen
+// time is recorded at most once per UpdateInterval, and they are shown as
+// online until OnlineWindow after it.
+type PresenceConfig struct {
+	UpdateInterval time.Duration
+	OnlineWindow   time.Duration
+}
+
+// GeoIPConfig holds country resolution and geo rule configuration.
+// RateLimits maps country codes to requests allowed per RateLimit window.
+type GeoIPConfig struct {
+	Driver              string
+	Header              string
+	Database            string
+	BlockedRegistration []string
+	RateLimits          map[string]int
+}
+
+// BackfillConfig holds the defaults for backfill jobs.
+// BatchDelay is the pause between batches that keeps load on the database down.
+type BackfillConfig struct {
+	BatchSize  int
+	BatchDelay time.Duration
+}
+
+// CommentConfig holds comment moderation configuration.
+// With ModerateFirstTime, comments by users without an approved comment
+// are held for moderation.
+type CommentConfig struct {
+	ModerateFirstTime bool
+	MaxLength         int
+}
+
+// SpamConfig holds spam check configuration
+type SpamConfig struct {
+	Driver  string
+	URL     string
+	APIKey  string
+	SiteURL string
+	Timeout time.Duration
+}
+
+// ImageProxyConfig holds external image proxy configuration.
+// Requested widths are rounded up to one of Widths so only a few sizes of
+// each image are ever cached. An empty AllowedHosts allows any public host.
+type ImageProxyConfig struct {
+	Enabled      bool
+	Widths       []int
+	MaxBytes     int64
+	MaxPixels    int
+	Timeout      time.Duration
+	CacheBytes   int64
+	CacheTTL     time.Duration
+	AllowedHosts []string
+	AllowPrivate bool
+}
+
+// MailConfig holds outgoing email configuration. URL overrides the API
+// endpoint of the sendgrid and ses drivers.
+type MailConfig struct {
+	Driver       string
+	From         string
+	FromName     string
+	BaseURL      string
+	URL          string
+	Timeout      time.Duration
+	SMTPHost     string
+	SMTPPort     int
+	SMTPUsername string
+	SMTPPassword string
+	APIKey       string
+	SESRegion    string
+	SESAccessKey string
+	SESSecretKey string
+}
+
+// WebhookConfig holds outgoing webhook delivery configuration. Failed
+// deliveries are retried up to MaxAttempts times, waiting RetryDelay before
+// the first retry and twice as long before each further one. Finished
+// deliveries, payloads included, are kept for Retention so they can be
+// redelivered.
+type WebhookConfig struct {
+	Timeout      time.Duration
+	MaxAttempts  int
+	RetryDelay   time.Duration
+	Retention    time.Duration
+	AllowPrivate bool
+}
+
+// JobsConfig holds background job queue configuration. Workers jobs run
+// concurrently in each process; with 0 workers, jobs are queued but only run
+// by other processes. Failed jobs are retried up to MaxAttempts times,
+// waiting RetryDelay before the first retry and twice as long before each
+// further one. Jobs still running after Timeout are assumed lost, e.g. to a
+// crash, and retried. Finished jobs are deleted after Retention.
+type JobsConfig struct {
+	Workers            int
+	PollInterval       time.Duration
+	MaxAttempts        int
+	RetryDelay         time.Duration
+	Timeout            time.Duration
+	Retention          time.Duration
+	ViewRollupInterval time.Duration
+}
+
+// ReadKeyConfig holds the rate limits of anonymous read keys, in requests per
+// RateLimit window for each tier
+type ReadKeyConfig struct {
+	FreeLimit    int
+	PartnerLimit int
+}
+
+// MetricsConfig holds application metrics configuration. The metrics are
+// served at Path in the Prometheus text format; a non-empty Token must be
+// sent as a bearer token to read them.
+type MetricsConfig struct {
+	Enabled bool
+	Path    string
+	Token   string
+}
+
+// DocsConfig holds the OpenAPI document and Swagger UI. When Password is
+// set, clients must send Username and Password with HTTP Basic
+// authentication to read them.
+type DocsConfig struct {
+	Enabled  bool
+	Username string
+	Password string
+}
+
+// HealthConfig holds the readiness checks of the components the server
+// depends on. Each check fails after CheckTimeout, and local storage fails
+// its check with less than DiskMinFreeMB free.
+type HealthConfig struct {
+	CheckTimeout  time.Duration
+	DiskMinFreeMB int
+}
+
+// StorageConfig holds the storage of generated files, such as the static
+// site. Path is the directory of the local driver; S3Endpoint overrides the
+// API endpoint of the s3 driver, e.g. for an S3-compatible service.
+type StorageConfig struct {
+	Driver      string
+	Path        string
+	S3Bucket    string
+	S3Region    string
+	S3Endpoint  string
+	S3AccessKey string
+	S3SecretKey string
+	Timeout     time.Duration
+}
+
+// SecretsConfig holds fetching the JWT secret and the database password
+// from a secrets provider, env (read from the environment), vault or aws.
+// JWTSecret and DBPassword are references to the secrets, such as
+// "go-enterprise-api#jwt_secret"; the settings without one are read from
+// the environment. Secrets are fetched again every RefreshInterval (0 for
+// never).
+type SecretsConfig struct {
+	Provider        string
+	RefreshInterval time.Duration
+	Timeout         time.Duration
+	JWTSecret       string
+	DBPassword      string
+	VaultAddress    string
+	VaultToken      string
+	VaultMount      string
+	AWSRegion       string
+	AWSEndpoint     string
+	AWSAccessKey    string
+	AWSSecretKey    string
+}
+
+// MediaConfig holds the media library. Uploads are kept in storage under
+// Prefix and may be at most MaxSize bytes of one of AllowedTypes, as sniffed
+// from their content. Files are served below BaseURL, the public address of
+// the API; signed URLs of private files are valid for URLTTL. Images of up to
+// MaxPixels pixels get a square thumbnail of ThumbnailSize pixels and copies
+// scaled down to MediumWidth and LargeWidth. Chunked uploads take chunks of
+// up to ChunkSize bytes and are abandoned UploadTTL after their last chunk.
+type MediaConfig struct {
+	Prefix        string
+	BaseURL       string
+	MaxSize       int64
+	AllowedTypes  []string
+	URLTTL        time.Duration
+	MaxPixels     int
+	ThumbnailSize int
+	MediumWidth   int
+	LargeWidth    int
+	ChunkSize     int64
+	UploadTTL     time.Duration
+}
+
+// MalwareConfig holds malware scanning of media uploads. The clamav driver
+// streams files to the clamd daemon at ClamAVAddress.
+type MalwareConfig struct {
+	Driver        string
+	ClamAVAddress string
+	Timeout       time.Duration
+}
+
+// StaticSiteConfig holds static site export configuration. Pages are stored
+// under Prefix; the home page lists PageSize posts per page.
+type StaticSiteConfig struct {
+	Prefix   string
+	PageSize int
+}
+
+// HTTPCacheConfig holds HTTP caching of public GET responses. Each field
+// but Enabled is the Cache-Control header of a group of routes; responses
+// for authenticated users are always private.
+type HTTPCacheConfig struct {
+	Enabled  bool
+	Posts    string
+	Profiles string
+	Tags     string
+	Meta     string
+}
+
+// RequestTimeoutConfig holds the deadlines requests are handled within:
+// Read for GET and HEAD requests, Write for other methods, and Export for
+// exports, imports and uploads. A deadline of 0 means none.
+type RequestTimeoutConfig struct {
+	Enabled bool
+	Read    time.Duration
+	Write   time.Duration
+	Export  time.Duration
+}
+
+// APIConfig holds the API versions served. V2Enabled mounts /api/v2
+// alongside /api/v1; once V1DeprecatedAt is set, v1 responses carry the
+// Deprecation header, and the Sunset and Link headers when V1SunsetAt and
+// V1DeprecationLink are set.
+type APIConfig struct {
+	V2Enabled         bool
+	V1DeprecatedAt    time.Time
+	V1SunsetAt        time.Time
+	V1DeprecationLink string
+}
+
+// IdempotencyConfig holds replaying responses to retried POST requests
+// sent with an Idempotency-Key header
+type IdempotencyConfig struct {
+	Enabled bool
+	TTL     time.Duration
+}
+
+// GraphQLConfig holds the GraphQL endpoint. Queries whose complexity
+// exceeds ComplexityLimit are rejected; 0 disables the limit.
+type GraphQLConfig struct {
+	Enabled         bool
+	ComplexityLimit int
+}
+
+// WebSocketConfig holds the WebSocket endpoint pushing real-time updates.
+// Clients are pinged every PingInterval, may send MessageRate messages per
+// minute and follow up to MaxSubscriptions posts at once.
+type WebSocketConfig struct {
+	Enabled          bool
+	PingInterval     time.Duration
+	MessageRate      int
+	MaxSubscriptions int
+}
+
+// DebugConfig holds the admin endpoints serving runtime profiles, garbage
+// collection and goroutine dumps
+type DebugConfig struct {
+	Enabled bool
+}
+
+// TenancyConfig holds scoping content to organizations. The organization
+// of a request is named by the Header, or by the subdomain of BaseDomain
+// when it is set.
+type TenancyConfig struct {
+	Enabled    bool
+	Header     string
+	BaseDomain string
+}
+
+// SeedConfig holds the initial admin created by the seed command
+type SeedConfig struct {
+	AdminEmail     string
+	AdminPassword  string
+	AdminFirstName string
+	AdminLastName  string
+}
+
+// FeatureConfig holds feature flags
+type FeatureConfig struct {
+	Enabled []string
+}
+
+// Load reads configuration from environment variables, the config files
+// and the .env file, in that order of precedence, over the defaults
 func Load() (*Config, error) {
-	viper.SetConfigFile(".env")
 	viper.AutomaticEnv()
 
-	// Read .env file if it exists
-	if err := viper.ReadInConfig(); err != nil {
-		// It's okay if .env doesn't exist, we can use environment variables
-		if _, ok := err.(viper.ConfigFileNotFoundError); !ok {
-			return nil, fmt.Errorf("error reading config file: %w", err)
-		}
+	// Read the config files that exist; it's okay if there are none, we can
+	// use environment variables
+	if err := loadFiles(); err != nil {
+		return nil, err
 	}
 
 	// Set defaults
@@ -81,10 +523,15 @@ func Load() (*Config, error) {
 
 	config := &Config{
 		App: AppConfig{
-			Name:  viper.GetString("APP_NAME"),
-			Env:   viper.GetString("APP_ENV"),
-			Port:  viper.GetString("APP_PORT"),
-			Debug: viper.GetBool("APP_DEBUG"),
+			Name:          viper.GetString("APP_NAME"),
+			Env:           viper.GetString("APP_ENV"),
+			Port:          viper.GetString("APP_PORT"),
+			Debug:         viper.GetBool("APP_DEBUG"),
+			MaxUploadSize: viper.GetInt64("APP_MAX_UPLOAD_SIZE"),
+			StrictJSON:    viper.GetBool("APP_STRICT_JSON"),
+			ReadOnly:      viper.GetBool("APP_READ_ONLY"),
+			Timezone:      viper.GetString("APP_TIMEZONE"),
+			TimeFormat:    viper.GetString("APP_TIME_FORMAT"),
 		},
 		Database: DatabaseConfig{
 			Driver:   viper.GetString("DB_DRIVER"),
@@ -94,6 +541,17 @@ func Load() (*Config, error) {
 			User:     viper.GetString("DB_USER"),
 			Password: viper.GetString("DB_PASSWORD"),
 			SSLMode:  viper.GetString("DB_SSL_MODE"),
+			Charset:  viper.GetString("DB_CHARSET"),
+
+			ReplicaHosts: splitList(viper.GetString("DB_REPLICA_HOSTS")),
+
+			MaxIdleConns:    viper.GetInt("DB_MAX_IDLE_CONNS"),
+			MaxOpenConns:    viper.GetInt("DB_MAX_OPEN_CONNS"),
+			ConnMaxLifetime: viper.GetDuration("DB_CONN_MAX_LIFETIME"),
+			ConnMaxIdleTime: viper.GetDuration("DB_CONN_MAX_IDLE_TIME"),
+
+			RetryMaxAttempts: viper.GetInt("DB_RETRY_MAX_ATTEMPTS"),
+			RetryDelay:       viper.GetDuration("DB_RETRY_DELAY"),
 		},
 		JWT: JWTConfig{
 			Secret:             viper.GetString("JWT_SECRET"),
@@ -101,23 +559,290 @@ func Load() (*Config, error) {
 			RefreshExpiryHours: viper.GetInt("JWT_REFRESH_EXPIRY_HOURS"),
 		},
 		Log: LogConfig{
-			Level:  viper.GetString("LOG_LEVEL"),
-			Format: viper.GetString("LOG_FORMAT"),
+			Level:              viper.GetString("LOG_LEVEL"),
+			Format:             viper.GetString("LOG_FORMAT"),
+			Bodies:             viper.GetBool("LOG_BODIES"),
+			BodyMaxBytes:       viper.GetInt("LOG_BODY_MAX_BYTES"),
+			RedactFields:       splitList(viper.GetString("LOG_REDACT_FIELDS")),
+			Outputs:            splitList(viper.GetString("LOG_OUTPUTS")),
+			File:               viper.GetString("LOG_FILE"),
+			AccessOutputs:      splitList(viper.GetString("LOG_ACCESS_OUTPUTS")),
+			AccessFile:         viper.GetString("LOG_ACCESS_FILE"),
+			FileMaxSizeMB:      viper.GetInt("LOG_FILE_MAX_SIZE_MB"),
+			FileMaxBackups:     viper.GetInt("LOG_FILE_MAX_BACKUPS"),
+			FileMaxAgeDays:     viper.GetInt("LOG_FILE_MAX_AGE_DAYS"),
+			FileCompress:       viper.GetBool("LOG_FILE_COMPRESS"),
+			FileRotateInterval: viper.GetDuration("LOG_FILE_ROTATE_INTERVAL"),
 		},
 		RateLimit: RateLimitConfig{
-			Requests: viper.GetInt("RATE_LIMIT_REQUESTS"),
-			Duration: viper.GetDuration("RATE_LIMIT_DURATION"),
+			Requests:     viper.GetInt("RATE_LIMIT_REQUESTS"),
+			Duration:     viper.GetDuration("RATE_LIMIT_DURATION"),
+			Store:        viper.GetString("RATE_LIMIT_STORE"),
+			RedisURL:     viper.GetString("RATE_LIMIT_REDIS_URL"),
+			RedisTimeout: viper.GetDuration("RATE_LIMIT_REDIS_TIMEOUT"),
 		},
 		CORS: CORSConfig{
 			AllowedOrigins: strings.Split(viper.GetString("CORS_ALLOWED_ORIGINS"), ","),
 			AllowedMethods: strings.Split(viper.GetString("CORS_ALLOWED_METHODS"), ","),
 			AllowedHeaders: strings.Split(viper.GetString("CORS_ALLOWED_HEADERS"), ","),
 		},
+		Search: SearchConfig{
+			Driver:  viper.GetString("SEARCH_DRIVER"),
+			URL:     viper.GetString("SEARCH_URL"),
+			APIKey:  viper.GetString("SEARCH_API_KEY"),
+			Index:   viper.GetString("SEARCH_INDEX"),
+			Timeout: viper.GetDuration("SEARCH_TIMEOUT"),
+		},
+		Admin: AdminConfig{
+			UndoRetention: viper.GetDuration("ADMIN_UNDO_RETENTION"),
+			InviteTTL:     viper.GetDuration("ADMIN_INVITE_TTL"),
+		},
+		Features: FeatureConfig{
+			Enabled: splitList(viper.GetString("FEATURE_FLAGS")),
+		},
+		Posts: PostConfig{
+			PreviewTokenTTL: viper.GetDuration("POST_PREVIEW_TOKEN_TTL"),
+			MaxProfilePins:  viper.GetInt("POST_MAX_PROFILE_PINS"),
+			DefaultLicense:  models.License(viper.GetString("POST_DEFAULT_LICENSE")),
+			RequireAltText:  viper.GetBool("POST_REQUIRE_ALT_TEXT"),
+		},
+		Avatar: AvatarConfig{
+			Style:  viper.GetString("AVATAR_STYLE"),
+			Size:   viper.GetInt("AVATAR_SIZE"),
+			Prefix: viper.GetString("AVATAR_PREFIX"),
+		},
+		Dormancy: DormancyConfig{
+			Enabled:             viper.GetBool("DORMANCY_ENABLED"),
+			Interval:            viper.GetDuration("DORMANCY_INTERVAL"),
+			NoticeAfterDays:     viper.GetInt("DORMANCY_NOTICE_AFTER_DAYS"),
+			DeactivateAfterDays: viper.GetInt("DORMANCY_DEACTIVATE_AFTER_DAYS"),
+			AnonymizeAfterDays:  viper.GetInt("DORMANCY_ANONYMIZE_AFTER_DAYS"),
+		},
+		AccountDeletion: AccountDeletionConfig{
+			GraceDays: viper.GetInt("ACCOUNT_DELETION_GRACE_DAYS"),
+			Interval:  viper.GetDuration("ACCOUNT_DELETION_INTERVAL"),
+			Successor: viper.GetString("ACCOUNT_DELETION_SUCCESSOR"),
+		},
+		GeoIP: GeoIPConfig{
+			Driver:              viper.GetString("GEOIP_DRIVER"),
+			Header:              viper.GetString("GEOIP_HEADER"),
+			Database:            viper.GetString("GEOIP_DATABASE"),
+			BlockedRegistration: splitCountries(viper.GetString("GEO_BLOCK_REGISTRATION")),
+		},
+		Age: AgeConfig{
+			MinimumAge: viper.GetInt("AGE_MINIMUM"),
+			AdultAge:   viper.GetInt("AGE_ADULT"),
+		},
+		Presence: PresenceConfig{
+			UpdateInterval: viper.GetDuration("PRESENCE_UPDATE_INTERVAL"),
+			OnlineWindow:   viper.GetDuration("PRESENCE_ONLINE_WINDOW"),
+		},
+		Backfill: BackfillConfig{
+			BatchSize:  viper.GetInt("BACKFILL_BATCH_SIZE"),
+			BatchDelay: viper.GetDuration("BACKFILL_BATCH_DELAY"),
+		},
+		Comments: CommentConfig{
+			ModerateFirstTime: viper.GetBool("COMMENTS_MODERATE_FIRST_TIME"),
+			MaxLength:         viper.GetInt("COMMENTS_MAX_LENGTH"),
+		},
+		Spam: SpamConfig{
+			Driver:  viper.GetString("SPAM_DRIVER"),
+			URL:     viper.GetString("SPAM_URL"),
+			APIKey:  viper.GetString("SPAM_API_KEY"),
+			SiteURL: viper.GetString("SPAM_SITE_URL"),
+			Timeout: viper.GetDuration("SPAM_TIMEOUT"),
+		},
+		ImageProxy: ImageProxyConfig{
+			Enabled:      viper.GetBool("IMAGE_PROXY_ENABLED"),
+			MaxBytes:     viper.GetInt64("IMAGE_PROXY_MAX_BYTES"),
+			MaxPixels:    viper.GetInt("IMAGE_PROXY_MAX_PIXELS"),
+			Timeout:      viper.GetDuration("IMAGE_PROXY_TIMEOUT"),
+			CacheBytes:   viper.GetInt64("IMAGE_PROXY_CACHE_BYTES"),
+			CacheTTL:     viper.GetDuration("IMAGE_PROXY_CACHE_TTL"),
+			AllowedHosts: splitHosts(viper.GetString("IMAGE_PROXY_ALLOWED_HOSTS")),
+			AllowPrivate: viper.GetBool("IMAGE_PROXY_ALLOW_PRIVATE"),
+		},
+		Mail: MailConfig{
+			Driver:       viper.GetString("MAIL_DRIVER"),
+			From:         viper.GetString("MAIL_FROM"),
+			FromName:     viper.GetString("MAIL_FROM_NAME"),
+			BaseURL:      strings.TrimRight(viper.GetString("MAIL_BASE_URL"), "/"),
+			URL:          viper.GetString("MAIL_URL"),
+			Timeout:      viper.GetDuration("MAIL_TIMEOUT"),
+			SMTPHost:     viper.GetString("MAIL_SMTP_HOST"),
+			SMTPPort:     viper.GetInt("MAIL_SMTP_PORT"),
+			SMTPUsername: viper.GetString("MAIL_SMTP_USERNAME"),
+			SMTPPassword: viper.GetString("MAIL_SMTP_PASSWORD"),
+			APIKey:       viper.GetString("MAIL_API_KEY"),
+			SESRegion:    viper.GetString("MAIL_SES_REGION"),
+			SESAccessKey: viper.GetString("MAIL_SES_ACCESS_KEY_ID"),
+			SESSecretKey: viper.GetString("MAIL_SES_SECRET_ACCESS_KEY"),
+		},
+		Webhooks: WebhookConfig{
+			Timeout:      viper.GetDuration("WEBHOOK_TIMEOUT"),
+			MaxAttempts:  viper.GetInt("WEBHOOK_MAX_ATTEMPTS"),
+			RetryDelay:   viper.GetDuration("WEBHOOK_RETRY_DELAY"),
+			Retention:    viper.GetDuration("WEBHOOK_RETENTION"),
+			AllowPrivate: viper.GetBool("WEBHOOK_ALLOW_PRIVATE"),
+		},
+		Jobs: JobsConfig{
+			Workers:            viper.GetInt("JOBS_WORKERS"),
+			PollInterval:       viper.GetDuration("JOBS_POLL_INTERVAL"),
+			MaxAttempts:        viper.GetInt("JOBS_MAX_ATTEMPTS"),
+			RetryDelay:         viper.GetDuration("JOBS_RETRY_DELAY"),
+			Timeout:            viper.GetDuration("JOBS_TIMEOUT"),
+			Retention:          viper.GetDuration("JOBS_RETENTION"),
+			ViewRollupInterval: viper.GetDuration("JOBS_VIEW_ROLLUP_INTERVAL"),
+		},
+		ReadKeys: ReadKeyConfig{
+			FreeLimit:    viper.GetInt("READ_KEY_FREE_LIMIT"),
+			PartnerLimit: viper.GetInt("READ_KEY_PARTNER_LIMIT"),
+		},
+		Metrics: MetricsConfig{
+			Enabled: viper.GetBool("METRICS_ENABLED"),
+			Path:    viper.GetString("METRICS_PATH"),
+			Token:   viper.GetString("METRICS_TOKEN"),
+		},
+		Health: HealthConfig{
+			CheckTimeout:  viper.GetDuration("HEALTH_CHECK_TIMEOUT"),
+			DiskMinFreeMB: viper.GetInt("HEALTH_DISK_MIN_FREE_MB"),
+		},
+		Docs: DocsConfig{
+			Enabled:  viper.GetBool("DOCS_ENABLED"),
+			Username: viper.GetString("DOCS_USERNAME"),
+			Password: viper.GetString("DOCS_PASSWORD"),
+		},
+		Storage: StorageConfig{
+			Driver:      viper.GetString("STORAGE_DRIVER"),
+			Path:        viper.GetString("STORAGE_PATH"),
+			S3Bucket:    viper.GetString("STORAGE_S3_BUCKET"),
+			S3Region:    viper.GetString("STORAGE_S3_REGION"),
+			S3Endpoint:  viper.GetString("STORAGE_S3_ENDPOINT"),
+			S3AccessKey: viper.GetString("STORAGE_S3_ACCESS_KEY_ID"),
+			S3SecretKey: viper.GetString("STORAGE_S3_SECRET_ACCESS_KEY"),
+			Timeout:     viper.GetDuration("STORAGE_TIMEOUT"),
+		},
+		Media: MediaConfig{
+			Prefix:        viper.GetString("MEDIA_PREFIX"),
+			BaseURL:       strings.TrimRight(viper.GetString("MEDIA_BASE_URL"), "/"),
+			MaxSize:       viper.GetInt64("MEDIA_MAX_SIZE"),
+			AllowedTypes:  splitList(strings.ToLower(viper.GetString("MEDIA_ALLOWED_TYPES"))),
+			URLTTL:        viper.GetDuration("MEDIA_URL_TTL"),
+			MaxPixels:     viper.GetInt("MEDIA_MAX_PIXELS"),
+			ThumbnailSize: viper.GetInt("MEDIA_THUMBNAIL_SIZE"),
+			MediumWidth:   viper.GetInt("MEDIA_MEDIUM_WIDTH"),
+			LargeWidth:    viper.GetInt("MEDIA_LARGE_WIDTH"),
+			ChunkSize:     viper.GetInt64("MEDIA_CHUNK_SIZE"),
+			UploadTTL:     viper.GetDuration("MEDIA_UPLOAD_TTL"),
+		},
+		Malware: MalwareConfig{
+			Driver:        viper.GetString("MALWARE_DRIVER"),
+			ClamAVAddress: viper.GetString("MALWARE_CLAMAV_ADDRESS"),
+			Timeout:       viper.GetDuration("MALWARE_TIMEOUT"),
+		},
+		StaticSite: StaticSiteConfig{
+			Prefix:   viper.GetString("STATIC_SITE_PREFIX"),
+			PageSize: viper.GetInt("STATIC_SITE_PAGE_SIZE"),
+		},
+		HTTPCache: HTTPCacheConfig{
+			Enabled:  viper.GetBool("HTTP_CACHE_ENABLED"),
+			Posts:    viper.GetString("HTTP_CACHE_POSTS"),
+			Profiles: viper.GetString("HTTP_CACHE_PROFILES"),
+			Tags:     viper.GetString("HTTP_CACHE_TAGS"),
+			Meta:     viper.GetString("HTTP_CACHE_META"),
+		},
+		Idempotency: IdempotencyConfig{
+			Enabled: viper.GetBool("IDEMPOTENCY_ENABLED"),
+			TTL:     viper.GetDuration("IDEMPOTENCY_TTL"),
+		},
+		RequestTimeout: RequestTimeoutConfig{
+			Enabled: viper.GetBool("REQUEST_TIMEOUT_ENABLED"),
+			Read:    viper.GetDuration("REQUEST_TIMEOUT_READ"),
+			Write:   viper.GetDuration("REQUEST_TIMEOUT_WRITE"),
+			Export:  viper.GetDuration("REQUEST_TIMEOUT_EXPORT"),
+		},
+		API: APIConfig{
+			V2Enabled:         viper.GetBool("API_V2_ENABLED"),
+			V1DeprecationLink: viper.GetString("API_V1_DEPRECATION_LINK"),
+		},
+		GraphQL: GraphQLConfig{
+			Enabled:         viper.GetBool("GRAPHQL_ENABLED"),
+			ComplexityLimit: viper.GetInt("GRAPHQL_COMPLEXITY_LIMIT"),
+		},
+		WebSocket: WebSocketConfig{
+			Enabled:          viper.GetBool("WS_ENABLED"),
+			PingInterval:     viper.GetDuration("WS_PING_INTERVAL"),
+			MessageRate:      viper.GetInt("WS_MESSAGE_RATE"),
+			MaxSubscriptions: viper.GetInt("WS_MAX_SUBSCRIPTIONS"),
+		},
+		Debug: DebugConfig{
+			Enabled: viper.GetBool("DEBUG_ENDPOINTS_ENABLED"),
+		},
+		Tenancy: TenancyConfig{
+			Enabled:    viper.GetBool("TENANCY_ENABLED"),
+			Header:     viper.GetString("TENANCY_HEADER"),
+			BaseDomain: strings.ToLower(strings.Trim(viper.GetString("TENANCY_BASE_DOMAIN"), ".")),
+		},
+		Secrets: SecretsConfig{
+			Provider:        viper.GetString("SECRETS_PROVIDER"),
+			RefreshInterval: viper.GetDuration("SECRETS_REFRESH_INTERVAL"),
+			Timeout:         viper.GetDuration("SECRETS_TIMEOUT"),
+			JWTSecret:       viper.GetString("SECRETS_JWT_SECRET"),
+			DBPassword:      viper.GetString("SECRETS_DB_PASSWORD"),
+			VaultAddress:    viper.GetString("SECRETS_VAULT_ADDRESS"),
+			VaultToken:      viper.GetString("SECRETS_VAULT_TOKEN"),
+			VaultMount:      viper.GetString("SECRETS_VAULT_MOUNT"),
+			AWSRegion:       viper.GetString("SECRETS_AWS_REGION"),
+			AWSEndpoint:     viper.GetString("SECRETS_AWS_ENDPOINT"),
+			AWSAccessKey:    viper.GetString("SECRETS_AWS_ACCESS_KEY_ID"),
+			AWSSecretKey:    viper.GetString("SECRETS_AWS_SECRET_ACCESS_KEY"),
+		},
+		Seed: SeedConfig{
+			AdminEmail:     viper.GetString("SEED_ADMIN_EMAIL"),
+			AdminPassword:  viper.GetString("SEED_ADMIN_PASSWORD"),
+			AdminFirstName: viper.GetString("SEED_ADMIN_FIRST_NAME"),
+			AdminLastName:  viper.GetString("SEED_ADMIN_LAST_NAME"),
+		},
+	}
+
+	// Every problem is collected, so they can all be fixed at once
+	var errs ValidationErrors
+
+	geoRateLimits, err := parseCountryLimits(viper.GetString("GEO_RATE_LIMITS"))
+	errs.addErr("GEO_RATE_LIMITS", err)
+	config.GeoIP.RateLimits = geoRateLimits
+
+	imageWidths, err := parseWidths("IMAGE_PROXY_WIDTHS", viper.GetString("IMAGE_PROXY_WIDTHS"))
+	errs.addErr("IMAGE_PROXY_WIDTHS", err)
+	config.ImageProxy.Widths = imageWidths
+
+	avatarSizes, err := parseWidths("AVATAR_UPLOAD_SIZES", viper.GetString("AVATAR_UPLOAD_SIZES"))
+	errs.addErr("AVATAR_UPLOAD_SIZES", err)
+	config.Avatar.UploadSizes = avatarSizes
+
+	config.API.V1DeprecatedAt, err = parseDate("API_V1_DEPRECATED_AT", viper.GetString("API_V1_DEPRECATED_AT"))
+	errs.addErr("API_V1_DEPRECATED_AT", err)
+	config.API.V1SunsetAt, err = parseDate("API_V1_SUNSET_AT", viper.GetString("API_V1_SUNSET_AT"))
+	errs.addErr("API_V1_SUNSET_AT", err)
+
+	// The default port depends on the driver
+	if config.Database.Port == "" {
+		config.Database.Port = defaultDBPort(config.Database.Driver)
+	}
+
+	// Secrets from the secrets provider replace those of the environment,
+	// when its settings are usable
+	var secretsErrs ValidationErrors
+	if config.validateSecrets(&secretsErrs); len(secretsErrs) == 0 {
+		errs.addErr("SECRETS_PROVIDER", config.loadSecrets())
 	}
 
 	// Validate required configurations
 	if err := config.Validate(); err != nil {
-		return nil, err
+		errs = append(errs, err.(ValidationErrors)...)
+	}
+	if len(errs) > 0 {
+		return nil, errs
 	}
 
 	return config, nil
@@ -129,39 +854,209 @@ func setDefaults() {
 	viper.SetDefault("APP_ENV", "development")
 	viper.SetDefault("APP_PORT", "8080")
 	viper.SetDefault("APP_DEBUG", true)
+	viper.SetDefault("APP_MAX_UPLOAD_SIZE", 10<<20)
+	viper.SetDefault("APP_STRICT_JSON", false)
+	viper.SetDefault("APP_READ_ONLY", false)
+	viper.SetDefault("APP_TIMEZONE", "UTC")
+	viper.SetDefault("APP_TIME_FORMAT", "rfc3339")
 
 	viper.SetDefault("DB_DRIVER", "sqlite")
 	viper.SetDefault("DB_HOST", "localhost")
-	viper.SetDefault("DB_PORT", "5432")
 	viper.SetDefault("DB_NAME", "enterprise.db")
 	viper.SetDefault("DB_SSL_MODE", "disable")
+	viper.SetDefault("DB_CHARSET", "utf8mb4")
+	viper.SetDefault("DB_MAX_IDLE_CONNS", 10)
+	viper.SetDefault("DB_MAX_OPEN_CONNS", 100)
+	viper.SetDefault("DB_CONN_MAX_LIFETIME", "1h")
+	viper.SetDefault("DB_CONN_MAX_IDLE_TIME", "10m")
+	viper.SetDefault("DB_RETRY_MAX_ATTEMPTS", 3)
+	viper.SetDefault("DB_RETRY_DELAY", "50ms")
 
 	viper.SetDefault("JWT_EXPIRY_HOURS", 24)
 	viper.SetDefault("JWT_REFRESH_EXPIRY_HOURS", 168)
 
 	viper.SetDefault("LOG_LEVEL", "debug")
 	viper.SetDefault("LOG_FORMAT", "json")
+	viper.SetDefault("LOG_BODIES", false)
+	viper.SetDefault("LOG_BODY_MAX_BYTES", 4096)
+	viper.SetDefault("LOG_REDACT_FIELDS", "password,token,authorization,secret,api_key")
+	viper.SetDefault("LOG_OUTPUTS", "stdout")
+	viper.SetDefault("LOG_FILE", "logs/app.log")
+	viper.SetDefault("LOG_ACCESS_FILE", "logs/access.log")
+	viper.SetDefault("LOG_FILE_MAX_SIZE_MB", 100)
+	viper.SetDefault("LOG_FILE_MAX_BACKUPS", 7)
+	viper.SetDefault("LOG_FILE_MAX_AGE_DAYS", 30)
+	viper.SetDefault("LOG_FILE_COMPRESS", true)
+	viper.SetDefault("LOG_FILE_ROTATE_INTERVAL", "24h")
 
 	viper.SetDefault("RATE_LIMIT_REQUESTS", 100)
 	viper.SetDefault("RATE_LIMIT_DURATION", "1m")
+	viper.SetDefault("RATE_LIMIT_STORE", "memory")
+	viper.SetDefault("RATE_LIMIT_REDIS_TIMEOUT", "500ms")
 
 	viper.SetDefault("CORS_ALLOWED_ORIGINS", "*")
 	viper.SetDefault("CORS_ALLOWED_METHODS", "GET,POST,PUT,DELETE,OPTIONS")
-	viper.SetDefault("CORS_ALLOWED_HEADERS", "Origin,Content-Type,Authorization")
+	viper.SetDefault("CORS_ALLOWED_HEADERS", "Origin,Content-Type,Authorization,X-API-Key,X-Read-Key,X-Timezone,X-Time-Format,If-None-Match,If-Modified-Since,Idempotency-Key,X-Request-ID,traceparent,X-Read-Primary,X-Organization")
+
+	viper.SetDefault("SEARCH_DRIVER", "database")
+	viper.SetDefault("SEARCH_INDEX", "posts")
+	viper.SetDefault("SEARCH_TIMEOUT", "5s")
+
+	viper.SetDefault("ADMIN_UNDO_RETENTION", "24h")
+	viper.SetDefault("ADMIN_INVITE_TTL", "168h")
+	viper.SetDefault("POST_PREVIEW_TOKEN_TTL", "72h")
+	viper.SetDefault("POST_MAX_PROFILE_PINS", 3)
+	viper.SetDefault("POST_REQUIRE_ALT_TEXT", false)
+
+	viper.SetDefault("AVATAR_STYLE", "initials")
+	viper.SetDefault("AVATAR_SIZE", 128)
+	viper.SetDefault("AVATAR_PREFIX", "avatars")
+	viper.SetDefault("AVATAR_UPLOAD_SIZES", "64,128,256")
+
+	viper.SetDefault("DORMANCY_ENABLED", false)
+	viper.SetDefault("DORMANCY_INTERVAL", "24h")
+	viper.SetDefault("DORMANCY_NOTICE_AFTER_DAYS", 365)
+	viper.SetDefault("DORMANCY_DEACTIVATE_AFTER_DAYS", 30)
+	viper.SetDefault("DORMANCY_ANONYMIZE_AFTER_DAYS", 0)
+
+	viper.SetDefault("ACCOUNT_DELETION_GRACE_DAYS", 30)
+	viper.SetDefault("ACCOUNT_DELETION_INTERVAL", "1h")
+	viper.SetDefault("ACCOUNT_DELETION_SUCCESSOR", "")
+
+	viper.SetDefault("GEOIP_DRIVER", "none")
+	viper.SetDefault("GEOIP_HEADER", "CF-IPCountry")
+
+	viper.SetDefault("AGE_MINIMUM", 0)
+	viper.SetDefault("AGE_ADULT", 18)
+
+	viper.SetDefault("PRESENCE_UPDATE_INTERVAL", "5m")
+	viper.SetDefault("PRESENCE_ONLINE_WINDOW", "10m")
+
+	viper.SetDefault("BACKFILL_BATCH_SIZE", 500)
+	viper.SetDefault("BACKFILL_BATCH_DELAY", "100ms")
+
+	viper.SetDefault("COMMENTS_MODERATE_FIRST_TIME", true)
+	viper.SetDefault("COMMENTS_MAX_LENGTH", 5000)
+
+	viper.SetDefault("SPAM_DRIVER", "none")
+	viper.SetDefault("SPAM_URL", "https://rest.akismet.com")
+	viper.SetDefault("SPAM_TIMEOUT", "5s")
+
+	viper.SetDefault("IMAGE_PROXY_ENABLED", true)
+	viper.SetDefault("IMAGE_PROXY_WIDTHS", "320,640,1024,1600")
+	viper.SetDefault("IMAGE_PROXY_MAX_BYTES", 10<<20)
+	viper.SetDefault("IMAGE_PROXY_MAX_PIXELS", 40_000_000)
+	viper.SetDefault("IMAGE_PROXY_TIMEOUT", "10s")
+	viper.SetDefault("IMAGE_PROXY_CACHE_BYTES", 64<<20)
+	viper.SetDefault("IMAGE_PROXY_CACHE_TTL", "24h")
+	viper.SetDefault("IMAGE_PROXY_ALLOW_PRIVATE", false)
+
+	viper.SetDefault("MAIL_DRIVER", "none")
+	viper.SetDefault("MAIL_BASE_URL", "http://localhost:8080")
+	viper.SetDefault("MAIL_TIMEOUT", "10s")
+	viper.SetDefault("MAIL_SMTP_PORT", 587)
+
+	viper.SetDefault("WEBHOOK_TIMEOUT", "10s")
+	viper.SetDefault("WEBHOOK_MAX_ATTEMPTS", 5)
+	viper.SetDefault("WEBHOOK_RETRY_DELAY", "1m")
+	viper.SetDefault("WEBHOOK_RETENTION", "720h")
+	viper.SetDefault("WEBHOOK_ALLOW_PRIVATE", false)
+
+	// Background jobs
+	viper.SetDefault("JOBS_WORKERS", 4)
+	viper.SetDefault("JOBS_POLL_INTERVAL", "1s")
+	viper.SetDefault("JOBS_MAX_ATTEMPTS", 5)
+	viper.SetDefault("JOBS_RETRY_DELAY", "30s")
+	viper.SetDefault("JOBS_TIMEOUT", "10m")
+	viper.SetDefault("JOBS_RETENTION", "168h")
+	viper.SetDefault("JOBS_VIEW_ROLLUP_INTERVAL", "1m")
+
+	// Anonymous read keys
+	viper.SetDefault("READ_KEY_FREE_LIMIT", 300)
+	viper.SetDefault("READ_KEY_PARTNER_LIMIT", 6000)
+
+	viper.SetDefault("METRICS_ENABLED", true)
+	viper.SetDefault("METRICS_PATH", "/metrics")
+
+	viper.SetDefault("DOCS_ENABLED", true)
+
+	viper.SetDefault("HEALTH_CHECK_TIMEOUT", "2s")
+	viper.SetDefault("HEALTH_DISK_MIN_FREE_MB", 100)
+
+	viper.SetDefault("STORAGE_DRIVER", "local")
+	viper.SetDefault("STORAGE_PATH", "./storage")
+	viper.SetDefault("STORAGE_TIMEOUT", "30s")
+
+	// Secrets provider defaults
+	viper.SetDefault("SECRETS_PROVIDER", "env")
+	viper.SetDefault("SECRETS_REFRESH_INTERVAL", "5m")
+	viper.SetDefault("SECRETS_TIMEOUT", "10s")
+	viper.SetDefault("SECRETS_VAULT_ADDRESS", "http://localhost:8200")
+	viper.SetDefault("SECRETS_VAULT_MOUNT", "secret")
+
+	viper.SetDefault("MEDIA_PREFIX", "media")
+	viper.SetDefault("MEDIA_BASE_URL", "http://localhost:8080")
+	viper.SetDefault("MEDIA_MAX_SIZE", 10<<20)
+	viper.SetDefault("MEDIA_ALLOWED_TYPES", "image/jpeg,image/png,image/gif,application/pdf")
+	viper.SetDefault("MEDIA_URL_TTL", "15m")
+	viper.SetDefault("MEDIA_MAX_PIXELS", 40_000_000)
+	viper.SetDefault("MEDIA_THUMBNAIL_SIZE", 150)
+	viper.SetDefault("MEDIA_MEDIUM_WIDTH", 640)
+	viper.SetDefault("MEDIA_LARGE_WIDTH", 1280)
+	viper.SetDefault("MEDIA_CHUNK_SIZE", 5<<20)
+	viper.SetDefault("MEDIA_UPLOAD_TTL", "24h")
+
+	viper.SetDefault("MALWARE_DRIVER", "none")
+	viper.SetDefault("MALWARE_CLAMAV_ADDRESS", "localhost:3310")
+	viper.SetDefault("MALWARE_TIMEOUT", "30s")
+
+	viper.SetDefault("STATIC_SITE_PREFIX", "site")
+	viper.SetDefault("STATIC_SITE_PAGE_SIZE", 20)
+
+	viper.SetDefault("HTTP_CACHE_ENABLED", true)
+	viper.SetDefault("HTTP_CACHE_POSTS", "public, max-age=60")
+	viper.SetDefault("HTTP_CACHE_PROFILES", "public, max-age=60")
+	viper.SetDefault("HTTP_CACHE_TAGS", "public, max-age=300")
+	viper.SetDefault("HTTP_CACHE_META", "public, max-age=300")
+
+	viper.SetDefault("IDEMPOTENCY_ENABLED", true)
+	viper.SetDefault("IDEMPOTENCY_TTL", "24h")
+
+	viper.SetDefault("REQUEST_TIMEOUT_ENABLED", true)
+	viper.SetDefault("REQUEST_TIMEOUT_READ", "5s")
+	viper.SetDefault("REQUEST_TIMEOUT_WRITE", "10s")
+	viper.SetDefault("REQUEST_TIMEOUT_EXPORT", "30s")
+
+	// API version defaults
+	viper.SetDefault("API_V2_ENABLED", false)
+	viper.SetDefault("API_V1_DEPRECATED_AT", "")
+	viper.SetDefault("API_V1_SUNSET_AT", "")
+	viper.SetDefault("API_V1_DEPRECATION_LINK", "")
+
+	viper.SetDefault("GRAPHQL_ENABLED", true)
+	viper.SetDefault("GRAPHQL_COMPLEXITY_LIMIT", 200)
+
+	viper.SetDefault("WS_ENABLED", true)
+	viper.SetDefault("WS_PING_INTERVAL", "30s")
+	viper.SetDefault("WS_MESSAGE_RATE", 60)
+	viper.SetDefault("WS_MAX_SUBSCRIPTIONS", 20)
+
+	viper.SetDefault("DEBUG_ENDPOINTS_ENABLED", true)
+
+	viper.SetDefault("TENANCY_ENABLED", false)
+	viper.SetDefault("TENANCY_HEADER", "X-Organization")
+
+	viper.SetDefault("SEED_ADMIN_FIRST_NAME", "Admin")
 }
 
-// Validate validates the configuration
-func (c *Config) Validate() error {
-	if c.JWT.Secret == "" {
-		return fmt.Errorf("JWT_SECRET is required")
-	}
-	if len(c.JWT.Secret) < 32 {
-		return fmt.Errorf("JWT_SECRET must be at least 32 characters")
-	}
-	if c.App.Port == "" {
-		return fmt.Errorf("APP_PORT is required")
+// FeatureEnabled returns true if the named feature flag is enabled
+func (c *Config) FeatureEnabled(name string) bool {
+	for _, f := range c.Features.Enabled {
+		if f == name {
+			return true
+		}
 	}
-	return nil
+	return false
 }
 
 // IsDevelopment returns true if the application is running in development mode
@@ -183,13 +1078,131 @@ func (c *Config) GetDSN() string {
 			c.Database.Host,
 			c.Database.Port,
 			c.Database.User,
-			c.Database.Password,
+			c.DBPassword(),
 			c.Database.Name,
 			c.Database.SSLMode,
 		)
 	case "sqlite":
-		return c.Database.Name
+		// Background job workers write concurrently: wait for the database
+		// lock instead of failing, and take it when a transaction begins so
+		// transactions do not fail upgrading a read lock
+		sep := "?"
+		if strings.Contains(c.Database.Name, "?") {
+			sep = "&"
+		}
+		return c.Database.Name + sep + "_busy_timeout=5000&_txlock=immediate"
+	case "mysql":
+		// Scan DATETIME columns into time.Time, stored and read as UTC
+		dsn := mysql.NewConfig()
+		dsn.User = c.Database.User
+		dsn.Passwd = c.DBPassword()
+		dsn.Net = "tcp"
+		dsn.Addr = net.JoinHostPort(c.Database.Host, c.Database.Port)
+		dsn.DBName = c.Database.Name
+		dsn.ParseTime = true
+		dsn.Loc = time.UTC
+		if c.Database.Charset != "" {
+			dsn.Params = map[string]string{"charset": c.Database.Charset}
+		}
+		return dsn.FormatDSN()
 	default:
 		return ""
 	}
 }
+
+// GetReplicaDSNs returns the connection strings of the read replicas
+func (c *Config) GetReplicaDSNs() []string {
+	dsns := make([]string, 0, len(c.Database.ReplicaHosts))
+	for _, host := range c.Database.ReplicaHosts {
+		replica := *c
+		replica.Database.Host, replica.Database.Port = host, c.Database.Port
+		if h, port, err := net.SplitHostPort(host); err == nil {
+			replica.Database.Host, replica.Database.Port = h, port
+		}
+		dsns = append(dsns, replica.GetDSN())
+	}
+	return dsns
+}
+
+// defaultDBPort returns the standard port of a database driver
+func defaultDBPort(driver string) string {
+	if driver == "mysql" {
+		return "3306"
+	}
+	return "5432"
+}
+
+// splitCountries splits a comma-separated list of country codes and upper-cases them
+func splitCountries(value string) []string {
+	countries := splitList(value)
+	for i, country := range countries {
+		countries[i] = strings.ToUpper(country)
+	}
+	return countries
+}
+
+// parseCountryLimits parses a comma-separated list of COUNTRY=LIMIT pairs
+func parseCountryLimits(value string) (map[string]int, error) {
+	limits := make(map[string]int)
+	for _, item := range splitList(value) {
+		country, limit, ok := strings.Cut(item, "=")
+		n, err := strconv.Atoi(strings.TrimSpace(limit))
+		if !ok || err != nil || n < 1 {
+			return nil, fmt.Errorf("invalid GEO_RATE_LIMITS entry %q: expected COUNTRY=LIMIT", item)
+		}
+		limits[strings.ToUpper(strings.TrimSpace(country))] = n
+	}
+	return limits, nil
+}
+
+// splitHosts splits a comma-separated list of host names and lower-cases them
+func splitHosts(value string) []string {
+	hosts := splitList(value)
+	for i, host := range hosts {
+		hosts[i] = strings.ToLower(host)
+	}
+	return hosts
+}
+
+// parseWidths parses a comma-separated list of image widths in pixels and sorts them
+func parseWidths(key, value string) ([]int, error) {
+	widths := make([]int, 0)
+	for _, item := range splitList(value) {
+		n, err := strconv.Atoi(item)
+		if err != nil || n < 1 || n > 4096 {
+			return nil, fmt.Errorf("invalid %s entry %q: expected a width between 1 and 4096", key, item)
+		}
+		widths = append(widths, n)
+	}
+	sort.Ints(widths)
+	return widths, nil
+}
+
+// parseDate parses a date such as 2025-01-31, taken as midnight UTC, or an
+// RFC 3339 timestamp. An empty value is the zero time.
+func parseDate(key, value string) (time.Time, error) {
+	value = strings.TrimSpace(value)
+	if value == "" {
+		return time.Time{}, nil
+	}
+	if t, err := time.Parse("2006-01-02", value); err == nil {
+		return t, nil
+	}
+	t, err := time.Parse(time.RFC3339, value)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("invalid %s %q: expected a date such as 2025-01-31 or an RFC 3339 timestamp", key, value)
+	}
+	return t, nil
+}
+
+// splitList splits a comma-separated value, trimming blanks and dropping empty items
+func splitList(value string) []string {
+	items := make([]string, 0)
+	for _, item := range strings.Split(value, ",") {
+		item = strings.TrimSpace(item)
+		if item != "" {
+			items = append(items, item)
+		}
+	}
+	return items
+}
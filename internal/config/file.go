@@ -0,0 +1,163 @@
+package config
+
+import (
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+
+	"github.com/spf13/viper"
+)
+
+// configFileNames are the config files looked for in the working directory
+// when CONFIG_FILE is not set, the first one found being used
+var configFileNames = []string{"config.yaml", "config.yml", "config.toml"}
+
+// envReference matches ${VAR} and ${VAR:-default} references to environment
+// variables in config file values
+var envReference = regexp.MustCompile(`\$\{([A-Za-z_][A-Za-z0-9_]*)(?::-([^}]*))?\}`)
+
+// loadFiles merges the config files into viper, later ones overriding
+// earlier ones:
+//
+//  1. the config file, CONFIG_FILE or the first of configFileNames found
+//  2. the file of the profile, e.g. config.production.yaml, the profile
+//     being APP_ENV
+//  3. the .env file
+//
+// Environment variables override them all, and the defaults apply to the
+// settings none of them set. Config file keys are the environment variable
+// names, nested or not: APP_PORT is set by either of
+//
+//	app:
+//	  port: 8080
+//	APP_PORT: 8080
+//
+// String values may reference environment variables as ${VAR} or
+// ${VAR:-default}, and lists are joined into comma-separated values.
+func loadFiles() error {
+	path, explicit := os.Getenv("CONFIG_FILE"), true
+	if path == "" {
+		path, explicit = findConfigFile(), false
+	}
+
+	var base map[string]interface{}
+	if path != "" {
+		var err error
+		if base, err = readFile(path, true); err != nil {
+			if explicit || !errors.Is(err, fs.ErrNotExist) {
+				return err
+			}
+		}
+	}
+
+	dotenv, err := readFile(".env", false)
+	if err != nil && !errors.Is(err, fs.ErrNotExist) {
+		return err
+	}
+
+	var profile map[string]interface{}
+	if name := profileName(base, dotenv); path != "" && name != "" {
+		profile, err = readFile(profileFile(path, name), true)
+		if err != nil && !errors.Is(err, fs.ErrNotExist) {
+			return err
+		}
+	}
+
+	for _, settings := range []map[string]interface{}{base, profile, dotenv} {
+		if err := viper.MergeConfigMap(settings); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// findConfigFile returns the first of configFileNames in the working
+// directory, or "" if there is none
+func findConfigFile() string {
+	for _, name := range configFileNames {
+		if _, err := os.Stat(name); err == nil {
+			return name
+		}
+	}
+	return ""
+}
+
+// profileName returns the profile, APP_ENV as set by the environment, the
+// .env file or the config file
+func profileName(base, dotenv map[string]interface{}) string {
+	if env := os.Getenv("APP_ENV"); env != "" {
+		return env
+	}
+	for _, settings := range []map[string]interface{}{dotenv, base} {
+		if env, ok := settings["app_env"].(string); ok && env != "" {
+			return env
+		}
+	}
+	return ""
+}
+
+// profileFile returns the file of a profile next to the config file, e.g.
+// config.production.yaml for config.yaml
+func profileFile(path, profile string) string {
+	ext := filepath.Ext(path)
+	return strings.TrimSuffix(path, ext) + "." + profile + ext
+}
+
+// readFile reads a config file into flat settings keyed by lower-cased
+// environment variable names, interpolating environment variables if
+// interpolate is set
+func readFile(path string, interpolate bool) (map[string]interface{}, error) {
+	v := viper.New()
+	v.SetConfigFile(path)
+	if err := v.ReadInConfig(); err != nil {
+		return nil, fmt.Errorf("error reading config file %s: %w", path, err)
+	}
+
+	settings := make(map[string]interface{})
+	flatten(settings, "", v.AllSettings(), interpolate)
+	return settings, nil
+}
+
+// flatten adds nested settings to settings, joining their keys with "_"
+func flatten(settings map[string]interface{}, prefix string, nested map[string]interface{}, interpolate bool) {
+	for key, value := range nested {
+		key = strings.ToLower(key)
+		if prefix != "" {
+			key = prefix + "_" + key
+		}
+
+		switch v := value.(type) {
+		case map[string]interface{}:
+			flatten(settings, key, v, interpolate)
+		case []interface{}:
+			items := make([]string, len(v))
+			for i, item := range v {
+				items[i] = expand(fmt.Sprint(item), interpolate)
+			}
+			settings[key] = strings.Join(items, ",")
+		case string:
+			settings[key] = expand(v, interpolate)
+		default:
+			settings[key] = v
+		}
+	}
+}
+
+// expand replaces the references to environment variables in a value. An
+// unset or empty variable is replaced with its default, or "" if it has none.
+func expand(value string, interpolate bool) string {
+	if !interpolate {
+		return value
+	}
+	return envReference.ReplaceAllStringFunc(value, func(ref string) string {
+		match := envReference.FindStringSubmatch(ref)
+		if env := os.Getenv(match[1]); env != "" {
+			return env
+		}
+		return match[2]
+	})
+}
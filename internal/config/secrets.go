@@ -0,0 +1,99 @@
+package config
+
+import (
+	"context"
+	"fmt"
+	"sync"
+
+	"github.com/yourusername/go-enterprise-api/internal/secrets"
+)
+
+// secretsMu guards the settings refreshed from the secrets provider while
+// the server runs
+var secretsMu sync.RWMutex
+
+// loadSecrets fetches the secrets SECRETS_JWT_SECRET and SECRETS_DB_PASSWORD
+// reference from the secrets provider, replacing JWT_SECRET and DB_PASSWORD,
+// and keeps a refresher to fetch them again later. The settings of the
+// provider are checked by validateSecrets first.
+func (c *Config) loadSecrets() error {
+	if c.Secrets.Provider == secrets.ProviderEnv {
+		return nil
+	}
+
+	provider, err := c.SecretsProvider()
+	if err != nil {
+		return err
+	}
+
+	refresher := secrets.NewRefresher(provider, c.Secrets.RefreshInterval)
+	if c.Secrets.JWTSecret != "" {
+		refresher.Watch(c.Secrets.JWTSecret, func(value string) error {
+			if len(value) < 32 {
+				return fmt.Errorf("JWT secret must be at least 32 characters")
+			}
+			secretsMu.Lock()
+			defer secretsMu.Unlock()
+			c.JWT.Secret = value
+			return nil
+		})
+	}
+	if c.Secrets.DBPassword != "" {
+		refresher.Watch(c.Secrets.DBPassword, func(value string) error {
+			secretsMu.Lock()
+			defer secretsMu.Unlock()
+			c.Database.Password = value
+			return nil
+		})
+	}
+
+	if _, err := refresher.Refresh(context.Background()); err != nil {
+		return fmt.Errorf("failed to fetch secrets: %w", err)
+	}
+	c.refresher = refresher
+	return nil
+}
+
+// SecretsProvider creates the configured secrets provider, which is nil when
+// the secrets are read from the environment
+func (c *Config) SecretsProvider() (secrets.Provider, error) {
+	return secrets.New(c.Secrets.Provider, secrets.Options{
+		VaultAddress: c.Secrets.VaultAddress,
+		VaultToken:   c.Secrets.VaultToken,
+		VaultMount:   c.Secrets.VaultMount,
+		AWSRegion:    c.Secrets.AWSRegion,
+		AWSEndpoint:  c.Secrets.AWSEndpoint,
+		AWSAccessKey: c.Secrets.AWSAccessKey,
+		AWSSecretKey: c.Secrets.AWSSecretKey,
+		Timeout:      c.Secrets.Timeout,
+	})
+}
+
+// SecretsRefresher returns the refresher fetching the secrets from the
+// secrets provider again, or nil if they are read from the environment
+func (c *Config) SecretsRefresher() *secrets.Refresher {
+	return c.refresher
+}
+
+// JWTSecret returns the secret tokens are signed with. It may change while
+// the server runs when it comes from the secrets provider.
+func (c *Config) JWTSecret() string {
+	secretsMu.RLock()
+	defer secretsMu.RUnlock()
+	return c.JWT.Secret
+}
+
+// DBPassword returns the database password. It may change while the server
+// runs when it comes from the secrets provider.
+func (c *Config) DBPassword() string {
+	secretsMu.RLock()
+	defer secretsMu.RUnlock()
+	return c.Database.Password
+}
+
+// RefreshesDBPassword reports whether the database password is fetched from
+// the secrets provider again while the server runs, in which case new
+// connections must use the current password
+func (c *Config) RefreshesDBPassword() bool {
+	return c.refresher != nil && c.Secrets.DBPassword != "" && c.Secrets.RefreshInterval > 0
+}
@@ -0,0 +1,489 @@
+package config
+
+import (
+	"fmt"
+	"net"
+	"net/mail"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/yourusername/go-enterprise-api/internal/secrets"
+)
+
+// ValidationError is a problem with a setting, named by Key
+type ValidationError struct {
+	Key     string
+	Message string
+}
+
+// Error returns the message of the problem
+func (e ValidationError) Error() string {
+	return e.Message
+}
+
+// ValidationErrors lists every problem found in a configuration, so they
+// can all be fixed at once
+type ValidationErrors []ValidationError
+
+// Error lists the problems, one per line
+func (e ValidationErrors) Error() string {
+	if len(e) == 1 {
+		return "invalid configuration: " + e[0].Message
+	}
+
+	var b strings.Builder
+	fmt.Fprintf(&b, "invalid configuration, %d problems:", len(e))
+	for _, err := range e {
+		b.WriteString("\n  - " + err.Message)
+	}
+	return b.String()
+}
+
+// add records a problem with the setting key
+func (e *ValidationErrors) add(key, format string, args ...interface{}) {
+	*e = append(*e, ValidationError{Key: key, Message: fmt.Sprintf(format, args...)})
+}
+
+// addErr records err as a problem with the setting key, unless it is nil
+func (e *ValidationErrors) addErr(key string, err error) {
+	if err != nil {
+		*e = append(*e, ValidationError{Key: key, Message: err.Error()})
+	}
+}
+
+// Validate validates the configuration. It returns ValidationErrors listing
+// every problem found, or nil if there is none.
+func (c *Config) Validate() error {
+	var errs ValidationErrors
+	c.validateApp(&errs)
+	c.validateDatabase(&errs)
+	c.validateAuth(&errs)
+	c.validateSecrets(&errs)
+	c.validateRateLimit(&errs)
+	c.validateCORS(&errs)
+	c.validateMail(&errs)
+	c.validateContent(&errs)
+	c.validateStorage(&errs)
+	c.validateJobs(&errs)
+	c.validateLog(&errs)
+	if len(errs) > 0 {
+		return errs
+	}
+	return nil
+}
+
+// validateApp checks the server, API and request settings
+func (c *Config) validateApp(errs *ValidationErrors) {
+	if c.App.Port == "" {
+		errs.add("APP_PORT", "APP_PORT is required")
+	} else if !validPort(c.App.Port) {
+		errs.add("APP_PORT", "APP_PORT must be a port number between 1 and 65535, got %q", c.App.Port)
+	}
+	if _, err := time.LoadLocation(c.App.Timezone); err != nil {
+		errs.add("APP_TIMEZONE", "APP_TIMEZONE is invalid: %v", err)
+	}
+	switch c.App.TimeFormat {
+	case "rfc3339", "unix", "unix_ms":
+	default:
+		errs.add("APP_TIME_FORMAT", "APP_TIME_FORMAT must be one of: rfc3339, unix, unix_ms")
+	}
+	if c.Idempotency.Enabled && c.Idempotency.TTL <= 0 {
+		errs.add("IDEMPOTENCY_TTL", "IDEMPOTENCY_TTL must be positive")
+	}
+	if c.RequestTimeout.Read < 0 || c.RequestTimeout.Write < 0 || c.RequestTimeout.Export < 0 {
+		errs.add("REQUEST_TIMEOUT_READ", "REQUEST_TIMEOUT_READ, REQUEST_TIMEOUT_WRITE and REQUEST_TIMEOUT_EXPORT must not be negative")
+	}
+	if !c.API.V1SunsetAt.IsZero() && c.API.V1DeprecatedAt.IsZero() {
+		errs.add("API_V1_SUNSET_AT", "API_V1_SUNSET_AT requires API_V1_DEPRECATED_AT")
+	}
+	if c.API.V1DeprecationLink != "" && !isHTTPURL(c.API.V1DeprecationLink) {
+		errs.add("API_V1_DEPRECATION_LINK", "API_V1_DEPRECATION_LINK must be an http or https URL")
+	}
+	if c.Metrics.Enabled && !strings.HasPrefix(c.Metrics.Path, "/") {
+		errs.add("METRICS_PATH", "METRICS_PATH must start with /")
+	}
+	if c.Docs.Enabled && (c.Docs.Username == "") != (c.Docs.Password == "") {
+		errs.add("DOCS_PASSWORD", "DOCS_USERNAME and DOCS_PASSWORD must be set together")
+	}
+	if c.Health.CheckTimeout <= 0 || c.Health.DiskMinFreeMB < 0 {
+		errs.add("HEALTH_CHECK_TIMEOUT", "HEALTH_CHECK_TIMEOUT must be positive and HEALTH_DISK_MIN_FREE_MB must not be negative")
+	}
+	if c.GraphQL.ComplexityLimit < 0 {
+		errs.add("GRAPHQL_COMPLEXITY_LIMIT", "GRAPHQL_COMPLEXITY_LIMIT must not be negative")
+	}
+	if c.WebSocket.Enabled && (c.WebSocket.PingInterval <= 0 || c.WebSocket.MessageRate < 1 || c.WebSocket.MaxSubscriptions < 1) {
+		errs.add("WS_PING_INTERVAL", "WS_PING_INTERVAL, WS_MESSAGE_RATE and WS_MAX_SUBSCRIPTIONS must be positive")
+	}
+	if c.Tenancy.Enabled && c.Tenancy.Header == "" && c.Tenancy.BaseDomain == "" {
+		errs.add("TENANCY_HEADER", "TENANCY_HEADER or TENANCY_BASE_DOMAIN must be set when TENANCY_ENABLED is true")
+	}
+}
+
+// validateDatabase checks the connection and pool settings
+func (c *Config) validateDatabase(errs *ValidationErrors) {
+	switch c.Database.Driver {
+	case "sqlite":
+		if c.Database.Name == "" {
+			errs.add("DB_NAME", "DB_NAME is required: the path of the sqlite database file")
+		}
+		if len(c.Database.ReplicaHosts) > 0 {
+			errs.add("DB_REPLICA_HOSTS", "DB_REPLICA_HOSTS is not supported with sqlite")
+		}
+	case "postgres", "mysql":
+		if c.Database.Host == "" {
+			errs.add("DB_HOST", "DB_HOST is required when DB_DRIVER is %s", c.Database.Driver)
+		}
+		if !validPort(c.Database.Port) {
+			errs.add("DB_PORT", "DB_PORT must be a port number between 1 and 65535, got %q", c.Database.Port)
+		}
+		if c.Database.Name == "" || c.Database.User == "" {
+			errs.add("DB_NAME", "DB_NAME and DB_USER are required when DB_DRIVER is %s", c.Database.Driver)
+		}
+		for _, host := range c.Database.ReplicaHosts {
+			if h, port, err := net.SplitHostPort(host); err == nil && (h == "" || !validPort(port)) {
+				errs.add("DB_REPLICA_HOSTS", "DB_REPLICA_HOSTS entry %q must be a host or host:port", host)
+			}
+		}
+	default:
+		errs.add("DB_DRIVER", "DB_DRIVER must be postgres, mysql or sqlite")
+	}
+	if c.Database.Driver == "postgres" {
+		switch c.Database.SSLMode {
+		case "disable", "allow", "prefer", "require", "verify-ca", "verify-full":
+		default:
+			errs.add("DB_SSL_MODE", "DB_SSL_MODE must be one of: disable, allow, prefer, require, verify-ca, verify-full")
+		}
+	}
+	if c.Database.MaxIdleConns < 0 || c.Database.MaxOpenConns < 0 {
+		errs.add("DB_MAX_IDLE_CONNS", "DB_MAX_IDLE_CONNS and DB_MAX_OPEN_CONNS must not be negative")
+	} else if c.Database.MaxOpenConns > 0 && c.Database.MaxIdleConns > c.Database.MaxOpenConns {
+		errs.add("DB_MAX_IDLE_CONNS", "DB_MAX_IDLE_CONNS must not exceed DB_MAX_OPEN_CONNS")
+	}
+	if c.Database.ConnMaxLifetime < 0 || c.Database.ConnMaxIdleTime < 0 {
+		errs.add("DB_CONN_MAX_LIFETIME", "DB_CONN_MAX_LIFETIME and DB_CONN_MAX_IDLE_TIME must not be negative")
+	}
+	if c.Database.RetryMaxAttempts < 1 || c.Database.RetryDelay < 0 {
+		errs.add("DB_RETRY_MAX_ATTEMPTS", "DB_RETRY_MAX_ATTEMPTS must be positive and DB_RETRY_DELAY must not be negative")
+	}
+}
+
+// validateAuth checks the token, account and client settings
+func (c *Config) validateAuth(errs *ValidationErrors) {
+	if c.JWT.Secret == "" {
+		if c.Secrets.JWTSecret == "" {
+			errs.add("JWT_SECRET", "JWT_SECRET is required")
+		}
+	} else if len(c.JWT.Secret) < 32 {
+		errs.add("JWT_SECRET", "JWT_SECRET must be at least 32 characters")
+	}
+	if c.JWT.ExpiryHours < 1 || c.JWT.RefreshExpiryHours < c.JWT.ExpiryHours {
+		errs.add("JWT_EXPIRY_HOURS", "JWT_EXPIRY_HOURS must be positive and JWT_REFRESH_EXPIRY_HOURS at least JWT_EXPIRY_HOURS")
+	}
+	if c.Age.MinimumAge < 0 || c.Age.AdultAge < 1 {
+		errs.add("AGE_MINIMUM", "AGE_MINIMUM must not be negative and AGE_ADULT must be positive")
+	}
+	if c.Presence.UpdateInterval <= 0 || c.Presence.OnlineWindow < c.Presence.UpdateInterval {
+		errs.add("PRESENCE_ONLINE_WINDOW", "PRESENCE_UPDATE_INTERVAL must be positive and PRESENCE_ONLINE_WINDOW must not be shorter")
+	}
+	if c.Dormancy.Enabled && (c.Dormancy.Interval <= 0 || c.Dormancy.NoticeAfterDays < 1 || c.Dormancy.DeactivateAfterDays < 1) {
+		errs.add("DORMANCY_INTERVAL", "DORMANCY_INTERVAL, DORMANCY_NOTICE_AFTER_DAYS and DORMANCY_DEACTIVATE_AFTER_DAYS must be positive when DORMANCY_ENABLED is true")
+	}
+	if c.Dormancy.Enabled && (c.Mail.Driver == "" || c.Mail.Driver == "none") {
+		errs.add("MAIL_DRIVER", "MAIL_DRIVER must be set when DORMANCY_ENABLED is true, so users are emailed before their account is deactivated")
+	}
+	if c.AccountDeletion.GraceDays < 0 || c.AccountDeletion.Interval <= 0 {
+		errs.add("ACCOUNT_DELETION_GRACE_DAYS", "ACCOUNT_DELETION_GRACE_DAYS must not be negative and ACCOUNT_DELETION_INTERVAL must be positive")
+	}
+	if c.AccountDeletion.Successor != "" {
+		if addr, err := mail.ParseAddress(c.AccountDeletion.Successor); err != nil || addr.Name != "" {
+			errs.add("ACCOUNT_DELETION_SUCCESSOR", "ACCOUNT_DELETION_SUCCESSOR must be the email address of a user")
+		}
+	}
+	if c.ReadKeys.FreeLimit < 1 || c.ReadKeys.PartnerLimit < 1 {
+		errs.add("READ_KEY_FREE_LIMIT", "READ_KEY_FREE_LIMIT and READ_KEY_PARTNER_LIMIT must be positive")
+	}
+	if c.GeoIP.Driver == "csv" && c.GeoIP.Database == "" {
+		errs.add("GEOIP_DATABASE", "GEOIP_DATABASE is required when GEOIP_DRIVER is csv")
+	}
+	if (len(c.GeoIP.BlockedRegistration) > 0 || len(c.GeoIP.RateLimits) > 0) && (c.GeoIP.Driver == "" || c.GeoIP.Driver == "none") {
+		errs.add("GEOIP_DRIVER", "GEO_BLOCK_REGISTRATION and GEO_RATE_LIMITS require a GEOIP_DRIVER")
+	}
+}
+
+// validateSecrets checks the secrets provider settings
+func (c *Config) validateSecrets(errs *ValidationErrors) {
+	switch c.Secrets.Provider {
+	case secrets.ProviderEnv:
+		return
+	case secrets.ProviderVault:
+		if c.Secrets.VaultAddress == "" || c.Secrets.VaultToken == "" {
+			errs.add("SECRETS_VAULT_TOKEN", "SECRETS_VAULT_ADDRESS and SECRETS_VAULT_TOKEN are required when SECRETS_PROVIDER is vault")
+		}
+	case secrets.ProviderAWS:
+		if c.Secrets.AWSRegion == "" || c.Secrets.AWSAccessKey == "" || c.Secrets.AWSSecretKey == "" {
+			errs.add("SECRETS_AWS_REGION", "SECRETS_AWS_REGION, SECRETS_AWS_ACCESS_KEY_ID and SECRETS_AWS_SECRET_ACCESS_KEY are required when SECRETS_PROVIDER is aws")
+		}
+	default:
+		errs.add("SECRETS_PROVIDER", "SECRETS_PROVIDER must be one of: env, vault, aws")
+		return
+	}
+	if c.Secrets.RefreshInterval < 0 {
+		errs.add("SECRETS_REFRESH_INTERVAL", "SECRETS_REFRESH_INTERVAL must not be negative")
+	}
+	if c.Secrets.JWTSecret == "" && c.Secrets.DBPassword == "" {
+		errs.add("SECRETS_JWT_SECRET", "SECRETS_JWT_SECRET or SECRETS_DB_PASSWORD is required when SECRETS_PROVIDER is %s", c.Secrets.Provider)
+	}
+}
+
+// validateRateLimit checks the rate limits and their store
+func (c *Config) validateRateLimit(errs *ValidationErrors) {
+	if c.RateLimit.Requests < 1 || c.RateLimit.Duration <= 0 {
+		errs.add("RATE_LIMIT_REQUESTS", "RATE_LIMIT_REQUESTS and RATE_LIMIT_DURATION must be positive")
+	}
+	switch c.RateLimit.Store {
+	case "", "memory":
+	case "redis":
+		if c.RateLimit.RedisURL == "" {
+			errs.add("RATE_LIMIT_REDIS_URL", "RATE_LIMIT_REDIS_URL is required when RATE_LIMIT_STORE is redis")
+		} else if u, err := url.Parse(c.RateLimit.RedisURL); err != nil || (u.Scheme != "redis" && u.Scheme != "rediss") || u.Host == "" {
+			errs.add("RATE_LIMIT_REDIS_URL", "RATE_LIMIT_REDIS_URL must be a redis:// or rediss:// URL, such as redis://localhost:6379/0")
+		}
+		if c.RateLimit.RedisTimeout <= 0 {
+			errs.add("RATE_LIMIT_REDIS_TIMEOUT", "RATE_LIMIT_REDIS_TIMEOUT must be positive")
+		}
+	default:
+		errs.add("RATE_LIMIT_STORE", "RATE_LIMIT_STORE must be one of: memory, redis")
+	}
+}
+
+// validateCORS checks the syntax of the CORS settings
+func (c *Config) validateCORS(errs *ValidationErrors) {
+	for i, origin := range c.CORS.AllowedOrigins {
+		if origin == "*" {
+			if len(c.CORS.AllowedOrigins) > 1 {
+				errs.add("CORS_ALLOWED_ORIGINS", "CORS_ALLOWED_ORIGINS must be either * or a list of origins, not both")
+			}
+			continue
+		}
+		if strings.TrimSpace(origin) != origin {
+			errs.add("CORS_ALLOWED_ORIGINS", "CORS_ALLOWED_ORIGINS entry %d (%q) must not contain spaces", i+1, origin)
+			continue
+		}
+		u, err := url.Parse(origin)
+		if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" || u.User != nil ||
+			(u.Path != "" && u.Path != "/") || u.RawQuery != "" || u.Fragment != "" {
+			errs.add("CORS_ALLOWED_ORIGINS", "CORS_ALLOWED_ORIGINS entry %q must be an origin such as https://app.example.com, without a path", origin)
+		} else if u.Path == "/" {
+			errs.add("CORS_ALLOWED_ORIGINS", "CORS_ALLOWED_ORIGINS entry %q must not end with /, browsers send %q", origin, strings.TrimSuffix(origin, "/"))
+		}
+	}
+	for _, method := range c.CORS.AllowedMethods {
+		method = strings.TrimSpace(method)
+		if method == "" || strings.ToUpper(method) != method || strings.ContainsAny(method, " \t") {
+			errs.add("CORS_ALLOWED_METHODS", "CORS_ALLOWED_METHODS entry %q must be an upper-case HTTP method such as GET", method)
+		}
+	}
+	for _, header := range c.CORS.AllowedHeaders {
+		header = strings.TrimSpace(header)
+		if header == "" || strings.ContainsAny(header, " \t:") {
+			errs.add("CORS_ALLOWED_HEADERS", "CORS_ALLOWED_HEADERS entry %q must be a header name such as Content-Type", header)
+		}
+	}
+}
+
+// validateMail checks the mail driver and, when mail is sent, the sender and
+// the links mails carry
+func (c *Config) validateMail(errs *ValidationErrors) {
+	switch c.Mail.Driver {
+	case "", "none", "log":
+		return
+	case "smtp":
+		if c.Mail.SMTPHost == "" {
+			errs.add("MAIL_SMTP_HOST", "MAIL_SMTP_HOST is required when MAIL_DRIVER is smtp")
+		}
+		if c.Mail.SMTPPort < 1 || c.Mail.SMTPPort > 65535 {
+			errs.add("MAIL_SMTP_PORT", "MAIL_SMTP_PORT must be a port number between 1 and 65535, such as 587")
+		}
+		if (c.Mail.SMTPUsername == "") != (c.Mail.SMTPPassword == "") {
+			errs.add("MAIL_SMTP_USERNAME", "MAIL_SMTP_USERNAME and MAIL_SMTP_PASSWORD must be set together")
+		}
+	case "sendgrid":
+		if c.Mail.APIKey == "" {
+			errs.add("MAIL_API_KEY", "MAIL_API_KEY is required when MAIL_DRIVER is sendgrid")
+		}
+	case "ses":
+		if c.Mail.SESRegion == "" || c.Mail.SESAccessKey == "" || c.Mail.SESSecretKey == "" {
+			errs.add("MAIL_SES_REGION", "MAIL_SES_REGION, MAIL_SES_ACCESS_KEY_ID and MAIL_SES_SECRET_ACCESS_KEY are required when MAIL_DRIVER is ses")
+		}
+	default:
+		errs.add("MAIL_DRIVER", "MAIL_DRIVER must be one of: none, log, smtp, sendgrid, ses")
+		return
+	}
+
+	if c.Mail.From == "" {
+		errs.add("MAIL_FROM", "MAIL_FROM is required when MAIL_DRIVER is %s", c.Mail.Driver)
+	} else if addr, err := mail.ParseAddress(c.Mail.From); err != nil || addr.Name != "" {
+		errs.add("MAIL_FROM", "MAIL_FROM must be an email address such as no-reply@example.com; set the name with MAIL_FROM_NAME")
+	}
+	if !isHTTPURL(c.Mail.BaseURL) {
+		errs.add("MAIL_BASE_URL", "MAIL_BASE_URL must be an http or https URL, the address links in mails point to")
+	}
+	if c.Mail.Timeout <= 0 {
+		errs.add("MAIL_TIMEOUT", "MAIL_TIMEOUT must be positive")
+	}
+}
+
+// validateContent checks the post, comment, avatar and search settings
+func (c *Config) validateContent(errs *ValidationErrors) {
+	if c.Avatar.Style != "initials" && c.Avatar.Style != "identicon" {
+		errs.add("AVATAR_STYLE", "AVATAR_STYLE must be initials or identicon")
+	}
+	if len(c.Avatar.UploadSizes) == 0 || c.Avatar.Prefix == "" || strings.HasPrefix(c.Avatar.Prefix, "/") || strings.Contains(c.Avatar.Prefix, "..") {
+		errs.add("AVATAR_PREFIX", "AVATAR_UPLOAD_SIZES must not be empty and AVATAR_PREFIX must be a relative path")
+	}
+	if c.Backfill.BatchSize < 1 || c.Backfill.BatchSize > 10000 || c.Backfill.BatchDelay < 0 {
+		errs.add("BACKFILL_BATCH_SIZE", "BACKFILL_BATCH_SIZE must be between 1 and 10000 and BACKFILL_BATCH_DELAY must not be negative")
+	}
+	if c.Admin.InviteTTL <= 0 {
+		errs.add("ADMIN_INVITE_TTL", "ADMIN_INVITE_TTL must be positive")
+	}
+	if c.Posts.MaxProfilePins < 0 {
+		errs.add("POST_MAX_PROFILE_PINS", "POST_MAX_PROFILE_PINS must not be negative")
+	}
+	if c.Posts.DefaultLicense != "" && !c.Posts.DefaultLicense.IsStandard() {
+		errs.add("POST_DEFAULT_LICENSE", "POST_DEFAULT_LICENSE must be empty or a Creative Commons license such as cc-by-4.0")
+	}
+	if c.Comments.MaxLength < 1 {
+		errs.add("COMMENTS_MAX_LENGTH", "COMMENTS_MAX_LENGTH must be positive")
+	}
+	if c.Spam.Driver == "akismet" && (c.Spam.APIKey == "" || c.Spam.SiteURL == "") {
+		errs.add("SPAM_API_KEY", "SPAM_API_KEY and SPAM_SITE_URL are required when SPAM_DRIVER is akismet")
+	}
+	if c.ImageProxy.Enabled && (len(c.ImageProxy.Widths) == 0 || c.ImageProxy.MaxBytes < 1 || c.ImageProxy.MaxPixels < 1 || c.ImageProxy.Timeout <= 0) {
+		errs.add("IMAGE_PROXY_WIDTHS", "IMAGE_PROXY_WIDTHS, IMAGE_PROXY_MAX_BYTES, IMAGE_PROXY_MAX_PIXELS and IMAGE_PROXY_TIMEOUT must be set when IMAGE_PROXY_ENABLED is true")
+	}
+	if c.Search.Driver != "" && c.Search.Driver != "database" {
+		if c.Search.URL == "" {
+			errs.add("SEARCH_URL", "SEARCH_URL is required when SEARCH_DRIVER is %s", c.Search.Driver)
+		} else if !isHTTPURL(c.Search.URL) {
+			errs.add("SEARCH_URL", "SEARCH_URL must be an http or https URL")
+		}
+	}
+}
+
+// validateStorage checks the file storage and media settings
+func (c *Config) validateStorage(errs *ValidationErrors) {
+	switch c.Storage.Driver {
+	case "local":
+		if c.Storage.Path == "" {
+			errs.add("STORAGE_PATH", "STORAGE_PATH is required when STORAGE_DRIVER is local")
+		}
+	case "s3":
+		if c.Storage.S3Bucket == "" || c.Storage.S3Region == "" || c.Storage.S3AccessKey == "" || c.Storage.S3SecretKey == "" {
+			errs.add("STORAGE_S3_BUCKET", "STORAGE_S3_BUCKET, STORAGE_S3_REGION, STORAGE_S3_ACCESS_KEY_ID and STORAGE_S3_SECRET_ACCESS_KEY are required when STORAGE_DRIVER is s3")
+		}
+	default:
+		errs.add("STORAGE_DRIVER", "STORAGE_DRIVER must be one of: local, s3")
+	}
+	if c.Media.MaxSize < 1 || len(c.Media.AllowedTypes) == 0 || c.Media.URLTTL <= 0 {
+		errs.add("MEDIA_MAX_SIZE", "MEDIA_MAX_SIZE and MEDIA_URL_TTL must be positive and MEDIA_ALLOWED_TYPES must not be empty")
+	}
+	if !isHTTPURL(c.Media.BaseURL) {
+		errs.add("MEDIA_BASE_URL", "MEDIA_BASE_URL must be an http or https URL")
+	}
+	if c.Media.MaxPixels < 1 || c.Media.ThumbnailSize < 1 || c.Media.MediumWidth < 1 || c.Media.LargeWidth < 1 {
+		errs.add("MEDIA_MAX_PIXELS", "MEDIA_MAX_PIXELS, MEDIA_THUMBNAIL_SIZE, MEDIA_MEDIUM_WIDTH and MEDIA_LARGE_WIDTH must be positive")
+	}
+	for _, contentType := range c.Media.AllowedTypes {
+		if strings.HasPrefix(contentType, "image/") && contentType != "image/jpeg" && contentType != "image/png" && contentType != "image/gif" {
+			errs.add("MEDIA_ALLOWED_TYPES", "MEDIA_ALLOWED_TYPES may only allow the image types image/jpeg, image/png and image/gif, got %s", contentType)
+		}
+	}
+	if c.Media.ChunkSize < 1 || c.Media.UploadTTL <= 0 {
+		errs.add("MEDIA_CHUNK_SIZE", "MEDIA_CHUNK_SIZE and MEDIA_UPLOAD_TTL must be positive")
+	}
+	if c.Media.Prefix == "" || strings.HasPrefix(c.Media.Prefix, "/") || strings.Contains(c.Media.Prefix, "..") {
+		errs.add("MEDIA_PREFIX", "MEDIA_PREFIX must be a relative path")
+	}
+	switch c.Malware.Driver {
+	case "", "none":
+	case "clamav":
+		if c.Malware.ClamAVAddress == "" {
+			errs.add("MALWARE_CLAMAV_ADDRESS", "MALWARE_CLAMAV_ADDRESS is required when MALWARE_DRIVER is clamav")
+		}
+	default:
+		errs.add("MALWARE_DRIVER", "MALWARE_DRIVER must be one of: none, clamav")
+	}
+	if c.StaticSite.PageSize < 1 || strings.HasPrefix(c.StaticSite.Prefix, "/") || strings.Contains(c.StaticSite.Prefix, "..") {
+		errs.add("STATIC_SITE_PAGE_SIZE", "STATIC_SITE_PAGE_SIZE must be positive and STATIC_SITE_PREFIX must be a relative path")
+	}
+}
+
+// validateJobs checks the background job and webhook delivery settings
+func (c *Config) validateJobs(errs *ValidationErrors) {
+	if c.Webhooks.Timeout <= 0 || c.Webhooks.MaxAttempts < 1 || c.Webhooks.RetryDelay <= 0 || c.Webhooks.Retention <= 0 {
+		errs.add("WEBHOOK_TIMEOUT", "WEBHOOK_TIMEOUT, WEBHOOK_RETRY_DELAY and WEBHOOK_RETENTION must be positive and WEBHOOK_MAX_ATTEMPTS at least 1")
+	}
+	if c.Jobs.Workers < 0 || c.Jobs.MaxAttempts < 1 {
+		errs.add("JOBS_WORKERS", "JOBS_WORKERS must not be negative and JOBS_MAX_ATTEMPTS must be at least 1")
+	}
+	if c.Jobs.PollInterval <= 0 || c.Jobs.RetryDelay <= 0 || c.Jobs.Timeout <= 0 || c.Jobs.Retention <= 0 || c.Jobs.ViewRollupInterval <= 0 {
+		errs.add("JOBS_POLL_INTERVAL", "JOBS_POLL_INTERVAL, JOBS_RETRY_DELAY, JOBS_TIMEOUT, JOBS_RETENTION and JOBS_VIEW_ROLLUP_INTERVAL must be positive")
+	}
+}
+
+// validateLog checks the log outputs and files
+func (c *Config) validateLog(errs *ValidationErrors) {
+	if c.Log.Bodies && c.Log.BodyMaxBytes < 1 {
+		errs.add("LOG_BODY_MAX_BYTES", "LOG_BODY_MAX_BYTES must be positive")
+	}
+	errs.addErr("LOG_OUTPUTS", validateLogOutputs("LOG_OUTPUTS", c.Log.Outputs, "LOG_FILE", c.Log.File))
+	errs.addErr("LOG_ACCESS_OUTPUTS", validateLogOutputs("LOG_ACCESS_OUTPUTS", c.Log.AccessOutputs, "LOG_ACCESS_FILE", c.Log.AccessFile))
+	if c.Log.FileMaxSizeMB < 1 || c.Log.FileMaxBackups < 0 || c.Log.FileMaxAgeDays < 0 || c.Log.FileRotateInterval < 0 {
+		errs.add("LOG_FILE_MAX_SIZE_MB", "LOG_FILE_MAX_SIZE_MB must be positive and LOG_FILE_MAX_BACKUPS, LOG_FILE_MAX_AGE_DAYS and LOG_FILE_ROTATE_INTERVAL must not be negative")
+	}
+	if writesLogFile(c.Log.Outputs) && writesLogFile(c.Log.AccessOutputs) && c.Log.File == c.Log.AccessFile {
+		errs.add("LOG_ACCESS_FILE", "LOG_FILE and LOG_ACCESS_FILE must differ")
+	}
+}
+
+// validateLogOutputs checks a list of log outputs, and that the file they
+// write to is set when it includes "file"
+func validateLogOutputs(key string, outputs []string, fileKey, file string) error {
+	for _, output := range outputs {
+		switch output {
+		case "stdout":
+		case "file":
+			if file == "" {
+				return fmt.Errorf("%s is required when %s includes file", fileKey, key)
+			}
+		default:
+			return fmt.Errorf("%s must list stdout or file, got %q", key, output)
+		}
+	}
+	return nil
+}
+
+// writesLogFile reports whether a list of log outputs includes "file"
+func writesLogFile(outputs []string) bool {
+	for _, output := range outputs {
+		if output == "file" {
+			return true
+		}
+	}
+	return false
+}
+
+// validPort reports whether a value is a TCP port number
+func validPort(value string) bool {
+	port, err := strconv.Atoi(value)
+	return err == nil && port >= 1 && port <= 65535
+}
+
+// isHTTPURL reports whether a value is an absolute http or https URL
+func isHTTPURL(value string) bool {
+	u, err := url.Parse(value)
+	return err == nil && (u.Scheme == "http" || u.Scheme == "https") && u.Host != ""
+}
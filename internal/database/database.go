@@ -1,6 +1,8 @@
 package database
 
 import (
+	"context"
+	"database/sql"
 	"fmt"
 	"time"
 
@@ -10,24 +12,47 @@ import (
 	"gorm.io/driver/sqlite"
 	"gorm.io/gorm"
 	gormlogger "gorm.io/gorm/logger"
+	"gorm.io/plugin/dbresolver"
+)
+
+// Pagination limits shared by all list endpoints
+const (
+	DefaultPageSize = 10
+	MaxPageSize     = 100
 )
 
 // Database holds the database connection
 type Database struct {
 	DB *gorm.DB
+	// resolver routes reads to the read replicas, if any
+	resolver *dbresolver.DBResolver
+}
+
+// PoolStats describes the connections of a connection pool
+type PoolStats struct {
+	MaxOpen           int   `json:"max_open"`
+	Open              int   `json:"open"`
+	InUse             int   `json:"in_use"`
+	Idle              int   `json:"idle"`
+	WaitCount         int64 `json:"wait_count"`
+	WaitDurationMs    int64 `json:"wait_duration_ms"`
+	MaxIdleClosed     int64 `json:"max_idle_closed"`
+	MaxIdleTimeClosed int64 `json:"max_idle_time_closed"`
+	MaxLifetimeClosed int64 `json:"max_lifetime_closed"`
 }
 
 // New creates a new database connection
 func New(cfg *config.Config) (*Database, error) {
-	var dialector gorm.Dialector
+	// A password fetched again from the secrets provider applies to new
+	// connections
+	var password func() string
+	if cfg.RefreshesDBPassword() {
+		password = cfg.DBPassword
+	}
 
-	switch cfg.Database.Driver {
-	case "postgres":
-		dialector = postgres.Open(cfg.GetDSN())
-	case "sqlite":
-		dialector = sqlite.Open(cfg.GetDSN())
-	default:
-		return nil, fmt.Errorf("unsupported database driver: %s", cfg.Database.Driver)
+	dialector, err := openDialector(cfg.Database.Driver, cfg.GetDSN(), password)
+	if err != nil {
+		return nil, err
 	}
 
 	// Configure GORM logger
@@ -41,12 +66,17 @@ func New(cfg *config.Config) (*Database, error) {
 		NowFunc: func() time.Time {
 			return time.Now().UTC()
 		},
+		// Translate driver errors (e.g. unique violations) into gorm errors
+		TranslateError: true,
 	}
 
 	db, err := gorm.Open(dialector, gormConfig)
 	if err != nil {
 		return nil, fmt.Errorf("failed to connect to database: %w", err)
 	}
+	if err := translateErrors(db); err != nil {
+		return nil, fmt.Errorf("failed to register error translation: %w", err)
+	}
 
 	// Get underlying SQL DB to configure connection pool
 	sqlDB, err := db.DB()
@@ -55,15 +85,51 @@ func New(cfg *config.Config) (*Database, error) {
 	}
 
 	// Configure connection pool
-	sqlDB.SetMaxIdleConns(10)
-	sqlDB.SetMaxOpenConns(100)
-	sqlDB.SetConnMaxLifetime(time.Hour)
+	sqlDB.SetMaxIdleConns(cfg.Database.MaxIdleConns)
+	sqlDB.SetMaxOpenConns(cfg.Database.MaxOpenConns)
+	sqlDB.SetConnMaxLifetime(cfg.Database.ConnMaxLifetime)
+	sqlDB.SetConnMaxIdleTime(cfg.Database.ConnMaxIdleTime)
+
+	var resolver *dbresolver.DBResolver
+	replicas := cfg.GetReplicaDSNs()
+	if len(replicas) > 0 {
+		if resolver, err = useReplicas(db, &cfg.Database, replicas, password); err != nil {
+			return nil, fmt.Errorf("failed to connect to read replicas: %w", err)
+		}
+	}
 
 	logger.Info("Database connection established",
 		logger.String("driver", cfg.Database.Driver),
+		logger.Int("replicas", len(replicas)),
 	)
 
-	return &Database{DB: db}, nil
+	return &Database{DB: db, resolver: resolver}, nil
+}
+
+// openDialector returns the dialector of a driver. Unless password is nil,
+// connections log in with the password it returns instead of the one of dsn.
+func openDialector(driver, dsn string, password func() string) (gorm.Dialector, error) {
+	var conn *sql.DB
+	if password != nil && driver != DriverSQLite {
+		var err error
+		if conn, err = openRotatingPool(driver, dsn, password); err != nil {
+			return nil, err
+		}
+	}
+
+	switch driver {
+	case DriverPostgres:
+		if conn != nil {
+			return postgres.New(postgres.Config{DSN: dsn, Conn: conn}), nil
+		}
+		return postgres.Open(dsn), nil
+	case DriverMySQL:
+		return newMySQLDialector(dsn, conn), nil
+	case DriverSQLite:
+		return sqlite.Open(dsn), nil
+	default:
+		return nil, fmt.Errorf("unsupported database driver: %s", driver)
+	}
 }
 
 // Close closes the database connection
@@ -76,12 +142,48 @@ func (d *Database) Close() error {
 }
 
 // HealthCheck checks if the database connection is alive
-func (d *Database) HealthCheck() error {
+func (d *Database) HealthCheck(ctx context.Context) error {
 	sqlDB, err := d.DB.DB()
 	if err != nil {
 		return err
 	}
-	return sqlDB.Ping()
+	return sqlDB.PingContext(ctx)
+}
+
+// PoolStats returns the statistics of the primary's connection pool and of
+// each replica's
+func (d *Database) PoolStats() (PoolStats, []PoolStats, error) {
+	sqlDB, err := d.DB.DB()
+	if err != nil {
+		return PoolStats{}, nil, err
+	}
+
+	replicas := []PoolStats{}
+	if d.resolver != nil {
+		// The resolver walks the primary too
+		d.resolver.Call(func(pool gorm.ConnPool) error {
+			if replica, ok := pool.(*sql.DB); ok && replica != sqlDB {
+				replicas = append(replicas, newPoolStats(replica.Stats()))
+			}
+			return nil
+		})
+	}
+	return newPoolStats(sqlDB.Stats()), replicas, nil
+}
+
+// newPoolStats converts the statistics of a database/sql pool
+func newPoolStats(s sql.DBStats) PoolStats {
+	return PoolStats{
+		MaxOpen:           s.MaxOpenConnections,
+		Open:              s.OpenConnections,
+		InUse:             s.InUse,
+		Idle:              s.Idle,
+		WaitCount:         s.WaitCount,
+		WaitDurationMs:    s.WaitDuration.Milliseconds(),
+		MaxIdleClosed:     s.MaxIdleClosed,
+		MaxIdleTimeClosed: s.MaxIdleTimeClosed,
+		MaxLifetimeClosed: s.MaxLifetimeClosed,
+	}
 }
 
 // Migrate runs database migrations
@@ -101,10 +203,10 @@ func Paginate(page, pageSize int) func(db *gorm.DB) *gorm.DB {
 			page = 1
 		}
 		if pageSize <= 0 {
-			pageSize = 10
+			pageSize = DefaultPageSize
 		}
-		if pageSize > 100 {
-			pageSize = 100
+		if pageSize > MaxPageSize {
+			pageSize = MaxPageSize
 		}
 
 		offset := (page - 1) * pageSize
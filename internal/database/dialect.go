@@ -0,0 +1,75 @@
+package database
+
+import (
+	"database/sql"
+
+	"gorm.io/driver/mysql"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+	"gorm.io/gorm/migrator"
+	"gorm.io/gorm/schema"
+)
+
+// Supported database drivers, as named by DB_DRIVER
+const (
+	DriverPostgres = "postgres"
+	DriverMySQL    = "mysql"
+	DriverSQLite   = "sqlite"
+)
+
+// Now returns an expression for the current UTC time in the dialect of db,
+// for updates that must use the database's clock rather than the
+// application's
+//
+// Usage:
+//
+//	db.Model(&user).Update("email_verified_at", Now(db))
+func Now(db *gorm.DB) clause.Expr {
+	switch db.Dialector.Name() {
+	case DriverMySQL:
+		// Times are stored without a zone and read back as UTC
+		return gorm.Expr("UTC_TIMESTAMP(3)")
+	case DriverSQLite:
+		// In the text format go-sqlite3 reads back as UTC
+		return gorm.Expr("strftime('%Y-%m-%d %H:%M:%f', 'now')")
+	default:
+		return gorm.Expr("NOW()")
+	}
+}
+
+// mysqlDialector adapts the MySQL dialector to the models, whose IDs are
+// declared with the PostgreSQL uuid type. MySQL has no such type, so UUIDs
+// are stored as strings in char(36) columns, which MariaDB reads as well.
+type mysqlDialector struct {
+	*mysql.Dialector
+}
+
+// newMySQLDialector creates a MySQL dialector for dsn, using conn as its
+// connection pool unless it is nil
+func newMySQLDialector(dsn string, conn *sql.DB) gorm.Dialector {
+	if conn != nil {
+		return mysqlDialector{Dialector: mysql.New(mysql.Config{DSN: dsn, Conn: conn}).(*mysql.Dialector)}
+	}
+	return mysqlDialector{Dialector: mysql.Open(dsn).(*mysql.Dialector)}
+}
+
+// DataTypeOf maps the uuid type to char(36)
+func (d mysqlDialector) DataTypeOf(field *schema.Field) string {
+	if field.DataType == "uuid" {
+		return "char(36)"
+	}
+	return d.Dialector.DataTypeOf(field)
+}
+
+// Migrator creates a MySQL migrator that uses the mapped column types
+func (d mysqlDialector) Migrator(db *gorm.DB) gorm.Migrator {
+	return mysql.Migrator{
+		Migrator: migrator.Migrator{
+			Config: migrator.Config{
+				DB:        db,
+				Dialector: d,
+			},
+		},
+		Dialector: *d.Dialector,
+	}
+}
@@ -0,0 +1,90 @@
+package database
+
+import (
+	"errors"
+
+	"github.com/go-sql-driver/mysql"
+	apperrors "github.com/yourusername/go-enterprise-api/pkg/errors"
+	"gorm.io/gorm"
+)
+
+// mysqlErrorCodes maps the MySQL error codes the driver's translator misses
+// to gorm errors
+var mysqlErrorCodes = map[uint16]error{
+	1062: gorm.ErrDuplicatedKey,
+	1586: gorm.ErrDuplicatedKey, // duplicate entry, naming the key
+	1216: gorm.ErrForeignKeyViolated,
+	1217: gorm.ErrForeignKeyViolated,
+	1451: gorm.ErrForeignKeyViolated,
+	1452: gorm.ErrForeignKeyViolated,
+}
+
+// Translate translates MySQL errors into gorm errors, like the PostgreSQL
+// and SQLite dialectors do, including wrapped errors
+func (d mysqlDialector) Translate(err error) error {
+	var mysqlErr *mysql.MySQLError
+	if errors.As(err, &mysqlErr) {
+		if translated, ok := mysqlErrorCodes[mysqlErr.Number]; ok {
+			return translated
+		}
+	}
+	return err
+}
+
+// TranslateError maps the gorm errors every driver's errors are translated
+// into to application errors, keeping err as their cause so errors.Is still
+// matches it:
+//
+//   - gorm.ErrRecordNotFound becomes ErrRecordNotFound (404)
+//   - gorm.ErrDuplicatedKey becomes ErrDuplicateEntry (409)
+//   - gorm.ErrForeignKeyViolated becomes ErrConflict (409)
+//
+// Other errors, including application errors, are returned unchanged.
+func TranslateError(err error) error {
+	var appErr *apperrors.AppError
+	if err == nil || errors.As(err, &appErr) {
+		return err
+	}
+
+	switch {
+	case errors.Is(err, gorm.ErrRecordNotFound):
+		return fromPredefined(apperrors.ErrRecordNotFound, err)
+	case errors.Is(err, gorm.ErrDuplicatedKey):
+		return fromPredefined(apperrors.ErrDuplicateEntry, err).
+			WithDetails("A record with the same unique values already exists")
+	case errors.Is(err, gorm.ErrForeignKeyViolated):
+		return fromPredefined(apperrors.ErrConflict, err).
+			WithDetails("The record references a missing record or is referenced by another record")
+	}
+	return err
+}
+
+// fromPredefined creates an error with the code, status and message of a
+// predefined error, without sharing the details other requests set on it
+func fromPredefined(predefined *apperrors.AppError, err error) *apperrors.AppError {
+	return apperrors.NewAppError(predefined.StatusCode, predefined.Code, predefined.Message).WithError(err)
+}
+
+// translateErrors registers callbacks that apply TranslateError to the
+// result of every statement, so repositories return application errors
+// whatever the driver
+func translateErrors(db *gorm.DB) error {
+	translate := func(tx *gorm.DB) {
+		tx.Error = TranslateError(tx.Error)
+	}
+
+	callbacks := db.Callback()
+	for _, err := range []error{
+		callbacks.Create().After("*").Register("errors:translate", translate),
+		callbacks.Query().After("*").Register("errors:translate", translate),
+		callbacks.Update().After("*").Register("errors:translate", translate),
+		callbacks.Delete().After("*").Register("errors:translate", translate),
+		callbacks.Row().After("*").Register("errors:translate", translate),
+		callbacks.Raw().After("*").Register("errors:translate", translate),
+	} {
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,60 @@
+package database
+
+import (
+	"context"
+	"database/sql"
+	"database/sql/driver"
+	"fmt"
+
+	"github.com/go-sql-driver/mysql"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/stdlib"
+)
+
+// openRotatingPool opens a connection pool for dsn whose new connections
+// log in with the current password, so a password rotated in the secrets
+// provider is picked up without a restart. Open connections are kept until
+// DB_CONN_MAX_LIFETIME ends them.
+func openRotatingPool(driverName, dsn string, password func() string) (*sql.DB, error) {
+	switch driverName {
+	case DriverPostgres:
+		connConfig, err := pgx.ParseConfig(dsn)
+		if err != nil {
+			return nil, err
+		}
+		return stdlib.OpenDB(*connConfig, stdlib.OptionBeforeConnect(func(_ context.Context, cc *pgx.ConnConfig) error {
+			cc.Password = password()
+			return nil
+		})), nil
+	case DriverMySQL:
+		mysqlConfig, err := mysql.ParseDSN(dsn)
+		if err != nil {
+			return nil, err
+		}
+		return sql.OpenDB(mysqlConnector{config: mysqlConfig, password: password}), nil
+	default:
+		return nil, fmt.Errorf("the %s driver has no password to rotate", driverName)
+	}
+}
+
+// mysqlConnector connects to MySQL with the current password
+type mysqlConnector struct {
+	config   *mysql.Config
+	password func() string
+}
+
+// Connect opens a connection
+func (c mysqlConnector) Connect(ctx context.Context) (driver.Conn, error) {
+	config := c.config.Clone()
+	config.Passwd = c.password()
+	connector, err := mysql.NewConnector(config)
+	if err != nil {
+		return nil, err
+	}
+	return connector.Connect(ctx)
+}
+
+// Driver returns the MySQL driver
+func (c mysqlConnector) Driver() driver.Driver {
+	return &mysql.MySQLDriver{}
+}
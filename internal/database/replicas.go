@@ -0,0 +1,113 @@
+package database
+
+import (
+	"context"
+	"strings"
+	"sync/atomic"
+
+	"github.com/yourusername/go-enterprise-api/internal/config"
+	"gorm.io/gorm"
+	"gorm.io/plugin/dbresolver"
+)
+
+type contextKey int
+
+const (
+	primaryKey contextKey = iota
+	writesKey
+)
+
+// writeTracker records whether a write was made with a context
+type writeTracker struct {
+	wrote atomic.Bool
+}
+
+// TrackWrites returns a context that records the writes made with it. Once
+// one was made, reads made with the context use the primary, so a request
+// sees its own writes even when the replicas lag behind.
+func TrackWrites(ctx context.Context) context.Context {
+	return context.WithValue(ctx, writesKey, &writeTracker{})
+}
+
+// ReadFromPrimary returns a context whose reads use the primary instead of
+// the replicas
+func ReadFromPrimary(ctx context.Context) context.Context {
+	return context.WithValue(ctx, primaryKey, true)
+}
+
+// readsFromPrimary reports whether reads made with ctx must use the primary
+func readsFromPrimary(ctx context.Context) bool {
+	if primary, _ := ctx.Value(primaryKey).(bool); primary {
+		return true
+	}
+	tracker, _ := ctx.Value(writesKey).(*writeTracker)
+	return tracker != nil && tracker.wrote.Load()
+}
+
+// recordWrite records a write made with ctx
+func recordWrite(ctx context.Context) {
+	if tracker, _ := ctx.Value(writesKey).(*writeTracker); tracker != nil {
+		tracker.wrote.Store(true)
+	}
+}
+
+// useReplicas routes reads outside transactions to the replicas and writes
+// to the primary. Reads made with a context asking for the primary, or after
+// a write made with a tracked context, stay on the primary. It returns the
+// resolver. Unless password is nil, replica connections log in with the
+// password it returns.
+func useReplicas(db *gorm.DB, cfg *config.DatabaseConfig, dsns []string, password func() string) (*dbresolver.DBResolver, error) {
+	replicas := make([]gorm.Dialector, len(dsns))
+	for i, dsn := range dsns {
+		dialector, err := openDialector(cfg.Driver, dsn, password)
+		if err != nil {
+			return nil, err
+		}
+		replicas[i] = dialector
+	}
+
+	resolver := dbresolver.Register(dbresolver.Config{
+		Replicas: replicas,
+		Policy:   dbresolver.RandomPolicy{},
+	})
+	resolver.SetMaxIdleConns(cfg.MaxIdleConns).
+		SetMaxOpenConns(cfg.MaxOpenConns).
+		SetConnMaxLifetime(cfg.ConnMaxLifetime).
+		SetConnMaxIdleTime(cfg.ConnMaxIdleTime)
+	if err := db.Use(resolver); err != nil {
+		return nil, err
+	}
+
+	readPrimary := func(tx *gorm.DB) {
+		if readsFromPrimary(tx.Statement.Context) {
+			dbresolver.Write.ModifyStatement(tx.Statement)
+		}
+	}
+	record := func(tx *gorm.DB) {
+		if tx.Error == nil {
+			recordWrite(tx.Statement.Context)
+		}
+	}
+	recordRaw := func(tx *gorm.DB) {
+		sql := strings.TrimSpace(tx.Statement.SQL.String())
+		if tx.Error == nil && !(len(sql) >= 6 && strings.EqualFold(sql[:6], "SELECT")) {
+			recordWrite(tx.Statement.Context)
+		}
+	}
+
+	callbacks := db.Callback()
+	for _, err := range []error{
+		callbacks.Query().Before("*").Register("replicas:read_primary", readPrimary),
+		callbacks.Row().Before("*").Register("replicas:read_primary", readPrimary),
+		callbacks.Raw().Before("*").Register("replicas:read_primary", readPrimary),
+		callbacks.Create().After("*").Register("replicas:record_write", record),
+		callbacks.Update().After("*").Register("replicas:record_write", record),
+		callbacks.Delete().After("*").Register("replicas:record_write", record),
+		callbacks.Raw().After("*").Register("replicas:record_write", recordRaw),
+	} {
+		if err != nil {
+			return nil, err
+		}
+	}
+	return resolver, nil
+}
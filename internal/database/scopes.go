@@ -48,6 +48,29 @@ func OrderBy(field string, direction string) func(db *gorm.DB) *gorm.DB {
 	}
 }
 
+// Sort creates a scope for ordering results by a sort parameter such as
+// "created_at" or "-created_at", descending when prefixed with "-". Fields
+// outside allowed are ignored, so the parameter can come from a request.
+// Ties are broken by id to keep pages stable.
+//
+// Usage:
+//
+//	db.Scopes(Sort("-created_at", []string{"created_at", "email"})).Find(&users)
+func Sort(sort string, allowed []string) func(db *gorm.DB) *gorm.DB {
+	return func(db *gorm.DB) *gorm.DB {
+		field, direction := strings.TrimPrefix(sort, "-"), "asc"
+		if strings.HasPrefix(sort, "-") {
+			direction = "desc"
+		}
+		for _, a := range allowed {
+			if a == field {
+				return db.Scopes(OrderBy(field, direction), OrderBy("id", "asc"))
+			}
+		}
+		return db
+	}
+}
+
 // Status filters by status field
 //
 // Usage:
@@ -62,6 +85,39 @@ func Status(status string) func(db *gorm.DB) *gorm.DB {
 	}
 }
 
+// Role filters by role field
+//
+// Usage:
+//
+//	db.Scopes(Role("admin")).Find(&users)
+func Role(role string) func(db *gorm.DB) *gorm.DB {
+	return func(db *gorm.DB) *gorm.DB {
+		if role == "" {
+			return db
+		}
+		return db.Where("role = ?", role)
+	}
+}
+
+// IsSet filters by whether a nullable field has a value. A nil set does not
+// filter.
+//
+// Usage:
+//
+//	verified := true
+//	db.Scopes(IsSet("email_verified_at", &verified)).Find(&users)
+func IsSet(field string, set *bool) func(db *gorm.DB) *gorm.DB {
+	return func(db *gorm.DB) *gorm.DB {
+		if set == nil {
+			return db
+		}
+		if *set {
+			return db.Where(field + " IS NOT NULL")
+		}
+		return db.Where(field + " IS NULL")
+	}
+}
+
 // DateRange filters by date range
 //
 // Usage:
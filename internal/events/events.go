@@ -0,0 +1,184 @@
+// Package events is an in-process publish/subscribe bus for domain events.
+// Services publish what happened; consumers such as email, webhooks, the
+// audit log and search indexing subscribe to it, so side effects stay out of
+// the request path.
+package events
+
+import (
+	"context"
+	"fmt"
+	"sync"
+
+	"github.com/yourusername/go-enterprise-api/pkg/logger"
+)
+
+// queueSize is the number of events buffered for each subscription. Once a
+// subscription's queue is full, publishing waits for its consumer to catch up.
+const queueSize = 256
+
+// Event is a domain event
+type Event interface {
+	// Name identifies the kind of event, e.g. "user.registered"
+	Name() string
+}
+
+// Handler handles an event. Errors are logged; they are not returned to the
+// publisher.
+type Handler func(ctx context.Context, event Event) error
+
+// envelope is a published event along with the context it was published in
+type envelope struct {
+	ctx   context.Context
+	event Event
+}
+
+// subscription delivers events to one handler, in the order they were
+// published
+type subscription struct {
+	consumer string
+	handler  Handler
+	queue    chan envelope
+}
+
+// Bus dispatches published events to their subscribers. Every subscription
+// has its own queue and goroutine, so a slow consumer never holds up the
+// others or the publisher. A nil *Bus is valid and discards events.
+type Bus struct {
+	mu       sync.RWMutex
+	byName   map[string][]*subscription
+	all      []*subscription
+	closed   bool
+	inflight sync.WaitGroup
+}
+
+// New creates an event bus
+func New() *Bus {
+	return &Bus{byName: make(map[string][]*subscription)}
+}
+
+// Subscribe registers handler for events with the given name. consumer names
+// the subscriber in logs.
+func (b *Bus) Subscribe(name, consumer string, handler Handler) {
+	sub := b.start(consumer, handler)
+
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.byName[name] = append(b.byName[name], sub)
+}
+
+// SubscribeAll registers handler for every event
+func (b *Bus) SubscribeAll(consumer string, handler Handler) {
+	sub := b.start(consumer, handler)
+
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.all = append(b.all, sub)
+}
+
+// Subscribe registers a handler for events of type E
+func Subscribe[E Event](b *Bus, consumer string, handler func(ctx context.Context, event E) error) {
+	var zero E
+	b.Subscribe(zero.Name(), consumer, func(ctx context.Context, event Event) error {
+		typed, ok := event.(E)
+		if !ok {
+			return fmt.Errorf("unexpected event type %T", event)
+		}
+		return handler(ctx, typed)
+	})
+}
+
+// Publish hands an event to its subscribers and returns without waiting for
+// them. Handlers receive a context carrying the values of ctx but not its
+// cancellation, so they outlive the request that published the event.
+func (b *Bus) Publish(ctx context.Context, event Event) {
+	if b == nil {
+		return
+	}
+
+	b.mu.RLock()
+	defer b.mu.RUnlock()
+
+	if b.closed {
+		logger.Warn("Event published after the event bus was closed", logger.String("event", event.Name()))
+		return
+	}
+
+	env := envelope{ctx: context.WithoutCancel(ctx), event: event}
+	for _, sub := range b.byName[event.Name()] {
+		sub.queue <- env
+	}
+	for _, sub := range b.all {
+		sub.queue <- env
+	}
+}
+
+// Close stops accepting events and waits until every queued event has been
+// handled or ctx is done
+func (b *Bus) Close(ctx context.Context) error {
+	b.mu.Lock()
+	if !b.closed {
+		b.closed = true
+		for _, subs := range b.byName {
+			for _, sub := range subs {
+				close(sub.queue)
+			}
+		}
+		for _, sub := range b.all {
+			close(sub.queue)
+		}
+	}
+	b.mu.Unlock()
+
+	done := make(chan struct{})
+	go func() {
+		b.inflight.Wait()
+		close(done)
+	}()
+
+	select {
+	case <-done:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+// start creates a subscription and starts delivering its events
+func (b *Bus) start(consumer string, handler Handler) *subscription {
+	sub := &subscription{
+		consumer: consumer,
+		handler:  handler,
+		queue:    make(chan envelope, queueSize),
+	}
+
+	b.inflight.Add(1)
+	go func() {
+		defer b.inflight.Done()
+		for env := range sub.queue {
+			sub.handle(env)
+		}
+	}()
+	return sub
+}
+
+// handle runs the handler for one event. A failing or panicking handler is
+// logged and does not stop the subscription.
+func (s *subscription) handle(env envelope) {
+	defer func() {
+		if r := recover(); r != nil {
+			logger.Error("Event handler panicked",
+				logger.String("event", env.event.Name()),
+				logger.String("consumer", s.consumer),
+				logger.Any("panic", r),
+			)
+		}
+	}()
+
+	if err := s.handler(env.ctx, env.event); err != nil {
+		logger.Error("Event handler failed",
+			logger.String("event", env.event.Name()),
+			logger.String("consumer", s.consumer),
+			logger.Err(err),
+		)
+	}
+}
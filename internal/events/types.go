@@ -0,0 +1,83 @@
+package events
+
+import "github.com/yourusername/go-enterprise-api/internal/models"
+
+// Names of the domain events
+const (
+	NameUserRegistered      = "user.registered"
+	NamePasswordChanged     = "user.password_changed"
+	NamePostSaved           = "post.saved"
+	NamePostPublished       = "post.published"
+	NamePostDeleted         = "post.deleted"
+	NameCommentCreated      = "comment.created"
+	NameNotificationCreated = "notification.created"
+	NameMediaQuarantined    = "media.quarantined"
+)
+
+// UserRegistered is published when a user signs up
+type UserRegistered struct {
+	User *models.User
+}
+
+// Name implements Event
+func (UserRegistered) Name() string { return NameUserRegistered }
+
+// PasswordChanged is published when a user changes their password
+type PasswordChanged struct {
+	User *models.User
+}
+
+// Name implements Event
+func (PasswordChanged) Name() string { return NamePasswordChanged }
+
+// PostSaved is published whenever a post is created or updated, whatever its
+// status
+type PostSaved struct {
+	Post *models.Post
+}
+
+// Name implements Event
+func (PostSaved) Name() string { return NamePostSaved }
+
+// PostPublished is published when a post is created as published or an
+// existing post becomes published. It follows the post's PostSaved event.
+type PostPublished struct {
+	Post *models.Post
+}
+
+// Name implements Event
+func (PostPublished) Name() string { return NamePostPublished }
+
+// PostDeleted is published when a post is deleted
+type PostDeleted struct {
+	Post *models.Post
+}
+
+// Name implements Event
+func (PostDeleted) Name() string { return NamePostDeleted }
+
+// CommentCreated is published when a comment on a post becomes visible
+type CommentCreated struct {
+	Comment *models.Comment
+	Post    *models.Post
+}
+
+// Name implements Event
+func (CommentCreated) Name() string { return NameCommentCreated }
+
+// NotificationCreated is published when a notification is recorded for a user
+type NotificationCreated struct {
+	Notification *models.Notification
+}
+
+// Name implements Event
+func (NotificationCreated) Name() string { return NameNotificationCreated }
+
+// MediaQuarantined is published when an upload is quarantined because the
+// malware scan found malware in it or failed
+type MediaQuarantined struct {
+	Media *models.Media
+}
+
+// Name implements Event
+func (MediaQuarantined) Name() string { return NameMediaQuarantined }
@@ -0,0 +1,111 @@
+package geoip
+
+import (
+	"bytes"
+	"encoding/csv"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"os"
+	"sort"
+	"strings"
+)
+
+// ipRange is an inclusive range of IPv6 (or IPv4-mapped) addresses
+type ipRange struct {
+	start   net.IP
+	end     net.IP
+	country string
+}
+
+// CSVResolver resolves client IPs against a CSV database of
+// "network,country" rows where network is in CIDR notation
+type CSVResolver struct {
+	ranges []ipRange
+}
+
+// NewCSVResolver loads a CSV database from path. Ranges must not overlap.
+func NewCSVResolver(path string) (*CSVResolver, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open geoip database: %w", err)
+	}
+	defer file.Close()
+
+	return ParseCSV(file)
+}
+
+// ParseCSV parses a CSV database of "network,country" rows. A header row
+// and blank or '#' comment lines are ignored.
+func ParseCSV(r io.Reader) (*CSVResolver, error) {
+	reader := csv.NewReader(r)
+	reader.Comment = '#'
+	reader.FieldsPerRecord = -1
+
+	var ranges []ipRange
+	for line := 1; ; line++ {
+		record, err := reader.Read()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, fmt.Errorf("geoip database line %d: %w", line, err)
+		}
+		if len(record) < 2 {
+			return nil, fmt.Errorf("geoip database line %d: expected network,country", line)
+		}
+
+		_, network, err := net.ParseCIDR(strings.TrimSpace(record[0]))
+		if err != nil {
+			if line == 1 {
+				continue // header row
+			}
+			return nil, fmt.Errorf("geoip database line %d: %w", line, err)
+		}
+
+		country := Normalize(record[1])
+		if country == "" {
+			continue
+		}
+
+		start := network.IP.To16()
+		end := make(net.IP, len(start))
+		mask := net.IP(network.Mask)
+		if len(mask) == net.IPv4len {
+			mask = append(net.IP{0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff}, mask...)
+		}
+		for i := range start {
+			end[i] = start[i] | ^mask[i]
+		}
+		ranges = append(ranges, ipRange{start: start, end: end, country: country})
+	}
+
+	sort.Slice(ranges, func(i, j int) bool {
+		return bytes.Compare(ranges[i].start, ranges[j].start) < 0
+	})
+
+	return &CSVResolver{ranges: ranges}, nil
+}
+
+// Name returns the driver name
+func (r *CSVResolver) Name() string {
+	return DriverCSV
+}
+
+// Country returns the country of the range containing the client IP
+func (r *CSVResolver) Country(_ *http.Request, clientIP string) string {
+	ip := net.ParseIP(clientIP).To16()
+	if ip == nil {
+		return ""
+	}
+
+	// Find the last range starting at or before ip
+	i := sort.Search(len(r.ranges), func(i int) bool {
+		return bytes.Compare(r.ranges[i].start, ip) > 0
+	}) - 1
+	if i < 0 || bytes.Compare(ip, r.ranges[i].end) > 0 {
+		return ""
+	}
+	return r.ranges[i].country
+}
@@ -0,0 +1,85 @@
+package geoip
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/yourusername/go-enterprise-api/internal/config"
+)
+
+// Supported GeoIP drivers
+const (
+	DriverNone   = "none"
+	DriverHeader = "header"
+	DriverCSV    = "csv"
+)
+
+// Resolver maps a request to an ISO 3166-1 alpha-2 country code
+type Resolver interface {
+	// Country returns the upper-case country code of the request or "" when unknown
+	Country(r *http.Request, clientIP string) string
+	// Name returns the driver name
+	Name() string
+}
+
+// New creates a Resolver for the configured driver.
+// It returns nil when GeoIP resolution is disabled.
+func New(cfg *config.GeoIPConfig) (Resolver, error) {
+	switch cfg.Driver {
+	case "", DriverNone:
+		return nil, nil
+	case DriverHeader:
+		return NewHeaderResolver(cfg.Header), nil
+	case DriverCSV:
+		return NewCSVResolver(cfg.Database)
+	default:
+		return nil, fmt.Errorf("unsupported geoip driver: %s", cfg.Driver)
+	}
+}
+
+// HeaderResolver reads the country code from a header set by a trusted
+// proxy or CDN, such as Cloudflare's CF-IPCountry
+type HeaderResolver struct {
+	header string
+}
+
+// NewHeaderResolver creates a resolver reading the given header
+func NewHeaderResolver(header string) *HeaderResolver {
+	return &HeaderResolver{header: header}
+}
+
+// Name returns the driver name
+func (h *HeaderResolver) Name() string {
+	return DriverHeader
+}
+
+// Country returns the country code found in the header
+func (h *HeaderResolver) Country(r *http.Request, clientIP string) string {
+	return Normalize(r.Header.Get(h.header))
+}
+
+// Normalize upper-cases a country code and returns "" for anything that is
+// not a two-letter code (e.g. Cloudflare's "XX" and "T1" markers)
+func Normalize(code string) string {
+	code = strings.ToUpper(strings.TrimSpace(code))
+	if len(code) != 2 || code == "XX" || code[0] < 'A' || code[0] > 'Z' || code[1] < 'A' || code[1] > 'Z' {
+		return ""
+	}
+	return code
+}
+
+// countryKey is the request context key holding the resolved country
+type countryKey struct{}
+
+// WithCountry returns a copy of ctx carrying the country code
+func WithCountry(ctx context.Context, country string) context.Context {
+	return context.WithValue(ctx, countryKey{}, country)
+}
+
+// CountryFromContext returns the country code carried by ctx or "" when unknown
+func CountryFromContext(ctx context.Context) string {
+	country, _ := ctx.Value(countryKey{}).(string)
+	return country
+}
@@ -0,0 +1,70 @@
+package graph
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"runtime/debug"
+
+	"github.com/99designs/gqlgen/graphql"
+	"github.com/99designs/gqlgen/graphql/handler"
+	"github.com/99designs/gqlgen/graphql/handler/extension"
+	"github.com/99designs/gqlgen/graphql/handler/transport"
+	"github.com/vektah/gqlparser/v2/gqlerror"
+	apperrors "github.com/yourusername/go-enterprise-api/pkg/errors"
+	"github.com/yourusername/go-enterprise-api/pkg/logger"
+	"github.com/yourusername/go-enterprise-api/pkg/requestid"
+)
+
+// NewHandler creates the HTTP handler serving GraphQL queries. Queries
+// whose complexity exceeds complexityLimit are rejected; 0 disables the limit.
+func NewHandler(resolver *Resolver, complexityLimit int) http.Handler {
+	srv := handler.New(NewExecutableSchema(Config{Resolvers: resolver}))
+	srv.AddTransport(transport.GET{})
+	srv.AddTransport(transport.POST{})
+	if complexityLimit > 0 {
+		srv.Use(extension.FixedComplexityLimit(complexityLimit))
+	}
+	srv.SetErrorPresenter(presentError)
+	srv.SetRecoverFunc(recoverPanic)
+
+	return withLoaders(resolver.userService, resolver.postService, srv)
+}
+
+// presentError reports application errors with their message and code, as
+// the REST API does. Other errors, such as invalid arguments, are the
+// client's and are reported as they are. Every error carries the request ID.
+func presentError(ctx context.Context, err error) *gqlerror.Error {
+	gqlErr := graphql.DefaultErrorPresenter(ctx, err)
+	if gqlErr.Extensions == nil {
+		gqlErr.Extensions = map[string]interface{}{}
+	}
+	if id := requestid.FromContext(ctx); id != "" {
+		gqlErr.Extensions["request_id"] = id
+	}
+
+	var appErr *apperrors.AppError
+	if !errors.As(err, &appErr) {
+		return gqlErr
+	}
+
+	gqlErr.Message = appErr.Message
+	gqlErr.Extensions["code"] = appErr.Code
+	if appErr.Details != "" {
+		gqlErr.Extensions["details"] = appErr.Details
+	}
+	if appErr.Data != nil {
+		gqlErr.Extensions["data"] = appErr.Data
+	}
+	return gqlErr
+}
+
+// recoverPanic logs a panic of a resolver and reports it as an internal error
+func recoverPanic(ctx context.Context, err interface{}) error {
+	logger.Error("GraphQL panic recovered",
+		logger.String("error", fmt.Sprintf("%v", err)),
+		logger.String("stack", string(debug.Stack())),
+	)
+	return apperrors.ErrInternal
+}
@@ -0,0 +1,140 @@
+package graph
+
+import (
+	"context"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/yourusername/go-enterprise-api/internal/models"
+	"github.com/yourusername/go-enterprise-api/internal/services"
+)
+
+const (
+	// loaderWait is how long a loader collects keys before fetching them.
+	// Resolvers of the items of a list run concurrently, so their keys
+	// arrive within this window and are fetched in one query.
+	loaderWait = 2 * time.Millisecond
+	// loaderMaxBatch bounds the keys fetched in one query
+	loaderMaxBatch = 100
+)
+
+// loader batches the lookups of values by key made while resolving a
+// request, so resolving a field of every item of a list takes one query
+// instead of one per item. Values are cached for the rest of the request.
+type loader[K comparable, V any] struct {
+	fetch func(ctx context.Context, keys []K) (map[K]V, error)
+
+	mu      sync.Mutex
+	pending *loaderBatch[K, V]
+	cache   map[K]*loaderBatch[K, V]
+}
+
+// loaderBatch is a set of keys fetched together
+type loaderBatch[K comparable, V any] struct {
+	keys    []K
+	once    sync.Once
+	done    chan struct{}
+	results map[K]V
+	err     error
+}
+
+// newLoader creates a loader fetching values with fetch, which leaves out
+// of its result the keys that have no value
+func newLoader[K comparable, V any](fetch func(ctx context.Context, keys []K) (map[K]V, error)) *loader[K, V] {
+	return &loader[K, V]{
+		fetch: fetch,
+		cache: make(map[K]*loaderBatch[K, V]),
+	}
+}
+
+// Load returns the value of key, and false when it has none
+func (l *loader[K, V]) Load(ctx context.Context, key K) (V, bool, error) {
+	l.mu.Lock()
+	batch, ok := l.cache[key]
+	if !ok {
+		batch = l.pending
+		if batch == nil {
+			batch = &loaderBatch[K, V]{done: make(chan struct{})}
+			l.pending = batch
+			time.AfterFunc(loaderWait, func() { l.run(ctx, batch) })
+		}
+		batch.keys = append(batch.keys, key)
+		l.cache[key] = batch
+		if len(batch.keys) >= loaderMaxBatch {
+			go l.run(ctx, batch)
+		}
+	}
+	l.mu.Unlock()
+
+	select {
+	case <-batch.done:
+	case <-ctx.Done():
+		var zero V
+		return zero, false, ctx.Err()
+	}
+	if batch.err != nil {
+		var zero V
+		return zero, false, batch.err
+	}
+	value, found := batch.results[key]
+	return value, found, nil
+}
+
+// run fetches a batch once, whichever of its timer and the batch filling up
+// comes first
+func (l *loader[K, V]) run(ctx context.Context, batch *loaderBatch[K, V]) {
+	batch.once.Do(func() {
+		l.mu.Lock()
+		if l.pending == batch {
+			l.pending = nil
+		}
+		keys := batch.keys
+		l.mu.Unlock()
+
+		batch.results, batch.err = l.fetch(ctx, keys)
+		close(batch.done)
+	})
+}
+
+// Loaders holds the loaders of a request
+type Loaders struct {
+	users *loader[uuid.UUID, *models.User]
+	tags  *loader[uuid.UUID, []models.Tag]
+}
+
+// newLoaders creates the loaders of a request
+func newLoaders(userService services.UserService, postService services.PostService) *Loaders {
+	return &Loaders{
+		users: newLoader(func(ctx context.Context, ids []uuid.UUID) (map[uuid.UUID]*models.User, error) {
+			users, err := userService.GetByIDs(ctx, ids)
+			if err != nil {
+				return nil, err
+			}
+			byID := make(map[uuid.UUID]*models.User, len(users))
+			for i := range users {
+				byID[users[i].ID] = &users[i]
+			}
+			return byID, nil
+		}),
+		tags: newLoader(postService.GetTagsByPostIDs),
+	}
+}
+
+// loadersKey is the request context key holding the request's loaders
+type loadersKey struct{}
+
+// withLoaders gives every request its own loaders, so cached values never
+// outlive the request nor leak between viewers
+func withLoaders(userService services.UserService, postService services.PostService, next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		loaders := newLoaders(userService, postService)
+		next.ServeHTTP(w, r.WithContext(context.WithValue(r.Context(), loadersKey{}, loaders)))
+	})
+}
+
+// loadersFrom returns the loaders of the request ctx belongs to
+func loadersFrom(ctx context.Context) *Loaders {
+	return ctx.Value(loadersKey{}).(*Loaders)
+}
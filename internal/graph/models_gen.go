@@ -0,0 +1,24 @@
+// Code generated by github.com/99designs/gqlgen, DO NOT EDIT.
+
+package graph
+
+import (
+	"github.com/yourusername/go-enterprise-api/internal/models"
+)
+
+type CommentPage struct {
+	Items    []models.Comment `json:"items"`
+	PageInfo *PageInfo        `json:"pageInfo"`
+}
+
+type PageInfo struct {
+	Page       int `json:"page"`
+	PageSize   int `json:"pageSize"`
+	Total      int `json:"total"`
+	TotalPages int `json:"totalPages"`
+}
+
+type PostPage struct {
+	Items    []models.Post `json:"items"`
+	PageInfo *PageInfo     `json:"pageInfo"`
+}
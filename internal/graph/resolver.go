@@ -0,0 +1,103 @@
+package graph
+
+import (
+	"context"
+	"errors"
+	"net/http"
+
+	"github.com/yourusername/go-enterprise-api/internal/models"
+	"github.com/yourusername/go-enterprise-api/internal/repository"
+	"github.com/yourusername/go-enterprise-api/internal/services"
+	apperrors "github.com/yourusername/go-enterprise-api/pkg/errors"
+)
+
+const (
+	// defaultPageSize and maxPageSize bound the page size of lists, as in
+	// the REST endpoints
+	defaultPageSize = 10
+	maxPageSize     = 100
+)
+
+// Resolver resolves GraphQL queries with the services backing the REST API
+type Resolver struct {
+	postService    services.PostService
+	userService    services.UserService
+	commentService services.CommentService
+	tagService     services.TagService
+	adultAge       int
+}
+
+// NewResolver creates a new resolver
+func NewResolver(
+	postService services.PostService,
+	userService services.UserService,
+	commentService services.CommentService,
+	tagService services.TagService,
+	adultAge int,
+) *Resolver {
+	return &Resolver{
+		postService:    postService,
+		userService:    userService,
+		commentService: commentService,
+		tagService:     tagService,
+		adultAge:       adultAge,
+	}
+}
+
+// viewer returns the authenticated user of the request, or nil for
+// anonymous requests
+func viewer(ctx context.Context) *models.User {
+	return repository.ViewerFromContext(ctx)
+}
+
+// includeMature reports whether mature posts are listed for the viewer
+func (r *Resolver) includeMature(ctx context.Context) bool {
+	user := viewer(ctx)
+	return user != nil && (user.IsAdmin() || user.IsVerifiedAdult(r.adultAge))
+}
+
+// normalizePage clamps the page arguments of a list like the services do
+func normalizePage(page, pageSize *int) (int, int) {
+	p, size := 1, defaultPageSize
+	if page != nil && *page > 0 {
+		p = *page
+	}
+	if pageSize != nil && *pageSize > 0 && *pageSize <= maxPageSize {
+		size = *pageSize
+	}
+	return p, size
+}
+
+// newPageInfo describes a page of a list of total items
+func newPageInfo(page, pageSize int, total int64) *PageInfo {
+	totalPages := int(total) / pageSize
+	if int(total)%pageSize > 0 {
+		totalPages++
+	}
+	return &PageInfo{
+		Page:       page,
+		PageSize:   pageSize,
+		Total:      int(total),
+		TotalPages: totalPages,
+	}
+}
+
+// canView reports whether the viewer may see a post: published posts are
+// public, unpublished ones are visible to their author and admins, and
+// shadowed ones to their author, admins and moderators
+func canView(user *models.User, post *models.Post) bool {
+	if post.IsHiddenFrom(user) {
+		return false
+	}
+	if post.IsPublished() {
+		return true
+	}
+	return user != nil && (post.UserID == user.ID || user.IsAdmin())
+}
+
+// isNotFound reports whether err reports a missing record. Missing records
+// resolve to null rather than to an error.
+func isNotFound(err error) bool {
+	var appErr *apperrors.AppError
+	return errors.As(err, &appErr) && appErr.StatusCode == http.StatusNotFound
+}
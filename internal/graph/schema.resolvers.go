@@ -0,0 +1,232 @@
+package graph
+
+// This file will be automatically regenerated based on the schema, any resolver implementations
+// will be copied through when generating and any unknown code will be moved to the end.
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/google/uuid"
+	"github.com/yourusername/go-enterprise-api/internal/models"
+	apperrors "github.com/yourusername/go-enterprise-api/pkg/errors"
+)
+
+// Status is the resolver for the status field.
+func (r *commentResolver) Status(ctx context.Context, obj *models.Comment) (string, error) {
+	// Only staff can tell comments held as spam from pending ones
+	user := viewer(ctx)
+	if obj.Status == models.CommentStatusSpam && (user == nil || !(user.IsAdmin() || user.IsModerator())) {
+		return string(models.CommentStatusPending), nil
+	}
+	return string(obj.Status), nil
+}
+
+// Author is the resolver for the author field.
+func (r *commentResolver) Author(ctx context.Context, obj *models.Comment) (*models.User, error) {
+	if obj.User != nil {
+		return obj.User, nil
+	}
+	user, _, err := loadersFrom(ctx).users.Load(ctx, obj.UserID)
+	return user, err
+}
+
+// ID is the resolver for the id field.
+func (r *licenseResolver) ID(ctx context.Context, obj *models.LicenseResponse) (string, error) {
+	return string(obj.ID), nil
+}
+
+// Status is the resolver for the status field.
+func (r *postResolver) Status(ctx context.Context, obj *models.Post) (string, error) {
+	return string(obj.Status), nil
+}
+
+// License is the resolver for the license field.
+func (r *postResolver) License(ctx context.Context, obj *models.Post) (*models.LicenseResponse, error) {
+	if obj.License == "" {
+		return nil, nil
+	}
+	return obj.License.ToResponse(obj.LicenseName, obj.LicenseURL), nil
+}
+
+// Author is the resolver for the author field.
+func (r *postResolver) Author(ctx context.Context, obj *models.Post) (*models.User, error) {
+	if obj.User != nil {
+		return obj.User, nil
+	}
+	user, _, err := loadersFrom(ctx).users.Load(ctx, obj.UserID)
+	return user, err
+}
+
+// Tags is the resolver for the tags field.
+func (r *postResolver) Tags(ctx context.Context, obj *models.Post) ([]models.Tag, error) {
+	if obj.Tags != nil {
+		return obj.Tags, nil
+	}
+	tags, _, err := loadersFrom(ctx).tags.Load(ctx, obj.ID)
+	if tags == nil {
+		tags = []models.Tag{}
+	}
+	return tags, err
+}
+
+// Comments is the resolver for the comments field.
+func (r *postResolver) Comments(ctx context.Context, obj *models.Post, page *int, pageSize *int) (*CommentPage, error) {
+	p, size := normalizePage(page, pageSize)
+	comments, total, err := r.commentService.GetByPost(ctx, obj.ID, viewer(ctx), p, size)
+	if err != nil {
+		// Only published posts have comments
+		if isNotFound(err) {
+			return &CommentPage{Items: []models.Comment{}, PageInfo: newPageInfo(p, size, 0)}, nil
+		}
+		return nil, err
+	}
+	return &CommentPage{Items: comments, PageInfo: newPageInfo(p, size, total)}, nil
+}
+
+// Me is the resolver for the me field.
+func (r *queryResolver) Me(ctx context.Context) (*models.User, error) {
+	return viewer(ctx), nil
+}
+
+// User is the resolver for the user field.
+func (r *queryResolver) User(ctx context.Context, id uuid.UUID) (*models.User, error) {
+	user, found, err := loadersFrom(ctx).users.Load(ctx, id)
+	if err != nil || !found {
+		return nil, err
+	}
+
+	// Users without a public profile are only visible to themselves and admins
+	if current := viewer(ctx); !user.HasPublicProfile() && (current == nil || (current.ID != user.ID && !current.IsAdmin())) {
+		return nil, nil
+	}
+	return user, nil
+}
+
+// Posts is the resolver for the posts field.
+func (r *queryResolver) Posts(ctx context.Context, tag *string, page *int, pageSize *int) (*PostPage, error) {
+	p, size := normalizePage(page, pageSize)
+	user := viewer(ctx)
+	isAdmin := user != nil && user.IsAdmin()
+
+	var posts []models.Post
+	var total int64
+	var err error
+
+	if tag != nil && *tag != "" {
+		// Aliases resolve to their canonical tag
+		posts, total, err = r.postService.GetByTag(ctx, *tag, !isAdmin, isAdmin || r.includeMature(ctx), p, size)
+	} else if isAdmin {
+		posts, total, err = r.postService.GetAll(ctx, p, size)
+	} else {
+		posts, total, err = r.postService.GetPublished(ctx, r.includeMature(ctx), p, size)
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &PostPage{Items: posts, PageInfo: newPageInfo(p, size, total)}, nil
+}
+
+// FeaturedPosts is the resolver for the featuredPosts field.
+func (r *queryResolver) FeaturedPosts(ctx context.Context, page *int, pageSize *int) (*PostPage, error) {
+	p, size := normalizePage(page, pageSize)
+	posts, total, err := r.postService.GetFeatured(ctx, r.includeMature(ctx), p, size)
+	if err != nil {
+		return nil, err
+	}
+	return &PostPage{Items: posts, PageInfo: newPageInfo(p, size, total)}, nil
+}
+
+// Post is the resolver for the post field.
+func (r *queryResolver) Post(ctx context.Context, id *uuid.UUID, slug *string) (*models.Post, error) {
+	var post *models.Post
+	var err error
+	switch {
+	case id != nil:
+		post, err = r.postService.GetByID(ctx, *id)
+	case slug != nil:
+		// Old slugs resolve to the post they now redirect to
+		post, _, err = r.postService.GetBySlug(ctx, *slug)
+	default:
+		return nil, apperrors.ErrValidation.WithDetails("Either id or slug is required")
+	}
+	if err != nil {
+		if isNotFound(err) {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	user := viewer(ctx)
+	if post.IsTakenDown() && (user == nil || !user.IsAdmin()) {
+		return nil, apperrors.NewAppError(http.StatusGone, apperrors.CodeGone, "This post has been taken down").
+			WithData(post.ToTombstone(user))
+	}
+	if !canView(user, post) {
+		return nil, nil
+	}
+	if !post.IsVisibleTo(user, r.adultAge) {
+		return nil, apperrors.NewAppError(http.StatusForbidden, apperrors.CodeForbidden, "This post is only available to verified adult accounts")
+	}
+	return post, nil
+}
+
+// Tag is the resolver for the tag field.
+func (r *queryResolver) Tag(ctx context.Context, slug string) (*models.Tag, error) {
+	tag, err := r.tagService.GetSynonyms(ctx, slug)
+	if err != nil {
+		if isNotFound(err) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return tag, nil
+}
+
+// Posts is the resolver for the posts field.
+func (r *tagResolver) Posts(ctx context.Context, obj *models.Tag, page *int, pageSize *int) (*PostPage, error) {
+	p, size := normalizePage(page, pageSize)
+	user := viewer(ctx)
+	isAdmin := user != nil && user.IsAdmin()
+
+	posts, total, err := r.postService.GetByTag(ctx, obj.Slug, !isAdmin, isAdmin || r.includeMature(ctx), p, size)
+	if err != nil {
+		return nil, err
+	}
+	return &PostPage{Items: posts, PageInfo: newPageInfo(p, size, total)}, nil
+}
+
+// Email is the resolver for the email field.
+func (r *userResolver) Email(ctx context.Context, obj *models.User) (*string, error) {
+	// Email addresses are only shown to the user and to staff
+	user := viewer(ctx)
+	if user == nil || (user.ID != obj.ID && !user.IsAdmin() && !user.IsModerator()) {
+		return nil, nil
+	}
+	return &obj.Email, nil
+}
+
+// Comment returns CommentResolver implementation.
+func (r *Resolver) Comment() CommentResolver { return &commentResolver{r} }
+
+// License returns LicenseResolver implementation.
+func (r *Resolver) License() LicenseResolver { return &licenseResolver{r} }
+
+// Post returns PostResolver implementation.
+func (r *Resolver) Post() PostResolver { return &postResolver{r} }
+
+// Query returns QueryResolver implementation.
+func (r *Resolver) Query() QueryResolver { return &queryResolver{r} }
+
+// Tag returns TagResolver implementation.
+func (r *Resolver) Tag() TagResolver { return &tagResolver{r} }
+
+// User returns UserResolver implementation.
+func (r *Resolver) User() UserResolver { return &userResolver{r} }
+
+type commentResolver struct{ *Resolver }
+type licenseResolver struct{ *Resolver }
+type postResolver struct{ *Resolver }
+type queryResolver struct{ *Resolver }
+type tagResolver struct{ *Resolver }
+type userResolver struct{ *Resolver }
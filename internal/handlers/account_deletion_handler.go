@@ -0,0 +1,79 @@
+package handlers
+
+import (
+	"github.com/gin-gonic/gin"
+	"github.com/yourusername/go-enterprise-api/internal/middleware"
+	"github.com/yourusername/go-enterprise-api/internal/services"
+	"github.com/yourusername/go-enterprise-api/pkg/response"
+)
+
+// AccountDeletionHandler handles self-service account deletion
+type AccountDeletionHandler struct {
+	accountDeletionService services.AccountDeletionService
+}
+
+// NewAccountDeletionHandler creates a new account deletion handler
+func NewAccountDeletionHandler(accountDeletionService services.AccountDeletionService) *AccountDeletionHandler {
+	return &AccountDeletionHandler{
+		accountDeletionService: accountDeletionService,
+	}
+}
+
+// DeleteAccountRequest represents the account deletion request body
+type DeleteAccountRequest struct {
+	Password    string `json:"password" binding:"required"`
+	DeletePosts bool   `json:"delete_posts"`
+}
+
+// Delete deactivates the current user's account and schedules its erasure
+// @Summary Delete own account
+// @Description Deactivate the current user's account after confirming their password. Once the grace period has passed, personal data is anonymized and posts are deleted (with delete_posts) or kept. Logging in during the grace period cancels the deletion.
+// @Tags users
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param request body DeleteAccountRequest true "Password confirmation and what happens to posts"
+// @Success 200 {object} response.Response
+// @Failure 400 {object} response.Response
+// @Failure 401 {object} response.Response
+// @Failure 403 {object} response.Response
+// @Router /users/me [delete]
+func (h *AccountDeletionHandler) Delete(c *gin.Context) {
+	var req DeleteAccountRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		response.BindingError(c, err)
+		return
+	}
+
+	user := middleware.MustGetUser(c)
+	eraseAt, err := h.accountDeletionService.Request(c.Request.Context(), user.ID, req.Password, req.DeletePosts)
+	if err != nil {
+		response.Error(c, err)
+		return
+	}
+
+	response.SuccessWithMessage(c, "Account scheduled for deletion; log in before it is erased to cancel", gin.H{
+		"erase_at":     eraseAt,
+		"delete_posts": req.DeletePosts,
+	})
+}
+
+// Run erases the accounts whose grace period has passed immediately
+// @Summary Run account erasure
+// @Description Erase the accounts whose deletion grace period has passed now instead of waiting for the schedule (admin only)
+// @Tags admin
+// @Produce json
+// @Security BearerAuth
+// @Success 200 {object} response.Response
+// @Failure 401 {object} response.Response
+// @Failure 403 {object} response.Response
+// @Router /admin/account-deletions/run [post]
+func (h *AccountDeletionHandler) Run(c *gin.Context) {
+	report, err := h.accountDeletionService.Run(c.Request.Context())
+	if err != nil {
+		response.Error(c, err)
+		return
+	}
+
+	response.SuccessWithMessage(c, "Account erasure applied", report)
+}
@@ -0,0 +1,80 @@
+package handlers
+
+import (
+	"strconv"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+	"github.com/yourusername/go-enterprise-api/internal/models"
+	"github.com/yourusername/go-enterprise-api/internal/services"
+	"github.com/yourusername/go-enterprise-api/pkg/response"
+	"github.com/yourusername/go-enterprise-api/pkg/validator"
+)
+
+const (
+	// defaultAdminSearchLimit and maxAdminSearchLimit bound the matches of
+	// each type returned by admin search
+	defaultAdminSearchLimit = 20
+	maxAdminSearchLimit     = 100
+)
+
+// AdminSearchHandler handles admin search requests
+type AdminSearchHandler struct {
+	searchService services.AdminSearchService
+}
+
+// NewAdminSearchHandler creates a new admin search handler
+func NewAdminSearchHandler(searchService services.AdminSearchService) *AdminSearchHandler {
+	return &AdminSearchHandler{
+		searchService: searchService,
+	}
+}
+
+// Search finds records across users, posts and comments
+// @Summary Search all records
+// @Description Find users, posts and comments, including soft-deleted and shadowed ones, by ID, email or current or previous slug (admin only)
+// @Tags admin
+// @Produce json
+// @Security BearerAuth
+// @Param q query string true "ID, email or slug"
+// @Param type query string false "Comma-separated record types (user, post, comment)"
+// @Param limit query int false "Matches per type" default(20)
+// @Success 200 {object} response.Response
+// @Failure 400 {object} response.Response
+// @Router /admin/search [get]
+func (h *AdminSearchHandler) Search(c *gin.Context) {
+	query := strings.TrimSpace(c.Query("q"))
+	limit, _ := strconv.Atoi(c.DefaultQuery("limit", strconv.Itoa(defaultAdminSearchLimit)))
+	if limit < 1 || limit > maxAdminSearchLimit {
+		limit = defaultAdminSearchLimit
+	}
+
+	var types []models.SearchMatchType
+	if raw := c.Query("type"); raw != "" {
+		for _, t := range strings.Split(raw, ",") {
+			types = append(types, models.SearchMatchType(strings.TrimSpace(t)))
+		}
+	}
+
+	v := validator.New()
+	v.Required("q", query, "")
+	v.MaxLength("q", query, 255, "")
+	validator.Each(v, "type", types, func(v *validator.Validator, t models.SearchMatchType) {
+		validator.OneOf(v, "", t, "", models.SearchMatchTypes...)
+	})
+	if errs := v.Validate(); errs != nil {
+		response.ValidationError(c, errs)
+		return
+	}
+
+	matches, err := h.searchService.Search(c.Request.Context(), query, types, limit)
+	if err != nil {
+		response.Error(c, err)
+		return
+	}
+
+	response.Success(c, gin.H{
+		"query":   query,
+		"matches": matches,
+	})
+}
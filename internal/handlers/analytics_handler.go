@@ -0,0 +1,175 @@
+package handlers
+
+import (
+	"strconv"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"github.com/yourusername/go-enterprise-api/internal/middleware"
+	"github.com/yourusername/go-enterprise-api/internal/models"
+	"github.com/yourusername/go-enterprise-api/internal/services"
+	"github.com/yourusername/go-enterprise-api/pkg/response"
+	"github.com/yourusername/go-enterprise-api/pkg/validator"
+)
+
+const (
+	// formatJSON and formatCSV are the supported analytics export formats
+	formatJSON = "json"
+	formatCSV  = "csv"
+)
+
+// AnalyticsHandler handles post analytics requests
+type AnalyticsHandler struct {
+	analyticsService services.AnalyticsService
+}
+
+// NewAnalyticsHandler creates a new analytics handler
+func NewAnalyticsHandler(analyticsService services.AnalyticsService) *AnalyticsHandler {
+	return &AnalyticsHandler{
+		analyticsService: analyticsService,
+	}
+}
+
+// GetPostAnalytics returns the analytics of a post
+// @Summary Get post analytics
+// @Description Get daily views, unique readers and referrers of a post for a date range (owner or admin only)
+// @Tags posts
+// @Accept json
+// @Produce json,text/csv
+// @Security BearerAuth
+// @Param id path string true "Post ID"
+// @Param from query string false "Start date (YYYY-MM-DD), defaults to 30 days ago"
+// @Param to query string false "End date (YYYY-MM-DD), defaults to today"
+// @Param format query string false "Response format (json or csv)" default(json)
+// @Success 200 {object} response.Response
+// @Failure 400 {object} response.Response
+// @Failure 401 {object} response.Response
+// @Failure 403 {object} response.Response
+// @Failure 404 {object} response.Response
+// @Router /posts/{id}/analytics [get]
+func (h *AnalyticsHandler) GetPostAnalytics(c *gin.Context) {
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		response.BadRequest(c, "Invalid post ID")
+		return
+	}
+
+	from, to, format, ok := parseAnalyticsQuery(c)
+	if !ok {
+		return
+	}
+
+	user := middleware.MustGetUser(c)
+
+	report, err := h.analyticsService.GetPostAnalytics(c.Request.Context(), id, user.ID, user.IsAdmin(), from, to)
+	if err != nil {
+		response.Error(c, err)
+		return
+	}
+
+	if format == formatCSV {
+		records := [][]string{{"date", "views", "unique_readers"}}
+		for _, day := range report.Daily {
+			records = append(records, []string{
+				day.Date,
+				strconv.FormatInt(day.Views, 10),
+				strconv.FormatInt(day.UniqueReaders, 10),
+			})
+		}
+		response.CSV(c, "post-"+id.String()+"-analytics.csv", records)
+		return
+	}
+
+	response.Success(c, gin.H{
+		"analytics": report,
+	})
+}
+
+// ExportMine exports the analytics of all posts of the current user
+// @Summary Export my analytics
+// @Description Export daily views and unique readers of all my posts for a date range
+// @Tags users
+// @Accept json
+// @Produce json,text/csv
+// @Security BearerAuth
+// @Param from query string false "Start date (YYYY-MM-DD), defaults to 30 days ago"
+// @Param to query string false "End date (YYYY-MM-DD), defaults to today"
+// @Param format query string false "Response format (json or csv)" default(json)
+// @Success 200 {object} response.Response
+// @Failure 400 {object} response.Response
+// @Failure 401 {object} response.Response
+// @Router /users/me/analytics/export [get]
+func (h *AnalyticsHandler) ExportMine(c *gin.Context) {
+	from, to, format, ok := parseAnalyticsQuery(c)
+	if !ok {
+		return
+	}
+
+	user := middleware.MustGetUser(c)
+
+	rows, err := h.analyticsService.ExportAuthorAnalytics(c.Request.Context(), user.ID, from, to)
+	if err != nil {
+		response.Error(c, err)
+		return
+	}
+
+	if format == formatCSV {
+		records := [][]string{{"date", "post_id", "post_title", "views", "unique_readers"}}
+		for _, row := range rows {
+			records = append(records, []string{
+				row.Date,
+				row.PostID.String(),
+				row.PostTitle,
+				strconv.FormatInt(row.Views, 10),
+				strconv.FormatInt(row.UniqueReaders, 10),
+			})
+		}
+		response.CSV(c, "analytics.csv", records)
+		return
+	}
+
+	if rows == nil {
+		rows = make([]models.AuthorAnalyticsRow, 0)
+	}
+
+	response.Success(c, gin.H{
+		"analytics": rows,
+	})
+}
+
+// parseAnalyticsQuery validates the from, to and format query parameters.
+// It writes the error response and returns ok=false when they are invalid.
+func parseAnalyticsQuery(c *gin.Context) (from, to time.Time, format string, ok bool) {
+	fromParam := c.Query("from")
+	toParam := c.Query("to")
+	format = c.DefaultQuery("format", formatJSON)
+
+	v := validator.New()
+	switch {
+	case fromParam != "" && toParam != "":
+		v.DateRange("from", fromParam, "to", toParam, "")
+	case fromParam != "":
+		v.Date("from", fromParam, "")
+	case toParam != "":
+		v.Date("to", toParam, "")
+	}
+	v.InSlice("format", format, []string{formatJSON, formatCSV}, "")
+
+	if errs := v.Validate(); errs != nil {
+		response.ValidationError(c, errs)
+		return from, to, format, false
+	}
+
+	from, _ = validator.ParseDate(fromParam)
+	to, _ = validator.ParseDate(toParam)
+	return from, to, format, true
+}
+
+// readerKey identifies the reader of a post for unique reader counts
+func readerKey(c *gin.Context) string {
+	if user, exists := middleware.GetUser(c); exists {
+		return "user:" + user.ID.String()
+	}
+	return "anon:" + c.ClientIP() + "|" + c.Request.UserAgent()
+}
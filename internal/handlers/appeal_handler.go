@@ -0,0 +1,231 @@
+package handlers
+
+import (
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"github.com/yourusername/go-enterprise-api/internal/middleware"
+	"github.com/yourusername/go-enterprise-api/internal/models"
+	"github.com/yourusername/go-enterprise-api/internal/services"
+	"github.com/yourusername/go-enterprise-api/pkg/response"
+	"github.com/yourusername/go-enterprise-api/pkg/validator"
+)
+
+// AppealHandler handles appeals against moderation decisions
+type AppealHandler struct {
+	appealService services.AppealService
+}
+
+// NewAppealHandler creates a new appeal handler
+func NewAppealHandler(appealService services.AppealService) *AppealHandler {
+	return &AppealHandler{
+		appealService: appealService,
+	}
+}
+
+// AppealRequest represents an author's appeal against the takedown of a post
+type AppealRequest struct {
+	Message string `json:"message" binding:"notblank,max=5000" sanitize:"text"`
+}
+
+// Create files an appeal
+// @Summary Appeal moderation decision
+// @Description Contest the takedown of your post (type "takedown" with post_id, requires login) or the ban of your account (type "ban" with the account's email and password, as banned users cannot log in). Each decision can be appealed once.
+// @Tags moderation
+// @Accept json
+// @Produce json
+// @Param request body services.CreateAppealRequest true "Appeal"
+// @Success 201 {object} response.Response
+// @Failure 400 {object} response.Response
+// @Failure 401 {object} response.Response
+// @Failure 403 {object} response.Response
+// @Failure 404 {object} response.Response
+// @Failure 409 {object} response.Response
+// @Router /moderation/appeals [post]
+func (h *AppealHandler) Create(c *gin.Context) {
+	var req services.CreateAppealRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		response.BindingError(c, err)
+		return
+	}
+
+	h.create(c, &req)
+}
+
+// AppealTakedown appeals the takedown of the current user's post
+// @Summary Appeal takedown
+// @Description Ask admins to review the takedown of your post. Each takedown can be appealed once.
+// @Tags posts
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "Post ID"
+// @Param request body AppealRequest true "Appeal message"
+// @Success 201 {object} response.Response
+// @Failure 400 {object} response.Response
+// @Failure 403 {object} response.Response
+// @Failure 404 {object} response.Response
+// @Failure 409 {object} response.Response
+// @Router /posts/{id}/appeal [post]
+func (h *AppealHandler) AppealTakedown(c *gin.Context) {
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		response.BadRequest(c, "Invalid post ID")
+		return
+	}
+
+	var req AppealRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		response.BindingError(c, err)
+		return
+	}
+
+	h.create(c, &services.CreateAppealRequest{
+		Type:    string(models.AppealTakedown),
+		PostID:  &id,
+		Message: req.Message,
+	})
+}
+
+// create validates and files an appeal
+func (h *AppealHandler) create(c *gin.Context, req *services.CreateAppealRequest) {
+	v := validator.New()
+	validator.OneOf(v, "type", models.AppealType(req.Type), "", models.AppealTypes...)
+	// Banned users cannot log in, so their appeal names the account instead
+	v.RequiredIf("email", req.Email, "type", req.Type, string(models.AppealBan), "")
+	v.RequiredIf("password", req.Password, "type", req.Type, string(models.AppealBan), "")
+	if errs := v.Validate(); errs != nil {
+		response.ValidationError(c, errs)
+		return
+	}
+
+	user, _ := middleware.GetUser(c)
+
+	appeal, err := h.appealService.Create(c.Request.Context(), user, req)
+	if err != nil {
+		response.Error(c, err)
+		return
+	}
+
+	response.Created(c, gin.H{
+		"appeal": appeal.ToResponse(),
+	})
+}
+
+// GetQueue returns the appeal review queue
+// @Summary List appeals
+// @Description Get a paginated list of appeals, oldest first (admin only)
+// @Tags admin
+// @Produce json
+// @Security BearerAuth
+// @Param status query string false "Filter by status (open, under_review, upheld, overturned)"
+// @Param type query string false "Filter by type (takedown, ban)"
+// @Param page query int false "Page number" default(1)
+// @Param page_size query int false "Page size" default(10)
+// @Success 200 {object} response.Response
+// @Failure 400 {object} response.Response
+// @Router /admin/appeals [get]
+func (h *AppealHandler) GetQueue(c *gin.Context) {
+	page, _ := strconv.Atoi(c.DefaultQuery("page", "1"))
+	pageSize, _ := strconv.Atoi(c.DefaultQuery("page_size", "10"))
+	status := models.AppealStatus(c.Query("status"))
+	appealType := models.AppealType(c.Query("type"))
+
+	v := validator.New()
+	validator.OneOf(v.When(status != ""), "status", status, "", models.AppealStatuses...)
+	validator.OneOf(v.When(appealType != ""), "type", appealType, "", models.AppealTypes...)
+	if errs := v.Validate(); errs != nil {
+		response.ValidationError(c, errs)
+		return
+	}
+
+	appeals, total, err := h.appealService.GetQueue(c.Request.Context(), status, appealType, page, pageSize)
+	if err != nil {
+		response.Error(c, err)
+		return
+	}
+
+	responses := make([]*models.AppealResponse, len(appeals))
+	for i := range appeals {
+		responses[i] = appeals[i].ToResponse()
+	}
+
+	response.Paginated(c, responses, page, pageSize, total)
+}
+
+// GetByID returns an appeal
+// @Summary Get appeal
+// @Description Get an appeal by ID (admin only)
+// @Tags admin
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "Appeal ID"
+// @Success 200 {object} response.Response
+// @Failure 400 {object} response.Response
+// @Failure 404 {object} response.Response
+// @Router /admin/appeals/{id} [get]
+func (h *AppealHandler) GetByID(c *gin.Context) {
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		response.BadRequest(c, "Invalid appeal ID")
+		return
+	}
+
+	appeal, err := h.appealService.GetByID(c.Request.Context(), id)
+	if err != nil {
+		response.Error(c, err)
+		return
+	}
+
+	response.Success(c, gin.H{
+		"appeal": appeal.ToResponse(),
+	})
+}
+
+// Review moves an appeal through its review
+// @Summary Review appeal
+// @Description Move an appeal to under_review, or resolve it as upheld or overturned. Overturning reinstates the post or unbans the user. The user is notified of the resolution (admin only).
+// @Tags admin
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "Appeal ID"
+// @Param request body services.ReviewAppealRequest true "New status and resolution note"
+// @Success 200 {object} response.Response
+// @Failure 400 {object} response.Response
+// @Failure 404 {object} response.Response
+// @Failure 409 {object} response.Response
+// @Router /admin/appeals/{id} [patch]
+func (h *AppealHandler) Review(c *gin.Context) {
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		response.BadRequest(c, "Invalid appeal ID")
+		return
+	}
+
+	var req services.ReviewAppealRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		response.BindingError(c, err)
+		return
+	}
+
+	v := validator.New()
+	validator.OneOf(v, "status", models.AppealStatus(req.Status), "", models.AppealUnderReview, models.AppealUpheld, models.AppealOverturned)
+	if errs := v.Validate(); errs != nil {
+		response.ValidationError(c, errs)
+		return
+	}
+
+	user := middleware.MustGetUser(c)
+
+	appeal, err := h.appealService.Review(c.Request.Context(), id, user.ID, &req)
+	if err != nil {
+		response.Error(c, err)
+		return
+	}
+
+	response.SuccessWithMessage(c, "Appeal updated", gin.H{
+		"appeal": appeal.ToResponse(),
+	})
+}
@@ -1,7 +1,10 @@
 package handlers
 
 import (
+	"time"
+
 	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
 	"github.com/yourusername/go-enterprise-api/internal/middleware"
 	"github.com/yourusername/go-enterprise-api/internal/services"
 	apperrors "github.com/yourusername/go-enterprise-api/pkg/errors"
@@ -11,27 +14,31 @@ import (
 
 // AuthHandler handles authentication-related requests
 type AuthHandler struct {
-	authService services.AuthService
+	authService   services.AuthService
+	policyService services.PolicyService
 }
 
 // NewAuthHandler creates a new auth handler
-func NewAuthHandler(authService services.AuthService) *AuthHandler {
+func NewAuthHandler(authService services.AuthService, policyService services.PolicyService) *AuthHandler {
 	return &AuthHandler{
-		authService: authService,
+		authService:   authService,
+		policyService: policyService,
 	}
 }
 
 // RegisterRequest represents the registration request body
 type RegisterRequest struct {
-	Email     string `json:"email" binding:"required,email"`
-	Password  string `json:"password" binding:"required,min=8"`
-	FirstName string `json:"first_name" binding:"required"`
-	LastName  string `json:"last_name" binding:"required"`
+	Email          string `json:"email" binding:"required,email" sanitize:"trim"`
+	Password       string `json:"password" binding:"required,password"`
+	FirstName      string `json:"first_name" binding:"notblank" sanitize:"text,striptags"`
+	LastName       string `json:"last_name" binding:"notblank" sanitize:"text,striptags"`
+	Birthdate      string `json:"birthdate" binding:"omitempty,birthdate" sanitize:"trim"`
+	AcceptPolicies bool   `json:"accept_policies"`
 }
 
 // LoginRequest represents the login request body
 type LoginRequest struct {
-	Email    string `json:"email" binding:"required,email"`
+	Email    string `json:"email" binding:"required,email" sanitize:"trim"`
 	Password string `json:"password" binding:"required"`
 }
 
@@ -43,12 +50,12 @@ type RefreshRequest struct {
 // ChangePasswordRequest represents the change password request body
 type ChangePasswordRequest struct {
 	OldPassword string `json:"old_password" binding:"required"`
-	NewPassword string `json:"new_password" binding:"required,min=8"`
+	NewPassword string `json:"new_password" binding:"required,password"`
 }
 
 // Register handles user registration
 // @Summary Register a new user
-// @Description Register a new user account
+// @Description Register a new user account. Once policies are published, accept_policies must be true to accept their current versions, as listed by GET /policies.
 // @Tags auth
 // @Accept json
 // @Produce json
@@ -60,19 +67,17 @@ type ChangePasswordRequest struct {
 func (h *AuthHandler) Register(c *gin.Context) {
 	var req RegisterRequest
 	if err := c.ShouldBindJSON(&req); err != nil {
-		response.BadRequest(c, "Invalid request body")
+		response.BindingError(c, err)
 		return
 	}
 
-	// Validate request
+	policies, err := h.policyService.GetCurrent(c.Request.Context())
+	if err != nil {
+		response.Error(c, err)
+		return
+	}
 	v := validator.New()
-	v.Required("email", req.Email, "")
-	v.Email("email", req.Email, "")
-	v.Required("password", req.Password, "")
-	v.Password("password", req.Password)
-	v.Required("first_name", req.FirstName, "")
-	v.Required("last_name", req.LastName, "")
-
+	v.Custom("accept_policies", len(policies) == 0 || req.AcceptPolicies, "The terms of service and privacy policy must be accepted")
 	if errs := v.Validate(); errs != nil {
 		response.ValidationError(c, errs)
 		return
@@ -85,6 +90,10 @@ func (h *AuthHandler) Register(c *gin.Context) {
 		FirstName: req.FirstName,
 		LastName:  req.LastName,
 	}
+	if birthdate, ok := validator.ParseDate(req.Birthdate); ok {
+		birthdate = time.Date(birthdate.Year(), birthdate.Month(), birthdate.Day(), 0, 0, 0, 0, time.UTC)
+		serviceReq.Birthdate = &birthdate
+	}
 
 	user, tokens, err := h.authService.Register(c.Request.Context(), serviceReq)
 	if err != nil {
@@ -92,6 +101,16 @@ func (h *AuthHandler) Register(c *gin.Context) {
 		return
 	}
 
+	// The account exists either way; should recording the acceptance fail,
+	// the user is asked to accept again before making changes
+	if len(policies) > 0 {
+		ids := make([]uuid.UUID, len(policies))
+		for i := range policies {
+			ids[i] = policies[i].ID
+		}
+		_ = h.policyService.Accept(c.Request.Context(), user.ID, ids)
+	}
+
 	response.Created(c, gin.H{
 		"user":   user.ToResponse(),
 		"tokens": tokens,
@@ -112,18 +131,7 @@ func (h *AuthHandler) Register(c *gin.Context) {
 func (h *AuthHandler) Login(c *gin.Context) {
 	var req LoginRequest
 	if err := c.ShouldBindJSON(&req); err != nil {
-		response.BadRequest(c, "Invalid request body")
-		return
-	}
-
-	// Validate request
-	v := validator.New()
-	v.Required("email", req.Email, "")
-	v.Email("email", req.Email, "")
-	v.Required("password", req.Password, "")
-
-	if errs := v.Validate(); errs != nil {
-		response.ValidationError(c, errs)
+		response.BindingError(c, err)
 		return
 	}
 
@@ -140,7 +148,7 @@ func (h *AuthHandler) Login(c *gin.Context) {
 	}
 
 	response.Success(c, gin.H{
-		"user":   user.ToResponse(),
+		"user":   user.ToResponseFor(user),
 		"tokens": tokens,
 	})
 }
@@ -180,7 +188,7 @@ func (h *AuthHandler) Logout(c *gin.Context) {
 func (h *AuthHandler) RefreshTokens(c *gin.Context) {
 	var req RefreshRequest
 	if err := c.ShouldBindJSON(&req); err != nil {
-		response.BadRequest(c, "Invalid request body")
+		response.BindingError(c, err)
 		return
 	}
 
@@ -208,7 +216,7 @@ func (h *AuthHandler) RefreshTokens(c *gin.Context) {
 func (h *AuthHandler) Me(c *gin.Context) {
 	user := middleware.MustGetUser(c)
 	response.Success(c, gin.H{
-		"user": user.ToResponse(),
+		"user": user.ToResponseFor(user),
 	})
 }
 
@@ -227,16 +235,7 @@ func (h *AuthHandler) Me(c *gin.Context) {
 func (h *AuthHandler) ChangePassword(c *gin.Context) {
 	var req ChangePasswordRequest
 	if err := c.ShouldBindJSON(&req); err != nil {
-		response.BadRequest(c, "Invalid request body")
-		return
-	}
-
-	// Validate new password
-	v := validator.New()
-	v.Password("new_password", req.NewPassword)
-
-	if errs := v.Validate(); errs != nil {
-		response.ValidationError(c, errs)
+		response.BindingError(c, err)
 		return
 	}
 
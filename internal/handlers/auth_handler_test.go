@@ -0,0 +1,166 @@
+package handlers_test
+
+import (
+	"errors"
+	"net/http"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/yourusername/go-enterprise-api/internal/handlers"
+	"github.com/yourusername/go-enterprise-api/internal/mocks"
+	"github.com/yourusername/go-enterprise-api/internal/models"
+	apperrors "github.com/yourusername/go-enterprise-api/pkg/errors"
+	"go.uber.org/mock/gomock"
+)
+
+func TestAuthHandlerRegister(t *testing.T) {
+	valid := map[string]interface{}{
+		"email":      "new@example.com",
+		"password":   "Password123!",
+		"first_name": "New",
+		"last_name":  "User",
+	}
+	with := func(key string, value interface{}) map[string]interface{} {
+		body := map[string]interface{}{}
+		for k, v := range valid {
+			body[k] = v
+		}
+		body[key] = value
+		return body
+	}
+	policies := []models.PolicyVersion{{BaseModel: models.BaseModel{ID: uuid.New()}, Kind: models.PolicyTerms, Version: "1"}}
+
+	tests := []struct {
+		name   string
+		body   map[string]interface{}
+		setup  func(auth *mocks.MockAuthService, policy *mocks.MockPolicyService)
+		status int
+		code   int
+	}{
+		{
+			name:   "invalid email",
+			body:   with("email", "not-an-email"),
+			status: http.StatusBadRequest,
+			code:   apperrors.CodeValidationError,
+		},
+		{
+			name:   "weak password",
+			body:   with("password", "password"),
+			status: http.StatusBadRequest,
+			code:   apperrors.CodeValidationError,
+		},
+		{
+			name: "policies not accepted",
+			body: valid,
+			setup: func(auth *mocks.MockAuthService, policy *mocks.MockPolicyService) {
+				policy.EXPECT().GetCurrent(gomock.Any()).Return(policies, nil)
+			},
+			status: http.StatusBadRequest,
+			code:   apperrors.CodeValidationError,
+		},
+		{
+			name: "existing email",
+			body: valid,
+			setup: func(auth *mocks.MockAuthService, policy *mocks.MockPolicyService) {
+				policy.EXPECT().GetCurrent(gomock.Any()).Return(nil, nil)
+				auth.EXPECT().Register(gomock.Any(), gomock.Any()).Return(nil, nil, apperrors.ErrEmailExists)
+			},
+			status: http.StatusConflict,
+			code:   apperrors.CodeEmailExists,
+		},
+		{
+			name: "policies unavailable",
+			body: valid,
+			setup: func(auth *mocks.MockAuthService, policy *mocks.MockPolicyService) {
+				policy.EXPECT().GetCurrent(gomock.Any()).Return(nil, apperrors.ErrInternal)
+			},
+			status: http.StatusInternalServerError,
+			code:   apperrors.CodeInternalError,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			auth := mocks.NewMockAuthService(ctrl)
+			policy := mocks.NewMockPolicyService(ctrl)
+			if tt.setup != nil {
+				tt.setup(auth, policy)
+			}
+			h := handlers.NewAuthHandler(auth, policy)
+
+			w := serve(t, h.Register, request{method: http.MethodPost, route: "/auth/register", path: "/auth/register", body: tt.body})
+			if w.Code != tt.status {
+				t.Fatalf("got %d, want %d: %s", w.Code, tt.status, w.Body)
+			}
+			if code := errorCode(t, w); code != tt.code {
+				t.Errorf("got error code %d, want %d", code, tt.code)
+			}
+		})
+	}
+}
+
+func TestAuthHandlerLogin(t *testing.T) {
+	tests := []struct {
+		name   string
+		body   map[string]string
+		err    error
+		status int
+		code   int
+	}{
+		{"missing password", map[string]string{"email": "user@example.com"}, nil, http.StatusBadRequest, apperrors.CodeValidationError},
+		{"invalid credentials", map[string]string{"email": "user@example.com", "password": "wrong"}, apperrors.ErrInvalidCredentials, http.StatusUnauthorized, apperrors.CodeInvalidCredentials},
+		{"banned account", map[string]string{"email": "user@example.com", "password": "Password123!"}, apperrors.ErrForbidden, http.StatusForbidden, apperrors.CodeForbidden},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			auth := mocks.NewMockAuthService(ctrl)
+			if tt.err != nil {
+				auth.EXPECT().Login(gomock.Any(), gomock.Any()).Return(nil, nil, tt.err)
+			}
+			h := handlers.NewAuthHandler(auth, mocks.NewMockPolicyService(ctrl))
+
+			w := serve(t, h.Login, request{method: http.MethodPost, route: "/auth/login", path: "/auth/login", body: tt.body})
+			if w.Code != tt.status {
+				t.Fatalf("got %d, want %d: %s", w.Code, tt.status, w.Body)
+			}
+			if code := errorCode(t, w); code != tt.code {
+				t.Errorf("got error code %d, want %d", code, tt.code)
+			}
+		})
+	}
+}
+
+func TestAuthHandlerChangePassword(t *testing.T) {
+	user := newUser(models.RoleUser)
+
+	tests := []struct {
+		name   string
+		body   map[string]string
+		err    error
+		status int
+		code   int
+	}{
+		{"weak new password", map[string]string{"old_password": "Password123!", "new_password": "password"}, nil, http.StatusBadRequest, apperrors.CodeValidationError},
+		{"wrong old password", map[string]string{"old_password": "wrong", "new_password": "N3w-password!"}, apperrors.ErrInvalidPassword, http.StatusBadRequest, apperrors.CodeInvalidPassword},
+		{"unexpected error", map[string]string{"old_password": "Password123!", "new_password": "N3w-password!"}, errors.New("connection refused"), http.StatusInternalServerError, apperrors.CodeInternalError},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			auth := mocks.NewMockAuthService(ctrl)
+			if tt.err != nil {
+				auth.EXPECT().ChangePassword(gomock.Any(), user.ID, tt.body["old_password"], tt.body["new_password"]).Return(tt.err)
+			}
+			h := handlers.NewAuthHandler(auth, mocks.NewMockPolicyService(ctrl))
+
+			w := serve(t, h.ChangePassword, request{method: http.MethodPost, route: "/auth/change-password", path: "/auth/change-password", user: user, body: tt.body})
+			if w.Code != tt.status {
+				t.Fatalf("got %d, want %d: %s", w.Code, tt.status, w.Body)
+			}
+			if code := errorCode(t, w); code != tt.code {
+				t.Errorf("got error code %d, want %d", code, tt.code)
+			}
+		})
+	}
+}
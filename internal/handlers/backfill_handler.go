@@ -0,0 +1,125 @@
+package handlers
+
+import (
+	"github.com/gin-gonic/gin"
+	"github.com/yourusername/go-enterprise-api/internal/middleware"
+	"github.com/yourusername/go-enterprise-api/internal/services"
+	"github.com/yourusername/go-enterprise-api/pkg/response"
+	"github.com/yourusername/go-enterprise-api/pkg/validator"
+)
+
+// BackfillHandler handles backfill jobs
+type BackfillHandler struct {
+	backfillService services.BackfillService
+}
+
+// NewBackfillHandler creates a new backfill handler
+func NewBackfillHandler(backfillService services.BackfillService) *BackfillHandler {
+	return &BackfillHandler{
+		backfillService: backfillService,
+	}
+}
+
+// RunBackfillRequest represents a request to run a backfill job
+type RunBackfillRequest struct {
+	BatchSize int  `json:"batch_size"`
+	Restart   bool `json:"restart"`
+}
+
+// List returns all backfill jobs with their progress
+// @Summary List backfill jobs
+// @Description List the available backfill jobs with the checkpoint of their latest run (admin only)
+// @Tags admin
+// @Produce json
+// @Security BearerAuth
+// @Success 200 {object} response.Response
+// @Failure 401 {object} response.Response
+// @Failure 403 {object} response.Response
+// @Router /admin/backfills [get]
+func (h *BackfillHandler) List(c *gin.Context) {
+	jobs, err := h.backfillService.List(c.Request.Context())
+	if err != nil {
+		response.Error(c, err)
+		return
+	}
+
+	response.Success(c, gin.H{
+		"jobs": jobs,
+	})
+}
+
+// Get returns a backfill job with its progress
+// @Summary Get backfill job
+// @Description Get a backfill job with the checkpoint of its latest run (admin only)
+// @Tags admin
+// @Produce json
+// @Security BearerAuth
+// @Param name path string true "Job name"
+// @Success 200 {object} response.Response
+// @Failure 404 {object} response.Response
+// @Router /admin/backfills/{name} [get]
+func (h *BackfillHandler) Get(c *gin.Context) {
+	job, err := h.backfillService.Get(c.Request.Context(), c.Param("name"))
+	if err != nil {
+		response.Error(c, err)
+		return
+	}
+
+	response.Success(c, gin.H{
+		"job": job,
+	})
+}
+
+// Run starts a backfill job in the background
+// @Summary Run backfill job
+// @Description Start a backfill job in the background. It resumes from its checkpoint unless the previous run completed or restart is set (admin only).
+// @Tags admin
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param name path string true "Job name"
+// @Param request body RunBackfillRequest false "Run options"
+// @Success 200 {object} response.Response
+// @Failure 400 {object} response.Response
+// @Failure 404 {object} response.Response
+// @Failure 409 {object} response.Response
+// @Router /admin/backfills/{name}/run [post]
+func (h *BackfillHandler) Run(c *gin.Context) {
+	var req RunBackfillRequest
+	if c.Request.ContentLength > 0 {
+		if err := c.ShouldBindJSON(&req); err != nil {
+			response.BindingError(c, err)
+			return
+		}
+	}
+
+	v := validator.New()
+	v.When(req.BatchSize != 0).Range("batch_size", req.BatchSize, 1, 10000, "")
+	if errs := v.Validate(); errs != nil {
+		response.ValidationError(c, errs)
+		return
+	}
+
+	user := middleware.MustGetUser(c)
+	name := c.Param("name")
+
+	err := h.backfillService.Start(name, services.BackfillOptions{
+		BatchSize: req.BatchSize,
+		Restart:   req.Restart,
+		StartedBy: &user.ID,
+	})
+	if err != nil {
+		response.Error(c, err)
+		return
+	}
+
+	job, err := h.backfillService.Get(c.Request.Context(), name)
+	if err != nil {
+		response.Error(c, err)
+		return
+	}
+
+	response.SuccessWithMessage(c, "Backfill started", gin.H{
+		"job": job,
+	})
+}
@@ -0,0 +1,67 @@
+package handlers
+
+import (
+	"github.com/gin-gonic/gin"
+	"github.com/yourusername/go-enterprise-api/internal/middleware"
+	"github.com/yourusername/go-enterprise-api/internal/services"
+	"github.com/yourusername/go-enterprise-api/pkg/response"
+)
+
+// BrandingHandler handles site branding requests
+type BrandingHandler struct {
+	brandingService services.BrandingService
+}
+
+// NewBrandingHandler creates a new branding handler
+func NewBrandingHandler(brandingService services.BrandingService) *BrandingHandler {
+	return &BrandingHandler{
+		brandingService: brandingService,
+	}
+}
+
+// Get returns the site's branding
+// @Summary Get branding
+// @Description Get the site title, logo, colors and footer links clients should render the site with
+// @Tags meta
+// @Produce json
+// @Success 200 {object} response.Response
+// @Router /branding [get]
+func (h *BrandingHandler) Get(c *gin.Context) {
+	branding, err := h.brandingService.Get(c.Request.Context())
+	if err != nil {
+		response.Error(c, err)
+		return
+	}
+	if !branding.UpdatedAt.IsZero() {
+		middleware.SetLastModified(c, branding.UpdatedAt)
+	}
+
+	response.Success(c, branding.ToResponse())
+}
+
+// Update replaces the site's branding
+// @Summary Update branding
+// @Description Replace the site title, logo URL, colors and footer links shown on the static site and in emails. Empty fields fall back to their defaults (admin only)
+// @Tags admin
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param request body services.UpdateBrandingRequest true "Branding"
+// @Success 200 {object} response.Response
+// @Failure 400 {object} response.Response
+// @Router /admin/branding [put]
+func (h *BrandingHandler) Update(c *gin.Context) {
+	var req services.UpdateBrandingRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		response.BindingError(c, err)
+		return
+	}
+
+	branding, err := h.brandingService.Update(c.Request.Context(), middleware.MustGetUser(c), &req)
+	if err != nil {
+		response.Error(c, err)
+		return
+	}
+
+	response.SuccessWithMessage(c, "Branding updated", branding.ToResponse())
+}
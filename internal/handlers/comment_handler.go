@@ -0,0 +1,246 @@
+package handlers
+
+import (
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"github.com/yourusername/go-enterprise-api/internal/middleware"
+	"github.com/yourusername/go-enterprise-api/internal/models"
+	"github.com/yourusername/go-enterprise-api/internal/services"
+	"github.com/yourusername/go-enterprise-api/pkg/response"
+	"github.com/yourusername/go-enterprise-api/pkg/validator"
+)
+
+// CommentHandler handles comment-related requests
+type CommentHandler struct {
+	commentService services.CommentService
+	maxLength      int
+}
+
+// NewCommentHandler creates a new comment handler
+func NewCommentHandler(commentService services.CommentService, maxLength int) *CommentHandler {
+	return &CommentHandler{
+		commentService: commentService,
+		maxLength:      maxLength,
+	}
+}
+
+// CreateCommentRequest represents the create comment request
+type CreateCommentRequest struct {
+	Content string `json:"content" binding:"notblank" sanitize:"text"`
+}
+
+// Create adds a comment to a post
+// @Summary Create comment
+// @Description Comment on a published post. Comments may be held for moderation.
+// @Tags comments
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "Post ID"
+// @Param request body CreateCommentRequest true "Comment data"
+// @Success 201 {object} response.Response
+// @Failure 400 {object} response.Response
+// @Failure 401 {object} response.Response
+// @Failure 404 {object} response.Response
+// @Router /posts/{id}/comments [post]
+func (h *CommentHandler) Create(c *gin.Context) {
+	postID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		response.BadRequest(c, "Invalid post ID")
+		return
+	}
+
+	var req CreateCommentRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		response.BindingError(c, err)
+		return
+	}
+
+	// The length limit is configured, so it cannot be a tag
+	v := validator.New()
+	v.MaxLength("content", req.Content, h.maxLength, "")
+
+	if errs := v.Validate(); errs != nil {
+		response.ValidationError(c, errs)
+		return
+	}
+
+	user := middleware.MustGetUser(c)
+
+	comment, err := h.commentService.Create(c.Request.Context(), postID, user, &services.CreateCommentRequest{
+		Content: req.Content,
+	}, services.CommentOrigin{
+		IP:        c.ClientIP(),
+		UserAgent: c.Request.UserAgent(),
+		Referrer:  c.Request.Referer(),
+	})
+	if err != nil {
+		response.Error(c, err)
+		return
+	}
+
+	response.Created(c, gin.H{
+		"comment": comment.ToResponseFor(user),
+	})
+}
+
+// GetByPost returns the approved comments on a post
+// @Summary Get post comments
+// @Description Get the approved comments on a published post, oldest first
+// @Tags comments
+// @Produce json
+// @Param id path string true "Post ID"
+// @Param page query int false "Page number" default(1)
+// @Param page_size query int false "Page size" default(10)
+// @Success 200 {object} response.Response
+// @Failure 400 {object} response.Response
+// @Failure 404 {object} response.Response
+// @Router /posts/{id}/comments [get]
+func (h *CommentHandler) GetByPost(c *gin.Context) {
+	postID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		response.BadRequest(c, "Invalid post ID")
+		return
+	}
+
+	page, _ := strconv.Atoi(c.DefaultQuery("page", "1"))
+	pageSize, _ := strconv.Atoi(c.DefaultQuery("page_size", "10"))
+
+	viewer, _ := middleware.GetUser(c)
+
+	comments, total, err := h.commentService.GetByPost(c.Request.Context(), postID, viewer, page, pageSize)
+	if err != nil {
+		response.Error(c, err)
+		return
+	}
+
+	response.Paginated(c, commentResponses(comments, viewer), page, pageSize, total)
+}
+
+// Delete deletes a comment
+// @Summary Delete comment
+// @Description Delete a comment (author, admin or moderator)
+// @Tags comments
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "Comment ID"
+// @Success 204 "No Content"
+// @Failure 400 {object} response.Response
+// @Failure 403 {object} response.Response
+// @Failure 404 {object} response.Response
+// @Router /comments/{id} [delete]
+func (h *CommentHandler) Delete(c *gin.Context) {
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		response.BadRequest(c, "Invalid comment ID")
+		return
+	}
+
+	user := middleware.MustGetUser(c)
+
+	if err := h.commentService.Delete(c.Request.Context(), id, user); err != nil {
+		response.Error(c, err)
+		return
+	}
+
+	response.NoContent(c)
+}
+
+// GetQueue returns comments awaiting moderation
+// @Summary Get moderation queue
+// @Description Get pending comments (or comments marked as spam), oldest first (admin or moderator)
+// @Tags moderation
+// @Produce json
+// @Security BearerAuth
+// @Param status query string false "pending or spam" default(pending)
+// @Param page query int false "Page number" default(1)
+// @Param page_size query int false "Page size" default(10)
+// @Success 200 {object} response.Response
+// @Failure 400 {object} response.Response
+// @Failure 403 {object} response.Response
+// @Router /moderation/comments [get]
+func (h *CommentHandler) GetQueue(c *gin.Context) {
+	status := models.CommentStatus(c.DefaultQuery("status", string(models.CommentStatusPending)))
+
+	v := validator.New()
+	validator.OneOf(v, "status", status, "", models.CommentStatusPending, models.CommentStatusSpam)
+	if errs := v.Validate(); errs != nil {
+		response.ValidationError(c, errs)
+		return
+	}
+
+	page, _ := strconv.Atoi(c.DefaultQuery("page", "1"))
+	pageSize, _ := strconv.Atoi(c.DefaultQuery("page_size", "10"))
+
+	comments, total, err := h.commentService.GetQueue(c.Request.Context(), status, page, pageSize)
+	if err != nil {
+		response.Error(c, err)
+		return
+	}
+
+	response.Paginated(c, commentResponses(comments, middleware.MustGetUser(c)), page, pageSize, total)
+}
+
+// Approve approves several comments
+// @Summary Approve comments
+// @Description Publish several pending or spam comments (admin or moderator)
+// @Tags moderation
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param request body BulkIDsRequest true "Comment IDs"
+// @Success 200 {object} response.Response
+// @Failure 400 {object} response.Response
+// @Failure 403 {object} response.Response
+// @Router /moderation/comments/approve [post]
+func (h *CommentHandler) Approve(c *gin.Context) {
+	h.moderate(c, models.CommentStatusApproved, "Comments approved")
+}
+
+// MarkSpam marks several comments as spam
+// @Summary Mark comments as spam
+// @Description Hide several comments as spam (admin or moderator)
+// @Tags moderation
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param request body BulkIDsRequest true "Comment IDs"
+// @Success 200 {object} response.Response
+// @Failure 400 {object} response.Response
+// @Failure 403 {object} response.Response
+// @Router /moderation/comments/spam [post]
+func (h *CommentHandler) MarkSpam(c *gin.Context) {
+	h.moderate(c, models.CommentStatusSpam, "Comments marked as spam")
+}
+
+// moderate applies a moderation status to the comments in the request
+func (h *CommentHandler) moderate(c *gin.Context, status models.CommentStatus, message string) {
+	var req BulkIDsRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		response.BindingError(c, err)
+		return
+	}
+
+	user := middleware.MustGetUser(c)
+
+	moderated, err := h.commentService.Moderate(c.Request.Context(), req.IDs, status, user.ID)
+	if err != nil {
+		response.Error(c, err)
+		return
+	}
+
+	response.SuccessWithMessage(c, message, gin.H{
+		"moderated": moderated,
+	})
+}
+
+// commentResponses converts comments to responses as seen by viewer
+func commentResponses(comments []models.Comment, viewer *models.User) []*models.CommentResponse {
+	responses := make([]*models.CommentResponse, len(comments))
+	for i := range comments {
+		responses[i] = comments[i].ToResponseFor(viewer)
+	}
+	return responses
+}
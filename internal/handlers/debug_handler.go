@@ -0,0 +1,154 @@
+package handlers
+
+import (
+	"context"
+	"net/http"
+	"net/http/pprof"
+	"runtime"
+	"runtime/debug"
+	rpprof "runtime/pprof"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/yourusername/go-enterprise-api/pkg/logger"
+	"github.com/yourusername/go-enterprise-api/pkg/response"
+)
+
+// defaultProfileSeconds is the duration of CPU profiles and execution traces
+// requested without a seconds parameter, as in net/http/pprof
+const defaultProfileSeconds = 30
+
+// DebugHandler serves runtime diagnostics to admins
+type DebugHandler struct{}
+
+// NewDebugHandler creates a new debug handler
+func NewDebugHandler() *DebugHandler {
+	return &DebugHandler{}
+}
+
+// MemoryStats represents the memory statistics reported around a collection
+type MemoryStats struct {
+	HeapAllocBytes uint64 `json:"heap_alloc_bytes"`
+	HeapInuseBytes uint64 `json:"heap_inuse_bytes"`
+	HeapObjects    uint64 `json:"heap_objects"`
+	SysBytes       uint64 `json:"sys_bytes"`
+	NumGC          uint32 `json:"num_gc"`
+}
+
+// Pprof serves the net/http/pprof profiles
+// @Summary Runtime profiles
+// @Description Serve the pprof index, CPU profile, execution trace and named profiles such as heap, goroutine, block and mutex (admin only). Profiles are meant for go tool pprof.
+// @Tags admin
+// @Produce octet-stream
+// @Security BearerAuth
+// @Param profile path string true "Profile name, or empty for the index"
+// @Param seconds query int false "Duration of CPU profiles and traces" default(30)
+// @Param debug query int false "Render the profile as text"
+// @Success 200 {file} binary
+// @Failure 401 {object} response.Response
+// @Failure 403 {object} response.Response
+// @Router /admin/debug/pprof/{profile} [get]
+func (h *DebugHandler) Pprof(c *gin.Context) {
+	name := strings.TrimPrefix(c.Param("profile"), "/")
+	w, r := c.Writer, c.Request
+
+	switch name {
+	case "":
+		// The index links to the profiles relative to the request path
+		pprof.Index(w, r)
+	case "cmdline":
+		pprof.Cmdline(w, r)
+	case "symbol":
+		pprof.Symbol(w, r)
+	case "profile":
+		pprof.Profile(w, withoutWriteTimeout(w, r))
+	case "trace":
+		pprof.Trace(w, withoutWriteTimeout(w, r))
+	default:
+		if rpprof.Lookup(name) == nil {
+			response.NotFound(c, "Unknown profile")
+			return
+		}
+		pprof.Handler(name).ServeHTTP(w, r)
+	}
+}
+
+// GC runs a garbage collection and returns heap statistics before and after
+// @Summary Run garbage collection
+// @Description Run a garbage collection, return freed memory to the operating system and report heap statistics before and after (admin only)
+// @Tags admin
+// @Produce json
+// @Security BearerAuth
+// @Success 200 {object} response.Response
+// @Failure 401 {object} response.Response
+// @Failure 403 {object} response.Response
+// @Router /admin/debug/gc [post]
+func (h *DebugHandler) GC(c *gin.Context) {
+	before := readMemoryStats()
+	start := time.Now()
+	debug.FreeOSMemory()
+	took := time.Since(start)
+	after := readMemoryStats()
+
+	logger.Info("Garbage collection triggered",
+		logger.Any("freed_bytes", int64(before.HeapAllocBytes)-int64(after.HeapAllocBytes)),
+		logger.Any("duration", took),
+	)
+
+	response.Success(c, gin.H{
+		"before":      before,
+		"after":       after,
+		"duration_ms": took.Milliseconds(),
+	})
+}
+
+// Goroutines dumps the stacks of all goroutines as text
+// @Summary Dump goroutine stacks
+// @Description Dump the stack of every goroutine in the format of an unrecovered panic (admin only)
+// @Tags admin
+// @Produce plain
+// @Security BearerAuth
+// @Success 200 {string} string
+// @Failure 401 {object} response.Response
+// @Failure 403 {object} response.Response
+// @Router /admin/debug/goroutines [get]
+func (h *DebugHandler) Goroutines(c *gin.Context) {
+	c.Header("Content-Type", "text/plain; charset=utf-8")
+	c.Header("X-Goroutine-Count", strconv.Itoa(runtime.NumGoroutine()))
+	c.Status(http.StatusOK)
+	if err := rpprof.Lookup("goroutine").WriteTo(c.Writer, 2); err != nil {
+		logger.Error("Failed to dump goroutines", logger.Err(err))
+	}
+}
+
+// readMemoryStats reads the current heap statistics
+func readMemoryStats() MemoryStats {
+	var m runtime.MemStats
+	runtime.ReadMemStats(&m)
+	return MemoryStats{
+		HeapAllocBytes: m.HeapAlloc,
+		HeapInuseBytes: m.HeapInuse,
+		HeapObjects:    m.HeapObjects,
+		SysBytes:       m.Sys,
+		NumGC:          m.NumGC,
+	}
+}
+
+// withoutWriteTimeout extends the write deadline of a response for the
+// duration of a CPU profile or trace, which would otherwise be cut short by
+// the server's WriteTimeout. net/http/pprof rejects durations longer than the
+// server's WriteTimeout, so the request no longer reports it.
+func withoutWriteTimeout(w http.ResponseWriter, r *http.Request) *http.Request {
+	seconds, err := strconv.ParseFloat(r.FormValue("seconds"), 64)
+	if err != nil || seconds <= 0 {
+		seconds = defaultProfileSeconds
+	}
+	deadline := time.Now().Add(time.Duration(seconds*float64(time.Second)) + 10*time.Second)
+	if err := http.NewResponseController(w).SetWriteDeadline(deadline); err != nil {
+		// Leave the server's checks in place
+		return r
+	}
+	return r.WithContext(context.WithValue(r.Context(), http.ServerContextKey, &http.Server{}))
+}
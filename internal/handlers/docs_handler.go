@@ -0,0 +1,71 @@
+package handlers
+
+import (
+	"html/template"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/yourusername/go-enterprise-api/pkg/logger"
+)
+
+// swaggerUIVersion is the version of the Swagger UI assets the docs page
+// loads from the CDN
+const swaggerUIVersion = "5.11.0"
+
+// swaggerUIPage renders Swagger UI for the OpenAPI document at SpecURL
+var swaggerUIPage = template.Must(template.New("docs").Parse(`<!DOCTYPE html>
+<html lang="en">
+<head>
+  <meta charset="utf-8">
+  <meta name="viewport" content="width=device-width, initial-scale=1">
+  <title>{{.Title}} API docs</title>
+  <link rel="stylesheet" href="https://cdn.jsdelivr.net/npm/swagger-ui-dist@{{.Version}}/swagger-ui.css">
+</head>
+<body>
+  <div id="swagger-ui"></div>
+  <script src="https://cdn.jsdelivr.net/npm/swagger-ui-dist@{{.Version}}/swagger-ui-bundle.js" crossorigin></script>
+  <script>
+    window.ui = SwaggerUIBundle({url: {{.SpecURL}}, dom_id: "#swagger-ui", deepLinking: true, persistAuthorization: true});
+  </script>
+</body>
+</html>
+`))
+
+// DocsHandler serves the OpenAPI document and Swagger UI
+type DocsHandler struct {
+	spec    []byte
+	title   string
+	specURL string
+}
+
+// NewDocsHandler creates a new docs handler for the document spec, which
+// Swagger UI loads from specURL
+func NewDocsHandler(spec []byte, title, specURL string) *DocsHandler {
+	return &DocsHandler{spec: spec, title: title, specURL: specURL}
+}
+
+// OpenAPI serves the OpenAPI document
+// @Summary OpenAPI document
+// @Description Get the OpenAPI (Swagger 2.0) document describing the API, generated from the handlers at build time
+// @Tags meta
+// @Produce json
+// @Success 200 {object} map[string]interface{}
+// @Failure 401 {object} response.Response
+// @Router /openapi.json [get]
+func (h *DocsHandler) OpenAPI(c *gin.Context) {
+	c.Data(http.StatusOK, "application/json; charset=utf-8", h.spec)
+}
+
+// UI serves the Swagger UI page
+func (h *DocsHandler) UI(c *gin.Context) {
+	c.Header("Content-Type", "text/html; charset=utf-8")
+	c.Status(http.StatusOK)
+	err := swaggerUIPage.Execute(c.Writer, map[string]string{
+		"Title":   h.title,
+		"Version": swaggerUIVersion,
+		"SpecURL": h.specURL,
+	})
+	if err != nil {
+		logger.Error("Failed to render the docs page", logger.Err(err))
+	}
+}
@@ -0,0 +1,39 @@
+package handlers
+
+import (
+	"github.com/gin-gonic/gin"
+	"github.com/yourusername/go-enterprise-api/internal/services"
+	"github.com/yourusername/go-enterprise-api/pkg/response"
+)
+
+// DormancyHandler handles the dormant account policy
+type DormancyHandler struct {
+	dormancyService services.DormancyService
+}
+
+// NewDormancyHandler creates a new dormancy handler
+func NewDormancyHandler(dormancyService services.DormancyService) *DormancyHandler {
+	return &DormancyHandler{
+		dormancyService: dormancyService,
+	}
+}
+
+// Run applies the dormant account policy immediately
+// @Summary Run dormancy policy
+// @Description Notify, deactivate and anonymize dormant accounts now instead of waiting for the schedule (admin only)
+// @Tags admin
+// @Produce json
+// @Security BearerAuth
+// @Success 200 {object} response.Response
+// @Failure 401 {object} response.Response
+// @Failure 403 {object} response.Response
+// @Router /admin/dormancy/run [post]
+func (h *DormancyHandler) Run(c *gin.Context) {
+	report, err := h.dormancyService.Run(c.Request.Context())
+	if err != nil {
+		response.Error(c, err)
+		return
+	}
+
+	response.SuccessWithMessage(c, "Dormancy policy applied", report)
+}
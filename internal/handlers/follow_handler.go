@@ -0,0 +1,185 @@
+package handlers
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"github.com/yourusername/go-enterprise-api/internal/middleware"
+	"github.com/yourusername/go-enterprise-api/internal/models"
+	"github.com/yourusername/go-enterprise-api/internal/services"
+	"github.com/yourusername/go-enterprise-api/pkg/response"
+)
+
+// FollowHandler handles follow and feed requests
+type FollowHandler struct {
+	followService services.FollowService
+}
+
+// NewFollowHandler creates a new follow handler
+func NewFollowHandler(followService services.FollowService) *FollowHandler {
+	return &FollowHandler{
+		followService: followService,
+	}
+}
+
+// Follow makes the current user follow a user
+// @Summary Follow user
+// @Description Follow a user. Following a user twice has no effect.
+// @Tags users
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "User ID"
+// @Success 200 {object} response.Response
+// @Failure 400 {object} response.Response
+// @Failure 401 {object} response.Response
+// @Failure 404 {object} response.Response
+// @Router /users/{id}/follow [post]
+func (h *FollowHandler) Follow(c *gin.Context) {
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		response.BadRequest(c, "Invalid user ID")
+		return
+	}
+
+	user := middleware.MustGetUser(c)
+	if err := h.followService.Follow(c.Request.Context(), user.ID, id); err != nil {
+		response.Error(c, err)
+		return
+	}
+
+	response.Success(c, gin.H{
+		"following": true,
+	})
+}
+
+// Unfollow makes the current user stop following a user
+// @Summary Unfollow user
+// @Description Stop following a user. Unfollowing a user that is not followed has no effect.
+// @Tags users
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "User ID"
+// @Success 204
+// @Failure 400 {object} response.Response
+// @Failure 401 {object} response.Response
+// @Router /users/{id}/follow [delete]
+func (h *FollowHandler) Unfollow(c *gin.Context) {
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		response.BadRequest(c, "Invalid user ID")
+		return
+	}
+
+	user := middleware.MustGetUser(c)
+	if err := h.followService.Unfollow(c.Request.Context(), user.ID, id); err != nil {
+		response.Error(c, err)
+		return
+	}
+
+	response.NoContent(c)
+}
+
+// GetFollowers returns the users following a user
+// @Summary Get followers
+// @Description Get a paginated list of the users following a user, most recent first. Users with hide_followers set only show this list to themselves, admins and moderators.
+// @Tags users
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "User ID"
+// @Param page query int false "Page number" default(1)
+// @Param page_size query int false "Page size" default(10)
+// @Success 200 {object} response.Response
+// @Failure 400 {object} response.Response
+// @Failure 403 {object} response.Response
+// @Failure 404 {object} response.Response
+// @Router /users/{id}/followers [get]
+func (h *FollowHandler) GetFollowers(c *gin.Context) {
+	h.list(c, h.followService.GetFollowers, func(f *models.UserFollow) *models.User { return f.Follower })
+}
+
+// GetFollowing returns the users a user follows
+// @Summary Get followed users
+// @Description Get a paginated list of the users a user follows, most recent first. Users with hide_followers set only show this list to themselves, admins and moderators.
+// @Tags users
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "User ID"
+// @Param page query int false "Page number" default(1)
+// @Param page_size query int false "Page size" default(10)
+// @Success 200 {object} response.Response
+// @Failure 400 {object} response.Response
+// @Failure 403 {object} response.Response
+// @Failure 404 {object} response.Response
+// @Router /users/{id}/following [get]
+func (h *FollowHandler) GetFollowing(c *gin.Context) {
+	h.list(c, h.followService.GetFollowing, func(f *models.UserFollow) *models.User { return f.Followee })
+}
+
+// GetFeed returns recent posts by the users the current user follows
+// @Summary Get personal feed
+// @Description Get the latest published posts by followed users, newest first. Pass the next_cursor of a page to get the next one.
+// @Tags feed
+// @Produce json
+// @Security BearerAuth
+// @Param cursor query string false "Cursor returned by the previous page"
+// @Param limit query int false "Page size" default(20)
+// @Success 200 {object} response.Response
+// @Failure 400 {object} response.Response
+// @Failure 401 {object} response.Response
+// @Router /feed [get]
+func (h *FollowHandler) GetFeed(c *gin.Context) {
+	limit, _ := strconv.Atoi(c.DefaultQuery("limit", "20"))
+
+	user := middleware.MustGetUser(c)
+	posts, next, err := h.followService.GetFeed(c.Request.Context(), user, c.Query("cursor"), limit)
+	if err != nil {
+		response.Error(c, err)
+		return
+	}
+
+	postResponses := make([]*models.PostResponse, len(posts))
+	for i, post := range posts {
+		postResponses[i] = post.ToResponse()
+	}
+
+	response.CursorPaginated(c, postResponses, len(postResponses), next)
+}
+
+// list writes a page of a followers or following list
+func (h *FollowHandler) list(
+	c *gin.Context,
+	find func(ctx context.Context, viewer *models.User, userID uuid.UUID, page, pageSize int) ([]models.UserFollow, int64, error),
+	other func(f *models.UserFollow) *models.User,
+) {
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		response.BadRequest(c, "Invalid user ID")
+		return
+	}
+
+	page, _ := strconv.Atoi(c.DefaultQuery("page", "1"))
+	pageSize, _ := strconv.Atoi(c.DefaultQuery("page_size", "10"))
+
+	viewer := middleware.MustGetUser(c)
+	follows, total, err := find(c.Request.Context(), viewer, id, page, pageSize)
+	if err != nil {
+		response.Error(c, err)
+		return
+	}
+
+	followResponses := make([]*models.FollowResponse, 0, len(follows))
+	for i := range follows {
+		user := other(&follows[i])
+		if user == nil {
+			continue
+		}
+		followResponses = append(followResponses, &models.FollowResponse{
+			User:       user.ToResponseFor(viewer),
+			FollowedAt: follows[i].CreatedAt,
+		})
+	}
+
+	response.Paginated(c, followResponses, page, pageSize, total)
+}
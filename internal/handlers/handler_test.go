@@ -0,0 +1,87 @@
+package handlers_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"io"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"github.com/yourusername/go-enterprise-api/internal/middleware"
+	"github.com/yourusername/go-enterprise-api/internal/models"
+	"github.com/yourusername/go-enterprise-api/pkg/response"
+)
+
+func init() {
+	gin.SetMode(gin.TestMode)
+}
+
+// request describes a request sent straight to a handler. User, unless nil,
+// is the authenticated user, as set by the auth middleware.
+type request struct {
+	method string
+	route  string
+	path   string
+	user   *models.User
+	body   interface{}
+}
+
+// serve sends a request to a handler registered on route and returns the
+// recorded response
+func serve(t *testing.T, handler gin.HandlerFunc, req request) *httptest.ResponseRecorder {
+	t.Helper()
+
+	var body io.Reader
+	if req.body != nil {
+		encoded, err := json.Marshal(req.body)
+		if err != nil {
+			t.Fatalf("failed to encode the request body: %v", err)
+		}
+		body = bytes.NewReader(encoded)
+	}
+
+	router := gin.New()
+	handlers := []gin.HandlerFunc{handler}
+	if req.user != nil {
+		user := req.user
+		handlers = append([]gin.HandlerFunc{func(c *gin.Context) {
+			c.Set(middleware.UserKey, user)
+		}}, handlers...)
+	}
+	router.Handle(req.method, req.route, handlers...)
+
+	httpReq := httptest.NewRequest(req.method, req.path, body)
+	if req.body != nil {
+		httpReq.Header.Set("Content-Type", "application/json")
+	}
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, httpReq)
+	return w
+}
+
+// errorCode returns the error code of a response, or 0 when it succeeded
+func errorCode(t *testing.T, w *httptest.ResponseRecorder) int {
+	t.Helper()
+
+	var resp response.Response
+	if err := json.Unmarshal(w.Body.Bytes(), &resp); err != nil {
+		t.Fatalf("failed to decode the response %s: %v", w.Body.String(), err)
+	}
+	if resp.Error == nil {
+		return 0
+	}
+	return resp.Error.Code
+}
+
+// newUser returns an active user with a role
+func newUser(role models.UserRole) *models.User {
+	return &models.User{
+		BaseModel: models.BaseModel{ID: uuid.New()},
+		Email:     string(role) + "@example.com",
+		Role:      role,
+		Status:    models.StatusActive,
+		Type:      models.UserTypeHuman,
+	}
+}
@@ -5,18 +5,22 @@ import (
 
 	"github.com/gin-gonic/gin"
 	"github.com/yourusername/go-enterprise-api/internal/database"
+	"github.com/yourusername/go-enterprise-api/internal/health"
 	"github.com/yourusername/go-enterprise-api/pkg/response"
 )
 
 // HealthHandler handles health check requests
 type HealthHandler struct {
-	db *database.Database
+	db     *database.Database
+	checks *health.Registry
 }
 
-// NewHealthHandler creates a new health handler
-func NewHealthHandler(db *database.Database) *HealthHandler {
+// NewHealthHandler creates a new health handler. Readiness is reported from
+// the checks registered with checks.
+func NewHealthHandler(db *database.Database, checks *health.Registry) *HealthHandler {
 	return &HealthHandler{
-		db: db,
+		db:     db,
+		checks: checks,
 	}
 }
 
@@ -43,7 +47,7 @@ func (h *HealthHandler) Health(c *gin.Context) {
 
 // Ready returns readiness status including dependencies
 // @Summary Readiness check
-// @Description Check if the service and its dependencies are ready
+// @Description Run the health checks of the service's dependencies and report the status and latency of each. The service is not ready when a critical check fails, and degraded when only non-critical checks fail.
 // @Tags health
 // @Accept json
 // @Produce json
@@ -51,22 +55,26 @@ func (h *HealthHandler) Health(c *gin.Context) {
 // @Failure 503 {object} response.Response
 // @Router /health/ready [get]
 func (h *HealthHandler) Ready(c *gin.Context) {
-	services := make(map[string]string)
+	report := h.checks.Run(c.Request.Context())
 
-	// Check database
-	if err := h.db.HealthCheck(); err != nil {
-		services["database"] = "unhealthy"
+	services := make(map[string]string, len(report.Checks))
+	for _, check := range report.Checks {
+		services[check.Name] = check.Status
+	}
+
+	if !report.Ready() {
 		c.JSON(503, gin.H{
-			"status":   "not ready",
+			"status":   report.Status,
 			"services": services,
+			"checks":   report.Checks,
 		})
 		return
 	}
-	services["database"] = "healthy"
 
 	response.Success(c, gin.H{
-		"status":   "ready",
+		"status":   report.Status,
 		"services": services,
+		"checks":   report.Checks,
 	})
 }
 
@@ -86,7 +94,7 @@ func (h *HealthHandler) Live(c *gin.Context) {
 
 // Info returns system information
 // @Summary System info
-// @Description Get system information (admin only)
+// @Description Get system information, including database connection pool statistics (admin only)
 // @Tags health
 // @Accept json
 // @Produce json
@@ -99,6 +107,12 @@ func (h *HealthHandler) Info(c *gin.Context) {
 	var memStats runtime.MemStats
 	runtime.ReadMemStats(&memStats)
 
+	primary, replicas, err := h.db.PoolStats()
+	if err != nil {
+		response.InternalServerError(c, "Failed to read database pool statistics")
+		return
+	}
+
 	response.Success(c, gin.H{
 		"go_version":    runtime.Version(),
 		"go_os":         runtime.GOOS,
@@ -107,5 +121,9 @@ func (h *HealthHandler) Info(c *gin.Context) {
 		"goroutines":    runtime.NumGoroutine(),
 		"heap_alloc_mb": memStats.HeapAlloc / 1024 / 1024,
 		"sys_mb":        memStats.Sys / 1024 / 1024,
+		"database_pool": gin.H{
+			"primary":  primary,
+			"replicas": replicas,
+		},
 	})
 }
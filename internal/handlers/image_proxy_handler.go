@@ -0,0 +1,82 @@
+package handlers
+
+import (
+	"errors"
+	"net/http"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+	"github.com/yourusername/go-enterprise-api/internal/imageproxy"
+	apperrors "github.com/yourusername/go-enterprise-api/pkg/errors"
+	"github.com/yourusername/go-enterprise-api/pkg/logger"
+	"github.com/yourusername/go-enterprise-api/pkg/response"
+)
+
+// ImageProxyHandler serves resized copies of external images
+type ImageProxyHandler struct {
+	proxy *imageproxy.Proxy
+}
+
+// NewImageProxyHandler creates a new image proxy handler
+func NewImageProxyHandler(proxy *imageproxy.Proxy) *ImageProxyHandler {
+	return &ImageProxyHandler{
+		proxy: proxy,
+	}
+}
+
+// Get serves a resized copy of an external image
+// @Summary Proxy an external image
+// @Description Fetch an external image, scale it down to the nearest supported width and serve it from cache (JPEG, or PNG for PNG and GIF sources)
+// @Tags images
+// @Produce image/jpeg,image/png
+// @Param url query string true "Absolute http(s) URL of the image"
+// @Param w query int false "Requested width in pixels, rounded up to a supported width"
+// @Success 200 {file} file
+// @Failure 400 {object} response.Response
+// @Failure 403 {object} response.Response
+// @Failure 502 {object} response.Response
+// @Router /img-proxy [get]
+func (h *ImageProxyHandler) Get(c *gin.Context) {
+	width := 0
+	if w := c.Query("w"); w != "" {
+		n, err := strconv.Atoi(w)
+		if err != nil || n < 1 {
+			response.BadRequest(c, "Width must be a positive number")
+			return
+		}
+		width = n
+	}
+
+	img, err := h.proxy.Get(c.Request.Context(), c.Query("url"), width)
+	if err != nil {
+		switch {
+		case errors.Is(err, imageproxy.ErrInvalidURL):
+			response.BadRequest(c, "URL must be an absolute http or https URL")
+		case errors.Is(err, imageproxy.ErrHostNotAllowed):
+			response.Forbidden(c, "Image host is not allowed")
+		case errors.Is(err, imageproxy.ErrTooLarge):
+			response.BadRequest(c, "Image exceeds the size limit")
+		case errors.Is(err, imageproxy.ErrUnsupported):
+			response.BadRequest(c, "Image format is not supported")
+		case errors.Is(err, imageproxy.ErrFetch):
+			logger.Warn("Failed to fetch proxied image", logger.String("url", c.Query("url")), logger.Err(err))
+			response.Error(c, apperrors.ErrBadGateway.WithDetails("The image could not be fetched"))
+		default:
+			logger.Error("Failed to proxy image", logger.String("url", c.Query("url")), logger.Err(err))
+			response.Error(c, apperrors.ErrInternal)
+		}
+		return
+	}
+
+	etag := `"` + img.ETag + `"`
+	c.Header("ETag", etag)
+	c.Header("Cache-Control", "public, max-age=86400")
+	c.Header("X-Content-Type-Options", "nosniff")
+
+	if c.GetHeader("If-None-Match") == etag {
+		c.Status(http.StatusNotModified)
+		return
+	}
+
+	c.Data(http.StatusOK, img.ContentType, img.Data)
+}
@@ -0,0 +1,83 @@
+package handlers
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/yourusername/go-enterprise-api/internal/importer"
+	"github.com/yourusername/go-enterprise-api/internal/middleware"
+	"github.com/yourusername/go-enterprise-api/internal/services"
+	"github.com/yourusername/go-enterprise-api/pkg/response"
+	"github.com/yourusername/go-enterprise-api/pkg/validator"
+)
+
+// ImportHandler handles importing content from other platforms
+type ImportHandler struct {
+	importService services.ImportService
+	maxUploadSize int64
+}
+
+// NewImportHandler creates a new import handler
+func NewImportHandler(importService services.ImportService, maxUploadSize int64) *ImportHandler {
+	return &ImportHandler{
+		importService: importService,
+		maxUploadSize: maxUploadSize,
+	}
+}
+
+// Import imports posts, tags and authors from a WordPress or Medium export
+// @Summary Import content
+// @Description Import posts, tags and authors from a WordPress WXR file or Medium export archive and report the outcome of every item (admin only)
+// @Tags admin
+// @Accept multipart/form-data
+// @Produce json
+// @Security BearerAuth
+// @Param file formData file true "WXR (.xml) file or Medium export (.zip) archive"
+// @Param format formData string false "Export format (wxr or medium); detected from the file when omitted"
+// @Success 200 {object} response.Response
+// @Failure 400 {object} response.Response
+// @Failure 401 {object} response.Response
+// @Failure 403 {object} response.Response
+// @Router /admin/import [post]
+func (h *ImportHandler) Import(c *gin.Context) {
+	user := middleware.MustGetUser(c)
+
+	c.Request.Body = http.MaxBytesReader(c.Writer, c.Request.Body, h.maxUploadSize)
+	header, err := c.FormFile("file")
+	if err != nil {
+		var maxBytesErr *http.MaxBytesError
+		if errors.As(err, &maxBytesErr) {
+			response.BadRequest(c, fmt.Sprintf("Import file must be at most %d bytes", h.maxUploadSize))
+			return
+		}
+		response.BadRequest(c, "An export file is required")
+		return
+	}
+
+	format := c.PostForm("format")
+	if format != "" {
+		v := validator.New()
+		v.InSlice("format", format, []string{importer.FormatWXR, importer.FormatMedium}, "")
+		if errs := v.Validate(); errs != nil {
+			response.ValidationError(c, errs)
+			return
+		}
+	}
+
+	file, err := header.Open()
+	if err != nil {
+		response.BadRequest(c, "Failed to read the export file")
+		return
+	}
+	defer file.Close()
+
+	report, err := h.importService.Import(c.Request.Context(), user.ID, header.Filename, format, file, header.Size)
+	if err != nil {
+		response.Error(c, err)
+		return
+	}
+
+	response.SuccessWithMessage(c, "Import completed", report)
+}
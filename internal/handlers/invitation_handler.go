@@ -0,0 +1,134 @@
+package handlers
+
+import (
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"github.com/yourusername/go-enterprise-api/internal/middleware"
+	"github.com/yourusername/go-enterprise-api/internal/models"
+	"github.com/yourusername/go-enterprise-api/internal/services"
+	"github.com/yourusername/go-enterprise-api/pkg/response"
+	"github.com/yourusername/go-enterprise-api/pkg/validator"
+)
+
+// InvitationHandler handles the accounts admins create and their invitations
+type InvitationHandler struct {
+	invitationService services.InvitationService
+}
+
+// NewInvitationHandler creates a new invitation handler
+func NewInvitationHandler(invitationService services.InvitationService) *InvitationHandler {
+	return &InvitationHandler{
+		invitationService: invitationService,
+	}
+}
+
+// SetPasswordRequest represents the set password request body of an
+// invited user
+type SetPasswordRequest struct {
+	Token    string `json:"token" binding:"required"`
+	Password string `json:"password" binding:"required,password"`
+}
+
+// CreateUser creates a user account
+// @Summary Create user
+// @Description Create a user account with a password, or invite its owner by email to choose one (admin only). The password only needs 8 characters, and no minimum age applies.
+// @Tags admin
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param request body services.CreateUserRequest true "User data"
+// @Success 201 {object} response.Response
+// @Failure 400 {object} response.Response
+// @Failure 401 {object} response.Response
+// @Failure 403 {object} response.Response
+// @Failure 409 {object} response.Response
+// @Router /admin/users [post]
+func (h *InvitationHandler) CreateUser(c *gin.Context) {
+	var req services.CreateUserRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		response.BindingError(c, err)
+		return
+	}
+
+	v := validator.New()
+	validator.OneOf(v.When(req.Role != ""), "role", models.UserRole(req.Role), "", models.UserRoles...)
+	validator.OneOf(v.When(req.Status != ""), "status", models.UserStatus(req.Status), "", models.UserStatuses...)
+	v.When(!req.Invite).Required("password", req.Password, "")
+	v.Custom("password", !req.Invite || req.Password == "", "Invited users choose their own password")
+	if errs := v.Validate(); errs != nil {
+		response.ValidationError(c, errs)
+		return
+	}
+
+	admin := middleware.MustGetUser(c)
+	user, err := h.invitationService.CreateUser(c.Request.Context(), admin, &req)
+	if err != nil {
+		response.Error(c, err)
+		return
+	}
+
+	response.Created(c, gin.H{
+		"user":    user.ToResponse(),
+		"invited": req.Invite,
+	})
+}
+
+// Invite emails a user a new link to choose their password
+// @Summary Invite user
+// @Description Email a new set-password link to a user who has never signed in, for instance when their invitation expired (admin only)
+// @Tags admin
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "User ID"
+// @Success 200 {object} response.Response
+// @Failure 400 {object} response.Response
+// @Failure 404 {object} response.Response
+// @Failure 409 {object} response.Response
+// @Router /admin/users/{id}/invite [post]
+func (h *InvitationHandler) Invite(c *gin.Context) {
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		response.BadRequest(c, "Invalid user ID")
+		return
+	}
+
+	admin := middleware.MustGetUser(c)
+	expiresAt, err := h.invitationService.Invite(c.Request.Context(), admin, id)
+	if err != nil {
+		response.Error(c, err)
+		return
+	}
+
+	response.SuccessWithMessage(c, "Invitation sent", gin.H{
+		"expires_at": expiresAt,
+	})
+}
+
+// SetPassword sets the password of an invited user
+// @Summary Set password
+// @Description Choose the password of an account created by an admin, with the token of the emailed invitation. It also verifies the email address. A token stops working once a password is set.
+// @Tags auth
+// @Accept json
+// @Produce json
+// @Param request body SetPasswordRequest true "Invitation token and password"
+// @Success 200 {object} response.Response
+// @Failure 400 {object} response.Response
+// @Failure 401 {object} response.Response
+// @Router /auth/set-password [post]
+func (h *InvitationHandler) SetPassword(c *gin.Context) {
+	var req SetPasswordRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		response.BindingError(c, err)
+		return
+	}
+
+	user, err := h.invitationService.SetPassword(c.Request.Context(), req.Token, req.Password)
+	if err != nil {
+		response.Error(c, err)
+		return
+	}
+
+	response.SuccessWithMessage(c, "Password set; you can now sign in", gin.H{
+		"email": user.Email,
+	})
+}
@@ -0,0 +1,142 @@
+package handlers
+
+import (
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"github.com/yourusername/go-enterprise-api/internal/models"
+	"github.com/yourusername/go-enterprise-api/internal/services"
+	"github.com/yourusername/go-enterprise-api/pkg/response"
+	"github.com/yourusername/go-enterprise-api/pkg/validator"
+)
+
+// JobHandler handles background job administration
+type JobHandler struct {
+	jobService services.JobService
+}
+
+// NewJobHandler creates a new job handler
+func NewJobHandler(jobService services.JobService) *JobHandler {
+	return &JobHandler{
+		jobService: jobService,
+	}
+}
+
+// GetAll returns background jobs
+// @Summary List jobs
+// @Description Get a paginated list of background jobs, newest first, optionally filtered by status and type (admin only)
+// @Tags admin
+// @Produce json
+// @Security BearerAuth
+// @Param status query string false "Job status (pending, running, succeeded or failed)"
+// @Param type query string false "Job type, e.g. webhook.deliver"
+// @Param page query int false "Page number" default(1)
+// @Param page_size query int false "Page size" default(10)
+// @Success 200 {object} response.Response
+// @Failure 400 {object} response.Response
+// @Router /admin/jobs [get]
+func (h *JobHandler) GetAll(c *gin.Context) {
+	page, _ := strconv.Atoi(c.DefaultQuery("page", "1"))
+	pageSize, _ := strconv.Atoi(c.DefaultQuery("page_size", "10"))
+	status := models.JobStatus(c.Query("status"))
+
+	if status != "" && !status.IsValid() {
+		v := validator.New()
+		validator.OneOf(v, "status", status, "", models.JobPending, models.JobRunning, models.JobSucceeded, models.JobFailed)
+		response.ValidationError(c, v.Validate())
+		return
+	}
+
+	list, total, err := h.jobService.GetAll(c.Request.Context(), status, c.Query("type"), page, pageSize)
+	if err != nil {
+		response.Error(c, err)
+		return
+	}
+
+	jobs := make([]*models.JobResponse, len(list))
+	for i := range list {
+		jobs[i] = list[i].ToResponse()
+	}
+
+	response.Paginated(c, jobs, page, pageSize, total)
+}
+
+// Stats returns the number of jobs in each status
+// @Summary Job stats
+// @Description Count background jobs by status (admin only)
+// @Tags admin
+// @Produce json
+// @Security BearerAuth
+// @Success 200 {object} response.Response
+// @Router /admin/jobs/stats [get]
+func (h *JobHandler) Stats(c *gin.Context) {
+	stats, err := h.jobService.GetStats(c.Request.Context())
+	if err != nil {
+		response.Error(c, err)
+		return
+	}
+
+	response.Success(c, gin.H{
+		"stats": stats,
+	})
+}
+
+// GetByID returns a background job
+// @Summary Get job
+// @Description Get a background job with its payload and latest error (admin only)
+// @Tags admin
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "Job ID"
+// @Success 200 {object} response.Response
+// @Failure 400 {object} response.Response
+// @Failure 404 {object} response.Response
+// @Router /admin/jobs/{id} [get]
+func (h *JobHandler) GetByID(c *gin.Context) {
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		response.BadRequest(c, "Invalid job ID")
+		return
+	}
+
+	job, err := h.jobService.GetByID(c.Request.Context(), id)
+	if err != nil {
+		response.Error(c, err)
+		return
+	}
+
+	response.Success(c, gin.H{
+		"job": job.ToResponse(),
+	})
+}
+
+// Retry queues a failed job to run again
+// @Summary Retry job
+// @Description Queue a failed background job to run again with a fresh set of attempts (admin only)
+// @Tags admin
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "Job ID"
+// @Success 200 {object} response.Response
+// @Failure 400 {object} response.Response
+// @Failure 404 {object} response.Response
+// @Failure 409 {object} response.Response
+// @Router /admin/jobs/{id}/retry [post]
+func (h *JobHandler) Retry(c *gin.Context) {
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		response.BadRequest(c, "Invalid job ID")
+		return
+	}
+
+	job, err := h.jobService.Retry(c.Request.Context(), id)
+	if err != nil {
+		response.Error(c, err)
+		return
+	}
+
+	response.SuccessWithMessage(c, "Job queued for retry", gin.H{
+		"job": job.ToResponse(),
+	})
+}
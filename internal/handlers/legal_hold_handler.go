@@ -0,0 +1,230 @@
+package handlers
+
+import (
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"github.com/yourusername/go-enterprise-api/internal/middleware"
+	"github.com/yourusername/go-enterprise-api/internal/services"
+	"github.com/yourusername/go-enterprise-api/pkg/response"
+)
+
+// LegalHoldHandler handles legal holds on users and posts
+type LegalHoldHandler struct {
+	legalHoldService services.LegalHoldService
+}
+
+// NewLegalHoldHandler creates a new legal hold handler
+func NewLegalHoldHandler(legalHoldService services.LegalHoldService) *LegalHoldHandler {
+	return &LegalHoldHandler{
+		legalHoldService: legalHoldService,
+	}
+}
+
+// PlaceLegalHoldRequest represents a request to place a legal hold
+type PlaceLegalHoldRequest struct {
+	Reason string `json:"reason" binding:"notblank,max=500" sanitize:"text"`
+}
+
+// bindReason binds and validates the reason of a place hold request
+func bindReason(c *gin.Context) (string, bool) {
+	var req PlaceLegalHoldRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		response.BindingError(c, err)
+		return "", false
+	}
+	return req.Reason, true
+}
+
+// GetUserHold returns the legal hold status of a user
+// @Summary Get user legal hold
+// @Description Get the legal hold status of a user (admin only)
+// @Tags admin
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "User ID"
+// @Success 200 {object} response.Response
+// @Failure 400 {object} response.Response
+// @Failure 404 {object} response.Response
+// @Router /admin/legal-holds/users/{id} [get]
+func (h *LegalHoldHandler) GetUserHold(c *gin.Context) {
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		response.BadRequest(c, "Invalid user ID")
+		return
+	}
+
+	hold, err := h.legalHoldService.GetUserHold(c.Request.Context(), id)
+	if err != nil {
+		response.Error(c, err)
+		return
+	}
+
+	response.Success(c, gin.H{
+		"legal_hold": hold,
+	})
+}
+
+// PlaceUserHold places a user under legal hold
+// @Summary Place user legal hold
+// @Description Prevent a user from being deleted or anonymized until the hold is lifted (admin only)
+// @Tags admin
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "User ID"
+// @Param request body PlaceLegalHoldRequest true "Reason"
+// @Success 200 {object} response.Response
+// @Failure 400 {object} response.Response
+// @Failure 404 {object} response.Response
+// @Failure 409 {object} response.Response
+// @Router /admin/legal-holds/users/{id} [put]
+func (h *LegalHoldHandler) PlaceUserHold(c *gin.Context) {
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		response.BadRequest(c, "Invalid user ID")
+		return
+	}
+
+	reason, ok := bindReason(c)
+	if !ok {
+		return
+	}
+
+	currentUser := middleware.MustGetUser(c)
+
+	hold, err := h.legalHoldService.PlaceUserHold(c.Request.Context(), id, currentUser.ID, reason)
+	if err != nil {
+		response.Error(c, err)
+		return
+	}
+
+	response.SuccessWithMessage(c, "Legal hold placed", gin.H{
+		"legal_hold": hold,
+	})
+}
+
+// ReleaseUserHold lifts a user's legal hold
+// @Summary Release user legal hold
+// @Description Lift a user's legal hold (admin only)
+// @Tags admin
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "User ID"
+// @Success 204
+// @Failure 400 {object} response.Response
+// @Failure 404 {object} response.Response
+// @Failure 409 {object} response.Response
+// @Router /admin/legal-holds/users/{id} [delete]
+func (h *LegalHoldHandler) ReleaseUserHold(c *gin.Context) {
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		response.BadRequest(c, "Invalid user ID")
+		return
+	}
+
+	currentUser := middleware.MustGetUser(c)
+
+	if err := h.legalHoldService.ReleaseUserHold(c.Request.Context(), id, currentUser.ID); err != nil {
+		response.Error(c, err)
+		return
+	}
+
+	response.NoContent(c)
+}
+
+// GetPostHold returns the legal hold status of a post
+// @Summary Get post legal hold
+// @Description Get the legal hold status of a post (admin only)
+// @Tags admin
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "Post ID"
+// @Success 200 {object} response.Response
+// @Failure 400 {object} response.Response
+// @Failure 404 {object} response.Response
+// @Router /admin/legal-holds/posts/{id} [get]
+func (h *LegalHoldHandler) GetPostHold(c *gin.Context) {
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		response.BadRequest(c, "Invalid post ID")
+		return
+	}
+
+	hold, err := h.legalHoldService.GetPostHold(c.Request.Context(), id)
+	if err != nil {
+		response.Error(c, err)
+		return
+	}
+
+	response.Success(c, gin.H{
+		"legal_hold": hold,
+	})
+}
+
+// PlacePostHold places a post under legal hold
+// @Summary Place post legal hold
+// @Description Prevent a post from being deleted until the hold is lifted (admin only)
+// @Tags admin
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "Post ID"
+// @Param request body PlaceLegalHoldRequest true "Reason"
+// @Success 200 {object} response.Response
+// @Failure 400 {object} response.Response
+// @Failure 404 {object} response.Response
+// @Failure 409 {object} response.Response
+// @Router /admin/legal-holds/posts/{id} [put]
+func (h *LegalHoldHandler) PlacePostHold(c *gin.Context) {
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		response.BadRequest(c, "Invalid post ID")
+		return
+	}
+
+	reason, ok := bindReason(c)
+	if !ok {
+		return
+	}
+
+	currentUser := middleware.MustGetUser(c)
+
+	hold, err := h.legalHoldService.PlacePostHold(c.Request.Context(), id, currentUser.ID, reason)
+	if err != nil {
+		response.Error(c, err)
+		return
+	}
+
+	response.SuccessWithMessage(c, "Legal hold placed", gin.H{
+		"legal_hold": hold,
+	})
+}
+
+// ReleasePostHold lifts a post's legal hold
+// @Summary Release post legal hold
+// @Description Lift a post's legal hold (admin only)
+// @Tags admin
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "Post ID"
+// @Success 204
+// @Failure 400 {object} response.Response
+// @Failure 404 {object} response.Response
+// @Failure 409 {object} response.Response
+// @Router /admin/legal-holds/posts/{id} [delete]
+func (h *LegalHoldHandler) ReleasePostHold(c *gin.Context) {
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		response.BadRequest(c, "Invalid post ID")
+		return
+	}
+
+	currentUser := middleware.MustGetUser(c)
+
+	if err := h.legalHoldService.ReleasePostHold(c.Request.Context(), id, currentUser.ID); err != nil {
+		response.Error(c, err)
+		return
+	}
+
+	response.NoContent(c)
+}
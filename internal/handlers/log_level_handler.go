@@ -0,0 +1,81 @@
+package handlers
+
+import (
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/yourusername/go-enterprise-api/internal/middleware"
+	"github.com/yourusername/go-enterprise-api/pkg/logger"
+	"github.com/yourusername/go-enterprise-api/pkg/response"
+	"github.com/yourusername/go-enterprise-api/pkg/validator"
+)
+
+// LogLevelHandler handles changing the log level at runtime
+type LogLevelHandler struct{}
+
+// NewLogLevelHandler creates a new log level handler
+func NewLogLevelHandler() *LogLevelHandler {
+	return &LogLevelHandler{}
+}
+
+// SetLogLevelRequest represents a request to change the log level
+type SetLogLevelRequest struct {
+	Level string `json:"level" binding:"required"`
+	// RevertAfterMinutes reverts the level to the configured one after that
+	// many minutes; 0 keeps it until changed again
+	RevertAfterMinutes int `json:"revert_after_minutes" binding:"min=0"`
+}
+
+// Get returns the current log level
+// @Summary Get log level
+// @Description Get the current log level of this instance, the configured level and when a temporary level reverts (admin only)
+// @Tags admin
+// @Produce json
+// @Security BearerAuth
+// @Success 200 {object} response.Response
+// @Router /admin/log-level [get]
+func (h *LogLevelHandler) Get(c *gin.Context) {
+	response.Success(c, logger.CurrentLevel())
+}
+
+// Set changes the log level
+// @Summary Set log level
+// @Description Change the log level of this instance without a restart, optionally reverting to the configured level after a while (admin only)
+// @Tags admin
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param request body SetLogLevelRequest true "Log level"
+// @Success 200 {object} response.Response
+// @Failure 400 {object} response.Response
+// @Router /admin/log-level [put]
+func (h *LogLevelHandler) Set(c *gin.Context) {
+	var req SetLogLevelRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		response.BindingError(c, err)
+		return
+	}
+
+	v := validator.New()
+	v.InSlice("level", req.Level, logger.Levels, "")
+	if errs := v.Validate(); errs != nil {
+		response.ValidationError(c, errs)
+		return
+	}
+
+	status, err := logger.SetLevel(req.Level, time.Duration(req.RevertAfterMinutes)*time.Minute)
+	if err != nil {
+		response.BadRequest(c, err.Error())
+		return
+	}
+
+	// Logged as a warning so the change shows at any level
+	user := middleware.MustGetUser(c)
+	logger.Warn("Log level changed",
+		logger.String("log_level", status.Level),
+		logger.Int("revert_after_minutes", req.RevertAfterMinutes),
+		logger.String("admin_id", user.ID.String()),
+	)
+
+	response.SuccessWithMessage(c, "Log level changed", status)
+}
@@ -0,0 +1,412 @@
+package handlers
+
+import (
+	"errors"
+	"fmt"
+	"mime"
+	"net/http"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"github.com/yourusername/go-enterprise-api/internal/middleware"
+	"github.com/yourusername/go-enterprise-api/internal/models"
+	"github.com/yourusername/go-enterprise-api/internal/services"
+	"github.com/yourusername/go-enterprise-api/pkg/response"
+	"github.com/yourusername/go-enterprise-api/pkg/validator"
+)
+
+// multipartOverhead is allowed on top of the maximum file size for the rest
+// of a multipart upload request
+const multipartOverhead = 64 << 10
+
+// uploadOffsetHeader carries the offset of a chunk of a chunked upload in
+// requests, and the number of bytes received in responses
+const uploadOffsetHeader = "Upload-Offset"
+
+// MediaHandler handles media library requests
+type MediaHandler struct {
+	mediaService  services.MediaService
+	uploadService services.MediaUploadService
+	maxSize       int64
+	chunkSize     int64
+}
+
+// NewMediaHandler creates a new media handler
+func NewMediaHandler(mediaService services.MediaService, uploadService services.MediaUploadService, maxSize, chunkSize int64) *MediaHandler {
+	return &MediaHandler{
+		mediaService:  mediaService,
+		uploadService: uploadService,
+		maxSize:       maxSize,
+		chunkSize:     chunkSize,
+	}
+}
+
+// Upload uploads a file to the current user's media library
+// @Summary Upload media
+// @Description Upload a file to your media library. The file type is detected from its content and must be one of the allowed types. Private files are only served to you and through signed URLs, which the response includes.
+// @Tags media
+// @Accept multipart/form-data
+// @Produce json
+// @Security BearerAuth
+// @Param file formData file true "File to upload"
+// @Param private formData bool false "Keep the file private" default(false)
+// @Success 201 {object} response.Response
+// @Failure 400 {object} response.Response
+// @Failure 401 {object} response.Response
+// @Router /media [post]
+func (h *MediaHandler) Upload(c *gin.Context) {
+	c.Request.Body = http.MaxBytesReader(c.Writer, c.Request.Body, h.maxSize+multipartOverhead)
+	header, err := c.FormFile("file")
+	if err != nil {
+		var maxBytesErr *http.MaxBytesError
+		if errors.As(err, &maxBytesErr) {
+			response.BadRequest(c, fmt.Sprintf("File must be at most %d bytes", h.maxSize))
+			return
+		}
+		response.BadRequest(c, "A file is required")
+		return
+	}
+	if header.Size > h.maxSize {
+		response.BadRequest(c, fmt.Sprintf("File must be at most %d bytes", h.maxSize))
+		return
+	}
+
+	private := false
+	if value := c.PostForm("private"); value != "" {
+		private, err = strconv.ParseBool(value)
+		if err != nil {
+			response.BadRequest(c, "Invalid private value")
+			return
+		}
+	}
+
+	file, err := header.Open()
+	if err != nil {
+		response.BadRequest(c, "Failed to read the uploaded file")
+		return
+	}
+	defer file.Close()
+
+	user := middleware.MustGetUser(c)
+	media, err := h.mediaService.Upload(c.Request.Context(), user.ID, header.Filename, file, private)
+	if err != nil {
+		response.Error(c, err)
+		return
+	}
+
+	response.Created(c, h.mediaResponse(media))
+}
+
+// GetMine returns the current user's media library
+// @Summary List my media
+// @Description Get a paginated list of the files in your media library, newest first. URLs of private files are signed and expire.
+// @Tags media
+// @Produce json
+// @Security BearerAuth
+// @Param page query int false "Page number" default(1)
+// @Param page_size query int false "Page size" default(10)
+// @Success 200 {object} response.Response
+// @Failure 401 {object} response.Response
+// @Router /media [get]
+func (h *MediaHandler) GetMine(c *gin.Context) {
+	page, _ := strconv.Atoi(c.DefaultQuery("page", "1"))
+	pageSize, _ := strconv.Atoi(c.DefaultQuery("page_size", "10"))
+
+	user := middleware.MustGetUser(c)
+	media, total, err := h.mediaService.GetByUser(c.Request.Context(), user.ID, page, pageSize)
+	if err != nil {
+		response.Error(c, err)
+		return
+	}
+
+	mediaResponses := make([]*models.MediaResponse, len(media))
+	for i := range media {
+		mediaResponses[i] = h.mediaResponse(&media[i])
+	}
+
+	response.Paginated(c, mediaResponses, page, pageSize, total)
+}
+
+// GetByID returns media
+// @Summary Get media
+// @Description Get a public file, or a private file of your own (admins see all), with the URL it is served at
+// @Tags media
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "Media ID"
+// @Success 200 {object} response.Response
+// @Failure 400 {object} response.Response
+// @Failure 401 {object} response.Response
+// @Failure 404 {object} response.Response
+// @Router /media/{id} [get]
+func (h *MediaHandler) GetByID(c *gin.Context) {
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		response.BadRequest(c, "Invalid media ID")
+		return
+	}
+
+	user := middleware.MustGetUser(c)
+	media, err := h.mediaService.GetByID(c.Request.Context(), id, user)
+	if err != nil {
+		response.Error(c, err)
+		return
+	}
+
+	response.Success(c, h.mediaResponse(media))
+}
+
+// Delete deletes media
+// @Summary Delete media
+// @Description Delete a file from your media library (owner or admin). Posts and profiles still linking to it will show a broken image.
+// @Tags media
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "Media ID"
+// @Success 204
+// @Failure 400 {object} response.Response
+// @Failure 401 {object} response.Response
+// @Failure 403 {object} response.Response
+// @Failure 404 {object} response.Response
+// @Router /media/{id} [delete]
+func (h *MediaHandler) Delete(c *gin.Context) {
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		response.BadRequest(c, "Invalid media ID")
+		return
+	}
+
+	user := middleware.MustGetUser(c)
+	if err := h.mediaService.Delete(c.Request.Context(), id, user); err != nil {
+		response.Error(c, err)
+		return
+	}
+
+	response.NoContent(c)
+}
+
+// Serve serves a media file
+// @Summary Get media file
+// @Description Serve the content of a file, or of one of the variants of an image. Public files are served to anyone; private files only to their owner or with the expires and signature of a signed URL.
+// @Tags media
+// @Produce octet-stream
+// @Param id path string true "Media ID"
+// @Param variant query string false "Image variant (thumbnail, medium or large)"
+// @Param expires query int false "Expiry time of a signed URL (Unix seconds)"
+// @Param signature query string false "Signature of a signed URL"
+// @Success 200 {file} binary
+// @Failure 400 {object} response.Response
+// @Failure 403 {object} response.Response
+// @Failure 404 {object} response.Response
+// @Router /media/{id}/file [get]
+func (h *MediaHandler) Serve(c *gin.Context) {
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		response.BadRequest(c, "Invalid media ID")
+		return
+	}
+
+	var viewer *models.User
+	if user, exists := middleware.GetUser(c); exists {
+		viewer = user
+	}
+
+	variant := models.MediaVariant(c.Query("variant"))
+	if variant != "" {
+		v := validator.New()
+		validator.OneOf(v, "variant", variant, "", models.MediaVariants...)
+		if errs := v.Validate(); errs != nil {
+			response.ValidationError(c, errs)
+			return
+		}
+	}
+
+	media, data, contentType, err := h.mediaService.Open(c.Request.Context(), id, viewer, variant, c.Query("expires"), c.Query("signature"))
+	if err != nil {
+		response.Error(c, err)
+		return
+	}
+
+	if media.Private {
+		c.Header("Cache-Control", "private, no-store")
+	} else {
+		c.Header("Cache-Control", "public, max-age=86400")
+	}
+	c.Header("X-Content-Type-Options", "nosniff")
+	c.Header("Content-Disposition", mime.FormatMediaType("inline", map[string]string{"filename": media.FileName}))
+	c.Data(http.StatusOK, contentType, data)
+}
+
+// CreateUpload starts a chunked upload
+// @Summary Start a chunked upload
+// @Description Start uploading a file of the given size to your media library in chunks, so that an interrupted upload can be resumed instead of restarted. Send the chunks in order with PATCH, then complete the upload. Uploads that receive no chunk for a while are abandoned and deleted.
+// @Tags media
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param request body services.CreateMediaUploadRequest true "File name, size in bytes and privacy of the file"
+// @Success 201 {object} response.Response
+// @Failure 400 {object} response.Response
+// @Failure 401 {object} response.Response
+// @Failure 429 {object} response.Response
+// @Router /media/uploads [post]
+func (h *MediaHandler) CreateUpload(c *gin.Context) {
+	var req services.CreateMediaUploadRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		response.BindingError(c, err)
+		return
+	}
+
+	user := middleware.MustGetUser(c)
+	upload, err := h.uploadService.Create(c.Request.Context(), user.ID, &req)
+	if err != nil {
+		response.Error(c, err)
+		return
+	}
+
+	c.Header(uploadOffsetHeader, strconv.FormatInt(upload.Received, 10))
+	response.Created(c, upload)
+}
+
+// GetUpload returns a chunked upload
+// @Summary Get a chunked upload
+// @Description Get one of your chunked uploads. Its offset, also in the Upload-Offset header, is the number of bytes received so far and where to resume.
+// @Tags media
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "Upload ID"
+// @Success 200 {object} response.Response
+// @Failure 400 {object} response.Response
+// @Failure 401 {object} response.Response
+// @Failure 404 {object} response.Response
+// @Router /media/uploads/{id} [get]
+func (h *MediaHandler) GetUpload(c *gin.Context) {
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		response.BadRequest(c, "Invalid upload ID")
+		return
+	}
+
+	user := middleware.MustGetUser(c)
+	upload, err := h.uploadService.GetByID(c.Request.Context(), id, user)
+	if err != nil {
+		response.Error(c, err)
+		return
+	}
+
+	c.Header(uploadOffsetHeader, strconv.FormatInt(upload.Received, 10))
+	response.Success(c, upload)
+}
+
+// AppendUpload appends a chunk to a chunked upload
+// @Summary Upload a chunk
+// @Description Append the request body to one of your chunked uploads. The Upload-Offset header must be the upload's current offset; a chunk at any other offset is rejected with 409, for example when resending a chunk whose response was lost. The response has the new offset.
+// @Tags media
+// @Accept octet-stream
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "Upload ID"
+// @Param Upload-Offset header int true "Offset of the chunk in the file"
+// @Success 200 {object} response.Response
+// @Failure 400 {object} response.Response
+// @Failure 401 {object} response.Response
+// @Failure 404 {object} response.Response
+// @Failure 409 {object} response.Response
+// @Router /media/uploads/{id} [patch]
+func (h *MediaHandler) AppendUpload(c *gin.Context) {
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		response.BadRequest(c, "Invalid upload ID")
+		return
+	}
+
+	offset, err := strconv.ParseInt(c.GetHeader(uploadOffsetHeader), 10, 64)
+	if err != nil || offset < 0 {
+		response.BadRequest(c, "The Upload-Offset header must be the offset of the chunk")
+		return
+	}
+
+	// One byte more than a chunk may have is read, so the service sees the
+	// chunk is too large and reports it
+	user := middleware.MustGetUser(c)
+	body := http.MaxBytesReader(c.Writer, c.Request.Body, h.chunkSize+1)
+	upload, err := h.uploadService.Append(c.Request.Context(), id, user, offset, body)
+	if err != nil {
+		response.Error(c, err)
+		return
+	}
+
+	c.Header(uploadOffsetHeader, strconv.FormatInt(upload.Received, 10))
+	response.Success(c, upload)
+}
+
+// CompleteUpload completes a chunked upload
+// @Summary Complete a chunked upload
+// @Description Add a fully received chunked upload to your media library, with the same checks as a direct upload. The upload is finished either way; a rejected file has to be uploaded again.
+// @Tags media
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "Upload ID"
+// @Success 201 {object} response.Response
+// @Failure 400 {object} response.Response
+// @Failure 401 {object} response.Response
+// @Failure 404 {object} response.Response
+// @Router /media/uploads/{id}/complete [post]
+func (h *MediaHandler) CompleteUpload(c *gin.Context) {
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		response.BadRequest(c, "Invalid upload ID")
+		return
+	}
+
+	user := middleware.MustGetUser(c)
+	media, err := h.uploadService.Complete(c.Request.Context(), id, user)
+	if err != nil {
+		response.Error(c, err)
+		return
+	}
+
+	response.Created(c, h.mediaResponse(media))
+}
+
+// AbortUpload cancels a chunked upload
+// @Summary Cancel a chunked upload
+// @Description Cancel one of your chunked uploads and delete the chunks received
+// @Tags media
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "Upload ID"
+// @Success 204
+// @Failure 400 {object} response.Response
+// @Failure 401 {object} response.Response
+// @Failure 404 {object} response.Response
+// @Router /media/uploads/{id} [delete]
+func (h *MediaHandler) AbortUpload(c *gin.Context) {
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		response.BadRequest(c, "Invalid upload ID")
+		return
+	}
+
+	user := middleware.MustGetUser(c)
+	if err := h.uploadService.Abort(c.Request.Context(), id, user); err != nil {
+		response.Error(c, err)
+		return
+	}
+
+	response.NoContent(c)
+}
+
+// mediaResponse converts media to its response, with the URLs of the file
+// and of its variants
+func (h *MediaHandler) mediaResponse(media *models.Media) *models.MediaResponse {
+	var variants map[models.MediaVariant]string
+	if list := media.VariantList(); len(list) > 0 {
+		variants = make(map[models.MediaVariant]string, len(list))
+		for _, variant := range list {
+			variants[variant] = h.mediaService.URL(media, variant)
+		}
+	}
+	return media.ToResponse(h.mediaService.URL(media, ""), variants)
+}
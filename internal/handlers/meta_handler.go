@@ -0,0 +1,124 @@
+package handlers
+
+import (
+	"github.com/gin-gonic/gin"
+	"github.com/yourusername/go-enterprise-api/internal/config"
+	"github.com/yourusername/go-enterprise-api/internal/database"
+	"github.com/yourusername/go-enterprise-api/internal/middleware"
+	apperrors "github.com/yourusername/go-enterprise-api/pkg/errors"
+	"github.com/yourusername/go-enterprise-api/pkg/i18n"
+	"github.com/yourusername/go-enterprise-api/pkg/response"
+	"github.com/yourusername/go-enterprise-api/pkg/version"
+)
+
+// MetaHandler exposes API capabilities so clients can adapt dynamically
+type MetaHandler struct {
+	cfg      *config.Config
+	readOnly *middleware.ReadOnlyMode
+}
+
+// NewMetaHandler creates a new meta handler
+func NewMetaHandler(cfg *config.Config, readOnly *middleware.ReadOnlyMode) *MetaHandler {
+	return &MetaHandler{
+		cfg:      cfg,
+		readOnly: readOnly,
+	}
+}
+
+// MetaResponse represents the API metadata response
+type MetaResponse struct {
+	Name        string          `json:"name"`
+	Version     string          `json:"version"`
+	APIVersion  string          `json:"api_version"`
+	APIVersions []string        `json:"api_versions"`
+	Commit      string          `json:"commit"`
+	BuildTime   string          `json:"build_time"`
+	ReadOnly    bool            `json:"read_only"`
+	Features    map[string]bool `json:"features"`
+	Limits      MetaLimits      `json:"limits"`
+}
+
+// MetaLimits describes request limits enforced by the API
+type MetaLimits struct {
+	DefaultPageSize   int    `json:"default_page_size"`
+	MaxPageSize       int    `json:"max_page_size"`
+	MaxUploadBytes    int64  `json:"max_upload_bytes"`
+	RateLimitRequests int    `json:"rate_limit_requests"`
+	RateLimitWindow   string `json:"rate_limit_window"`
+}
+
+// Meta returns API version, build information, features and limits
+// @Summary API metadata
+// @Description Get the API version of the request, the versions served, build commit, supported features and limits
+// @Tags meta
+// @Accept json
+// @Produce json
+// @Success 200 {object} response.Response
+// @Router /meta [get]
+func (h *MetaHandler) Meta(c *gin.Context) {
+	features := map[string]bool{
+		"external_search":  h.cfg.Search.Driver != "" && h.cfg.Search.Driver != "database",
+		"service_accounts": true,
+		"change_tracking":  true,
+		"bulk_undo":        true,
+		"image_proxy":      h.cfg.ImageProxy.Enabled,
+	}
+	for _, flag := range h.cfg.Features.Enabled {
+		features[flag] = true
+	}
+
+	response.Success(c, MetaResponse{
+		Name:        h.cfg.App.Name,
+		Version:     version.Version,
+		APIVersion:  middleware.GetAPIVersion(c),
+		APIVersions: h.apiVersions(),
+		Commit:      version.Commit,
+		BuildTime:   version.BuildTime,
+		ReadOnly:    h.readOnly.Enabled(),
+		Features:    features,
+		Limits: MetaLimits{
+			DefaultPageSize:   database.DefaultPageSize,
+			MaxPageSize:       database.MaxPageSize,
+			MaxUploadBytes:    h.cfg.App.MaxUploadSize,
+			RateLimitRequests: h.cfg.RateLimit.Requests,
+			RateLimitWindow:   h.cfg.RateLimit.Duration.String(),
+		},
+	})
+}
+
+// apiVersions lists the API versions served, oldest first
+func (h *MetaHandler) apiVersions() []string {
+	versions := []string{version.APIVersion}
+	if h.cfg.API.V2Enabled {
+		versions = append(versions, version.APIVersionNext)
+	}
+	return versions
+}
+
+// ErrorCatalogEntry describes an error code, with its message in every
+// supported language
+type ErrorCatalogEntry struct {
+	apperrors.CatalogEntry
+	Translations map[string]string `json:"translations"`
+}
+
+// Errors returns the catalog of error codes
+// @Summary Error catalog
+// @Description List the error codes the API returns, with their HTTP status and message in every supported language
+// @Tags meta
+// @Accept json
+// @Produce json
+// @Success 200 {object} response.Response
+// @Router /errors [get]
+func (h *MetaHandler) Errors(c *gin.Context) {
+	catalog := apperrors.Catalog()
+	entries := make([]ErrorCatalogEntry, len(catalog))
+	for i, entry := range catalog {
+		translations := make(map[string]string)
+		for _, lang := range i18n.Languages() {
+			translations[lang] = i18n.T(lang, entry.Message)
+		}
+		entries[i] = ErrorCatalogEntry{CatalogEntry: entry, Translations: translations}
+	}
+	response.Success(c, entries)
+}
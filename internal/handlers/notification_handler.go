@@ -0,0 +1,111 @@
+package handlers
+
+import (
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"github.com/yourusername/go-enterprise-api/internal/middleware"
+	"github.com/yourusername/go-enterprise-api/internal/models"
+	"github.com/yourusername/go-enterprise-api/internal/services"
+	"github.com/yourusername/go-enterprise-api/pkg/response"
+)
+
+// NotificationHandler handles notification requests
+type NotificationHandler struct {
+	notificationService services.NotificationService
+}
+
+// NewNotificationHandler creates a new notification handler
+func NewNotificationHandler(notificationService services.NotificationService) *NotificationHandler {
+	return &NotificationHandler{
+		notificationService: notificationService,
+	}
+}
+
+// GetAll returns the current user's notifications
+// @Summary Get notifications
+// @Description Get a paginated list of the current user's notifications, newest first, with the number of unread notifications
+// @Tags notifications
+// @Produce json
+// @Security BearerAuth
+// @Param unread query bool false "Only unread notifications"
+// @Param page query int false "Page number" default(1)
+// @Param page_size query int false "Page size" default(10)
+// @Success 200 {object} response.Response
+// @Failure 401 {object} response.Response
+// @Router /notifications [get]
+func (h *NotificationHandler) GetAll(c *gin.Context) {
+	page, _ := strconv.Atoi(c.DefaultQuery("page", "1"))
+	pageSize, _ := strconv.Atoi(c.DefaultQuery("page_size", "10"))
+	unreadOnly, _ := strconv.ParseBool(c.Query("unread"))
+
+	user := middleware.MustGetUser(c)
+
+	notifications, total, unread, err := h.notificationService.GetByUser(c.Request.Context(), user.ID, unreadOnly, page, pageSize)
+	if err != nil {
+		response.Error(c, err)
+		return
+	}
+
+	notificationResponses := make([]*models.NotificationResponse, len(notifications))
+	for i := range notifications {
+		notificationResponses[i] = notifications[i].ToResponseFor(user)
+	}
+
+	response.Paginated(c, gin.H{
+		"notifications": notificationResponses,
+		"unread_count":  unread,
+	}, page, pageSize, total)
+}
+
+// MarkRead marks a notification as read
+// @Summary Mark notification as read
+// @Description Mark one of the current user's notifications as read
+// @Tags notifications
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "Notification ID"
+// @Success 204
+// @Failure 400 {object} response.Response
+// @Failure 401 {object} response.Response
+// @Failure 404 {object} response.Response
+// @Router /notifications/{id}/read [post]
+func (h *NotificationHandler) MarkRead(c *gin.Context) {
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		response.BadRequest(c, "Invalid notification ID")
+		return
+	}
+
+	user := middleware.MustGetUser(c)
+	if err := h.notificationService.MarkRead(c.Request.Context(), id, user.ID); err != nil {
+		response.Error(c, err)
+		return
+	}
+
+	response.NoContent(c)
+}
+
+// MarkAllRead marks all notifications as read
+// @Summary Mark all notifications as read
+// @Description Mark all of the current user's notifications as read
+// @Tags notifications
+// @Produce json
+// @Security BearerAuth
+// @Success 200 {object} response.Response
+// @Failure 401 {object} response.Response
+// @Router /notifications/read-all [post]
+func (h *NotificationHandler) MarkAllRead(c *gin.Context) {
+	user := middleware.MustGetUser(c)
+
+	marked, err := h.notificationService.MarkAllRead(c.Request.Context(), user.ID)
+	if err != nil {
+		response.Error(c, err)
+		return
+	}
+
+	response.Success(c, gin.H{
+		"marked": marked,
+	})
+}
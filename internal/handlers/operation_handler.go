@@ -0,0 +1,245 @@
+package handlers
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"github.com/yourusername/go-enterprise-api/internal/middleware"
+	"github.com/yourusername/go-enterprise-api/internal/models"
+	"github.com/yourusername/go-enterprise-api/internal/services"
+	"github.com/yourusername/go-enterprise-api/pkg/response"
+	"github.com/yourusername/go-enterprise-api/pkg/validator"
+)
+
+// OperationHandler handles bulk admin operations and their undo
+type OperationHandler struct {
+	operationService services.OperationService
+}
+
+// NewOperationHandler creates a new operation handler
+func NewOperationHandler(operationService services.OperationService) *OperationHandler {
+	return &OperationHandler{
+		operationService: operationService,
+	}
+}
+
+// BulkIDsRequest represents a request targeting several records
+type BulkIDsRequest struct {
+	IDs []uuid.UUID `json:"ids" binding:"required"`
+}
+
+// BulkRoleRequest represents a bulk role change request
+type BulkRoleRequest struct {
+	IDs  []uuid.UUID `json:"ids" binding:"required"`
+	Role string      `json:"role" binding:"required"`
+}
+
+// BulkDeleteUsers soft deletes several users
+// @Summary Bulk delete users
+// @Description Soft delete several users; the operation can be undone within the retention window (admin only)
+// @Tags admin
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param request body BulkIDsRequest true "User IDs"
+// @Success 200 {object} response.Response
+// @Failure 400 {object} response.Response
+// @Failure 401 {object} response.Response
+// @Failure 403 {object} response.Response
+// @Router /admin/users/bulk-delete [post]
+func (h *OperationHandler) BulkDeleteUsers(c *gin.Context) {
+	var req BulkIDsRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		response.BindingError(c, err)
+		return
+	}
+
+	currentUser := middleware.MustGetUser(c)
+
+	operation, err := h.operationService.BulkDeleteUsers(c.Request.Context(), currentUser.ID, req.IDs)
+	if err != nil {
+		response.Error(c, err)
+		return
+	}
+
+	response.SuccessWithMessage(c, "Users deleted successfully", gin.H{
+		"operation": operation.ToResponse(),
+	})
+}
+
+// BulkUpdateRole changes the role of several users
+// @Summary Bulk update user role
+// @Description Change the role of several users; the operation can be undone within the retention window (admin only)
+// @Tags admin
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param request body BulkRoleRequest true "User IDs and role"
+// @Success 200 {object} response.Response
+// @Failure 400 {object} response.Response
+// @Failure 401 {object} response.Response
+// @Failure 403 {object} response.Response
+// @Router /admin/users/bulk-role [post]
+func (h *OperationHandler) BulkUpdateRole(c *gin.Context) {
+	var req BulkRoleRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		response.BindingError(c, err)
+		return
+	}
+
+	// Validate role
+	validRoles := []string{string(models.RoleUser), string(models.RoleAdmin), string(models.RoleModerator)}
+	isValid := false
+	for _, r := range validRoles {
+		if req.Role == r {
+			isValid = true
+			break
+		}
+	}
+	if !isValid {
+		response.BadRequest(c, "Invalid role value")
+		return
+	}
+
+	currentUser := middleware.MustGetUser(c)
+
+	operation, err := h.operationService.BulkUpdateRole(c.Request.Context(), currentUser.ID, req.IDs, models.UserRole(req.Role))
+	if err != nil {
+		response.Error(c, err)
+		return
+	}
+
+	response.SuccessWithMessage(c, "Roles updated successfully", gin.H{
+		"operation": operation.ToResponse(),
+	})
+}
+
+// BulkUpdateUsers applies an action to several users
+// @Summary Bulk update users
+// @Description Set the status (set_status with status) or role (set_role with role) of several users, or delete them (delete). Users that cannot be changed are reported as failed while the others are changed; the results list the outcome for each ID. The operation can be undone within the retention window (admin only).
+// @Tags admin
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param request body services.BulkUsersRequest true "Action and user IDs"
+// @Success 200 {object} response.Response
+// @Failure 400 {object} response.Response
+// @Failure 401 {object} response.Response
+// @Failure 403 {object} response.Response
+// @Router /admin/users/bulk [post]
+func (h *OperationHandler) BulkUpdateUsers(c *gin.Context) {
+	var req services.BulkUsersRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		response.BindingError(c, err)
+		return
+	}
+
+	action := services.BulkUserAction(req.Action)
+	v := validator.New()
+	validator.OneOf(v, "action", action, "", services.BulkUserActions...)
+	validator.Max(v, "ids", len(req.IDs), services.MaxBulkItems, fmt.Sprintf("ids must list at most %d users", services.MaxBulkItems))
+	v.RequiredIf("status", req.Status, "action", req.Action, string(services.BulkUserSetStatus), "")
+	v.RequiredIf("role", req.Role, "action", req.Action, string(services.BulkUserSetRole), "")
+	validator.OneOf(v.When(action == services.BulkUserSetStatus && req.Status != ""), "status", models.UserStatus(req.Status), "", models.UserStatuses...)
+	validator.OneOf(v.When(action == services.BulkUserSetRole && req.Role != ""), "role", models.UserRole(req.Role), "", models.UserRoles...)
+	if errs := v.Validate(); errs != nil {
+		response.ValidationError(c, errs)
+		return
+	}
+
+	currentUser := middleware.MustGetUser(c)
+
+	result, err := h.operationService.BulkUpdateUsers(c.Request.Context(), currentUser.ID, &req)
+	if err != nil {
+		response.Error(c, err)
+		return
+	}
+
+	counts := map[string]int{
+		services.BulkItemApplied:   0,
+		services.BulkItemUnchanged: 0,
+		services.BulkItemFailed:    0,
+	}
+	for _, item := range result.Items {
+		counts[item.Result]++
+	}
+
+	var operation *models.AdminOperationResponse
+	if result.Operation != nil {
+		operation = result.Operation.ToResponse()
+	}
+
+	response.SuccessWithMessage(c, "Bulk operation applied", gin.H{
+		"operation": operation,
+		"results":   result.Items,
+		"counts":    counts,
+	})
+}
+
+// GetAll returns recent bulk operations
+// @Summary List admin operations
+// @Description Get a paginated list of bulk admin operations (admin only)
+// @Tags admin
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param page query int false "Page number" default(1)
+// @Param page_size query int false "Page size" default(10)
+// @Success 200 {object} response.Response
+// @Failure 401 {object} response.Response
+// @Failure 403 {object} response.Response
+// @Router /admin/operations [get]
+func (h *OperationHandler) GetAll(c *gin.Context) {
+	page, _ := strconv.Atoi(c.DefaultQuery("page", "1"))
+	pageSize, _ := strconv.Atoi(c.DefaultQuery("page_size", "10"))
+
+	operations, total, err := h.operationService.GetAll(c.Request.Context(), page, pageSize)
+	if err != nil {
+		response.Error(c, err)
+		return
+	}
+
+	operationResponses := make([]*models.AdminOperationResponse, len(operations))
+	for i, operation := range operations {
+		operationResponses[i] = operation.ToResponse()
+	}
+
+	response.Paginated(c, operationResponses, page, pageSize, total)
+}
+
+// Undo reverts a bulk operation from its restore point
+// @Summary Undo admin operation
+// @Description Restore the rows affected by a bulk operation within the retention window (admin only)
+// @Tags admin
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "Operation ID"
+// @Success 200 {object} response.Response
+// @Failure 400 {object} response.Response
+// @Failure 401 {object} response.Response
+// @Failure 403 {object} response.Response
+// @Failure 404 {object} response.Response
+// @Failure 409 {object} response.Response
+// @Router /admin/operations/{id}/undo [post]
+func (h *OperationHandler) Undo(c *gin.Context) {
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		response.BadRequest(c, "Invalid operation ID")
+		return
+	}
+
+	currentUser := middleware.MustGetUser(c)
+
+	operation, err := h.operationService.Undo(c.Request.Context(), id, currentUser.ID)
+	if err != nil {
+		response.Error(c, err)
+		return
+	}
+
+	response.SuccessWithMessage(c, "Operation undone successfully", gin.H{
+		"operation": operation.ToResponse(),
+	})
+}
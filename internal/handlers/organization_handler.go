@@ -0,0 +1,281 @@
+package handlers
+
+import (
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"github.com/yourusername/go-enterprise-api/internal/middleware"
+	"github.com/yourusername/go-enterprise-api/internal/models"
+	"github.com/yourusername/go-enterprise-api/internal/services"
+	"github.com/yourusername/go-enterprise-api/pkg/response"
+	"github.com/yourusername/go-enterprise-api/pkg/validator"
+)
+
+// OrganizationHandler handles organization and membership requests
+type OrganizationHandler struct {
+	orgService services.OrganizationService
+}
+
+// NewOrganizationHandler creates a new organization handler
+func NewOrganizationHandler(orgService services.OrganizationService) *OrganizationHandler {
+	return &OrganizationHandler{
+		orgService: orgService,
+	}
+}
+
+// Create creates an organization owned by the current user
+// @Summary Create organization
+// @Description Create an organization with the current user as its owner. The slug names the organization in the X-Organization header and as a subdomain, so it must be a valid DNS label.
+// @Tags organizations
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param request body services.CreateOrganizationRequest true "Organization data"
+// @Success 201 {object} response.Response
+// @Failure 400 {object} response.Response
+// @Failure 401 {object} response.Response
+// @Failure 409 {object} response.Response
+// @Router /organizations [post]
+func (h *OrganizationHandler) Create(c *gin.Context) {
+	var req services.CreateOrganizationRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		response.BindingError(c, err)
+		return
+	}
+
+	user := middleware.MustGetUser(c)
+	org, err := h.orgService.Create(c.Request.Context(), user, &req)
+	if err != nil {
+		response.Error(c, err)
+		return
+	}
+
+	orgResponse := org.ToResponse()
+	orgResponse.Role = models.OrgRoleOwner
+	response.Created(c, orgResponse)
+}
+
+// GetMine returns the organizations of the current user
+// @Summary List my organizations
+// @Description Get the organizations the current user is a member of, with their role in each, ordered by name
+// @Tags organizations
+// @Produce json
+// @Security BearerAuth
+// @Success 200 {object} response.Response
+// @Failure 401 {object} response.Response
+// @Router /organizations [get]
+func (h *OrganizationHandler) GetMine(c *gin.Context) {
+	user := middleware.MustGetUser(c)
+	members, err := h.orgService.GetMine(c.Request.Context(), user.ID)
+	if err != nil {
+		response.Error(c, err)
+		return
+	}
+
+	orgResponses := make([]*models.OrganizationResponse, 0, len(members))
+	for _, member := range members {
+		if member.Organization == nil {
+			continue
+		}
+		orgResponse := member.Organization.ToResponse()
+		orgResponse.Role = member.Role
+		orgResponses = append(orgResponses, orgResponse)
+	}
+
+	response.Success(c, orgResponses)
+}
+
+// GetByID returns an organization
+// @Summary Get organization
+// @Description Get an organization the current user is a member of
+// @Tags organizations
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "Organization ID"
+// @Success 200 {object} response.Response
+// @Failure 400 {object} response.Response
+// @Failure 401 {object} response.Response
+// @Failure 403 {object} response.Response
+// @Failure 404 {object} response.Response
+// @Router /organizations/{id} [get]
+func (h *OrganizationHandler) GetByID(c *gin.Context) {
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		response.BadRequest(c, "Invalid organization ID")
+		return
+	}
+
+	user := middleware.MustGetUser(c)
+	org, err := h.orgService.GetByID(c.Request.Context(), id, user)
+	if err != nil {
+		response.Error(c, err)
+		return
+	}
+
+	response.Success(c, org.ToResponse())
+}
+
+// Update renames an organization
+// @Summary Update organization
+// @Description Rename an organization (owners and admins of the organization). The slug cannot be changed.
+// @Tags organizations
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "Organization ID"
+// @Param request body services.UpdateOrganizationRequest true "Organization data"
+// @Success 200 {object} response.Response
+// @Failure 400 {object} response.Response
+// @Failure 401 {object} response.Response
+// @Failure 403 {object} response.Response
+// @Failure 404 {object} response.Response
+// @Router /organizations/{id} [put]
+func (h *OrganizationHandler) Update(c *gin.Context) {
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		response.BadRequest(c, "Invalid organization ID")
+		return
+	}
+
+	var req services.UpdateOrganizationRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		response.BindingError(c, err)
+		return
+	}
+
+	user := middleware.MustGetUser(c)
+	org, err := h.orgService.Update(c.Request.Context(), id, user, &req)
+	if err != nil {
+		response.Error(c, err)
+		return
+	}
+
+	response.Success(c, org.ToResponse())
+}
+
+// GetMembers returns the members of an organization
+// @Summary List organization members
+// @Description Get a paginated list of the members of an organization the current user is a member of, longest-standing first
+// @Tags organizations
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "Organization ID"
+// @Param page query int false "Page number" default(1)
+// @Param page_size query int false "Page size" default(10)
+// @Success 200 {object} response.Response
+// @Failure 400 {object} response.Response
+// @Failure 401 {object} response.Response
+// @Failure 403 {object} response.Response
+// @Failure 404 {object} response.Response
+// @Router /organizations/{id}/members [get]
+func (h *OrganizationHandler) GetMembers(c *gin.Context) {
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		response.BadRequest(c, "Invalid organization ID")
+		return
+	}
+
+	page, _ := strconv.Atoi(c.DefaultQuery("page", "1"))
+	pageSize, _ := strconv.Atoi(c.DefaultQuery("page_size", "10"))
+
+	user := middleware.MustGetUser(c)
+	members, total, err := h.orgService.GetMembers(c.Request.Context(), id, user, page, pageSize)
+	if err != nil {
+		response.Error(c, err)
+		return
+	}
+
+	memberResponses := make([]*models.OrganizationMemberResponse, len(members))
+	for i := range members {
+		memberResponses[i] = members[i].ToResponse(user)
+	}
+
+	response.Paginated(c, memberResponses, page, pageSize, total)
+}
+
+// SetMember adds a user to an organization or changes their role
+// @Summary Add or change organization member
+// @Description Add a user to an organization or change their role (owners and admins of the organization). Only owners can add or change owners, and the last owner cannot be demoted.
+// @Tags organizations
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "Organization ID"
+// @Param user_id path string true "User ID"
+// @Param request body services.SetMemberRequest true "Role (owner, admin or member)"
+// @Success 200 {object} response.Response
+// @Failure 400 {object} response.Response
+// @Failure 401 {object} response.Response
+// @Failure 403 {object} response.Response
+// @Failure 404 {object} response.Response
+// @Router /organizations/{id}/members/{user_id} [put]
+func (h *OrganizationHandler) SetMember(c *gin.Context) {
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		response.BadRequest(c, "Invalid organization ID")
+		return
+	}
+	userID, err := uuid.Parse(c.Param("user_id"))
+	if err != nil {
+		response.BadRequest(c, "Invalid user ID")
+		return
+	}
+
+	var req services.SetMemberRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		response.BindingError(c, err)
+		return
+	}
+
+	v := validator.New()
+	validator.OneOf(v, "role", models.OrgRole(req.Role), "", models.OrgRoles...)
+	if errs := v.Validate(); errs != nil {
+		response.ValidationError(c, errs)
+		return
+	}
+
+	user := middleware.MustGetUser(c)
+	member, err := h.orgService.SetMember(c.Request.Context(), id, user, userID, models.OrgRole(req.Role))
+	if err != nil {
+		response.Error(c, err)
+		return
+	}
+
+	response.Success(c, member.ToResponse(user))
+}
+
+// RemoveMember removes a user from an organization
+// @Summary Remove organization member
+// @Description Remove a user from an organization (owners and admins of the organization; only owners remove owners). Members may remove themselves to leave, except the last owner.
+// @Tags organizations
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "Organization ID"
+// @Param user_id path string true "User ID"
+// @Success 204
+// @Failure 400 {object} response.Response
+// @Failure 401 {object} response.Response
+// @Failure 403 {object} response.Response
+// @Failure 404 {object} response.Response
+// @Router /organizations/{id}/members/{user_id} [delete]
+func (h *OrganizationHandler) RemoveMember(c *gin.Context) {
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		response.BadRequest(c, "Invalid organization ID")
+		return
+	}
+	userID, err := uuid.Parse(c.Param("user_id"))
+	if err != nil {
+		response.BadRequest(c, "Invalid user ID")
+		return
+	}
+
+	user := middleware.MustGetUser(c)
+	if err := h.orgService.RemoveMember(c.Request.Context(), id, user, userID); err != nil {
+		response.Error(c, err)
+		return
+	}
+
+	response.NoContent(c)
+}
@@ -0,0 +1,180 @@
+package handlers
+
+import (
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"github.com/yourusername/go-enterprise-api/internal/middleware"
+	"github.com/yourusername/go-enterprise-api/internal/models"
+	"github.com/yourusername/go-enterprise-api/internal/services"
+	"github.com/yourusername/go-enterprise-api/pkg/response"
+	"github.com/yourusername/go-enterprise-api/pkg/validator"
+)
+
+// PolicyHandler handles the terms of service and privacy policy
+type PolicyHandler struct {
+	policyService services.PolicyService
+}
+
+// NewPolicyHandler creates a new policy handler
+func NewPolicyHandler(policyService services.PolicyService) *PolicyHandler {
+	return &PolicyHandler{
+		policyService: policyService,
+	}
+}
+
+// AcceptPoliciesRequest represents the acceptance of policy versions
+type AcceptPoliciesRequest struct {
+	PolicyVersionIDs []uuid.UUID `json:"policy_version_ids" binding:"required,min=1,max=10"`
+}
+
+// GetCurrent returns the current policy versions
+// @Summary Get current policies
+// @Description Get the current version of the terms of service and of the privacy policy. Registering requires accepting them.
+// @Tags policies
+// @Produce json
+// @Success 200 {object} response.Response
+// @Router /policies [get]
+func (h *PolicyHandler) GetCurrent(c *gin.Context) {
+	versions, err := h.policyService.GetCurrent(c.Request.Context())
+	if err != nil {
+		response.Error(c, err)
+		return
+	}
+
+	response.Success(c, gin.H{
+		"policies": models.PolicyVersionResponses(versions),
+	})
+}
+
+// GetPending returns the current policy versions the user has yet to accept
+// @Summary Get policies to accept
+// @Description Get the current policy versions you have not accepted. Until you accept them, requests that make changes fail with error code 2006.
+// @Tags policies
+// @Produce json
+// @Security BearerAuth
+// @Success 200 {object} response.Response
+// @Failure 401 {object} response.Response
+// @Router /policies/pending [get]
+func (h *PolicyHandler) GetPending(c *gin.Context) {
+	user := middleware.MustGetUser(c)
+
+	versions, err := h.policyService.GetPending(c.Request.Context(), user.ID)
+	if err != nil {
+		response.Error(c, err)
+		return
+	}
+
+	response.Success(c, gin.H{
+		"policies": models.PolicyVersionResponses(versions),
+	})
+}
+
+// Accept records the user's acceptance of policy versions
+// @Summary Accept policies
+// @Description Accept current policy versions, as listed by GET /policies/pending
+// @Tags policies
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param request body AcceptPoliciesRequest true "IDs of the accepted policy versions"
+// @Success 200 {object} response.Response
+// @Failure 400 {object} response.Response
+// @Failure 401 {object} response.Response
+// @Router /policies/accept [post]
+func (h *PolicyHandler) Accept(c *gin.Context) {
+	var req AcceptPoliciesRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		response.BindingError(c, err)
+		return
+	}
+
+	user := middleware.MustGetUser(c)
+	if err := h.policyService.Accept(c.Request.Context(), user.ID, req.PolicyVersionIDs); err != nil {
+		response.Error(c, err)
+		return
+	}
+
+	pending, err := h.policyService.GetPending(c.Request.Context(), user.ID)
+	if err != nil {
+		response.Error(c, err)
+		return
+	}
+
+	response.SuccessWithMessage(c, "Policies accepted", gin.H{
+		"pending": models.PolicyVersionResponses(pending),
+	})
+}
+
+// GetAll returns the published policy versions
+// @Summary List policy versions
+// @Description Get a paginated list of the published policy versions, latest first (admin only)
+// @Tags admin
+// @Produce json
+// @Security BearerAuth
+// @Param kind query string false "Filter by kind (terms, privacy)"
+// @Param page query int false "Page number" default(1)
+// @Param page_size query int false "Page size" default(10)
+// @Success 200 {object} response.Response
+// @Failure 400 {object} response.Response
+// @Router /admin/policies [get]
+func (h *PolicyHandler) GetAll(c *gin.Context) {
+	page, _ := strconv.Atoi(c.DefaultQuery("page", "1"))
+	pageSize, _ := strconv.Atoi(c.DefaultQuery("page_size", "10"))
+	kind := models.PolicyKind(c.Query("kind"))
+
+	v := validator.New()
+	validator.OneOf(v.When(kind != ""), "kind", kind, "", models.PolicyKinds...)
+	if errs := v.Validate(); errs != nil {
+		response.ValidationError(c, errs)
+		return
+	}
+
+	versions, total, err := h.policyService.GetAll(c.Request.Context(), kind, page, pageSize)
+	if err != nil {
+		response.Error(c, err)
+		return
+	}
+
+	response.Paginated(c, models.PolicyVersionResponses(versions), page, pageSize, total)
+}
+
+// Publish publishes a new policy version
+// @Summary Publish policy version
+// @Description Publish a new version of the terms of service or the privacy policy, which becomes the current one. Users must accept it before they can make changes again (admin only).
+// @Tags admin
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param request body services.PublishPolicyRequest true "Policy version"
+// @Success 201 {object} response.Response
+// @Failure 400 {object} response.Response
+// @Failure 409 {object} response.Response
+// @Router /admin/policies [post]
+func (h *PolicyHandler) Publish(c *gin.Context) {
+	var req services.PublishPolicyRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		response.BindingError(c, err)
+		return
+	}
+
+	v := validator.New()
+	validator.OneOf(v, "kind", models.PolicyKind(req.Kind), "", models.PolicyKinds...)
+	v.URL("url", req.URL, "")
+	if errs := v.Validate(); errs != nil {
+		response.ValidationError(c, errs)
+		return
+	}
+
+	user := middleware.MustGetUser(c)
+	version, err := h.policyService.Publish(c.Request.Context(), user.ID, &req)
+	if err != nil {
+		response.Error(c, err)
+		return
+	}
+
+	response.Created(c, gin.H{
+		"policy": version.ToResponse(),
+	})
+}
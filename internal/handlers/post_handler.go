@@ -1,47 +1,98 @@
 package handlers
 
 import (
+	"archive/zip"
+	"net/http"
+	"net/url"
+	"path"
 	"strconv"
+	"strings"
 
 	"github.com/gin-gonic/gin"
 	"github.com/google/uuid"
 	"github.com/yourusername/go-enterprise-api/internal/middleware"
 	"github.com/yourusername/go-enterprise-api/internal/models"
 	"github.com/yourusername/go-enterprise-api/internal/services"
+	"github.com/yourusername/go-enterprise-api/pkg/logger"
 	"github.com/yourusername/go-enterprise-api/pkg/response"
 	"github.com/yourusername/go-enterprise-api/pkg/validator"
 )
 
+const (
+	// exportFormatJSONL and exportFormatZip are the supported post export formats
+	exportFormatJSONL = "jsonl"
+	exportFormatZip   = "zip"
+)
+
 // PostHandler handles post-related requests
 type PostHandler struct {
-	postService services.PostService
+	postService      services.PostService
+	analyticsService services.AnalyticsService
+	previewService   services.PreviewService
+	adultAge         int
 }
 
-// NewPostHandler creates a new post handler
-func NewPostHandler(postService services.PostService) *PostHandler {
+// NewPostHandler creates a new post handler.
+// adultAge is the age from which verified accounts may view mature posts.
+func NewPostHandler(postService services.PostService, analyticsService services.AnalyticsService, previewService services.PreviewService, adultAge int) *PostHandler {
 	return &PostHandler{
-		postService: postService,
+		postService:      postService,
+		analyticsService: analyticsService,
+		previewService:   previewService,
+		adultAge:         adultAge,
 	}
 }
 
 // CreatePostRequest represents the create post request body
 type CreatePostRequest struct {
-	Title         string   `json:"title" binding:"required"`
-	Content       string   `json:"content" binding:"required"`
-	Excerpt       string   `json:"excerpt"`
-	FeaturedImage string   `json:"featured_image"`
-	Status        string   `json:"status"`
-	Tags          []string `json:"tags"`
+	Title            string   `json:"title" binding:"notblank,max=255" sanitize:"text,striptags"`
+	Content          string   `json:"content" binding:"notblank" sanitize:"nfc,nocontrol"`
+	Excerpt          string   `json:"excerpt" sanitize:"text,striptags"`
+	FeaturedImage    string   `json:"featured_image" sanitize:"trim"`
+	FeaturedImageAlt string   `json:"featured_image_alt" sanitize:"text,striptags"`
+	Status           string   `json:"status"`
+	Tags             []string `json:"tags" sanitize:"text,striptags"`
+	Mature           bool     `json:"mature"`
+	License          string   `json:"license"`
+	LicenseName      string   `json:"license_name" sanitize:"text,striptags"`
+	LicenseURL       string   `json:"license_url" sanitize:"trim"`
 }
 
 // UpdatePostRequest represents the update post request body
 type UpdatePostRequest struct {
-	Title         *string  `json:"title,omitempty"`
-	Content       *string  `json:"content,omitempty"`
-	Excerpt       *string  `json:"excerpt,omitempty"`
-	FeaturedImage *string  `json:"featured_image,omitempty"`
-	Status        *string  `json:"status,omitempty"`
-	Tags          []string `json:"tags,omitempty"`
+	Title            *string  `json:"title,omitempty" binding:"omitempty,notblank,max=255" sanitize:"text,striptags"`
+	Content          *string  `json:"content,omitempty" sanitize:"nfc,nocontrol"`
+	Excerpt          *string  `json:"excerpt,omitempty" sanitize:"text,striptags"`
+	FeaturedImage    *string  `json:"featured_image,omitempty" sanitize:"trim"`
+	FeaturedImageAlt *string  `json:"featured_image_alt,omitempty" sanitize:"text,striptags"`
+	Status           *string  `json:"status,omitempty"`
+	Tags             []string `json:"tags,omitempty" sanitize:"text,striptags"`
+	Mature           *bool    `json:"mature,omitempty"`
+	License          *string  `json:"license,omitempty"`
+	LicenseName      *string  `json:"license_name,omitempty" sanitize:"text,striptags"`
+	LicenseURL       *string  `json:"license_url,omitempty" sanitize:"trim"`
+	Version          *int64   `json:"version,omitempty"`
+}
+
+// validatePostFields validates the optional fields shared by create and update
+func validatePostFields(v *validator.Validator, featuredImage, featuredImageAlt, status string, tags []string) {
+	v.When(featuredImage != "").URL("featured_image", featuredImage, "")
+	v.MaxLength("featured_image_alt", featuredImageAlt, 500, "")
+	validator.OneOf(v.When(status != ""), "status", models.PostStatus(status), "",
+		models.PostStatusDraft, models.PostStatusPublished, models.PostStatusArchived)
+	validator.Each(v, "tags", tags, func(v *validator.Validator, tag string) {
+		v.Required("", strings.TrimSpace(tag), "")
+		v.MaxLength("", tag, 100, "")
+	})
+}
+
+// validateLicense validates the license fields shared by create and update.
+// Whether a custom license is named depends on the stored post on update, so
+// it is checked by the service.
+func validateLicense(v *validator.Validator, license, name, url string) {
+	validator.OneOf(v.When(license != ""), "license", models.License(license), "", models.Licenses...)
+	v.MaxLength("license_name", name, 200, "")
+	v.When(url != "").URL("license_url", url, "")
 }
 
 // Create creates a new post
@@ -59,15 +110,14 @@ type UpdatePostRequest struct {
 func (h *PostHandler) Create(c *gin.Context) {
 	var req CreatePostRequest
 	if err := c.ShouldBindJSON(&req); err != nil {
-		response.BadRequest(c, "Invalid request body")
+		response.BindingError(c, err)
 		return
 	}
 
 	// Validate request
 	v := validator.New()
-	v.Required("title", req.Title, "")
-	v.MaxLength("title", req.Title, 255, "")
-	v.Required("content", req.Content, "")
+	validatePostFields(v, req.FeaturedImage, req.FeaturedImageAlt, req.Status, req.Tags)
+	validateLicense(v, req.License, req.LicenseName, req.LicenseURL)
 
 	if errs := v.Validate(); errs != nil {
 		response.ValidationError(c, errs)
@@ -77,15 +127,20 @@ func (h *PostHandler) Create(c *gin.Context) {
 	user := middleware.MustGetUser(c)
 
 	serviceReq := &services.CreatePostRequest{
-		Title:         req.Title,
-		Content:       req.Content,
-		Excerpt:       req.Excerpt,
-		FeaturedImage: req.FeaturedImage,
-		Status:        req.Status,
-		Tags:          req.Tags,
+		Title:            req.Title,
+		Content:          req.Content,
+		Excerpt:          req.Excerpt,
+		FeaturedImage:    req.FeaturedImage,
+		FeaturedImageAlt: req.FeaturedImageAlt,
+		Status:           req.Status,
+		Tags:             req.Tags,
+		Mature:           req.Mature,
+		License:          req.License,
+		LicenseName:      req.LicenseName,
+		LicenseURL:       req.LicenseURL,
 	}
 
-	post, err := h.postService.Create(c.Request.Context(), user.ID, serviceReq)
+	post, err := h.postService.Create(c.Request.Context(), user, serviceReq)
 	if err != nil {
 		response.Error(c, err)
 		return
@@ -98,12 +153,13 @@ func (h *PostHandler) Create(c *gin.Context) {
 
 // GetAll returns all posts with pagination
 // @Summary Get all posts
-// @Description Get a paginated list of posts (published only for non-authenticated users)
+// @Description Get a paginated list of posts (published only for non-admins; mature posts only for verified adults)
 // @Tags posts
 // @Accept json
 // @Produce json
 // @Param page query int false "Page number" default(1)
 // @Param page_size query int false "Page size" default(10)
+// @Param tag query string false "Only posts with this tag (tag or alias slug)"
 // @Success 200 {object} response.Response
 // @Router /posts [get]
 func (h *PostHandler) GetAll(c *gin.Context) {
@@ -118,12 +174,15 @@ func (h *PostHandler) GetAll(c *gin.Context) {
 	var total int64
 	var err error
 
-	if isAdmin {
+	if tag := c.Query("tag"); tag != "" {
+		// Aliases resolve to their canonical tag
+		posts, total, err = h.postService.GetByTag(c.Request.Context(), tag, !isAdmin, isAdmin || h.includeMature(c), page, pageSize)
+	} else if isAdmin {
 		// Admin can see all posts
 		posts, total, err = h.postService.GetAll(c.Request.Context(), page, pageSize)
 	} else {
 		// Non-admin only sees published posts
-		posts, total, err = h.postService.GetPublished(c.Request.Context(), page, pageSize)
+		posts, total, err = h.postService.GetPublished(c.Request.Context(), h.includeMature(c), page, pageSize)
 	}
 
 	if err != nil {
@@ -140,6 +199,35 @@ func (h *PostHandler) GetAll(c *gin.Context) {
 	response.Paginated(c, postResponses, page, pageSize, total)
 }
 
+// GetFeatured returns published featured posts with pagination
+// @Summary Get featured posts
+// @Description Get a paginated list of featured posts for the homepage, pinned posts first
+// @Tags posts
+// @Accept json
+// @Produce json
+// @Param page query int false "Page number" default(1)
+// @Param page_size query int false "Page size" default(10)
+// @Success 200 {object} response.Response
+// @Router /posts/featured [get]
+func (h *PostHandler) GetFeatured(c *gin.Context) {
+	page, _ := strconv.Atoi(c.DefaultQuery("page", "1"))
+	pageSize, _ := strconv.Atoi(c.DefaultQuery("page_size", "10"))
+
+	posts, total, err := h.postService.GetFeatured(c.Request.Context(), h.includeMature(c), page, pageSize)
+	if err != nil {
+		response.Error(c, err)
+		return
+	}
+
+	// Convert to response
+	postResponses := make([]*models.PostResponse, len(posts))
+	for i, post := range posts {
+		postResponses[i] = post.ToResponse()
+	}
+
+	response.Paginated(c, postResponses, page, pageSize, total)
+}
+
 // GetByID returns a post by ID
 // @Summary Get post by ID
 // @Description Get a specific post by its ID
@@ -149,6 +237,7 @@ func (h *PostHandler) GetAll(c *gin.Context) {
 // @Param id path string true "Post ID"
 // @Success 200 {object} response.Response
 // @Failure 404 {object} response.Response
+// @Failure 410 {object} response.Response "Taken down; data holds the tombstone"
 // @Router /posts/{id} [get]
 func (h *PostHandler) GetByID(c *gin.Context) {
 	id, err := uuid.Parse(c.Param("id"))
@@ -163,23 +252,81 @@ func (h *PostHandler) GetByID(c *gin.Context) {
 		return
 	}
 
+	if h.tombstone(c, post) {
+		return
+	}
+
 	// Check if post is published or user has permission
-	user, exists := middleware.GetUser(c)
-	if !post.IsPublished() {
-		if !exists || (post.UserID != user.ID && !user.IsAdmin()) {
-			response.NotFound(c, "Post not found")
-			return
-		}
+	if !h.canView(c, post) {
+		response.NotFound(c, "Post not found")
+		return
+	}
+	if !h.canViewMature(c, post) {
+		response.Forbidden(c, "This post is only available to verified adult accounts")
+		return
 	}
 
-	// Increment view count
-	_ = h.postService.IncrementViews(c.Request.Context(), id)
+	// Record the view; view counts are updated from the recorded views in the
+	// background (previews of unpublished posts and views served in read-only
+	// mode are not counted)
+	if post.IsPublished() && !middleware.IsReadOnly(c) {
+		h.analyticsService.RecordView(c.Request.Context(), id, readerKey(c), c.Request.Referer())
+	}
 
+	middleware.SetLastModified(c, post.UpdatedAt)
 	response.Success(c, gin.H{
 		"post": post.ToResponse(),
 	})
 }
 
+// tombstone answers with a 410 tombstone when a post has been taken down and
+// reports whether it did. Admins still see taken down posts.
+func (h *PostHandler) tombstone(c *gin.Context, post *models.Post) bool {
+	if !post.IsTakenDown() {
+		return false
+	}
+	user, _ := middleware.GetUser(c)
+	if user != nil && user.IsAdmin() {
+		return false
+	}
+	response.Gone(c, "This post has been taken down", post.ToTombstone(user))
+	return true
+}
+
+// canView reports whether the current request may view a post: published
+// posts are public, unpublished ones are visible to their author, admins and
+// holders of a valid preview token for that post. Shadowed posts are only
+// visible to their author, admins and moderators.
+func (h *PostHandler) canView(c *gin.Context, post *models.Post) bool {
+	user, exists := middleware.GetUser(c)
+	if post.IsHiddenFrom(user) {
+		return false
+	}
+	if post.IsPublished() {
+		return true
+	}
+	if exists && (post.UserID == user.ID || user.IsAdmin()) {
+		return true
+	}
+	return h.previewService.ValidateToken(c.Query("preview_token"), post.ID)
+}
+
+// canViewMature reports whether the current request may see a post's content
+// given its mature flag. Preview token holders were invited by the author.
+func (h *PostHandler) canViewMature(c *gin.Context, post *models.Post) bool {
+	user, _ := middleware.GetUser(c)
+	if post.IsVisibleTo(user, h.adultAge) {
+		return true
+	}
+	return h.previewService.ValidateToken(c.Query("preview_token"), post.ID)
+}
+
+// includeMature reports whether listings for the current request may include mature posts
+func (h *PostHandler) includeMature(c *gin.Context) bool {
+	user, exists := middleware.GetUser(c)
+	return exists && (user.IsAdmin() || user.IsVerifiedAdult(h.adultAge))
+}
+
 // GetBySlug returns a post by slug
 // @Summary Get post by slug
 // @Description Get a specific post by its URL slug
@@ -188,29 +335,51 @@ func (h *PostHandler) GetByID(c *gin.Context) {
 // @Produce json
 // @Param slug path string true "Post slug"
 // @Success 200 {object} response.Response
+// @Success 301 {object} response.Response "Slug changed; Location holds the canonical URL"
 // @Failure 404 {object} response.Response
+// @Failure 410 {object} response.Response "Taken down; data holds the tombstone"
 // @Router /posts/slug/{slug} [get]
 func (h *PostHandler) GetBySlug(c *gin.Context) {
 	slug := c.Param("slug")
 
-	post, err := h.postService.GetBySlug(c.Request.Context(), slug)
+	post, redirected, err := h.postService.GetBySlug(c.Request.Context(), slug)
 	if err != nil {
 		response.Error(c, err)
 		return
 	}
 
+	if h.tombstone(c, post) {
+		return
+	}
+
 	// Check if post is published or user has permission
-	user, exists := middleware.GetUser(c)
-	if !post.IsPublished() {
-		if !exists || (post.UserID != user.ID && !user.IsAdmin()) {
-			response.NotFound(c, "Post not found")
-			return
+	if !h.canView(c, post) {
+		response.NotFound(c, "Post not found")
+		return
+	}
+	if !h.canViewMature(c, post) {
+		response.Forbidden(c, "This post is only available to verified adult accounts")
+		return
+	}
+
+	// Old slugs redirect to the canonical one
+	if redirected {
+		location := path.Join(path.Dir(c.Request.URL.Path), post.Slug)
+		if c.Request.URL.RawQuery != "" {
+			location += "?" + c.Request.URL.RawQuery
 		}
+		response.MovedPermanently(c, location, gin.H{
+			"canonical_slug": post.Slug,
+		})
+		return
 	}
 
-	// Increment view count
-	_ = h.postService.IncrementViews(c.Request.Context(), post.ID)
+	// Record the view, as in GetByID
+	if post.IsPublished() && !middleware.IsReadOnly(c) {
+		h.analyticsService.RecordView(c.Request.Context(), post.ID, readerKey(c), c.Request.Referer())
+	}
 
+	middleware.SetLastModified(c, post.UpdatedAt)
 	response.Success(c, gin.H{
 		"post": post.ToResponse(),
 	})
@@ -218,7 +387,7 @@ func (h *PostHandler) GetBySlug(c *gin.Context) {
 
 // Update updates a post
 // @Summary Update post
-// @Description Update a post (owner or admin only)
+// @Description Update a post (owner or admin only). Send the post's version to reject the update if the post has changed since.
 // @Tags posts
 // @Accept json
 // @Produce json
@@ -230,6 +399,7 @@ func (h *PostHandler) GetBySlug(c *gin.Context) {
 // @Failure 401 {object} response.Response
 // @Failure 403 {object} response.Response
 // @Failure 404 {object} response.Response
+// @Failure 409 {object} response.Response
 // @Router /posts/{id} [put]
 func (h *PostHandler) Update(c *gin.Context) {
 	id, err := uuid.Parse(c.Param("id"))
@@ -240,19 +410,55 @@ func (h *PostHandler) Update(c *gin.Context) {
 
 	var req UpdatePostRequest
 	if err := c.ShouldBindJSON(&req); err != nil {
-		response.BadRequest(c, "Invalid request body")
+		response.BindingError(c, err)
+		return
+	}
+
+	// Validate request
+	v := validator.New()
+	var featuredImage, featuredImageAlt, status string
+	if req.FeaturedImage != nil {
+		featuredImage = *req.FeaturedImage
+	}
+	if req.FeaturedImageAlt != nil {
+		featuredImageAlt = *req.FeaturedImageAlt
+	}
+	if req.Status != nil {
+		status = *req.Status
+	}
+	validatePostFields(v, featuredImage, featuredImageAlt, status, req.Tags)
+	var license, licenseName, licenseURL string
+	if req.License != nil {
+		license = *req.License
+	}
+	if req.LicenseName != nil {
+		licenseName = *req.LicenseName
+	}
+	if req.LicenseURL != nil {
+		licenseURL = *req.LicenseURL
+	}
+	validateLicense(v, license, licenseName, licenseURL)
+
+	if errs := v.Validate(); errs != nil {
+		response.ValidationError(c, errs)
 		return
 	}
 
 	user := middleware.MustGetUser(c)
 
 	serviceReq := &services.UpdatePostRequest{
-		Title:         req.Title,
-		Content:       req.Content,
-		Excerpt:       req.Excerpt,
-		FeaturedImage: req.FeaturedImage,
-		Status:        req.Status,
-		Tags:          req.Tags,
+		Title:            req.Title,
+		Content:          req.Content,
+		Excerpt:          req.Excerpt,
+		FeaturedImage:    req.FeaturedImage,
+		FeaturedImageAlt: req.FeaturedImageAlt,
+		Status:           req.Status,
+		Tags:             req.Tags,
+		Mature:           req.Mature,
+		License:          req.License,
+		LicenseName:      req.LicenseName,
+		LicenseURL:       req.LicenseURL,
+		Version:          req.Version,
 	}
 
 	post, err := h.postService.Update(c.Request.Context(), id, user.ID, user.IsAdmin(), serviceReq)
@@ -266,6 +472,133 @@ func (h *PostHandler) Update(c *gin.Context) {
 	})
 }
 
+// UpdateFlags sets a post's pinned and featured flags
+// @Summary Update post flags
+// @Description Pin or feature a post (admin or moderator only)
+// @Tags posts
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "Post ID"
+// @Param request body services.UpdatePostFlagsRequest true "Flags"
+// @Success 200 {object} response.Response
+// @Failure 400 {object} response.Response
+// @Failure 401 {object} response.Response
+// @Failure 403 {object} response.Response
+// @Failure 404 {object} response.Response
+// @Router /posts/{id}/flags [patch]
+func (h *PostHandler) UpdateFlags(c *gin.Context) {
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		response.BadRequest(c, "Invalid post ID")
+		return
+	}
+
+	var req services.UpdatePostFlagsRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		response.BindingError(c, err)
+		return
+	}
+
+	user := middleware.MustGetUser(c)
+
+	post, err := h.postService.UpdateFlags(c.Request.Context(), id, user.ID, &req)
+	if err != nil {
+		response.Error(c, err)
+		return
+	}
+
+	response.Success(c, gin.H{
+		"post": post.ToResponse(),
+	})
+}
+
+// PinToProfileRequest represents a request to pin a post to its author's profile
+type PinToProfileRequest struct {
+	Position int `json:"position"`
+}
+
+// PinToProfile pins a post to the top of its author's profile
+// @Summary Pin post to profile
+// @Description Pin a published post to the top of the author's public profile (author only). position is 1-based; omit it to pin the post last. Pinning an already pinned post moves it.
+// @Tags posts
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "Post ID"
+// @Param request body PinToProfileRequest false "Pin position"
+// @Success 200 {object} response.Response
+// @Failure 400 {object} response.Response
+// @Failure 401 {object} response.Response
+// @Failure 403 {object} response.Response
+// @Failure 404 {object} response.Response
+// @Failure 409 {object} response.Response
+// @Router /posts/{id}/pin-to-profile [post]
+func (h *PostHandler) PinToProfile(c *gin.Context) {
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		response.BadRequest(c, "Invalid post ID")
+		return
+	}
+
+	var req PinToProfileRequest
+	if c.Request.ContentLength != 0 {
+		if err := c.ShouldBindJSON(&req); err != nil {
+			response.BindingError(c, err)
+			return
+		}
+	}
+	if req.Position < 0 {
+		response.BadRequest(c, "Position must be a positive number")
+		return
+	}
+
+	user := middleware.MustGetUser(c)
+
+	pins, err := h.postService.PinToProfile(c.Request.Context(), id, user.ID, req.Position)
+	if err != nil {
+		response.Error(c, err)
+		return
+	}
+
+	pinResponses := make([]*models.PostSummaryResponse, len(pins))
+	for i, pin := range pins {
+		pinResponses[i] = pin.ToSummary()
+	}
+
+	response.Success(c, gin.H{
+		"pinned_posts": pinResponses,
+	})
+}
+
+// UnpinFromProfile removes a post from its author's profile pins
+// @Summary Unpin post from profile
+// @Description Remove a post from the pins of the author's public profile (author only)
+// @Tags posts
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "Post ID"
+// @Success 204
+// @Failure 401 {object} response.Response
+// @Failure 403 {object} response.Response
+// @Failure 404 {object} response.Response
+// @Router /posts/{id}/pin-to-profile [delete]
+func (h *PostHandler) UnpinFromProfile(c *gin.Context) {
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		response.BadRequest(c, "Invalid post ID")
+		return
+	}
+
+	user := middleware.MustGetUser(c)
+	if err := h.postService.UnpinFromProfile(c.Request.Context(), id, user.ID); err != nil {
+		response.Error(c, err)
+		return
+	}
+
+	response.NoContent(c)
+}
+
 // Delete deletes a post
 // @Summary Delete post
 // @Description Delete a post (owner or admin only)
@@ -329,6 +662,38 @@ func (h *PostHandler) GetMyPosts(c *gin.Context) {
 	response.Paginated(c, postResponses, page, pageSize, total)
 }
 
+// GetMissingAltText returns the current user's posts with images that have no alt text
+// @Summary Get my posts missing alt text
+// @Description Get the current user's posts with images, the featured image included, that have no alt text
+// @Tags posts
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param page query int false "Page number" default(1)
+// @Param page_size query int false "Page size" default(10)
+// @Success 200 {object} response.Response
+// @Failure 401 {object} response.Response
+// @Router /posts/my/missing-alt-text [get]
+func (h *PostHandler) GetMissingAltText(c *gin.Context) {
+	page, _ := strconv.Atoi(c.DefaultQuery("page", "1"))
+	pageSize, _ := strconv.Atoi(c.DefaultQuery("page_size", "10"))
+
+	user := middleware.MustGetUser(c)
+
+	posts, total, err := h.postService.GetMissingAltText(c.Request.Context(), user.ID, page, pageSize)
+	if err != nil {
+		response.Error(c, err)
+		return
+	}
+
+	reports := make([]*models.AltTextReportResponse, len(posts))
+	for i, post := range posts {
+		reports[i] = post.ToAltTextReport()
+	}
+
+	response.Paginated(c, reports, page, pageSize, total)
+}
+
 // Search searches for posts
 // @Summary Search posts
 // @Description Search for posts by title or content
@@ -351,7 +716,7 @@ func (h *PostHandler) Search(c *gin.Context) {
 	page, _ := strconv.Atoi(c.DefaultQuery("page", "1"))
 	pageSize, _ := strconv.Atoi(c.DefaultQuery("page_size", "10"))
 
-	posts, total, err := h.postService.Search(c.Request.Context(), query, page, pageSize)
+	posts, total, err := h.postService.Search(c.Request.Context(), query, h.includeMature(c), page, pageSize)
 	if err != nil {
 		response.Error(c, err)
 		return
@@ -365,3 +730,243 @@ func (h *PostHandler) Search(c *gin.Context) {
 
 	response.Paginated(c, postResponses, page, pageSize, total)
 }
+
+// CheckSlug previews the slug a post title would receive
+// @Summary Check post slug
+// @Description Preview the slug a post with the given title would receive and whether the clean slug is available
+// @Tags posts
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param title query string true "Post title"
+// @Success 200 {object} response.Response
+// @Failure 400 {object} response.Response
+// @Failure 401 {object} response.Response
+// @Router /posts/slug-check [get]
+func (h *PostHandler) CheckSlug(c *gin.Context) {
+	title := c.Query("title")
+	if title == "" {
+		response.BadRequest(c, "Title is required")
+		return
+	}
+
+	slug, available, err := h.postService.CheckSlug(c.Request.Context(), title)
+	if err != nil {
+		response.Error(c, err)
+		return
+	}
+
+	response.Success(c, gin.H{
+		"slug":      slug,
+		"available": available,
+	})
+}
+
+// CreatePreviewToken creates a share link for an unpublished post
+// @Summary Create draft preview token
+// @Description Create a signed, expiring link that lets unauthenticated reviewers view an unpublished post (owner or admin only)
+// @Tags posts
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "Post ID"
+// @Success 201 {object} response.Response
+// @Failure 400 {object} response.Response
+// @Failure 401 {object} response.Response
+// @Failure 403 {object} response.Response
+// @Failure 404 {object} response.Response
+// @Router /posts/{id}/preview-token [post]
+func (h *PostHandler) CreatePreviewToken(c *gin.Context) {
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		response.BadRequest(c, "Invalid post ID")
+		return
+	}
+
+	user := middleware.MustGetUser(c)
+
+	preview, err := h.previewService.CreateToken(c.Request.Context(), id, user.ID, user.IsAdmin())
+	if err != nil {
+		response.Error(c, err)
+		return
+	}
+
+	postsPath := path.Dir(path.Dir(c.Request.URL.Path))
+	response.Created(c, gin.H{
+		"token":      preview.Token,
+		"expires_at": preview.ExpiresAt,
+		"url":        postsPath + "/" + id.String() + "?preview_token=" + url.QueryEscape(preview.Token),
+	})
+}
+
+// GetChanges returns the field-level change timeline of a post
+// @Summary Get post changes
+// @Description Get the field-level change timeline of a post (owner or admin only)
+// @Tags posts
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "Post ID"
+// @Param page query int false "Page number" default(1)
+// @Param page_size query int false "Page size" default(10)
+// @Success 200 {object} response.Response
+// @Failure 400 {object} response.Response
+// @Failure 401 {object} response.Response
+// @Failure 403 {object} response.Response
+// @Failure 404 {object} response.Response
+// @Router /posts/{id}/changes [get]
+func (h *PostHandler) GetChanges(c *gin.Context) {
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		response.BadRequest(c, "Invalid post ID")
+		return
+	}
+
+	page, _ := strconv.Atoi(c.DefaultQuery("page", "1"))
+	pageSize, _ := strconv.Atoi(c.DefaultQuery("page_size", "10"))
+
+	user := middleware.MustGetUser(c)
+
+	changes, total, err := h.postService.GetChanges(c.Request.Context(), id, user.ID, user.IsAdmin(), page, pageSize)
+	if err != nil {
+		response.Error(c, err)
+		return
+	}
+
+	changeResponses := make([]*models.ChangeResponse, len(changes))
+	for i, change := range changes {
+		changeResponses[i] = change.ToResponse()
+	}
+
+	response.Paginated(c, changeResponses, page, pageSize, total)
+}
+
+// Reindex queues a rebuild of the external search index from the database
+// @Summary Reindex posts
+// @Description Queue a background job rebuilding the external search index from all published posts. Its progress can be followed under /admin/jobs (admin only).
+// @Tags admin
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Success 200 {object} response.Response
+// @Failure 400 {object} response.Response
+// @Failure 401 {object} response.Response
+// @Failure 403 {object} response.Response
+// @Failure 409 {object} response.Response
+// @Router /admin/search/reindex [post]
+func (h *PostHandler) Reindex(c *gin.Context) {
+	job, err := h.postService.Reindex(c.Request.Context())
+	if err != nil {
+		response.Error(c, err)
+		return
+	}
+
+	response.SuccessWithMessage(c, "Search reindex queued", gin.H{
+		"job": job.ToResponse(),
+	})
+}
+
+// Export streams the current user's posts, or every post for admins
+// @Summary Export posts
+// @Description Download posts with their tags and metadata as JSON lines or as a zip archive with one JSON file per post. Admins export all posts.
+// @Tags posts
+// @Produce application/x-ndjson,application/zip
+// @Security BearerAuth
+// @Param format query string false "Export format (jsonl or zip)" default(jsonl)
+// @Success 200 {file} file
+// @Failure 400 {object} response.Response
+// @Failure 401 {object} response.Response
+// @Router /posts/export [get]
+func (h *PostHandler) Export(c *gin.Context) {
+	format := c.DefaultQuery("format", exportFormatJSONL)
+
+	v := validator.New()
+	v.InSlice("format", format, []string{exportFormatJSONL, exportFormatZip}, "")
+	if errs := v.Validate(); errs != nil {
+		response.ValidationError(c, errs)
+		return
+	}
+
+	user := middleware.MustGetUser(c)
+
+	var (
+		write  func(post *models.Post) error
+		finish func() error
+	)
+	started := false
+	start := func() {
+		if format == exportFormatZip {
+			c.Header("Content-Disposition", `attachment; filename="posts.zip"`)
+			c.Header("Content-Type", "application/zip")
+		} else {
+			c.Header("Content-Disposition", `attachment; filename="posts.jsonl"`)
+			c.Header("Content-Type", "application/x-ndjson")
+		}
+		c.Status(http.StatusOK)
+		started = true
+	}
+
+	if format == exportFormatZip {
+		zw := zip.NewWriter(c.Writer)
+		write = func(post *models.Post) error {
+			data, err := response.Marshal(c, post.ToResponse())
+			if err != nil {
+				return err
+			}
+			w, err := zw.CreateHeader(&zip.FileHeader{
+				Name:     "posts/" + post.Slug + ".json",
+				Method:   zip.Deflate,
+				Modified: post.UpdatedAt,
+			})
+			if err != nil {
+				return err
+			}
+			_, err = w.Write(data)
+			return err
+		}
+		finish = zw.Close
+	} else {
+		write = func(post *models.Post) error {
+			data, err := response.Marshal(c, post.ToResponse())
+			if err != nil {
+				return err
+			}
+			_, err = c.Writer.Write(append(data, '\n'))
+			return err
+		}
+		finish = func() error { return nil }
+	}
+
+	err := h.postService.Export(c.Request.Context(), user.ID, user.IsAdmin(), func(posts []models.Post) error {
+		if !started {
+			start()
+		}
+		for i := range posts {
+			if err := write(&posts[i]); err != nil {
+				return err
+			}
+		}
+		c.Writer.Flush()
+		return nil
+	})
+	if err == nil {
+		if !started {
+			start()
+		}
+		err = finish()
+	}
+
+	if err != nil {
+		if !started {
+			response.Error(c, err)
+			return
+		}
+		// The response is already under way, so the client can only be told by
+		// an incomplete download
+		logger.Error("Post export aborted",
+			logger.String("user_id", user.ID.String()),
+			logger.Err(err),
+		)
+		c.Abort()
+	}
+}
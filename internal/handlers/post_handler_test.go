@@ -0,0 +1,190 @@
+package handlers_test
+
+import (
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/yourusername/go-enterprise-api/internal/config"
+	"github.com/yourusername/go-enterprise-api/internal/handlers"
+	"github.com/yourusername/go-enterprise-api/internal/mocks"
+	"github.com/yourusername/go-enterprise-api/internal/models"
+	"github.com/yourusername/go-enterprise-api/internal/services"
+	apperrors "github.com/yourusername/go-enterprise-api/pkg/errors"
+	"go.uber.org/mock/gomock"
+)
+
+// newPostHandler creates a post handler using posts, with a preview service
+// that rejects every token
+func newPostHandler(ctrl *gomock.Controller, posts services.PostService) *handlers.PostHandler {
+	previews := services.NewPreviewService(mocks.NewMockPostRepository(ctrl), &config.Config{})
+	return handlers.NewPostHandler(posts, nil, previews, 18)
+}
+
+func TestPostHandlerGetByID(t *testing.T) {
+	author := newUser(models.RoleUser)
+	other := newUser(models.RoleUser)
+	draft := &models.Post{BaseModel: models.BaseModel{ID: uuid.New()}, UserID: author.ID, Status: models.PostStatusDraft}
+	missing := uuid.New()
+
+	tests := []struct {
+		name   string
+		path   string
+		user   *models.User
+		setup  func(posts *mocks.MockPostService)
+		status int
+		code   int
+	}{
+		{
+			name:   "invalid id",
+			path:   "/posts/not-a-uuid",
+			status: http.StatusBadRequest,
+			code:   apperrors.CodeBadRequest,
+		},
+		{
+			name: "not found",
+			path: "/posts/" + missing.String(),
+			setup: func(posts *mocks.MockPostService) {
+				posts.EXPECT().GetByID(gomock.Any(), missing).Return(nil, apperrors.ErrNotFound)
+			},
+			status: http.StatusNotFound,
+			code:   apperrors.CodeNotFound,
+		},
+		{
+			name: "draft of another user",
+			path: "/posts/" + draft.ID.String(),
+			user: other,
+			setup: func(posts *mocks.MockPostService) {
+				posts.EXPECT().GetByID(gomock.Any(), draft.ID).Return(draft, nil)
+			},
+			status: http.StatusNotFound,
+			code:   apperrors.CodeNotFound,
+		},
+		{
+			name: "draft of the author",
+			path: "/posts/" + draft.ID.String(),
+			user: author,
+			setup: func(posts *mocks.MockPostService) {
+				posts.EXPECT().GetByID(gomock.Any(), draft.ID).Return(draft, nil)
+			},
+			status: http.StatusOK,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			posts := mocks.NewMockPostService(ctrl)
+			if tt.setup != nil {
+				tt.setup(posts)
+			}
+			h := newPostHandler(ctrl, posts)
+
+			w := serve(t, h.GetByID, request{method: http.MethodGet, route: "/posts/:id", path: tt.path, user: tt.user})
+			if w.Code != tt.status {
+				t.Fatalf("got %d, want %d: %s", w.Code, tt.status, w.Body)
+			}
+			if code := errorCode(t, w); code != tt.code {
+				t.Errorf("got error code %d, want %d", code, tt.code)
+			}
+		})
+	}
+}
+
+func TestPostHandlerUpdate(t *testing.T) {
+	user := newUser(models.RoleUser)
+	id := uuid.New()
+	path := "/posts/" + id.String()
+
+	tests := []struct {
+		name   string
+		body   interface{}
+		setup  func(posts *mocks.MockPostService)
+		status int
+		code   int
+	}{
+		{
+			name:   "unknown status",
+			body:   map[string]string{"status": "secret"},
+			status: http.StatusBadRequest,
+			code:   apperrors.CodeValidationError,
+		},
+		{
+			name:   "title too long",
+			body:   map[string]string{"title": strings.Repeat("a", 256)},
+			status: http.StatusBadRequest,
+			code:   apperrors.CodeValidationError,
+		},
+		{
+			name: "not found",
+			body: map[string]string{"title": "Renamed"},
+			setup: func(posts *mocks.MockPostService) {
+				posts.EXPECT().Update(gomock.Any(), id, user.ID, false, gomock.Any()).Return(nil, apperrors.ErrNotFound)
+			},
+			status: http.StatusNotFound,
+			code:   apperrors.CodeNotFound,
+		},
+		{
+			name: "not the author",
+			body: map[string]string{"title": "Renamed"},
+			setup: func(posts *mocks.MockPostService) {
+				posts.EXPECT().Update(gomock.Any(), id, user.ID, false, gomock.Any()).Return(nil, apperrors.ErrForbidden)
+			},
+			status: http.StatusForbidden,
+			code:   apperrors.CodeForbidden,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			posts := mocks.NewMockPostService(ctrl)
+			if tt.setup != nil {
+				tt.setup(posts)
+			}
+			h := newPostHandler(ctrl, posts)
+
+			w := serve(t, h.Update, request{method: http.MethodPut, route: "/posts/:id", path: path, user: user, body: tt.body})
+			if w.Code != tt.status {
+				t.Fatalf("got %d, want %d: %s", w.Code, tt.status, w.Body)
+			}
+			if code := errorCode(t, w); code != tt.code {
+				t.Errorf("got error code %d, want %d", code, tt.code)
+			}
+		})
+	}
+}
+
+func TestPostHandlerDelete(t *testing.T) {
+	user := newUser(models.RoleUser)
+	id := uuid.New()
+	path := "/posts/" + id.String()
+
+	tests := []struct {
+		name   string
+		err    error
+		status int
+		code   int
+	}{
+		{"not found", apperrors.ErrNotFound, http.StatusNotFound, apperrors.CodeNotFound},
+		{"not the author", apperrors.ErrForbidden, http.StatusForbidden, apperrors.CodeForbidden},
+		{"deleted", nil, http.StatusNoContent, 0},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			posts := mocks.NewMockPostService(ctrl)
+			posts.EXPECT().Delete(gomock.Any(), id, user.ID, false).Return(tt.err)
+			h := newPostHandler(ctrl, posts)
+
+			w := serve(t, h.Delete, request{method: http.MethodDelete, route: "/posts/:id", path: path, user: user})
+			if w.Code != tt.status {
+				t.Fatalf("got %d, want %d: %s", w.Code, tt.status, w.Body)
+			}
+			if tt.err != nil {
+				if code := errorCode(t, w); code != tt.code {
+					t.Errorf("got error code %d, want %d", code, tt.code)
+				}
+			}
+		})
+	}
+}
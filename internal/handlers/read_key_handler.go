@@ -0,0 +1,282 @@
+package handlers
+
+import (
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"github.com/yourusername/go-enterprise-api/internal/middleware"
+	"github.com/yourusername/go-enterprise-api/internal/models"
+	"github.com/yourusername/go-enterprise-api/internal/services"
+	"github.com/yourusername/go-enterprise-api/pkg/response"
+	"github.com/yourusername/go-enterprise-api/pkg/validator"
+)
+
+// ReadKeyHandler handles anonymous read key management requests
+type ReadKeyHandler struct {
+	readKeyService services.ReadKeyService
+}
+
+// NewReadKeyHandler creates a new read key handler
+func NewReadKeyHandler(readKeyService services.ReadKeyService) *ReadKeyHandler {
+	return &ReadKeyHandler{
+		readKeyService: readKeyService,
+	}
+}
+
+// Create issues a new read key
+// @Summary Create read key
+// @Description Issue a read key for an anonymous consumer of published content such as a syndication partner; the key is only shown once (admin only)
+// @Tags admin
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param request body services.CreateReadKeyRequest true "Read key data"
+// @Success 201 {object} response.Response
+// @Failure 400 {object} response.Response
+// @Failure 401 {object} response.Response
+// @Failure 403 {object} response.Response
+// @Router /admin/read-keys [post]
+func (h *ReadKeyHandler) Create(c *gin.Context) {
+	var req services.CreateReadKeyRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		response.BindingError(c, err)
+		return
+	}
+
+	v := validator.New()
+	v.When(req.Tier != "").InSlice("tier", req.Tier, readKeyTiers(), "")
+
+	if errs := v.Validate(); errs != nil {
+		response.ValidationError(c, errs)
+		return
+	}
+
+	user := middleware.MustGetUser(c)
+
+	key, rawKey, err := h.readKeyService.Create(c.Request.Context(), user.ID, &req)
+	if err != nil {
+		response.Error(c, err)
+		return
+	}
+
+	response.Created(c, gin.H{
+		"read_key": key.ToResponse(),
+		"key":      rawKey,
+	})
+}
+
+// GetAll returns all read keys
+// @Summary List read keys
+// @Description Get a paginated list of read keys, newest first (admin only)
+// @Tags admin
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param tier query string false "Filter by tier (free or partner)"
+// @Param page query int false "Page number" default(1)
+// @Param page_size query int false "Page size" default(10)
+// @Success 200 {object} response.Response
+// @Failure 400 {object} response.Response
+// @Failure 401 {object} response.Response
+// @Failure 403 {object} response.Response
+// @Router /admin/read-keys [get]
+func (h *ReadKeyHandler) GetAll(c *gin.Context) {
+	page, _ := strconv.Atoi(c.DefaultQuery("page", "1"))
+	pageSize, _ := strconv.Atoi(c.DefaultQuery("page_size", "10"))
+	tier := c.Query("tier")
+
+	if tier != "" {
+		v := validator.New()
+		v.InSlice("tier", tier, readKeyTiers(), "")
+		if errs := v.Validate(); errs != nil {
+			response.ValidationError(c, errs)
+			return
+		}
+	}
+
+	keys, total, err := h.readKeyService.GetAll(c.Request.Context(), models.ReadKeyTier(tier), page, pageSize)
+	if err != nil {
+		response.Error(c, err)
+		return
+	}
+
+	keyResponses := make([]*models.ReadKeyResponse, len(keys))
+	for i, key := range keys {
+		keyResponses[i] = key.ToResponse()
+	}
+
+	response.Paginated(c, keyResponses, page, pageSize, total)
+}
+
+// GetByID returns a read key
+// @Summary Get read key
+// @Description Get a read key by ID (admin only)
+// @Tags admin
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "Read key ID"
+// @Success 200 {object} response.Response
+// @Failure 400 {object} response.Response
+// @Failure 401 {object} response.Response
+// @Failure 403 {object} response.Response
+// @Failure 404 {object} response.Response
+// @Router /admin/read-keys/{id} [get]
+func (h *ReadKeyHandler) GetByID(c *gin.Context) {
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		response.BadRequest(c, "Invalid read key ID")
+		return
+	}
+
+	key, err := h.readKeyService.GetByID(c.Request.Context(), id)
+	if err != nil {
+		response.Error(c, err)
+		return
+	}
+
+	response.Success(c, gin.H{
+		"read_key": key.ToResponse(),
+	})
+}
+
+// Update updates a read key
+// @Summary Update read key
+// @Description Change the name, contact or tier of a read key; a new tier applies from the key's next request (admin only)
+// @Tags admin
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "Read key ID"
+// @Param request body services.UpdateReadKeyRequest true "Read key data"
+// @Success 200 {object} response.Response
+// @Failure 400 {object} response.Response
+// @Failure 401 {object} response.Response
+// @Failure 403 {object} response.Response
+// @Failure 404 {object} response.Response
+// @Router /admin/read-keys/{id} [patch]
+func (h *ReadKeyHandler) Update(c *gin.Context) {
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		response.BadRequest(c, "Invalid read key ID")
+		return
+	}
+
+	var req services.UpdateReadKeyRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		response.BindingError(c, err)
+		return
+	}
+
+	v := validator.New()
+	if req.Tier != nil {
+		v.InSlice("tier", *req.Tier, readKeyTiers(), "")
+	}
+
+	if errs := v.Validate(); errs != nil {
+		response.ValidationError(c, errs)
+		return
+	}
+
+	key, err := h.readKeyService.Update(c.Request.Context(), id, &req)
+	if err != nil {
+		response.Error(c, err)
+		return
+	}
+
+	response.Success(c, gin.H{
+		"read_key": key.ToResponse(),
+	})
+}
+
+// Revoke revokes a read key
+// @Summary Revoke read key
+// @Description Revoke a read key; requests made with it are rejected from then on (admin only)
+// @Tags admin
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "Read key ID"
+// @Success 204
+// @Failure 400 {object} response.Response
+// @Failure 401 {object} response.Response
+// @Failure 403 {object} response.Response
+// @Failure 404 {object} response.Response
+// @Router /admin/read-keys/{id} [delete]
+func (h *ReadKeyHandler) Revoke(c *gin.Context) {
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		response.BadRequest(c, "Invalid read key ID")
+		return
+	}
+
+	if err := h.readKeyService.Revoke(c.Request.Context(), id); err != nil {
+		response.Error(c, err)
+		return
+	}
+
+	response.NoContent(c)
+}
+
+// GetUsage returns the usage of a read key
+// @Summary Get read key usage
+// @Description Get the daily requests and post views of a read key for a date range (admin only)
+// @Tags admin
+// @Accept json
+// @Produce json,text/csv
+// @Security BearerAuth
+// @Param id path string true "Read key ID"
+// @Param from query string false "Start date (YYYY-MM-DD), defaults to 30 days ago"
+// @Param to query string false "End date (YYYY-MM-DD), defaults to today"
+// @Param format query string false "Response format (json or csv)" default(json)
+// @Success 200 {object} response.Response
+// @Failure 400 {object} response.Response
+// @Failure 401 {object} response.Response
+// @Failure 403 {object} response.Response
+// @Failure 404 {object} response.Response
+// @Router /admin/read-keys/{id}/usage [get]
+func (h *ReadKeyHandler) GetUsage(c *gin.Context) {
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		response.BadRequest(c, "Invalid read key ID")
+		return
+	}
+
+	from, to, format, ok := parseAnalyticsQuery(c)
+	if !ok {
+		return
+	}
+
+	usage, err := h.readKeyService.GetUsage(c.Request.Context(), id, from, to)
+	if err != nil {
+		response.Error(c, err)
+		return
+	}
+
+	if format == formatCSV {
+		records := [][]string{{"date", "requests", "post_views"}}
+		for _, day := range usage.Daily {
+			records = append(records, []string{
+				day.Date,
+				strconv.FormatInt(day.Requests, 10),
+				strconv.FormatInt(day.PostViews, 10),
+			})
+		}
+		response.CSV(c, "read-key-"+id.String()+"-usage.csv", records)
+		return
+	}
+
+	response.Success(c, gin.H{
+		"usage": usage,
+	})
+}
+
+// readKeyTiers returns the valid read key tiers as strings
+func readKeyTiers() []string {
+	tiers := make([]string, len(models.ReadKeyTiers))
+	for i, tier := range models.ReadKeyTiers {
+		tiers[i] = string(tier)
+	}
+	return tiers
+}
@@ -0,0 +1,73 @@
+package handlers
+
+import (
+	"github.com/gin-gonic/gin"
+	"github.com/yourusername/go-enterprise-api/internal/middleware"
+	"github.com/yourusername/go-enterprise-api/pkg/logger"
+	"github.com/yourusername/go-enterprise-api/pkg/response"
+)
+
+// ReadOnlyHandler handles toggling the API's read-only mode
+type ReadOnlyHandler struct {
+	mode *middleware.ReadOnlyMode
+}
+
+// NewReadOnlyHandler creates a new read-only mode handler
+func NewReadOnlyHandler(mode *middleware.ReadOnlyMode) *ReadOnlyHandler {
+	return &ReadOnlyHandler{
+		mode: mode,
+	}
+}
+
+// SetReadOnlyRequest represents a request to toggle read-only mode
+type SetReadOnlyRequest struct {
+	Enabled *bool `json:"enabled" binding:"required"`
+}
+
+// Get returns whether read-only mode is enabled
+// @Summary Get read-only mode
+// @Description Get whether the API rejects mutating requests (admin only)
+// @Tags admin
+// @Produce json
+// @Security BearerAuth
+// @Success 200 {object} response.Response
+// @Router /admin/read-only [get]
+func (h *ReadOnlyHandler) Get(c *gin.Context) {
+	response.Success(c, gin.H{
+		"read_only": h.mode.Enabled(),
+	})
+}
+
+// Set turns read-only mode on or off
+// @Summary Set read-only mode
+// @Description Turn read-only mode on or off for this instance (admin only)
+// @Tags admin
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param request body SetReadOnlyRequest true "Read-only state"
+// @Success 200 {object} response.Response
+// @Failure 400 {object} response.Response
+// @Router /admin/read-only [put]
+func (h *ReadOnlyHandler) Set(c *gin.Context) {
+	var req SetReadOnlyRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		response.BindingError(c, err)
+		return
+	}
+
+	user := middleware.MustGetUser(c)
+	h.mode.Set(*req.Enabled)
+	logger.Info("Read-only mode changed",
+		logger.Any("enabled", *req.Enabled),
+		logger.String("admin_id", user.ID.String()),
+	)
+
+	message := "Read-only mode disabled"
+	if *req.Enabled {
+		message = "Read-only mode enabled"
+	}
+	response.SuccessWithMessage(c, message, gin.H{
+		"read_only": *req.Enabled,
+	})
+}
@@ -0,0 +1,32 @@
+package handlers
+
+import (
+	"github.com/gin-gonic/gin"
+	"github.com/yourusername/go-enterprise-api/internal/middleware"
+	"github.com/yourusername/go-enterprise-api/internal/realtime"
+)
+
+// RealtimeHandler handles WebSocket connections
+type RealtimeHandler struct {
+	hub *realtime.Hub
+}
+
+// NewRealtimeHandler creates a new realtime handler
+func NewRealtimeHandler(hub *realtime.Hub) *RealtimeHandler {
+	return &RealtimeHandler{
+		hub: hub,
+	}
+}
+
+// Connect upgrades the request to a WebSocket connection pushing real-time updates
+// @Summary Connect for real-time updates
+// @Description Open a WebSocket connection receiving the user's new notifications and new comments on the posts it subscribes to. Browsers authenticate by offering the subprotocols "access_token" and their access token.
+// @Tags realtime
+// @Security BearerAuth
+// @Success 101 "Switching Protocols"
+// @Failure 401 {object} response.Response
+// @Router /ws [get]
+func (h *RealtimeHandler) Connect(c *gin.Context) {
+	user := middleware.MustGetUser(c)
+	h.hub.Serve(c.Writer, c.Request, user)
+}
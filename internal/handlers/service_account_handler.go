@@ -0,0 +1,207 @@
+package handlers
+
+import (
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"github.com/yourusername/go-enterprise-api/internal/models"
+	"github.com/yourusername/go-enterprise-api/internal/services"
+	"github.com/yourusername/go-enterprise-api/pkg/response"
+	"github.com/yourusername/go-enterprise-api/pkg/validator"
+)
+
+// ServiceAccountHandler handles service account management requests
+type ServiceAccountHandler struct {
+	serviceAccountService services.ServiceAccountService
+}
+
+// NewServiceAccountHandler creates a new service account handler
+func NewServiceAccountHandler(serviceAccountService services.ServiceAccountService) *ServiceAccountHandler {
+	return &ServiceAccountHandler{
+		serviceAccountService: serviceAccountService,
+	}
+}
+
+// Create creates a new service account
+// @Summary Create service account
+// @Description Create a non-interactive service account (admin only)
+// @Tags admin
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param request body services.CreateServiceAccountRequest true "Service account data"
+// @Success 201 {object} response.Response
+// @Failure 400 {object} response.Response
+// @Failure 401 {object} response.Response
+// @Failure 403 {object} response.Response
+// @Router /admin/service-accounts [post]
+func (h *ServiceAccountHandler) Create(c *gin.Context) {
+	var req services.CreateServiceAccountRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		response.BindingError(c, err)
+		return
+	}
+
+	v := validator.New()
+	v.When(req.Role != "").InSlice("role", req.Role, []string{string(models.RoleUser), string(models.RoleModerator), string(models.RoleAdmin)}, "")
+
+	if errs := v.Validate(); errs != nil {
+		response.ValidationError(c, errs)
+		return
+	}
+
+	user, err := h.serviceAccountService.Create(c.Request.Context(), &req)
+	if err != nil {
+		response.Error(c, err)
+		return
+	}
+
+	response.Created(c, gin.H{
+		"user": user.ToResponse(),
+	})
+}
+
+// GetAll returns all service accounts
+// @Summary List service accounts
+// @Description Get a paginated list of service accounts (admin only)
+// @Tags admin
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param page query int false "Page number" default(1)
+// @Param page_size query int false "Page size" default(10)
+// @Success 200 {object} response.Response
+// @Failure 401 {object} response.Response
+// @Failure 403 {object} response.Response
+// @Router /admin/service-accounts [get]
+func (h *ServiceAccountHandler) GetAll(c *gin.Context) {
+	page, _ := strconv.Atoi(c.DefaultQuery("page", "1"))
+	pageSize, _ := strconv.Atoi(c.DefaultQuery("page_size", "10"))
+
+	users, total, err := h.serviceAccountService.GetAll(c.Request.Context(), page, pageSize)
+	if err != nil {
+		response.Error(c, err)
+		return
+	}
+
+	userResponses := make([]*models.UserResponse, len(users))
+	for i, user := range users {
+		userResponses[i] = user.ToResponse()
+	}
+
+	response.Paginated(c, userResponses, page, pageSize, total)
+}
+
+// CreateAPIKey issues a new API key for a service account
+// @Summary Create API key
+// @Description Issue an API key for a service account; the key is only shown once (admin only)
+// @Tags admin
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "Service account ID"
+// @Param request body services.CreateAPIKeyRequest true "API key data"
+// @Success 201 {object} response.Response
+// @Failure 400 {object} response.Response
+// @Failure 401 {object} response.Response
+// @Failure 403 {object} response.Response
+// @Failure 404 {object} response.Response
+// @Router /admin/service-accounts/{id}/keys [post]
+func (h *ServiceAccountHandler) CreateAPIKey(c *gin.Context) {
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		response.BadRequest(c, "Invalid service account ID")
+		return
+	}
+
+	var req services.CreateAPIKeyRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		response.BindingError(c, err)
+		return
+	}
+
+	key, rawKey, err := h.serviceAccountService.CreateAPIKey(c.Request.Context(), id, &req)
+	if err != nil {
+		response.Error(c, err)
+		return
+	}
+
+	response.Created(c, gin.H{
+		"api_key": key.ToResponse(),
+		"key":     rawKey,
+	})
+}
+
+// ListAPIKeys lists the API keys of a service account
+// @Summary List API keys
+// @Description List the API keys of a service account (admin only)
+// @Tags admin
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "Service account ID"
+// @Success 200 {object} response.Response
+// @Failure 400 {object} response.Response
+// @Failure 401 {object} response.Response
+// @Failure 403 {object} response.Response
+// @Failure 404 {object} response.Response
+// @Router /admin/service-accounts/{id}/keys [get]
+func (h *ServiceAccountHandler) ListAPIKeys(c *gin.Context) {
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		response.BadRequest(c, "Invalid service account ID")
+		return
+	}
+
+	keys, err := h.serviceAccountService.ListAPIKeys(c.Request.Context(), id)
+	if err != nil {
+		response.Error(c, err)
+		return
+	}
+
+	keyResponses := make([]*models.APIKeyResponse, len(keys))
+	for i, key := range keys {
+		keyResponses[i] = key.ToResponse()
+	}
+
+	response.Success(c, gin.H{
+		"api_keys": keyResponses,
+	})
+}
+
+// RevokeAPIKey revokes a service account API key
+// @Summary Revoke API key
+// @Description Revoke an API key of a service account (admin only)
+// @Tags admin
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "Service account ID"
+// @Param key_id path string true "API key ID"
+// @Success 204
+// @Failure 400 {object} response.Response
+// @Failure 401 {object} response.Response
+// @Failure 403 {object} response.Response
+// @Failure 404 {object} response.Response
+// @Router /admin/service-accounts/{id}/keys/{key_id} [delete]
+func (h *ServiceAccountHandler) RevokeAPIKey(c *gin.Context) {
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		response.BadRequest(c, "Invalid service account ID")
+		return
+	}
+
+	keyID, err := uuid.Parse(c.Param("key_id"))
+	if err != nil {
+		response.BadRequest(c, "Invalid API key ID")
+		return
+	}
+
+	if err := h.serviceAccountService.RevokeAPIKey(c.Request.Context(), id, keyID); err != nil {
+		response.Error(c, err)
+		return
+	}
+
+	response.NoContent(c)
+}
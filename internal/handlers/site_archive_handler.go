@@ -0,0 +1,113 @@
+package handlers
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/yourusername/go-enterprise-api/internal/services"
+	"github.com/yourusername/go-enterprise-api/pkg/logger"
+	"github.com/yourusername/go-enterprise-api/pkg/response"
+)
+
+// SiteArchiveHandler handles exporting and importing the whole site
+type SiteArchiveHandler struct {
+	siteArchiveService services.SiteArchiveService
+	maxUploadSize      int64
+}
+
+// NewSiteArchiveHandler creates a new site archive handler
+func NewSiteArchiveHandler(siteArchiveService services.SiteArchiveService, maxUploadSize int64) *SiteArchiveHandler {
+	return &SiteArchiveHandler{
+		siteArchiveService: siteArchiveService,
+		maxUploadSize:      maxUploadSize,
+	}
+}
+
+// Export streams an archive of the whole site
+// @Summary Export site
+// @Description Download a versioned archive of the whole site: users without passwords, follows, tags, posts, comments and a manifest of referenced media (admin only)
+// @Tags admin
+// @Produce application/zip
+// @Security BearerAuth
+// @Success 200 {file} file
+// @Failure 401 {object} response.Response
+// @Failure 403 {object} response.Response
+// @Router /admin/site/export [get]
+func (h *SiteArchiveHandler) Export(c *gin.Context) {
+	w := &exportWriter{c: c, filename: "site-" + time.Now().UTC().Format("2006-01-02") + ".zip"}
+
+	if err := h.siteArchiveService.Export(c.Request.Context(), w); err != nil {
+		if !w.started {
+			response.Error(c, err)
+			return
+		}
+		// The response is already under way, so the client can only be told by
+		// an incomplete download
+		logger.Error("Site export aborted", logger.Err(err))
+		c.Abort()
+	}
+}
+
+// exportWriter writes an archive to the response, sending the headers with
+// the first write so errors before it can still be reported as JSON
+type exportWriter struct {
+	c        *gin.Context
+	filename string
+	started  bool
+}
+
+func (w *exportWriter) Write(p []byte) (int, error) {
+	if !w.started {
+		w.c.Header("Content-Disposition", `attachment; filename="`+w.filename+`"`)
+		w.c.Header("Content-Type", "application/zip")
+		w.c.Status(http.StatusOK)
+		w.started = true
+	}
+	return w.c.Writer.Write(p)
+}
+
+// Import imports a site archive into a site without content
+// @Summary Import site
+// @Description Import a site archive into a site without posts, tags, comments or follows. Users are matched to existing users by email; imported users cannot log in until an admin sets up their password (admin only)
+// @Tags admin
+// @Accept multipart/form-data
+// @Produce json
+// @Security BearerAuth
+// @Param file formData file true "Site archive (.zip)"
+// @Success 200 {object} response.Response
+// @Failure 400 {object} response.Response
+// @Failure 401 {object} response.Response
+// @Failure 403 {object} response.Response
+// @Failure 409 {object} response.Response
+// @Router /admin/site/import [post]
+func (h *SiteArchiveHandler) Import(c *gin.Context) {
+	c.Request.Body = http.MaxBytesReader(c.Writer, c.Request.Body, h.maxUploadSize)
+	header, err := c.FormFile("file")
+	if err != nil {
+		var maxBytesErr *http.MaxBytesError
+		if errors.As(err, &maxBytesErr) {
+			response.BadRequest(c, fmt.Sprintf("Site archive must be at most %d bytes", h.maxUploadSize))
+			return
+		}
+		response.BadRequest(c, "A site archive is required")
+		return
+	}
+
+	file, err := header.Open()
+	if err != nil {
+		response.BadRequest(c, "Failed to read the site archive")
+		return
+	}
+	defer file.Close()
+
+	report, err := h.siteArchiveService.Import(c.Request.Context(), file, header.Size)
+	if err != nil {
+		response.Error(c, err)
+		return
+	}
+
+	response.SuccessWithMessage(c, "Site imported", report)
+}
@@ -0,0 +1,40 @@
+package handlers
+
+import (
+	"github.com/gin-gonic/gin"
+	"github.com/yourusername/go-enterprise-api/internal/services"
+	"github.com/yourusername/go-enterprise-api/pkg/response"
+)
+
+// StaticSiteHandler handles static site exports
+type StaticSiteHandler struct {
+	staticSiteService services.StaticSiteService
+}
+
+// NewStaticSiteHandler creates a new static site handler
+func NewStaticSiteHandler(staticSiteService services.StaticSiteService) *StaticSiteHandler {
+	return &StaticSiteHandler{staticSiteService: staticSiteService}
+}
+
+// Export queues an export of the static site
+// @Summary Export static site
+// @Description Queue a background job rendering all public posts, tag pages and author pages into a static site stored in the storage backend. Its progress can be followed under /admin/jobs (admin only).
+// @Tags admin
+// @Produce json
+// @Security BearerAuth
+// @Success 200 {object} response.Response
+// @Failure 401 {object} response.Response
+// @Failure 403 {object} response.Response
+// @Failure 409 {object} response.Response
+// @Router /admin/site/static-export [post]
+func (h *StaticSiteHandler) Export(c *gin.Context) {
+	job, err := h.staticSiteService.QueueExport(c.Request.Context())
+	if err != nil {
+		response.Error(c, err)
+		return
+	}
+
+	response.SuccessWithMessage(c, "Static site export queued", gin.H{
+		"job": job.ToResponse(),
+	})
+}
@@ -0,0 +1,98 @@
+package handlers
+
+import (
+	"github.com/gin-gonic/gin"
+	"github.com/yourusername/go-enterprise-api/internal/middleware"
+	"github.com/yourusername/go-enterprise-api/internal/services"
+	"github.com/yourusername/go-enterprise-api/pkg/response"
+)
+
+// TagHandler handles tag requests
+type TagHandler struct {
+	tagService services.TagService
+}
+
+// NewTagHandler creates a new tag handler
+func NewTagHandler(tagService services.TagService) *TagHandler {
+	return &TagHandler{
+		tagService: tagService,
+	}
+}
+
+// CreateTagAliasRequest represents a request to create a tag alias
+type CreateTagAliasRequest struct {
+	Name string `json:"name" binding:"notblank,max=100" sanitize:"text,striptags"`
+}
+
+// GetSynonyms returns a tag and its aliases
+// @Summary Get tag synonyms
+// @Description Get a tag and its aliases. The slug may be the slug of the tag or of one of its aliases.
+// @Tags tags
+// @Produce json
+// @Param slug path string true "Tag or alias slug"
+// @Success 200 {object} response.Response
+// @Failure 404 {object} response.Response
+// @Router /tags/{slug}/aliases [get]
+func (h *TagHandler) GetSynonyms(c *gin.Context) {
+	tag, err := h.tagService.GetSynonyms(c.Request.Context(), c.Param("slug"))
+	if err != nil {
+		response.Error(c, err)
+		return
+	}
+
+	response.Success(c, tag.ToSynonymsResponse())
+}
+
+// CreateAlias adds an alias to a tag
+// @Summary Create tag alias
+// @Description Make a name an alias of a tag. An existing tag with the same slug is merged into the tag (admin only).
+// @Tags admin
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param slug path string true "Tag slug"
+// @Param request body CreateTagAliasRequest true "Alias name"
+// @Success 201 {object} response.Response
+// @Failure 400 {object} response.Response
+// @Failure 404 {object} response.Response
+// @Failure 409 {object} response.Response
+// @Router /admin/tags/{slug}/aliases [post]
+func (h *TagHandler) CreateAlias(c *gin.Context) {
+	var req CreateTagAliasRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		response.BindingError(c, err)
+		return
+	}
+
+	user := middleware.MustGetUser(c)
+	alias, err := h.tagService.CreateAlias(c.Request.Context(), user.ID, c.Param("slug"), req.Name)
+	if err != nil {
+		response.Error(c, err)
+		return
+	}
+
+	response.Created(c, gin.H{
+		"alias": alias.ToResponse(),
+	})
+}
+
+// DeleteAlias removes an alias from a tag
+// @Summary Delete tag alias
+// @Description Remove an alias from a tag. Posts keep the canonical tag (admin only).
+// @Tags admin
+// @Produce json
+// @Security BearerAuth
+// @Param slug path string true "Tag slug"
+// @Param alias path string true "Alias slug"
+// @Success 204
+// @Failure 404 {object} response.Response
+// @Router /admin/tags/{slug}/aliases/{alias} [delete]
+func (h *TagHandler) DeleteAlias(c *gin.Context) {
+	user := middleware.MustGetUser(c)
+	if err := h.tagService.DeleteAlias(c.Request.Context(), user.ID, c.Param("slug"), c.Param("alias")); err != nil {
+		response.Error(c, err)
+		return
+	}
+
+	response.NoContent(c)
+}
@@ -0,0 +1,134 @@
+package handlers
+
+import (
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"github.com/yourusername/go-enterprise-api/internal/middleware"
+	"github.com/yourusername/go-enterprise-api/internal/models"
+	"github.com/yourusername/go-enterprise-api/internal/services"
+	"github.com/yourusername/go-enterprise-api/pkg/response"
+	"github.com/yourusername/go-enterprise-api/pkg/validator"
+)
+
+// TakedownHandler handles content takedowns and appeals
+type TakedownHandler struct {
+	takedownService services.TakedownService
+}
+
+// NewTakedownHandler creates a new takedown handler
+func NewTakedownHandler(takedownService services.TakedownService) *TakedownHandler {
+	return &TakedownHandler{
+		takedownService: takedownService,
+	}
+}
+
+// TakeDown takes down a post
+// @Summary Take down post
+// @Description Remove a published post from public view, keeping its content for appeals. Its URLs answer 410 Gone with a tombstone and the author is notified (admin only).
+// @Tags admin
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "Post ID"
+// @Param request body services.TakedownRequest true "Reason category and internal note"
+// @Success 200 {object} response.Response
+// @Failure 400 {object} response.Response
+// @Failure 404 {object} response.Response
+// @Failure 409 {object} response.Response
+// @Router /admin/posts/{id}/takedown [post]
+func (h *TakedownHandler) TakeDown(c *gin.Context) {
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		response.BadRequest(c, "Invalid post ID")
+		return
+	}
+
+	var req services.TakedownRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		response.BindingError(c, err)
+		return
+	}
+
+	v := validator.New()
+	validator.OneOf(v, "reason", models.TakedownReason(req.Reason), "", models.TakedownReasons...)
+	if errs := v.Validate(); errs != nil {
+		response.ValidationError(c, errs)
+		return
+	}
+
+	user := middleware.MustGetUser(c)
+
+	post, err := h.takedownService.TakeDown(c.Request.Context(), id, user.ID, &req)
+	if err != nil {
+		response.Error(c, err)
+		return
+	}
+
+	response.SuccessWithMessage(c, "Post taken down", gin.H{
+		"takedown": post.ToTakedownResponse(),
+	})
+}
+
+// Reinstate reinstates a taken down post
+// @Summary Reinstate post
+// @Description Republish a taken down post, e.g. after a successful appeal. The author is notified (admin only).
+// @Tags admin
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "Post ID"
+// @Success 200 {object} response.Response
+// @Failure 400 {object} response.Response
+// @Failure 404 {object} response.Response
+// @Failure 409 {object} response.Response
+// @Router /admin/posts/{id}/takedown [delete]
+func (h *TakedownHandler) Reinstate(c *gin.Context) {
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		response.BadRequest(c, "Invalid post ID")
+		return
+	}
+
+	user := middleware.MustGetUser(c)
+
+	post, err := h.takedownService.Reinstate(c.Request.Context(), id, user.ID)
+	if err != nil {
+		response.Error(c, err)
+		return
+	}
+
+	response.SuccessWithMessage(c, "Post reinstated", gin.H{
+		"post": post.ToResponse(),
+	})
+}
+
+// GetAll returns taken down posts
+// @Summary List takedowns
+// @Description Get a paginated list of taken down posts with their reasons and appeals, most recent first (admin only)
+// @Tags admin
+// @Produce json
+// @Security BearerAuth
+// @Param appealed query bool false "Only takedowns under appeal"
+// @Param page query int false "Page number" default(1)
+// @Param page_size query int false "Page size" default(10)
+// @Success 200 {object} response.Response
+// @Router /admin/takedowns [get]
+func (h *TakedownHandler) GetAll(c *gin.Context) {
+	page, _ := strconv.Atoi(c.DefaultQuery("page", "1"))
+	pageSize, _ := strconv.Atoi(c.DefaultQuery("page_size", "10"))
+	appealedOnly, _ := strconv.ParseBool(c.Query("appealed"))
+
+	posts, total, err := h.takedownService.GetTakedowns(c.Request.Context(), appealedOnly, page, pageSize)
+	if err != nil {
+		response.Error(c, err)
+		return
+	}
+
+	takedowns := make([]*models.TakedownResponse, len(posts))
+	for i := range posts {
+		takedowns[i] = posts[i].ToTakedownResponse()
+	}
+
+	response.Paginated(c, takedowns, page, pageSize, total)
+}
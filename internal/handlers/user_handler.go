@@ -1,46 +1,108 @@
 package handlers
 
 import (
+	"errors"
+	"fmt"
+	"net/http"
 	"strconv"
+	"strings"
+	"time"
 
 	"github.com/gin-gonic/gin"
 	"github.com/google/uuid"
+	"github.com/yourusername/go-enterprise-api/internal/avatar"
+	"github.com/yourusername/go-enterprise-api/internal/imageproxy"
 	"github.com/yourusername/go-enterprise-api/internal/middleware"
 	"github.com/yourusername/go-enterprise-api/internal/models"
 	"github.com/yourusername/go-enterprise-api/internal/services"
 	apperrors "github.com/yourusername/go-enterprise-api/pkg/errors"
+	"github.com/yourusername/go-enterprise-api/pkg/logger"
 	"github.com/yourusername/go-enterprise-api/pkg/response"
+	"github.com/yourusername/go-enterprise-api/pkg/validator"
 )
 
 // UserHandler handles user-related requests
 type UserHandler struct {
-	userService services.UserService
+	userService   services.UserService
+	avatarService services.AvatarService
+	avatars       *avatar.Generator
+	imageProxy    *imageproxy.Proxy
+	maxSize       int64
 }
 
-// NewUserHandler creates a new user handler
-func NewUserHandler(userService services.UserService) *UserHandler {
+// NewUserHandler creates a new user handler. Avatar URLs set on profiles are
+// fetched through imageProxy; when it is nil, the generated avatar is served
+// instead.
+func NewUserHandler(userService services.UserService, avatarService services.AvatarService, avatars *avatar.Generator, imageProxy *imageproxy.Proxy, maxSize int64) *UserHandler {
 	return &UserHandler{
-		userService: userService,
+		userService:   userService,
+		avatarService: avatarService,
+		avatars:       avatars,
+		imageProxy:    imageProxy,
+		maxSize:       maxSize,
 	}
 }
 
 // GetAll returns all users with pagination
 // @Summary Get all users
-// @Description Get a paginated list of users
+// @Description Get a paginated list of users. Admins can filter and sort the list, and export it as CSV.
 // @Tags users
 // @Accept json
-// @Produce json
+// @Produce json,text/csv
 // @Security BearerAuth
+// @Param role query string false "Filter by role (user, admin, moderator), admin only"
+// @Param status query string false "Filter by status (active, inactive, banned, pending, shadow_banned), admin only"
+// @Param email_verified query bool false "Filter by whether the email is verified, admin only"
+// @Param created_from query string false "Only users created on or after this date (YYYY-MM-DD or RFC 3339), admin only"
+// @Param created_to query string false "Only users created on or before this date (YYYY-MM-DD or RFC 3339), admin only"
+// @Param sort query string false "Sort by created_at, email, first_name, last_name or last_login_at, prefixed with - for descending order, admin only" default(created_at)
+// @Param format query string false "Response format (json or csv), admin only; csv exports every matching user" default(json)
 // @Param page query int false "Page number" default(1)
 // @Param page_size query int false "Page size" default(10)
 // @Success 200 {object} response.Response
+// @Failure 400 {object} response.Response
 // @Failure 401 {object} response.Response
+// @Failure 403 {object} response.Response
 // @Router /users [get]
 func (h *UserHandler) GetAll(c *gin.Context) {
 	page, _ := strconv.Atoi(c.DefaultQuery("page", "1"))
 	pageSize, _ := strconv.Atoi(c.DefaultQuery("page_size", "10"))
 
-	users, total, err := h.userService.GetAll(c.Request.Context(), page, pageSize)
+	currentUser := middleware.MustGetUser(c)
+
+	filter, format, ok := parseUserFilter(c, currentUser)
+	if !ok {
+		return
+	}
+
+	if format == formatCSV {
+		records := [][]string{{"id", "email", "first_name", "last_name", "role", "status", "email_verified_at", "last_login_at", "created_at"}}
+		err := h.userService.Export(c.Request.Context(), filter, func(users []models.User) error {
+			for i := range users {
+				user := &users[i]
+				records = append(records, []string{
+					user.ID.String(),
+					user.Email,
+					user.FirstName,
+					user.LastName,
+					string(user.Role),
+					string(user.Status),
+					formatOptionalTime(user.EmailVerifiedAt),
+					formatOptionalTime(user.LastLoginAt),
+					user.CreatedAt.UTC().Format(time.RFC3339),
+				})
+			}
+			return nil
+		})
+		if err != nil {
+			response.Error(c, err)
+			return
+		}
+		response.CSV(c, "users.csv", records)
+		return
+	}
+
+	users, total, err := h.userService.GetAll(c.Request.Context(), filter, page, pageSize)
 	if err != nil {
 		response.Error(c, err)
 		return
@@ -49,7 +111,7 @@ func (h *UserHandler) GetAll(c *gin.Context) {
 	// Convert to response
 	userResponses := make([]*models.UserResponse, len(users))
 	for i, user := range users {
-		userResponses[i] = user.ToResponse()
+		userResponses[i] = user.ToResponseFor(currentUser)
 	}
 
 	response.Paginated(c, userResponses, page, pageSize, total)
@@ -80,14 +142,244 @@ func (h *UserHandler) GetByID(c *gin.Context) {
 		return
 	}
 
+	response.Success(c, gin.H{
+		"user": user.ToResponseFor(middleware.MustGetUser(c)),
+	})
+}
+
+// GetProfile returns a user's public profile
+// @Summary Get public user profile
+// @Description Get a user's public profile with their published post count and most recent posts (no authentication required)
+// @Tags users
+// @Produce json
+// @Param id path string true "User ID"
+// @Success 200 {object} response.Response
+// @Failure 400 {object} response.Response
+// @Failure 404 {object} response.Response
+// @Router /users/{id}/profile [get]
+func (h *UserHandler) GetProfile(c *gin.Context) {
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		response.BadRequest(c, "Invalid user ID")
+		return
+	}
+
+	viewer, _ := middleware.GetUser(c)
+	profile, err := h.userService.GetProfile(c.Request.Context(), id, viewer)
+	if err != nil {
+		response.Error(c, err)
+		return
+	}
+
+	response.Success(c, gin.H{
+		"profile": profile,
+	})
+}
+
+// Avatar serves a user's avatar
+// @Summary Get user avatar
+// @Description Serve the user's uploaded avatar in the smallest stored size of at least the requested size, a copy of the avatar URL set on their profile fetched through the image proxy, or a generated fallback avatar (SVG) when none is set or it cannot be fetched
+// @Tags users
+// @Produce image/jpeg,image/png,image/svg+xml
+// @Param id path string true "User ID"
+// @Param size query int false "Requested size in pixels (largest stored or supported size when omitted)"
+// @Success 200 {file} file
+// @Failure 400 {object} response.Response
+// @Failure 404 {object} response.Response
+// @Router /users/{id}/avatar [get]
+func (h *UserHandler) Avatar(c *gin.Context) {
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		response.BadRequest(c, "Invalid user ID")
+		return
+	}
+
+	size := 0
+	if value := c.Query("size"); value != "" {
+		size, err = strconv.Atoi(value)
+		if err != nil || size < 1 {
+			response.BadRequest(c, "Invalid size")
+			return
+		}
+	}
+
+	user, err := h.userService.GetByID(c.Request.Context(), id)
+	if err != nil {
+		response.Error(c, err)
+		return
+	}
+
+	if user.AvatarKey != "" {
+		h.serveUploadedAvatar(c, user, size)
+		return
+	}
+
+	if user.Avatar != "" && h.serveLinkedAvatar(c, user, size) {
+		return
+	}
+
+	svg, key := h.avatars.Generate(user.ID, user.FirstName, user.LastName, user.Email)
+	etag := `"` + key + `"`
+
+	c.Header("ETag", etag)
+	c.Header("Cache-Control", "public, max-age=86400")
+	c.Header("X-Content-Type-Options", "nosniff")
+
+	if c.GetHeader("If-None-Match") == etag {
+		c.Status(http.StatusNotModified)
+		return
+	}
+
+	c.Data(http.StatusOK, "image/svg+xml", svg)
+}
+
+// serveUploadedAvatar serves the requested size of a user's uploaded avatar
+func (h *UserHandler) serveUploadedAvatar(c *gin.Context, user *models.User, size int) {
+	data, contentType, err := h.avatarService.Open(c.Request.Context(), user, size)
+	if err != nil {
+		response.Error(c, err)
+		return
+	}
+
+	// Every upload is stored under a new key, so the key and size identify
+	// the content
+	etag := fmt.Sprintf(`"%s-%d"`, user.AvatarKey, size)
+
+	c.Header("ETag", etag)
+	c.Header("Cache-Control", "public, max-age=86400")
+	c.Header("X-Content-Type-Options", "nosniff")
+
+	if c.GetHeader("If-None-Match") == etag {
+		c.Status(http.StatusNotModified)
+		return
+	}
+
+	c.Data(http.StatusOK, contentType, data)
+}
+
+// serveLinkedAvatar serves a copy of the avatar URL set on a user's profile
+// and reports whether it did. The image is fetched through the image proxy,
+// with its host restrictions, rather than redirected to, so the API never
+// sends clients to an address a user chose.
+func (h *UserHandler) serveLinkedAvatar(c *gin.Context, user *models.User, size int) bool {
+	if h.imageProxy == nil {
+		return false
+	}
+
+	img, err := h.imageProxy.Get(c.Request.Context(), user.Avatar, size)
+	if err != nil {
+		logger.Warn("Failed to proxy avatar",
+			logger.String("user_id", user.ID.String()),
+			logger.Err(err),
+		)
+		return false
+	}
+
+	// The avatar URL may change at any time while the path serving it stays
+	// the same, so linked avatars are cached for less time than uploads
+	etag := `"` + img.ETag + `"`
+	c.Header("ETag", etag)
+	c.Header("Cache-Control", "public, max-age=3600")
+	c.Header("X-Content-Type-Options", "nosniff")
+
+	if c.GetHeader("If-None-Match") == etag {
+		c.Status(http.StatusNotModified)
+		return true
+	}
+
+	c.Data(http.StatusOK, img.ContentType, img.Data)
+	return true
+}
+
+// UploadAvatar uploads a new avatar for the current user
+// @Summary Upload avatar
+// @Description Upload a JPEG, PNG or GIF image as your avatar. The image is cropped to the square given by crop_x, crop_y and crop_size, in pixels of the upright image, or to its centered square when no crop is given, and stored in the standard avatar sizes. Your previous uploaded avatar is deleted.
+// @Tags users
+// @Accept multipart/form-data
+// @Produce json
+// @Security BearerAuth
+// @Param file formData file true "Image to upload"
+// @Param crop_x formData int false "Left edge of the crop square"
+// @Param crop_y formData int false "Top edge of the crop square"
+// @Param crop_size formData int false "Side length of the crop square"
+// @Success 200 {object} response.Response
+// @Failure 400 {object} response.Response
+// @Failure 401 {object} response.Response
+// @Router /users/me/avatar [post]
+func (h *UserHandler) UploadAvatar(c *gin.Context) {
+	c.Request.Body = http.MaxBytesReader(c.Writer, c.Request.Body, h.maxSize+multipartOverhead)
+	header, err := c.FormFile("file")
+	if err != nil {
+		var maxBytesErr *http.MaxBytesError
+		if errors.As(err, &maxBytesErr) {
+			response.BadRequest(c, fmt.Sprintf("File must be at most %d bytes", h.maxSize))
+			return
+		}
+		response.BadRequest(c, "A file is required")
+		return
+	}
+	if header.Size > h.maxSize {
+		response.BadRequest(c, fmt.Sprintf("File must be at most %d bytes", h.maxSize))
+		return
+	}
+
+	crop, ok := parseAvatarCrop(c)
+	if !ok {
+		response.BadRequest(c, "crop_x, crop_y and crop_size must all be given as whole numbers")
+		return
+	}
+	if crop != nil {
+		v := validator.New()
+		validator.Min(v, "crop_x", crop.X, 0, "")
+		validator.Min(v, "crop_y", crop.Y, 0, "")
+		validator.Min(v, "crop_size", crop.Size, 1, "")
+		if errs := v.Validate(); errs != nil {
+			response.ValidationError(c, errs)
+			return
+		}
+	}
+
+	file, err := header.Open()
+	if err != nil {
+		response.BadRequest(c, "Failed to read the uploaded file")
+		return
+	}
+	defer file.Close()
+
+	user, err := h.avatarService.Upload(c.Request.Context(), middleware.MustGetUser(c), file, crop)
+	if err != nil {
+		response.Error(c, err)
+		return
+	}
+
 	response.Success(c, gin.H{
 		"user": user.ToResponse(),
 	})
 }
 
+// parseAvatarCrop reads the crop square of an avatar upload. It returns nil
+// when no crop is given, and false unless all or none of the crop fields are
+// given as integers.
+func parseAvatarCrop(c *gin.Context) (*services.AvatarCrop, bool) {
+	fields := []string{c.PostForm("crop_x"), c.PostForm("crop_y"), c.PostForm("crop_size")}
+	if fields[0] == "" && fields[1] == "" && fields[2] == "" {
+		return nil, true
+	}
+
+	values := make([]int, len(fields))
+	for i, field := range fields {
+		value, err := strconv.Atoi(field)
+		if err != nil {
+			return nil, false
+		}
+		values[i] = value
+	}
+	return &services.AvatarCrop{X: values[0], Y: values[1], Size: values[2]}, true
+}
+
 // Update updates a user
 // @Summary Update user
-// @Description Update a user's profile (own profile or admin)
+// @Description Update a user's profile (own profile or admin). Send the user's version to reject the update if the user has changed since.
 // @Tags users
 // @Accept json
 // @Produce json
@@ -99,6 +391,7 @@ func (h *UserHandler) GetByID(c *gin.Context) {
 // @Failure 401 {object} response.Response
 // @Failure 403 {object} response.Response
 // @Failure 404 {object} response.Response
+// @Failure 409 {object} response.Response
 // @Router /users/{id} [put]
 func (h *UserHandler) Update(c *gin.Context) {
 	id, err := uuid.Parse(c.Param("id"))
@@ -114,20 +407,50 @@ func (h *UserHandler) Update(c *gin.Context) {
 		return
 	}
 
+	// Service accounts are managed exclusively by admins
+	if currentUser.IsServiceAccount() && !currentUser.IsAdmin() {
+		response.Forbidden(c, "Service accounts can only be managed by admins")
+		return
+	}
+
 	var req services.UpdateUserRequest
 	if err := c.ShouldBindJSON(&req); err != nil {
-		response.BadRequest(c, "Invalid request body")
+		response.BindingError(c, err)
 		return
 	}
 
-	user, err := h.userService.Update(c.Request.Context(), id, &req)
+	// Validate request
+	v := validator.New()
+	if req.Birthdate != nil {
+		v.Birthdate("birthdate", *req.Birthdate, "")
+	}
+	// An empty phone number removes it
+	if req.PhoneNumber != nil && *req.PhoneNumber != "" {
+		v.Phone("phone_number", *req.PhoneNumber, "")
+	}
+	// An empty avatar removes it
+	if req.Avatar != nil && *req.Avatar != "" {
+		v.URL("avatar", *req.Avatar, "")
+	}
+
+	if errs := v.Validate(); errs != nil {
+		response.ValidationError(c, errs)
+		return
+	}
+	if req.Birthdate != nil {
+		birthdate, _ := validator.ParseDate(*req.Birthdate)
+		normalized := birthdate.Format(models.BirthdateFormat)
+		req.Birthdate = &normalized
+	}
+
+	user, err := h.userService.Update(c.Request.Context(), id, currentUser.ID, &req)
 	if err != nil {
 		response.Error(c, err)
 		return
 	}
 
 	response.Success(c, gin.H{
-		"user": user.ToResponse(),
+		"user": user.ToResponseFor(currentUser),
 	})
 }
 
@@ -196,10 +519,12 @@ func (h *UserHandler) Search(c *gin.Context) {
 		return
 	}
 
+	currentUser := middleware.MustGetUser(c)
+
 	// Convert to response
 	userResponses := make([]*models.UserResponse, len(users))
 	for i, user := range users {
-		userResponses[i] = user.ToResponse()
+		userResponses[i] = user.ToResponseFor(currentUser)
 	}
 
 	response.Paginated(c, userResponses, page, pageSize, total)
@@ -234,12 +559,12 @@ func (h *UserHandler) UpdateStatus(c *gin.Context) {
 
 	var req UpdateStatusRequest
 	if err := c.ShouldBindJSON(&req); err != nil {
-		response.BadRequest(c, "Invalid request body")
+		response.BindingError(c, err)
 		return
 	}
 
 	// Validate status
-	validStatuses := []string{string(models.StatusActive), string(models.StatusInactive), string(models.StatusBanned), string(models.StatusPending)}
+	validStatuses := []string{string(models.StatusActive), string(models.StatusInactive), string(models.StatusBanned), string(models.StatusPending), string(models.StatusShadowBanned)}
 	isValid := false
 	for _, s := range validStatuses {
 		if req.Status == s {
@@ -252,7 +577,9 @@ func (h *UserHandler) UpdateStatus(c *gin.Context) {
 		return
 	}
 
-	if err := h.userService.UpdateStatus(c.Request.Context(), id, models.UserStatus(req.Status)); err != nil {
+	currentUser := middleware.MustGetUser(c)
+
+	if err := h.userService.UpdateStatus(c.Request.Context(), id, currentUser.ID, models.UserStatus(req.Status)); err != nil {
 		response.Error(c, err)
 		return
 	}
@@ -289,7 +616,7 @@ func (h *UserHandler) UpdateRole(c *gin.Context) {
 
 	var req UpdateRoleRequest
 	if err := c.ShouldBindJSON(&req); err != nil {
-		response.BadRequest(c, "Invalid request body")
+		response.BindingError(c, err)
 		return
 	}
 
@@ -314,10 +641,119 @@ func (h *UserHandler) UpdateRole(c *gin.Context) {
 		return
 	}
 
-	if err := h.userService.UpdateRole(c.Request.Context(), id, models.UserRole(req.Role)); err != nil {
+	if err := h.userService.UpdateRole(c.Request.Context(), id, currentUser.ID, models.UserRole(req.Role)); err != nil {
 		response.Error(c, err)
 		return
 	}
 
 	response.SuccessWithMessage(c, "Role updated successfully", nil)
 }
+
+// GetChanges returns the field-level change timeline of a user (admin only)
+// @Summary Get user changes
+// @Description Get the field-level change timeline of a user (admin only)
+// @Tags users
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "User ID"
+// @Param page query int false "Page number" default(1)
+// @Param page_size query int false "Page size" default(10)
+// @Success 200 {object} response.Response
+// @Failure 400 {object} response.Response
+// @Failure 401 {object} response.Response
+// @Failure 403 {object} response.Response
+// @Failure 404 {object} response.Response
+// @Router /users/{id}/changes [get]
+func (h *UserHandler) GetChanges(c *gin.Context) {
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		response.BadRequest(c, "Invalid user ID")
+		return
+	}
+
+	page, _ := strconv.Atoi(c.DefaultQuery("page", "1"))
+	pageSize, _ := strconv.Atoi(c.DefaultQuery("page_size", "10"))
+
+	changes, total, err := h.userService.GetChanges(c.Request.Context(), id, page, pageSize)
+	if err != nil {
+		response.Error(c, err)
+		return
+	}
+
+	changeResponses := make([]*models.ChangeResponse, len(changes))
+	for i, change := range changes {
+		changeResponses[i] = change.ToResponse()
+	}
+
+	response.Paginated(c, changeResponses, page, pageSize, total)
+}
+
+// parseUserFilter validates the filter, sort and format query parameters of
+// the user list. They reveal details users may hide from each other, so only
+// admins can use them. It writes the error response and returns ok=false when
+// they are invalid or not allowed.
+func parseUserFilter(c *gin.Context, currentUser *models.User) (filter *models.UserFilter, format string, ok bool) {
+	role := models.UserRole(c.Query("role"))
+	status := models.UserStatus(c.Query("status"))
+	emailVerified := c.Query("email_verified")
+	createdFrom := c.Query("created_from")
+	createdTo := c.Query("created_to")
+	sort := c.Query("sort")
+	format = c.DefaultQuery("format", formatJSON)
+
+	filtered := role != "" || status != "" || emailVerified != "" || createdFrom != "" || createdTo != "" || sort != ""
+	if (filtered || format != formatJSON) && !currentUser.IsAdmin() {
+		response.Error(c, apperrors.ErrForbidden.WithDetails("Only admins can filter, sort and export users"))
+		return nil, format, false
+	}
+
+	v := validator.New()
+	validator.OneOf(v.When(role != ""), "role", role, "", models.UserRoles...)
+	validator.OneOf(v.When(status != ""), "status", status, "", models.UserStatuses...)
+	v.When(emailVerified != "").InSlice("email_verified", emailVerified, []string{"true", "false"}, "")
+	switch {
+	case createdFrom != "" && createdTo != "":
+		v.DateRange("created_from", createdFrom, "created_to", createdTo, "")
+	case createdFrom != "":
+		v.Date("created_from", createdFrom, "")
+	case createdTo != "":
+		v.Date("created_to", createdTo, "")
+	}
+	v.When(sort != "").InSlice("sort", strings.TrimPrefix(sort, "-"), models.UserSortFields, "")
+	v.InSlice("format", format, []string{formatJSON, formatCSV}, "")
+	if errs := v.Validate(); errs != nil {
+		response.ValidationError(c, errs)
+		return nil, format, false
+	}
+
+	filter = &models.UserFilter{
+		Role:   role,
+		Status: status,
+		Sort:   sort,
+	}
+	if emailVerified != "" {
+		verified := emailVerified == "true"
+		filter.EmailVerified = &verified
+	}
+	if from, ok := validator.ParseDate(createdFrom); ok {
+		filter.CreatedFrom = &from
+	}
+	if to, ok := validator.ParseDate(createdTo); ok {
+		// A date without a time includes the whole day
+		if len(createdTo) == len("2006-01-02") {
+			to = to.Add(24*time.Hour - time.Nanosecond)
+		}
+		filter.CreatedTo = &to
+	}
+	return filter, format, true
+}
+
+// formatOptionalTime formats a time as RFC 3339, or as an empty string when
+// it is not set
+func formatOptionalTime(t *time.Time) string {
+	if t == nil {
+		return ""
+	}
+	return t.UTC().Format(time.RFC3339)
+}
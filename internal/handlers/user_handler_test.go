@@ -0,0 +1,302 @@
+package handlers_test
+
+import (
+	"bytes"
+	"errors"
+	"image"
+	"image/png"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/yourusername/go-enterprise-api/internal/avatar"
+	"github.com/yourusername/go-enterprise-api/internal/config"
+	"github.com/yourusername/go-enterprise-api/internal/handlers"
+	"github.com/yourusername/go-enterprise-api/internal/imageproxy"
+	"github.com/yourusername/go-enterprise-api/internal/mocks"
+	"github.com/yourusername/go-enterprise-api/internal/models"
+	apperrors "github.com/yourusername/go-enterprise-api/pkg/errors"
+	"go.uber.org/mock/gomock"
+)
+
+func TestUserHandlerGetByID(t *testing.T) {
+	viewer := newUser(models.RoleUser)
+	id := uuid.New()
+
+	tests := []struct {
+		name   string
+		path   string
+		setup  func(users *mocks.MockUserService)
+		status int
+		code   int
+	}{
+		{
+			name:   "invalid id",
+			path:   "/users/not-a-uuid",
+			status: http.StatusBadRequest,
+			code:   apperrors.CodeBadRequest,
+		},
+		{
+			name: "not found",
+			path: "/users/" + id.String(),
+			setup: func(users *mocks.MockUserService) {
+				users.EXPECT().GetByID(gomock.Any(), id).Return(nil, apperrors.ErrUserNotFound)
+			},
+			status: http.StatusNotFound,
+			code:   apperrors.CodeUserNotFound,
+		},
+		{
+			name: "unexpected error",
+			path: "/users/" + id.String(),
+			setup: func(users *mocks.MockUserService) {
+				users.EXPECT().GetByID(gomock.Any(), id).Return(nil, errors.New("connection refused"))
+			},
+			status: http.StatusInternalServerError,
+			code:   apperrors.CodeInternalError,
+		},
+		{
+			name: "found",
+			path: "/users/" + id.String(),
+			setup: func(users *mocks.MockUserService) {
+				users.EXPECT().GetByID(gomock.Any(), id).Return(&models.User{BaseModel: models.BaseModel{ID: id}}, nil)
+			},
+			status: http.StatusOK,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			users := mocks.NewMockUserService(gomock.NewController(t))
+			if tt.setup != nil {
+				tt.setup(users)
+			}
+			h := handlers.NewUserHandler(users, nil, nil, nil, 0)
+
+			w := serve(t, h.GetByID, request{method: http.MethodGet, route: "/users/:id", path: tt.path, user: viewer})
+			if w.Code != tt.status {
+				t.Fatalf("got %d, want %d: %s", w.Code, tt.status, w.Body)
+			}
+			if code := errorCode(t, w); code != tt.code {
+				t.Errorf("got error code %d, want %d", code, tt.code)
+			}
+		})
+	}
+}
+
+func TestUserHandlerUpdate(t *testing.T) {
+	owner := newUser(models.RoleUser)
+	other := newUser(models.RoleUser)
+	admin := newUser(models.RoleAdmin)
+	path := "/users/" + owner.ID.String()
+
+	tests := []struct {
+		name   string
+		user   *models.User
+		body   interface{}
+		setup  func(users *mocks.MockUserService)
+		status int
+		code   int
+	}{
+		{
+			name:   "another user",
+			user:   other,
+			body:   map[string]string{"first_name": "Mallory"},
+			status: http.StatusForbidden,
+			code:   apperrors.CodeForbidden,
+		},
+		{
+			name:   "invalid phone number",
+			user:   owner,
+			body:   map[string]string{"phone_number": "call me"},
+			status: http.StatusBadRequest,
+			code:   apperrors.CodeValidationError,
+		},
+		{
+			name:   "invalid birthdate",
+			user:   owner,
+			body:   map[string]string{"birthdate": "31/12/1990"},
+			status: http.StatusBadRequest,
+			code:   apperrors.CodeValidationError,
+		},
+		{
+			name:   "script avatar",
+			user:   owner,
+			body:   map[string]string{"avatar": "javascript:alert(1)"},
+			status: http.StatusBadRequest,
+			code:   apperrors.CodeValidationError,
+		},
+		{
+			name:   "relative avatar",
+			user:   owner,
+			body:   map[string]string{"avatar": "//evil.example/a.png"},
+			status: http.StatusBadRequest,
+			code:   apperrors.CodeValidationError,
+		},
+		{
+			name:   "malformed body",
+			user:   owner,
+			body:   []string{"first_name"},
+			status: http.StatusBadRequest,
+			code:   apperrors.CodeValidationError,
+		},
+		{
+			name: "deleted meanwhile",
+			user: admin,
+			body: map[string]string{"first_name": "Ada"},
+			setup: func(users *mocks.MockUserService) {
+				users.EXPECT().Update(gomock.Any(), owner.ID, admin.ID, gomock.Any()).Return(nil, apperrors.ErrUserNotFound)
+			},
+			status: http.StatusNotFound,
+			code:   apperrors.CodeUserNotFound,
+		},
+		{
+			name: "edit conflict",
+			user: owner,
+			body: map[string]string{"first_name": "Ada"},
+			setup: func(users *mocks.MockUserService) {
+				users.EXPECT().Update(gomock.Any(), owner.ID, owner.ID, gomock.Any()).Return(nil, apperrors.ErrConflict)
+			},
+			status: http.StatusConflict,
+			code:   apperrors.CodeConflict,
+		},
+		{
+			name: "updated",
+			user: owner,
+			body: map[string]string{"first_name": "Ada"},
+			setup: func(users *mocks.MockUserService) {
+				users.EXPECT().Update(gomock.Any(), owner.ID, owner.ID, gomock.Any()).Return(owner, nil)
+			},
+			status: http.StatusOK,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			users := mocks.NewMockUserService(gomock.NewController(t))
+			if tt.setup != nil {
+				tt.setup(users)
+			}
+			h := handlers.NewUserHandler(users, nil, nil, nil, 0)
+
+			w := serve(t, h.Update, request{method: http.MethodPut, route: "/users/:id", path: path, user: tt.user, body: tt.body})
+			if w.Code != tt.status {
+				t.Fatalf("got %d, want %d: %s", w.Code, tt.status, w.Body)
+			}
+			if code := errorCode(t, w); code != tt.code {
+				t.Errorf("got error code %d, want %d", code, tt.code)
+			}
+		})
+	}
+}
+
+func TestUserHandlerAvatar(t *testing.T) {
+	img := image.NewRGBA(image.Rect(0, 0, 4, 4))
+	var encoded bytes.Buffer
+	if err := png.Encode(&encoded, img); err != nil {
+		t.Fatal(err)
+	}
+	origin := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "image/png")
+		_, _ = w.Write(encoded.Bytes())
+	}))
+	defer origin.Close()
+
+	proxy := imageproxy.New(&config.ImageProxyConfig{
+		Widths:       []int{64},
+		MaxBytes:     1 << 20,
+		MaxPixels:    1 << 20,
+		Timeout:      time.Second,
+		CacheBytes:   1 << 20,
+		CacheTTL:     time.Minute,
+		AllowPrivate: true,
+	})
+
+	tests := []struct {
+		name        string
+		avatar      string
+		proxy       *imageproxy.Proxy
+		contentType string
+	}{
+		{"linked avatar", origin.URL + "/avatar.png", proxy, "image/png"},
+		{"script avatar", "javascript:alert(1)", proxy, "image/svg+xml"},
+		{"unreachable avatar", origin.URL + "/avatar.png", nil, "image/svg+xml"},
+		{"no avatar", "", proxy, "image/svg+xml"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			user := newUser(models.RoleUser)
+			user.Avatar = tt.avatar
+			users := mocks.NewMockUserService(gomock.NewController(t))
+			users.EXPECT().GetByID(gomock.Any(), user.ID).Return(user, nil)
+			avatars := avatar.NewGenerator(&config.AvatarConfig{Style: "initials", Size: 128})
+			h := handlers.NewUserHandler(users, nil, avatars, tt.proxy, 0)
+
+			w := serve(t, h.Avatar, request{method: http.MethodGet, route: "/users/:id/avatar", path: "/users/" + user.ID.String() + "/avatar"})
+			if w.Code != http.StatusOK {
+				t.Fatalf("got %d, want %d: %s", w.Code, http.StatusOK, w.Body)
+			}
+			if location := w.Header().Get("Location"); location != "" {
+				t.Errorf("got a redirect to %q, want the avatar served", location)
+			}
+			if contentType := w.Header().Get("Content-Type"); contentType != tt.contentType {
+				t.Errorf("got content type %q, want %q", contentType, tt.contentType)
+			}
+		})
+	}
+}
+
+func TestUserHandlerDelete(t *testing.T) {
+	admin := newUser(models.RoleAdmin)
+	id := uuid.New()
+
+	tests := []struct {
+		name   string
+		path   string
+		setup  func(users *mocks.MockUserService)
+		status int
+		code   int
+	}{
+		{
+			name:   "own account",
+			path:   "/users/" + admin.ID.String(),
+			status: http.StatusBadRequest,
+			code:   apperrors.CodeBadRequest,
+		},
+		{
+			name: "not found",
+			path: "/users/" + id.String(),
+			setup: func(users *mocks.MockUserService) {
+				users.EXPECT().Delete(gomock.Any(), id).Return(apperrors.ErrUserNotFound)
+			},
+			status: http.StatusNotFound,
+			code:   apperrors.CodeUserNotFound,
+		},
+		{
+			name: "deleted",
+			path: "/users/" + id.String(),
+			setup: func(users *mocks.MockUserService) {
+				users.EXPECT().Delete(gomock.Any(), id).Return(nil)
+			},
+			status: http.StatusNoContent,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			users := mocks.NewMockUserService(gomock.NewController(t))
+			if tt.setup != nil {
+				tt.setup(users)
+			}
+			h := handlers.NewUserHandler(users, nil, nil, nil, 0)
+
+			w := serve(t, h.Delete, request{method: http.MethodDelete, route: "/users/:id", path: tt.path, user: admin})
+			if w.Code != tt.status {
+				t.Fatalf("got %d, want %d: %s", w.Code, tt.status, w.Body)
+			}
+			if tt.status != http.StatusNoContent {
+				if code := errorCode(t, w); code != tt.code {
+					t.Errorf("got error code %d, want %d", code, tt.code)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,389 @@
+package handlers
+
+import (
+	"strconv"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"github.com/yourusername/go-enterprise-api/internal/middleware"
+	"github.com/yourusername/go-enterprise-api/internal/models"
+	"github.com/yourusername/go-enterprise-api/internal/services"
+	"github.com/yourusername/go-enterprise-api/pkg/response"
+	"github.com/yourusername/go-enterprise-api/pkg/validator"
+)
+
+// WebhookHandler handles outgoing webhook management
+type WebhookHandler struct {
+	webhookService services.WebhookService
+}
+
+// NewWebhookHandler creates a new webhook handler
+func NewWebhookHandler(webhookService services.WebhookService) *WebhookHandler {
+	return &WebhookHandler{
+		webhookService: webhookService,
+	}
+}
+
+// Create registers a webhook
+// @Summary Create webhook
+// @Description Register an endpoint notified of events about your content. Admins can create global webhooks, which receive events about everyone's content and user.registered. The signing secret is only shown once.
+// @Tags webhooks
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param request body services.CreateWebhookRequest true "Webhook data"
+// @Success 201 {object} response.Response
+// @Failure 400 {object} response.Response
+// @Failure 403 {object} response.Response
+// @Router /webhooks [post]
+func (h *WebhookHandler) Create(c *gin.Context) {
+	var req services.CreateWebhookRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		response.BindingError(c, err)
+		return
+	}
+
+	v := validator.New()
+	validateWebhookEvents(v, req.Events)
+	if errs := v.Validate(); errs != nil {
+		response.ValidationError(c, errs)
+		return
+	}
+
+	user := middleware.MustGetUser(c)
+
+	webhook, secret, err := h.webhookService.Create(c.Request.Context(), user, &req)
+	if err != nil {
+		response.Error(c, err)
+		return
+	}
+
+	response.Created(c, gin.H{
+		"webhook": webhook.ToResponse(),
+		"secret":  secret,
+	})
+}
+
+// GetMine returns the current user's webhooks
+// @Summary List my webhooks
+// @Description Get a paginated list of your webhooks, newest first
+// @Tags webhooks
+// @Produce json
+// @Security BearerAuth
+// @Param page query int false "Page number" default(1)
+// @Param page_size query int false "Page size" default(10)
+// @Success 200 {object} response.Response
+// @Router /webhooks [get]
+func (h *WebhookHandler) GetMine(c *gin.Context) {
+	page, _ := strconv.Atoi(c.DefaultQuery("page", "1"))
+	pageSize, _ := strconv.Atoi(c.DefaultQuery("page_size", "10"))
+
+	user := middleware.MustGetUser(c)
+
+	webhooks, total, err := h.webhookService.GetByUser(c.Request.Context(), user.ID, page, pageSize)
+	if err != nil {
+		response.Error(c, err)
+		return
+	}
+
+	response.Paginated(c, webhookResponses(webhooks), page, pageSize, total)
+}
+
+// GetAll returns every user's webhooks
+// @Summary List webhooks
+// @Description Get a paginated list of all webhooks, newest first (admin only)
+// @Tags admin
+// @Produce json
+// @Security BearerAuth
+// @Param page query int false "Page number" default(1)
+// @Param page_size query int false "Page size" default(10)
+// @Success 200 {object} response.Response
+// @Router /admin/webhooks [get]
+func (h *WebhookHandler) GetAll(c *gin.Context) {
+	page, _ := strconv.Atoi(c.DefaultQuery("page", "1"))
+	pageSize, _ := strconv.Atoi(c.DefaultQuery("page_size", "10"))
+
+	webhooks, total, err := h.webhookService.GetAll(c.Request.Context(), page, pageSize)
+	if err != nil {
+		response.Error(c, err)
+		return
+	}
+
+	response.Paginated(c, webhookResponses(webhooks), page, pageSize, total)
+}
+
+// GetByID returns a webhook
+// @Summary Get webhook
+// @Description Get one of your webhooks; admins can get any webhook
+// @Tags webhooks
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "Webhook ID"
+// @Success 200 {object} response.Response
+// @Failure 400 {object} response.Response
+// @Failure 404 {object} response.Response
+// @Router /webhooks/{id} [get]
+func (h *WebhookHandler) GetByID(c *gin.Context) {
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		response.BadRequest(c, "Invalid webhook ID")
+		return
+	}
+
+	user := middleware.MustGetUser(c)
+
+	webhook, err := h.webhookService.GetByID(c.Request.Context(), id, user)
+	if err != nil {
+		response.Error(c, err)
+		return
+	}
+
+	response.Success(c, gin.H{
+		"webhook": webhook.ToResponse(),
+	})
+}
+
+// Update updates a webhook
+// @Summary Update webhook
+// @Description Change a webhook's URL, description or events, or pause it by setting active to false
+// @Tags webhooks
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "Webhook ID"
+// @Param request body services.UpdateWebhookRequest true "Webhook data"
+// @Success 200 {object} response.Response
+// @Failure 400 {object} response.Response
+// @Failure 404 {object} response.Response
+// @Router /webhooks/{id} [put]
+func (h *WebhookHandler) Update(c *gin.Context) {
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		response.BadRequest(c, "Invalid webhook ID")
+		return
+	}
+
+	var req services.UpdateWebhookRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		response.BindingError(c, err)
+		return
+	}
+
+	v := validator.New()
+	if req.Events != nil {
+		validateWebhookEvents(v, req.Events)
+	}
+	if errs := v.Validate(); errs != nil {
+		response.ValidationError(c, errs)
+		return
+	}
+
+	user := middleware.MustGetUser(c)
+
+	webhook, err := h.webhookService.Update(c.Request.Context(), id, user, &req)
+	if err != nil {
+		response.Error(c, err)
+		return
+	}
+
+	response.SuccessWithMessage(c, "Webhook updated", gin.H{
+		"webhook": webhook.ToResponse(),
+	})
+}
+
+// Delete deletes a webhook
+// @Summary Delete webhook
+// @Description Delete a webhook; pending deliveries are abandoned
+// @Tags webhooks
+// @Security BearerAuth
+// @Param id path string true "Webhook ID"
+// @Success 204
+// @Failure 400 {object} response.Response
+// @Failure 404 {object} response.Response
+// @Router /webhooks/{id} [delete]
+func (h *WebhookHandler) Delete(c *gin.Context) {
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		response.BadRequest(c, "Invalid webhook ID")
+		return
+	}
+
+	user := middleware.MustGetUser(c)
+
+	if err := h.webhookService.Delete(c.Request.Context(), id, user); err != nil {
+		response.Error(c, err)
+		return
+	}
+
+	response.NoContent(c)
+}
+
+// GetDeliveries returns a webhook's delivery log
+// @Summary List webhook deliveries
+// @Description Get a paginated log of a webhook's deliveries, newest first, with the outcome of their latest attempt
+// @Tags webhooks
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "Webhook ID"
+// @Param page query int false "Page number" default(1)
+// @Param page_size query int false "Page size" default(10)
+// @Success 200 {object} response.Response
+// @Failure 400 {object} response.Response
+// @Failure 404 {object} response.Response
+// @Router /webhooks/{id}/deliveries [get]
+func (h *WebhookHandler) GetDeliveries(c *gin.Context) {
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		response.BadRequest(c, "Invalid webhook ID")
+		return
+	}
+
+	page, _ := strconv.Atoi(c.DefaultQuery("page", "1"))
+	pageSize, _ := strconv.Atoi(c.DefaultQuery("page_size", "10"))
+
+	user := middleware.MustGetUser(c)
+
+	deliveries, total, err := h.webhookService.GetDeliveries(c.Request.Context(), id, user, page, pageSize)
+	if err != nil {
+		response.Error(c, err)
+		return
+	}
+
+	responses := make([]*models.WebhookDeliveryResponse, len(deliveries))
+	for i := range deliveries {
+		responses[i] = deliveries[i].ToResponse()
+	}
+
+	response.Paginated(c, responses, page, pageSize, total)
+}
+
+// Redeliver sends a delivery's payload again
+// @Summary Redeliver webhook delivery
+// @Description Send the payload of one of a webhook's deliveries again as a new delivery, e.g. after the receiver was down. The payload keeps its event id.
+// @Tags webhooks
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "Webhook ID"
+// @Param delivery_id path string true "Delivery ID"
+// @Success 201 {object} response.Response
+// @Failure 400 {object} response.Response
+// @Failure 404 {object} response.Response
+// @Failure 409 {object} response.Response
+// @Router /webhooks/{id}/redeliver/{delivery_id} [post]
+func (h *WebhookHandler) Redeliver(c *gin.Context) {
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		response.BadRequest(c, "Invalid webhook ID")
+		return
+	}
+	deliveryID, err := uuid.Parse(c.Param("delivery_id"))
+	if err != nil {
+		response.BadRequest(c, "Invalid delivery ID")
+		return
+	}
+
+	user := middleware.MustGetUser(c)
+
+	delivery, err := h.webhookService.Redeliver(c.Request.Context(), id, deliveryID, user)
+	if err != nil {
+		response.Error(c, err)
+		return
+	}
+
+	response.Created(c, gin.H{
+		"delivery": delivery.ToResponse(),
+	})
+}
+
+// ReplayWebhookRequest selects the deliveries to replay
+type ReplayWebhookRequest struct {
+	From   string `json:"from" binding:"required"`
+	To     string `json:"to,omitempty"`
+	Status string `json:"status,omitempty"`
+}
+
+// Replay redelivers a webhook's deliveries from a time range
+// @Summary Replay webhook deliveries
+// @Description Queue the redelivery of every delivery created from "from" up to "to" (default now), optionally only failed or succeeded ones. Redeliveries are not replayed again.
+// @Tags webhooks
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "Webhook ID"
+// @Param request body ReplayWebhookRequest true "Time range (ISO-8601) and status"
+// @Success 200 {object} response.Response
+// @Failure 400 {object} response.Response
+// @Failure 404 {object} response.Response
+// @Failure 409 {object} response.Response
+// @Router /webhooks/{id}/replay [post]
+func (h *WebhookHandler) Replay(c *gin.Context) {
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		response.BadRequest(c, "Invalid webhook ID")
+		return
+	}
+
+	var req ReplayWebhookRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		response.BindingError(c, err)
+		return
+	}
+
+	v := validator.New()
+	if req.To != "" {
+		v.DateRange("from", req.From, "to", req.To, "")
+	} else {
+		v.Date("from", req.From, "")
+	}
+	validator.OneOf(v.When(req.Status != ""), "status", models.WebhookDeliveryStatus(req.Status), "", models.WebhookDeliverySucceeded, models.WebhookDeliveryFailed)
+	if errs := v.Validate(); errs != nil {
+		response.ValidationError(c, errs)
+		return
+	}
+
+	from, _ := validator.ParseDate(req.From)
+	to := time.Now()
+	if req.To != "" {
+		to, _ = validator.ParseDate(req.To)
+	}
+
+	user := middleware.MustGetUser(c)
+
+	job, count, err := h.webhookService.Replay(c.Request.Context(), id, user, from, to, models.WebhookDeliveryStatus(req.Status))
+	if err != nil {
+		response.Error(c, err)
+		return
+	}
+
+	if job == nil {
+		response.SuccessWithMessage(c, "No deliveries to replay", gin.H{
+			"deliveries": 0,
+		})
+		return
+	}
+
+	response.SuccessWithMessage(c, "Webhook replay queued", gin.H{
+		"deliveries": count,
+		"job":        job.ToResponse(),
+	})
+}
+
+// validateWebhookEvents checks that events are known webhook events
+func validateWebhookEvents(v *validator.Validator, events []string) {
+	if len(events) == 0 {
+		v.AddError("events", "events must contain at least one event")
+		return
+	}
+	for i, event := range events {
+		validator.OneOf(v, "events["+strconv.Itoa(i)+"]", models.WebhookEvent(event), "", models.WebhookEvents...)
+	}
+}
+
+func webhookResponses(webhooks []models.Webhook) []*models.WebhookResponse {
+	responses := make([]*models.WebhookResponse, len(webhooks))
+	for i := range webhooks {
+		responses[i] = webhooks[i].ToResponse()
+	}
+	return responses
+}
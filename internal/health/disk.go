@@ -0,0 +1,44 @@
+package health
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+)
+
+// DiskSpace returns a check that fails when the filesystem holding path has
+// less than minFree bytes available. A path that does not exist yet is
+// checked on its nearest existing parent. The check passes on platforms
+// where free space cannot be read.
+func DiskSpace(path string, minFree uint64) Check {
+	return func(ctx context.Context) error {
+		dir, err := filepath.Abs(path)
+		if err != nil {
+			return err
+		}
+		for {
+			if _, err := os.Stat(dir); err == nil || !errors.Is(err, os.ErrNotExist) {
+				break
+			}
+			parent := filepath.Dir(dir)
+			if parent == dir {
+				break
+			}
+			dir = parent
+		}
+
+		free, err := diskFree(dir)
+		if errors.Is(err, errors.ErrUnsupported) {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+		if free < minFree {
+			return fmt.Errorf("%d MB free on %s, below the minimum of %d MB", free>>20, dir, minFree>>20)
+		}
+		return nil
+	}
+}
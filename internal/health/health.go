@@ -0,0 +1,216 @@
+// Package health runs the readiness checks of the components the API server
+// depends on. Components register named checks with a Registry, which runs
+// them concurrently, each bounded by its timeout, and reports the status and
+// latency of each.
+package health
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/yourusername/go-enterprise-api/pkg/logger"
+)
+
+// Check statuses
+const (
+	StatusHealthy   = "healthy"
+	StatusUnhealthy = "unhealthy"
+)
+
+// Readiness statuses. A failing non-critical check degrades the server
+// without taking it out of service.
+const (
+	StatusReady    = "ready"
+	StatusDegraded = "degraded"
+	StatusNotReady = "not ready"
+)
+
+// Check reports whether a component works, returning an error if not. It
+// should give up when ctx is done.
+type Check func(ctx context.Context) error
+
+// check is a registered check
+type check struct {
+	name     string
+	run      Check
+	timeout  time.Duration
+	critical bool
+	cacheFor time.Duration
+
+	// mu guards the last result, reused for cacheFor
+	mu     sync.Mutex
+	last   Result
+	lastAt time.Time
+}
+
+// Option configures a registered check
+type Option func(c *check)
+
+// Timeout sets how long a check may take before it is reported unhealthy
+func Timeout(d time.Duration) Option {
+	return func(c *check) {
+		c.timeout = d
+	}
+}
+
+// CacheFor reuses the result of a check for d, for checks calling external
+// services that should not be called on every readiness probe
+func CacheFor(d time.Duration) Option {
+	return func(c *check) {
+		c.cacheFor = d
+	}
+}
+
+// NonCritical reports a check without failing readiness when it fails, for
+// components the server can serve most requests without
+func NonCritical() Option {
+	return func(c *check) {
+		c.critical = false
+	}
+}
+
+// Result is the outcome of a check
+type Result struct {
+	Name      string  `json:"name"`
+	Status    string  `json:"status"`
+	Critical  bool    `json:"critical"`
+	LatencyMS float64 `json:"latency_ms"`
+}
+
+// Report is the outcome of all checks
+type Report struct {
+	Status string   `json:"status"`
+	Checks []Result `json:"checks"`
+}
+
+// Ready reports whether every critical check passed
+func (r *Report) Ready() bool {
+	return r.Status != StatusNotReady
+}
+
+// Registry holds the checks of the components
+type Registry struct {
+	mu      sync.RWMutex
+	checks  []*check
+	timeout time.Duration
+}
+
+// New creates a registry whose checks time out after timeout unless they
+// set their own
+func New(timeout time.Duration) *Registry {
+	return &Registry{timeout: timeout}
+}
+
+// Register adds a check named after the component it checks. Checks are
+// critical unless registered with NonCritical.
+//
+// Usage:
+//
+//	checks.Register("search", indexer.Ping, health.NonCritical())
+func (r *Registry) Register(name string, run Check, opts ...Option) {
+	c := &check{name: name, run: run, timeout: r.timeout, critical: true}
+	for _, opt := range opts {
+		opt(c)
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.checks = append(r.checks, c)
+}
+
+// Run runs every check concurrently and reports their results, sorted by
+// name. Failures are logged with their error, which the report leaves out
+// as it may name internal hosts.
+func (r *Registry) Run(ctx context.Context) *Report {
+	r.mu.RLock()
+	checks := append([]*check(nil), r.checks...)
+	r.mu.RUnlock()
+
+	results := make([]Result, len(checks))
+	var wg sync.WaitGroup
+	for i, c := range checks {
+		wg.Add(1)
+		go func(i int, c *check) {
+			defer wg.Done()
+			results[i] = c.result(ctx)
+		}(i, c)
+	}
+	wg.Wait()
+
+	sort.Slice(results, func(i, j int) bool {
+		return results[i].Name < results[j].Name
+	})
+
+	report := &Report{Status: StatusReady, Checks: results}
+	for _, result := range results {
+		if result.Status == StatusHealthy {
+			continue
+		}
+		if result.Critical {
+			report.Status = StatusNotReady
+		} else if report.Status == StatusReady {
+			report.Status = StatusDegraded
+		}
+	}
+	return report
+}
+
+// result runs the check, or returns its last result while it is cached
+func (c *check) result(ctx context.Context) Result {
+	if c.cacheFor <= 0 {
+		return c.do(ctx)
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if !c.lastAt.IsZero() && time.Since(c.lastAt) < c.cacheFor {
+		return c.last
+	}
+	c.last = c.do(ctx)
+	c.lastAt = time.Now()
+	return c.last
+}
+
+// do runs the check within its timeout. A check that does not return when
+// its context is done is abandoned.
+func (c *check) do(ctx context.Context) Result {
+	ctx, cancel := context.WithTimeout(ctx, c.timeout)
+	defer cancel()
+
+	start := time.Now()
+	done := make(chan error, 1)
+	go func() {
+		defer func() {
+			if p := recover(); p != nil {
+				done <- fmt.Errorf("panic: %v", p)
+			}
+		}()
+		done <- c.run(ctx)
+	}()
+
+	var err error
+	select {
+	case err = <-done:
+	case <-ctx.Done():
+		err = fmt.Errorf("timed out after %s", c.timeout)
+	}
+
+	result := Result{
+		Name:      c.name,
+		Status:    StatusHealthy,
+		Critical:  c.critical,
+		LatencyMS: float64(time.Since(start).Microseconds()) / 1000,
+	}
+	if err != nil {
+		result.Status = StatusUnhealthy
+		logger.Warn("Health check failed",
+			logger.String("check", c.name),
+			logger.Any("critical", c.critical),
+			logger.Err(err),
+		)
+	}
+	return result
+}
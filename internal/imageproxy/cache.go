@@ -0,0 +1,82 @@
+package imageproxy
+
+import (
+	"container/list"
+	"sync"
+	"time"
+)
+
+// cacheEntry is a cached image and its expiry
+type cacheEntry struct {
+	key     string
+	image   *Image
+	expires time.Time
+}
+
+// cache is an in-memory LRU cache of images bounded by their total size.
+// A zero maxBytes disables caching.
+type cache struct {
+	maxBytes int64
+	ttl      time.Duration
+
+	mu    sync.Mutex
+	size  int64
+	order *list.List
+	items map[string]*list.Element
+}
+
+// newCache creates a new image cache
+func newCache(maxBytes int64, ttl time.Duration) *cache {
+	return &cache{
+		maxBytes: maxBytes,
+		ttl:      ttl,
+		order:    list.New(),
+		items:    make(map[string]*list.Element),
+	}
+}
+
+// get returns a cached image that has not expired
+func (c *cache) get(key string) (*Image, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	elem, ok := c.items[key]
+	if !ok {
+		return nil, false
+	}
+	entry := elem.Value.(*cacheEntry)
+	if time.Now().After(entry.expires) {
+		c.remove(elem)
+		return nil, false
+	}
+	c.order.MoveToFront(elem)
+	return entry.image, true
+}
+
+// put caches an image, evicting the least recently used ones to stay within maxBytes
+func (c *cache) put(key string, img *Image) {
+	size := int64(len(img.Data))
+	if size > c.maxBytes {
+		return
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if elem, ok := c.items[key]; ok {
+		c.remove(elem)
+	}
+	for c.size+size > c.maxBytes {
+		c.remove(c.order.Back())
+	}
+
+	c.items[key] = c.order.PushFront(&cacheEntry{key: key, image: img, expires: time.Now().Add(c.ttl)})
+	c.size += size
+}
+
+// remove drops an entry; the caller must hold the lock
+func (c *cache) remove(elem *list.Element) {
+	entry := c.order.Remove(elem).(*cacheEntry)
+	delete(c.items, entry.key)
+	c.size -= int64(len(entry.image.Data))
+}
@@ -0,0 +1,101 @@
+package imageproxy
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"net/url"
+	"strings"
+	"syscall"
+	"time"
+
+	"github.com/yourusername/go-enterprise-api/internal/netguard"
+)
+
+// maxRedirects bounds the number of redirects followed when fetching an image
+const maxRedirects = 3
+
+// userAgent identifies the proxy to image hosts
+const userAgent = "go-enterprise-api-image-proxy/1.0"
+
+// newClient creates an HTTP client that refuses to connect to private,
+// loopback and other non-public addresses. The check runs on the resolved
+// address of every connection, so it also covers redirects and DNS names
+// pointing at internal hosts.
+func (p *Proxy) newClient(timeout time.Duration) *http.Client {
+	dialer := &net.Dialer{
+		Timeout: timeout,
+		Control: func(network, address string, _ syscall.RawConn) error {
+			if p.allowPrivate {
+				return nil
+			}
+			if err := netguard.Control(network, address, nil); err != nil {
+				if errors.Is(err, netguard.ErrNotPublic) {
+					return ErrHostNotAllowed
+				}
+				return err
+			}
+			return nil
+		},
+	}
+
+	return &http.Client{
+		Timeout: timeout,
+		Transport: &http.Transport{
+			DialContext:           dialer.DialContext,
+			TLSHandshakeTimeout:   timeout,
+			ResponseHeaderTimeout: timeout,
+			MaxIdleConns:          10,
+			IdleConnTimeout:       90 * time.Second,
+		},
+		CheckRedirect: func(req *http.Request, via []*http.Request) error {
+			if len(via) >= maxRedirects {
+				return fmt.Errorf("%w: too many redirects", ErrFetch)
+			}
+			return p.checkURL(req.URL)
+		},
+	}
+}
+
+// fetch downloads an image, enforcing the size limit
+func (p *Proxy) fetch(ctx context.Context, u *url.URL) ([]byte, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, u.String(), nil)
+	if err != nil {
+		return nil, ErrInvalidURL
+	}
+	req.Header.Set("Accept", "image/*")
+	req.Header.Set("User-Agent", userAgent)
+
+	resp, err := p.client.Do(req)
+	if err != nil {
+		for _, known := range []error{ErrHostNotAllowed, ErrInvalidURL} {
+			if errors.Is(err, known) {
+				return nil, known
+			}
+		}
+		return nil, fmt.Errorf("%w: %v", ErrFetch, err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("%w: upstream returned status %d", ErrFetch, resp.StatusCode)
+	}
+	if contentType := resp.Header.Get("Content-Type"); contentType != "" && !strings.HasPrefix(contentType, "image/") {
+		return nil, ErrUnsupported
+	}
+	if resp.ContentLength > p.maxBytes {
+		return nil, ErrTooLarge
+	}
+
+	data, err := io.ReadAll(io.LimitReader(resp.Body, p.maxBytes+1))
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrFetch, err)
+	}
+	if int64(len(data)) > p.maxBytes {
+		return nil, ErrTooLarge
+	}
+	return data, nil
+}
@@ -0,0 +1,142 @@
+package imageproxy
+
+import (
+	"context"
+	"crypto/sha256"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+
+	"github.com/yourusername/go-enterprise-api/internal/config"
+)
+
+// maxURLLength bounds the length of proxied image URLs
+const maxURLLength = 2048
+
+// Errors returned by the proxy
+var (
+	ErrInvalidURL     = errors.New("image URL must be an absolute http or https URL")
+	ErrHostNotAllowed = errors.New("image host is not allowed")
+	ErrTooLarge       = errors.New("image exceeds the size limit")
+	ErrUnsupported    = errors.New("image format is not supported")
+	ErrFetch          = errors.New("image could not be fetched")
+)
+
+// Image is a resized image ready to be served. ETag identifies its content.
+type Image struct {
+	Data        []byte
+	ContentType string
+	ETag        string
+}
+
+// Proxy fetches external images, scales them down to a fixed set of widths
+// and caches the result
+type Proxy struct {
+	widths       []int
+	maxBytes     int64
+	maxPixels    int
+	allowedHosts []string
+	allowPrivate bool
+	client       *http.Client
+	cache        *cache
+}
+
+// New creates a new image proxy
+func New(cfg *config.ImageProxyConfig) *Proxy {
+	p := &Proxy{
+		widths:       cfg.Widths,
+		maxBytes:     cfg.MaxBytes,
+		maxPixels:    cfg.MaxPixels,
+		allowedHosts: cfg.AllowedHosts,
+		allowPrivate: cfg.AllowPrivate,
+		cache:        newCache(cfg.CacheBytes, cfg.CacheTTL),
+	}
+	p.client = p.newClient(cfg.Timeout)
+	return p
+}
+
+// Width rounds a requested width up to the nearest supported width. Zero or
+// widths above the largest supported one give the largest supported width.
+func (p *Proxy) Width(requested int) int {
+	for _, width := range p.widths {
+		if requested > 0 && requested <= width {
+			return width
+		}
+	}
+	return p.widths[len(p.widths)-1]
+}
+
+// Get returns the image at rawURL scaled down to the supported width closest
+// to width. Images narrower than the target width are never scaled up.
+func (p *Proxy) Get(ctx context.Context, rawURL string, width int) (*Image, error) {
+	u, err := p.parseURL(rawURL)
+	if err != nil {
+		return nil, err
+	}
+	width = p.Width(width)
+
+	key := fmt.Sprintf("%d|%s", width, u.String())
+	if img, ok := p.cache.get(key); ok {
+		return img, nil
+	}
+
+	data, err := p.fetch(ctx, u)
+	if err != nil {
+		return nil, err
+	}
+
+	out, contentType, err := p.process(data, width)
+	if err != nil {
+		return nil, err
+	}
+
+	img := &Image{
+		Data:        out,
+		ContentType: contentType,
+		ETag:        fmt.Sprintf("%x", sha256.Sum256(out))[:16],
+	}
+	p.cache.put(key, img)
+	return img, nil
+}
+
+// parseURL parses and validates an image URL
+func (p *Proxy) parseURL(rawURL string) (*url.URL, error) {
+	if rawURL == "" || len(rawURL) > maxURLLength {
+		return nil, ErrInvalidURL
+	}
+	u, err := url.Parse(rawURL)
+	if err != nil {
+		return nil, ErrInvalidURL
+	}
+	if err := p.checkURL(u); err != nil {
+		return nil, err
+	}
+	u.Fragment = ""
+	return u, nil
+}
+
+// checkURL rejects URLs that are not plain http(s) URLs on an allowed host.
+// It is also applied to every redirect.
+func (p *Proxy) checkURL(u *url.URL) error {
+	if (u.Scheme != "http" && u.Scheme != "https") || u.Hostname() == "" || u.User != nil {
+		return ErrInvalidURL
+	}
+	if !p.allowPrivate {
+		if port := u.Port(); port != "" && port != "80" && port != "443" {
+			return ErrHostNotAllowed
+		}
+	}
+	if len(p.allowedHosts) == 0 {
+		return nil
+	}
+
+	host := strings.ToLower(u.Hostname())
+	for _, allowed := range p.allowedHosts {
+		if host == allowed || strings.HasSuffix(host, "."+allowed) {
+			return nil
+		}
+	}
+	return ErrHostNotAllowed
+}
@@ -0,0 +1,22 @@
+package imageproxy
+
+import (
+	"errors"
+
+	"github.com/yourusername/go-enterprise-api/internal/imaging"
+)
+
+// process decodes an image, scales it down to width and re-encodes it.
+// Images that may be transparent (PNG and GIF) are encoded as PNG, everything
+// else as JPEG. Animated GIFs keep their first frame only.
+func (p *Proxy) process(data []byte, width int) ([]byte, string, error) {
+	img, format, err := imaging.Decode(data, p.maxPixels)
+	if err != nil {
+		if errors.Is(err, imaging.ErrTooLarge) {
+			return nil, "", ErrTooLarge
+		}
+		return nil, "", ErrUnsupported
+	}
+
+	return imaging.Encode(imaging.Resize(img, width), format)
+}
@@ -0,0 +1,127 @@
+// Package imaging decodes, resizes and re-encodes JPEG, PNG and GIF images.
+// Re-encoded images carry no metadata, so EXIF data such as camera details
+// and GPS coordinates is dropped; the EXIF orientation of JPEG images is
+// applied to their pixels first.
+package imaging
+
+import (
+	"bytes"
+	"errors"
+	"image"
+	"image/draw"
+	_ "image/gif" // register the GIF decoder
+	"image/jpeg"
+	"image/png"
+)
+
+// jpegQuality is the quality used when encoding JPEG images
+const jpegQuality = 85
+
+// Supported image formats, as named by the image package
+const (
+	FormatJPEG = "jpeg"
+	FormatPNG  = "png"
+	FormatGIF  = "gif"
+)
+
+var (
+	// ErrUnsupported is returned for data that is not a JPEG, PNG or GIF
+	// image
+	ErrUnsupported = errors.New("image format is not supported")
+
+	// ErrTooLarge is returned for images with more pixels than allowed
+	ErrTooLarge = errors.New("image exceeds the size limit")
+)
+
+// Decode decodes an image of at most maxPixels pixels, upright according to
+// its EXIF orientation. It also returns the image's format.
+func Decode(data []byte, maxPixels int) (*image.RGBA, string, error) {
+	cfg, format, err := image.DecodeConfig(bytes.NewReader(data))
+	if err != nil {
+		return nil, "", ErrUnsupported
+	}
+	// Check the dimensions before decoding so huge images are never allocated
+	if cfg.Width < 1 || cfg.Height < 1 || int64(cfg.Width)*int64(cfg.Height) > int64(maxPixels) {
+		return nil, "", ErrTooLarge
+	}
+
+	src, _, err := image.Decode(bytes.NewReader(data))
+	if err != nil {
+		return nil, "", ErrUnsupported
+	}
+
+	img := toRGBA(src)
+	if format == FormatJPEG {
+		img = orient(img, exifOrientation(data))
+	}
+	return img, format, nil
+}
+
+// Encode encodes an image in the format best suited to images decoded from
+// format: PNG for images that may be transparent (PNG and GIF), JPEG for
+// everything else. It also returns the content type of the encoded image.
+func Encode(img image.Image, format string) ([]byte, string, error) {
+	var buf bytes.Buffer
+	if format == FormatPNG || format == FormatGIF {
+		if err := png.Encode(&buf, img); err != nil {
+			return nil, "", err
+		}
+		return buf.Bytes(), "image/png", nil
+	}
+	if err := jpeg.Encode(&buf, img, &jpeg.Options{Quality: jpegQuality}); err != nil {
+		return nil, "", err
+	}
+	return buf.Bytes(), "image/jpeg", nil
+}
+
+// Resize scales an image down to width, keeping its aspect ratio. Images no
+// wider than width are returned as they are.
+func Resize(img *image.RGBA, width int) *image.RGBA {
+	bounds := img.Bounds()
+	if bounds.Dx() <= width {
+		return img
+	}
+	height := bounds.Dy() * width / bounds.Dx()
+	if height < 1 {
+		height = 1
+	}
+	return Scale(img, width, height)
+}
+
+// Square crops the largest centered square out of an image and scales it
+// to size by size. Images smaller than size are cropped but not scaled up.
+func Square(img *image.RGBA, size int) *image.RGBA {
+	square := Crop(img, CenterSquare(img.Bounds()))
+	if square.Bounds().Dx() <= size {
+		return square
+	}
+	return Scale(square, size, size)
+}
+
+// CenterSquare returns the largest square centered in rect
+func CenterSquare(rect image.Rectangle) image.Rectangle {
+	side := min(rect.Dx(), rect.Dy())
+	x := rect.Min.X + (rect.Dx()-side)/2
+	y := rect.Min.Y + (rect.Dy()-side)/2
+	return image.Rect(x, y, x+side, y+side)
+}
+
+// Crop returns the part of an image within rect, which is clipped to the
+// image's bounds
+func Crop(img *image.RGBA, rect image.Rectangle) *image.RGBA {
+	rect = rect.Intersect(img.Bounds())
+	dst := image.NewRGBA(image.Rect(0, 0, rect.Dx(), rect.Dy()))
+	draw.Draw(dst, dst.Bounds(), img, rect.Min, draw.Src)
+	return dst
+}
+
+// toRGBA converts an image to RGBA with its origin at (0, 0)
+func toRGBA(src image.Image) *image.RGBA {
+	if rgba, ok := src.(*image.RGBA); ok && rgba.Bounds().Min == (image.Point{}) {
+		return rgba
+	}
+	bounds := src.Bounds()
+	rgba := image.NewRGBA(image.Rect(0, 0, bounds.Dx(), bounds.Dy()))
+	draw.Draw(rgba, rgba.Bounds(), src, bounds.Min, draw.Src)
+	return rgba
+}
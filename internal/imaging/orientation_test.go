@@ -0,0 +1,198 @@
+package imaging
+
+import (
+	"bytes"
+	"encoding/binary"
+	"image"
+	"image/color"
+	"image/jpeg"
+	"testing"
+)
+
+// ifdEntry is an entry of a TIFF image file directory
+type ifdEntry struct {
+	tag, typ uint16
+	count    uint32
+	value    uint32
+}
+
+// orientationEntry is the entry of an EXIF orientation
+func orientationEntry(orientation int) ifdEntry {
+	return ifdEntry{orientationTag, typeShort, 1, uint32(orientation)}
+}
+
+// tiffData encodes a TIFF structure with a first IFD at offset 8 holding
+// entries and linking to the IFD at next
+func tiffData(order binary.ByteOrder, next uint32, entries ...ifdEntry) []byte {
+	var buf bytes.Buffer
+	if order == binary.ByteOrder(binary.LittleEndian) {
+		buf.WriteString("II")
+	} else {
+		buf.WriteString("MM")
+	}
+	binary.Write(&buf, order, uint16(42))
+	binary.Write(&buf, order, uint32(8))
+	binary.Write(&buf, order, uint16(len(entries)))
+	for _, e := range entries {
+		binary.Write(&buf, order, e.tag)
+		binary.Write(&buf, order, e.typ)
+		binary.Write(&buf, order, e.count)
+		// Values shorter than 4 bytes are left-aligned in the value field
+		if e.typ == typeShort {
+			binary.Write(&buf, order, uint16(e.value))
+			binary.Write(&buf, order, uint16(0))
+		} else {
+			binary.Write(&buf, order, e.value)
+		}
+	}
+	binary.Write(&buf, order, next)
+	return buf.Bytes()
+}
+
+// segment encodes a JPEG segment
+func segment(marker byte, data []byte) []byte {
+	length := make([]byte, 2)
+	binary.BigEndian.PutUint16(length, uint16(len(data)+2))
+	return append(append([]byte{0xFF, marker}, length...), data...)
+}
+
+// jpegWithExif returns the start of a JPEG file holding tiff as EXIF data,
+// after a JFIF segment
+func jpegWithExif(tiff []byte) []byte {
+	data := []byte{0xFF, 0xD8}
+	data = append(data, segment(0xE0, []byte("JFIF\x00\x01\x01\x00\x00\x01\x00\x01\x00\x00"))...)
+	data = append(data, segment(0xE1, append([]byte("Exif\x00\x00"), tiff...))...)
+	return append(data, 0xFF, 0xDA)
+}
+
+func TestExifOrientation(t *testing.T) {
+	orders := []struct {
+		name  string
+		order binary.ByteOrder
+	}{
+		{"little endian", binary.LittleEndian},
+		{"big endian", binary.BigEndian},
+	}
+	for _, o := range orders {
+		for orientation := 1; orientation <= 8; orientation++ {
+			data := jpegWithExif(tiffData(o.order, 0, ifdEntry{0x010F, 2, 4, 0}, orientationEntry(orientation)))
+			if got := exifOrientation(data); got != orientation {
+				t.Errorf("%s: got orientation %d, want %d", o.name, got, orientation)
+			}
+		}
+	}
+}
+
+func TestExifOrientationInvalid(t *testing.T) {
+	valid := tiffData(binary.BigEndian, 0, orientationEntry(6))
+	// A first IFD offset past the end of the data
+	pastEnd := append([]byte(nil), valid...)
+	binary.BigEndian.PutUint32(pastEnd[4:], 0xFFFFFFF0)
+	// A first IFD offset pointing back into the header
+	intoHeader := append([]byte(nil), valid...)
+	binary.BigEndian.PutUint32(intoHeader[4:], 4)
+	// More entries than the data holds, the orientation last
+	tooManyEntries := tiffData(binary.BigEndian, 0, ifdEntry{0x010F, 2, 4, 0}, orientationEntry(6))
+	binary.BigEndian.PutUint16(tooManyEntries[8:], 0xFFFF)
+	tooManyEntries = tooManyEntries[:len(tooManyEntries)-16]
+
+	tests := []struct {
+		name string
+		data []byte
+		want int
+	}{
+		{"not a JPEG", []byte("GIF89a"), 1},
+		{"empty", nil, 1},
+		{"no EXIF", []byte{0xFF, 0xD8, 0xFF, 0xDA, 0x00}, 1},
+		{"no orientation", jpegWithExif(tiffData(binary.LittleEndian, 0, ifdEntry{0x010F, 2, 4, 0})), 1},
+		{"orientation out of range", jpegWithExif(tiffData(binary.LittleEndian, 0, orientationEntry(9))), 1},
+		{"orientation of zero", jpegWithExif(tiffData(binary.LittleEndian, 0, orientationEntry(0))), 1},
+		{"orientation that is not a SHORT", jpegWithExif(tiffData(binary.BigEndian, 0, ifdEntry{orientationTag, 4, 1, 6})), 1},
+		{"several orientations", jpegWithExif(tiffData(binary.BigEndian, 0, ifdEntry{orientationTag, typeShort, 2, 6})), 1},
+		{"unknown byte order", jpegWithExif(append([]byte("XX"), valid[2:]...)), 1},
+		{"wrong TIFF magic", jpegWithExif(append([]byte("MM\x00\x2b"), valid[4:]...)), 1},
+		{"truncated TIFF header", jpegWithExif(valid[:6]), 1},
+		{"truncated IFD", jpegWithExif(valid[:len(valid)-10]), 1},
+		{"truncated segment", jpegWithExif(valid)[:30], 1},
+		{"IFD offset past the end", jpegWithExif(pastEnd), 1},
+		{"IFD offset into the header", jpegWithExif(intoHeader), 1},
+		{"too many entries", jpegWithExif(tooManyEntries), 1},
+		{"IFD linking to itself", jpegWithExif(tiffData(binary.BigEndian, 8, orientationEntry(6))), 6},
+		{"IFD linking back to the header", jpegWithExif(tiffData(binary.LittleEndian, 4, orientationEntry(3))), 3},
+		{"segment length below two", []byte{0xFF, 0xD8, 0xFF, 0xE1, 0x00, 0x01, 0xFF, 0xDA}, 1},
+		{"fill bytes and standalone markers", append([]byte{0xFF, 0xD8, 0xFF, 0xFF, 0xD0}, jpegWithExif(valid)[2:]...), 6},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := exifOrientation(tt.data); got != tt.want {
+				t.Errorf("got orientation %d, want %d", got, tt.want)
+			}
+		})
+	}
+}
+
+// labeled returns an image whose pixels are labeled by their red value,
+// row by row
+func labeled(width, height int, labels string) *image.RGBA {
+	img := image.NewRGBA(image.Rect(0, 0, width, height))
+	for i, label := range labels {
+		img.Set(i%width, i/width, color.RGBA{uint8(label), 0, 0, 255})
+	}
+	return img
+}
+
+// labels returns the labels of an image's pixels, row by row
+func labels(img *image.RGBA) string {
+	var b []byte
+	for y := 0; y < img.Bounds().Dy(); y++ {
+		for x := 0; x < img.Bounds().Dx(); x++ {
+			b = append(b, img.RGBAAt(x, y).R)
+		}
+	}
+	return string(b)
+}
+
+func TestOrient(t *testing.T) {
+	// The stored image is
+	//   abc
+	//   def
+	tests := []struct {
+		orientation   int
+		width, height int
+		want          string
+	}{
+		{1, 3, 2, "abcdef"},
+		{2, 3, 2, "cbafed"},
+		{3, 3, 2, "fedcba"},
+		{4, 3, 2, "defabc"},
+		{5, 2, 3, "adbecf"},
+		{6, 2, 3, "daebfc"},
+		{7, 2, 3, "fcebda"},
+		{8, 2, 3, "cfbead"},
+	}
+	for _, tt := range tests {
+		got := orient(labeled(3, 2, "abcdef"), tt.orientation)
+		if got.Bounds().Dx() != tt.width || got.Bounds().Dy() != tt.height || labels(got) != tt.want {
+			t.Errorf("orientation %d: got %dx%d %q, want %dx%d %q", tt.orientation,
+				got.Bounds().Dx(), got.Bounds().Dy(), labels(got), tt.width, tt.height, tt.want)
+		}
+	}
+}
+
+func TestDecodeAppliesOrientation(t *testing.T) {
+	var buf bytes.Buffer
+	if err := jpeg.Encode(&buf, image.NewRGBA(image.Rect(0, 0, 40, 20)), nil); err != nil {
+		t.Fatal(err)
+	}
+	// Insert the EXIF segment right after the start of image marker
+	exif := segment(0xE1, append([]byte("Exif\x00\x00"), tiffData(binary.LittleEndian, 0, orientationEntry(6))...))
+	data := append(append([]byte{0xFF, 0xD8}, exif...), buf.Bytes()[2:]...)
+
+	img, format, err := Decode(data, 1000)
+	if err != nil {
+		t.Fatalf("failed to decode: %v", err)
+	}
+	if format != FormatJPEG || img.Bounds().Dx() != 20 || img.Bounds().Dy() != 40 {
+		t.Errorf("got %s image of %v, want a 20x40 JPEG image", format, img.Bounds())
+	}
+}
@@ -0,0 +1,16 @@
+package imaging
+
+import (
+	"image"
+
+	"golang.org/x/image/draw"
+)
+
+// Scale resizes an image to width by height with a Catmull-Rom filter,
+// which keeps edges sharp without the aliasing of cheaper filters when
+// scaling down
+func Scale(src image.Image, width, height int) *image.RGBA {
+	dst := image.NewRGBA(image.Rect(0, 0, width, height))
+	draw.CatmullRom.Scale(dst, dst.Bounds(), src, src.Bounds(), draw.Src, nil)
+	return dst
+}
@@ -0,0 +1,43 @@
+package imaging
+
+import (
+	"image"
+	"image/color"
+	"image/draw"
+	"testing"
+)
+
+func TestScale(t *testing.T) {
+	// The left half is opaque red, the right half transparent
+	src := image.NewRGBA(image.Rect(0, 0, 64, 32))
+	draw.Draw(src, image.Rect(0, 0, 32, 32), image.NewUniform(color.RGBA{255, 0, 0, 255}), image.Point{}, draw.Src)
+
+	dst := Scale(src, 16, 8)
+	if dst.Bounds() != image.Rect(0, 0, 16, 8) {
+		t.Fatalf("got bounds %v, want 16x8", dst.Bounds())
+	}
+
+	tests := []struct {
+		name string
+		x, y int
+		want color.RGBA
+	}{
+		{"opaque corner", 0, 0, color.RGBA{255, 0, 0, 255}},
+		{"opaque middle", 4, 4, color.RGBA{255, 0, 0, 255}},
+		{"transparent corner", 15, 7, color.RGBA{}},
+		{"transparent middle", 12, 4, color.RGBA{}},
+	}
+	for _, tt := range tests {
+		if got := dst.RGBAAt(tt.x, tt.y); got != tt.want {
+			t.Errorf("%s: got %v, want %v", tt.name, got, tt.want)
+		}
+	}
+
+	// Pixels on the edge blend without bleeding color into the transparent
+	// half
+	for x := 0; x < 16; x++ {
+		if c := dst.RGBAAt(x, 4); c.G != 0 || c.B != 0 || c.R > c.A {
+			t.Errorf("got %v at x=%d, want premultiplied red", c, x)
+		}
+	}
+}
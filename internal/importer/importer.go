@@ -0,0 +1,82 @@
+// Package importer parses blog exports from other platforms into a neutral
+// representation that can be mapped onto the local models.
+package importer
+
+import (
+	"errors"
+	"html"
+	"path"
+	"regexp"
+	"strings"
+	"time"
+)
+
+// Supported export formats
+const (
+	FormatWXR    = "wxr"
+	FormatMedium = "medium"
+)
+
+// ErrUnknownFormat is returned when the format of an export cannot be detected
+var ErrUnknownFormat = errors.New("unsupported export format: expected a WordPress WXR (.xml) file or a Medium export (.zip) archive")
+
+// Author is an author found in an export
+type Author struct {
+	// Key identifies the author within the export (e.g. the WordPress login)
+	Key         string
+	Email       string
+	DisplayName string
+	FirstName   string
+	LastName    string
+}
+
+// Post is a post found in an export
+type Post struct {
+	// SourceID uniquely identifies the post on the source platform and is
+	// used to skip posts that were already imported
+	SourceID    string
+	Title       string
+	Slug        string
+	Content     string
+	Excerpt     string
+	Published   bool
+	PublishedAt time.Time
+	Tags        []string
+	AuthorKey   string
+}
+
+// Archive is the parsed content of an export
+type Archive struct {
+	Format  string
+	Authors []Author
+	Posts   []Post
+	// Skipped lists source items that are not posts (pages, attachments, ...)
+	Skipped int
+}
+
+// DetectFormat guesses the export format from the file name and its first bytes
+func DetectFormat(filename string, head []byte) (string, error) {
+	switch strings.ToLower(path.Ext(filename)) {
+	case ".xml", ".wxr":
+		return FormatWXR, nil
+	case ".zip":
+		return FormatMedium, nil
+	}
+
+	switch {
+	case len(head) >= 4 && string(head[:4]) == "PK\x03\x04":
+		return FormatMedium, nil
+	case strings.HasPrefix(strings.TrimSpace(string(head)), "<?xml"), strings.HasPrefix(strings.TrimSpace(string(head)), "<rss"):
+		return FormatWXR, nil
+	default:
+		return "", ErrUnknownFormat
+	}
+}
+
+// htmlTagRegex matches HTML tags
+var htmlTagRegex = regexp.MustCompile(`<[^>]*>`)
+
+// plainText strips HTML tags, unescapes entities and collapses whitespace
+func plainText(value string) string {
+	return strings.Join(strings.Fields(html.UnescapeString(htmlTagRegex.ReplaceAllString(value, " "))), " ")
+}
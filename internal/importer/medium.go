@@ -0,0 +1,148 @@
+package importer
+
+import (
+	"archive/zip"
+	"fmt"
+	"io"
+	"path"
+	"regexp"
+	"strings"
+	"time"
+)
+
+// maxMediumFileSize bounds the size of a single file read from a Medium archive
+const maxMediumFileSize = 10 << 20
+
+// mediumAuthorKey is the key of the single author of a Medium export
+const mediumAuthorKey = "medium"
+
+var (
+	mediumTitleRegex     = regexp.MustCompile(`(?s)<h1 class="p-name">(.*?)</h1>`)
+	mediumSubtitleRegex  = regexp.MustCompile(`(?s)<section data-field="subtitle"[^>]*>(.*?)</section>`)
+	mediumBodyRegex      = regexp.MustCompile(`(?s)<section data-field="body"[^>]*>(.*)</section>\s*<footer`)
+	mediumPublishedRegex = regexp.MustCompile(`<time class="dt-published" datetime="([^"]+)"`)
+	mediumCanonicalRegex = regexp.MustCompile(`<a href="([^"]+)" class="p-canonical"`)
+	mediumProfileName    = regexp.MustCompile(`(?s)<h3 class="p-name">(.*?)</h3>`)
+	mediumProfileEmail   = regexp.MustCompile(`(?s)<b>Email address:</b>\s*([^<\s]+)`)
+	mediumProfileURL     = regexp.MustCompile(`<a class="u-url" href="([^"]+)"`)
+)
+
+// ParseMedium parses a Medium export archive. Posts are read from the
+// posts/ directory and the author from profile/profile.html. Files whose
+// name starts with "draft_" are imported as drafts.
+func ParseMedium(r io.ReaderAt, size int64) (*Archive, error) {
+	zr, err := zip.NewReader(r, size)
+	if err != nil {
+		return nil, fmt.Errorf("invalid Medium archive: %w", err)
+	}
+
+	archive := &Archive{Format: FormatMedium}
+	author := Author{Key: mediumAuthorKey}
+	hasAuthor := false
+
+	for _, file := range zr.File {
+		dir, name := path.Split(file.Name)
+		if file.FileInfo().IsDir() || !strings.HasSuffix(name, ".html") {
+			continue
+		}
+
+		switch {
+		case strings.HasSuffix(dir, "profile/") && name == "profile.html":
+			content, err := readZipFile(file)
+			if err != nil {
+				return nil, err
+			}
+			if m := mediumProfileName.FindStringSubmatch(content); m != nil {
+				author.DisplayName = plainText(m[1])
+			}
+			if m := mediumProfileEmail.FindStringSubmatch(content); m != nil {
+				author.Email = plainText(m[1])
+			}
+			hasAuthor = true
+
+		case strings.HasSuffix(dir, "posts/"):
+			content, err := readZipFile(file)
+			if err != nil {
+				return nil, err
+			}
+			post := parseMediumPost(name, content)
+			if post.Title == "" && post.Content == "" {
+				archive.Skipped++
+				continue
+			}
+			archive.Posts = append(archive.Posts, post)
+		}
+	}
+
+	if hasAuthor {
+		if author.DisplayName != "" {
+			author.FirstName, author.LastName, _ = strings.Cut(author.DisplayName, " ")
+		}
+		archive.Authors = append(archive.Authors, author)
+	}
+
+	return archive, nil
+}
+
+// parseMediumPost extracts a post from a Medium export HTML file
+func parseMediumPost(filename, content string) Post {
+	post := Post{
+		SourceID:  "medium:" + strings.TrimSuffix(filename, ".html"),
+		Published: !strings.HasPrefix(filename, "draft_"),
+		AuthorKey: mediumAuthorKey,
+	}
+
+	if m := mediumTitleRegex.FindStringSubmatch(content); m != nil {
+		post.Title = plainText(m[1])
+	}
+	if m := mediumSubtitleRegex.FindStringSubmatch(content); m != nil {
+		post.Excerpt = plainText(m[1])
+	}
+	if m := mediumBodyRegex.FindStringSubmatch(content); m != nil {
+		post.Content = strings.TrimSpace(m[1])
+	}
+	if m := mediumPublishedRegex.FindStringSubmatch(content); m != nil {
+		if t, err := time.Parse(time.RFC3339, m[1]); err == nil {
+			post.PublishedAt = t.UTC()
+		}
+	}
+	if m := mediumCanonicalRegex.FindStringSubmatch(content); m != nil {
+		post.SourceID = "medium:" + m[1]
+		post.Slug = mediumSlug(m[1])
+	}
+
+	return post
+}
+
+// mediumSlug derives a slug from a Medium URL, dropping the trailing post ID
+// (e.g. https://medium.com/@me/my-post-1a2b3c4d5e6f -> my-post). Unpublished
+// posts only have an ID-based URL (https://medium.com/p/1a2b3c4d5e6f), which
+// yields no slug.
+func mediumSlug(url string) string {
+	if path.Base(path.Dir(url)) == "p" {
+		return ""
+	}
+	slug := path.Base(url)
+	if i := strings.LastIndex(slug, "-"); i > 0 && len(slug)-i-1 >= 10 {
+		slug = slug[:i]
+	}
+	return slug
+}
+
+// readZipFile reads a file from a zip archive, refusing oversized files
+func readZipFile(file *zip.File) (string, error) {
+	rc, err := file.Open()
+	if err != nil {
+		return "", fmt.Errorf("failed to read %s: %w", file.Name, err)
+	}
+	defer rc.Close()
+
+	data, err := io.ReadAll(io.LimitReader(rc, maxMediumFileSize+1))
+	if err != nil {
+		return "", fmt.Errorf("failed to read %s: %w", file.Name, err)
+	}
+	if len(data) > maxMediumFileSize {
+		return "", fmt.Errorf("%s is too large", file.Name)
+	}
+	return string(data), nil
+}
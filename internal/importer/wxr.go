@@ -0,0 +1,131 @@
+package importer
+
+import (
+	"encoding/xml"
+	"fmt"
+	"io"
+	"strings"
+	"time"
+)
+
+// contentNamespace is the namespace of <content:encoded> in WXR files
+const contentNamespace = "http://purl.org/rss/1.0/modules/content/"
+
+// wxrDocument is the subset of a WordPress eXtended RSS export that is imported.
+// WordPress namespaces vary between export versions, so elements are matched
+// by local name.
+type wxrDocument struct {
+	Channel struct {
+		Authors []wxrAuthor `xml:"author"`
+		Items   []wxrItem   `xml:"item"`
+	} `xml:"channel"`
+}
+
+type wxrAuthor struct {
+	Login       string `xml:"author_login"`
+	Email       string `xml:"author_email"`
+	DisplayName string `xml:"author_display_name"`
+	FirstName   string `xml:"author_first_name"`
+	LastName    string `xml:"author_last_name"`
+}
+
+type wxrItem struct {
+	Title      string        `xml:"title"`
+	GUID       string        `xml:"guid"`
+	Creator    string        `xml:"creator"`
+	Encoded    []wxrEncoded  `xml:"encoded"`
+	PostID     string        `xml:"post_id"`
+	PostDate   string        `xml:"post_date_gmt"`
+	PostName   string        `xml:"post_name"`
+	Status     string        `xml:"status"`
+	PostType   string        `xml:"post_type"`
+	Categories []wxrCategory `xml:"category"`
+}
+
+// wxrEncoded is a <content:encoded> or <excerpt:encoded> element
+type wxrEncoded struct {
+	XMLName xml.Name
+	Value   string `xml:",chardata"`
+}
+
+type wxrCategory struct {
+	Domain string `xml:"domain,attr"`
+	Name   string `xml:",chardata"`
+}
+
+// ParseWXR parses a WordPress eXtended RSS (WXR) export. Only items of type
+// "post" are imported; trashed posts are skipped.
+func ParseWXR(r io.Reader) (*Archive, error) {
+	var doc wxrDocument
+	decoder := xml.NewDecoder(r)
+	decoder.Strict = false
+	if err := decoder.Decode(&doc); err != nil {
+		return nil, fmt.Errorf("invalid WXR file: %w", err)
+	}
+
+	archive := &Archive{Format: FormatWXR}
+	for _, a := range doc.Channel.Authors {
+		archive.Authors = append(archive.Authors, Author{
+			Key:         strings.TrimSpace(a.Login),
+			Email:       strings.TrimSpace(a.Email),
+			DisplayName: strings.TrimSpace(a.DisplayName),
+			FirstName:   strings.TrimSpace(a.FirstName),
+			LastName:    strings.TrimSpace(a.LastName),
+		})
+	}
+
+	for _, item := range doc.Channel.Items {
+		if item.PostType != "post" || item.Status == "trash" || item.Status == "auto-draft" {
+			archive.Skipped++
+			continue
+		}
+
+		post := Post{
+			SourceID:  "wxr:" + firstNonEmpty(strings.TrimSpace(item.GUID), strings.TrimSpace(item.PostID)),
+			Title:     plainText(item.Title),
+			Slug:      strings.TrimSpace(item.PostName),
+			Published: item.Status == "publish",
+			AuthorKey: strings.TrimSpace(item.Creator),
+		}
+		for _, encoded := range item.Encoded {
+			switch {
+			case encoded.XMLName.Space == contentNamespace:
+				post.Content = strings.TrimSpace(encoded.Value)
+			case strings.Contains(encoded.XMLName.Space, "/excerpt/"):
+				post.Excerpt = plainText(encoded.Value)
+			}
+		}
+		if t, err := time.Parse("2006-01-02 15:04:05", strings.TrimSpace(item.PostDate)); err == nil && !t.IsZero() {
+			post.PublishedAt = t.UTC()
+		}
+		for _, category := range item.Categories {
+			if name := plainText(category.Name); name != "" && (category.Domain == "post_tag" || category.Domain == "category") {
+				post.Tags = appendUnique(post.Tags, name)
+			}
+		}
+
+		archive.Posts = append(archive.Posts, post)
+	}
+
+	return archive, nil
+}
+
+// firstNonEmpty returns the first non-empty value
+func firstNonEmpty(values ...string) string {
+	for _, v := range values {
+		if v != "" {
+			return v
+		}
+	}
+	return ""
+}
+
+// appendUnique appends value unless it is already present (case-insensitively)
+func appendUnique(values []string, value string) []string {
+	for _, v := range values {
+		if strings.EqualFold(v, value) {
+			return values
+		}
+	}
+	return append(values, value)
+}
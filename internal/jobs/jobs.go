@@ -0,0 +1,439 @@
+// Package jobs is a database-backed background job queue. Jobs are stored in
+// the jobs table, so they survive restarts and can be run by any API process
+// with workers enabled. Failed jobs are retried with exponential backoff.
+package jobs
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/yourusername/go-enterprise-api/internal/config"
+	"github.com/yourusername/go-enterprise-api/internal/database"
+	"github.com/yourusername/go-enterprise-api/internal/models"
+	"github.com/yourusername/go-enterprise-api/internal/repository"
+	"github.com/yourusername/go-enterprise-api/pkg/logger"
+	"github.com/yourusername/go-enterprise-api/pkg/requestid"
+)
+
+// TypePurge is the recurring job deleting finished jobs past their retention
+const TypePurge = "jobs.purge"
+
+// purgeInterval is how often finished jobs past their retention are deleted
+const purgeInterval = time.Hour
+
+// maxRetryDelay caps the backoff between attempts
+const maxRetryDelay = 24 * time.Hour
+
+var (
+	// ErrDuplicate is returned when a job with the same unique key is already
+	// waiting to run
+	ErrDuplicate = errors.New("job is already queued")
+
+	// ErrNotRetryable is returned when retrying a job that has not failed
+	ErrNotRetryable = errors.New("only failed jobs can be retried")
+)
+
+// Handler runs a job given its payload. A returned error fails the attempt;
+// the job is retried unless the error is permanent or no attempts are left.
+type Handler func(ctx context.Context, payload []byte) error
+
+// Handle adapts a function taking a decoded JSON payload of type P to a
+// Handler. Payloads that cannot be decoded fail the job permanently.
+func Handle[P any](handler func(ctx context.Context, payload P) error) Handler {
+	return func(ctx context.Context, raw []byte) error {
+		var payload P
+		if len(raw) > 0 {
+			if err := json.Unmarshal(raw, &payload); err != nil {
+				return Permanent(fmt.Errorf("invalid payload: %w", err))
+			}
+		}
+		return handler(ctx, payload)
+	}
+}
+
+// permanentError marks an error retrying cannot fix
+type permanentError struct {
+	err error
+}
+
+func (e permanentError) Error() string { return e.err.Error() }
+func (e permanentError) Unwrap() error { return e.err }
+
+// Permanent wraps err so the job fails without further attempts
+func Permanent(err error) error {
+	return permanentError{err: err}
+}
+
+// Option configures an enqueued job
+type Option func(job *models.Job)
+
+// RunAt delays a job until the given time
+func RunAt(t time.Time) Option {
+	return func(job *models.Job) {
+		job.RunAt = t.UTC()
+	}
+}
+
+// UniqueKey skips enqueueing the job while another job with the same key is
+// waiting to run
+func UniqueKey(key string) Option {
+	return func(job *models.Job) {
+		job.UniqueKey = key
+	}
+}
+
+// MaxAttempts overrides the configured number of attempts for a job
+func MaxAttempts(n int) Option {
+	return func(job *models.Job) {
+		job.MaxAttempts = n
+	}
+}
+
+// recurring is a job enqueued on a fixed interval
+type recurring struct {
+	jobType  string
+	interval time.Duration
+}
+
+// Queue stores jobs and runs them on a pool of workers
+type Queue struct {
+	repo repository.JobRepository
+	cfg  *config.JobsConfig
+
+	mu        sync.RWMutex
+	handlers  map[string]Handler
+	recurring []recurring
+
+	// wake prompts the dispatcher to look for due jobs before its next poll
+	wake chan struct{}
+
+	// polledAt is when the dispatcher last looked for due jobs, in Unix
+	// nanoseconds, or zero before the workers start
+	polledAt atomic.Int64
+}
+
+// New creates a job queue
+func New(repo repository.JobRepository, cfg *config.JobsConfig) *Queue {
+	q := &Queue{
+		repo:     repo,
+		cfg:      cfg,
+		handlers: make(map[string]Handler),
+		wake:     make(chan struct{}, 1),
+	}
+	q.Register(TypePurge, func(ctx context.Context, _ []byte) error {
+		return q.purge(ctx)
+	})
+	q.Every(TypePurge, purgeInterval)
+	return q
+}
+
+// Register sets the handler for jobs of the given type
+func (q *Queue) Register(jobType string, handler Handler) {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	q.handlers[jobType] = handler
+}
+
+// Every enqueues a job of the given type, with no payload, on the given
+// interval once Run is called. Only one such job waits to run at a time,
+// however many processes are running.
+func (q *Queue) Every(jobType string, interval time.Duration) {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	q.recurring = append(q.recurring, recurring{jobType: jobType, interval: interval})
+}
+
+// Enqueue stores a job with a JSON-encoded payload, which may be nil, to be
+// run by a worker. It returns ErrDuplicate if the job has a unique key
+// already in use. The job keeps the request ID carried by ctx, which the
+// worker passes on to its handler.
+func (q *Queue) Enqueue(ctx context.Context, jobType string, payload interface{}, opts ...Option) (*models.Job, error) {
+	job := &models.Job{
+		Type:        jobType,
+		Status:      models.JobPending,
+		MaxAttempts: q.cfg.MaxAttempts,
+		RunAt:       time.Now().UTC(),
+		RequestID:   requestid.FromContext(ctx),
+		Traceparent: requestid.TraceparentFromContext(ctx),
+	}
+	if payload != nil {
+		data, err := json.Marshal(payload)
+		if err != nil {
+			return nil, fmt.Errorf("encode payload: %w", err)
+		}
+		job.Payload = string(data)
+	}
+	for _, opt := range opts {
+		opt(job)
+	}
+
+	if job.UniqueKey != "" {
+		created, err := q.repo.CreateUnique(ctx, job)
+		if err != nil {
+			return nil, err
+		}
+		if !created {
+			return nil, ErrDuplicate
+		}
+	} else if err := q.repo.Create(ctx, job); err != nil {
+		return nil, err
+	}
+
+	if !job.RunAt.After(time.Now().UTC()) {
+		q.notify()
+	}
+	return job, nil
+}
+
+// Retry queues a failed job to run again with a fresh set of attempts
+func (q *Queue) Retry(ctx context.Context, id uuid.UUID) (*models.Job, error) {
+	job, err := q.repo.FindByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if job.Status != models.JobFailed {
+		return nil, ErrNotRetryable
+	}
+
+	job.Status = models.JobPending
+	job.Attempts = 0
+	job.RunAt = time.Now().UTC()
+	job.LockedUntil = nil
+	job.StartedAt = nil
+	job.FinishedAt = nil
+	job.LastError = ""
+	if err := q.repo.Update(ctx, job); err != nil {
+		return nil, err
+	}
+
+	q.notify()
+	return job, nil
+}
+
+// Run starts the workers and recurring jobs and blocks until ctx is
+// cancelled, then waits for running jobs to finish. With no workers
+// configured, it returns immediately and jobs are left to other processes.
+func (q *Queue) Run(ctx context.Context) {
+	if q.cfg.Workers == 0 {
+		logger.Info("Job workers disabled; jobs are left to other processes")
+		return
+	}
+
+	q.mu.RLock()
+	for _, r := range q.recurring {
+		go q.schedule(ctx, r)
+	}
+	q.mu.RUnlock()
+
+	logger.Info("Job workers started", logger.Int("workers", q.cfg.Workers))
+
+	var running sync.WaitGroup
+	slots := make(chan struct{}, q.cfg.Workers)
+	ticker := time.NewTicker(q.cfg.PollInterval)
+	defer ticker.Stop()
+
+	for {
+		q.dispatch(ctx, slots, &running)
+
+		select {
+		case <-ctx.Done():
+			running.Wait()
+			logger.Info("Job workers stopped")
+			return
+		case <-ticker.C:
+		case <-q.wake:
+		}
+	}
+}
+
+// Ping checks that the workers are looking for due jobs, or all busy,
+// within the last three poll intervals.
+// With no workers configured, jobs are left to other processes and there is
+// nothing to check.
+func (q *Queue) Ping(_ context.Context) error {
+	if q.cfg.Workers == 0 {
+		return nil
+	}
+	polledAt := q.polledAt.Load()
+	if polledAt == 0 {
+		return errors.New("job workers have not polled for jobs yet")
+	}
+	if since := time.Since(time.Unix(0, polledAt)); since > 3*q.cfg.PollInterval {
+		return fmt.Errorf("job workers last polled for jobs %s ago", since.Round(time.Second))
+	}
+	return nil
+}
+
+// dispatch claims as many due jobs as there are free workers and starts them
+func (q *Queue) dispatch(ctx context.Context, slots chan struct{}, running *sync.WaitGroup) {
+	free := cap(slots) - len(slots)
+	if free == 0 {
+		q.polledAt.Store(time.Now().UnixNano())
+		return
+	}
+
+	now := time.Now().UTC()
+	due, err := q.repo.FindDue(ctx, now, free)
+	if err != nil {
+		if ctx.Err() == nil {
+			logger.Error("Failed to find due jobs", logger.Err(err))
+		}
+		return
+	}
+	q.polledAt.Store(now.UnixNano())
+
+	for i := range due {
+		job := &due[i]
+		claimed, err := q.repo.Claim(ctx, job, now, now.Add(q.cfg.Timeout))
+		if err != nil {
+			logger.Error("Failed to claim job", logger.String("job_id", job.ID.String()), logger.Err(err))
+			continue
+		}
+		if !claimed {
+			continue
+		}
+
+		slots <- struct{}{}
+		running.Add(1)
+		go func() {
+			defer running.Done()
+			defer func() { <-slots }()
+			q.run(ctx, job)
+			q.notify()
+		}()
+	}
+}
+
+// run runs a claimed job and records the outcome. Jobs are not cancelled
+// when the queue stops; they get until the end of their lease to finish.
+func (q *Queue) run(ctx context.Context, job *models.Job) {
+	ctx, cancel := context.WithTimeout(context.WithoutCancel(ctx), q.cfg.Timeout)
+	defer cancel()
+	if job.RequestID != "" {
+		ctx = requestid.WithContext(ctx, job.RequestID, job.Traceparent)
+	}
+	// Jobs usually run right after the write that queued them, before the
+	// replicas may have caught up
+	ctx = database.ReadFromPrimary(ctx)
+
+	q.mu.RLock()
+	handler, ok := q.handlers[job.Type]
+	q.mu.RUnlock()
+
+	var err error
+	if ok {
+		err = q.call(ctx, handler, job)
+	} else {
+		err = Permanent(fmt.Errorf("no handler registered for job type %q", job.Type))
+	}
+
+	now := time.Now().UTC()
+	job.LockedUntil = nil
+
+	var permanent permanentError
+	switch {
+	case err == nil:
+		job.Status = models.JobSucceeded
+		job.FinishedAt = &now
+		job.LastError = ""
+	case errors.As(err, &permanent) || job.Attempts >= job.MaxAttempts:
+		job.Status = models.JobFailed
+		job.FinishedAt = &now
+		job.LastError = truncate(err.Error(), 1024)
+	default:
+		job.Status = models.JobPending
+		job.RunAt = now.Add(q.retryDelay(job.Attempts))
+		job.LastError = truncate(err.Error(), 1024)
+	}
+
+	if err != nil {
+		logger.Warn("Job failed",
+			logger.String("job_id", job.ID.String()),
+			logger.String("type", job.Type),
+			logger.Int("attempt", job.Attempts),
+			logger.String("status", string(job.Status)),
+			logger.String("request_id", job.RequestID),
+			logger.Err(err),
+		)
+	}
+
+	if err := q.repo.Finish(ctx, job); err != nil {
+		logger.Error("Failed to update job", logger.String("job_id", job.ID.String()), logger.Err(err))
+	}
+}
+
+// call runs a handler, turning a panic into an error
+func (q *Queue) call(ctx context.Context, handler Handler, job *models.Job) (err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			err = fmt.Errorf("job panicked: %v", r)
+		}
+	}()
+	return handler(ctx, []byte(job.Payload))
+}
+
+// schedule enqueues a recurring job on its interval until ctx is cancelled
+func (q *Queue) schedule(ctx context.Context, r recurring) {
+	ticker := time.NewTicker(r.interval)
+	defer ticker.Stop()
+
+	for {
+		_, err := q.Enqueue(ctx, r.jobType, nil, UniqueKey("recurring:"+r.jobType))
+		if err != nil && !errors.Is(err, ErrDuplicate) && ctx.Err() == nil {
+			logger.Error("Failed to enqueue recurring job", logger.String("type", r.jobType), logger.Err(err))
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// purge deletes finished jobs past their retention
+func (q *Queue) purge(ctx context.Context) error {
+	deleted, err := q.repo.DeleteFinishedBefore(ctx, time.Now().UTC().Add(-q.cfg.Retention))
+	if err != nil {
+		return err
+	}
+	if deleted > 0 {
+		logger.Info("Purged finished jobs", logger.Int("count", int(deleted)))
+	}
+	return nil
+}
+
+// notify prompts the dispatcher to look for due jobs
+func (q *Queue) notify() {
+	select {
+	case q.wake <- struct{}{}:
+	default:
+	}
+}
+
+// retryDelay returns how long to wait after the given number of failed
+// attempts: RetryDelay, doubling with each further attempt
+func (q *Queue) retryDelay(attempts int) time.Duration {
+	delay := q.cfg.RetryDelay
+	for i := 1; i < attempts && delay < maxRetryDelay; i++ {
+		delay *= 2
+	}
+	if delay > maxRetryDelay {
+		delay = maxRetryDelay
+	}
+	return delay
+}
+
+// truncate shortens s to at most max bytes
+func truncate(s string, max int) string {
+	if len(s) <= max {
+		return s
+	}
+	return s[:max]
+}
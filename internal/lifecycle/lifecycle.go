@@ -0,0 +1,92 @@
+// Package lifecycle stops the background components of the API server when
+// it shuts down, so their in-flight work completes instead of being cut off
+// when the process exits.
+package lifecycle
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+
+	"github.com/yourusername/go-enterprise-api/pkg/logger"
+)
+
+// component is a registered background component
+type component struct {
+	name string
+	stop func(ctx context.Context) error
+}
+
+// Manager stops background components in the reverse of the order they were
+// registered in, like deferred calls, so a component is stopped before the
+// components it depends on.
+type Manager struct {
+	mu         sync.Mutex
+	components []component
+	stopped    bool
+}
+
+// New creates a lifecycle manager
+func New() *Manager {
+	return &Manager{}
+}
+
+// Go runs a component in the background until it is stopped: its context is
+// then cancelled and Shutdown waits for run to return.
+//
+// Usage:
+//
+//	lc.Go("job workers", queue.Run)
+func (m *Manager) Go(name string, run func(ctx context.Context)) {
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		run(ctx)
+	}()
+
+	m.OnShutdown(name, func(stopCtx context.Context) error {
+		cancel()
+		select {
+		case <-done:
+			return nil
+		case <-stopCtx.Done():
+			return stopCtx.Err()
+		}
+	})
+}
+
+// OnShutdown registers a function stopping a component. It should return
+// once the component's in-flight work is done, or when ctx is done.
+func (m *Manager) OnShutdown(name string, stop func(ctx context.Context) error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.components = append(m.components, component{name: name, stop: stop})
+}
+
+// Shutdown stops the components one at a time, newest first, sharing ctx as
+// their deadline. A component failing to stop is logged and does not keep
+// the others running. It returns the errors of the components that failed.
+func (m *Manager) Shutdown(ctx context.Context) error {
+	m.mu.Lock()
+	if m.stopped {
+		m.mu.Unlock()
+		return nil
+	}
+	m.stopped = true
+	components := m.components
+	m.mu.Unlock()
+
+	var errs []error
+	for i := len(components) - 1; i >= 0; i-- {
+		c := components[i]
+		if err := c.stop(ctx); err != nil {
+			logger.Error("Failed to stop component", logger.String("component", c.name), logger.Err(err))
+			errs = append(errs, fmt.Errorf("%s: %w", c.name, err))
+			continue
+		}
+		logger.Info("Component stopped", logger.String("component", c.name))
+	}
+	return errors.Join(errs...)
+}
@@ -0,0 +1,176 @@
+// Package mailer renders and delivers transactional emails through a
+// configurable email provider
+package mailer
+
+import (
+	"context"
+	"fmt"
+	"net/mail"
+	"time"
+
+	"github.com/yourusername/go-enterprise-api/internal/config"
+	"github.com/yourusername/go-enterprise-api/internal/models"
+	"github.com/yourusername/go-enterprise-api/pkg/logger"
+	"github.com/yourusername/go-enterprise-api/pkg/metrics"
+)
+
+// Supported mail drivers
+const (
+	DriverNone     = "none"
+	DriverLog      = "log"
+	DriverSMTP     = "smtp"
+	DriverSendGrid = "sendgrid"
+	DriverSES      = "ses"
+)
+
+// emailsSent counts delivery attempts by template, provider and result
+var emailsSent = metrics.NewCounter("app_emails_sent_total",
+	"Emails sent by template, provider and result (success or failure).", "template", "provider", "result")
+
+// Message is a rendered email ready to be sent
+type Message struct {
+	From    mail.Address
+	To      []string
+	Subject string
+	HTML    string
+}
+
+// EmailSender delivers messages through an email provider
+type EmailSender interface {
+	// Send delivers a message
+	Send(ctx context.Context, msg *Message) error
+	// Ping checks that the provider can be reached with the configured
+	// credentials, without sending anything
+	Ping(ctx context.Context) error
+	// Name returns the provider name
+	Name() string
+}
+
+// NewSender creates an EmailSender for the configured driver.
+// It returns nil for the none driver, in which case no email is sent.
+func NewSender(cfg *config.MailConfig) (EmailSender, error) {
+	timeout := cfg.Timeout
+	if timeout <= 0 {
+		timeout = 10 * time.Second
+	}
+
+	switch cfg.Driver {
+	case "", DriverNone:
+		return nil, nil
+	case DriverLog:
+		return logSender{}, nil
+	case DriverSMTP:
+		return NewSMTPSender(cfg.SMTPHost, cfg.SMTPPort, cfg.SMTPUsername, cfg.SMTPPassword, timeout), nil
+	case DriverSendGrid:
+		return NewSendGridSender(cfg.URL, cfg.APIKey, timeout), nil
+	case DriverSES:
+		return NewSESSender(cfg.URL, cfg.SESRegion, cfg.SESAccessKey, cfg.SESSecretKey, timeout), nil
+	default:
+		return nil, fmt.Errorf("unsupported mail driver: %s", cfg.Driver)
+	}
+}
+
+// BrandingSource provides the site's current branding
+type BrandingSource interface {
+	Current(ctx context.Context) *models.Branding
+}
+
+// Mailer renders templates and sends them with an EmailSender
+type Mailer struct {
+	sender    EmailSender
+	templates *templates
+	from      mail.Address
+	appName   string
+	baseURL   string
+	branding  BrandingSource
+}
+
+// New creates a Mailer for the configured driver.
+// It returns nil for the none driver, in which case no email is sent.
+func New(cfg *config.MailConfig, appName string) (*Mailer, error) {
+	sender, err := NewSender(cfg)
+	if err != nil || sender == nil {
+		return nil, err
+	}
+
+	tmpl, err := parseTemplates()
+	if err != nil {
+		return nil, err
+	}
+
+	return &Mailer{
+		sender:    sender,
+		templates: tmpl,
+		from:      mail.Address{Name: cfg.FromName, Address: cfg.From},
+		appName:   appName,
+		baseURL:   cfg.BaseURL,
+	}, nil
+}
+
+// UseBranding renders emails with the site's branding instead of the
+// default look titled with the app name
+func (m *Mailer) UseBranding(source BrandingSource) {
+	m.branding = source
+}
+
+// brand returns the branding to render an email with
+func (m *Mailer) brand(ctx context.Context) *models.Branding {
+	if m.branding == nil {
+		return (&models.Branding{}).WithDefaults(m.appName)
+	}
+	return m.branding.Current(ctx)
+}
+
+// URL returns the absolute URL of a path on the public site
+func (m *Mailer) URL(path string) string {
+	return m.baseURL + path
+}
+
+// Send renders a template with data and sends it to a recipient
+func (m *Mailer) Send(ctx context.Context, to string, tmpl Template, data interface{}) error {
+	subject, html, err := m.templates.render(tmpl, m.brand(ctx), data)
+	if err != nil {
+		return fmt.Errorf("render %s email: %w", tmpl, err)
+	}
+
+	msg := &Message{
+		From:    m.from,
+		To:      []string{to},
+		Subject: subject,
+		HTML:    html,
+	}
+	if err := m.sender.Send(ctx, msg); err != nil {
+		emailsSent.Inc(string(tmpl), m.sender.Name(), "failure")
+		return fmt.Errorf("send %s email with %s: %w", tmpl, m.sender.Name(), err)
+	}
+	emailsSent.Inc(string(tmpl), m.sender.Name(), "success")
+	return nil
+}
+
+// Ping checks the email provider
+func (m *Mailer) Ping(ctx context.Context) error {
+	return m.sender.Ping(ctx)
+}
+
+// logSender only logs messages. It is meant for development.
+type logSender struct{}
+
+// Name returns the provider name
+func (logSender) Name() string {
+	return DriverLog
+}
+
+// Send logs a message instead of delivering it
+func (logSender) Send(ctx context.Context, msg *Message) error {
+	logger.Info("Email",
+		logger.Any("to", msg.To),
+		logger.String("subject", msg.Subject),
+		logger.String("html", msg.HTML),
+	)
+	return nil
+}
+
+// Ping always succeeds, as nothing is delivered
+func (logSender) Ping(ctx context.Context) error {
+	return nil
+}
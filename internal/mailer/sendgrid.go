@@ -0,0 +1,110 @@
+package mailer
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/yourusername/go-enterprise-api/pkg/requestid"
+)
+
+// defaultSendGridURL is the SendGrid API endpoint
+const defaultSendGridURL = "https://api.sendgrid.com"
+
+// SendGridSender implements EmailSender using the SendGrid v3 mail send API
+type SendGridSender struct {
+	baseURL string
+	apiKey  string
+	client  *http.Client
+}
+
+// NewSendGridSender creates a new SendGrid sender. An empty baseURL uses the
+// public SendGrid API.
+func NewSendGridSender(baseURL, apiKey string, timeout time.Duration) *SendGridSender {
+	if baseURL == "" {
+		baseURL = defaultSendGridURL
+	}
+	return &SendGridSender{
+		baseURL: strings.TrimRight(baseURL, "/"),
+		apiKey:  apiKey,
+		client:  &http.Client{Timeout: timeout},
+	}
+}
+
+// Name returns the provider name
+func (s *SendGridSender) Name() string {
+	return DriverSendGrid
+}
+
+// sendGridAddress is an email address in SendGrid requests
+type sendGridAddress struct {
+	Email string `json:"email"`
+	Name  string `json:"name,omitempty"`
+}
+
+// Send delivers a message through SendGrid
+func (s *SendGridSender) Send(ctx context.Context, msg *Message) error {
+	to := make([]sendGridAddress, len(msg.To))
+	for i, addr := range msg.To {
+		to[i] = sendGridAddress{Email: addr}
+	}
+
+	payload := map[string]interface{}{
+		"personalizations": []map[string]interface{}{{"to": to}},
+		"from":             sendGridAddress{Email: msg.From.Address, Name: msg.From.Name},
+		"subject":          msg.Subject,
+		"content": []map[string]string{
+			{"type": "text/html", "value": msg.HTML},
+		},
+	}
+	body, err := json.Marshal(payload)
+	if err != nil {
+		return err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, s.baseURL+"/v3/mail/send", bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("Authorization", "Bearer "+s.apiKey)
+	requestid.SetHeaders(ctx, req)
+
+	resp, err := s.client.Do(req)
+	if err != nil {
+		return fmt.Errorf("sendgrid request failed: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		msg, _ := io.ReadAll(io.LimitReader(resp.Body, 1024))
+		return fmt.Errorf("sendgrid returned %d: %s", resp.StatusCode, strings.TrimSpace(string(msg)))
+	}
+	return nil
+}
+
+// Ping checks that SendGrid accepts the API key by listing its scopes
+func (s *SendGridSender) Ping(ctx context.Context) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, s.baseURL+"/v3/scopes", nil)
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Authorization", "Bearer "+s.apiKey)
+
+	resp, err := s.client.Do(req)
+	if err != nil {
+		return fmt.Errorf("sendgrid request failed: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		msg, _ := io.ReadAll(io.LimitReader(resp.Body, 1024))
+		return fmt.Errorf("sendgrid returned %d: %s", resp.StatusCode, strings.TrimSpace(string(msg)))
+	}
+	return nil
+}
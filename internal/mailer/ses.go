@@ -0,0 +1,118 @@
+package mailer
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/yourusername/go-enterprise-api/pkg/awsv4"
+	"github.com/yourusername/go-enterprise-api/pkg/requestid"
+)
+
+// SESSender implements EmailSender using the Amazon SES v2 API
+type SESSender struct {
+	endpoint  string
+	region    string
+	accessKey string
+	secretKey string
+	client    *http.Client
+}
+
+// NewSESSender creates a new SES sender. An empty endpoint uses the regional
+// SES API.
+func NewSESSender(endpoint, region, accessKey, secretKey string, timeout time.Duration) *SESSender {
+	if endpoint == "" {
+		endpoint = "https://email." + region + ".amazonaws.com"
+	}
+	return &SESSender{
+		endpoint:  strings.TrimRight(endpoint, "/"),
+		region:    region,
+		accessKey: accessKey,
+		secretKey: secretKey,
+		client:    &http.Client{Timeout: timeout},
+	}
+}
+
+// Name returns the provider name
+func (s *SESSender) Name() string {
+	return DriverSES
+}
+
+// sesContent is a text part of an SES message
+type sesContent struct {
+	Data    string `json:"Data"`
+	Charset string `json:"Charset"`
+}
+
+// Send delivers a message through SES
+func (s *SESSender) Send(ctx context.Context, msg *Message) error {
+	payload := map[string]interface{}{
+		"FromEmailAddress": msg.From.String(),
+		"Destination": map[string]interface{}{
+			"ToAddresses": msg.To,
+		},
+		"Content": map[string]interface{}{
+			"Simple": map[string]interface{}{
+				"Subject": sesContent{Data: msg.Subject, Charset: "UTF-8"},
+				"Body": map[string]interface{}{
+					"Html": sesContent{Data: msg.HTML, Charset: "UTF-8"},
+				},
+			},
+		},
+	}
+	body, err := json.Marshal(payload)
+	if err != nil {
+		return err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, s.endpoint+"/v2/email/outbound-emails", bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	requestid.SetHeaders(ctx, req)
+	if err := awsv4.Sign(req, body, "ses", s.region, awsv4.Credentials{AccessKey: s.accessKey, SecretKey: s.secretKey}, time.Now().UTC()); err != nil {
+		return err
+	}
+
+	resp, err := s.client.Do(req)
+	if err != nil {
+		return fmt.Errorf("ses request failed: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		msg, _ := io.ReadAll(io.LimitReader(resp.Body, 1024))
+		return fmt.Errorf("ses returned %d: %s", resp.StatusCode, strings.TrimSpace(string(msg)))
+	}
+	return nil
+}
+
+// Ping checks that SES accepts the credentials by reading the account's
+// sending status
+func (s *SESSender) Ping(ctx context.Context) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, s.endpoint+"/v2/email/account", nil)
+	if err != nil {
+		return err
+	}
+	if err := awsv4.Sign(req, nil, "ses", s.region, awsv4.Credentials{AccessKey: s.accessKey, SecretKey: s.secretKey}, time.Now().UTC()); err != nil {
+		return err
+	}
+
+	resp, err := s.client.Do(req)
+	if err != nil {
+		return fmt.Errorf("ses request failed: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		msg, _ := io.ReadAll(io.LimitReader(resp.Body, 1024))
+		return fmt.Errorf("ses returned %d: %s", resp.StatusCode, strings.TrimSpace(string(msg)))
+	}
+	return nil
+}
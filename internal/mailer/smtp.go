@@ -0,0 +1,188 @@
+package mailer
+
+import (
+	"bytes"
+	"context"
+	"crypto/rand"
+	"crypto/tls"
+	"encoding/hex"
+	"fmt"
+	"mime"
+	"mime/quotedprintable"
+	"net"
+	"net/smtp"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/yourusername/go-enterprise-api/pkg/requestid"
+)
+
+// smtpsPort is the port of SMTP over implicit TLS; other ports use STARTTLS when offered
+const smtpsPort = 465
+
+// SMTPSender implements EmailSender over SMTP
+type SMTPSender struct {
+	host     string
+	port     int
+	username string
+	password string
+	timeout  time.Duration
+}
+
+// NewSMTPSender creates a new SMTP sender. Authentication is skipped when
+// username is empty.
+func NewSMTPSender(host string, port int, username, password string, timeout time.Duration) *SMTPSender {
+	return &SMTPSender{
+		host:     host,
+		port:     port,
+		username: username,
+		password: password,
+		timeout:  timeout,
+	}
+}
+
+// Name returns the provider name
+func (s *SMTPSender) Name() string {
+	return DriverSMTP
+}
+
+// Send delivers a message through the SMTP server
+func (s *SMTPSender) Send(ctx context.Context, msg *Message) error {
+	data, err := buildMIME(msg, requestid.FromContext(ctx))
+	if err != nil {
+		return err
+	}
+
+	client, err := s.dial(ctx)
+	if err != nil {
+		return err
+	}
+	defer client.Close()
+
+	if err := client.Mail(msg.From.Address); err != nil {
+		return err
+	}
+	for _, to := range msg.To {
+		if err := client.Rcpt(to); err != nil {
+			return err
+		}
+	}
+
+	w, err := client.Data()
+	if err != nil {
+		return err
+	}
+	if _, err := w.Write(data); err != nil {
+		return err
+	}
+	if err := w.Close(); err != nil {
+		return err
+	}
+	return client.Quit()
+}
+
+// Ping connects and authenticates to the SMTP server without sending a message
+func (s *SMTPSender) Ping(ctx context.Context) error {
+	client, err := s.dial(ctx)
+	if err != nil {
+		return err
+	}
+	defer client.Close()
+
+	if err := client.Noop(); err != nil {
+		return err
+	}
+	return client.Quit()
+}
+
+// dial connects to the SMTP server, upgrading to TLS when offered, and
+// authenticates. The connection must be done with within the timeout.
+func (s *SMTPSender) dial(ctx context.Context) (*smtp.Client, error) {
+	addr := net.JoinHostPort(s.host, strconv.Itoa(s.port))
+	dialer := &net.Dialer{Timeout: s.timeout}
+	conn, err := dialer.DialContext(ctx, "tcp", addr)
+	if err != nil {
+		return nil, fmt.Errorf("connect to %s: %w", addr, err)
+	}
+
+	deadline := time.Now().Add(s.timeout)
+	if d, ok := ctx.Deadline(); ok && d.Before(deadline) {
+		deadline = d
+	}
+	if err := conn.SetDeadline(deadline); err != nil {
+		conn.Close()
+		return nil, err
+	}
+
+	if s.port == smtpsPort {
+		conn = tls.Client(conn, &tls.Config{ServerName: s.host})
+	}
+
+	client, err := smtp.NewClient(conn, s.host)
+	if err != nil {
+		conn.Close()
+		return nil, err
+	}
+
+	if s.port != smtpsPort {
+		if ok, _ := client.Extension("STARTTLS"); ok {
+			if err := client.StartTLS(&tls.Config{ServerName: s.host}); err != nil {
+				client.Close()
+				return nil, fmt.Errorf("starttls: %w", err)
+			}
+		}
+	}
+
+	if s.username != "" {
+		if err := client.Auth(smtp.PlainAuth("", s.username, s.password, s.host)); err != nil {
+			client.Close()
+			return nil, fmt.Errorf("auth: %w", err)
+		}
+	}
+	return client, nil
+}
+
+// buildMIME formats a message as an RFC 5322 email with a quoted-printable
+// HTML body. A non-empty requestID is sent in the X-Request-ID header.
+func buildMIME(msg *Message, requestID string) ([]byte, error) {
+	var buf bytes.Buffer
+
+	header := func(name, value string) {
+		// Header values must not break out of their line
+		value = strings.NewReplacer("\r", "", "\n", " ").Replace(value)
+		buf.WriteString(name + ": " + value + "\r\n")
+	}
+	header("From", msg.From.String())
+	header("To", strings.Join(msg.To, ", "))
+	header("Subject", mime.QEncoding.Encode("utf-8", msg.Subject))
+	header("Date", time.Now().Format(time.RFC1123Z))
+	header("Message-ID", messageID(msg.From.Address))
+	if requestID != "" {
+		header(requestid.Header, requestID)
+	}
+	header("MIME-Version", "1.0")
+	header("Content-Type", "text/html; charset=UTF-8")
+	header("Content-Transfer-Encoding", "quoted-printable")
+	buf.WriteString("\r\n")
+
+	qp := quotedprintable.NewWriter(&buf)
+	if _, err := qp.Write([]byte(msg.HTML)); err != nil {
+		return nil, err
+	}
+	if err := qp.Close(); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+// messageID generates a unique Message-ID in the sender's domain
+func messageID(from string) string {
+	domain := "localhost"
+	if at := strings.LastIndex(from, "@"); at >= 0 {
+		domain = from[at+1:]
+	}
+	b := make([]byte, 16)
+	_, _ = rand.Read(b)
+	return "<" + hex.EncodeToString(b) + "@" + domain + ">"
+}
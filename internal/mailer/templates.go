@@ -0,0 +1,124 @@
+package mailer
+
+import (
+	"bytes"
+	"embed"
+	"fmt"
+	"html"
+	"html/template"
+	"strings"
+
+	"github.com/yourusername/go-enterprise-api/internal/models"
+)
+
+// Template names a built-in email template
+type Template string
+
+const (
+	TemplateVerification    Template = "verification"
+	TemplatePasswordReset   Template = "password_reset"
+	TemplateComment         Template = "comment"
+	TemplatePasswordChanged Template = "password_changed"
+	TemplateInvitation      Template = "invitation"
+	TemplateDormancy        Template = "dormancy"
+)
+
+// VerificationEmail is the data of the email address verification template
+type VerificationEmail struct {
+	Name      string
+	URL       string
+	ExpiresIn string
+}
+
+// PasswordResetEmail is the data of the password reset template
+type PasswordResetEmail struct {
+	Name      string
+	URL       string
+	ExpiresIn string
+}
+
+// CommentEmail is the data of the new comment notification template
+type CommentEmail struct {
+	Name          string
+	CommenterName string
+	PostTitle     string
+	PostURL       string
+	Comment       string
+}
+
+// PasswordChangedEmail is the data of the password change notice template
+type PasswordChangedEmail struct {
+	Name      string
+	ChangedAt string
+}
+
+// InvitationEmail is the data of the template inviting a user created by an
+// admin to choose their password
+type InvitationEmail struct {
+	Name        string
+	InviterName string
+	URL         string
+	ExpiresAt   string
+}
+
+// DormancyEmail is the data of the notice telling an inactive user that
+// their account will be deactivated unless they sign in
+type DormancyEmail struct {
+	Name         string
+	LoginURL     string
+	DeactivateAt string
+}
+
+//go:embed templates/*.html
+var templateFS embed.FS
+
+// templates holds the parsed email templates. Each template defines a
+// "subject" and a "content" block; "content" is rendered inside the shared
+// layout.
+type templates struct {
+	byName map[Template]*template.Template
+}
+
+// parseTemplates parses the built-in templates
+func parseTemplates() (*templates, error) {
+	t := &templates{byName: make(map[Template]*template.Template)}
+	for _, name := range []Template{TemplateVerification, TemplatePasswordReset, TemplateComment, TemplatePasswordChanged, TemplateInvitation, TemplateDormancy} {
+		tmpl, err := template.ParseFS(templateFS, "templates/layout.html", "templates/"+string(name)+".html")
+		if err != nil {
+			return nil, fmt.Errorf("parse %s template: %w", name, err)
+		}
+		t.byName[name] = tmpl
+	}
+	return t, nil
+}
+
+// templateData is what templates are executed with. AppName is the site
+// title of the branding.
+type templateData struct {
+	AppName string
+	Brand   *models.Branding
+	Data    interface{}
+}
+
+// render executes a template with the site's branding and returns its
+// subject and HTML body
+func (t *templates) render(name Template, brand *models.Branding, data interface{}) (string, string, error) {
+	tmpl, ok := t.byName[name]
+	if !ok {
+		return "", "", fmt.Errorf("unknown template %q", name)
+	}
+	td := templateData{AppName: brand.SiteTitle, Brand: brand, Data: data}
+
+	var subject bytes.Buffer
+	if err := tmpl.ExecuteTemplate(&subject, "subject", td); err != nil {
+		return "", "", err
+	}
+
+	var body bytes.Buffer
+	if err := tmpl.ExecuteTemplate(&body, "layout", td); err != nil {
+		return "", "", err
+	}
+
+	// The subject is plain text, so undo the escaping html/template applied
+	return strings.TrimSpace(html.UnescapeString(subject.String())), body.String(), nil
+}
@@ -0,0 +1,104 @@
+package malware
+
+import (
+	"bufio"
+	"context"
+	"encoding/binary"
+	"fmt"
+	"net"
+	"strings"
+	"time"
+)
+
+// clamAVChunkSize is the size of the chunks files are streamed to clamd in
+const clamAVChunkSize = 64 << 10
+
+// ClamAVScanner implements Scanner by streaming files to a clamd daemon
+// over TCP with the INSTREAM command
+type ClamAVScanner struct {
+	address string
+	timeout time.Duration
+}
+
+// NewClamAVScanner creates a new ClamAV scanner for the clamd daemon
+// listening at address (host:port)
+func NewClamAVScanner(address string, timeout time.Duration) *ClamAVScanner {
+	return &ClamAVScanner{
+		address: address,
+		timeout: timeout,
+	}
+}
+
+// Name returns the backend name
+func (s *ClamAVScanner) Name() string {
+	return DriverClamAV
+}
+
+// Scan streams data to clamd and parses its verdict
+func (s *ClamAVScanner) Scan(ctx context.Context, data []byte) (string, error) {
+	dialer := net.Dialer{Timeout: s.timeout}
+	conn, err := dialer.DialContext(ctx, "tcp", s.address)
+	if err != nil {
+		return "", fmt.Errorf("clamav connection failed: %w", err)
+	}
+	defer conn.Close()
+
+	deadline := time.Now().Add(s.timeout)
+	if ctxDeadline, ok := ctx.Deadline(); ok && ctxDeadline.Before(deadline) {
+		deadline = ctxDeadline
+	}
+	if err := conn.SetDeadline(deadline); err != nil {
+		return "", err
+	}
+
+	// The file is sent as chunks prefixed with their length in network byte
+	// order, ended by an empty chunk
+	w := bufio.NewWriter(conn)
+	if _, err := w.WriteString("zINSTREAM\x00"); err != nil {
+		return "", fmt.Errorf("clamav request failed: %w", err)
+	}
+	var length [4]byte
+	for start := 0; start < len(data); start += clamAVChunkSize {
+		chunk := data[start:min(start+clamAVChunkSize, len(data))]
+		binary.BigEndian.PutUint32(length[:], uint32(len(chunk)))
+		if _, err := w.Write(length[:]); err != nil {
+			return "", fmt.Errorf("clamav request failed: %w", err)
+		}
+		if _, err := w.Write(chunk); err != nil {
+			return "", fmt.Errorf("clamav request failed: %w", err)
+		}
+	}
+	binary.BigEndian.PutUint32(length[:], 0)
+	if _, err := w.Write(length[:]); err != nil {
+		return "", fmt.Errorf("clamav request failed: %w", err)
+	}
+	if err := w.Flush(); err != nil {
+		return "", fmt.Errorf("clamav request failed: %w", err)
+	}
+
+	reply, err := bufio.NewReader(conn).ReadString(0)
+	if err != nil && reply == "" {
+		return "", fmt.Errorf("clamav response failed: %w", err)
+	}
+	return parseClamAVReply(strings.TrimRight(reply, "\x00\n"))
+}
+
+// parseClamAVReply parses a reply such as "stream: OK" or
+// "stream: Eicar-Signature FOUND" into the name of the malware found
+func parseClamAVReply(reply string) (string, error) {
+	result := reply
+	if i := strings.Index(reply, ": "); i >= 0 {
+		result = reply[i+2:]
+	}
+
+	switch {
+	case result == "OK":
+		return "", nil
+	case strings.HasSuffix(result, " FOUND"):
+		return strings.TrimSuffix(result, " FOUND"), nil
+	case strings.HasSuffix(result, " ERROR"):
+		return "", fmt.Errorf("clamav scan failed: %s", strings.TrimSuffix(result, " ERROR"))
+	default:
+		return "", fmt.Errorf("unexpected clamav response: %q", reply)
+	}
+}
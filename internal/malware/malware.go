@@ -0,0 +1,43 @@
+// Package malware scans uploaded files for viruses and other malware
+package malware
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/yourusername/go-enterprise-api/internal/config"
+)
+
+// Supported malware scanner drivers
+const (
+	DriverNone   = "none"
+	DriverClamAV = "clamav"
+)
+
+// Scanner scans files for malware
+type Scanner interface {
+	// Scan scans data and returns the name of the malware found in it, or
+	// an empty string when it is clean
+	Scan(ctx context.Context, data []byte) (string, error)
+	// Name returns the backend name
+	Name() string
+}
+
+// New creates a Scanner for the configured driver.
+// It returns nil for the none driver, in which case uploads are not scanned.
+func New(cfg *config.MalwareConfig) (Scanner, error) {
+	timeout := cfg.Timeout
+	if timeout <= 0 {
+		timeout = 30 * time.Second
+	}
+
+	switch cfg.Driver {
+	case "", DriverNone:
+		return nil, nil
+	case DriverClamAV:
+		return NewClamAVScanner(cfg.ClamAVAddress, timeout), nil
+	default:
+		return nil, fmt.Errorf("unsupported malware driver: %s", cfg.Driver)
+	}
+}
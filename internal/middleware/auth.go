@@ -5,6 +5,7 @@ import (
 
 	"github.com/gin-gonic/gin"
 	"github.com/yourusername/go-enterprise-api/internal/models"
+	"github.com/yourusername/go-enterprise-api/internal/repository"
 	"github.com/yourusername/go-enterprise-api/internal/services"
 	apperrors "github.com/yourusername/go-enterprise-api/pkg/errors"
 	"github.com/yourusername/go-enterprise-api/pkg/response"
@@ -15,6 +16,8 @@ const (
 	AuthorizationHeader = "Authorization"
 	// BearerPrefix is the prefix for bearer tokens
 	BearerPrefix = "Bearer "
+	// APIKeyHeader is the header key for service account API keys
+	APIKeyHeader = "X-API-Key"
 	// UserKey is the context key for storing user
 	UserKey = "user"
 	// ClaimsKey is the context key for storing claims
@@ -24,6 +27,26 @@ const (
 // AuthMiddleware creates an authentication middleware
 func AuthMiddleware(authService services.AuthService) gin.HandlerFunc {
 	return func(c *gin.Context) {
+		// Service accounts authenticate with an API key instead of a JWT
+		if apiKey := c.GetHeader(APIKeyHeader); apiKey != "" {
+			user, err := authService.AuthenticateAPIKey(c.Request.Context(), apiKey)
+			if err != nil {
+				response.Error(c, err)
+				c.Abort()
+				return
+			}
+
+			if !user.IsActive() {
+				response.Forbidden(c, "Account is not active")
+				c.Abort()
+				return
+			}
+
+			setUser(c, user)
+			c.Next()
+			return
+		}
+
 		// Get authorization header
 		authHeader := c.GetHeader(AuthorizationHeader)
 		if authHeader == "" {
@@ -78,8 +101,9 @@ func AuthMiddleware(authService services.AuthService) gin.HandlerFunc {
 		}
 
 		// Store user and claims in context
-		c.Set(UserKey, user)
+		setUser(c, user)
 		c.Set(ClaimsKey, claims)
+		authService.RecordSeen(c.Request.Context(), user)
 
 		c.Next()
 	}
@@ -89,6 +113,14 @@ func AuthMiddleware(authService services.AuthService) gin.HandlerFunc {
 // It tries to authenticate but doesn't fail if no token is provided
 func OptionalAuthMiddleware(authService services.AuthService) gin.HandlerFunc {
 	return func(c *gin.Context) {
+		if apiKey := c.GetHeader(APIKeyHeader); apiKey != "" {
+			if user, err := authService.AuthenticateAPIKey(c.Request.Context(), apiKey); err == nil && user.IsActive() {
+				setUser(c, user)
+			}
+			c.Next()
+			return
+		}
+
 		authHeader := c.GetHeader(AuthorizationHeader)
 		if authHeader == "" {
 			c.Next()
@@ -123,8 +155,11 @@ func OptionalAuthMiddleware(authService services.AuthService) gin.HandlerFunc {
 			return
 		}
 
-		c.Set(UserKey, user)
+		setUser(c, user)
 		c.Set(ClaimsKey, claims)
+		if user.IsActive() {
+			authService.RecordSeen(c.Request.Context(), user)
+		}
 
 		c.Next()
 	}
@@ -169,6 +204,14 @@ func RequireAdminOrModerator() gin.HandlerFunc {
 	return RequireRole(models.RoleAdmin, models.RoleModerator)
 }
 
+// setUser stores the authenticated user in context. The user is also stored
+// in the request context so repositories can show them content only they
+// may see (e.g. their own shadowed posts).
+func setUser(c *gin.Context, user *models.User) {
+	c.Set(UserKey, user)
+	c.Request = c.Request.WithContext(repository.WithViewer(c.Request.Context(), user))
+}
+
 // GetUser retrieves the authenticated user from context
 func GetUser(c *gin.Context) (*models.User, bool) {
 	user, exists := c.Get(UserKey)
@@ -0,0 +1,142 @@
+package middleware
+
+import (
+	"bytes"
+	"io"
+	"mime"
+	"net/http"
+	"regexp"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+)
+
+// redactedValue replaces the values of redacted fields
+const redactedValue = "[REDACTED]"
+
+// bodyRedactor replaces the values of sensitive fields in logged bodies and
+// query strings. A field is sensitive when its name contains one of the
+// redacted names, ignoring case, so "token" also covers "access_token".
+// Values are replaced in place rather than by decoding and encoding the
+// body, so truncated bodies are redacted too.
+type bodyRedactor struct {
+	json *regexp.Regexp
+	form *regexp.Regexp
+}
+
+// newBodyRedactor creates a redactor for fields containing one of names. It
+// returns nil when names is empty.
+func newBodyRedactor(names []string) *bodyRedactor {
+	if len(names) == 0 {
+		return nil
+	}
+	quoted := make([]string, len(names))
+	for i, name := range names {
+		quoted[i] = regexp.QuoteMeta(name)
+	}
+	alternatives := strings.Join(quoted, "|")
+
+	return &bodyRedactor{
+		// A JSON string, possibly cut off by truncation, or a scalar. Objects
+		// and arrays are left alone; their own fields are redacted.
+		json: regexp.MustCompile(`(?i)("[^"\\]*(?:` + alternatives + `)[^"\\]*"\s*:\s*)(?:"(?:[^"\\]|\\.)*(?:"|\\?$)|[^\s,}\]{\[]+)`),
+		form: regexp.MustCompile(`(?i)((?:^|&)[^=&]*(?:` + alternatives + `)[^=&]*=)[^&]*`),
+	}
+}
+
+// redactJSON redacts a JSON body
+func (r *bodyRedactor) redactJSON(body string) string {
+	if r == nil {
+		return body
+	}
+	return r.json.ReplaceAllString(body, `${1}"`+redactedValue+`"`)
+}
+
+// redactForm redacts a query string or form body
+func (r *bodyRedactor) redactForm(body string) string {
+	if r == nil {
+		return body
+	}
+	return r.form.ReplaceAllString(body, "${1}"+redactedValue)
+}
+
+// redact redacts a body according to its content type
+func (r *bodyRedactor) redact(contentType, body string) string {
+	if bodyKind(contentType) == "form" {
+		return r.redactForm(body)
+	}
+	return r.redactJSON(body)
+}
+
+// bodyKind classifies the bodies worth logging: "json", "form" or "text".
+// Binary bodies and multipart uploads are not logged and give "".
+func bodyKind(contentType string) string {
+	mediaType, _, err := mime.ParseMediaType(contentType)
+	if err != nil {
+		return ""
+	}
+	switch {
+	case mediaType == "application/json" || strings.HasSuffix(mediaType, "+json"):
+		return "json"
+	case mediaType == "application/x-www-form-urlencoded":
+		return "form"
+	case strings.HasPrefix(mediaType, "text/") || mediaType == "application/xml" || strings.HasSuffix(mediaType, "+xml"):
+		return "text"
+	default:
+		return ""
+	}
+}
+
+// captureRequestBody reads up to max bytes of a request body for logging and
+// leaves the body intact for the handler. It reports whether the body was
+// longer than max.
+func captureRequestBody(req *http.Request, max int) ([]byte, bool) {
+	body := req.Body
+	if body == nil || body == http.NoBody {
+		return nil, false
+	}
+	prefix, _ := io.ReadAll(io.LimitReader(body, int64(max)+1))
+	req.Body = struct {
+		io.Reader
+		io.Closer
+	}{io.MultiReader(bytes.NewReader(prefix), body), body}
+
+	if len(prefix) > max {
+		return prefix[:max], true
+	}
+	return prefix, false
+}
+
+// bodyLogWriter keeps the first bytes of a response for logging while
+// passing it through
+type bodyLogWriter struct {
+	gin.ResponseWriter
+	body      bytes.Buffer
+	max       int
+	truncated bool
+}
+
+func (w *bodyLogWriter) Write(data []byte) (int, error) {
+	w.capture(data)
+	return w.ResponseWriter.Write(data)
+}
+
+func (w *bodyLogWriter) WriteString(s string) (int, error) {
+	w.capture([]byte(s))
+	return w.ResponseWriter.WriteString(s)
+}
+
+// Unwrap lets http.ResponseController reach the underlying writer
+func (w *bodyLogWriter) Unwrap() http.ResponseWriter {
+	return w.ResponseWriter
+}
+
+// capture keeps data up to the size limit
+func (w *bodyLogWriter) capture(data []byte) {
+	room := w.max - w.body.Len()
+	if len(data) > room {
+		data = data[:room]
+		w.truncated = true
+	}
+	w.body.Write(data)
+}
@@ -6,6 +6,7 @@ import (
 
 	"github.com/gin-gonic/gin"
 	"github.com/yourusername/go-enterprise-api/internal/config"
+	"github.com/yourusername/go-enterprise-api/pkg/requestid"
 )
 
 // CORS creates a CORS middleware
@@ -28,6 +29,10 @@ func CORS(cfg *config.CORSConfig) gin.HandlerFunc {
 		c.Header("Access-Control-Allow-Origin", allowedOrigin)
 		c.Header("Access-Control-Allow-Methods", strings.Join(cfg.AllowedMethods, ", "))
 		c.Header("Access-Control-Allow-Headers", strings.Join(cfg.AllowedHeaders, ", "))
+		c.Header("Access-Control-Expose-Headers", strings.Join([]string{
+			RateLimitLimitHeader, RateLimitRemainingHeader, RateLimitResetHeader, RetryAfterHeader, "ETag", "Last-Modified", IdempotentReplayedHeader, requestid.Header,
+			APIVersionHeader, DeprecationHeader, SunsetHeader, "Link",
+		}, ", "))
 		c.Header("Access-Control-Allow-Credentials", "true")
 		c.Header("Access-Control-Max-Age", "86400")
 
@@ -0,0 +1,32 @@
+package middleware
+
+import (
+	"crypto/subtle"
+
+	"github.com/gin-gonic/gin"
+	"github.com/yourusername/go-enterprise-api/internal/config"
+	"github.com/yourusername/go-enterprise-api/pkg/response"
+)
+
+// DocsAuth requires the docs credentials with HTTP Basic authentication,
+// which browsers prompt for. Without a password, the docs are public.
+func DocsAuth(cfg *config.DocsConfig) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		if cfg.Password == "" {
+			c.Next()
+			return
+		}
+
+		username, password, ok := c.Request.BasicAuth()
+		validUsername := subtle.ConstantTimeCompare([]byte(username), []byte(cfg.Username)) == 1
+		validPassword := subtle.ConstantTimeCompare([]byte(password), []byte(cfg.Password)) == 1
+		if !ok || !validUsername || !validPassword {
+			c.Header("WWW-Authenticate", `Basic realm="API docs", charset="UTF-8"`)
+			response.Unauthorized(c, "Invalid docs credentials")
+			c.Abort()
+			return
+		}
+
+		c.Next()
+	}
+}
@@ -0,0 +1,81 @@
+package middleware
+
+import (
+	"net/http"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/yourusername/go-enterprise-api/internal/geoip"
+	apperrors "github.com/yourusername/go-enterprise-api/pkg/errors"
+	"github.com/yourusername/go-enterprise-api/pkg/response"
+)
+
+const (
+	// CountryKey is the context key for the resolved country code
+	CountryKey = "country"
+)
+
+// GeoIP creates a middleware that tags each request with the client's
+// country code. The code is also stored in the request context so services
+// can record it (e.g. in the change history).
+func GeoIP(resolver geoip.Resolver) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		if country := resolver.Country(c.Request, c.ClientIP()); country != "" {
+			c.Set(CountryKey, country)
+			c.Request = c.Request.WithContext(geoip.WithCountry(c.Request.Context(), country))
+		}
+		c.Next()
+	}
+}
+
+// GetCountry retrieves the resolved country code from context ("" when unknown)
+func GetCountry(c *gin.Context) string {
+	if country, exists := c.Get(CountryKey); exists {
+		return country.(string)
+	}
+	return ""
+}
+
+// BlockCountries creates a middleware rejecting requests from the given countries
+func BlockCountries(countries []string, message string) gin.HandlerFunc {
+	blocked := make(map[string]bool, len(countries))
+	for _, country := range countries {
+		blocked[country] = true
+	}
+
+	return func(c *gin.Context) {
+		if blocked[GetCountry(c)] {
+			response.Forbidden(c, message)
+			c.Abort()
+			return
+		}
+		c.Next()
+	}
+}
+
+// GeoRateLimit creates a middleware applying per-country rate limits on top
+// of the global limit. Countries without a configured limit and requests
+// made with a read key are not affected.
+func GeoRateLimit(store RateLimitStore, limits map[string]int, window time.Duration) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		country := GetCountry(c)
+		limit, ok := limits[country]
+		if _, exists := GetReadKey(c); !ok || exists {
+			c.Next()
+			return
+		}
+
+		if !allow(c, store, "geo:"+country+":"+c.ClientIP(), limit, window) {
+			response.Abort(c, http.StatusTooManyRequests, response.Response{
+				Success: false,
+				Error: response.Localize(c, &response.ErrorInfo{
+					Code:    apperrors.CodeTooManyRequests,
+					Message: "Rate limit exceeded for your region. Please try again later.",
+				}),
+			})
+			return
+		}
+
+		c.Next()
+	}
+}
@@ -0,0 +1,201 @@
+package middleware
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/gin-gonic/gin"
+)
+
+// maxCachedBody bounds the size of a response buffered to compute its ETag.
+// Larger responses, and responses that are flushed while being written, are
+// passed through without an ETag.
+const maxCachedBody = 1 << 20
+
+// privateCacheControl is sent instead of a route's policy when the response
+// was made for an authenticated user, so shared caches do not keep it
+const privateCacheControl = "private, no-cache"
+
+// cacheVary lists the request headers responses vary by: the viewer, the
+// requested format, the language of messages and the requested time
+// representation. The lang query parameter overriding Accept-Language is
+// part of the URL, so caches already key on it.
+var cacheVary = strings.Join([]string{"Authorization", "Accept", "Accept-Language", TimezoneHeader, TimeFormatHeader}, ", ")
+
+// HTTPCache creates a middleware making successful GET responses cacheable.
+// Responses get an ETag computed from their body unless the handler set one,
+// and cacheControl as their Cache-Control header unless the handler set one
+// (an empty cacheControl leaves it unset). Requests whose If-None-Match
+// matches the ETag, or whose If-Modified-Since is not older than the
+// Last-Modified header set with SetLastModified, are answered with
+// 304 Not Modified and no body. Vary names further request headers the
+// responses depend on, such as the tenancy header.
+func HTTPCache(cacheControl string, vary ...string) gin.HandlerFunc {
+	varyHeader := strings.Join(append([]string{cacheVary}, vary...), ", ")
+	return func(c *gin.Context) {
+		if c.Request.Method != http.MethodGet {
+			c.Next()
+			return
+		}
+
+		w := &cacheWriter{ResponseWriter: c.Writer, status: http.StatusOK}
+		c.Writer = w
+		c.Next()
+		c.Writer = w.ResponseWriter
+
+		if w.passthrough {
+			return
+		}
+		if w.status != http.StatusOK {
+			w.flush()
+			return
+		}
+
+		header := w.Header()
+		etag := header.Get("ETag")
+		if etag == "" {
+			sum := sha256.Sum256(w.body.Bytes())
+			etag = `"` + hex.EncodeToString(sum[:16]) + `"`
+			header.Set("ETag", etag)
+		}
+		if header.Get("Cache-Control") == "" {
+			if _, authenticated := GetUser(c); authenticated {
+				header.Set("Cache-Control", privateCacheControl)
+			} else if cacheControl != "" {
+				header.Set("Cache-Control", cacheControl)
+			}
+		}
+		header.Add("Vary", varyHeader)
+
+		if notModified(c.Request, etag, header.Get("Last-Modified")) {
+			header.Del("Content-Type")
+			header.Del("Content-Length")
+			w.ResponseWriter.WriteHeader(http.StatusNotModified)
+			w.ResponseWriter.WriteHeaderNow()
+			return
+		}
+		w.flush()
+	}
+}
+
+// SetLastModified reports when the resource of a response last changed, in
+// the Last-Modified header, so HTTPCache can answer If-Modified-Since
+func SetLastModified(c *gin.Context, t time.Time) {
+	c.Header("Last-Modified", t.UTC().Format(http.TimeFormat))
+}
+
+// notModified reports whether the client's copy of a response is current.
+// If-None-Match takes precedence over If-Modified-Since.
+func notModified(r *http.Request, etag, lastModified string) bool {
+	if ifNoneMatch := r.Header.Get("If-None-Match"); ifNoneMatch != "" {
+		return etagMatches(ifNoneMatch, etag)
+	}
+
+	ifModifiedSince := r.Header.Get("If-Modified-Since")
+	if ifModifiedSince == "" || lastModified == "" {
+		return false
+	}
+	since, err := http.ParseTime(ifModifiedSince)
+	if err != nil {
+		return false
+	}
+	modified, err := http.ParseTime(lastModified)
+	if err != nil {
+		return false
+	}
+	return !modified.After(since)
+}
+
+// etagMatches reports whether an If-None-Match header matches etag, using
+// the weak comparison If-None-Match calls for
+func etagMatches(ifNoneMatch, etag string) bool {
+	etag = strings.TrimPrefix(etag, "W/")
+	for _, candidate := range strings.Split(ifNoneMatch, ",") {
+		candidate = strings.TrimSpace(candidate)
+		if candidate == "*" || strings.TrimPrefix(candidate, "W/") == etag {
+			return true
+		}
+	}
+	return false
+}
+
+// cacheWriter buffers a response so its ETag can be computed before it is
+// sent. It falls back to passing the response through when it grows past
+// maxCachedBody or is flushed, as streamed responses are.
+type cacheWriter struct {
+	gin.ResponseWriter
+	body        bytes.Buffer
+	status      int
+	passthrough bool
+}
+
+func (w *cacheWriter) WriteHeader(code int) {
+	if w.passthrough {
+		w.ResponseWriter.WriteHeader(code)
+		return
+	}
+	w.status = code
+}
+
+// WriteHeaderNow is deferred until the response is sent
+func (w *cacheWriter) WriteHeaderNow() {
+	if w.passthrough {
+		w.ResponseWriter.WriteHeaderNow()
+	}
+}
+
+func (w *cacheWriter) Write(data []byte) (int, error) {
+	if w.passthrough {
+		return w.ResponseWriter.Write(data)
+	}
+	if w.body.Len()+len(data) > maxCachedBody {
+		w.flush()
+		return w.ResponseWriter.Write(data)
+	}
+	return w.body.Write(data)
+}
+
+func (w *cacheWriter) WriteString(s string) (int, error) {
+	return w.Write([]byte(s))
+}
+
+func (w *cacheWriter) Flush() {
+	if !w.passthrough {
+		w.flush()
+	}
+	w.ResponseWriter.Flush()
+}
+
+func (w *cacheWriter) Status() int {
+	if w.passthrough {
+		return w.ResponseWriter.Status()
+	}
+	return w.status
+}
+
+func (w *cacheWriter) Size() int {
+	if w.passthrough {
+		return w.ResponseWriter.Size()
+	}
+	return w.body.Len()
+}
+
+func (w *cacheWriter) Written() bool {
+	return w.passthrough || w.body.Len() > 0
+}
+
+// flush sends the status and the buffered body and passes the rest of the
+// response through
+func (w *cacheWriter) flush() {
+	w.passthrough = true
+	w.ResponseWriter.WriteHeader(w.status)
+	w.ResponseWriter.WriteHeaderNow()
+	if w.body.Len() > 0 {
+		w.ResponseWriter.Write(w.body.Bytes())
+		w.body.Reset()
+	}
+}
@@ -0,0 +1,189 @@
+package middleware
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"hash"
+	"io"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/yourusername/go-enterprise-api/internal/models"
+	"github.com/yourusername/go-enterprise-api/internal/services"
+	apperrors "github.com/yourusername/go-enterprise-api/pkg/errors"
+	"github.com/yourusername/go-enterprise-api/pkg/response"
+)
+
+const (
+	// IdempotencyKeyHeader is the header clients send a unique key for a POST
+	// request in, so that retrying the request is safe
+	IdempotencyKeyHeader = "Idempotency-Key"
+	// IdempotentReplayedHeader marks responses replayed for a retried request
+	IdempotentReplayedHeader = "Idempotent-Replayed"
+)
+
+// maxIdempotencyKeyLength bounds the length of an Idempotency-Key
+const maxIdempotencyKeyLength = 255
+
+// maxIdempotentResponse bounds the size of a response stored for replay.
+// Larger responses are not stored, so retries are processed again.
+const maxIdempotentResponse = 1 << 20
+
+// Idempotency creates a middleware making POST requests sent with an
+// Idempotency-Key header safe to retry. The first request with a key is
+// processed and its response stored; retries with the same key and request
+// get the stored response, marked with the Idempotent-Replayed header,
+// without being processed again. A key reused for a different request is
+// rejected with 422, and a retry arriving while the first request is still
+// being processed with 409.
+//
+// Keys belong to the credentials a request was sent with, or to the client
+// IP for anonymous requests, so a retry must be sent with the same token or
+// API key. Rate limited requests and server errors are not stored, so the
+// request can be retried.
+func Idempotency(idempotencyService services.IdempotencyService) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		key := c.GetHeader(IdempotencyKeyHeader)
+		if c.Request.Method != http.MethodPost || key == "" {
+			c.Next()
+			return
+		}
+		if len(key) > maxIdempotencyKeyLength {
+			response.BadRequest(c, "Idempotency-Key must be at most 255 characters")
+			c.Abort()
+			return
+		}
+
+		scope := idempotencyScope(c)
+		existing, err := idempotencyService.Begin(c.Request.Context(), scope, key)
+		if err != nil {
+			response.Error(c, err)
+			c.Abort()
+			return
+		}
+		if existing != nil {
+			replay(c, existing)
+			return
+		}
+
+		// Storing the outcome must not depend on the client waiting for it:
+		// a disconnected client is the one most likely to retry
+		ctx := context.WithoutCancel(c.Request.Context())
+
+		hasher := newRequestHasher(c.Request)
+		body := c.Request.Body
+		c.Request.Body = io.NopCloser(io.TeeReader(body, hasher))
+		w := &recordingWriter{ResponseWriter: c.Writer}
+		c.Writer = w
+
+		done := false
+		defer func() {
+			// Release the key when the handler panicked
+			if !done {
+				idempotencyService.Release(ctx, scope, key)
+			}
+		}()
+
+		c.Next()
+		c.Writer = w.ResponseWriter
+		done = true
+
+		// The hash covers the whole body, including what the handler left unread
+		_, _ = io.Copy(hasher, body)
+
+		// Rate limited requests and server errors are worth retrying
+		status := w.Status()
+		if status == http.StatusTooManyRequests || status >= http.StatusInternalServerError || w.overflow {
+			idempotencyService.Release(ctx, scope, key)
+			return
+		}
+		idempotencyService.Complete(ctx, &models.IdempotencyKey{
+			Scope:       scope,
+			Key:         key,
+			RequestHash: hex.EncodeToString(hasher.Sum(nil)),
+			StatusCode:  status,
+			ContentType: w.Header().Get("Content-Type"),
+			Body:        w.body.Bytes(),
+		})
+	}
+}
+
+// replay answers a request whose key was used before: with the stored
+// response when the request is the same and has completed
+func replay(c *gin.Context, existing *models.IdempotencyKey) {
+	if !existing.IsComplete() {
+		c.Header(RetryAfterHeader, "1")
+		response.Error(c, apperrors.ErrConflict.WithDetails("A request with this Idempotency-Key is being processed"))
+		c.Abort()
+		return
+	}
+
+	hasher := newRequestHasher(c.Request)
+	if _, err := io.Copy(hasher, c.Request.Body); err != nil {
+		response.BadRequest(c, "Failed to read request body")
+		c.Abort()
+		return
+	}
+	if hex.EncodeToString(hasher.Sum(nil)) != existing.RequestHash {
+		response.Error(c, apperrors.ErrIdempotencyMismatch)
+		c.Abort()
+		return
+	}
+
+	c.Header(IdempotentReplayedHeader, "true")
+	c.Data(existing.StatusCode, existing.ContentType, existing.Body)
+	c.Abort()
+}
+
+// idempotencyScope identifies who a key belongs to: a hash of the request's
+// credentials, or of the client IP for anonymous requests
+func idempotencyScope(c *gin.Context) string {
+	owner := "ip:" + c.ClientIP()
+	if apiKey := c.GetHeader(APIKeyHeader); apiKey != "" {
+		owner = "apikey:" + apiKey
+	} else if auth := c.GetHeader(AuthorizationHeader); auth != "" {
+		owner = "auth:" + auth
+	}
+	sum := sha256.Sum256([]byte(owner))
+	return hex.EncodeToString(sum[:])
+}
+
+// newRequestHasher starts the hash identifying a request with its method and
+// URL; the body is written to it as it is read
+func newRequestHasher(r *http.Request) hash.Hash {
+	h := sha256.New()
+	io.WriteString(h, r.Method+" "+r.URL.RequestURI()+"\n")
+	return h
+}
+
+// recordingWriter keeps a copy of the response it writes, up to
+// maxIdempotentResponse, so it can be stored for replay
+type recordingWriter struct {
+	gin.ResponseWriter
+	body     bytes.Buffer
+	overflow bool
+}
+
+func (w *recordingWriter) Write(data []byte) (int, error) {
+	w.record(data)
+	return w.ResponseWriter.Write(data)
+}
+
+func (w *recordingWriter) WriteString(s string) (int, error) {
+	w.record([]byte(s))
+	return w.ResponseWriter.WriteString(s)
+}
+
+func (w *recordingWriter) record(data []byte) {
+	if w.overflow {
+		return
+	}
+	if w.body.Len()+len(data) > maxIdempotentResponse {
+		w.overflow = true
+		w.body.Reset()
+		return
+	}
+	w.body.Write(data)
+}
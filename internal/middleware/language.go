@@ -0,0 +1,21 @@
+package middleware
+
+import (
+	"github.com/gin-gonic/gin"
+	"github.com/yourusername/go-enterprise-api/pkg/i18n"
+)
+
+// Language creates a middleware that negotiates the language of error and
+// validation messages from the Accept-Language header. Clients may override
+// it with the lang query parameter; unsupported languages fall back to
+// negotiation.
+func Language() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		lang := c.Query("lang")
+		if !i18n.IsSupported(lang) {
+			lang = i18n.Negotiate(c.GetHeader("Accept-Language"))
+		}
+		c.Request = c.Request.WithContext(i18n.WithLanguage(c.Request.Context(), lang))
+		c.Next()
+	}
+}
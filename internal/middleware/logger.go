@@ -1,12 +1,16 @@
 package middleware
 
 import (
+	"errors"
 	"time"
 
 	"github.com/gin-gonic/gin"
-	"github.com/google/uuid"
+	"github.com/yourusername/go-enterprise-api/internal/config"
+	apperrors "github.com/yourusername/go-enterprise-api/pkg/errors"
 	"github.com/yourusername/go-enterprise-api/pkg/logger"
+	"github.com/yourusername/go-enterprise-api/pkg/requestid"
 	"go.uber.org/zap"
+	"go.uber.org/zap/zapcore"
 )
 
 const (
@@ -14,13 +18,23 @@ const (
 	RequestIDKey = "request_id"
 )
 
-// RequestLogger creates a request logging middleware
-func RequestLogger() gin.HandlerFunc {
+// RequestLogger creates a request logging middleware. Requests keep the ID
+// sent by the client in X-Request-ID, or the trace ID of their traceparent,
+// when it is valid, and get a new one otherwise; the ID is returned in
+// X-Request-ID and carried by the request context, so it reaches outbound
+// calls and error responses. Sensitive fields of
+// the query string are redacted. With cfg.Bodies, JSON, form and text request
+// and response bodies are logged too, up to cfg.BodyMaxBytes each and with
+// sensitive fields redacted, to debug API integrations.
+func RequestLogger(cfg *config.LogConfig) gin.HandlerFunc {
+	redactor := newBodyRedactor(cfg.RedactFields)
+
 	return func(c *gin.Context) {
-		// Generate request ID
-		requestID := uuid.New().String()
+		// Take the request ID from the client or generate one
+		requestID, traceparent := requestid.FromRequest(c.Request)
 		c.Set(RequestIDKey, requestID)
-		c.Header("X-Request-ID", requestID)
+		c.Request = c.Request.WithContext(requestid.WithContext(c.Request.Context(), requestID, traceparent))
+		c.Header(requestid.Header, requestID)
 
 		// Start timer
 		start := time.Now()
@@ -30,9 +44,25 @@ func RequestLogger() gin.HandlerFunc {
 		raw := c.Request.URL.RawQuery
 		method := c.Request.Method
 
+		// Capture bodies
+		var requestBody []byte
+		var requestTruncated bool
+		var responseWriter *bodyLogWriter
+		if cfg.Bodies {
+			if bodyKind(c.ContentType()) != "" {
+				requestBody, requestTruncated = captureRequestBody(c.Request, cfg.BodyMaxBytes)
+			}
+			responseWriter = &bodyLogWriter{ResponseWriter: c.Writer, max: cfg.BodyMaxBytes}
+			c.Writer = responseWriter
+		}
+
 		// Process request
 		c.Next()
 
+		if responseWriter != nil {
+			c.Writer = responseWriter.ResponseWriter
+		}
+
 		// Calculate latency
 		latency := time.Since(start)
 
@@ -58,7 +88,23 @@ func RequestLogger() gin.HandlerFunc {
 
 		// Add query string if exists
 		if raw != "" {
-			fields = append(fields, zap.String("query", raw))
+			fields = append(fields, zap.String("query", redactor.redactForm(raw)))
+		}
+
+		// Add bodies if captured
+		if len(requestBody) > 0 {
+			fields = append(fields,
+				zap.String("request_body", redactor.redact(c.ContentType(), string(requestBody))),
+				zap.Bool("request_body_truncated", requestTruncated),
+			)
+		}
+		if responseWriter != nil && responseWriter.body.Len() > 0 {
+			if contentType := c.Writer.Header().Get("Content-Type"); bodyKind(contentType) != "" {
+				fields = append(fields,
+					zap.String("response_body", redactor.redact(contentType, responseWriter.body.String())),
+					zap.Bool("response_body_truncated", responseWriter.truncated),
+				)
+			}
 		}
 
 		// Add user ID if authenticated
@@ -66,23 +112,47 @@ func RequestLogger() gin.HandlerFunc {
 			fields = append(fields, zap.String("user_id", user.ID.String()))
 		}
 
-		// Add error if exists
+		// Add country if resolved
+		if country := GetCountry(c); country != "" {
+			fields = append(fields, zap.String("country", country))
+		}
+
+		// Add error if exists, with the stack of the first server error that
+		// captured one
 		if len(c.Errors) > 0 {
 			fields = append(fields, zap.String("errors", c.Errors.String()))
+			if statusCode >= 500 {
+				if stack := errorStack(c.Errors); stack != "" {
+					fields = append(fields, zap.String("stack", stack))
+				}
+			}
 		}
 
 		// Log based on status code
 		switch {
 		case statusCode >= 500:
-			logger.Error("Server error", fields...)
+			logger.Access(zapcore.ErrorLevel, "Server error", fields...)
 		case statusCode >= 400:
-			logger.Warn("Client error", fields...)
+			logger.Access(zapcore.WarnLevel, "Client error", fields...)
 		default:
-			logger.Info("Request completed", fields...)
+			logger.Access(zapcore.InfoLevel, "Request completed", fields...)
 		}
 	}
 }
 
+// errorStack returns the stack captured by the first of errs that has one
+func errorStack(errs []*gin.Error) string {
+	for _, e := range errs {
+		var appErr *apperrors.AppError
+		if errors.As(e.Err, &appErr) {
+			if stack := appErr.Stack(); stack != "" {
+				return stack
+			}
+		}
+	}
+	return ""
+}
+
 // GetRequestID retrieves the request ID from context
 func GetRequestID(c *gin.Context) string {
 	if requestID, exists := c.Get(RequestIDKey); exists {
@@ -0,0 +1,63 @@
+package middleware
+
+import (
+	"crypto/subtle"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/yourusername/go-enterprise-api/pkg/metrics"
+	"github.com/yourusername/go-enterprise-api/pkg/response"
+)
+
+// unmatchedRoute labels requests that matched no route, so unknown paths
+// cannot create unbounded series
+const unmatchedRoute = "unmatched"
+
+var (
+	httpRequests = metrics.NewCounter("http_requests_total",
+		"HTTP requests by method, route and status code.", "method", "route", "status")
+	httpRequestDuration = metrics.NewHistogram("http_request_duration_seconds",
+		"HTTP request duration in seconds by method and route.", nil, "method", "route")
+)
+
+// Metrics creates a middleware recording the count and duration of HTTP
+// requests. Requests are labelled by route pattern (e.g. /api/v1/posts/:id)
+// rather than path.
+func Metrics() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		start := time.Now()
+
+		c.Next()
+
+		route := c.FullPath()
+		if route == "" {
+			route = unmatchedRoute
+		}
+		method := c.Request.Method
+
+		httpRequests.Inc(method, route, strconv.Itoa(c.Writer.Status()))
+		httpRequestDuration.Observe(time.Since(start).Seconds(), method, route)
+	}
+}
+
+// MetricsToken creates a middleware requiring the given bearer token, e.g.
+// for the metrics endpoint. An empty token lets every request through.
+func MetricsToken(token string) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		if token == "" {
+			c.Next()
+			return
+		}
+
+		given := strings.TrimPrefix(c.GetHeader(AuthorizationHeader), BearerPrefix)
+		if subtle.ConstantTimeCompare([]byte(given), []byte(token)) != 1 {
+			response.Unauthorized(c, "Invalid metrics token")
+			c.Abort()
+			return
+		}
+
+		c.Next()
+	}
+}
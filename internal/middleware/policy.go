@@ -0,0 +1,43 @@
+package middleware
+
+import (
+	"github.com/gin-gonic/gin"
+	"github.com/yourusername/go-enterprise-api/internal/models"
+	"github.com/yourusername/go-enterprise-api/internal/services"
+	apperrors "github.com/yourusername/go-enterprise-api/pkg/errors"
+	"github.com/yourusername/go-enterprise-api/pkg/response"
+)
+
+// RequirePolicyAcceptance creates a middleware rejecting the mutating
+// requests of users who have not accepted the current terms of service and
+// privacy policy. The error lists the versions to accept. Reads are always
+// served, and service accounts are not asked to accept policies. Routes are
+// exempt when their path matches one of the exempt entries; an entry ending
+// in "/" exempts the whole group below it. It must run after AuthMiddleware.
+func RequirePolicyAcceptance(policyService services.PolicyService, exempt ...string) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		user, exists := GetUser(c)
+		if !exists || user.IsServiceAccount() || !isMutatingMethod(c.Request.Method) || isExemptRoute(c.FullPath(), exempt) {
+			c.Next()
+			return
+		}
+
+		pending, err := policyService.GetPending(c.Request.Context(), user.ID)
+		if err != nil {
+			response.Error(c, err)
+			c.Abort()
+			return
+		}
+		if len(pending) > 0 {
+			response.Error(c, apperrors.NewAppError(
+				apperrors.ErrPolicyAcceptanceRequired.StatusCode,
+				apperrors.ErrPolicyAcceptanceRequired.Code,
+				apperrors.ErrPolicyAcceptanceRequired.Message,
+			).WithData(gin.H{"policies": models.PolicyVersionResponses(pending)}))
+			c.Abort()
+			return
+		}
+
+		c.Next()
+	}
+}
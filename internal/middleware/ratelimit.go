@@ -1,21 +1,82 @@
 package middleware
 
 import (
+	"context"
+	"fmt"
 	"net/http"
+	"strconv"
 	"sync"
 	"time"
 
 	"github.com/gin-gonic/gin"
+	"github.com/yourusername/go-enterprise-api/internal/config"
 	apperrors "github.com/yourusername/go-enterprise-api/pkg/errors"
+	"github.com/yourusername/go-enterprise-api/pkg/logger"
 	"github.com/yourusername/go-enterprise-api/pkg/response"
 )
 
-// RateLimiter implements a simple in-memory rate limiter
-type RateLimiter struct {
-	requests map[string]*clientInfo
-	mu       sync.RWMutex
-	limit    int
-	window   time.Duration
+// Supported rate limit stores
+const (
+	RateLimitStoreMemory = "memory"
+	RateLimitStoreRedis  = "redis"
+)
+
+// RateLimitStore counts requests per key. Each rate limiting middleware
+// prefixes its keys, so a single store can be shared by all of them.
+type RateLimitStore interface {
+	// Allow records a request for key and reports whether it is within limit
+	// requests per window
+	Allow(ctx context.Context, key string, limit int, window time.Duration) (*RateLimitResult, error)
+	// Name returns the store name
+	Name() string
+	// Ping checks that the store can be reached
+	Ping(ctx context.Context) error
+	// Close stops the store's background work and releases its connections
+	Close() error
+}
+
+// RateLimitResult is the outcome of a rate limit check. ResetAt is when the
+// client may make a request again: the end of the window for the memory
+// store and the expiry of the oldest counted request for the redis store.
+type RateLimitResult struct {
+	Allowed   bool
+	Limit     int
+	Remaining int
+	ResetAt   time.Time
+}
+
+// Rate limit response headers
+const (
+	RateLimitLimitHeader     = "X-RateLimit-Limit"
+	RateLimitRemainingHeader = "X-RateLimit-Remaining"
+	RateLimitResetHeader     = "X-RateLimit-Reset"
+	RetryAfterHeader         = "Retry-After"
+)
+
+// rateLimitResultKey is the context key for the rate limit reported in the
+// response headers
+const rateLimitResultKey = "rate_limit_result"
+
+// NewRateLimitStore creates a RateLimitStore for the configured store. The
+// memory store only counts the requests of its own process, so replicas
+// behind a load balancer must share a redis store.
+func NewRateLimitStore(cfg *config.RateLimitConfig) (RateLimitStore, error) {
+	switch cfg.Store {
+	case "", RateLimitStoreMemory:
+		return NewMemoryRateLimitStore(), nil
+	case RateLimitStoreRedis:
+		return NewRedisRateLimitStore(cfg.RedisURL, cfg.RedisTimeout)
+	default:
+		return nil, fmt.Errorf("unsupported rate limit store: %s", cfg.Store)
+	}
+}
+
+// MemoryRateLimitStore implements a simple in-memory fixed window rate limiter
+type MemoryRateLimitStore struct {
+	requests  map[string]*clientInfo
+	mu        sync.Mutex
+	stop      chan struct{}
+	closeOnce sync.Once
 }
 
 type clientInfo struct {
@@ -23,79 +84,154 @@ type clientInfo struct {
 	resetTime time.Time
 }
 
-// NewRateLimiter creates a new rate limiter
-func NewRateLimiter(limit int, window time.Duration) *RateLimiter {
-	rl := &RateLimiter{
+// memoryCleanupInterval is how often expired windows are removed
+const memoryCleanupInterval = time.Minute
+
+// NewMemoryRateLimitStore creates a new in-memory rate limit store
+func NewMemoryRateLimitStore() *MemoryRateLimitStore {
+	s := &MemoryRateLimitStore{
 		requests: make(map[string]*clientInfo),
-		limit:    limit,
-		window:   window,
+		stop:     make(chan struct{}),
 	}
 
 	// Start cleanup goroutine
-	go rl.cleanup()
+	go s.cleanup()
 
-	return rl
+	return s
 }
 
-// cleanup removes expired entries
-func (rl *RateLimiter) cleanup() {
-	ticker := time.NewTicker(rl.window)
-	for range ticker.C {
-		rl.mu.Lock()
+// cleanup removes expired entries until the store is closed
+func (s *MemoryRateLimitStore) cleanup() {
+	ticker := time.NewTicker(memoryCleanupInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-s.stop:
+			return
+		case <-ticker.C:
+		}
+
+		s.mu.Lock()
 		now := time.Now()
-		for key, info := range rl.requests {
+		for key, info := range s.requests {
 			if now.After(info.resetTime) {
-				delete(rl.requests, key)
+				delete(s.requests, key)
 			}
 		}
-		rl.mu.Unlock()
+		s.mu.Unlock()
 	}
 }
 
 // Allow checks if a request is allowed
-func (rl *RateLimiter) Allow(key string) bool {
-	rl.mu.Lock()
-	defer rl.mu.Unlock()
+func (s *MemoryRateLimitStore) Allow(_ context.Context, key string, limit int, window time.Duration) (*RateLimitResult, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
 
 	now := time.Now()
 
-	info, exists := rl.requests[key]
+	info, exists := s.requests[key]
 	if !exists || now.After(info.resetTime) {
-		rl.requests[key] = &clientInfo{
+		info = &clientInfo{
 			count:     1,
-			resetTime: now.Add(rl.window),
+			resetTime: now.Add(window),
 		}
-		return true
+		s.requests[key] = info
+		return &RateLimitResult{Allowed: true, Limit: limit, Remaining: limit - 1, ResetAt: info.resetTime}, nil
 	}
 
-	if info.count >= rl.limit {
-		return false
+	if info.count >= limit {
+		return &RateLimitResult{Allowed: false, Limit: limit, Remaining: 0, ResetAt: info.resetTime}, nil
 	}
 
 	info.count++
-	return true
+	return &RateLimitResult{Allowed: true, Limit: limit, Remaining: limit - info.count, ResetAt: info.resetTime}, nil
 }
 
-// RateLimit creates a rate limiting middleware
-func RateLimit(limit int, window time.Duration) gin.HandlerFunc {
-	limiter := NewRateLimiter(limit, window)
+// Name returns the store name
+func (s *MemoryRateLimitStore) Name() string {
+	return RateLimitStoreMemory
+}
+
+// Ping always succeeds, as the counts are kept in memory
+func (s *MemoryRateLimitStore) Ping(_ context.Context) error {
+	return nil
+}
 
+// Close stops the cleanup of expired windows
+func (s *MemoryRateLimitStore) Close() error {
+	s.closeOnce.Do(func() {
+		close(s.stop)
+	})
+	return nil
+}
+
+// allow checks a request against the store and reports the limit in the
+// response headers. Requests are let through when the store fails, so an
+// unavailable store does not take the API down.
+func allow(c *gin.Context, store RateLimitStore, key string, limit int, window time.Duration) bool {
+	result, err := store.Allow(c.Request.Context(), key, limit, window)
+	if err != nil {
+		logger.Error("Rate limit check failed",
+			logger.String("store", store.Name()),
+			logger.Err(err),
+		)
+		return true
+	}
+
+	setRateLimitHeaders(c, result)
+	return result.Allowed
+}
+
+// setRateLimitHeaders reports a rate limit in the response headers. When
+// several limits apply to a request, the one with the fewest remaining
+// requests is reported, and a rejected request always reports the limit
+// that rejected it.
+func setRateLimitHeaders(c *gin.Context, result *RateLimitResult) {
+	if current, exists := c.Get(rateLimitResultKey); exists && result.Allowed {
+		if current := current.(*RateLimitResult); current.Remaining <= result.Remaining {
+			return
+		}
+	}
+	c.Set(rateLimitResultKey, result)
+
+	resetIn := time.Until(result.ResetAt)
+	if resetIn < 0 {
+		resetIn = 0
+	}
+
+	c.Header(RateLimitLimitHeader, strconv.Itoa(result.Limit))
+	c.Header(RateLimitRemainingHeader, strconv.Itoa(result.Remaining))
+	c.Header(RateLimitResetHeader, strconv.FormatInt(result.ResetAt.Unix(), 10))
+	if !result.Allowed {
+		// Round up so clients retrying after the given seconds are let through
+		c.Header(RetryAfterHeader, strconv.FormatInt(int64((resetIn+time.Second-1)/time.Second), 10))
+	}
+}
+
+// RateLimit creates a rate limiting middleware
+func RateLimit(store RateLimitStore, limit int, window time.Duration) gin.HandlerFunc {
 	return func(c *gin.Context) {
+		// Requests made with a read key are limited by the key's tier instead
+		if _, exists := GetReadKey(c); exists {
+			c.Next()
+			return
+		}
+
 		// Use client IP as key (can be customized to use user ID for authenticated users)
-		key := c.ClientIP()
+		key := "ip:" + c.ClientIP()
 
 		// Check if authenticated user
 		if user, exists := GetUser(c); exists {
-			key = user.ID.String()
+			key = "user:" + user.ID.String()
 		}
 
-		if !limiter.Allow(key) {
-			c.AbortWithStatusJSON(http.StatusTooManyRequests, response.Response{
+		if !allow(c, store, "global:"+key, limit, window) {
+			response.Abort(c, http.StatusTooManyRequests, response.Response{
 				Success: false,
-				Error: &response.ErrorInfo{
+				Error: response.Localize(c, &response.ErrorInfo{
 					Code:    apperrors.CodeTooManyRequests,
 					Message: "Rate limit exceeded. Please try again later.",
-				},
+				}),
 			})
 			return
 		}
@@ -105,20 +241,18 @@ func RateLimit(limit int, window time.Duration) gin.HandlerFunc {
 }
 
 // StrictRateLimit creates a stricter rate limiting middleware for sensitive endpoints
-func StrictRateLimit(limit int, window time.Duration) gin.HandlerFunc {
-	limiter := NewRateLimiter(limit, window)
-
+func StrictRateLimit(store RateLimitStore, limit int, window time.Duration) gin.HandlerFunc {
 	return func(c *gin.Context) {
 		// Use combination of IP and path for more granular limiting
-		key := c.ClientIP() + ":" + c.Request.URL.Path
+		key := "strict:" + c.ClientIP() + ":" + c.Request.URL.Path
 
-		if !limiter.Allow(key) {
-			c.AbortWithStatusJSON(http.StatusTooManyRequests, response.Response{
+		if !allow(c, store, key, limit, window) {
+			response.Abort(c, http.StatusTooManyRequests, response.Response{
 				Success: false,
-				Error: &response.ErrorInfo{
+				Error: response.Localize(c, &response.ErrorInfo{
 					Code:    apperrors.CodeTooManyRequests,
 					Message: "Too many requests to this endpoint. Please try again later.",
-				},
+				}),
 			})
 			return
 		}
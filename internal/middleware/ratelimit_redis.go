@@ -0,0 +1,123 @@
+package middleware
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"fmt"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+)
+
+// slidingWindowScript implements a sliding window log: each allowed request
+// is a member of a sorted set scored by its time in milliseconds, and members
+// older than the window are dropped before counting. The time is taken from
+// the Redis server so replicas with skewed clocks agree. It returns whether
+// the request is allowed, the number of requests in the window and when the
+// oldest of them leaves the window.
+const slidingWindowScript = `
+local t = redis.call('TIME')
+local now = tonumber(t[1]) * 1000 + math.floor(tonumber(t[2]) / 1000)
+local window = tonumber(ARGV[1])
+local limit = tonumber(ARGV[2])
+redis.call('ZREMRANGEBYSCORE', KEYS[1], '-inf', now - window)
+local allowed = 0
+if redis.call('ZCARD', KEYS[1]) < limit then
+	redis.call('ZADD', KEYS[1], now, ARGV[3])
+	redis.call('PEXPIRE', KEYS[1], window)
+	allowed = 1
+end
+local count = redis.call('ZCARD', KEYS[1])
+local oldest = redis.call('ZRANGE', KEYS[1], 0, 0, 'WITHSCORES')
+local reset = now + window
+if oldest[2] then
+	reset = tonumber(oldest[2]) + window
+end
+return {allowed, count, reset}
+`
+
+// redisKeyPrefix namespaces rate limit keys in a shared Redis database
+const redisKeyPrefix = "ratelimit:"
+
+// slidingWindow runs slidingWindowScript by its hash, loading it on first use
+var slidingWindow = redis.NewScript(slidingWindowScript)
+
+// RedisRateLimitStore implements a sliding window rate limiter in Redis, so
+// limits hold across every replica sharing the Redis database. It requires
+// Redis 5 or later.
+type RedisRateLimitStore struct {
+	client *redis.Client
+}
+
+// NewRedisRateLimitStore creates a Redis rate limit store from a
+// redis://[user:password@]host:port[/db] URL (rediss:// for TLS). Timeout
+// bounds connecting to Redis and each command.
+func NewRedisRateLimitStore(rawURL string, timeout time.Duration) (*RedisRateLimitStore, error) {
+	opts, err := redis.ParseURL(rawURL)
+	if err != nil {
+		return nil, fmt.Errorf("invalid redis URL %q: %w", rawURL, err)
+	}
+
+	if timeout <= 0 {
+		timeout = time.Second
+	}
+	opts.DialTimeout = timeout
+	opts.ReadTimeout = timeout
+	opts.WriteTimeout = timeout
+
+	return &RedisRateLimitStore{client: redis.NewClient(opts)}, nil
+}
+
+// Allow checks if a request is allowed
+func (s *RedisRateLimitStore) Allow(ctx context.Context, key string, limit int, window time.Duration) (*RateLimitResult, error) {
+	member, err := requestID()
+	if err != nil {
+		return nil, err
+	}
+
+	values, err := slidingWindow.Run(ctx, s.client, []string{redisKeyPrefix + key}, window.Milliseconds(), limit, member).Int64Slice()
+	if err != nil {
+		return nil, err
+	}
+	if len(values) != 3 {
+		return nil, fmt.Errorf("unexpected redis reply %v", values)
+	}
+	allowed, count, reset := values[0], values[1], values[2]
+
+	remaining := limit - int(count)
+	if remaining < 0 {
+		remaining = 0
+	}
+	return &RateLimitResult{
+		Allowed:   allowed == 1,
+		Limit:     limit,
+		Remaining: remaining,
+		ResetAt:   time.UnixMilli(reset),
+	}, nil
+}
+
+// Name returns the store name
+func (s *RedisRateLimitStore) Name() string {
+	return RateLimitStoreRedis
+}
+
+// Ping checks that the Redis server answers
+func (s *RedisRateLimitStore) Ping(ctx context.Context) error {
+	return s.client.Ping(ctx).Err()
+}
+
+// Close closes the connections to Redis
+func (s *RedisRateLimitStore) Close() error {
+	return s.client.Close()
+}
+
+// requestID returns a random member name, so concurrent requests within the
+// same millisecond are all counted
+func requestID() (string, error) {
+	b := make([]byte, 8)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(b), nil
+}
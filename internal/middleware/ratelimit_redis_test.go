@@ -0,0 +1,158 @@
+package middleware
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/alicebob/miniredis/v2"
+)
+
+// newRedisStore starts an in-memory Redis server at a fixed time and returns
+// a store connected to it
+func newRedisStore(t *testing.T) (*RedisRateLimitStore, *miniredis.Miniredis) {
+	t.Helper()
+
+	server := miniredis.RunT(t)
+	server.SetTime(time.UnixMilli(1_700_000_000_000))
+	store, err := NewRedisRateLimitStore("redis://"+server.Addr()+"/0", time.Second)
+	if err != nil {
+		t.Fatalf("failed to create the store: %v", err)
+	}
+	t.Cleanup(func() { store.Close() })
+	return store, server
+}
+
+func TestRedisRateLimitStoreSlidingWindow(t *testing.T) {
+	store, server := newRedisStore(t)
+	ctx := context.Background()
+	start := time.UnixMilli(1_700_000_000_000)
+	window := time.Minute
+
+	steps := []struct {
+		name      string
+		at        time.Duration
+		allowed   bool
+		remaining int
+		resetAt   time.Duration
+	}{
+		{"first request", 0, true, 2, window},
+		{"second request", 10 * time.Second, true, 1, window},
+		{"last request of the limit", 20 * time.Second, true, 0, window},
+		{"over the limit", 30 * time.Second, false, 0, window},
+		{"first request left the window", window + time.Second, true, 0, window + 10*time.Second},
+		{"still over the limit", window + 5*time.Second, false, 0, window + 10*time.Second},
+		{"every request left the window", 2*window + 30*time.Second, true, 2, 3*window + 30*time.Second},
+	}
+	for _, step := range steps {
+		server.SetTime(start.Add(step.at))
+		result, err := store.Allow(ctx, "client", 3, window)
+		if err != nil {
+			t.Fatalf("%s: %v", step.name, err)
+		}
+		if result.Allowed != step.allowed || result.Remaining != step.remaining || result.Limit != 3 {
+			t.Errorf("%s: got allowed %v with %d of %d remaining, want allowed %v with %d remaining",
+				step.name, result.Allowed, result.Remaining, result.Limit, step.allowed, step.remaining)
+		}
+		if want := start.Add(step.resetAt); !result.ResetAt.Equal(want) {
+			t.Errorf("%s: got reset at %v, want %v", step.name, result.ResetAt, want)
+		}
+	}
+}
+
+func TestRedisRateLimitStoreKeys(t *testing.T) {
+	store, server := newRedisStore(t)
+	ctx := context.Background()
+
+	for i := 0; i < 2; i++ {
+		if _, err := store.Allow(ctx, "a", 2, time.Minute); err != nil {
+			t.Fatal(err)
+		}
+	}
+	a, err := store.Allow(ctx, "a", 2, time.Minute)
+	if err != nil {
+		t.Fatal(err)
+	}
+	b, err := store.Allow(ctx, "b", 2, time.Minute)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if a.Allowed || !b.Allowed {
+		t.Errorf("got a allowed %v and b allowed %v, want keys counted separately", a.Allowed, b.Allowed)
+	}
+
+	if !server.Exists(redisKeyPrefix + "a") {
+		t.Errorf("got keys %v, want %q", server.Keys(), redisKeyPrefix+"a")
+	}
+	if ttl := server.TTL(redisKeyPrefix + "a"); ttl != time.Minute {
+		t.Errorf("got TTL %v, want the window", ttl)
+	}
+	server.FastForward(time.Minute)
+	if server.Exists(redisKeyPrefix + "a") {
+		t.Error("got the key kept after the window, want it expired")
+	}
+}
+
+func TestNewRedisRateLimitStore(t *testing.T) {
+	server := miniredis.RunT(t)
+	server.RequireUserAuth("limiter", "secret")
+	ctx := context.Background()
+
+	tests := []struct {
+		name    string
+		url     string
+		invalid bool
+		fails   bool
+	}{
+		{"not a redis URL", "http://" + server.Addr(), true, false},
+		{"invalid database", "redis://" + server.Addr() + "/first", true, false},
+		{"wrong password", "redis://limiter:wrong@" + server.Addr() + "/0", false, true},
+		{"credentials and database", "redis://limiter:secret@" + server.Addr() + "/2", false, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			store, err := NewRedisRateLimitStore(tt.url, time.Second)
+			if tt.invalid {
+				if err == nil {
+					store.Close()
+					t.Fatal("got a store, want an invalid URL error")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("failed to create the store: %v", err)
+			}
+			defer store.Close()
+
+			_, err = store.Allow(ctx, "client", 1, time.Minute)
+			if tt.fails {
+				if err == nil {
+					t.Error("got the request counted, want an authentication error")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("failed to count the request: %v", err)
+			}
+			if !server.DB(2).Exists(redisKeyPrefix + "client") {
+				t.Error("got the request counted elsewhere, want it in database 2")
+			}
+		})
+	}
+}
+
+func TestRedisRateLimitStoreUnavailable(t *testing.T) {
+	store, server := newRedisStore(t)
+	ctx := context.Background()
+
+	if err := store.Ping(ctx); err != nil {
+		t.Fatalf("got %v, want the server to answer", err)
+	}
+	server.Close()
+	if err := store.Ping(ctx); err == nil {
+		t.Error("got an answer, want an error once the server is gone")
+	}
+	if _, err := store.Allow(ctx, "client", 1, time.Minute); err == nil {
+		t.Error("got the request counted, want an error once the server is gone")
+	}
+}
@@ -0,0 +1,70 @@
+package middleware
+
+import (
+	"net/http"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/yourusername/go-enterprise-api/internal/models"
+	"github.com/yourusername/go-enterprise-api/internal/services"
+	apperrors "github.com/yourusername/go-enterprise-api/pkg/errors"
+	"github.com/yourusername/go-enterprise-api/pkg/response"
+)
+
+const (
+	// ReadKeyHeader is the header key for anonymous read keys
+	ReadKeyHeader = "X-Read-Key"
+	// ReadKeyKey is the context key for storing the read key
+	ReadKeyKey = "read_key"
+)
+
+// ReadKeyMiddleware creates a middleware identifying anonymous consumers by
+// their read key. Read keys are only honoured on GET and HEAD requests; the
+// requests they make are rate limited by the key's tier instead of the client
+// IP and counted in the key's usage.
+func ReadKeyMiddleware(readKeyService services.ReadKeyService, store RateLimitStore, limits map[models.ReadKeyTier]int, window time.Duration) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		rawKey := c.GetHeader(ReadKeyHeader)
+		if rawKey == "" || (c.Request.Method != http.MethodGet && c.Request.Method != http.MethodHead) {
+			c.Next()
+			return
+		}
+
+		key, err := readKeyService.Authenticate(c.Request.Context(), rawKey)
+		if err != nil {
+			response.Error(c, err)
+			c.Abort()
+			return
+		}
+
+		limit, ok := limits[key.Tier]
+		if !ok {
+			limit = limits[models.ReadKeyFree]
+		}
+		if !allow(c, store, "readkey:"+key.ID.String(), limit, window) {
+			response.Abort(c, http.StatusTooManyRequests, response.Response{
+				Success: false,
+				Error: response.Localize(c, &response.ErrorInfo{
+					Code:    apperrors.CodeTooManyRequests,
+					Message: "Rate limit exceeded for this read key. Please try again later.",
+				}),
+			})
+			return
+		}
+
+		c.Set(ReadKeyKey, key)
+		c.Request = c.Request.WithContext(services.WithReadKey(c.Request.Context(), key))
+		readKeyService.RecordRequest(c.Request.Context(), key)
+
+		c.Next()
+	}
+}
+
+// GetReadKey retrieves the read key a request was made with from context
+func GetReadKey(c *gin.Context) (*models.ReadKey, bool) {
+	key, exists := c.Get(ReadKeyKey)
+	if !exists {
+		return nil, false
+	}
+	return key.(*models.ReadKey), true
+}
@@ -0,0 +1,87 @@
+package middleware
+
+import (
+	"net/http"
+	"strings"
+	"sync/atomic"
+
+	"github.com/gin-gonic/gin"
+	apperrors "github.com/yourusername/go-enterprise-api/pkg/errors"
+	"github.com/yourusername/go-enterprise-api/pkg/response"
+)
+
+const (
+	// ReadOnlyKey is the context key set when the API is in read-only mode
+	ReadOnlyKey = "read_only"
+)
+
+// ReadOnlyMode is a runtime switch for read-only mode. The state is kept in
+// memory, so each instance has to be toggled separately.
+type ReadOnlyMode struct {
+	enabled atomic.Bool
+}
+
+// NewReadOnlyMode creates a read-only switch with the given initial state
+func NewReadOnlyMode(enabled bool) *ReadOnlyMode {
+	mode := &ReadOnlyMode{}
+	mode.enabled.Store(enabled)
+	return mode
+}
+
+// Enabled reports whether read-only mode is on
+func (m *ReadOnlyMode) Enabled() bool {
+	return m.enabled.Load()
+}
+
+// Set turns read-only mode on or off
+func (m *ReadOnlyMode) Set(enabled bool) {
+	m.enabled.Store(enabled)
+}
+
+// ReadOnly creates a middleware rejecting mutating requests with 503 while
+// read-only mode is enabled. Safe methods are always served. Routes are
+// exempt when their path matches one of the exempt entries; an entry ending
+// in "/" exempts the whole group below it.
+func ReadOnly(mode *ReadOnlyMode, exempt ...string) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		if !mode.Enabled() {
+			c.Next()
+			return
+		}
+		c.Set(ReadOnlyKey, true)
+
+		// Unmatched routes fall through so they still get a 404
+		path := c.FullPath()
+		if !isMutatingMethod(c.Request.Method) || path == "" || isExemptRoute(path, exempt) {
+			c.Next()
+			return
+		}
+
+		c.Header("Retry-After", "60")
+		response.Error(c, apperrors.ErrReadOnly)
+		c.Abort()
+	}
+}
+
+// IsReadOnly reports whether the request is served in read-only mode.
+// Handlers use it to skip best-effort writes such as view counting.
+func IsReadOnly(c *gin.Context) bool {
+	return c.GetBool(ReadOnlyKey)
+}
+
+func isMutatingMethod(method string) bool {
+	switch method {
+	case http.MethodGet, http.MethodHead, http.MethodOptions:
+		return false
+	}
+	return true
+}
+
+func isExemptRoute(path string, exempt []string) bool {
+	for _, route := range exempt {
+		if path == route || (strings.HasSuffix(route, "/") && strings.HasPrefix(path, route)) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,26 @@
+package middleware
+
+import (
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+	"github.com/yourusername/go-enterprise-api/internal/database"
+)
+
+// ReadPrimaryHeader asks for the reads of a request to use the primary
+// database, for clients that must see a write they just made
+const ReadPrimaryHeader = "X-Read-Primary"
+
+// ReadYourWrites creates a middleware that keeps reads on the primary
+// database where the replicas may not have caught up: for the rest of a
+// request once it has written, and for requests sent with X-Read-Primary
+func ReadYourWrites() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		ctx := database.TrackWrites(c.Request.Context())
+		if primary, _ := strconv.ParseBool(c.GetHeader(ReadPrimaryHeader)); primary {
+			ctx = database.ReadFromPrimary(ctx)
+		}
+		c.Request = c.Request.WithContext(ctx)
+		c.Next()
+	}
+}
@@ -29,12 +29,12 @@ func Recovery() gin.HandlerFunc {
 				)
 
 				// Respond with error
-				c.AbortWithStatusJSON(http.StatusInternalServerError, response.Response{
+				response.Abort(c, http.StatusInternalServerError, response.Response{
 					Success: false,
-					Error: &response.ErrorInfo{
+					Error: response.Localize(c, &response.ErrorInfo{
 						Code:    apperrors.CodeInternalError,
 						Message: "Internal server error",
-					},
+					}),
 				})
 			}
 		}()
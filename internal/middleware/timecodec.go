@@ -0,0 +1,59 @@
+package middleware
+
+import (
+	"github.com/gin-gonic/gin"
+	"github.com/yourusername/go-enterprise-api/internal/config"
+	"github.com/yourusername/go-enterprise-api/pkg/logger"
+	"github.com/yourusername/go-enterprise-api/pkg/response"
+)
+
+const (
+	// TimezoneHeader is the header used to request a response timezone
+	TimezoneHeader = "X-Timezone"
+	// TimeFormatHeader is the header used to request a response time format
+	TimeFormatHeader = "X-Time-Format"
+)
+
+// TimeCodec creates a middleware that negotiates how timestamps are rendered.
+// Clients may override the configured defaults with the tz and time_format
+// query parameters or the X-Timezone and X-Time-Format headers.
+func TimeCodec(cfg *config.AppConfig) gin.HandlerFunc {
+	defaultCodec, err := response.NewTimeCodec(cfg.Timezone, cfg.TimeFormat)
+	if err != nil {
+		logger.Fatal("Invalid time codec configuration", logger.Err(err))
+	}
+
+	return func(c *gin.Context) {
+		timezone := c.Query("tz")
+		if timezone == "" {
+			timezone = c.GetHeader(TimezoneHeader)
+		}
+		format := c.Query("time_format")
+		if format == "" {
+			format = c.GetHeader(TimeFormatHeader)
+		}
+
+		if timezone == "" && format == "" {
+			response.SetTimeCodec(c, defaultCodec)
+			c.Next()
+			return
+		}
+
+		if timezone == "" {
+			timezone = defaultCodec.Location.String()
+		}
+		if format == "" {
+			format = string(defaultCodec.Format)
+		}
+
+		codec, err := response.NewTimeCodec(timezone, format)
+		if err != nil {
+			response.BadRequest(c, "Invalid timezone or time format")
+			c.Abort()
+			return
+		}
+
+		response.SetTimeCodec(c, codec)
+		c.Next()
+	}
+}
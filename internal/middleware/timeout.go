@@ -0,0 +1,88 @@
+package middleware
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	apperrors "github.com/yourusername/go-enterprise-api/pkg/errors"
+	"github.com/yourusername/go-enterprise-api/pkg/response"
+)
+
+// Timeout creates a middleware giving each request a deadline on its
+// context, so the queries and calls made with it are cancelled once it
+// passes: read for GET and HEAD requests, write for other methods, or the
+// deadline routes sets for the request's route, such as
+// "/api/v1/posts/export". A deadline of 0 means none.
+//
+// A handler failing with a server error after the deadline passed is
+// answered with 504 and ErrTimeout instead. Responses that had started by
+// then, such as streamed exports, are cut short.
+func Timeout(read, write time.Duration, routes map[string]time.Duration) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		timeout, ok := routes[c.FullPath()]
+		if !ok {
+			timeout = write
+			if c.Request.Method == http.MethodGet || c.Request.Method == http.MethodHead {
+				timeout = read
+			}
+		}
+		if timeout <= 0 {
+			c.Next()
+			return
+		}
+
+		ctx, cancel := context.WithTimeout(c.Request.Context(), timeout)
+		defer cancel()
+		c.Request = c.Request.WithContext(ctx)
+
+		w := &timeoutWriter{ResponseWriter: c.Writer, ctx: ctx}
+		c.Writer = w
+		c.Next()
+		c.Writer = w.ResponseWriter
+
+		if w.timedOut {
+			response.Error(c, apperrors.ErrTimeout)
+		}
+	}
+}
+
+// timeoutWriter drops a server error response written after the deadline of
+// its request passed, so it can be replaced with 504
+type timeoutWriter struct {
+	gin.ResponseWriter
+	ctx      context.Context
+	timedOut bool
+}
+
+func (w *timeoutWriter) WriteHeader(code int) {
+	if code >= http.StatusInternalServerError && !w.ResponseWriter.Written() &&
+		errors.Is(w.ctx.Err(), context.DeadlineExceeded) {
+		w.timedOut = true
+		return
+	}
+	w.ResponseWriter.WriteHeader(code)
+}
+
+// WriteHeaderNow is skipped for a dropped response
+func (w *timeoutWriter) WriteHeaderNow() {
+	if !w.timedOut {
+		w.ResponseWriter.WriteHeaderNow()
+	}
+}
+
+func (w *timeoutWriter) Write(data []byte) (int, error) {
+	if w.timedOut {
+		return len(data), nil
+	}
+	return w.ResponseWriter.Write(data)
+}
+
+func (w *timeoutWriter) WriteString(s string) (int, error) {
+	if w.timedOut {
+		return len(s), nil
+	}
+	return w.ResponseWriter.WriteString(s)
+}
@@ -0,0 +1,93 @@
+package middleware
+
+import (
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/yourusername/go-enterprise-api/pkg/metrics"
+	"github.com/yourusername/go-enterprise-api/pkg/version"
+)
+
+const (
+	// APIVersionKey is the context key for the API version a request was
+	// made to
+	APIVersionKey = "api_version"
+
+	// APIVersionHeader names the API version that answered a request
+	APIVersionHeader = "API-Version"
+	// DeprecationHeader carries the date a route was deprecated (RFC 9745)
+	DeprecationHeader = "Deprecation"
+	// SunsetHeader carries the date a route is expected to be removed
+	// (RFC 8594)
+	SunsetHeader = "Sunset"
+)
+
+var deprecatedRequests = metrics.NewCounter("http_deprecated_requests_total",
+	"Requests to deprecated routes by method and route.", "method", "route")
+
+// APIVersion creates a middleware recording the API version of a route
+// group, e.g. "v2", in the context and the API-Version response header
+func APIVersion(v string) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		c.Set(APIVersionKey, v)
+		c.Header(APIVersionHeader, v)
+		c.Next()
+	}
+}
+
+// GetAPIVersion returns the API version a request was made to, or the
+// default version for routes outside the versioned groups
+func GetAPIVersion(c *gin.Context) string {
+	if v := c.GetString(APIVersionKey); v != "" {
+		return v
+	}
+	return version.APIVersion
+}
+
+// Deprecation describes the deprecation of a route or of a whole API version
+type Deprecation struct {
+	// At is when the route was deprecated
+	At time.Time
+	// Sunset is when the route is expected to stop answering; zero if not
+	// planned yet
+	Sunset time.Time
+	// Link is the URL of the migration guide; empty if there is none
+	Link string
+}
+
+// Deprecated creates a middleware flagging the routes it is used on as
+// deprecated: responses carry the Deprecation header, and the Sunset header
+// and a Link to the migration guide when they are set. Requests are still
+// served, and counted in http_deprecated_requests_total so the routes can be
+// removed once clients have moved on.
+//
+// Usage:
+//
+//	api.GET("/posts/:id/stats", middleware.Deprecated(middleware.Deprecation{
+//		At:     time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC),
+//		Sunset: time.Date(2025, 7, 1, 0, 0, 0, 0, time.UTC),
+//		Link:   "https://example.com/docs/migrate-to-v2",
+//	}), postHandler.Stats)
+func Deprecated(d Deprecation) gin.HandlerFunc {
+	deprecation := "@" + strconv.FormatInt(d.At.Unix(), 10)
+	sunset := ""
+	if !d.Sunset.IsZero() {
+		sunset = d.Sunset.UTC().Format(http.TimeFormat)
+	}
+
+	return func(c *gin.Context) {
+		header := c.Writer.Header()
+		header.Set(DeprecationHeader, deprecation)
+		if sunset != "" {
+			header.Set(SunsetHeader, sunset)
+		}
+		if d.Link != "" {
+			header.Add("Link", "<"+d.Link+`>; rel="deprecation"; type="text/html"`)
+		}
+
+		deprecatedRequests.Inc(c.Request.Method, c.FullPath())
+		c.Next()
+	}
+}
@@ -0,0 +1,36 @@
+package middleware
+
+import (
+	"strings"
+
+	"github.com/gin-gonic/gin"
+	"github.com/yourusername/go-enterprise-api/internal/realtime"
+)
+
+// WebSocketToken lets browsers authenticate WebSocket requests, which
+// cannot carry headers, by offering the subprotocols "access_token" and
+// their token. The token is moved to the Authorization header for the auth
+// middleware that follows. Tokens are not accepted in the query string, as
+// query strings are logged.
+func WebSocketToken() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		if c.GetHeader(AuthorizationHeader) == "" {
+			protocols := websocketProtocols(c.GetHeader("Sec-WebSocket-Protocol"))
+			if len(protocols) == 2 && protocols[0] == realtime.TokenProtocol && protocols[1] != "" {
+				c.Request.Header.Set(AuthorizationHeader, BearerPrefix+protocols[1])
+			}
+		}
+		c.Next()
+	}
+}
+
+// websocketProtocols splits a Sec-WebSocket-Protocol header
+func websocketProtocols(header string) []string {
+	var protocols []string
+	for _, p := range strings.Split(header, ",") {
+		if p = strings.TrimSpace(p); p != "" {
+			protocols = append(protocols, p)
+		}
+	}
+	return protocols
+}
@@ -0,0 +1,7 @@
+// Package mocks holds GoMock implementations of the service and repository
+// interfaces, for unit tests of the code using them. The mocks are
+// generated; run "make mocks" after changing an interface.
+package mocks
+
+//go:generate go run go.uber.org/mock/mockgen@v0.4.0 -destination=services.go -package=mocks github.com/yourusername/go-enterprise-api/internal/services AuthService,UserService,PostService,PolicyService
+//go:generate go run go.uber.org/mock/mockgen@v0.4.0 -destination=repositories.go -package=mocks github.com/yourusername/go-enterprise-api/internal/repository AdminSearchRepository,AnalyticsRepository,APIKeyRepository,AppealRepository,BackfillRepository,BrandingRepository,ChangeRepository,CommentRepository,FollowRepository,IdempotencyRepository,JobRepository,MediaRepository,MediaUploadRepository,NotificationRepository,OperationRepository,OrganizationRepository,PolicyRepository,PostRepository,ReadKeyRepository,SiteRepository,SlugRedirectRepository,TagRepository,TrashRepository,UserRepository,WebhookRepository
@@ -0,0 +1,5257 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: github.com/yourusername/go-enterprise-api/internal/repository (interfaces: AdminSearchRepository,AnalyticsRepository,APIKeyRepository,AppealRepository,BackfillRepository,BrandingRepository,ChangeRepository,CommentRepository,FollowRepository,IdempotencyRepository,JobRepository,MediaRepository,MediaUploadRepository,NotificationRepository,OperationRepository,OrganizationRepository,PolicyRepository,PostRepository,ReadKeyRepository,SiteRepository,SlugRedirectRepository,TagRepository,TrashRepository,UserRepository,WebhookRepository)
+//
+// Generated by this command:
+//
+//	mockgen -destination=repositories.go -package=mocks github.com/yourusername/go-enterprise-api/internal/repository AdminSearchRepository,AnalyticsRepository,APIKeyRepository,AppealRepository,BackfillRepository,BrandingRepository,ChangeRepository,CommentRepository,FollowRepository,IdempotencyRepository,JobRepository,MediaRepository,MediaUploadRepository,NotificationRepository,OperationRepository,OrganizationRepository,PolicyRepository,PostRepository,ReadKeyRepository,SiteRepository,SlugRedirectRepository,TagRepository,TrashRepository,UserRepository,WebhookRepository
+//
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	reflect "reflect"
+	time "time"
+
+	uuid "github.com/google/uuid"
+	models "github.com/yourusername/go-enterprise-api/internal/models"
+	repository "github.com/yourusername/go-enterprise-api/internal/repository"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockAdminSearchRepository is a mock of AdminSearchRepository interface.
+type MockAdminSearchRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockAdminSearchRepositoryMockRecorder
+}
+
+// MockAdminSearchRepositoryMockRecorder is the mock recorder for MockAdminSearchRepository.
+type MockAdminSearchRepositoryMockRecorder struct {
+	mock *MockAdminSearchRepository
+}
+
+// NewMockAdminSearchRepository creates a new mock instance.
+func NewMockAdminSearchRepository(ctrl *gomock.Controller) *MockAdminSearchRepository {
+	mock := &MockAdminSearchRepository{ctrl: ctrl}
+	mock.recorder = &MockAdminSearchRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockAdminSearchRepository) EXPECT() *MockAdminSearchRepositoryMockRecorder {
+	return m.recorder
+}
+
+// FindCommentsByID mocks base method.
+func (m *MockAdminSearchRepository) FindCommentsByID(arg0 context.Context, arg1 uuid.UUID) ([]models.Comment, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindCommentsByID", arg0, arg1)
+	ret0, _ := ret[0].([]models.Comment)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindCommentsByID indicates an expected call of FindCommentsByID.
+func (mr *MockAdminSearchRepositoryMockRecorder) FindCommentsByID(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindCommentsByID", reflect.TypeOf((*MockAdminSearchRepository)(nil).FindCommentsByID), arg0, arg1)
+}
+
+// FindPostsByID mocks base method.
+func (m *MockAdminSearchRepository) FindPostsByID(arg0 context.Context, arg1 uuid.UUID) ([]models.Post, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindPostsByID", arg0, arg1)
+	ret0, _ := ret[0].([]models.Post)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindPostsByID indicates an expected call of FindPostsByID.
+func (mr *MockAdminSearchRepositoryMockRecorder) FindPostsByID(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindPostsByID", reflect.TypeOf((*MockAdminSearchRepository)(nil).FindPostsByID), arg0, arg1)
+}
+
+// FindPostsBySlug mocks base method.
+func (m *MockAdminSearchRepository) FindPostsBySlug(arg0 context.Context, arg1 string, arg2 int) ([]models.Post, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindPostsBySlug", arg0, arg1, arg2)
+	ret0, _ := ret[0].([]models.Post)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindPostsBySlug indicates an expected call of FindPostsBySlug.
+func (mr *MockAdminSearchRepositoryMockRecorder) FindPostsBySlug(arg0, arg1, arg2 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindPostsBySlug", reflect.TypeOf((*MockAdminSearchRepository)(nil).FindPostsBySlug), arg0, arg1, arg2)
+}
+
+// FindUsersByEmail mocks base method.
+func (m *MockAdminSearchRepository) FindUsersByEmail(arg0 context.Context, arg1 string, arg2 int) ([]models.User, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindUsersByEmail", arg0, arg1, arg2)
+	ret0, _ := ret[0].([]models.User)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindUsersByEmail indicates an expected call of FindUsersByEmail.
+func (mr *MockAdminSearchRepositoryMockRecorder) FindUsersByEmail(arg0, arg1, arg2 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindUsersByEmail", reflect.TypeOf((*MockAdminSearchRepository)(nil).FindUsersByEmail), arg0, arg1, arg2)
+}
+
+// FindUsersByID mocks base method.
+func (m *MockAdminSearchRepository) FindUsersByID(arg0 context.Context, arg1 uuid.UUID) ([]models.User, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindUsersByID", arg0, arg1)
+	ret0, _ := ret[0].([]models.User)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindUsersByID indicates an expected call of FindUsersByID.
+func (mr *MockAdminSearchRepositoryMockRecorder) FindUsersByID(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindUsersByID", reflect.TypeOf((*MockAdminSearchRepository)(nil).FindUsersByID), arg0, arg1)
+}
+
+// MockAnalyticsRepository is a mock of AnalyticsRepository interface.
+type MockAnalyticsRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockAnalyticsRepositoryMockRecorder
+}
+
+// MockAnalyticsRepositoryMockRecorder is the mock recorder for MockAnalyticsRepository.
+type MockAnalyticsRepositoryMockRecorder struct {
+	mock *MockAnalyticsRepository
+}
+
+// NewMockAnalyticsRepository creates a new mock instance.
+func NewMockAnalyticsRepository(ctrl *gomock.Controller) *MockAnalyticsRepository {
+	mock := &MockAnalyticsRepository{ctrl: ctrl}
+	mock.recorder = &MockAnalyticsRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockAnalyticsRepository) EXPECT() *MockAnalyticsRepositoryMockRecorder {
+	return m.recorder
+}
+
+// FindAuthorDailyStats mocks base method.
+func (m *MockAnalyticsRepository) FindAuthorDailyStats(arg0 context.Context, arg1 uuid.UUID, arg2, arg3 string) ([]models.AuthorAnalyticsRow, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindAuthorDailyStats", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].([]models.AuthorAnalyticsRow)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindAuthorDailyStats indicates an expected call of FindAuthorDailyStats.
+func (mr *MockAnalyticsRepositoryMockRecorder) FindAuthorDailyStats(arg0, arg1, arg2, arg3 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindAuthorDailyStats", reflect.TypeOf((*MockAnalyticsRepository)(nil).FindAuthorDailyStats), arg0, arg1, arg2, arg3)
+}
+
+// FindDailyStats mocks base method.
+func (m *MockAnalyticsRepository) FindDailyStats(arg0 context.Context, arg1 uuid.UUID, arg2, arg3 string) ([]models.PostDailyStat, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindDailyStats", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].([]models.PostDailyStat)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindDailyStats indicates an expected call of FindDailyStats.
+func (mr *MockAnalyticsRepositoryMockRecorder) FindDailyStats(arg0, arg1, arg2, arg3 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindDailyStats", reflect.TypeOf((*MockAnalyticsRepository)(nil).FindDailyStats), arg0, arg1, arg2, arg3)
+}
+
+// FindReferrerTotals mocks base method.
+func (m *MockAnalyticsRepository) FindReferrerTotals(arg0 context.Context, arg1 uuid.UUID, arg2, arg3 string) ([]models.ReferrerViews, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindReferrerTotals", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].([]models.ReferrerViews)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindReferrerTotals indicates an expected call of FindReferrerTotals.
+func (mr *MockAnalyticsRepositoryMockRecorder) FindReferrerTotals(arg0, arg1, arg2, arg3 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindReferrerTotals", reflect.TypeOf((*MockAnalyticsRepository)(nil).FindReferrerTotals), arg0, arg1, arg2, arg3)
+}
+
+// RecordView mocks base method.
+func (m *MockAnalyticsRepository) RecordView(arg0 context.Context, arg1 uuid.UUID, arg2, arg3, arg4 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RecordView", arg0, arg1, arg2, arg3, arg4)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RecordView indicates an expected call of RecordView.
+func (mr *MockAnalyticsRepositoryMockRecorder) RecordView(arg0, arg1, arg2, arg3, arg4 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RecordView", reflect.TypeOf((*MockAnalyticsRepository)(nil).RecordView), arg0, arg1, arg2, arg3, arg4)
+}
+
+// RollUpViews mocks base method.
+func (m *MockAnalyticsRepository) RollUpViews(arg0 context.Context, arg1 int) (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RollUpViews", arg0, arg1)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RollUpViews indicates an expected call of RollUpViews.
+func (mr *MockAnalyticsRepositoryMockRecorder) RollUpViews(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RollUpViews", reflect.TypeOf((*MockAnalyticsRepository)(nil).RollUpViews), arg0, arg1)
+}
+
+// MockAPIKeyRepository is a mock of APIKeyRepository interface.
+type MockAPIKeyRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockAPIKeyRepositoryMockRecorder
+}
+
+// MockAPIKeyRepositoryMockRecorder is the mock recorder for MockAPIKeyRepository.
+type MockAPIKeyRepositoryMockRecorder struct {
+	mock *MockAPIKeyRepository
+}
+
+// NewMockAPIKeyRepository creates a new mock instance.
+func NewMockAPIKeyRepository(ctrl *gomock.Controller) *MockAPIKeyRepository {
+	mock := &MockAPIKeyRepository{ctrl: ctrl}
+	mock.recorder = &MockAPIKeyRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockAPIKeyRepository) EXPECT() *MockAPIKeyRepositoryMockRecorder {
+	return m.recorder
+}
+
+// Count mocks base method.
+func (m *MockAPIKeyRepository) Count(arg0 context.Context) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Count", arg0)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Count indicates an expected call of Count.
+func (mr *MockAPIKeyRepositoryMockRecorder) Count(arg0 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Count", reflect.TypeOf((*MockAPIKeyRepository)(nil).Count), arg0)
+}
+
+// Create mocks base method.
+func (m *MockAPIKeyRepository) Create(arg0 context.Context, arg1 *models.APIKey) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockAPIKeyRepositoryMockRecorder) Create(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockAPIKeyRepository)(nil).Create), arg0, arg1)
+}
+
+// CreateInBatches mocks base method.
+func (m *MockAPIKeyRepository) CreateInBatches(arg0 context.Context, arg1 []models.APIKey, arg2 int) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateInBatches", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreateInBatches indicates an expected call of CreateInBatches.
+func (mr *MockAPIKeyRepositoryMockRecorder) CreateInBatches(arg0, arg1, arg2 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateInBatches", reflect.TypeOf((*MockAPIKeyRepository)(nil).CreateInBatches), arg0, arg1, arg2)
+}
+
+// Delete mocks base method.
+func (m *MockAPIKeyRepository) Delete(arg0 context.Context, arg1 uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Delete", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Delete indicates an expected call of Delete.
+func (mr *MockAPIKeyRepositoryMockRecorder) Delete(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockAPIKeyRepository)(nil).Delete), arg0, arg1)
+}
+
+// FindAll mocks base method.
+func (m *MockAPIKeyRepository) FindAll(arg0 context.Context, arg1, arg2 int) ([]models.APIKey, int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindAll", arg0, arg1, arg2)
+	ret0, _ := ret[0].([]models.APIKey)
+	ret1, _ := ret[1].(int64)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// FindAll indicates an expected call of FindAll.
+func (mr *MockAPIKeyRepositoryMockRecorder) FindAll(arg0, arg1, arg2 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindAll", reflect.TypeOf((*MockAPIKeyRepository)(nil).FindAll), arg0, arg1, arg2)
+}
+
+// FindByHash mocks base method.
+func (m *MockAPIKeyRepository) FindByHash(arg0 context.Context, arg1 string) (*models.APIKey, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindByHash", arg0, arg1)
+	ret0, _ := ret[0].(*models.APIKey)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindByHash indicates an expected call of FindByHash.
+func (mr *MockAPIKeyRepositoryMockRecorder) FindByHash(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByHash", reflect.TypeOf((*MockAPIKeyRepository)(nil).FindByHash), arg0, arg1)
+}
+
+// FindByID mocks base method.
+func (m *MockAPIKeyRepository) FindByID(arg0 context.Context, arg1 uuid.UUID) (*models.APIKey, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindByID", arg0, arg1)
+	ret0, _ := ret[0].(*models.APIKey)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindByID indicates an expected call of FindByID.
+func (mr *MockAPIKeyRepositoryMockRecorder) FindByID(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByID", reflect.TypeOf((*MockAPIKeyRepository)(nil).FindByID), arg0, arg1)
+}
+
+// FindByIDs mocks base method.
+func (m *MockAPIKeyRepository) FindByIDs(arg0 context.Context, arg1 []uuid.UUID) ([]models.APIKey, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindByIDs", arg0, arg1)
+	ret0, _ := ret[0].([]models.APIKey)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindByIDs indicates an expected call of FindByIDs.
+func (mr *MockAPIKeyRepositoryMockRecorder) FindByIDs(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByIDs", reflect.TypeOf((*MockAPIKeyRepository)(nil).FindByIDs), arg0, arg1)
+}
+
+// FindByUserID mocks base method.
+func (m *MockAPIKeyRepository) FindByUserID(arg0 context.Context, arg1 uuid.UUID) ([]models.APIKey, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindByUserID", arg0, arg1)
+	ret0, _ := ret[0].([]models.APIKey)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindByUserID indicates an expected call of FindByUserID.
+func (mr *MockAPIKeyRepositoryMockRecorder) FindByUserID(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByUserID", reflect.TypeOf((*MockAPIKeyRepository)(nil).FindByUserID), arg0, arg1)
+}
+
+// Revoke mocks base method.
+func (m *MockAPIKeyRepository) Revoke(arg0 context.Context, arg1 uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Revoke", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Revoke indicates an expected call of Revoke.
+func (mr *MockAPIKeyRepositoryMockRecorder) Revoke(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Revoke", reflect.TypeOf((*MockAPIKeyRepository)(nil).Revoke), arg0, arg1)
+}
+
+// Update mocks base method.
+func (m *MockAPIKeyRepository) Update(arg0 context.Context, arg1 *models.APIKey) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Update", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Update indicates an expected call of Update.
+func (mr *MockAPIKeyRepositoryMockRecorder) Update(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockAPIKeyRepository)(nil).Update), arg0, arg1)
+}
+
+// UpdateLastUsed mocks base method.
+func (m *MockAPIKeyRepository) UpdateLastUsed(arg0 context.Context, arg1 uuid.UUID, arg2 time.Time) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateLastUsed", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateLastUsed indicates an expected call of UpdateLastUsed.
+func (mr *MockAPIKeyRepositoryMockRecorder) UpdateLastUsed(arg0, arg1, arg2 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateLastUsed", reflect.TypeOf((*MockAPIKeyRepository)(nil).UpdateLastUsed), arg0, arg1, arg2)
+}
+
+// Upsert mocks base method.
+func (m *MockAPIKeyRepository) Upsert(arg0 context.Context, arg1 *models.APIKey) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Upsert", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Upsert indicates an expected call of Upsert.
+func (mr *MockAPIKeyRepositoryMockRecorder) Upsert(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Upsert", reflect.TypeOf((*MockAPIKeyRepository)(nil).Upsert), arg0, arg1)
+}
+
+// MockAppealRepository is a mock of AppealRepository interface.
+type MockAppealRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockAppealRepositoryMockRecorder
+}
+
+// MockAppealRepositoryMockRecorder is the mock recorder for MockAppealRepository.
+type MockAppealRepositoryMockRecorder struct {
+	mock *MockAppealRepository
+}
+
+// NewMockAppealRepository creates a new mock instance.
+func NewMockAppealRepository(ctrl *gomock.Controller) *MockAppealRepository {
+	mock := &MockAppealRepository{ctrl: ctrl}
+	mock.recorder = &MockAppealRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockAppealRepository) EXPECT() *MockAppealRepositoryMockRecorder {
+	return m.recorder
+}
+
+// Count mocks base method.
+func (m *MockAppealRepository) Count(arg0 context.Context) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Count", arg0)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Count indicates an expected call of Count.
+func (mr *MockAppealRepositoryMockRecorder) Count(arg0 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Count", reflect.TypeOf((*MockAppealRepository)(nil).Count), arg0)
+}
+
+// Create mocks base method.
+func (m *MockAppealRepository) Create(arg0 context.Context, arg1 *models.Appeal) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockAppealRepositoryMockRecorder) Create(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockAppealRepository)(nil).Create), arg0, arg1)
+}
+
+// CreateInBatches mocks base method.
+func (m *MockAppealRepository) CreateInBatches(arg0 context.Context, arg1 []models.Appeal, arg2 int) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateInBatches", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreateInBatches indicates an expected call of CreateInBatches.
+func (mr *MockAppealRepositoryMockRecorder) CreateInBatches(arg0, arg1, arg2 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateInBatches", reflect.TypeOf((*MockAppealRepository)(nil).CreateInBatches), arg0, arg1, arg2)
+}
+
+// Delete mocks base method.
+func (m *MockAppealRepository) Delete(arg0 context.Context, arg1 uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Delete", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Delete indicates an expected call of Delete.
+func (mr *MockAppealRepositoryMockRecorder) Delete(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockAppealRepository)(nil).Delete), arg0, arg1)
+}
+
+// ExistsBanAppeal mocks base method.
+func (m *MockAppealRepository) ExistsBanAppeal(arg0 context.Context, arg1 uuid.UUID) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ExistsBanAppeal", arg0, arg1)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ExistsBanAppeal indicates an expected call of ExistsBanAppeal.
+func (mr *MockAppealRepositoryMockRecorder) ExistsBanAppeal(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ExistsBanAppeal", reflect.TypeOf((*MockAppealRepository)(nil).ExistsBanAppeal), arg0, arg1)
+}
+
+// FindAll mocks base method.
+func (m *MockAppealRepository) FindAll(arg0 context.Context, arg1, arg2 int) ([]models.Appeal, int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindAll", arg0, arg1, arg2)
+	ret0, _ := ret[0].([]models.Appeal)
+	ret1, _ := ret[1].(int64)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// FindAll indicates an expected call of FindAll.
+func (mr *MockAppealRepositoryMockRecorder) FindAll(arg0, arg1, arg2 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindAll", reflect.TypeOf((*MockAppealRepository)(nil).FindAll), arg0, arg1, arg2)
+}
+
+// FindByID mocks base method.
+func (m *MockAppealRepository) FindByID(arg0 context.Context, arg1 uuid.UUID) (*models.Appeal, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindByID", arg0, arg1)
+	ret0, _ := ret[0].(*models.Appeal)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindByID indicates an expected call of FindByID.
+func (mr *MockAppealRepositoryMockRecorder) FindByID(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByID", reflect.TypeOf((*MockAppealRepository)(nil).FindByID), arg0, arg1)
+}
+
+// FindByIDs mocks base method.
+func (m *MockAppealRepository) FindByIDs(arg0 context.Context, arg1 []uuid.UUID) ([]models.Appeal, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindByIDs", arg0, arg1)
+	ret0, _ := ret[0].([]models.Appeal)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindByIDs indicates an expected call of FindByIDs.
+func (mr *MockAppealRepositoryMockRecorder) FindByIDs(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByIDs", reflect.TypeOf((*MockAppealRepository)(nil).FindByIDs), arg0, arg1)
+}
+
+// FindQueue mocks base method.
+func (m *MockAppealRepository) FindQueue(arg0 context.Context, arg1 models.AppealStatus, arg2 models.AppealType, arg3, arg4 int) ([]models.Appeal, int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindQueue", arg0, arg1, arg2, arg3, arg4)
+	ret0, _ := ret[0].([]models.Appeal)
+	ret1, _ := ret[1].(int64)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// FindQueue indicates an expected call of FindQueue.
+func (mr *MockAppealRepositoryMockRecorder) FindQueue(arg0, arg1, arg2, arg3, arg4 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindQueue", reflect.TypeOf((*MockAppealRepository)(nil).FindQueue), arg0, arg1, arg2, arg3, arg4)
+}
+
+// FindWithRelations mocks base method.
+func (m *MockAppealRepository) FindWithRelations(arg0 context.Context, arg1 uuid.UUID) (*models.Appeal, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindWithRelations", arg0, arg1)
+	ret0, _ := ret[0].(*models.Appeal)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindWithRelations indicates an expected call of FindWithRelations.
+func (mr *MockAppealRepositoryMockRecorder) FindWithRelations(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindWithRelations", reflect.TypeOf((*MockAppealRepository)(nil).FindWithRelations), arg0, arg1)
+}
+
+// Update mocks base method.
+func (m *MockAppealRepository) Update(arg0 context.Context, arg1 *models.Appeal) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Update", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Update indicates an expected call of Update.
+func (mr *MockAppealRepositoryMockRecorder) Update(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockAppealRepository)(nil).Update), arg0, arg1)
+}
+
+// Upsert mocks base method.
+func (m *MockAppealRepository) Upsert(arg0 context.Context, arg1 *models.Appeal) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Upsert", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Upsert indicates an expected call of Upsert.
+func (mr *MockAppealRepositoryMockRecorder) Upsert(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Upsert", reflect.TypeOf((*MockAppealRepository)(nil).Upsert), arg0, arg1)
+}
+
+// MockBackfillRepository is a mock of BackfillRepository interface.
+type MockBackfillRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockBackfillRepositoryMockRecorder
+}
+
+// MockBackfillRepositoryMockRecorder is the mock recorder for MockBackfillRepository.
+type MockBackfillRepositoryMockRecorder struct {
+	mock *MockBackfillRepository
+}
+
+// NewMockBackfillRepository creates a new mock instance.
+func NewMockBackfillRepository(ctrl *gomock.Controller) *MockBackfillRepository {
+	mock := &MockBackfillRepository{ctrl: ctrl}
+	mock.recorder = &MockBackfillRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockBackfillRepository) EXPECT() *MockBackfillRepositoryMockRecorder {
+	return m.recorder
+}
+
+// Count mocks base method.
+func (m *MockBackfillRepository) Count(arg0 context.Context) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Count", arg0)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Count indicates an expected call of Count.
+func (mr *MockBackfillRepositoryMockRecorder) Count(arg0 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Count", reflect.TypeOf((*MockBackfillRepository)(nil).Count), arg0)
+}
+
+// Create mocks base method.
+func (m *MockBackfillRepository) Create(arg0 context.Context, arg1 *models.BackfillCheckpoint) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockBackfillRepositoryMockRecorder) Create(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockBackfillRepository)(nil).Create), arg0, arg1)
+}
+
+// CreateInBatches mocks base method.
+func (m *MockBackfillRepository) CreateInBatches(arg0 context.Context, arg1 []models.BackfillCheckpoint, arg2 int) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateInBatches", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreateInBatches indicates an expected call of CreateInBatches.
+func (mr *MockBackfillRepositoryMockRecorder) CreateInBatches(arg0, arg1, arg2 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateInBatches", reflect.TypeOf((*MockBackfillRepository)(nil).CreateInBatches), arg0, arg1, arg2)
+}
+
+// Delete mocks base method.
+func (m *MockBackfillRepository) Delete(arg0 context.Context, arg1 uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Delete", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Delete indicates an expected call of Delete.
+func (mr *MockBackfillRepositoryMockRecorder) Delete(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockBackfillRepository)(nil).Delete), arg0, arg1)
+}
+
+// FindAll mocks base method.
+func (m *MockBackfillRepository) FindAll(arg0 context.Context, arg1, arg2 int) ([]models.BackfillCheckpoint, int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindAll", arg0, arg1, arg2)
+	ret0, _ := ret[0].([]models.BackfillCheckpoint)
+	ret1, _ := ret[1].(int64)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// FindAll indicates an expected call of FindAll.
+func (mr *MockBackfillRepositoryMockRecorder) FindAll(arg0, arg1, arg2 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindAll", reflect.TypeOf((*MockBackfillRepository)(nil).FindAll), arg0, arg1, arg2)
+}
+
+// FindAllCheckpoints mocks base method.
+func (m *MockBackfillRepository) FindAllCheckpoints(arg0 context.Context) ([]models.BackfillCheckpoint, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindAllCheckpoints", arg0)
+	ret0, _ := ret[0].([]models.BackfillCheckpoint)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindAllCheckpoints indicates an expected call of FindAllCheckpoints.
+func (mr *MockBackfillRepositoryMockRecorder) FindAllCheckpoints(arg0 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindAllCheckpoints", reflect.TypeOf((*MockBackfillRepository)(nil).FindAllCheckpoints), arg0)
+}
+
+// FindByID mocks base method.
+func (m *MockBackfillRepository) FindByID(arg0 context.Context, arg1 uuid.UUID) (*models.BackfillCheckpoint, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindByID", arg0, arg1)
+	ret0, _ := ret[0].(*models.BackfillCheckpoint)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindByID indicates an expected call of FindByID.
+func (mr *MockBackfillRepositoryMockRecorder) FindByID(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByID", reflect.TypeOf((*MockBackfillRepository)(nil).FindByID), arg0, arg1)
+}
+
+// FindByIDs mocks base method.
+func (m *MockBackfillRepository) FindByIDs(arg0 context.Context, arg1 []uuid.UUID) ([]models.BackfillCheckpoint, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindByIDs", arg0, arg1)
+	ret0, _ := ret[0].([]models.BackfillCheckpoint)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindByIDs indicates an expected call of FindByIDs.
+func (mr *MockBackfillRepositoryMockRecorder) FindByIDs(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByIDs", reflect.TypeOf((*MockBackfillRepository)(nil).FindByIDs), arg0, arg1)
+}
+
+// FindByJob mocks base method.
+func (m *MockBackfillRepository) FindByJob(arg0 context.Context, arg1 string) (*models.BackfillCheckpoint, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindByJob", arg0, arg1)
+	ret0, _ := ret[0].(*models.BackfillCheckpoint)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindByJob indicates an expected call of FindByJob.
+func (mr *MockBackfillRepositoryMockRecorder) FindByJob(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByJob", reflect.TypeOf((*MockBackfillRepository)(nil).FindByJob), arg0, arg1)
+}
+
+// Update mocks base method.
+func (m *MockBackfillRepository) Update(arg0 context.Context, arg1 *models.BackfillCheckpoint) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Update", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Update indicates an expected call of Update.
+func (mr *MockBackfillRepositoryMockRecorder) Update(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockBackfillRepository)(nil).Update), arg0, arg1)
+}
+
+// Upsert mocks base method.
+func (m *MockBackfillRepository) Upsert(arg0 context.Context, arg1 *models.BackfillCheckpoint) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Upsert", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Upsert indicates an expected call of Upsert.
+func (mr *MockBackfillRepositoryMockRecorder) Upsert(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Upsert", reflect.TypeOf((*MockBackfillRepository)(nil).Upsert), arg0, arg1)
+}
+
+// MockBrandingRepository is a mock of BrandingRepository interface.
+type MockBrandingRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockBrandingRepositoryMockRecorder
+}
+
+// MockBrandingRepositoryMockRecorder is the mock recorder for MockBrandingRepository.
+type MockBrandingRepositoryMockRecorder struct {
+	mock *MockBrandingRepository
+}
+
+// NewMockBrandingRepository creates a new mock instance.
+func NewMockBrandingRepository(ctrl *gomock.Controller) *MockBrandingRepository {
+	mock := &MockBrandingRepository{ctrl: ctrl}
+	mock.recorder = &MockBrandingRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockBrandingRepository) EXPECT() *MockBrandingRepositoryMockRecorder {
+	return m.recorder
+}
+
+// Get mocks base method.
+func (m *MockBrandingRepository) Get(arg0 context.Context) (*models.Branding, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Get", arg0)
+	ret0, _ := ret[0].(*models.Branding)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Get indicates an expected call of Get.
+func (mr *MockBrandingRepositoryMockRecorder) Get(arg0 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Get", reflect.TypeOf((*MockBrandingRepository)(nil).Get), arg0)
+}
+
+// Save mocks base method.
+func (m *MockBrandingRepository) Save(arg0 context.Context, arg1 *models.Branding) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Save", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Save indicates an expected call of Save.
+func (mr *MockBrandingRepositoryMockRecorder) Save(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Save", reflect.TypeOf((*MockBrandingRepository)(nil).Save), arg0, arg1)
+}
+
+// MockChangeRepository is a mock of ChangeRepository interface.
+type MockChangeRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockChangeRepositoryMockRecorder
+}
+
+// MockChangeRepositoryMockRecorder is the mock recorder for MockChangeRepository.
+type MockChangeRepositoryMockRecorder struct {
+	mock *MockChangeRepository
+}
+
+// NewMockChangeRepository creates a new mock instance.
+func NewMockChangeRepository(ctrl *gomock.Controller) *MockChangeRepository {
+	mock := &MockChangeRepository{ctrl: ctrl}
+	mock.recorder = &MockChangeRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockChangeRepository) EXPECT() *MockChangeRepositoryMockRecorder {
+	return m.recorder
+}
+
+// Count mocks base method.
+func (m *MockChangeRepository) Count(arg0 context.Context) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Count", arg0)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Count indicates an expected call of Count.
+func (mr *MockChangeRepositoryMockRecorder) Count(arg0 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Count", reflect.TypeOf((*MockChangeRepository)(nil).Count), arg0)
+}
+
+// Create mocks base method.
+func (m *MockChangeRepository) Create(arg0 context.Context, arg1 *models.Change) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockChangeRepositoryMockRecorder) Create(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockChangeRepository)(nil).Create), arg0, arg1)
+}
+
+// CreateInBatches mocks base method.
+func (m *MockChangeRepository) CreateInBatches(arg0 context.Context, arg1 []models.Change, arg2 int) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateInBatches", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreateInBatches indicates an expected call of CreateInBatches.
+func (mr *MockChangeRepositoryMockRecorder) CreateInBatches(arg0, arg1, arg2 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateInBatches", reflect.TypeOf((*MockChangeRepository)(nil).CreateInBatches), arg0, arg1, arg2)
+}
+
+// CreateMany mocks base method.
+func (m *MockChangeRepository) CreateMany(arg0 context.Context, arg1 []models.Change) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateMany", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreateMany indicates an expected call of CreateMany.
+func (mr *MockChangeRepositoryMockRecorder) CreateMany(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateMany", reflect.TypeOf((*MockChangeRepository)(nil).CreateMany), arg0, arg1)
+}
+
+// Delete mocks base method.
+func (m *MockChangeRepository) Delete(arg0 context.Context, arg1 uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Delete", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Delete indicates an expected call of Delete.
+func (mr *MockChangeRepositoryMockRecorder) Delete(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockChangeRepository)(nil).Delete), arg0, arg1)
+}
+
+// FindAll mocks base method.
+func (m *MockChangeRepository) FindAll(arg0 context.Context, arg1, arg2 int) ([]models.Change, int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindAll", arg0, arg1, arg2)
+	ret0, _ := ret[0].([]models.Change)
+	ret1, _ := ret[1].(int64)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// FindAll indicates an expected call of FindAll.
+func (mr *MockChangeRepositoryMockRecorder) FindAll(arg0, arg1, arg2 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindAll", reflect.TypeOf((*MockChangeRepository)(nil).FindAll), arg0, arg1, arg2)
+}
+
+// FindByEntity mocks base method.
+func (m *MockChangeRepository) FindByEntity(arg0 context.Context, arg1 string, arg2 uuid.UUID, arg3, arg4 int) ([]models.Change, int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindByEntity", arg0, arg1, arg2, arg3, arg4)
+	ret0, _ := ret[0].([]models.Change)
+	ret1, _ := ret[1].(int64)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// FindByEntity indicates an expected call of FindByEntity.
+func (mr *MockChangeRepositoryMockRecorder) FindByEntity(arg0, arg1, arg2, arg3, arg4 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByEntity", reflect.TypeOf((*MockChangeRepository)(nil).FindByEntity), arg0, arg1, arg2, arg3, arg4)
+}
+
+// FindByID mocks base method.
+func (m *MockChangeRepository) FindByID(arg0 context.Context, arg1 uuid.UUID) (*models.Change, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindByID", arg0, arg1)
+	ret0, _ := ret[0].(*models.Change)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindByID indicates an expected call of FindByID.
+func (mr *MockChangeRepositoryMockRecorder) FindByID(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByID", reflect.TypeOf((*MockChangeRepository)(nil).FindByID), arg0, arg1)
+}
+
+// FindByIDs mocks base method.
+func (m *MockChangeRepository) FindByIDs(arg0 context.Context, arg1 []uuid.UUID) ([]models.Change, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindByIDs", arg0, arg1)
+	ret0, _ := ret[0].([]models.Change)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindByIDs indicates an expected call of FindByIDs.
+func (mr *MockChangeRepositoryMockRecorder) FindByIDs(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByIDs", reflect.TypeOf((*MockChangeRepository)(nil).FindByIDs), arg0, arg1)
+}
+
+// Update mocks base method.
+func (m *MockChangeRepository) Update(arg0 context.Context, arg1 *models.Change) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Update", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Update indicates an expected call of Update.
+func (mr *MockChangeRepositoryMockRecorder) Update(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockChangeRepository)(nil).Update), arg0, arg1)
+}
+
+// Upsert mocks base method.
+func (m *MockChangeRepository) Upsert(arg0 context.Context, arg1 *models.Change) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Upsert", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Upsert indicates an expected call of Upsert.
+func (mr *MockChangeRepositoryMockRecorder) Upsert(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Upsert", reflect.TypeOf((*MockChangeRepository)(nil).Upsert), arg0, arg1)
+}
+
+// MockCommentRepository is a mock of CommentRepository interface.
+type MockCommentRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockCommentRepositoryMockRecorder
+}
+
+// MockCommentRepositoryMockRecorder is the mock recorder for MockCommentRepository.
+type MockCommentRepositoryMockRecorder struct {
+	mock *MockCommentRepository
+}
+
+// NewMockCommentRepository creates a new mock instance.
+func NewMockCommentRepository(ctrl *gomock.Controller) *MockCommentRepository {
+	mock := &MockCommentRepository{ctrl: ctrl}
+	mock.recorder = &MockCommentRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockCommentRepository) EXPECT() *MockCommentRepositoryMockRecorder {
+	return m.recorder
+}
+
+// Count mocks base method.
+func (m *MockCommentRepository) Count(arg0 context.Context) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Count", arg0)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Count indicates an expected call of Count.
+func (mr *MockCommentRepositoryMockRecorder) Count(arg0 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Count", reflect.TypeOf((*MockCommentRepository)(nil).Count), arg0)
+}
+
+// CountByUserAndStatus mocks base method.
+func (m *MockCommentRepository) CountByUserAndStatus(arg0 context.Context, arg1 uuid.UUID, arg2 models.CommentStatus) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CountByUserAndStatus", arg0, arg1, arg2)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CountByUserAndStatus indicates an expected call of CountByUserAndStatus.
+func (mr *MockCommentRepositoryMockRecorder) CountByUserAndStatus(arg0, arg1, arg2 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CountByUserAndStatus", reflect.TypeOf((*MockCommentRepository)(nil).CountByUserAndStatus), arg0, arg1, arg2)
+}
+
+// Create mocks base method.
+func (m *MockCommentRepository) Create(arg0 context.Context, arg1 *models.Comment) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockCommentRepositoryMockRecorder) Create(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockCommentRepository)(nil).Create), arg0, arg1)
+}
+
+// CreateInBatches mocks base method.
+func (m *MockCommentRepository) CreateInBatches(arg0 context.Context, arg1 []models.Comment, arg2 int) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateInBatches", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreateInBatches indicates an expected call of CreateInBatches.
+func (mr *MockCommentRepositoryMockRecorder) CreateInBatches(arg0, arg1, arg2 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateInBatches", reflect.TypeOf((*MockCommentRepository)(nil).CreateInBatches), arg0, arg1, arg2)
+}
+
+// Delete mocks base method.
+func (m *MockCommentRepository) Delete(arg0 context.Context, arg1 uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Delete", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Delete indicates an expected call of Delete.
+func (mr *MockCommentRepositoryMockRecorder) Delete(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockCommentRepository)(nil).Delete), arg0, arg1)
+}
+
+// FindAll mocks base method.
+func (m *MockCommentRepository) FindAll(arg0 context.Context, arg1, arg2 int) ([]models.Comment, int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindAll", arg0, arg1, arg2)
+	ret0, _ := ret[0].([]models.Comment)
+	ret1, _ := ret[1].(int64)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// FindAll indicates an expected call of FindAll.
+func (mr *MockCommentRepositoryMockRecorder) FindAll(arg0, arg1, arg2 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindAll", reflect.TypeOf((*MockCommentRepository)(nil).FindAll), arg0, arg1, arg2)
+}
+
+// FindByID mocks base method.
+func (m *MockCommentRepository) FindByID(arg0 context.Context, arg1 uuid.UUID) (*models.Comment, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindByID", arg0, arg1)
+	ret0, _ := ret[0].(*models.Comment)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindByID indicates an expected call of FindByID.
+func (mr *MockCommentRepositoryMockRecorder) FindByID(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByID", reflect.TypeOf((*MockCommentRepository)(nil).FindByID), arg0, arg1)
+}
+
+// FindByIDs mocks base method.
+func (m *MockCommentRepository) FindByIDs(arg0 context.Context, arg1 []uuid.UUID) ([]models.Comment, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindByIDs", arg0, arg1)
+	ret0, _ := ret[0].([]models.Comment)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindByIDs indicates an expected call of FindByIDs.
+func (mr *MockCommentRepositoryMockRecorder) FindByIDs(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByIDs", reflect.TypeOf((*MockCommentRepository)(nil).FindByIDs), arg0, arg1)
+}
+
+// FindByPost mocks base method.
+func (m *MockCommentRepository) FindByPost(arg0 context.Context, arg1 uuid.UUID, arg2 models.CommentStatus, arg3, arg4 int) ([]models.Comment, int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindByPost", arg0, arg1, arg2, arg3, arg4)
+	ret0, _ := ret[0].([]models.Comment)
+	ret1, _ := ret[1].(int64)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// FindByPost indicates an expected call of FindByPost.
+func (mr *MockCommentRepositoryMockRecorder) FindByPost(arg0, arg1, arg2, arg3, arg4 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByPost", reflect.TypeOf((*MockCommentRepository)(nil).FindByPost), arg0, arg1, arg2, arg3, arg4)
+}
+
+// FindByStatus mocks base method.
+func (m *MockCommentRepository) FindByStatus(arg0 context.Context, arg1 models.CommentStatus, arg2, arg3 int) ([]models.Comment, int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindByStatus", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].([]models.Comment)
+	ret1, _ := ret[1].(int64)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// FindByStatus indicates an expected call of FindByStatus.
+func (mr *MockCommentRepositoryMockRecorder) FindByStatus(arg0, arg1, arg2, arg3 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByStatus", reflect.TypeOf((*MockCommentRepository)(nil).FindByStatus), arg0, arg1, arg2, arg3)
+}
+
+// Update mocks base method.
+func (m *MockCommentRepository) Update(arg0 context.Context, arg1 *models.Comment) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Update", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Update indicates an expected call of Update.
+func (mr *MockCommentRepositoryMockRecorder) Update(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockCommentRepository)(nil).Update), arg0, arg1)
+}
+
+// UpdateStatus mocks base method.
+func (m *MockCommentRepository) UpdateStatus(arg0 context.Context, arg1 []uuid.UUID, arg2 models.CommentStatus, arg3 uuid.UUID) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateStatus", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateStatus indicates an expected call of UpdateStatus.
+func (mr *MockCommentRepositoryMockRecorder) UpdateStatus(arg0, arg1, arg2, arg3 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateStatus", reflect.TypeOf((*MockCommentRepository)(nil).UpdateStatus), arg0, arg1, arg2, arg3)
+}
+
+// Upsert mocks base method.
+func (m *MockCommentRepository) Upsert(arg0 context.Context, arg1 *models.Comment) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Upsert", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Upsert indicates an expected call of Upsert.
+func (mr *MockCommentRepositoryMockRecorder) Upsert(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Upsert", reflect.TypeOf((*MockCommentRepository)(nil).Upsert), arg0, arg1)
+}
+
+// MockFollowRepository is a mock of FollowRepository interface.
+type MockFollowRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockFollowRepositoryMockRecorder
+}
+
+// MockFollowRepositoryMockRecorder is the mock recorder for MockFollowRepository.
+type MockFollowRepositoryMockRecorder struct {
+	mock *MockFollowRepository
+}
+
+// NewMockFollowRepository creates a new mock instance.
+func NewMockFollowRepository(ctrl *gomock.Controller) *MockFollowRepository {
+	mock := &MockFollowRepository{ctrl: ctrl}
+	mock.recorder = &MockFollowRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockFollowRepository) EXPECT() *MockFollowRepositoryMockRecorder {
+	return m.recorder
+}
+
+// FindFeed mocks base method.
+func (m *MockFollowRepository) FindFeed(arg0 context.Context, arg1 uuid.UUID, arg2 bool, arg3 *repository.FeedCursor, arg4 int) ([]models.Post, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindFeed", arg0, arg1, arg2, arg3, arg4)
+	ret0, _ := ret[0].([]models.Post)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindFeed indicates an expected call of FindFeed.
+func (mr *MockFollowRepositoryMockRecorder) FindFeed(arg0, arg1, arg2, arg3, arg4 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindFeed", reflect.TypeOf((*MockFollowRepository)(nil).FindFeed), arg0, arg1, arg2, arg3, arg4)
+}
+
+// FindFollowers mocks base method.
+func (m *MockFollowRepository) FindFollowers(arg0 context.Context, arg1 uuid.UUID, arg2, arg3 int) ([]models.UserFollow, int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindFollowers", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].([]models.UserFollow)
+	ret1, _ := ret[1].(int64)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// FindFollowers indicates an expected call of FindFollowers.
+func (mr *MockFollowRepositoryMockRecorder) FindFollowers(arg0, arg1, arg2, arg3 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindFollowers", reflect.TypeOf((*MockFollowRepository)(nil).FindFollowers), arg0, arg1, arg2, arg3)
+}
+
+// FindFollowing mocks base method.
+func (m *MockFollowRepository) FindFollowing(arg0 context.Context, arg1 uuid.UUID, arg2, arg3 int) ([]models.UserFollow, int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindFollowing", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].([]models.UserFollow)
+	ret1, _ := ret[1].(int64)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// FindFollowing indicates an expected call of FindFollowing.
+func (mr *MockFollowRepositoryMockRecorder) FindFollowing(arg0, arg1, arg2, arg3 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindFollowing", reflect.TypeOf((*MockFollowRepository)(nil).FindFollowing), arg0, arg1, arg2, arg3)
+}
+
+// Follow mocks base method.
+func (m *MockFollowRepository) Follow(arg0 context.Context, arg1, arg2 uuid.UUID) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Follow", arg0, arg1, arg2)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Follow indicates an expected call of Follow.
+func (mr *MockFollowRepositoryMockRecorder) Follow(arg0, arg1, arg2 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Follow", reflect.TypeOf((*MockFollowRepository)(nil).Follow), arg0, arg1, arg2)
+}
+
+// Unfollow mocks base method.
+func (m *MockFollowRepository) Unfollow(arg0 context.Context, arg1, arg2 uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Unfollow", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Unfollow indicates an expected call of Unfollow.
+func (mr *MockFollowRepositoryMockRecorder) Unfollow(arg0, arg1, arg2 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Unfollow", reflect.TypeOf((*MockFollowRepository)(nil).Unfollow), arg0, arg1, arg2)
+}
+
+// MockIdempotencyRepository is a mock of IdempotencyRepository interface.
+type MockIdempotencyRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockIdempotencyRepositoryMockRecorder
+}
+
+// MockIdempotencyRepositoryMockRecorder is the mock recorder for MockIdempotencyRepository.
+type MockIdempotencyRepositoryMockRecorder struct {
+	mock *MockIdempotencyRepository
+}
+
+// NewMockIdempotencyRepository creates a new mock instance.
+func NewMockIdempotencyRepository(ctrl *gomock.Controller) *MockIdempotencyRepository {
+	mock := &MockIdempotencyRepository{ctrl: ctrl}
+	mock.recorder = &MockIdempotencyRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockIdempotencyRepository) EXPECT() *MockIdempotencyRepositoryMockRecorder {
+	return m.recorder
+}
+
+// Acquire mocks base method.
+func (m *MockIdempotencyRepository) Acquire(arg0 context.Context, arg1 *models.IdempotencyKey, arg2 time.Time) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Acquire", arg0, arg1, arg2)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Acquire indicates an expected call of Acquire.
+func (mr *MockIdempotencyRepositoryMockRecorder) Acquire(arg0, arg1, arg2 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Acquire", reflect.TypeOf((*MockIdempotencyRepository)(nil).Acquire), arg0, arg1, arg2)
+}
+
+// Complete mocks base method.
+func (m *MockIdempotencyRepository) Complete(arg0 context.Context, arg1 *models.IdempotencyKey) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Complete", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Complete indicates an expected call of Complete.
+func (mr *MockIdempotencyRepositoryMockRecorder) Complete(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Complete", reflect.TypeOf((*MockIdempotencyRepository)(nil).Complete), arg0, arg1)
+}
+
+// Delete mocks base method.
+func (m *MockIdempotencyRepository) Delete(arg0 context.Context, arg1, arg2 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Delete", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Delete indicates an expected call of Delete.
+func (mr *MockIdempotencyRepositoryMockRecorder) Delete(arg0, arg1, arg2 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockIdempotencyRepository)(nil).Delete), arg0, arg1, arg2)
+}
+
+// DeleteExpired mocks base method.
+func (m *MockIdempotencyRepository) DeleteExpired(arg0 context.Context, arg1 time.Time) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteExpired", arg0, arg1)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteExpired indicates an expected call of DeleteExpired.
+func (mr *MockIdempotencyRepositoryMockRecorder) DeleteExpired(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteExpired", reflect.TypeOf((*MockIdempotencyRepository)(nil).DeleteExpired), arg0, arg1)
+}
+
+// Find mocks base method.
+func (m *MockIdempotencyRepository) Find(arg0 context.Context, arg1, arg2 string) (*models.IdempotencyKey, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Find", arg0, arg1, arg2)
+	ret0, _ := ret[0].(*models.IdempotencyKey)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Find indicates an expected call of Find.
+func (mr *MockIdempotencyRepositoryMockRecorder) Find(arg0, arg1, arg2 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Find", reflect.TypeOf((*MockIdempotencyRepository)(nil).Find), arg0, arg1, arg2)
+}
+
+// MockJobRepository is a mock of JobRepository interface.
+type MockJobRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockJobRepositoryMockRecorder
+}
+
+// MockJobRepositoryMockRecorder is the mock recorder for MockJobRepository.
+type MockJobRepositoryMockRecorder struct {
+	mock *MockJobRepository
+}
+
+// NewMockJobRepository creates a new mock instance.
+func NewMockJobRepository(ctrl *gomock.Controller) *MockJobRepository {
+	mock := &MockJobRepository{ctrl: ctrl}
+	mock.recorder = &MockJobRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockJobRepository) EXPECT() *MockJobRepositoryMockRecorder {
+	return m.recorder
+}
+
+// Claim mocks base method.
+func (m *MockJobRepository) Claim(arg0 context.Context, arg1 *models.Job, arg2, arg3 time.Time) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Claim", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Claim indicates an expected call of Claim.
+func (mr *MockJobRepositoryMockRecorder) Claim(arg0, arg1, arg2, arg3 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Claim", reflect.TypeOf((*MockJobRepository)(nil).Claim), arg0, arg1, arg2, arg3)
+}
+
+// Count mocks base method.
+func (m *MockJobRepository) Count(arg0 context.Context) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Count", arg0)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Count indicates an expected call of Count.
+func (mr *MockJobRepositoryMockRecorder) Count(arg0 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Count", reflect.TypeOf((*MockJobRepository)(nil).Count), arg0)
+}
+
+// CountByStatus mocks base method.
+func (m *MockJobRepository) CountByStatus(arg0 context.Context) (*models.JobStats, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CountByStatus", arg0)
+	ret0, _ := ret[0].(*models.JobStats)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CountByStatus indicates an expected call of CountByStatus.
+func (mr *MockJobRepositoryMockRecorder) CountByStatus(arg0 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CountByStatus", reflect.TypeOf((*MockJobRepository)(nil).CountByStatus), arg0)
+}
+
+// Create mocks base method.
+func (m *MockJobRepository) Create(arg0 context.Context, arg1 *models.Job) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockJobRepositoryMockRecorder) Create(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockJobRepository)(nil).Create), arg0, arg1)
+}
+
+// CreateInBatches mocks base method.
+func (m *MockJobRepository) CreateInBatches(arg0 context.Context, arg1 []models.Job, arg2 int) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateInBatches", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreateInBatches indicates an expected call of CreateInBatches.
+func (mr *MockJobRepositoryMockRecorder) CreateInBatches(arg0, arg1, arg2 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateInBatches", reflect.TypeOf((*MockJobRepository)(nil).CreateInBatches), arg0, arg1, arg2)
+}
+
+// CreateUnique mocks base method.
+func (m *MockJobRepository) CreateUnique(arg0 context.Context, arg1 *models.Job) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateUnique", arg0, arg1)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateUnique indicates an expected call of CreateUnique.
+func (mr *MockJobRepositoryMockRecorder) CreateUnique(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateUnique", reflect.TypeOf((*MockJobRepository)(nil).CreateUnique), arg0, arg1)
+}
+
+// Delete mocks base method.
+func (m *MockJobRepository) Delete(arg0 context.Context, arg1 uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Delete", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Delete indicates an expected call of Delete.
+func (mr *MockJobRepositoryMockRecorder) Delete(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockJobRepository)(nil).Delete), arg0, arg1)
+}
+
+// DeleteFinishedBefore mocks base method.
+func (m *MockJobRepository) DeleteFinishedBefore(arg0 context.Context, arg1 time.Time) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteFinishedBefore", arg0, arg1)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteFinishedBefore indicates an expected call of DeleteFinishedBefore.
+func (mr *MockJobRepositoryMockRecorder) DeleteFinishedBefore(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteFinishedBefore", reflect.TypeOf((*MockJobRepository)(nil).DeleteFinishedBefore), arg0, arg1)
+}
+
+// FindAll mocks base method.
+func (m *MockJobRepository) FindAll(arg0 context.Context, arg1, arg2 int) ([]models.Job, int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindAll", arg0, arg1, arg2)
+	ret0, _ := ret[0].([]models.Job)
+	ret1, _ := ret[1].(int64)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// FindAll indicates an expected call of FindAll.
+func (mr *MockJobRepositoryMockRecorder) FindAll(arg0, arg1, arg2 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindAll", reflect.TypeOf((*MockJobRepository)(nil).FindAll), arg0, arg1, arg2)
+}
+
+// FindByID mocks base method.
+func (m *MockJobRepository) FindByID(arg0 context.Context, arg1 uuid.UUID) (*models.Job, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindByID", arg0, arg1)
+	ret0, _ := ret[0].(*models.Job)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindByID indicates an expected call of FindByID.
+func (mr *MockJobRepositoryMockRecorder) FindByID(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByID", reflect.TypeOf((*MockJobRepository)(nil).FindByID), arg0, arg1)
+}
+
+// FindByIDs mocks base method.
+func (m *MockJobRepository) FindByIDs(arg0 context.Context, arg1 []uuid.UUID) ([]models.Job, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindByIDs", arg0, arg1)
+	ret0, _ := ret[0].([]models.Job)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindByIDs indicates an expected call of FindByIDs.
+func (mr *MockJobRepositoryMockRecorder) FindByIDs(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByIDs", reflect.TypeOf((*MockJobRepository)(nil).FindByIDs), arg0, arg1)
+}
+
+// FindDue mocks base method.
+func (m *MockJobRepository) FindDue(arg0 context.Context, arg1 time.Time, arg2 int) ([]models.Job, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindDue", arg0, arg1, arg2)
+	ret0, _ := ret[0].([]models.Job)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindDue indicates an expected call of FindDue.
+func (mr *MockJobRepositoryMockRecorder) FindDue(arg0, arg1, arg2 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindDue", reflect.TypeOf((*MockJobRepository)(nil).FindDue), arg0, arg1, arg2)
+}
+
+// FindJobs mocks base method.
+func (m *MockJobRepository) FindJobs(arg0 context.Context, arg1 models.JobStatus, arg2 string, arg3, arg4 int) ([]models.Job, int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindJobs", arg0, arg1, arg2, arg3, arg4)
+	ret0, _ := ret[0].([]models.Job)
+	ret1, _ := ret[1].(int64)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// FindJobs indicates an expected call of FindJobs.
+func (mr *MockJobRepositoryMockRecorder) FindJobs(arg0, arg1, arg2, arg3, arg4 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindJobs", reflect.TypeOf((*MockJobRepository)(nil).FindJobs), arg0, arg1, arg2, arg3, arg4)
+}
+
+// Finish mocks base method.
+func (m *MockJobRepository) Finish(arg0 context.Context, arg1 *models.Job) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Finish", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Finish indicates an expected call of Finish.
+func (mr *MockJobRepositoryMockRecorder) Finish(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Finish", reflect.TypeOf((*MockJobRepository)(nil).Finish), arg0, arg1)
+}
+
+// Update mocks base method.
+func (m *MockJobRepository) Update(arg0 context.Context, arg1 *models.Job) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Update", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Update indicates an expected call of Update.
+func (mr *MockJobRepositoryMockRecorder) Update(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockJobRepository)(nil).Update), arg0, arg1)
+}
+
+// Upsert mocks base method.
+func (m *MockJobRepository) Upsert(arg0 context.Context, arg1 *models.Job) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Upsert", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Upsert indicates an expected call of Upsert.
+func (mr *MockJobRepositoryMockRecorder) Upsert(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Upsert", reflect.TypeOf((*MockJobRepository)(nil).Upsert), arg0, arg1)
+}
+
+// MockMediaRepository is a mock of MediaRepository interface.
+type MockMediaRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockMediaRepositoryMockRecorder
+}
+
+// MockMediaRepositoryMockRecorder is the mock recorder for MockMediaRepository.
+type MockMediaRepositoryMockRecorder struct {
+	mock *MockMediaRepository
+}
+
+// NewMockMediaRepository creates a new mock instance.
+func NewMockMediaRepository(ctrl *gomock.Controller) *MockMediaRepository {
+	mock := &MockMediaRepository{ctrl: ctrl}
+	mock.recorder = &MockMediaRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockMediaRepository) EXPECT() *MockMediaRepositoryMockRecorder {
+	return m.recorder
+}
+
+// Count mocks base method.
+func (m *MockMediaRepository) Count(arg0 context.Context) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Count", arg0)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Count indicates an expected call of Count.
+func (mr *MockMediaRepositoryMockRecorder) Count(arg0 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Count", reflect.TypeOf((*MockMediaRepository)(nil).Count), arg0)
+}
+
+// Create mocks base method.
+func (m *MockMediaRepository) Create(arg0 context.Context, arg1 *models.Media) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockMediaRepositoryMockRecorder) Create(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockMediaRepository)(nil).Create), arg0, arg1)
+}
+
+// CreateInBatches mocks base method.
+func (m *MockMediaRepository) CreateInBatches(arg0 context.Context, arg1 []models.Media, arg2 int) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateInBatches", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreateInBatches indicates an expected call of CreateInBatches.
+func (mr *MockMediaRepositoryMockRecorder) CreateInBatches(arg0, arg1, arg2 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateInBatches", reflect.TypeOf((*MockMediaRepository)(nil).CreateInBatches), arg0, arg1, arg2)
+}
+
+// Delete mocks base method.
+func (m *MockMediaRepository) Delete(arg0 context.Context, arg1 uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Delete", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Delete indicates an expected call of Delete.
+func (mr *MockMediaRepositoryMockRecorder) Delete(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockMediaRepository)(nil).Delete), arg0, arg1)
+}
+
+// FindAll mocks base method.
+func (m *MockMediaRepository) FindAll(arg0 context.Context, arg1, arg2 int) ([]models.Media, int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindAll", arg0, arg1, arg2)
+	ret0, _ := ret[0].([]models.Media)
+	ret1, _ := ret[1].(int64)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// FindAll indicates an expected call of FindAll.
+func (mr *MockMediaRepositoryMockRecorder) FindAll(arg0, arg1, arg2 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindAll", reflect.TypeOf((*MockMediaRepository)(nil).FindAll), arg0, arg1, arg2)
+}
+
+// FindByID mocks base method.
+func (m *MockMediaRepository) FindByID(arg0 context.Context, arg1 uuid.UUID) (*models.Media, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindByID", arg0, arg1)
+	ret0, _ := ret[0].(*models.Media)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindByID indicates an expected call of FindByID.
+func (mr *MockMediaRepositoryMockRecorder) FindByID(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByID", reflect.TypeOf((*MockMediaRepository)(nil).FindByID), arg0, arg1)
+}
+
+// FindByIDs mocks base method.
+func (m *MockMediaRepository) FindByIDs(arg0 context.Context, arg1 []uuid.UUID) ([]models.Media, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindByIDs", arg0, arg1)
+	ret0, _ := ret[0].([]models.Media)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindByIDs indicates an expected call of FindByIDs.
+func (mr *MockMediaRepositoryMockRecorder) FindByIDs(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByIDs", reflect.TypeOf((*MockMediaRepository)(nil).FindByIDs), arg0, arg1)
+}
+
+// FindByUser mocks base method.
+func (m *MockMediaRepository) FindByUser(arg0 context.Context, arg1 uuid.UUID, arg2, arg3 int) ([]models.Media, int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindByUser", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].([]models.Media)
+	ret1, _ := ret[1].(int64)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// FindByUser indicates an expected call of FindByUser.
+func (mr *MockMediaRepositoryMockRecorder) FindByUser(arg0, arg1, arg2, arg3 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByUser", reflect.TypeOf((*MockMediaRepository)(nil).FindByUser), arg0, arg1, arg2, arg3)
+}
+
+// Update mocks base method.
+func (m *MockMediaRepository) Update(arg0 context.Context, arg1 *models.Media) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Update", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Update indicates an expected call of Update.
+func (mr *MockMediaRepositoryMockRecorder) Update(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockMediaRepository)(nil).Update), arg0, arg1)
+}
+
+// Upsert mocks base method.
+func (m *MockMediaRepository) Upsert(arg0 context.Context, arg1 *models.Media) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Upsert", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Upsert indicates an expected call of Upsert.
+func (mr *MockMediaRepositoryMockRecorder) Upsert(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Upsert", reflect.TypeOf((*MockMediaRepository)(nil).Upsert), arg0, arg1)
+}
+
+// MockMediaUploadRepository is a mock of MediaUploadRepository interface.
+type MockMediaUploadRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockMediaUploadRepositoryMockRecorder
+}
+
+// MockMediaUploadRepositoryMockRecorder is the mock recorder for MockMediaUploadRepository.
+type MockMediaUploadRepositoryMockRecorder struct {
+	mock *MockMediaUploadRepository
+}
+
+// NewMockMediaUploadRepository creates a new mock instance.
+func NewMockMediaUploadRepository(ctrl *gomock.Controller) *MockMediaUploadRepository {
+	mock := &MockMediaUploadRepository{ctrl: ctrl}
+	mock.recorder = &MockMediaUploadRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockMediaUploadRepository) EXPECT() *MockMediaUploadRepositoryMockRecorder {
+	return m.recorder
+}
+
+// Append mocks base method.
+func (m *MockMediaUploadRepository) Append(arg0 context.Context, arg1 *models.MediaUpload, arg2 int64) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Append", arg0, arg1, arg2)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Append indicates an expected call of Append.
+func (mr *MockMediaUploadRepositoryMockRecorder) Append(arg0, arg1, arg2 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Append", reflect.TypeOf((*MockMediaUploadRepository)(nil).Append), arg0, arg1, arg2)
+}
+
+// CountPending mocks base method.
+func (m *MockMediaUploadRepository) CountPending(arg0 context.Context, arg1 uuid.UUID, arg2 time.Time) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CountPending", arg0, arg1, arg2)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CountPending indicates an expected call of CountPending.
+func (mr *MockMediaUploadRepositoryMockRecorder) CountPending(arg0, arg1, arg2 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CountPending", reflect.TypeOf((*MockMediaUploadRepository)(nil).CountPending), arg0, arg1, arg2)
+}
+
+// Create mocks base method.
+func (m *MockMediaUploadRepository) Create(arg0 context.Context, arg1 *models.MediaUpload) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockMediaUploadRepositoryMockRecorder) Create(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockMediaUploadRepository)(nil).Create), arg0, arg1)
+}
+
+// Delete mocks base method.
+func (m *MockMediaUploadRepository) Delete(arg0 context.Context, arg1 uuid.UUID) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Delete", arg0, arg1)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Delete indicates an expected call of Delete.
+func (mr *MockMediaUploadRepositoryMockRecorder) Delete(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockMediaUploadRepository)(nil).Delete), arg0, arg1)
+}
+
+// FindByID mocks base method.
+func (m *MockMediaUploadRepository) FindByID(arg0 context.Context, arg1 uuid.UUID) (*models.MediaUpload, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindByID", arg0, arg1)
+	ret0, _ := ret[0].(*models.MediaUpload)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindByID indicates an expected call of FindByID.
+func (mr *MockMediaUploadRepositoryMockRecorder) FindByID(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByID", reflect.TypeOf((*MockMediaUploadRepository)(nil).FindByID), arg0, arg1)
+}
+
+// FindExpired mocks base method.
+func (m *MockMediaUploadRepository) FindExpired(arg0 context.Context, arg1 time.Time, arg2 int) ([]models.MediaUpload, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindExpired", arg0, arg1, arg2)
+	ret0, _ := ret[0].([]models.MediaUpload)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindExpired indicates an expected call of FindExpired.
+func (mr *MockMediaUploadRepositoryMockRecorder) FindExpired(arg0, arg1, arg2 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindExpired", reflect.TypeOf((*MockMediaUploadRepository)(nil).FindExpired), arg0, arg1, arg2)
+}
+
+// MockNotificationRepository is a mock of NotificationRepository interface.
+type MockNotificationRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockNotificationRepositoryMockRecorder
+}
+
+// MockNotificationRepositoryMockRecorder is the mock recorder for MockNotificationRepository.
+type MockNotificationRepositoryMockRecorder struct {
+	mock *MockNotificationRepository
+}
+
+// NewMockNotificationRepository creates a new mock instance.
+func NewMockNotificationRepository(ctrl *gomock.Controller) *MockNotificationRepository {
+	mock := &MockNotificationRepository{ctrl: ctrl}
+	mock.recorder = &MockNotificationRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockNotificationRepository) EXPECT() *MockNotificationRepositoryMockRecorder {
+	return m.recorder
+}
+
+// Count mocks base method.
+func (m *MockNotificationRepository) Count(arg0 context.Context) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Count", arg0)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Count indicates an expected call of Count.
+func (mr *MockNotificationRepositoryMockRecorder) Count(arg0 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Count", reflect.TypeOf((*MockNotificationRepository)(nil).Count), arg0)
+}
+
+// CountUnread mocks base method.
+func (m *MockNotificationRepository) CountUnread(arg0 context.Context, arg1 uuid.UUID) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CountUnread", arg0, arg1)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CountUnread indicates an expected call of CountUnread.
+func (mr *MockNotificationRepositoryMockRecorder) CountUnread(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CountUnread", reflect.TypeOf((*MockNotificationRepository)(nil).CountUnread), arg0, arg1)
+}
+
+// Create mocks base method.
+func (m *MockNotificationRepository) Create(arg0 context.Context, arg1 *models.Notification) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockNotificationRepositoryMockRecorder) Create(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockNotificationRepository)(nil).Create), arg0, arg1)
+}
+
+// CreateInBatches mocks base method.
+func (m *MockNotificationRepository) CreateInBatches(arg0 context.Context, arg1 []models.Notification, arg2 int) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateInBatches", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreateInBatches indicates an expected call of CreateInBatches.
+func (mr *MockNotificationRepositoryMockRecorder) CreateInBatches(arg0, arg1, arg2 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateInBatches", reflect.TypeOf((*MockNotificationRepository)(nil).CreateInBatches), arg0, arg1, arg2)
+}
+
+// Delete mocks base method.
+func (m *MockNotificationRepository) Delete(arg0 context.Context, arg1 uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Delete", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Delete indicates an expected call of Delete.
+func (mr *MockNotificationRepositoryMockRecorder) Delete(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockNotificationRepository)(nil).Delete), arg0, arg1)
+}
+
+// FindAll mocks base method.
+func (m *MockNotificationRepository) FindAll(arg0 context.Context, arg1, arg2 int) ([]models.Notification, int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindAll", arg0, arg1, arg2)
+	ret0, _ := ret[0].([]models.Notification)
+	ret1, _ := ret[1].(int64)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// FindAll indicates an expected call of FindAll.
+func (mr *MockNotificationRepositoryMockRecorder) FindAll(arg0, arg1, arg2 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindAll", reflect.TypeOf((*MockNotificationRepository)(nil).FindAll), arg0, arg1, arg2)
+}
+
+// FindByID mocks base method.
+func (m *MockNotificationRepository) FindByID(arg0 context.Context, arg1 uuid.UUID) (*models.Notification, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindByID", arg0, arg1)
+	ret0, _ := ret[0].(*models.Notification)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindByID indicates an expected call of FindByID.
+func (mr *MockNotificationRepositoryMockRecorder) FindByID(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByID", reflect.TypeOf((*MockNotificationRepository)(nil).FindByID), arg0, arg1)
+}
+
+// FindByIDs mocks base method.
+func (m *MockNotificationRepository) FindByIDs(arg0 context.Context, arg1 []uuid.UUID) ([]models.Notification, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindByIDs", arg0, arg1)
+	ret0, _ := ret[0].([]models.Notification)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindByIDs indicates an expected call of FindByIDs.
+func (mr *MockNotificationRepositoryMockRecorder) FindByIDs(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByIDs", reflect.TypeOf((*MockNotificationRepository)(nil).FindByIDs), arg0, arg1)
+}
+
+// FindByUser mocks base method.
+func (m *MockNotificationRepository) FindByUser(arg0 context.Context, arg1 uuid.UUID, arg2 bool, arg3, arg4 int) ([]models.Notification, int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindByUser", arg0, arg1, arg2, arg3, arg4)
+	ret0, _ := ret[0].([]models.Notification)
+	ret1, _ := ret[1].(int64)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// FindByUser indicates an expected call of FindByUser.
+func (mr *MockNotificationRepositoryMockRecorder) FindByUser(arg0, arg1, arg2, arg3, arg4 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByUser", reflect.TypeOf((*MockNotificationRepository)(nil).FindByUser), arg0, arg1, arg2, arg3, arg4)
+}
+
+// FindForDelivery mocks base method.
+func (m *MockNotificationRepository) FindForDelivery(arg0 context.Context, arg1 uuid.UUID) (*models.Notification, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindForDelivery", arg0, arg1)
+	ret0, _ := ret[0].(*models.Notification)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindForDelivery indicates an expected call of FindForDelivery.
+func (mr *MockNotificationRepositoryMockRecorder) FindForDelivery(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindForDelivery", reflect.TypeOf((*MockNotificationRepository)(nil).FindForDelivery), arg0, arg1)
+}
+
+// MarkAllRead mocks base method.
+func (m *MockNotificationRepository) MarkAllRead(arg0 context.Context, arg1 uuid.UUID) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MarkAllRead", arg0, arg1)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// MarkAllRead indicates an expected call of MarkAllRead.
+func (mr *MockNotificationRepositoryMockRecorder) MarkAllRead(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MarkAllRead", reflect.TypeOf((*MockNotificationRepository)(nil).MarkAllRead), arg0, arg1)
+}
+
+// MarkRead mocks base method.
+func (m *MockNotificationRepository) MarkRead(arg0 context.Context, arg1, arg2 uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MarkRead", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// MarkRead indicates an expected call of MarkRead.
+func (mr *MockNotificationRepositoryMockRecorder) MarkRead(arg0, arg1, arg2 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MarkRead", reflect.TypeOf((*MockNotificationRepository)(nil).MarkRead), arg0, arg1, arg2)
+}
+
+// Update mocks base method.
+func (m *MockNotificationRepository) Update(arg0 context.Context, arg1 *models.Notification) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Update", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Update indicates an expected call of Update.
+func (mr *MockNotificationRepositoryMockRecorder) Update(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockNotificationRepository)(nil).Update), arg0, arg1)
+}
+
+// Upsert mocks base method.
+func (m *MockNotificationRepository) Upsert(arg0 context.Context, arg1 *models.Notification) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Upsert", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Upsert indicates an expected call of Upsert.
+func (mr *MockNotificationRepositoryMockRecorder) Upsert(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Upsert", reflect.TypeOf((*MockNotificationRepository)(nil).Upsert), arg0, arg1)
+}
+
+// MockOperationRepository is a mock of OperationRepository interface.
+type MockOperationRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockOperationRepositoryMockRecorder
+}
+
+// MockOperationRepositoryMockRecorder is the mock recorder for MockOperationRepository.
+type MockOperationRepositoryMockRecorder struct {
+	mock *MockOperationRepository
+}
+
+// NewMockOperationRepository creates a new mock instance.
+func NewMockOperationRepository(ctrl *gomock.Controller) *MockOperationRepository {
+	mock := &MockOperationRepository{ctrl: ctrl}
+	mock.recorder = &MockOperationRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockOperationRepository) EXPECT() *MockOperationRepositoryMockRecorder {
+	return m.recorder
+}
+
+// Count mocks base method.
+func (m *MockOperationRepository) Count(arg0 context.Context) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Count", arg0)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Count indicates an expected call of Count.
+func (mr *MockOperationRepositoryMockRecorder) Count(arg0 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Count", reflect.TypeOf((*MockOperationRepository)(nil).Count), arg0)
+}
+
+// Create mocks base method.
+func (m *MockOperationRepository) Create(arg0 context.Context, arg1 *models.AdminOperation) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockOperationRepositoryMockRecorder) Create(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockOperationRepository)(nil).Create), arg0, arg1)
+}
+
+// CreateInBatches mocks base method.
+func (m *MockOperationRepository) CreateInBatches(arg0 context.Context, arg1 []models.AdminOperation, arg2 int) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateInBatches", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreateInBatches indicates an expected call of CreateInBatches.
+func (mr *MockOperationRepositoryMockRecorder) CreateInBatches(arg0, arg1, arg2 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateInBatches", reflect.TypeOf((*MockOperationRepository)(nil).CreateInBatches), arg0, arg1, arg2)
+}
+
+// Delete mocks base method.
+func (m *MockOperationRepository) Delete(arg0 context.Context, arg1 uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Delete", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Delete indicates an expected call of Delete.
+func (mr *MockOperationRepositoryMockRecorder) Delete(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockOperationRepository)(nil).Delete), arg0, arg1)
+}
+
+// FindAll mocks base method.
+func (m *MockOperationRepository) FindAll(arg0 context.Context, arg1, arg2 int) ([]models.AdminOperation, int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindAll", arg0, arg1, arg2)
+	ret0, _ := ret[0].([]models.AdminOperation)
+	ret1, _ := ret[1].(int64)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// FindAll indicates an expected call of FindAll.
+func (mr *MockOperationRepositoryMockRecorder) FindAll(arg0, arg1, arg2 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindAll", reflect.TypeOf((*MockOperationRepository)(nil).FindAll), arg0, arg1, arg2)
+}
+
+// FindAllRecent mocks base method.
+func (m *MockOperationRepository) FindAllRecent(arg0 context.Context, arg1, arg2 int) ([]models.AdminOperation, int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindAllRecent", arg0, arg1, arg2)
+	ret0, _ := ret[0].([]models.AdminOperation)
+	ret1, _ := ret[1].(int64)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// FindAllRecent indicates an expected call of FindAllRecent.
+func (mr *MockOperationRepositoryMockRecorder) FindAllRecent(arg0, arg1, arg2 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindAllRecent", reflect.TypeOf((*MockOperationRepository)(nil).FindAllRecent), arg0, arg1, arg2)
+}
+
+// FindByID mocks base method.
+func (m *MockOperationRepository) FindByID(arg0 context.Context, arg1 uuid.UUID) (*models.AdminOperation, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindByID", arg0, arg1)
+	ret0, _ := ret[0].(*models.AdminOperation)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindByID indicates an expected call of FindByID.
+func (mr *MockOperationRepositoryMockRecorder) FindByID(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByID", reflect.TypeOf((*MockOperationRepository)(nil).FindByID), arg0, arg1)
+}
+
+// FindByIDs mocks base method.
+func (m *MockOperationRepository) FindByIDs(arg0 context.Context, arg1 []uuid.UUID) ([]models.AdminOperation, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindByIDs", arg0, arg1)
+	ret0, _ := ret[0].([]models.AdminOperation)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindByIDs indicates an expected call of FindByIDs.
+func (mr *MockOperationRepositoryMockRecorder) FindByIDs(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByIDs", reflect.TypeOf((*MockOperationRepository)(nil).FindByIDs), arg0, arg1)
+}
+
+// InTransaction mocks base method.
+func (m *MockOperationRepository) InTransaction(arg0 context.Context, arg1 func(repository.OperationRepos) error) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "InTransaction", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// InTransaction indicates an expected call of InTransaction.
+func (mr *MockOperationRepositoryMockRecorder) InTransaction(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InTransaction", reflect.TypeOf((*MockOperationRepository)(nil).InTransaction), arg0, arg1)
+}
+
+// MarkUndone mocks base method.
+func (m *MockOperationRepository) MarkUndone(arg0 context.Context, arg1, arg2 uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MarkUndone", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// MarkUndone indicates an expected call of MarkUndone.
+func (mr *MockOperationRepositoryMockRecorder) MarkUndone(arg0, arg1, arg2 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MarkUndone", reflect.TypeOf((*MockOperationRepository)(nil).MarkUndone), arg0, arg1, arg2)
+}
+
+// Restore mocks base method.
+func (m *MockOperationRepository) Restore(arg0 context.Context, arg1 string, arg2 []models.SnapshotRow) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Restore", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Restore indicates an expected call of Restore.
+func (mr *MockOperationRepositoryMockRecorder) Restore(arg0, arg1, arg2 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Restore", reflect.TypeOf((*MockOperationRepository)(nil).Restore), arg0, arg1, arg2)
+}
+
+// Snapshot mocks base method.
+func (m *MockOperationRepository) Snapshot(arg0 context.Context, arg1 string, arg2 []uuid.UUID, arg3 []string) ([]models.SnapshotRow, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Snapshot", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].([]models.SnapshotRow)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Snapshot indicates an expected call of Snapshot.
+func (mr *MockOperationRepositoryMockRecorder) Snapshot(arg0, arg1, arg2, arg3 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Snapshot", reflect.TypeOf((*MockOperationRepository)(nil).Snapshot), arg0, arg1, arg2, arg3)
+}
+
+// Update mocks base method.
+func (m *MockOperationRepository) Update(arg0 context.Context, arg1 *models.AdminOperation) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Update", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Update indicates an expected call of Update.
+func (mr *MockOperationRepositoryMockRecorder) Update(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockOperationRepository)(nil).Update), arg0, arg1)
+}
+
+// Upsert mocks base method.
+func (m *MockOperationRepository) Upsert(arg0 context.Context, arg1 *models.AdminOperation) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Upsert", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Upsert indicates an expected call of Upsert.
+func (mr *MockOperationRepositoryMockRecorder) Upsert(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Upsert", reflect.TypeOf((*MockOperationRepository)(nil).Upsert), arg0, arg1)
+}
+
+// MockOrganizationRepository is a mock of OrganizationRepository interface.
+type MockOrganizationRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockOrganizationRepositoryMockRecorder
+}
+
+// MockOrganizationRepositoryMockRecorder is the mock recorder for MockOrganizationRepository.
+type MockOrganizationRepositoryMockRecorder struct {
+	mock *MockOrganizationRepository
+}
+
+// NewMockOrganizationRepository creates a new mock instance.
+func NewMockOrganizationRepository(ctrl *gomock.Controller) *MockOrganizationRepository {
+	mock := &MockOrganizationRepository{ctrl: ctrl}
+	mock.recorder = &MockOrganizationRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockOrganizationRepository) EXPECT() *MockOrganizationRepositoryMockRecorder {
+	return m.recorder
+}
+
+// Count mocks base method.
+func (m *MockOrganizationRepository) Count(arg0 context.Context) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Count", arg0)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Count indicates an expected call of Count.
+func (mr *MockOrganizationRepositoryMockRecorder) Count(arg0 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Count", reflect.TypeOf((*MockOrganizationRepository)(nil).Count), arg0)
+}
+
+// CountOwners mocks base method.
+func (m *MockOrganizationRepository) CountOwners(arg0 context.Context, arg1 uuid.UUID) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CountOwners", arg0, arg1)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CountOwners indicates an expected call of CountOwners.
+func (mr *MockOrganizationRepositoryMockRecorder) CountOwners(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CountOwners", reflect.TypeOf((*MockOrganizationRepository)(nil).CountOwners), arg0, arg1)
+}
+
+// Create mocks base method.
+func (m *MockOrganizationRepository) Create(arg0 context.Context, arg1 *models.Organization) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockOrganizationRepositoryMockRecorder) Create(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockOrganizationRepository)(nil).Create), arg0, arg1)
+}
+
+// CreateInBatches mocks base method.
+func (m *MockOrganizationRepository) CreateInBatches(arg0 context.Context, arg1 []models.Organization, arg2 int) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateInBatches", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreateInBatches indicates an expected call of CreateInBatches.
+func (mr *MockOrganizationRepositoryMockRecorder) CreateInBatches(arg0, arg1, arg2 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateInBatches", reflect.TypeOf((*MockOrganizationRepository)(nil).CreateInBatches), arg0, arg1, arg2)
+}
+
+// CreateWithOwner mocks base method.
+func (m *MockOrganizationRepository) CreateWithOwner(arg0 context.Context, arg1 *models.Organization, arg2 uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateWithOwner", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreateWithOwner indicates an expected call of CreateWithOwner.
+func (mr *MockOrganizationRepositoryMockRecorder) CreateWithOwner(arg0, arg1, arg2 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateWithOwner", reflect.TypeOf((*MockOrganizationRepository)(nil).CreateWithOwner), arg0, arg1, arg2)
+}
+
+// Delete mocks base method.
+func (m *MockOrganizationRepository) Delete(arg0 context.Context, arg1 uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Delete", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Delete indicates an expected call of Delete.
+func (mr *MockOrganizationRepositoryMockRecorder) Delete(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockOrganizationRepository)(nil).Delete), arg0, arg1)
+}
+
+// DeleteMember mocks base method.
+func (m *MockOrganizationRepository) DeleteMember(arg0 context.Context, arg1, arg2 uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteMember", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteMember indicates an expected call of DeleteMember.
+func (mr *MockOrganizationRepositoryMockRecorder) DeleteMember(arg0, arg1, arg2 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteMember", reflect.TypeOf((*MockOrganizationRepository)(nil).DeleteMember), arg0, arg1, arg2)
+}
+
+// FindAll mocks base method.
+func (m *MockOrganizationRepository) FindAll(arg0 context.Context, arg1, arg2 int) ([]models.Organization, int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindAll", arg0, arg1, arg2)
+	ret0, _ := ret[0].([]models.Organization)
+	ret1, _ := ret[1].(int64)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// FindAll indicates an expected call of FindAll.
+func (mr *MockOrganizationRepositoryMockRecorder) FindAll(arg0, arg1, arg2 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindAll", reflect.TypeOf((*MockOrganizationRepository)(nil).FindAll), arg0, arg1, arg2)
+}
+
+// FindByID mocks base method.
+func (m *MockOrganizationRepository) FindByID(arg0 context.Context, arg1 uuid.UUID) (*models.Organization, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindByID", arg0, arg1)
+	ret0, _ := ret[0].(*models.Organization)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindByID indicates an expected call of FindByID.
+func (mr *MockOrganizationRepositoryMockRecorder) FindByID(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByID", reflect.TypeOf((*MockOrganizationRepository)(nil).FindByID), arg0, arg1)
+}
+
+// FindByIDs mocks base method.
+func (m *MockOrganizationRepository) FindByIDs(arg0 context.Context, arg1 []uuid.UUID) ([]models.Organization, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindByIDs", arg0, arg1)
+	ret0, _ := ret[0].([]models.Organization)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindByIDs indicates an expected call of FindByIDs.
+func (mr *MockOrganizationRepositoryMockRecorder) FindByIDs(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByIDs", reflect.TypeOf((*MockOrganizationRepository)(nil).FindByIDs), arg0, arg1)
+}
+
+// FindBySlug mocks base method.
+func (m *MockOrganizationRepository) FindBySlug(arg0 context.Context, arg1 string) (*models.Organization, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindBySlug", arg0, arg1)
+	ret0, _ := ret[0].(*models.Organization)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindBySlug indicates an expected call of FindBySlug.
+func (mr *MockOrganizationRepositoryMockRecorder) FindBySlug(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindBySlug", reflect.TypeOf((*MockOrganizationRepository)(nil).FindBySlug), arg0, arg1)
+}
+
+// FindMember mocks base method.
+func (m *MockOrganizationRepository) FindMember(arg0 context.Context, arg1, arg2 uuid.UUID) (*models.OrganizationMember, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindMember", arg0, arg1, arg2)
+	ret0, _ := ret[0].(*models.OrganizationMember)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindMember indicates an expected call of FindMember.
+func (mr *MockOrganizationRepositoryMockRecorder) FindMember(arg0, arg1, arg2 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindMember", reflect.TypeOf((*MockOrganizationRepository)(nil).FindMember), arg0, arg1, arg2)
+}
+
+// FindMembers mocks base method.
+func (m *MockOrganizationRepository) FindMembers(arg0 context.Context, arg1 uuid.UUID, arg2, arg3 int) ([]models.OrganizationMember, int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindMembers", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].([]models.OrganizationMember)
+	ret1, _ := ret[1].(int64)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// FindMembers indicates an expected call of FindMembers.
+func (mr *MockOrganizationRepositoryMockRecorder) FindMembers(arg0, arg1, arg2, arg3 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindMembers", reflect.TypeOf((*MockOrganizationRepository)(nil).FindMembers), arg0, arg1, arg2, arg3)
+}
+
+// FindMemberships mocks base method.
+func (m *MockOrganizationRepository) FindMemberships(arg0 context.Context, arg1 uuid.UUID) ([]models.OrganizationMember, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindMemberships", arg0, arg1)
+	ret0, _ := ret[0].([]models.OrganizationMember)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindMemberships indicates an expected call of FindMemberships.
+func (mr *MockOrganizationRepositoryMockRecorder) FindMemberships(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindMemberships", reflect.TypeOf((*MockOrganizationRepository)(nil).FindMemberships), arg0, arg1)
+}
+
+// SaveMember mocks base method.
+func (m *MockOrganizationRepository) SaveMember(arg0 context.Context, arg1 *models.OrganizationMember) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SaveMember", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SaveMember indicates an expected call of SaveMember.
+func (mr *MockOrganizationRepositoryMockRecorder) SaveMember(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SaveMember", reflect.TypeOf((*MockOrganizationRepository)(nil).SaveMember), arg0, arg1)
+}
+
+// Update mocks base method.
+func (m *MockOrganizationRepository) Update(arg0 context.Context, arg1 *models.Organization) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Update", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Update indicates an expected call of Update.
+func (mr *MockOrganizationRepositoryMockRecorder) Update(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockOrganizationRepository)(nil).Update), arg0, arg1)
+}
+
+// Upsert mocks base method.
+func (m *MockOrganizationRepository) Upsert(arg0 context.Context, arg1 *models.Organization) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Upsert", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Upsert indicates an expected call of Upsert.
+func (mr *MockOrganizationRepositoryMockRecorder) Upsert(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Upsert", reflect.TypeOf((*MockOrganizationRepository)(nil).Upsert), arg0, arg1)
+}
+
+// MockPolicyRepository is a mock of PolicyRepository interface.
+type MockPolicyRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockPolicyRepositoryMockRecorder
+}
+
+// MockPolicyRepositoryMockRecorder is the mock recorder for MockPolicyRepository.
+type MockPolicyRepositoryMockRecorder struct {
+	mock *MockPolicyRepository
+}
+
+// NewMockPolicyRepository creates a new mock instance.
+func NewMockPolicyRepository(ctrl *gomock.Controller) *MockPolicyRepository {
+	mock := &MockPolicyRepository{ctrl: ctrl}
+	mock.recorder = &MockPolicyRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockPolicyRepository) EXPECT() *MockPolicyRepositoryMockRecorder {
+	return m.recorder
+}
+
+// Accept mocks base method.
+func (m *MockPolicyRepository) Accept(arg0 context.Context, arg1 uuid.UUID, arg2 []uuid.UUID, arg3 time.Time) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Accept", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Accept indicates an expected call of Accept.
+func (mr *MockPolicyRepositoryMockRecorder) Accept(arg0, arg1, arg2, arg3 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Accept", reflect.TypeOf((*MockPolicyRepository)(nil).Accept), arg0, arg1, arg2, arg3)
+}
+
+// Count mocks base method.
+func (m *MockPolicyRepository) Count(arg0 context.Context) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Count", arg0)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Count indicates an expected call of Count.
+func (mr *MockPolicyRepositoryMockRecorder) Count(arg0 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Count", reflect.TypeOf((*MockPolicyRepository)(nil).Count), arg0)
+}
+
+// Create mocks base method.
+func (m *MockPolicyRepository) Create(arg0 context.Context, arg1 *models.PolicyVersion) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockPolicyRepositoryMockRecorder) Create(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockPolicyRepository)(nil).Create), arg0, arg1)
+}
+
+// CreateInBatches mocks base method.
+func (m *MockPolicyRepository) CreateInBatches(arg0 context.Context, arg1 []models.PolicyVersion, arg2 int) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateInBatches", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreateInBatches indicates an expected call of CreateInBatches.
+func (mr *MockPolicyRepositoryMockRecorder) CreateInBatches(arg0, arg1, arg2 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateInBatches", reflect.TypeOf((*MockPolicyRepository)(nil).CreateInBatches), arg0, arg1, arg2)
+}
+
+// Delete mocks base method.
+func (m *MockPolicyRepository) Delete(arg0 context.Context, arg1 uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Delete", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Delete indicates an expected call of Delete.
+func (mr *MockPolicyRepositoryMockRecorder) Delete(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockPolicyRepository)(nil).Delete), arg0, arg1)
+}
+
+// ExistsVersion mocks base method.
+func (m *MockPolicyRepository) ExistsVersion(arg0 context.Context, arg1 models.PolicyKind, arg2 string) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ExistsVersion", arg0, arg1, arg2)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ExistsVersion indicates an expected call of ExistsVersion.
+func (mr *MockPolicyRepositoryMockRecorder) ExistsVersion(arg0, arg1, arg2 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ExistsVersion", reflect.TypeOf((*MockPolicyRepository)(nil).ExistsVersion), arg0, arg1, arg2)
+}
+
+// FindAll mocks base method.
+func (m *MockPolicyRepository) FindAll(arg0 context.Context, arg1, arg2 int) ([]models.PolicyVersion, int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindAll", arg0, arg1, arg2)
+	ret0, _ := ret[0].([]models.PolicyVersion)
+	ret1, _ := ret[1].(int64)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// FindAll indicates an expected call of FindAll.
+func (mr *MockPolicyRepositoryMockRecorder) FindAll(arg0, arg1, arg2 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindAll", reflect.TypeOf((*MockPolicyRepository)(nil).FindAll), arg0, arg1, arg2)
+}
+
+// FindByID mocks base method.
+func (m *MockPolicyRepository) FindByID(arg0 context.Context, arg1 uuid.UUID) (*models.PolicyVersion, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindByID", arg0, arg1)
+	ret0, _ := ret[0].(*models.PolicyVersion)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindByID indicates an expected call of FindByID.
+func (mr *MockPolicyRepositoryMockRecorder) FindByID(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByID", reflect.TypeOf((*MockPolicyRepository)(nil).FindByID), arg0, arg1)
+}
+
+// FindByIDs mocks base method.
+func (m *MockPolicyRepository) FindByIDs(arg0 context.Context, arg1 []uuid.UUID) ([]models.PolicyVersion, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindByIDs", arg0, arg1)
+	ret0, _ := ret[0].([]models.PolicyVersion)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindByIDs indicates an expected call of FindByIDs.
+func (mr *MockPolicyRepositoryMockRecorder) FindByIDs(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByIDs", reflect.TypeOf((*MockPolicyRepository)(nil).FindByIDs), arg0, arg1)
+}
+
+// FindByKind mocks base method.
+func (m *MockPolicyRepository) FindByKind(arg0 context.Context, arg1 models.PolicyKind, arg2, arg3 int) ([]models.PolicyVersion, int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindByKind", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].([]models.PolicyVersion)
+	ret1, _ := ret[1].(int64)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// FindByKind indicates an expected call of FindByKind.
+func (mr *MockPolicyRepositoryMockRecorder) FindByKind(arg0, arg1, arg2, arg3 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByKind", reflect.TypeOf((*MockPolicyRepository)(nil).FindByKind), arg0, arg1, arg2, arg3)
+}
+
+// FindCurrent mocks base method.
+func (m *MockPolicyRepository) FindCurrent(arg0 context.Context) ([]models.PolicyVersion, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindCurrent", arg0)
+	ret0, _ := ret[0].([]models.PolicyVersion)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindCurrent indicates an expected call of FindCurrent.
+func (mr *MockPolicyRepositoryMockRecorder) FindCurrent(arg0 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindCurrent", reflect.TypeOf((*MockPolicyRepository)(nil).FindCurrent), arg0)
+}
+
+// FindPending mocks base method.
+func (m *MockPolicyRepository) FindPending(arg0 context.Context, arg1 uuid.UUID) ([]models.PolicyVersion, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindPending", arg0, arg1)
+	ret0, _ := ret[0].([]models.PolicyVersion)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindPending indicates an expected call of FindPending.
+func (mr *MockPolicyRepositoryMockRecorder) FindPending(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindPending", reflect.TypeOf((*MockPolicyRepository)(nil).FindPending), arg0, arg1)
+}
+
+// Update mocks base method.
+func (m *MockPolicyRepository) Update(arg0 context.Context, arg1 *models.PolicyVersion) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Update", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Update indicates an expected call of Update.
+func (mr *MockPolicyRepositoryMockRecorder) Update(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockPolicyRepository)(nil).Update), arg0, arg1)
+}
+
+// Upsert mocks base method.
+func (m *MockPolicyRepository) Upsert(arg0 context.Context, arg1 *models.PolicyVersion) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Upsert", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Upsert indicates an expected call of Upsert.
+func (mr *MockPolicyRepositoryMockRecorder) Upsert(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Upsert", reflect.TypeOf((*MockPolicyRepository)(nil).Upsert), arg0, arg1)
+}
+
+// MockPostRepository is a mock of PostRepository interface.
+type MockPostRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockPostRepositoryMockRecorder
+}
+
+// MockPostRepositoryMockRecorder is the mock recorder for MockPostRepository.
+type MockPostRepositoryMockRecorder struct {
+	mock *MockPostRepository
+}
+
+// NewMockPostRepository creates a new mock instance.
+func NewMockPostRepository(ctrl *gomock.Controller) *MockPostRepository {
+	mock := &MockPostRepository{ctrl: ctrl}
+	mock.recorder = &MockPostRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockPostRepository) EXPECT() *MockPostRepositoryMockRecorder {
+	return m.recorder
+}
+
+// AddTag mocks base method.
+func (m *MockPostRepository) AddTag(arg0 context.Context, arg1, arg2 uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddTag", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// AddTag indicates an expected call of AddTag.
+func (mr *MockPostRepositoryMockRecorder) AddTag(arg0, arg1, arg2 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddTag", reflect.TypeOf((*MockPostRepository)(nil).AddTag), arg0, arg1, arg2)
+}
+
+// AvailableSlug mocks base method.
+func (m *MockPostRepository) AvailableSlug(arg0 context.Context, arg1 string, arg2 uuid.UUID) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AvailableSlug", arg0, arg1, arg2)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AvailableSlug indicates an expected call of AvailableSlug.
+func (mr *MockPostRepositoryMockRecorder) AvailableSlug(arg0, arg1, arg2 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AvailableSlug", reflect.TypeOf((*MockPostRepository)(nil).AvailableSlug), arg0, arg1, arg2)
+}
+
+// Count mocks base method.
+func (m *MockPostRepository) Count(arg0 context.Context) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Count", arg0)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Count indicates an expected call of Count.
+func (mr *MockPostRepositoryMockRecorder) Count(arg0 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Count", reflect.TypeOf((*MockPostRepository)(nil).Count), arg0)
+}
+
+// CountPublishedByUser mocks base method.
+func (m *MockPostRepository) CountPublishedByUser(arg0 context.Context, arg1 uuid.UUID, arg2 bool) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CountPublishedByUser", arg0, arg1, arg2)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CountPublishedByUser indicates an expected call of CountPublishedByUser.
+func (mr *MockPostRepositoryMockRecorder) CountPublishedByUser(arg0, arg1, arg2 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CountPublishedByUser", reflect.TypeOf((*MockPostRepository)(nil).CountPublishedByUser), arg0, arg1, arg2)
+}
+
+// Create mocks base method.
+func (m *MockPostRepository) Create(arg0 context.Context, arg1 *models.Post) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockPostRepositoryMockRecorder) Create(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockPostRepository)(nil).Create), arg0, arg1)
+}
+
+// CreateInBatches mocks base method.
+func (m *MockPostRepository) CreateInBatches(arg0 context.Context, arg1 []models.Post, arg2 int) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateInBatches", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreateInBatches indicates an expected call of CreateInBatches.
+func (mr *MockPostRepositoryMockRecorder) CreateInBatches(arg0, arg1, arg2 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateInBatches", reflect.TypeOf((*MockPostRepository)(nil).CreateInBatches), arg0, arg1, arg2)
+}
+
+// CreateWithSlug mocks base method.
+func (m *MockPostRepository) CreateWithSlug(arg0 context.Context, arg1 *models.Post, arg2 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateWithSlug", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreateWithSlug indicates an expected call of CreateWithSlug.
+func (mr *MockPostRepositoryMockRecorder) CreateWithSlug(arg0, arg1, arg2 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateWithSlug", reflect.TypeOf((*MockPostRepository)(nil).CreateWithSlug), arg0, arg1, arg2)
+}
+
+// Delete mocks base method.
+func (m *MockPostRepository) Delete(arg0 context.Context, arg1 uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Delete", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Delete indicates an expected call of Delete.
+func (mr *MockPostRepositoryMockRecorder) Delete(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockPostRepository)(nil).Delete), arg0, arg1)
+}
+
+// FindAll mocks base method.
+func (m *MockPostRepository) FindAll(arg0 context.Context, arg1, arg2 int) ([]models.Post, int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindAll", arg0, arg1, arg2)
+	ret0, _ := ret[0].([]models.Post)
+	ret1, _ := ret[1].(int64)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// FindAll indicates an expected call of FindAll.
+func (mr *MockPostRepositoryMockRecorder) FindAll(arg0, arg1, arg2 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindAll", reflect.TypeOf((*MockPostRepository)(nil).FindAll), arg0, arg1, arg2)
+}
+
+// FindAllInBatches mocks base method.
+func (m *MockPostRepository) FindAllInBatches(arg0 context.Context, arg1 int, arg2 func([]models.Post) error) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindAllInBatches", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// FindAllInBatches indicates an expected call of FindAllInBatches.
+func (mr *MockPostRepositoryMockRecorder) FindAllInBatches(arg0, arg1, arg2 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindAllInBatches", reflect.TypeOf((*MockPostRepository)(nil).FindAllInBatches), arg0, arg1, arg2)
+}
+
+// FindAllWithAuthor mocks base method.
+func (m *MockPostRepository) FindAllWithAuthor(arg0 context.Context, arg1, arg2 int) ([]models.Post, int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindAllWithAuthor", arg0, arg1, arg2)
+	ret0, _ := ret[0].([]models.Post)
+	ret1, _ := ret[1].(int64)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// FindAllWithAuthor indicates an expected call of FindAllWithAuthor.
+func (mr *MockPostRepositoryMockRecorder) FindAllWithAuthor(arg0, arg1, arg2 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindAllWithAuthor", reflect.TypeOf((*MockPostRepository)(nil).FindAllWithAuthor), arg0, arg1, arg2)
+}
+
+// FindByID mocks base method.
+func (m *MockPostRepository) FindByID(arg0 context.Context, arg1 uuid.UUID) (*models.Post, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindByID", arg0, arg1)
+	ret0, _ := ret[0].(*models.Post)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindByID indicates an expected call of FindByID.
+func (mr *MockPostRepositoryMockRecorder) FindByID(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByID", reflect.TypeOf((*MockPostRepository)(nil).FindByID), arg0, arg1)
+}
+
+// FindByIDs mocks base method.
+func (m *MockPostRepository) FindByIDs(arg0 context.Context, arg1 []uuid.UUID) ([]models.Post, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindByIDs", arg0, arg1)
+	ret0, _ := ret[0].([]models.Post)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindByIDs indicates an expected call of FindByIDs.
+func (mr *MockPostRepositoryMockRecorder) FindByIDs(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByIDs", reflect.TypeOf((*MockPostRepository)(nil).FindByIDs), arg0, arg1)
+}
+
+// FindByIDsWithAuthor mocks base method.
+func (m *MockPostRepository) FindByIDsWithAuthor(arg0 context.Context, arg1 []uuid.UUID) ([]models.Post, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindByIDsWithAuthor", arg0, arg1)
+	ret0, _ := ret[0].([]models.Post)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindByIDsWithAuthor indicates an expected call of FindByIDsWithAuthor.
+func (mr *MockPostRepositoryMockRecorder) FindByIDsWithAuthor(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByIDsWithAuthor", reflect.TypeOf((*MockPostRepository)(nil).FindByIDsWithAuthor), arg0, arg1)
+}
+
+// FindByImportKey mocks base method.
+func (m *MockPostRepository) FindByImportKey(arg0 context.Context, arg1 string) (*models.Post, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindByImportKey", arg0, arg1)
+	ret0, _ := ret[0].(*models.Post)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindByImportKey indicates an expected call of FindByImportKey.
+func (mr *MockPostRepositoryMockRecorder) FindByImportKey(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByImportKey", reflect.TypeOf((*MockPostRepository)(nil).FindByImportKey), arg0, arg1)
+}
+
+// FindBySlug mocks base method.
+func (m *MockPostRepository) FindBySlug(arg0 context.Context, arg1 string) (*models.Post, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindBySlug", arg0, arg1)
+	ret0, _ := ret[0].(*models.Post)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindBySlug indicates an expected call of FindBySlug.
+func (mr *MockPostRepositoryMockRecorder) FindBySlug(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindBySlug", reflect.TypeOf((*MockPostRepository)(nil).FindBySlug), arg0, arg1)
+}
+
+// FindByStatus mocks base method.
+func (m *MockPostRepository) FindByStatus(arg0 context.Context, arg1 models.PostStatus, arg2, arg3 int) ([]models.Post, int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindByStatus", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].([]models.Post)
+	ret1, _ := ret[1].(int64)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// FindByStatus indicates an expected call of FindByStatus.
+func (mr *MockPostRepositoryMockRecorder) FindByStatus(arg0, arg1, arg2, arg3 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByStatus", reflect.TypeOf((*MockPostRepository)(nil).FindByStatus), arg0, arg1, arg2, arg3)
+}
+
+// FindByTag mocks base method.
+func (m *MockPostRepository) FindByTag(arg0 context.Context, arg1 string, arg2, arg3 int) ([]models.Post, int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindByTag", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].([]models.Post)
+	ret1, _ := ret[1].(int64)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// FindByTag indicates an expected call of FindByTag.
+func (mr *MockPostRepositoryMockRecorder) FindByTag(arg0, arg1, arg2, arg3 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByTag", reflect.TypeOf((*MockPostRepository)(nil).FindByTag), arg0, arg1, arg2, arg3)
+}
+
+// FindByTagID mocks base method.
+func (m *MockPostRepository) FindByTagID(arg0 context.Context, arg1 uuid.UUID, arg2, arg3 bool, arg4, arg5 int) ([]models.Post, int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindByTagID", arg0, arg1, arg2, arg3, arg4, arg5)
+	ret0, _ := ret[0].([]models.Post)
+	ret1, _ := ret[1].(int64)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// FindByTagID indicates an expected call of FindByTagID.
+func (mr *MockPostRepositoryMockRecorder) FindByTagID(arg0, arg1, arg2, arg3, arg4, arg5 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByTagID", reflect.TypeOf((*MockPostRepository)(nil).FindByTagID), arg0, arg1, arg2, arg3, arg4, arg5)
+}
+
+// FindByUserID mocks base method.
+func (m *MockPostRepository) FindByUserID(arg0 context.Context, arg1 uuid.UUID, arg2, arg3 int) ([]models.Post, int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindByUserID", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].([]models.Post)
+	ret1, _ := ret[1].(int64)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// FindByUserID indicates an expected call of FindByUserID.
+func (mr *MockPostRepositoryMockRecorder) FindByUserID(arg0, arg1, arg2, arg3 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByUserID", reflect.TypeOf((*MockPostRepository)(nil).FindByUserID), arg0, arg1, arg2, arg3)
+}
+
+// FindFeatured mocks base method.
+func (m *MockPostRepository) FindFeatured(arg0 context.Context, arg1 bool, arg2, arg3 int) ([]models.Post, int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindFeatured", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].([]models.Post)
+	ret1, _ := ret[1].(int64)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// FindFeatured indicates an expected call of FindFeatured.
+func (mr *MockPostRepositoryMockRecorder) FindFeatured(arg0, arg1, arg2, arg3 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindFeatured", reflect.TypeOf((*MockPostRepository)(nil).FindFeatured), arg0, arg1, arg2, arg3)
+}
+
+// FindInBatchesWithAuthor mocks base method.
+func (m *MockPostRepository) FindInBatchesWithAuthor(arg0 context.Context, arg1 *uuid.UUID, arg2 int, arg3 func([]models.Post) error) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindInBatchesWithAuthor", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// FindInBatchesWithAuthor indicates an expected call of FindInBatchesWithAuthor.
+func (mr *MockPostRepositoryMockRecorder) FindInBatchesWithAuthor(arg0, arg1, arg2, arg3 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindInBatchesWithAuthor", reflect.TypeOf((*MockPostRepository)(nil).FindInBatchesWithAuthor), arg0, arg1, arg2, arg3)
+}
+
+// FindMissingAltText mocks base method.
+func (m *MockPostRepository) FindMissingAltText(arg0 context.Context, arg1 uuid.UUID, arg2, arg3 int) ([]models.Post, int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindMissingAltText", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].([]models.Post)
+	ret1, _ := ret[1].(int64)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// FindMissingAltText indicates an expected call of FindMissingAltText.
+func (mr *MockPostRepositoryMockRecorder) FindMissingAltText(arg0, arg1, arg2, arg3 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindMissingAltText", reflect.TypeOf((*MockPostRepository)(nil).FindMissingAltText), arg0, arg1, arg2, arg3)
+}
+
+// FindProfilePins mocks base method.
+func (m *MockPostRepository) FindProfilePins(arg0 context.Context, arg1 uuid.UUID) ([]models.Post, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindProfilePins", arg0, arg1)
+	ret0, _ := ret[0].([]models.Post)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindProfilePins indicates an expected call of FindProfilePins.
+func (mr *MockPostRepositoryMockRecorder) FindProfilePins(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindProfilePins", reflect.TypeOf((*MockPostRepository)(nil).FindProfilePins), arg0, arg1)
+}
+
+// FindPublicInBatches mocks base method.
+func (m *MockPostRepository) FindPublicInBatches(arg0 context.Context, arg1 int, arg2 func([]models.Post) error) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindPublicInBatches", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// FindPublicInBatches indicates an expected call of FindPublicInBatches.
+func (mr *MockPostRepositoryMockRecorder) FindPublicInBatches(arg0, arg1, arg2 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindPublicInBatches", reflect.TypeOf((*MockPostRepository)(nil).FindPublicInBatches), arg0, arg1, arg2)
+}
+
+// FindPublished mocks base method.
+func (m *MockPostRepository) FindPublished(arg0 context.Context, arg1 bool, arg2, arg3 int) ([]models.Post, int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindPublished", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].([]models.Post)
+	ret1, _ := ret[1].(int64)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// FindPublished indicates an expected call of FindPublished.
+func (mr *MockPostRepositoryMockRecorder) FindPublished(arg0, arg1, arg2, arg3 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindPublished", reflect.TypeOf((*MockPostRepository)(nil).FindPublished), arg0, arg1, arg2, arg3)
+}
+
+// FindRecentPublishedByUser mocks base method.
+func (m *MockPostRepository) FindRecentPublishedByUser(arg0 context.Context, arg1 uuid.UUID, arg2 bool, arg3 int) ([]models.Post, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindRecentPublishedByUser", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].([]models.Post)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindRecentPublishedByUser indicates an expected call of FindRecentPublishedByUser.
+func (mr *MockPostRepositoryMockRecorder) FindRecentPublishedByUser(arg0, arg1, arg2, arg3 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindRecentPublishedByUser", reflect.TypeOf((*MockPostRepository)(nil).FindRecentPublishedByUser), arg0, arg1, arg2, arg3)
+}
+
+// FindTagsByPostIDs mocks base method.
+func (m *MockPostRepository) FindTagsByPostIDs(arg0 context.Context, arg1 []uuid.UUID) (map[uuid.UUID][]models.Tag, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindTagsByPostIDs", arg0, arg1)
+	ret0, _ := ret[0].(map[uuid.UUID][]models.Tag)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindTagsByPostIDs indicates an expected call of FindTagsByPostIDs.
+func (mr *MockPostRepositoryMockRecorder) FindTagsByPostIDs(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindTagsByPostIDs", reflect.TypeOf((*MockPostRepository)(nil).FindTagsByPostIDs), arg0, arg1)
+}
+
+// FindTakenDown mocks base method.
+func (m *MockPostRepository) FindTakenDown(arg0 context.Context, arg1 bool, arg2, arg3 int) ([]models.Post, int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindTakenDown", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].([]models.Post)
+	ret1, _ := ret[1].(int64)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// FindTakenDown indicates an expected call of FindTakenDown.
+func (mr *MockPostRepositoryMockRecorder) FindTakenDown(arg0, arg1, arg2, arg3 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindTakenDown", reflect.TypeOf((*MockPostRepository)(nil).FindTakenDown), arg0, arg1, arg2, arg3)
+}
+
+// FindWithAuthor mocks base method.
+func (m *MockPostRepository) FindWithAuthor(arg0 context.Context, arg1 uuid.UUID) (*models.Post, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindWithAuthor", arg0, arg1)
+	ret0, _ := ret[0].(*models.Post)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindWithAuthor indicates an expected call of FindWithAuthor.
+func (mr *MockPostRepositoryMockRecorder) FindWithAuthor(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindWithAuthor", reflect.TypeOf((*MockPostRepository)(nil).FindWithAuthor), arg0, arg1)
+}
+
+// ReassignAuthor mocks base method.
+func (m *MockPostRepository) ReassignAuthor(arg0 context.Context, arg1, arg2 uuid.UUID) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReassignAuthor", arg0, arg1, arg2)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ReassignAuthor indicates an expected call of ReassignAuthor.
+func (mr *MockPostRepositoryMockRecorder) ReassignAuthor(arg0, arg1, arg2 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReassignAuthor", reflect.TypeOf((*MockPostRepository)(nil).ReassignAuthor), arg0, arg1, arg2)
+}
+
+// RemoveTag mocks base method.
+func (m *MockPostRepository) RemoveTag(arg0 context.Context, arg1, arg2 uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RemoveTag", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RemoveTag indicates an expected call of RemoveTag.
+func (mr *MockPostRepositoryMockRecorder) RemoveTag(arg0, arg1, arg2 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveTag", reflect.TypeOf((*MockPostRepository)(nil).RemoveTag), arg0, arg1, arg2)
+}
+
+// ReplaceTags mocks base method.
+func (m *MockPostRepository) ReplaceTags(arg0 context.Context, arg1 uuid.UUID, arg2 []models.Tag) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReplaceTags", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ReplaceTags indicates an expected call of ReplaceTags.
+func (mr *MockPostRepositoryMockRecorder) ReplaceTags(arg0, arg1, arg2 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReplaceTags", reflect.TypeOf((*MockPostRepository)(nil).ReplaceTags), arg0, arg1, arg2)
+}
+
+// SearchPosts mocks base method.
+func (m *MockPostRepository) SearchPosts(arg0 context.Context, arg1 string, arg2 *uuid.UUID, arg3 bool, arg4, arg5 int) ([]models.Post, int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SearchPosts", arg0, arg1, arg2, arg3, arg4, arg5)
+	ret0, _ := ret[0].([]models.Post)
+	ret1, _ := ret[1].(int64)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// SearchPosts indicates an expected call of SearchPosts.
+func (mr *MockPostRepositoryMockRecorder) SearchPosts(arg0, arg1, arg2, arg3, arg4, arg5 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SearchPosts", reflect.TypeOf((*MockPostRepository)(nil).SearchPosts), arg0, arg1, arg2, arg3, arg4, arg5)
+}
+
+// SetProfilePins mocks base method.
+func (m *MockPostRepository) SetProfilePins(arg0 context.Context, arg1 uuid.UUID, arg2 []uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetProfilePins", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetProfilePins indicates an expected call of SetProfilePins.
+func (mr *MockPostRepositoryMockRecorder) SetProfilePins(arg0, arg1, arg2 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetProfilePins", reflect.TypeOf((*MockPostRepository)(nil).SetProfilePins), arg0, arg1, arg2)
+}
+
+// Update mocks base method.
+func (m *MockPostRepository) Update(arg0 context.Context, arg1 *models.Post) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Update", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Update indicates an expected call of Update.
+func (mr *MockPostRepositoryMockRecorder) Update(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockPostRepository)(nil).Update), arg0, arg1)
+}
+
+// UpdateWithSlug mocks base method.
+func (m *MockPostRepository) UpdateWithSlug(arg0 context.Context, arg1 *models.Post, arg2 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateWithSlug", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateWithSlug indicates an expected call of UpdateWithSlug.
+func (mr *MockPostRepositoryMockRecorder) UpdateWithSlug(arg0, arg1, arg2 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateWithSlug", reflect.TypeOf((*MockPostRepository)(nil).UpdateWithSlug), arg0, arg1, arg2)
+}
+
+// Upsert mocks base method.
+func (m *MockPostRepository) Upsert(arg0 context.Context, arg1 *models.Post) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Upsert", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Upsert indicates an expected call of Upsert.
+func (mr *MockPostRepositoryMockRecorder) Upsert(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Upsert", reflect.TypeOf((*MockPostRepository)(nil).Upsert), arg0, arg1)
+}
+
+// MockReadKeyRepository is a mock of ReadKeyRepository interface.
+type MockReadKeyRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockReadKeyRepositoryMockRecorder
+}
+
+// MockReadKeyRepositoryMockRecorder is the mock recorder for MockReadKeyRepository.
+type MockReadKeyRepositoryMockRecorder struct {
+	mock *MockReadKeyRepository
+}
+
+// NewMockReadKeyRepository creates a new mock instance.
+func NewMockReadKeyRepository(ctrl *gomock.Controller) *MockReadKeyRepository {
+	mock := &MockReadKeyRepository{ctrl: ctrl}
+	mock.recorder = &MockReadKeyRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockReadKeyRepository) EXPECT() *MockReadKeyRepositoryMockRecorder {
+	return m.recorder
+}
+
+// AddUsage mocks base method.
+func (m *MockReadKeyRepository) AddUsage(arg0 context.Context, arg1 uuid.UUID, arg2 string, arg3, arg4 int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddUsage", arg0, arg1, arg2, arg3, arg4)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// AddUsage indicates an expected call of AddUsage.
+func (mr *MockReadKeyRepositoryMockRecorder) AddUsage(arg0, arg1, arg2, arg3, arg4 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddUsage", reflect.TypeOf((*MockReadKeyRepository)(nil).AddUsage), arg0, arg1, arg2, arg3, arg4)
+}
+
+// Count mocks base method.
+func (m *MockReadKeyRepository) Count(arg0 context.Context) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Count", arg0)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Count indicates an expected call of Count.
+func (mr *MockReadKeyRepositoryMockRecorder) Count(arg0 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Count", reflect.TypeOf((*MockReadKeyRepository)(nil).Count), arg0)
+}
+
+// Create mocks base method.
+func (m *MockReadKeyRepository) Create(arg0 context.Context, arg1 *models.ReadKey) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockReadKeyRepositoryMockRecorder) Create(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockReadKeyRepository)(nil).Create), arg0, arg1)
+}
+
+// CreateInBatches mocks base method.
+func (m *MockReadKeyRepository) CreateInBatches(arg0 context.Context, arg1 []models.ReadKey, arg2 int) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateInBatches", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreateInBatches indicates an expected call of CreateInBatches.
+func (mr *MockReadKeyRepositoryMockRecorder) CreateInBatches(arg0, arg1, arg2 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateInBatches", reflect.TypeOf((*MockReadKeyRepository)(nil).CreateInBatches), arg0, arg1, arg2)
+}
+
+// Delete mocks base method.
+func (m *MockReadKeyRepository) Delete(arg0 context.Context, arg1 uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Delete", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Delete indicates an expected call of Delete.
+func (mr *MockReadKeyRepositoryMockRecorder) Delete(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockReadKeyRepository)(nil).Delete), arg0, arg1)
+}
+
+// FindAll mocks base method.
+func (m *MockReadKeyRepository) FindAll(arg0 context.Context, arg1, arg2 int) ([]models.ReadKey, int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindAll", arg0, arg1, arg2)
+	ret0, _ := ret[0].([]models.ReadKey)
+	ret1, _ := ret[1].(int64)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// FindAll indicates an expected call of FindAll.
+func (mr *MockReadKeyRepositoryMockRecorder) FindAll(arg0, arg1, arg2 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindAll", reflect.TypeOf((*MockReadKeyRepository)(nil).FindAll), arg0, arg1, arg2)
+}
+
+// FindByHash mocks base method.
+func (m *MockReadKeyRepository) FindByHash(arg0 context.Context, arg1 string) (*models.ReadKey, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindByHash", arg0, arg1)
+	ret0, _ := ret[0].(*models.ReadKey)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindByHash indicates an expected call of FindByHash.
+func (mr *MockReadKeyRepositoryMockRecorder) FindByHash(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByHash", reflect.TypeOf((*MockReadKeyRepository)(nil).FindByHash), arg0, arg1)
+}
+
+// FindByID mocks base method.
+func (m *MockReadKeyRepository) FindByID(arg0 context.Context, arg1 uuid.UUID) (*models.ReadKey, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindByID", arg0, arg1)
+	ret0, _ := ret[0].(*models.ReadKey)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindByID indicates an expected call of FindByID.
+func (mr *MockReadKeyRepositoryMockRecorder) FindByID(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByID", reflect.TypeOf((*MockReadKeyRepository)(nil).FindByID), arg0, arg1)
+}
+
+// FindByIDs mocks base method.
+func (m *MockReadKeyRepository) FindByIDs(arg0 context.Context, arg1 []uuid.UUID) ([]models.ReadKey, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindByIDs", arg0, arg1)
+	ret0, _ := ret[0].([]models.ReadKey)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindByIDs indicates an expected call of FindByIDs.
+func (mr *MockReadKeyRepositoryMockRecorder) FindByIDs(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByIDs", reflect.TypeOf((*MockReadKeyRepository)(nil).FindByIDs), arg0, arg1)
+}
+
+// FindDailyUsage mocks base method.
+func (m *MockReadKeyRepository) FindDailyUsage(arg0 context.Context, arg1 uuid.UUID, arg2, arg3 string) ([]models.ReadKeyDailyUsage, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindDailyUsage", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].([]models.ReadKeyDailyUsage)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindDailyUsage indicates an expected call of FindDailyUsage.
+func (mr *MockReadKeyRepositoryMockRecorder) FindDailyUsage(arg0, arg1, arg2, arg3 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindDailyUsage", reflect.TypeOf((*MockReadKeyRepository)(nil).FindDailyUsage), arg0, arg1, arg2, arg3)
+}
+
+// FindReadKeys mocks base method.
+func (m *MockReadKeyRepository) FindReadKeys(arg0 context.Context, arg1 models.ReadKeyTier, arg2, arg3 int) ([]models.ReadKey, int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindReadKeys", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].([]models.ReadKey)
+	ret1, _ := ret[1].(int64)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// FindReadKeys indicates an expected call of FindReadKeys.
+func (mr *MockReadKeyRepositoryMockRecorder) FindReadKeys(arg0, arg1, arg2, arg3 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindReadKeys", reflect.TypeOf((*MockReadKeyRepository)(nil).FindReadKeys), arg0, arg1, arg2, arg3)
+}
+
+// Revoke mocks base method.
+func (m *MockReadKeyRepository) Revoke(arg0 context.Context, arg1 uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Revoke", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Revoke indicates an expected call of Revoke.
+func (mr *MockReadKeyRepositoryMockRecorder) Revoke(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Revoke", reflect.TypeOf((*MockReadKeyRepository)(nil).Revoke), arg0, arg1)
+}
+
+// Update mocks base method.
+func (m *MockReadKeyRepository) Update(arg0 context.Context, arg1 *models.ReadKey) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Update", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Update indicates an expected call of Update.
+func (mr *MockReadKeyRepositoryMockRecorder) Update(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockReadKeyRepository)(nil).Update), arg0, arg1)
+}
+
+// UpdateLastUsed mocks base method.
+func (m *MockReadKeyRepository) UpdateLastUsed(arg0 context.Context, arg1 uuid.UUID, arg2 time.Time) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateLastUsed", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateLastUsed indicates an expected call of UpdateLastUsed.
+func (mr *MockReadKeyRepositoryMockRecorder) UpdateLastUsed(arg0, arg1, arg2 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateLastUsed", reflect.TypeOf((*MockReadKeyRepository)(nil).UpdateLastUsed), arg0, arg1, arg2)
+}
+
+// Upsert mocks base method.
+func (m *MockReadKeyRepository) Upsert(arg0 context.Context, arg1 *models.ReadKey) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Upsert", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Upsert indicates an expected call of Upsert.
+func (mr *MockReadKeyRepositoryMockRecorder) Upsert(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Upsert", reflect.TypeOf((*MockReadKeyRepository)(nil).Upsert), arg0, arg1)
+}
+
+// MockSiteRepository is a mock of SiteRepository interface.
+type MockSiteRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockSiteRepositoryMockRecorder
+}
+
+// MockSiteRepositoryMockRecorder is the mock recorder for MockSiteRepository.
+type MockSiteRepositoryMockRecorder struct {
+	mock *MockSiteRepository
+}
+
+// NewMockSiteRepository creates a new mock instance.
+func NewMockSiteRepository(ctrl *gomock.Controller) *MockSiteRepository {
+	mock := &MockSiteRepository{ctrl: ctrl}
+	mock.recorder = &MockSiteRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockSiteRepository) EXPECT() *MockSiteRepositoryMockRecorder {
+	return m.recorder
+}
+
+// EachComment mocks base method.
+func (m *MockSiteRepository) EachComment(arg0 context.Context, arg1 int, arg2 func([]models.Comment) error) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "EachComment", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// EachComment indicates an expected call of EachComment.
+func (mr *MockSiteRepositoryMockRecorder) EachComment(arg0, arg1, arg2 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EachComment", reflect.TypeOf((*MockSiteRepository)(nil).EachComment), arg0, arg1, arg2)
+}
+
+// EachFollow mocks base method.
+func (m *MockSiteRepository) EachFollow(arg0 context.Context, arg1 int, arg2 func([]models.UserFollow) error) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "EachFollow", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// EachFollow indicates an expected call of EachFollow.
+func (mr *MockSiteRepositoryMockRecorder) EachFollow(arg0, arg1, arg2 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EachFollow", reflect.TypeOf((*MockSiteRepository)(nil).EachFollow), arg0, arg1, arg2)
+}
+
+// EachPost mocks base method.
+func (m *MockSiteRepository) EachPost(arg0 context.Context, arg1 int, arg2 func([]models.Post) error) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "EachPost", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// EachPost indicates an expected call of EachPost.
+func (mr *MockSiteRepositoryMockRecorder) EachPost(arg0, arg1, arg2 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EachPost", reflect.TypeOf((*MockSiteRepository)(nil).EachPost), arg0, arg1, arg2)
+}
+
+// EachSlugRedirect mocks base method.
+func (m *MockSiteRepository) EachSlugRedirect(arg0 context.Context, arg1 int, arg2 func([]models.SlugRedirect) error) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "EachSlugRedirect", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// EachSlugRedirect indicates an expected call of EachSlugRedirect.
+func (mr *MockSiteRepositoryMockRecorder) EachSlugRedirect(arg0, arg1, arg2 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EachSlugRedirect", reflect.TypeOf((*MockSiteRepository)(nil).EachSlugRedirect), arg0, arg1, arg2)
+}
+
+// EachTag mocks base method.
+func (m *MockSiteRepository) EachTag(arg0 context.Context, arg1 int, arg2 func([]models.Tag) error) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "EachTag", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// EachTag indicates an expected call of EachTag.
+func (mr *MockSiteRepositoryMockRecorder) EachTag(arg0, arg1, arg2 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EachTag", reflect.TypeOf((*MockSiteRepository)(nil).EachTag), arg0, arg1, arg2)
+}
+
+// EachTagAlias mocks base method.
+func (m *MockSiteRepository) EachTagAlias(arg0 context.Context, arg1 int, arg2 func([]models.TagAlias) error) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "EachTagAlias", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// EachTagAlias indicates an expected call of EachTagAlias.
+func (mr *MockSiteRepositoryMockRecorder) EachTagAlias(arg0, arg1, arg2 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EachTagAlias", reflect.TypeOf((*MockSiteRepository)(nil).EachTagAlias), arg0, arg1, arg2)
+}
+
+// EachUser mocks base method.
+func (m *MockSiteRepository) EachUser(arg0 context.Context, arg1 int, arg2 func([]models.User) error) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "EachUser", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// EachUser indicates an expected call of EachUser.
+func (mr *MockSiteRepositoryMockRecorder) EachUser(arg0, arg1, arg2 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EachUser", reflect.TypeOf((*MockSiteRepository)(nil).EachUser), arg0, arg1, arg2)
+}
+
+// FindUserIDsByEmail mocks base method.
+func (m *MockSiteRepository) FindUserIDsByEmail(arg0 context.Context) (map[string]uuid.UUID, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindUserIDsByEmail", arg0)
+	ret0, _ := ret[0].(map[string]uuid.UUID)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindUserIDsByEmail indicates an expected call of FindUserIDsByEmail.
+func (mr *MockSiteRepositoryMockRecorder) FindUserIDsByEmail(arg0 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindUserIDsByEmail", reflect.TypeOf((*MockSiteRepository)(nil).FindUserIDsByEmail), arg0)
+}
+
+// HasContent mocks base method.
+func (m *MockSiteRepository) HasContent(arg0 context.Context) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "HasContent", arg0)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// HasContent indicates an expected call of HasContent.
+func (mr *MockSiteRepositoryMockRecorder) HasContent(arg0 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "HasContent", reflect.TypeOf((*MockSiteRepository)(nil).HasContent), arg0)
+}
+
+// Import mocks base method.
+func (m *MockSiteRepository) Import(arg0 context.Context, arg1 func(repository.SiteRepository) error) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Import", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Import indicates an expected call of Import.
+func (mr *MockSiteRepositoryMockRecorder) Import(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Import", reflect.TypeOf((*MockSiteRepository)(nil).Import), arg0, arg1)
+}
+
+// Insert mocks base method.
+func (m *MockSiteRepository) Insert(arg0 context.Context, arg1 any) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Insert", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Insert indicates an expected call of Insert.
+func (mr *MockSiteRepositoryMockRecorder) Insert(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Insert", reflect.TypeOf((*MockSiteRepository)(nil).Insert), arg0, arg1)
+}
+
+// InsertPostTags mocks base method.
+func (m *MockSiteRepository) InsertPostTags(arg0 context.Context, arg1 uuid.UUID, arg2 []uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "InsertPostTags", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// InsertPostTags indicates an expected call of InsertPostTags.
+func (mr *MockSiteRepositoryMockRecorder) InsertPostTags(arg0, arg1, arg2 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InsertPostTags", reflect.TypeOf((*MockSiteRepository)(nil).InsertPostTags), arg0, arg1, arg2)
+}
+
+// MockSlugRedirectRepository is a mock of SlugRedirectRepository interface.
+type MockSlugRedirectRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockSlugRedirectRepositoryMockRecorder
+}
+
+// MockSlugRedirectRepositoryMockRecorder is the mock recorder for MockSlugRedirectRepository.
+type MockSlugRedirectRepositoryMockRecorder struct {
+	mock *MockSlugRedirectRepository
+}
+
+// NewMockSlugRedirectRepository creates a new mock instance.
+func NewMockSlugRedirectRepository(ctrl *gomock.Controller) *MockSlugRedirectRepository {
+	mock := &MockSlugRedirectRepository{ctrl: ctrl}
+	mock.recorder = &MockSlugRedirectRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockSlugRedirectRepository) EXPECT() *MockSlugRedirectRepositoryMockRecorder {
+	return m.recorder
+}
+
+// Count mocks base method.
+func (m *MockSlugRedirectRepository) Count(arg0 context.Context) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Count", arg0)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Count indicates an expected call of Count.
+func (mr *MockSlugRedirectRepositoryMockRecorder) Count(arg0 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Count", reflect.TypeOf((*MockSlugRedirectRepository)(nil).Count), arg0)
+}
+
+// Create mocks base method.
+func (m *MockSlugRedirectRepository) Create(arg0 context.Context, arg1 *models.SlugRedirect) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockSlugRedirectRepositoryMockRecorder) Create(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockSlugRedirectRepository)(nil).Create), arg0, arg1)
+}
+
+// CreateInBatches mocks base method.
+func (m *MockSlugRedirectRepository) CreateInBatches(arg0 context.Context, arg1 []models.SlugRedirect, arg2 int) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateInBatches", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreateInBatches indicates an expected call of CreateInBatches.
+func (mr *MockSlugRedirectRepositoryMockRecorder) CreateInBatches(arg0, arg1, arg2 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateInBatches", reflect.TypeOf((*MockSlugRedirectRepository)(nil).CreateInBatches), arg0, arg1, arg2)
+}
+
+// Delete mocks base method.
+func (m *MockSlugRedirectRepository) Delete(arg0 context.Context, arg1 uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Delete", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Delete indicates an expected call of Delete.
+func (mr *MockSlugRedirectRepositoryMockRecorder) Delete(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockSlugRedirectRepository)(nil).Delete), arg0, arg1)
+}
+
+// DeleteByOldSlug mocks base method.
+func (m *MockSlugRedirectRepository) DeleteByOldSlug(arg0 context.Context, arg1 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteByOldSlug", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteByOldSlug indicates an expected call of DeleteByOldSlug.
+func (mr *MockSlugRedirectRepositoryMockRecorder) DeleteByOldSlug(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteByOldSlug", reflect.TypeOf((*MockSlugRedirectRepository)(nil).DeleteByOldSlug), arg0, arg1)
+}
+
+// FindAll mocks base method.
+func (m *MockSlugRedirectRepository) FindAll(arg0 context.Context, arg1, arg2 int) ([]models.SlugRedirect, int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindAll", arg0, arg1, arg2)
+	ret0, _ := ret[0].([]models.SlugRedirect)
+	ret1, _ := ret[1].(int64)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// FindAll indicates an expected call of FindAll.
+func (mr *MockSlugRedirectRepositoryMockRecorder) FindAll(arg0, arg1, arg2 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindAll", reflect.TypeOf((*MockSlugRedirectRepository)(nil).FindAll), arg0, arg1, arg2)
+}
+
+// FindByID mocks base method.
+func (m *MockSlugRedirectRepository) FindByID(arg0 context.Context, arg1 uuid.UUID) (*models.SlugRedirect, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindByID", arg0, arg1)
+	ret0, _ := ret[0].(*models.SlugRedirect)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindByID indicates an expected call of FindByID.
+func (mr *MockSlugRedirectRepositoryMockRecorder) FindByID(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByID", reflect.TypeOf((*MockSlugRedirectRepository)(nil).FindByID), arg0, arg1)
+}
+
+// FindByIDs mocks base method.
+func (m *MockSlugRedirectRepository) FindByIDs(arg0 context.Context, arg1 []uuid.UUID) ([]models.SlugRedirect, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindByIDs", arg0, arg1)
+	ret0, _ := ret[0].([]models.SlugRedirect)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindByIDs indicates an expected call of FindByIDs.
+func (mr *MockSlugRedirectRepositoryMockRecorder) FindByIDs(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByIDs", reflect.TypeOf((*MockSlugRedirectRepository)(nil).FindByIDs), arg0, arg1)
+}
+
+// FindByOldSlug mocks base method.
+func (m *MockSlugRedirectRepository) FindByOldSlug(arg0 context.Context, arg1 string) (*models.SlugRedirect, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindByOldSlug", arg0, arg1)
+	ret0, _ := ret[0].(*models.SlugRedirect)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindByOldSlug indicates an expected call of FindByOldSlug.
+func (mr *MockSlugRedirectRepositoryMockRecorder) FindByOldSlug(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByOldSlug", reflect.TypeOf((*MockSlugRedirectRepository)(nil).FindByOldSlug), arg0, arg1)
+}
+
+// Record mocks base method.
+func (m *MockSlugRedirectRepository) Record(arg0 context.Context, arg1 string, arg2 uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Record", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Record indicates an expected call of Record.
+func (mr *MockSlugRedirectRepositoryMockRecorder) Record(arg0, arg1, arg2 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Record", reflect.TypeOf((*MockSlugRedirectRepository)(nil).Record), arg0, arg1, arg2)
+}
+
+// Update mocks base method.
+func (m *MockSlugRedirectRepository) Update(arg0 context.Context, arg1 *models.SlugRedirect) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Update", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Update indicates an expected call of Update.
+func (mr *MockSlugRedirectRepositoryMockRecorder) Update(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockSlugRedirectRepository)(nil).Update), arg0, arg1)
+}
+
+// Upsert mocks base method.
+func (m *MockSlugRedirectRepository) Upsert(arg0 context.Context, arg1 *models.SlugRedirect) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Upsert", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Upsert indicates an expected call of Upsert.
+func (mr *MockSlugRedirectRepositoryMockRecorder) Upsert(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Upsert", reflect.TypeOf((*MockSlugRedirectRepository)(nil).Upsert), arg0, arg1)
+}
+
+// MockTagRepository is a mock of TagRepository interface.
+type MockTagRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockTagRepositoryMockRecorder
+}
+
+// MockTagRepositoryMockRecorder is the mock recorder for MockTagRepository.
+type MockTagRepositoryMockRecorder struct {
+	mock *MockTagRepository
+}
+
+// NewMockTagRepository creates a new mock instance.
+func NewMockTagRepository(ctrl *gomock.Controller) *MockTagRepository {
+	mock := &MockTagRepository{ctrl: ctrl}
+	mock.recorder = &MockTagRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockTagRepository) EXPECT() *MockTagRepositoryMockRecorder {
+	return m.recorder
+}
+
+// Count mocks base method.
+func (m *MockTagRepository) Count(arg0 context.Context) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Count", arg0)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Count indicates an expected call of Count.
+func (mr *MockTagRepositoryMockRecorder) Count(arg0 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Count", reflect.TypeOf((*MockTagRepository)(nil).Count), arg0)
+}
+
+// Create mocks base method.
+func (m *MockTagRepository) Create(arg0 context.Context, arg1 *models.Tag) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockTagRepositoryMockRecorder) Create(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockTagRepository)(nil).Create), arg0, arg1)
+}
+
+// CreateAlias mocks base method.
+func (m *MockTagRepository) CreateAlias(arg0 context.Context, arg1 *models.TagAlias, arg2 *uuid.UUID) ([]uuid.UUID, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateAlias", arg0, arg1, arg2)
+	ret0, _ := ret[0].([]uuid.UUID)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateAlias indicates an expected call of CreateAlias.
+func (mr *MockTagRepositoryMockRecorder) CreateAlias(arg0, arg1, arg2 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateAlias", reflect.TypeOf((*MockTagRepository)(nil).CreateAlias), arg0, arg1, arg2)
+}
+
+// CreateInBatches mocks base method.
+func (m *MockTagRepository) CreateInBatches(arg0 context.Context, arg1 []models.Tag, arg2 int) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateInBatches", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreateInBatches indicates an expected call of CreateInBatches.
+func (mr *MockTagRepositoryMockRecorder) CreateInBatches(arg0, arg1, arg2 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateInBatches", reflect.TypeOf((*MockTagRepository)(nil).CreateInBatches), arg0, arg1, arg2)
+}
+
+// Delete mocks base method.
+func (m *MockTagRepository) Delete(arg0 context.Context, arg1 uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Delete", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Delete indicates an expected call of Delete.
+func (mr *MockTagRepositoryMockRecorder) Delete(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockTagRepository)(nil).Delete), arg0, arg1)
+}
+
+// DeleteAlias mocks base method.
+func (m *MockTagRepository) DeleteAlias(arg0 context.Context, arg1 uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteAlias", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteAlias indicates an expected call of DeleteAlias.
+func (mr *MockTagRepositoryMockRecorder) DeleteAlias(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteAlias", reflect.TypeOf((*MockTagRepository)(nil).DeleteAlias), arg0, arg1)
+}
+
+// FindAliasBySlug mocks base method.
+func (m *MockTagRepository) FindAliasBySlug(arg0 context.Context, arg1 string) (*models.TagAlias, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindAliasBySlug", arg0, arg1)
+	ret0, _ := ret[0].(*models.TagAlias)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindAliasBySlug indicates an expected call of FindAliasBySlug.
+func (mr *MockTagRepositoryMockRecorder) FindAliasBySlug(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindAliasBySlug", reflect.TypeOf((*MockTagRepository)(nil).FindAliasBySlug), arg0, arg1)
+}
+
+// FindAll mocks base method.
+func (m *MockTagRepository) FindAll(arg0 context.Context, arg1, arg2 int) ([]models.Tag, int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindAll", arg0, arg1, arg2)
+	ret0, _ := ret[0].([]models.Tag)
+	ret1, _ := ret[1].(int64)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// FindAll indicates an expected call of FindAll.
+func (mr *MockTagRepositoryMockRecorder) FindAll(arg0, arg1, arg2 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindAll", reflect.TypeOf((*MockTagRepository)(nil).FindAll), arg0, arg1, arg2)
+}
+
+// FindByID mocks base method.
+func (m *MockTagRepository) FindByID(arg0 context.Context, arg1 uuid.UUID) (*models.Tag, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindByID", arg0, arg1)
+	ret0, _ := ret[0].(*models.Tag)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindByID indicates an expected call of FindByID.
+func (mr *MockTagRepositoryMockRecorder) FindByID(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByID", reflect.TypeOf((*MockTagRepository)(nil).FindByID), arg0, arg1)
+}
+
+// FindByIDs mocks base method.
+func (m *MockTagRepository) FindByIDs(arg0 context.Context, arg1 []uuid.UUID) ([]models.Tag, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindByIDs", arg0, arg1)
+	ret0, _ := ret[0].([]models.Tag)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindByIDs indicates an expected call of FindByIDs.
+func (mr *MockTagRepositoryMockRecorder) FindByIDs(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByIDs", reflect.TypeOf((*MockTagRepository)(nil).FindByIDs), arg0, arg1)
+}
+
+// FindBySlug mocks base method.
+func (m *MockTagRepository) FindBySlug(arg0 context.Context, arg1 string) (*models.Tag, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindBySlug", arg0, arg1)
+	ret0, _ := ret[0].(*models.Tag)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindBySlug indicates an expected call of FindBySlug.
+func (mr *MockTagRepositoryMockRecorder) FindBySlug(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindBySlug", reflect.TypeOf((*MockTagRepository)(nil).FindBySlug), arg0, arg1)
+}
+
+// FindOrCreate mocks base method.
+func (m *MockTagRepository) FindOrCreate(arg0 context.Context, arg1, arg2 string) (*models.Tag, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindOrCreate", arg0, arg1, arg2)
+	ret0, _ := ret[0].(*models.Tag)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindOrCreate indicates an expected call of FindOrCreate.
+func (mr *MockTagRepositoryMockRecorder) FindOrCreate(arg0, arg1, arg2 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindOrCreate", reflect.TypeOf((*MockTagRepository)(nil).FindOrCreate), arg0, arg1, arg2)
+}
+
+// FindWithAliases mocks base method.
+func (m *MockTagRepository) FindWithAliases(arg0 context.Context, arg1 uuid.UUID) (*models.Tag, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindWithAliases", arg0, arg1)
+	ret0, _ := ret[0].(*models.Tag)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindWithAliases indicates an expected call of FindWithAliases.
+func (mr *MockTagRepositoryMockRecorder) FindWithAliases(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindWithAliases", reflect.TypeOf((*MockTagRepository)(nil).FindWithAliases), arg0, arg1)
+}
+
+// Update mocks base method.
+func (m *MockTagRepository) Update(arg0 context.Context, arg1 *models.Tag) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Update", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Update indicates an expected call of Update.
+func (mr *MockTagRepositoryMockRecorder) Update(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockTagRepository)(nil).Update), arg0, arg1)
+}
+
+// Upsert mocks base method.
+func (m *MockTagRepository) Upsert(arg0 context.Context, arg1 *models.Tag) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Upsert", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Upsert indicates an expected call of Upsert.
+func (mr *MockTagRepositoryMockRecorder) Upsert(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Upsert", reflect.TypeOf((*MockTagRepository)(nil).Upsert), arg0, arg1)
+}
+
+// MockTrashRepository is a mock of TrashRepository interface.
+type MockTrashRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockTrashRepositoryMockRecorder
+}
+
+// MockTrashRepositoryMockRecorder is the mock recorder for MockTrashRepository.
+type MockTrashRepositoryMockRecorder struct {
+	mock *MockTrashRepository
+}
+
+// NewMockTrashRepository creates a new mock instance.
+func NewMockTrashRepository(ctrl *gomock.Controller) *MockTrashRepository {
+	mock := &MockTrashRepository{ctrl: ctrl}
+	mock.recorder = &MockTrashRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockTrashRepository) EXPECT() *MockTrashRepositoryMockRecorder {
+	return m.recorder
+}
+
+// PurgeComments mocks base method.
+func (m *MockTrashRepository) PurgeComments(arg0 context.Context, arg1 time.Time, arg2 int) (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PurgeComments", arg0, arg1, arg2)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PurgeComments indicates an expected call of PurgeComments.
+func (mr *MockTrashRepositoryMockRecorder) PurgeComments(arg0, arg1, arg2 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PurgeComments", reflect.TypeOf((*MockTrashRepository)(nil).PurgeComments), arg0, arg1, arg2)
+}
+
+// PurgePosts mocks base method.
+func (m *MockTrashRepository) PurgePosts(arg0 context.Context, arg1 time.Time, arg2 int) (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PurgePosts", arg0, arg1, arg2)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PurgePosts indicates an expected call of PurgePosts.
+func (mr *MockTrashRepositoryMockRecorder) PurgePosts(arg0, arg1, arg2 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PurgePosts", reflect.TypeOf((*MockTrashRepository)(nil).PurgePosts), arg0, arg1, arg2)
+}
+
+// MockUserRepository is a mock of UserRepository interface.
+type MockUserRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockUserRepositoryMockRecorder
+}
+
+// MockUserRepositoryMockRecorder is the mock recorder for MockUserRepository.
+type MockUserRepositoryMockRecorder struct {
+	mock *MockUserRepository
+}
+
+// NewMockUserRepository creates a new mock instance.
+func NewMockUserRepository(ctrl *gomock.Controller) *MockUserRepository {
+	mock := &MockUserRepository{ctrl: ctrl}
+	mock.recorder = &MockUserRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockUserRepository) EXPECT() *MockUserRepositoryMockRecorder {
+	return m.recorder
+}
+
+// CancelDeletion mocks base method.
+func (m *MockUserRepository) CancelDeletion(arg0 context.Context, arg1 uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CancelDeletion", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CancelDeletion indicates an expected call of CancelDeletion.
+func (mr *MockUserRepositoryMockRecorder) CancelDeletion(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CancelDeletion", reflect.TypeOf((*MockUserRepository)(nil).CancelDeletion), arg0, arg1)
+}
+
+// ClearDormancyNotice mocks base method.
+func (m *MockUserRepository) ClearDormancyNotice(arg0 context.Context, arg1 uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ClearDormancyNotice", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ClearDormancyNotice indicates an expected call of ClearDormancyNotice.
+func (mr *MockUserRepositoryMockRecorder) ClearDormancyNotice(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ClearDormancyNotice", reflect.TypeOf((*MockUserRepository)(nil).ClearDormancyNotice), arg0, arg1)
+}
+
+// Count mocks base method.
+func (m *MockUserRepository) Count(arg0 context.Context) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Count", arg0)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Count indicates an expected call of Count.
+func (mr *MockUserRepositoryMockRecorder) Count(arg0 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Count", reflect.TypeOf((*MockUserRepository)(nil).Count), arg0)
+}
+
+// Create mocks base method.
+func (m *MockUserRepository) Create(arg0 context.Context, arg1 *models.User) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockUserRepositoryMockRecorder) Create(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockUserRepository)(nil).Create), arg0, arg1)
+}
+
+// CreateInBatches mocks base method.
+func (m *MockUserRepository) CreateInBatches(arg0 context.Context, arg1 []models.User, arg2 int) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateInBatches", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreateInBatches indicates an expected call of CreateInBatches.
+func (mr *MockUserRepositoryMockRecorder) CreateInBatches(arg0, arg1, arg2 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateInBatches", reflect.TypeOf((*MockUserRepository)(nil).CreateInBatches), arg0, arg1, arg2)
+}
+
+// Delete mocks base method.
+func (m *MockUserRepository) Delete(arg0 context.Context, arg1 uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Delete", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Delete indicates an expected call of Delete.
+func (mr *MockUserRepositoryMockRecorder) Delete(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockUserRepository)(nil).Delete), arg0, arg1)
+}
+
+// DeleteMany mocks base method.
+func (m *MockUserRepository) DeleteMany(arg0 context.Context, arg1 []uuid.UUID) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteMany", arg0, arg1)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteMany indicates an expected call of DeleteMany.
+func (mr *MockUserRepositoryMockRecorder) DeleteMany(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteMany", reflect.TypeOf((*MockUserRepository)(nil).DeleteMany), arg0, arg1)
+}
+
+// ExistsByEmail mocks base method.
+func (m *MockUserRepository) ExistsByEmail(arg0 context.Context, arg1 string) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ExistsByEmail", arg0, arg1)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ExistsByEmail indicates an expected call of ExistsByEmail.
+func (mr *MockUserRepositoryMockRecorder) ExistsByEmail(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ExistsByEmail", reflect.TypeOf((*MockUserRepository)(nil).ExistsByEmail), arg0, arg1)
+}
+
+// FindAll mocks base method.
+func (m *MockUserRepository) FindAll(arg0 context.Context, arg1, arg2 int) ([]models.User, int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindAll", arg0, arg1, arg2)
+	ret0, _ := ret[0].([]models.User)
+	ret1, _ := ret[1].(int64)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// FindAll indicates an expected call of FindAll.
+func (mr *MockUserRepositoryMockRecorder) FindAll(arg0, arg1, arg2 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindAll", reflect.TypeOf((*MockUserRepository)(nil).FindAll), arg0, arg1, arg2)
+}
+
+// FindByEmail mocks base method.
+func (m *MockUserRepository) FindByEmail(arg0 context.Context, arg1 string) (*models.User, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindByEmail", arg0, arg1)
+	ret0, _ := ret[0].(*models.User)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindByEmail indicates an expected call of FindByEmail.
+func (mr *MockUserRepositoryMockRecorder) FindByEmail(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByEmail", reflect.TypeOf((*MockUserRepository)(nil).FindByEmail), arg0, arg1)
+}
+
+// FindByID mocks base method.
+func (m *MockUserRepository) FindByID(arg0 context.Context, arg1 uuid.UUID) (*models.User, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindByID", arg0, arg1)
+	ret0, _ := ret[0].(*models.User)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindByID indicates an expected call of FindByID.
+func (mr *MockUserRepositoryMockRecorder) FindByID(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByID", reflect.TypeOf((*MockUserRepository)(nil).FindByID), arg0, arg1)
+}
+
+// FindByIDs mocks base method.
+func (m *MockUserRepository) FindByIDs(arg0 context.Context, arg1 []uuid.UUID) ([]models.User, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindByIDs", arg0, arg1)
+	ret0, _ := ret[0].([]models.User)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindByIDs indicates an expected call of FindByIDs.
+func (mr *MockUserRepositoryMockRecorder) FindByIDs(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByIDs", reflect.TypeOf((*MockUserRepository)(nil).FindByIDs), arg0, arg1)
+}
+
+// FindByRefreshToken mocks base method.
+func (m *MockUserRepository) FindByRefreshToken(arg0 context.Context, arg1 string) (*models.User, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindByRefreshToken", arg0, arg1)
+	ret0, _ := ret[0].(*models.User)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindByRefreshToken indicates an expected call of FindByRefreshToken.
+func (mr *MockUserRepositoryMockRecorder) FindByRefreshToken(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByRefreshToken", reflect.TypeOf((*MockUserRepository)(nil).FindByRefreshToken), arg0, arg1)
+}
+
+// FindDeletionRequestedBefore mocks base method.
+func (m *MockUserRepository) FindDeletionRequestedBefore(arg0 context.Context, arg1 time.Time, arg2 int) ([]models.User, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindDeletionRequestedBefore", arg0, arg1, arg2)
+	ret0, _ := ret[0].([]models.User)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindDeletionRequestedBefore indicates an expected call of FindDeletionRequestedBefore.
+func (mr *MockUserRepositoryMockRecorder) FindDeletionRequestedBefore(arg0, arg1, arg2 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindDeletionRequestedBefore", reflect.TypeOf((*MockUserRepository)(nil).FindDeletionRequestedBefore), arg0, arg1, arg2)
+}
+
+// FindDormancyNotifiedBefore mocks base method.
+func (m *MockUserRepository) FindDormancyNotifiedBefore(arg0 context.Context, arg1 time.Time, arg2 int) ([]models.User, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindDormancyNotifiedBefore", arg0, arg1, arg2)
+	ret0, _ := ret[0].([]models.User)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindDormancyNotifiedBefore indicates an expected call of FindDormancyNotifiedBefore.
+func (mr *MockUserRepositoryMockRecorder) FindDormancyNotifiedBefore(arg0, arg1, arg2 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindDormancyNotifiedBefore", reflect.TypeOf((*MockUserRepository)(nil).FindDormancyNotifiedBefore), arg0, arg1, arg2)
+}
+
+// FindDormantBefore mocks base method.
+func (m *MockUserRepository) FindDormantBefore(arg0 context.Context, arg1 time.Time, arg2 int) ([]models.User, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindDormantBefore", arg0, arg1, arg2)
+	ret0, _ := ret[0].([]models.User)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindDormantBefore indicates an expected call of FindDormantBefore.
+func (mr *MockUserRepositoryMockRecorder) FindDormantBefore(arg0, arg1, arg2 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindDormantBefore", reflect.TypeOf((*MockUserRepository)(nil).FindDormantBefore), arg0, arg1, arg2)
+}
+
+// FindFiltered mocks base method.
+func (m *MockUserRepository) FindFiltered(arg0 context.Context, arg1 *models.UserFilter, arg2, arg3 int) ([]models.User, int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindFiltered", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].([]models.User)
+	ret1, _ := ret[1].(int64)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// FindFiltered indicates an expected call of FindFiltered.
+func (mr *MockUserRepositoryMockRecorder) FindFiltered(arg0, arg1, arg2, arg3 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindFiltered", reflect.TypeOf((*MockUserRepository)(nil).FindFiltered), arg0, arg1, arg2, arg3)
+}
+
+// FindLastActiveBefore mocks base method.
+func (m *MockUserRepository) FindLastActiveBefore(arg0 context.Context, arg1 time.Time, arg2 int) ([]models.User, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindLastActiveBefore", arg0, arg1, arg2)
+	ret0, _ := ret[0].([]models.User)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindLastActiveBefore indicates an expected call of FindLastActiveBefore.
+func (mr *MockUserRepositoryMockRecorder) FindLastActiveBefore(arg0, arg1, arg2 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindLastActiveBefore", reflect.TypeOf((*MockUserRepository)(nil).FindLastActiveBefore), arg0, arg1, arg2)
+}
+
+// FindServiceAccounts mocks base method.
+func (m *MockUserRepository) FindServiceAccounts(arg0 context.Context, arg1, arg2 int) ([]models.User, int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindServiceAccounts", arg0, arg1, arg2)
+	ret0, _ := ret[0].([]models.User)
+	ret1, _ := ret[1].(int64)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// FindServiceAccounts indicates an expected call of FindServiceAccounts.
+func (mr *MockUserRepositoryMockRecorder) FindServiceAccounts(arg0, arg1, arg2 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindServiceAccounts", reflect.TypeOf((*MockUserRepository)(nil).FindServiceAccounts), arg0, arg1, arg2)
+}
+
+// SearchUsers mocks base method.
+func (m *MockUserRepository) SearchUsers(arg0 context.Context, arg1 string, arg2, arg3 int) ([]models.User, int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SearchUsers", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].([]models.User)
+	ret1, _ := ret[1].(int64)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// SearchUsers indicates an expected call of SearchUsers.
+func (mr *MockUserRepositoryMockRecorder) SearchUsers(arg0, arg1, arg2, arg3 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SearchUsers", reflect.TypeOf((*MockUserRepository)(nil).SearchUsers), arg0, arg1, arg2, arg3)
+}
+
+// Update mocks base method.
+func (m *MockUserRepository) Update(arg0 context.Context, arg1 *models.User) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Update", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Update indicates an expected call of Update.
+func (mr *MockUserRepositoryMockRecorder) Update(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockUserRepository)(nil).Update), arg0, arg1)
+}
+
+// UpdateLastLogin mocks base method.
+func (m *MockUserRepository) UpdateLastLogin(arg0 context.Context, arg1 uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateLastLogin", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateLastLogin indicates an expected call of UpdateLastLogin.
+func (mr *MockUserRepositoryMockRecorder) UpdateLastLogin(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateLastLogin", reflect.TypeOf((*MockUserRepository)(nil).UpdateLastLogin), arg0, arg1)
+}
+
+// UpdateLastSeen mocks base method.
+func (m *MockUserRepository) UpdateLastSeen(arg0 context.Context, arg1 uuid.UUID, arg2 time.Time) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateLastSeen", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateLastSeen indicates an expected call of UpdateLastSeen.
+func (mr *MockUserRepositoryMockRecorder) UpdateLastSeen(arg0, arg1, arg2 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateLastSeen", reflect.TypeOf((*MockUserRepository)(nil).UpdateLastSeen), arg0, arg1, arg2)
+}
+
+// UpdatePassword mocks base method.
+func (m *MockUserRepository) UpdatePassword(arg0 context.Context, arg1 uuid.UUID, arg2 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdatePassword", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdatePassword indicates an expected call of UpdatePassword.
+func (mr *MockUserRepositoryMockRecorder) UpdatePassword(arg0, arg1, arg2 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdatePassword", reflect.TypeOf((*MockUserRepository)(nil).UpdatePassword), arg0, arg1, arg2)
+}
+
+// UpdateRefreshToken mocks base method.
+func (m *MockUserRepository) UpdateRefreshToken(arg0 context.Context, arg1 uuid.UUID, arg2 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateRefreshToken", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateRefreshToken indicates an expected call of UpdateRefreshToken.
+func (mr *MockUserRepositoryMockRecorder) UpdateRefreshToken(arg0, arg1, arg2 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateRefreshToken", reflect.TypeOf((*MockUserRepository)(nil).UpdateRefreshToken), arg0, arg1, arg2)
+}
+
+// UpdateRole mocks base method.
+func (m *MockUserRepository) UpdateRole(arg0 context.Context, arg1 uuid.UUID, arg2 models.UserRole) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateRole", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateRole indicates an expected call of UpdateRole.
+func (mr *MockUserRepositoryMockRecorder) UpdateRole(arg0, arg1, arg2 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateRole", reflect.TypeOf((*MockUserRepository)(nil).UpdateRole), arg0, arg1, arg2)
+}
+
+// UpdateRoleMany mocks base method.
+func (m *MockUserRepository) UpdateRoleMany(arg0 context.Context, arg1 []uuid.UUID, arg2 models.UserRole) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateRoleMany", arg0, arg1, arg2)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateRoleMany indicates an expected call of UpdateRoleMany.
+func (mr *MockUserRepositoryMockRecorder) UpdateRoleMany(arg0, arg1, arg2 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateRoleMany", reflect.TypeOf((*MockUserRepository)(nil).UpdateRoleMany), arg0, arg1, arg2)
+}
+
+// UpdateStatus mocks base method.
+func (m *MockUserRepository) UpdateStatus(arg0 context.Context, arg1 uuid.UUID, arg2 models.UserStatus) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateStatus", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateStatus indicates an expected call of UpdateStatus.
+func (mr *MockUserRepositoryMockRecorder) UpdateStatus(arg0, arg1, arg2 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateStatus", reflect.TypeOf((*MockUserRepository)(nil).UpdateStatus), arg0, arg1, arg2)
+}
+
+// UpdateStatusMany mocks base method.
+func (m *MockUserRepository) UpdateStatusMany(arg0 context.Context, arg1 []uuid.UUID, arg2 models.UserStatus) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateStatusMany", arg0, arg1, arg2)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateStatusMany indicates an expected call of UpdateStatusMany.
+func (mr *MockUserRepositoryMockRecorder) UpdateStatusMany(arg0, arg1, arg2 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateStatusMany", reflect.TypeOf((*MockUserRepository)(nil).UpdateStatusMany), arg0, arg1, arg2)
+}
+
+// Upsert mocks base method.
+func (m *MockUserRepository) Upsert(arg0 context.Context, arg1 *models.User) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Upsert", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Upsert indicates an expected call of Upsert.
+func (mr *MockUserRepositoryMockRecorder) Upsert(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Upsert", reflect.TypeOf((*MockUserRepository)(nil).Upsert), arg0, arg1)
+}
+
+// VerifyEmail mocks base method.
+func (m *MockUserRepository) VerifyEmail(arg0 context.Context, arg1 uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "VerifyEmail", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// VerifyEmail indicates an expected call of VerifyEmail.
+func (mr *MockUserRepositoryMockRecorder) VerifyEmail(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "VerifyEmail", reflect.TypeOf((*MockUserRepository)(nil).VerifyEmail), arg0, arg1)
+}
+
+// MockWebhookRepository is a mock of WebhookRepository interface.
+type MockWebhookRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockWebhookRepositoryMockRecorder
+}
+
+// MockWebhookRepositoryMockRecorder is the mock recorder for MockWebhookRepository.
+type MockWebhookRepositoryMockRecorder struct {
+	mock *MockWebhookRepository
+}
+
+// NewMockWebhookRepository creates a new mock instance.
+func NewMockWebhookRepository(ctrl *gomock.Controller) *MockWebhookRepository {
+	mock := &MockWebhookRepository{ctrl: ctrl}
+	mock.recorder = &MockWebhookRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockWebhookRepository) EXPECT() *MockWebhookRepositoryMockRecorder {
+	return m.recorder
+}
+
+// Count mocks base method.
+func (m *MockWebhookRepository) Count(arg0 context.Context) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Count", arg0)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Count indicates an expected call of Count.
+func (mr *MockWebhookRepositoryMockRecorder) Count(arg0 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Count", reflect.TypeOf((*MockWebhookRepository)(nil).Count), arg0)
+}
+
+// CountReplayable mocks base method.
+func (m *MockWebhookRepository) CountReplayable(arg0 context.Context, arg1 uuid.UUID, arg2, arg3 time.Time, arg4 []models.WebhookDeliveryStatus) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CountReplayable", arg0, arg1, arg2, arg3, arg4)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CountReplayable indicates an expected call of CountReplayable.
+func (mr *MockWebhookRepositoryMockRecorder) CountReplayable(arg0, arg1, arg2, arg3, arg4 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CountReplayable", reflect.TypeOf((*MockWebhookRepository)(nil).CountReplayable), arg0, arg1, arg2, arg3, arg4)
+}
+
+// Create mocks base method.
+func (m *MockWebhookRepository) Create(arg0 context.Context, arg1 *models.Webhook) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockWebhookRepositoryMockRecorder) Create(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockWebhookRepository)(nil).Create), arg0, arg1)
+}
+
+// CreateDelivery mocks base method.
+func (m *MockWebhookRepository) CreateDelivery(arg0 context.Context, arg1 *models.WebhookDelivery) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateDelivery", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreateDelivery indicates an expected call of CreateDelivery.
+func (mr *MockWebhookRepositoryMockRecorder) CreateDelivery(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateDelivery", reflect.TypeOf((*MockWebhookRepository)(nil).CreateDelivery), arg0, arg1)
+}
+
+// CreateInBatches mocks base method.
+func (m *MockWebhookRepository) CreateInBatches(arg0 context.Context, arg1 []models.Webhook, arg2 int) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateInBatches", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreateInBatches indicates an expected call of CreateInBatches.
+func (mr *MockWebhookRepositoryMockRecorder) CreateInBatches(arg0, arg1, arg2 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateInBatches", reflect.TypeOf((*MockWebhookRepository)(nil).CreateInBatches), arg0, arg1, arg2)
+}
+
+// Delete mocks base method.
+func (m *MockWebhookRepository) Delete(arg0 context.Context, arg1 uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Delete", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Delete indicates an expected call of Delete.
+func (mr *MockWebhookRepositoryMockRecorder) Delete(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockWebhookRepository)(nil).Delete), arg0, arg1)
+}
+
+// DeleteFinishedDeliveriesBefore mocks base method.
+func (m *MockWebhookRepository) DeleteFinishedDeliveriesBefore(arg0 context.Context, arg1 time.Time) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteFinishedDeliveriesBefore", arg0, arg1)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteFinishedDeliveriesBefore indicates an expected call of DeleteFinishedDeliveriesBefore.
+func (mr *MockWebhookRepositoryMockRecorder) DeleteFinishedDeliveriesBefore(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteFinishedDeliveriesBefore", reflect.TypeOf((*MockWebhookRepository)(nil).DeleteFinishedDeliveriesBefore), arg0, arg1)
+}
+
+// EachReplayable mocks base method.
+func (m *MockWebhookRepository) EachReplayable(arg0 context.Context, arg1 uuid.UUID, arg2, arg3 time.Time, arg4 []models.WebhookDeliveryStatus, arg5 int, arg6 func([]models.WebhookDelivery) error) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "EachReplayable", arg0, arg1, arg2, arg3, arg4, arg5, arg6)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// EachReplayable indicates an expected call of EachReplayable.
+func (mr *MockWebhookRepositoryMockRecorder) EachReplayable(arg0, arg1, arg2, arg3, arg4, arg5, arg6 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EachReplayable", reflect.TypeOf((*MockWebhookRepository)(nil).EachReplayable), arg0, arg1, arg2, arg3, arg4, arg5, arg6)
+}
+
+// FindAll mocks base method.
+func (m *MockWebhookRepository) FindAll(arg0 context.Context, arg1, arg2 int) ([]models.Webhook, int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindAll", arg0, arg1, arg2)
+	ret0, _ := ret[0].([]models.Webhook)
+	ret1, _ := ret[1].(int64)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// FindAll indicates an expected call of FindAll.
+func (mr *MockWebhookRepositoryMockRecorder) FindAll(arg0, arg1, arg2 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindAll", reflect.TypeOf((*MockWebhookRepository)(nil).FindAll), arg0, arg1, arg2)
+}
+
+// FindAllWebhooks mocks base method.
+func (m *MockWebhookRepository) FindAllWebhooks(arg0 context.Context, arg1, arg2 int) ([]models.Webhook, int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindAllWebhooks", arg0, arg1, arg2)
+	ret0, _ := ret[0].([]models.Webhook)
+	ret1, _ := ret[1].(int64)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// FindAllWebhooks indicates an expected call of FindAllWebhooks.
+func (mr *MockWebhookRepositoryMockRecorder) FindAllWebhooks(arg0, arg1, arg2 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindAllWebhooks", reflect.TypeOf((*MockWebhookRepository)(nil).FindAllWebhooks), arg0, arg1, arg2)
+}
+
+// FindByID mocks base method.
+func (m *MockWebhookRepository) FindByID(arg0 context.Context, arg1 uuid.UUID) (*models.Webhook, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindByID", arg0, arg1)
+	ret0, _ := ret[0].(*models.Webhook)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindByID indicates an expected call of FindByID.
+func (mr *MockWebhookRepositoryMockRecorder) FindByID(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByID", reflect.TypeOf((*MockWebhookRepository)(nil).FindByID), arg0, arg1)
+}
+
+// FindByIDs mocks base method.
+func (m *MockWebhookRepository) FindByIDs(arg0 context.Context, arg1 []uuid.UUID) ([]models.Webhook, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindByIDs", arg0, arg1)
+	ret0, _ := ret[0].([]models.Webhook)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindByIDs indicates an expected call of FindByIDs.
+func (mr *MockWebhookRepositoryMockRecorder) FindByIDs(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByIDs", reflect.TypeOf((*MockWebhookRepository)(nil).FindByIDs), arg0, arg1)
+}
+
+// FindByUser mocks base method.
+func (m *MockWebhookRepository) FindByUser(arg0 context.Context, arg1 uuid.UUID, arg2, arg3 int) ([]models.Webhook, int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindByUser", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].([]models.Webhook)
+	ret1, _ := ret[1].(int64)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// FindByUser indicates an expected call of FindByUser.
+func (mr *MockWebhookRepositoryMockRecorder) FindByUser(arg0, arg1, arg2, arg3 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByUser", reflect.TypeOf((*MockWebhookRepository)(nil).FindByUser), arg0, arg1, arg2, arg3)
+}
+
+// FindDeliveries mocks base method.
+func (m *MockWebhookRepository) FindDeliveries(arg0 context.Context, arg1 uuid.UUID, arg2, arg3 int) ([]models.WebhookDelivery, int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindDeliveries", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].([]models.WebhookDelivery)
+	ret1, _ := ret[1].(int64)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// FindDeliveries indicates an expected call of FindDeliveries.
+func (mr *MockWebhookRepositoryMockRecorder) FindDeliveries(arg0, arg1, arg2, arg3 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindDeliveries", reflect.TypeOf((*MockWebhookRepository)(nil).FindDeliveries), arg0, arg1, arg2, arg3)
+}
+
+// FindDelivery mocks base method.
+func (m *MockWebhookRepository) FindDelivery(arg0 context.Context, arg1 uuid.UUID) (*models.WebhookDelivery, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindDelivery", arg0, arg1)
+	ret0, _ := ret[0].(*models.WebhookDelivery)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindDelivery indicates an expected call of FindDelivery.
+func (mr *MockWebhookRepositoryMockRecorder) FindDelivery(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindDelivery", reflect.TypeOf((*MockWebhookRepository)(nil).FindDelivery), arg0, arg1)
+}
+
+// FindSubscribed mocks base method.
+func (m *MockWebhookRepository) FindSubscribed(arg0 context.Context, arg1 models.WebhookEvent, arg2 *uuid.UUID) ([]models.Webhook, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindSubscribed", arg0, arg1, arg2)
+	ret0, _ := ret[0].([]models.Webhook)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindSubscribed indicates an expected call of FindSubscribed.
+func (mr *MockWebhookRepositoryMockRecorder) FindSubscribed(arg0, arg1, arg2 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindSubscribed", reflect.TypeOf((*MockWebhookRepository)(nil).FindSubscribed), arg0, arg1, arg2)
+}
+
+// Update mocks base method.
+func (m *MockWebhookRepository) Update(arg0 context.Context, arg1 *models.Webhook) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Update", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Update indicates an expected call of Update.
+func (mr *MockWebhookRepositoryMockRecorder) Update(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockWebhookRepository)(nil).Update), arg0, arg1)
+}
+
+// UpdateDelivery mocks base method.
+func (m *MockWebhookRepository) UpdateDelivery(arg0 context.Context, arg1 *models.WebhookDelivery) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateDelivery", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateDelivery indicates an expected call of UpdateDelivery.
+func (mr *MockWebhookRepositoryMockRecorder) UpdateDelivery(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateDelivery", reflect.TypeOf((*MockWebhookRepository)(nil).UpdateDelivery), arg0, arg1)
+}
+
+// Upsert mocks base method.
+func (m *MockWebhookRepository) Upsert(arg0 context.Context, arg1 *models.Webhook) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Upsert", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Upsert indicates an expected call of Upsert.
+func (mr *MockWebhookRepositoryMockRecorder) Upsert(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Upsert", reflect.TypeOf((*MockWebhookRepository)(nil).Upsert), arg0, arg1)
+}
@@ -0,0 +1,847 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: github.com/yourusername/go-enterprise-api/internal/services (interfaces: AuthService,UserService,PostService,PolicyService)
+//
+// Generated by this command:
+//
+//	mockgen -destination=services.go -package=mocks github.com/yourusername/go-enterprise-api/internal/services AuthService,UserService,PostService,PolicyService
+//
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	reflect "reflect"
+
+	uuid "github.com/google/uuid"
+	jobs "github.com/yourusername/go-enterprise-api/internal/jobs"
+	models "github.com/yourusername/go-enterprise-api/internal/models"
+	services "github.com/yourusername/go-enterprise-api/internal/services"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockAuthService is a mock of AuthService interface.
+type MockAuthService struct {
+	ctrl     *gomock.Controller
+	recorder *MockAuthServiceMockRecorder
+}
+
+// MockAuthServiceMockRecorder is the mock recorder for MockAuthService.
+type MockAuthServiceMockRecorder struct {
+	mock *MockAuthService
+}
+
+// NewMockAuthService creates a new mock instance.
+func NewMockAuthService(ctrl *gomock.Controller) *MockAuthService {
+	mock := &MockAuthService{ctrl: ctrl}
+	mock.recorder = &MockAuthServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockAuthService) EXPECT() *MockAuthServiceMockRecorder {
+	return m.recorder
+}
+
+// AuthenticateAPIKey mocks base method.
+func (m *MockAuthService) AuthenticateAPIKey(arg0 context.Context, arg1 string) (*models.User, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AuthenticateAPIKey", arg0, arg1)
+	ret0, _ := ret[0].(*models.User)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AuthenticateAPIKey indicates an expected call of AuthenticateAPIKey.
+func (mr *MockAuthServiceMockRecorder) AuthenticateAPIKey(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AuthenticateAPIKey", reflect.TypeOf((*MockAuthService)(nil).AuthenticateAPIKey), arg0, arg1)
+}
+
+// ChangePassword mocks base method.
+func (m *MockAuthService) ChangePassword(arg0 context.Context, arg1 uuid.UUID, arg2, arg3 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ChangePassword", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ChangePassword indicates an expected call of ChangePassword.
+func (mr *MockAuthServiceMockRecorder) ChangePassword(arg0, arg1, arg2, arg3 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ChangePassword", reflect.TypeOf((*MockAuthService)(nil).ChangePassword), arg0, arg1, arg2, arg3)
+}
+
+// GetUserFromToken mocks base method.
+func (m *MockAuthService) GetUserFromToken(arg0 context.Context, arg1 *services.Claims) (*models.User, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetUserFromToken", arg0, arg1)
+	ret0, _ := ret[0].(*models.User)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetUserFromToken indicates an expected call of GetUserFromToken.
+func (mr *MockAuthServiceMockRecorder) GetUserFromToken(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUserFromToken", reflect.TypeOf((*MockAuthService)(nil).GetUserFromToken), arg0, arg1)
+}
+
+// Login mocks base method.
+func (m *MockAuthService) Login(arg0 context.Context, arg1 *services.LoginRequest) (*models.User, *services.TokenPair, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Login", arg0, arg1)
+	ret0, _ := ret[0].(*models.User)
+	ret1, _ := ret[1].(*services.TokenPair)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// Login indicates an expected call of Login.
+func (mr *MockAuthServiceMockRecorder) Login(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Login", reflect.TypeOf((*MockAuthService)(nil).Login), arg0, arg1)
+}
+
+// Logout mocks base method.
+func (m *MockAuthService) Logout(arg0 context.Context, arg1 uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Logout", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Logout indicates an expected call of Logout.
+func (mr *MockAuthServiceMockRecorder) Logout(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Logout", reflect.TypeOf((*MockAuthService)(nil).Logout), arg0, arg1)
+}
+
+// RecordSeen mocks base method.
+func (m *MockAuthService) RecordSeen(arg0 context.Context, arg1 *models.User) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "RecordSeen", arg0, arg1)
+}
+
+// RecordSeen indicates an expected call of RecordSeen.
+func (mr *MockAuthServiceMockRecorder) RecordSeen(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RecordSeen", reflect.TypeOf((*MockAuthService)(nil).RecordSeen), arg0, arg1)
+}
+
+// RefreshTokens mocks base method.
+func (m *MockAuthService) RefreshTokens(arg0 context.Context, arg1 string) (*services.TokenPair, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RefreshTokens", arg0, arg1)
+	ret0, _ := ret[0].(*services.TokenPair)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RefreshTokens indicates an expected call of RefreshTokens.
+func (mr *MockAuthServiceMockRecorder) RefreshTokens(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RefreshTokens", reflect.TypeOf((*MockAuthService)(nil).RefreshTokens), arg0, arg1)
+}
+
+// Register mocks base method.
+func (m *MockAuthService) Register(arg0 context.Context, arg1 *services.RegisterRequest) (*models.User, *services.TokenPair, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Register", arg0, arg1)
+	ret0, _ := ret[0].(*models.User)
+	ret1, _ := ret[1].(*services.TokenPair)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// Register indicates an expected call of Register.
+func (mr *MockAuthServiceMockRecorder) Register(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Register", reflect.TypeOf((*MockAuthService)(nil).Register), arg0, arg1)
+}
+
+// ResetPassword mocks base method.
+func (m *MockAuthService) ResetPassword(arg0 context.Context, arg1 uuid.UUID, arg2 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ResetPassword", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ResetPassword indicates an expected call of ResetPassword.
+func (mr *MockAuthServiceMockRecorder) ResetPassword(arg0, arg1, arg2 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ResetPassword", reflect.TypeOf((*MockAuthService)(nil).ResetPassword), arg0, arg1, arg2)
+}
+
+// ValidateToken mocks base method.
+func (m *MockAuthService) ValidateToken(arg0 string) (*services.Claims, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ValidateToken", arg0)
+	ret0, _ := ret[0].(*services.Claims)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ValidateToken indicates an expected call of ValidateToken.
+func (mr *MockAuthServiceMockRecorder) ValidateToken(arg0 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ValidateToken", reflect.TypeOf((*MockAuthService)(nil).ValidateToken), arg0)
+}
+
+// MockUserService is a mock of UserService interface.
+type MockUserService struct {
+	ctrl     *gomock.Controller
+	recorder *MockUserServiceMockRecorder
+}
+
+// MockUserServiceMockRecorder is the mock recorder for MockUserService.
+type MockUserServiceMockRecorder struct {
+	mock *MockUserService
+}
+
+// NewMockUserService creates a new mock instance.
+func NewMockUserService(ctrl *gomock.Controller) *MockUserService {
+	mock := &MockUserService{ctrl: ctrl}
+	mock.recorder = &MockUserServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockUserService) EXPECT() *MockUserServiceMockRecorder {
+	return m.recorder
+}
+
+// Delete mocks base method.
+func (m *MockUserService) Delete(arg0 context.Context, arg1 uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Delete", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Delete indicates an expected call of Delete.
+func (mr *MockUserServiceMockRecorder) Delete(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockUserService)(nil).Delete), arg0, arg1)
+}
+
+// Export mocks base method.
+func (m *MockUserService) Export(arg0 context.Context, arg1 *models.UserFilter, arg2 func([]models.User) error) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Export", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Export indicates an expected call of Export.
+func (mr *MockUserServiceMockRecorder) Export(arg0, arg1, arg2 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Export", reflect.TypeOf((*MockUserService)(nil).Export), arg0, arg1, arg2)
+}
+
+// GetAll mocks base method.
+func (m *MockUserService) GetAll(arg0 context.Context, arg1 *models.UserFilter, arg2, arg3 int) ([]models.User, int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAll", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].([]models.User)
+	ret1, _ := ret[1].(int64)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetAll indicates an expected call of GetAll.
+func (mr *MockUserServiceMockRecorder) GetAll(arg0, arg1, arg2, arg3 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAll", reflect.TypeOf((*MockUserService)(nil).GetAll), arg0, arg1, arg2, arg3)
+}
+
+// GetByEmail mocks base method.
+func (m *MockUserService) GetByEmail(arg0 context.Context, arg1 string) (*models.User, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetByEmail", arg0, arg1)
+	ret0, _ := ret[0].(*models.User)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetByEmail indicates an expected call of GetByEmail.
+func (mr *MockUserServiceMockRecorder) GetByEmail(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByEmail", reflect.TypeOf((*MockUserService)(nil).GetByEmail), arg0, arg1)
+}
+
+// GetByID mocks base method.
+func (m *MockUserService) GetByID(arg0 context.Context, arg1 uuid.UUID) (*models.User, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetByID", arg0, arg1)
+	ret0, _ := ret[0].(*models.User)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetByID indicates an expected call of GetByID.
+func (mr *MockUserServiceMockRecorder) GetByID(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByID", reflect.TypeOf((*MockUserService)(nil).GetByID), arg0, arg1)
+}
+
+// GetByIDs mocks base method.
+func (m *MockUserService) GetByIDs(arg0 context.Context, arg1 []uuid.UUID) ([]models.User, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetByIDs", arg0, arg1)
+	ret0, _ := ret[0].([]models.User)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetByIDs indicates an expected call of GetByIDs.
+func (mr *MockUserServiceMockRecorder) GetByIDs(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByIDs", reflect.TypeOf((*MockUserService)(nil).GetByIDs), arg0, arg1)
+}
+
+// GetChanges mocks base method.
+func (m *MockUserService) GetChanges(arg0 context.Context, arg1 uuid.UUID, arg2, arg3 int) ([]models.Change, int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetChanges", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].([]models.Change)
+	ret1, _ := ret[1].(int64)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetChanges indicates an expected call of GetChanges.
+func (mr *MockUserServiceMockRecorder) GetChanges(arg0, arg1, arg2, arg3 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetChanges", reflect.TypeOf((*MockUserService)(nil).GetChanges), arg0, arg1, arg2, arg3)
+}
+
+// GetProfile mocks base method.
+func (m *MockUserService) GetProfile(arg0 context.Context, arg1 uuid.UUID, arg2 *models.User) (*models.PublicProfileResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetProfile", arg0, arg1, arg2)
+	ret0, _ := ret[0].(*models.PublicProfileResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetProfile indicates an expected call of GetProfile.
+func (mr *MockUserServiceMockRecorder) GetProfile(arg0, arg1, arg2 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetProfile", reflect.TypeOf((*MockUserService)(nil).GetProfile), arg0, arg1, arg2)
+}
+
+// Search mocks base method.
+func (m *MockUserService) Search(arg0 context.Context, arg1 string, arg2, arg3 int) ([]models.User, int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Search", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].([]models.User)
+	ret1, _ := ret[1].(int64)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// Search indicates an expected call of Search.
+func (mr *MockUserServiceMockRecorder) Search(arg0, arg1, arg2, arg3 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Search", reflect.TypeOf((*MockUserService)(nil).Search), arg0, arg1, arg2, arg3)
+}
+
+// Update mocks base method.
+func (m *MockUserService) Update(arg0 context.Context, arg1, arg2 uuid.UUID, arg3 *services.UpdateUserRequest) (*models.User, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Update", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].(*models.User)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Update indicates an expected call of Update.
+func (mr *MockUserServiceMockRecorder) Update(arg0, arg1, arg2, arg3 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockUserService)(nil).Update), arg0, arg1, arg2, arg3)
+}
+
+// UpdateRole mocks base method.
+func (m *MockUserService) UpdateRole(arg0 context.Context, arg1, arg2 uuid.UUID, arg3 models.UserRole) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateRole", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateRole indicates an expected call of UpdateRole.
+func (mr *MockUserServiceMockRecorder) UpdateRole(arg0, arg1, arg2, arg3 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateRole", reflect.TypeOf((*MockUserService)(nil).UpdateRole), arg0, arg1, arg2, arg3)
+}
+
+// UpdateStatus mocks base method.
+func (m *MockUserService) UpdateStatus(arg0 context.Context, arg1, arg2 uuid.UUID, arg3 models.UserStatus) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateStatus", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateStatus indicates an expected call of UpdateStatus.
+func (mr *MockUserServiceMockRecorder) UpdateStatus(arg0, arg1, arg2, arg3 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateStatus", reflect.TypeOf((*MockUserService)(nil).UpdateStatus), arg0, arg1, arg2, arg3)
+}
+
+// MockPostService is a mock of PostService interface.
+type MockPostService struct {
+	ctrl     *gomock.Controller
+	recorder *MockPostServiceMockRecorder
+}
+
+// MockPostServiceMockRecorder is the mock recorder for MockPostService.
+type MockPostServiceMockRecorder struct {
+	mock *MockPostService
+}
+
+// NewMockPostService creates a new mock instance.
+func NewMockPostService(ctrl *gomock.Controller) *MockPostService {
+	mock := &MockPostService{ctrl: ctrl}
+	mock.recorder = &MockPostServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockPostService) EXPECT() *MockPostServiceMockRecorder {
+	return m.recorder
+}
+
+// CheckSlug mocks base method.
+func (m *MockPostService) CheckSlug(arg0 context.Context, arg1 string) (string, bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CheckSlug", arg0, arg1)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(bool)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// CheckSlug indicates an expected call of CheckSlug.
+func (mr *MockPostServiceMockRecorder) CheckSlug(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CheckSlug", reflect.TypeOf((*MockPostService)(nil).CheckSlug), arg0, arg1)
+}
+
+// Create mocks base method.
+func (m *MockPostService) Create(arg0 context.Context, arg1 *models.User, arg2 *services.CreatePostRequest) (*models.Post, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", arg0, arg1, arg2)
+	ret0, _ := ret[0].(*models.Post)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockPostServiceMockRecorder) Create(arg0, arg1, arg2 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockPostService)(nil).Create), arg0, arg1, arg2)
+}
+
+// Delete mocks base method.
+func (m *MockPostService) Delete(arg0 context.Context, arg1, arg2 uuid.UUID, arg3 bool) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Delete", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Delete indicates an expected call of Delete.
+func (mr *MockPostServiceMockRecorder) Delete(arg0, arg1, arg2, arg3 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockPostService)(nil).Delete), arg0, arg1, arg2, arg3)
+}
+
+// Export mocks base method.
+func (m *MockPostService) Export(arg0 context.Context, arg1 uuid.UUID, arg2 bool, arg3 func([]models.Post) error) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Export", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Export indicates an expected call of Export.
+func (mr *MockPostServiceMockRecorder) Export(arg0, arg1, arg2, arg3 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Export", reflect.TypeOf((*MockPostService)(nil).Export), arg0, arg1, arg2, arg3)
+}
+
+// GetAll mocks base method.
+func (m *MockPostService) GetAll(arg0 context.Context, arg1, arg2 int) ([]models.Post, int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAll", arg0, arg1, arg2)
+	ret0, _ := ret[0].([]models.Post)
+	ret1, _ := ret[1].(int64)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetAll indicates an expected call of GetAll.
+func (mr *MockPostServiceMockRecorder) GetAll(arg0, arg1, arg2 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAll", reflect.TypeOf((*MockPostService)(nil).GetAll), arg0, arg1, arg2)
+}
+
+// GetByID mocks base method.
+func (m *MockPostService) GetByID(arg0 context.Context, arg1 uuid.UUID) (*models.Post, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetByID", arg0, arg1)
+	ret0, _ := ret[0].(*models.Post)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetByID indicates an expected call of GetByID.
+func (mr *MockPostServiceMockRecorder) GetByID(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByID", reflect.TypeOf((*MockPostService)(nil).GetByID), arg0, arg1)
+}
+
+// GetBySlug mocks base method.
+func (m *MockPostService) GetBySlug(arg0 context.Context, arg1 string) (*models.Post, bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetBySlug", arg0, arg1)
+	ret0, _ := ret[0].(*models.Post)
+	ret1, _ := ret[1].(bool)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetBySlug indicates an expected call of GetBySlug.
+func (mr *MockPostServiceMockRecorder) GetBySlug(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetBySlug", reflect.TypeOf((*MockPostService)(nil).GetBySlug), arg0, arg1)
+}
+
+// GetByTag mocks base method.
+func (m *MockPostService) GetByTag(arg0 context.Context, arg1 string, arg2, arg3 bool, arg4, arg5 int) ([]models.Post, int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetByTag", arg0, arg1, arg2, arg3, arg4, arg5)
+	ret0, _ := ret[0].([]models.Post)
+	ret1, _ := ret[1].(int64)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetByTag indicates an expected call of GetByTag.
+func (mr *MockPostServiceMockRecorder) GetByTag(arg0, arg1, arg2, arg3, arg4, arg5 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByTag", reflect.TypeOf((*MockPostService)(nil).GetByTag), arg0, arg1, arg2, arg3, arg4, arg5)
+}
+
+// GetByUser mocks base method.
+func (m *MockPostService) GetByUser(arg0 context.Context, arg1 uuid.UUID, arg2, arg3 int) ([]models.Post, int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetByUser", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].([]models.Post)
+	ret1, _ := ret[1].(int64)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetByUser indicates an expected call of GetByUser.
+func (mr *MockPostServiceMockRecorder) GetByUser(arg0, arg1, arg2, arg3 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByUser", reflect.TypeOf((*MockPostService)(nil).GetByUser), arg0, arg1, arg2, arg3)
+}
+
+// GetChanges mocks base method.
+func (m *MockPostService) GetChanges(arg0 context.Context, arg1, arg2 uuid.UUID, arg3 bool, arg4, arg5 int) ([]models.Change, int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetChanges", arg0, arg1, arg2, arg3, arg4, arg5)
+	ret0, _ := ret[0].([]models.Change)
+	ret1, _ := ret[1].(int64)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetChanges indicates an expected call of GetChanges.
+func (mr *MockPostServiceMockRecorder) GetChanges(arg0, arg1, arg2, arg3, arg4, arg5 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetChanges", reflect.TypeOf((*MockPostService)(nil).GetChanges), arg0, arg1, arg2, arg3, arg4, arg5)
+}
+
+// GetFeatured mocks base method.
+func (m *MockPostService) GetFeatured(arg0 context.Context, arg1 bool, arg2, arg3 int) ([]models.Post, int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetFeatured", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].([]models.Post)
+	ret1, _ := ret[1].(int64)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetFeatured indicates an expected call of GetFeatured.
+func (mr *MockPostServiceMockRecorder) GetFeatured(arg0, arg1, arg2, arg3 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetFeatured", reflect.TypeOf((*MockPostService)(nil).GetFeatured), arg0, arg1, arg2, arg3)
+}
+
+// GetMissingAltText mocks base method.
+func (m *MockPostService) GetMissingAltText(arg0 context.Context, arg1 uuid.UUID, arg2, arg3 int) ([]models.Post, int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetMissingAltText", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].([]models.Post)
+	ret1, _ := ret[1].(int64)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetMissingAltText indicates an expected call of GetMissingAltText.
+func (mr *MockPostServiceMockRecorder) GetMissingAltText(arg0, arg1, arg2, arg3 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetMissingAltText", reflect.TypeOf((*MockPostService)(nil).GetMissingAltText), arg0, arg1, arg2, arg3)
+}
+
+// GetPublished mocks base method.
+func (m *MockPostService) GetPublished(arg0 context.Context, arg1 bool, arg2, arg3 int) ([]models.Post, int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetPublished", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].([]models.Post)
+	ret1, _ := ret[1].(int64)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetPublished indicates an expected call of GetPublished.
+func (mr *MockPostServiceMockRecorder) GetPublished(arg0, arg1, arg2, arg3 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPublished", reflect.TypeOf((*MockPostService)(nil).GetPublished), arg0, arg1, arg2, arg3)
+}
+
+// GetTagsByPostIDs mocks base method.
+func (m *MockPostService) GetTagsByPostIDs(arg0 context.Context, arg1 []uuid.UUID) (map[uuid.UUID][]models.Tag, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetTagsByPostIDs", arg0, arg1)
+	ret0, _ := ret[0].(map[uuid.UUID][]models.Tag)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetTagsByPostIDs indicates an expected call of GetTagsByPostIDs.
+func (mr *MockPostServiceMockRecorder) GetTagsByPostIDs(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTagsByPostIDs", reflect.TypeOf((*MockPostService)(nil).GetTagsByPostIDs), arg0, arg1)
+}
+
+// PinToProfile mocks base method.
+func (m *MockPostService) PinToProfile(arg0 context.Context, arg1, arg2 uuid.UUID, arg3 int) ([]models.Post, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PinToProfile", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].([]models.Post)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PinToProfile indicates an expected call of PinToProfile.
+func (mr *MockPostServiceMockRecorder) PinToProfile(arg0, arg1, arg2, arg3 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PinToProfile", reflect.TypeOf((*MockPostService)(nil).PinToProfile), arg0, arg1, arg2, arg3)
+}
+
+// RebuildIndex mocks base method.
+func (m *MockPostService) RebuildIndex(arg0 context.Context) (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RebuildIndex", arg0)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RebuildIndex indicates an expected call of RebuildIndex.
+func (mr *MockPostServiceMockRecorder) RebuildIndex(arg0 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RebuildIndex", reflect.TypeOf((*MockPostService)(nil).RebuildIndex), arg0)
+}
+
+// RegisterJobs mocks base method.
+func (m *MockPostService) RegisterJobs(arg0 *jobs.Queue) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "RegisterJobs", arg0)
+}
+
+// RegisterJobs indicates an expected call of RegisterJobs.
+func (mr *MockPostServiceMockRecorder) RegisterJobs(arg0 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RegisterJobs", reflect.TypeOf((*MockPostService)(nil).RegisterJobs), arg0)
+}
+
+// Reindex mocks base method.
+func (m *MockPostService) Reindex(arg0 context.Context) (*models.Job, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Reindex", arg0)
+	ret0, _ := ret[0].(*models.Job)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Reindex indicates an expected call of Reindex.
+func (mr *MockPostServiceMockRecorder) Reindex(arg0 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Reindex", reflect.TypeOf((*MockPostService)(nil).Reindex), arg0)
+}
+
+// Search mocks base method.
+func (m *MockPostService) Search(arg0 context.Context, arg1 string, arg2 bool, arg3, arg4 int) ([]models.Post, int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Search", arg0, arg1, arg2, arg3, arg4)
+	ret0, _ := ret[0].([]models.Post)
+	ret1, _ := ret[1].(int64)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// Search indicates an expected call of Search.
+func (mr *MockPostServiceMockRecorder) Search(arg0, arg1, arg2, arg3, arg4 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Search", reflect.TypeOf((*MockPostService)(nil).Search), arg0, arg1, arg2, arg3, arg4)
+}
+
+// UnpinFromProfile mocks base method.
+func (m *MockPostService) UnpinFromProfile(arg0 context.Context, arg1, arg2 uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UnpinFromProfile", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UnpinFromProfile indicates an expected call of UnpinFromProfile.
+func (mr *MockPostServiceMockRecorder) UnpinFromProfile(arg0, arg1, arg2 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UnpinFromProfile", reflect.TypeOf((*MockPostService)(nil).UnpinFromProfile), arg0, arg1, arg2)
+}
+
+// Update mocks base method.
+func (m *MockPostService) Update(arg0 context.Context, arg1, arg2 uuid.UUID, arg3 bool, arg4 *services.UpdatePostRequest) (*models.Post, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Update", arg0, arg1, arg2, arg3, arg4)
+	ret0, _ := ret[0].(*models.Post)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Update indicates an expected call of Update.
+func (mr *MockPostServiceMockRecorder) Update(arg0, arg1, arg2, arg3, arg4 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockPostService)(nil).Update), arg0, arg1, arg2, arg3, arg4)
+}
+
+// UpdateFlags mocks base method.
+func (m *MockPostService) UpdateFlags(arg0 context.Context, arg1, arg2 uuid.UUID, arg3 *services.UpdatePostFlagsRequest) (*models.Post, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateFlags", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].(*models.Post)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateFlags indicates an expected call of UpdateFlags.
+func (mr *MockPostServiceMockRecorder) UpdateFlags(arg0, arg1, arg2, arg3 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateFlags", reflect.TypeOf((*MockPostService)(nil).UpdateFlags), arg0, arg1, arg2, arg3)
+}
+
+// MockPolicyService is a mock of PolicyService interface.
+type MockPolicyService struct {
+	ctrl     *gomock.Controller
+	recorder *MockPolicyServiceMockRecorder
+}
+
+// MockPolicyServiceMockRecorder is the mock recorder for MockPolicyService.
+type MockPolicyServiceMockRecorder struct {
+	mock *MockPolicyService
+}
+
+// NewMockPolicyService creates a new mock instance.
+func NewMockPolicyService(ctrl *gomock.Controller) *MockPolicyService {
+	mock := &MockPolicyService{ctrl: ctrl}
+	mock.recorder = &MockPolicyServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockPolicyService) EXPECT() *MockPolicyServiceMockRecorder {
+	return m.recorder
+}
+
+// Accept mocks base method.
+func (m *MockPolicyService) Accept(arg0 context.Context, arg1 uuid.UUID, arg2 []uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Accept", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Accept indicates an expected call of Accept.
+func (mr *MockPolicyServiceMockRecorder) Accept(arg0, arg1, arg2 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Accept", reflect.TypeOf((*MockPolicyService)(nil).Accept), arg0, arg1, arg2)
+}
+
+// GetAll mocks base method.
+func (m *MockPolicyService) GetAll(arg0 context.Context, arg1 models.PolicyKind, arg2, arg3 int) ([]models.PolicyVersion, int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAll", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].([]models.PolicyVersion)
+	ret1, _ := ret[1].(int64)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetAll indicates an expected call of GetAll.
+func (mr *MockPolicyServiceMockRecorder) GetAll(arg0, arg1, arg2, arg3 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAll", reflect.TypeOf((*MockPolicyService)(nil).GetAll), arg0, arg1, arg2, arg3)
+}
+
+// GetCurrent mocks base method.
+func (m *MockPolicyService) GetCurrent(arg0 context.Context) ([]models.PolicyVersion, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetCurrent", arg0)
+	ret0, _ := ret[0].([]models.PolicyVersion)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetCurrent indicates an expected call of GetCurrent.
+func (mr *MockPolicyServiceMockRecorder) GetCurrent(arg0 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCurrent", reflect.TypeOf((*MockPolicyService)(nil).GetCurrent), arg0)
+}
+
+// GetPending mocks base method.
+func (m *MockPolicyService) GetPending(arg0 context.Context, arg1 uuid.UUID) ([]models.PolicyVersion, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetPending", arg0, arg1)
+	ret0, _ := ret[0].([]models.PolicyVersion)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetPending indicates an expected call of GetPending.
+func (mr *MockPolicyServiceMockRecorder) GetPending(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPending", reflect.TypeOf((*MockPolicyService)(nil).GetPending), arg0, arg1)
+}
+
+// Publish mocks base method.
+func (m *MockPolicyService) Publish(arg0 context.Context, arg1 uuid.UUID, arg2 *services.PublishPolicyRequest) (*models.PolicyVersion, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Publish", arg0, arg1, arg2)
+	ret0, _ := ret[0].(*models.PolicyVersion)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Publish indicates an expected call of Publish.
+func (mr *MockPolicyServiceMockRecorder) Publish(arg0, arg1, arg2 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Publish", reflect.TypeOf((*MockPolicyService)(nil).Publish), arg0, arg1, arg2)
+}
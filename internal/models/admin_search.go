@@ -0,0 +1,110 @@
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+// SearchMatchType is the kind of record an admin search matched
+type SearchMatchType string
+
+const (
+	SearchMatchUser    SearchMatchType = "user"
+	SearchMatchPost    SearchMatchType = "post"
+	SearchMatchComment SearchMatchType = "comment"
+)
+
+// SearchMatchTypes lists the kinds of records admin search covers
+var SearchMatchTypes = []SearchMatchType{SearchMatchUser, SearchMatchPost, SearchMatchComment}
+
+// Fields an admin search can match records on
+const (
+	SearchFieldID           = "id"
+	SearchFieldEmail        = "email"
+	SearchFieldSlug         = "slug"
+	SearchFieldPreviousSlug = "previous_slug"
+)
+
+// searchSnippetLength bounds the comment content shown as a match's label
+const searchSnippetLength = 100
+
+// SearchMatchResponse is a record found by admin search. Soft-deleted
+// records are included, with their deletion date.
+type SearchMatchResponse struct {
+	Type      SearchMatchType `json:"type"`
+	ID        uuid.UUID       `json:"id"`
+	MatchedOn string          `json:"matched_on"`
+	Label     string          `json:"label"`
+	Status    string          `json:"status"`
+	UserID    *uuid.UUID      `json:"user_id,omitempty"`
+	PostID    *uuid.UUID      `json:"post_id,omitempty"`
+	Shadowed  bool            `json:"shadowed"`
+	Deleted   bool            `json:"deleted"`
+	DeletedAt *time.Time      `json:"deleted_at,omitempty"`
+	CreatedAt time.Time       `json:"created_at"`
+}
+
+// deletedAt returns the deletion date of a soft-deleted record, or nil
+func deletedAt(deleted gorm.DeletedAt) *time.Time {
+	if !deleted.Valid {
+		return nil
+	}
+	return &deleted.Time
+}
+
+// ToSearchMatch converts User to SearchMatchResponse, labelled by email
+func (u *User) ToSearchMatch(matchedOn string) *SearchMatchResponse {
+	return &SearchMatchResponse{
+		Type:      SearchMatchUser,
+		ID:        u.ID,
+		MatchedOn: matchedOn,
+		Label:     u.Email,
+		Status:    string(u.Status),
+		Shadowed:  u.IsShadowBanned(),
+		Deleted:   u.DeletedAt.Valid,
+		DeletedAt: deletedAt(u.DeletedAt),
+		CreatedAt: u.CreatedAt,
+	}
+}
+
+// ToSearchMatch converts Post to SearchMatchResponse, labelled by title
+func (p *Post) ToSearchMatch(matchedOn string) *SearchMatchResponse {
+	userID := p.UserID
+	return &SearchMatchResponse{
+		Type:      SearchMatchPost,
+		ID:        p.ID,
+		MatchedOn: matchedOn,
+		Label:     p.Title,
+		Status:    string(p.Status),
+		UserID:    &userID,
+		Shadowed:  p.Shadowed,
+		Deleted:   p.DeletedAt.Valid,
+		DeletedAt: deletedAt(p.DeletedAt),
+		CreatedAt: p.CreatedAt,
+	}
+}
+
+// ToSearchMatch converts Comment to SearchMatchResponse, labelled by the
+// start of its content
+func (c *Comment) ToSearchMatch(matchedOn string) *SearchMatchResponse {
+	userID, postID := c.UserID, c.PostID
+	label := []rune(c.Content)
+	if len(label) > searchSnippetLength {
+		label = append(label[:searchSnippetLength], '…')
+	}
+	return &SearchMatchResponse{
+		Type:      SearchMatchComment,
+		ID:        c.ID,
+		MatchedOn: matchedOn,
+		Label:     string(label),
+		Status:    string(c.Status),
+		UserID:    &userID,
+		PostID:    &postID,
+		Shadowed:  c.Shadowed,
+		Deleted:   c.DeletedAt.Valid,
+		DeletedAt: deletedAt(c.DeletedAt),
+		CreatedAt: c.CreatedAt,
+	}
+}
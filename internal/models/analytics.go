@@ -0,0 +1,87 @@
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// AnalyticsDateFormat is the format of the Day column of analytics aggregates
+const AnalyticsDateFormat = "2006-01-02"
+
+// PostDailyStat aggregates the views of a post on a single day (UTC).
+// PendingViews counts the views not yet added to the post's view count.
+type PostDailyStat struct {
+	PostID        uuid.UUID `gorm:"type:uuid;primaryKey" json:"post_id"`
+	Day           string    `gorm:"size:10;primaryKey" json:"date"`
+	Views         int64     `gorm:"not null;default:0" json:"views"`
+	UniqueReaders int64     `gorm:"not null;default:0" json:"unique_readers"`
+	PendingViews  int64     `gorm:"not null;default:0;index" json:"-"`
+	UpdatedAt     time.Time `json:"-"`
+}
+
+// TableName returns the table name for PostDailyStat model
+func (PostDailyStat) TableName() string {
+	return "post_daily_stats"
+}
+
+// PostReferrerStat aggregates the views of a post from a referrer on a single day (UTC)
+type PostReferrerStat struct {
+	PostID    uuid.UUID `gorm:"type:uuid;primaryKey" json:"post_id"`
+	Day       string    `gorm:"size:10;primaryKey" json:"date"`
+	Referrer  string    `gorm:"size:255;primaryKey" json:"referrer"`
+	Views     int64     `gorm:"not null;default:0" json:"views"`
+	UpdatedAt time.Time `json:"-"`
+}
+
+// TableName returns the table name for PostReferrerStat model
+func (PostReferrerStat) TableName() string {
+	return "post_referrer_stats"
+}
+
+// PostViewReader records that a reader viewed a post on a day, so unique
+// readers are only counted once per day. ReaderHash never holds raw IPs or IDs.
+type PostViewReader struct {
+	PostID     uuid.UUID `gorm:"type:uuid;primaryKey" json:"-"`
+	Day        string    `gorm:"size:10;primaryKey" json:"-"`
+	ReaderHash string    `gorm:"size:64;primaryKey" json:"-"`
+}
+
+// TableName returns the table name for PostViewReader model
+func (PostViewReader) TableName() string {
+	return "post_view_readers"
+}
+
+// DailyViews is a post's aggregated views for one day
+type DailyViews struct {
+	Date          string `json:"date"`
+	Views         int64  `json:"views"`
+	UniqueReaders int64  `json:"unique_readers"`
+}
+
+// ReferrerViews is a post's aggregated views from one referrer
+type ReferrerViews struct {
+	Referrer string `json:"referrer"`
+	Views    int64  `json:"views"`
+}
+
+// PostAnalytics is the analytics report of a post for a date range.
+// UniqueReaders is the sum of daily unique readers.
+type PostAnalytics struct {
+	PostID        uuid.UUID       `json:"post_id"`
+	From          string          `json:"from"`
+	To            string          `json:"to"`
+	TotalViews    int64           `json:"total_views"`
+	UniqueReaders int64           `json:"unique_readers"`
+	Daily         []DailyViews    `json:"daily"`
+	Referrers     []ReferrerViews `json:"referrers"`
+}
+
+// AuthorAnalyticsRow is one post-day row of an author's analytics export
+type AuthorAnalyticsRow struct {
+	Date          string    `json:"date"`
+	PostID        uuid.UUID `json:"post_id"`
+	PostTitle     string    `json:"post_title"`
+	Views         int64     `json:"views"`
+	UniqueReaders int64     `json:"unique_readers"`
+}
@@ -0,0 +1,118 @@
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// AppealType identifies the moderation decision an appeal contests
+type AppealType string
+
+const (
+	AppealTakedown AppealType = "takedown"
+	AppealBan      AppealType = "ban"
+)
+
+// AppealTypes lists the valid appeal types
+var AppealTypes = []AppealType{AppealTakedown, AppealBan}
+
+// AppealStatus is the review state of an appeal
+type AppealStatus string
+
+const (
+	AppealOpen        AppealStatus = "open"
+	AppealUnderReview AppealStatus = "under_review"
+	AppealUpheld      AppealStatus = "upheld"
+	AppealOverturned  AppealStatus = "overturned"
+)
+
+// AppealStatuses lists the valid appeal statuses
+var AppealStatuses = []AppealStatus{AppealOpen, AppealUnderReview, AppealUpheld, AppealOverturned}
+
+// appealTransitions lists the statuses each status can move to. Upheld and
+// overturned appeals are resolved and final.
+var appealTransitions = map[AppealStatus][]AppealStatus{
+	AppealOpen:        {AppealUnderReview, AppealUpheld, AppealOverturned},
+	AppealUnderReview: {AppealUpheld, AppealOverturned},
+}
+
+// Appeal is a user's request to reverse a moderation decision about their
+// content or account
+type Appeal struct {
+	BaseModel
+	Type       AppealType   `gorm:"type:varchar(20);not null;index" json:"type"`
+	Status     AppealStatus `gorm:"type:varchar(20);not null;default:open;index" json:"status"`
+	Message    string       `gorm:"type:text;not null" json:"message"`
+	Resolution string       `gorm:"size:1000" json:"resolution,omitempty"`
+	ReviewedAt *time.Time   `json:"reviewed_at,omitempty"`
+	ResolvedAt *time.Time   `json:"resolved_at,omitempty"`
+
+	// Foreign keys
+	UserID     uuid.UUID  `gorm:"type:uuid;not null;index" json:"user_id"`
+	PostID     *uuid.UUID `gorm:"type:uuid;index" json:"post_id,omitempty"`
+	ReviewerID *uuid.UUID `gorm:"type:uuid" json:"reviewer_id,omitempty"`
+
+	// Relations
+	User *User `gorm:"foreignKey:UserID" json:"user,omitempty"`
+	Post *Post `gorm:"foreignKey:PostID" json:"post,omitempty"`
+}
+
+// TableName returns the table name for Appeal model
+func (Appeal) TableName() string {
+	return "appeals"
+}
+
+// IsResolved checks if the appeal has been upheld or overturned
+func (a *Appeal) IsResolved() bool {
+	return a.Status == AppealUpheld || a.Status == AppealOverturned
+}
+
+// CanTransitionTo checks if the appeal can move to status
+func (a *Appeal) CanTransitionTo(status AppealStatus) bool {
+	for _, next := range appealTransitions[a.Status] {
+		if next == status {
+			return true
+		}
+	}
+	return false
+}
+
+// AppealResponse is the response structure for appeal data
+type AppealResponse struct {
+	ID         uuid.UUID            `json:"id"`
+	Type       AppealType           `json:"type"`
+	Status     AppealStatus         `json:"status"`
+	Message    string               `json:"message"`
+	Resolution string               `json:"resolution,omitempty"`
+	User       *UserResponse        `json:"user,omitempty"`
+	Post       *PostSummaryResponse `json:"post,omitempty"`
+	ReviewerID *uuid.UUID           `json:"reviewer_id,omitempty"`
+	ReviewedAt *time.Time           `json:"reviewed_at,omitempty"`
+	ResolvedAt *time.Time           `json:"resolved_at,omitempty"`
+	CreatedAt  time.Time            `json:"created_at"`
+	UpdatedAt  time.Time            `json:"updated_at"`
+}
+
+// ToResponse converts Appeal to AppealResponse
+func (a *Appeal) ToResponse() *AppealResponse {
+	response := &AppealResponse{
+		ID:         a.ID,
+		Type:       a.Type,
+		Status:     a.Status,
+		Message:    a.Message,
+		Resolution: a.Resolution,
+		ReviewerID: a.ReviewerID,
+		ReviewedAt: a.ReviewedAt,
+		ResolvedAt: a.ResolvedAt,
+		CreatedAt:  a.CreatedAt,
+		UpdatedAt:  a.UpdatedAt,
+	}
+	if a.User != nil {
+		response.User = a.User.ToResponse()
+	}
+	if a.Post != nil {
+		response.Post = a.Post.ToSummary()
+	}
+	return response
+}
@@ -0,0 +1,72 @@
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// BackfillStatus represents the state of a backfill job's latest run
+type BackfillStatus string
+
+const (
+	BackfillStatusRunning     BackfillStatus = "running"
+	BackfillStatusInterrupted BackfillStatus = "interrupted"
+	BackfillStatusFailed      BackfillStatus = "failed"
+	BackfillStatusCompleted   BackfillStatus = "completed"
+)
+
+// BackfillCheckpoint records the progress of a backfill job so an
+// interrupted or failed run can resume after the last processed row
+type BackfillCheckpoint struct {
+	BaseModel
+	Job        string         `gorm:"uniqueIndex;not null;size:100" json:"job"`
+	Status     BackfillStatus `gorm:"type:varchar(20);not null" json:"status"`
+	Cursor     string         `gorm:"size:255" json:"-"`
+	Processed  int64          `gorm:"default:0" json:"processed"`
+	Batches    int            `gorm:"default:0" json:"batches"`
+	LastError  string         `gorm:"size:1000" json:"last_error,omitempty"`
+	StartedAt  time.Time      `json:"started_at"`
+	FinishedAt *time.Time     `json:"finished_at,omitempty"`
+
+	// Foreign keys
+	StartedBy *uuid.UUID `gorm:"type:uuid" json:"started_by,omitempty"`
+}
+
+// TableName returns the table name for BackfillCheckpoint model
+func (BackfillCheckpoint) TableName() string {
+	return "backfill_checkpoints"
+}
+
+// IsFinished reports whether the checkpoint's run has finished successfully
+func (c *BackfillCheckpoint) IsFinished() bool {
+	return c.Status == BackfillStatusCompleted
+}
+
+// BackfillCheckpointResponse is the response structure for backfill checkpoint data
+type BackfillCheckpointResponse struct {
+	Status     BackfillStatus `json:"status"`
+	Processed  int64          `json:"processed"`
+	Batches    int            `json:"batches"`
+	Resumable  bool           `json:"resumable"`
+	LastError  string         `json:"last_error,omitempty"`
+	StartedBy  *uuid.UUID     `json:"started_by,omitempty"`
+	StartedAt  time.Time      `json:"started_at"`
+	FinishedAt *time.Time     `json:"finished_at,omitempty"`
+	UpdatedAt  time.Time      `json:"updated_at"`
+}
+
+// ToResponse converts BackfillCheckpoint to BackfillCheckpointResponse
+func (c *BackfillCheckpoint) ToResponse() *BackfillCheckpointResponse {
+	return &BackfillCheckpointResponse{
+		Status:     c.Status,
+		Processed:  c.Processed,
+		Batches:    c.Batches,
+		Resumable:  c.Cursor != "" && !c.IsFinished(),
+		LastError:  c.LastError,
+		StartedBy:  c.StartedBy,
+		StartedAt:  c.StartedAt,
+		FinishedAt: c.FinishedAt,
+		UpdatedAt:  c.UpdatedAt,
+	}
+}
@@ -0,0 +1,103 @@
+package models
+
+import (
+	"encoding/json"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// SiteBrandingID is the primary key of the site's branding. A site has a
+// single branding record.
+const SiteBrandingID = 1
+
+// Default branding colors, used until an admin sets their own
+const (
+	DefaultPrimaryColor = "#18181b"
+	DefaultAccentColor  = "#2563eb"
+)
+
+// Branding is the look of the site: its title, logo, colors and footer
+// links. It is shown on the static site and in emails.
+type Branding struct {
+	ID           uint       `gorm:"primaryKey;autoIncrement:false" json:"-"`
+	SiteTitle    string     `gorm:"size:100" json:"site_title"`
+	LogoURL      string     `gorm:"size:2048" json:"logo_url"`
+	PrimaryColor string     `gorm:"size:9" json:"primary_color"`
+	AccentColor  string     `gorm:"size:9" json:"accent_color"`
+	FooterLinks  string     `gorm:"type:text" json:"-"`
+	UpdatedBy    *uuid.UUID `gorm:"type:uuid" json:"updated_by,omitempty"`
+	UpdatedAt    time.Time  `json:"updated_at"`
+}
+
+// TableName returns the table name for Branding model
+func (Branding) TableName() string {
+	return "branding"
+}
+
+// FooterLink is a link shown in the footer of pages and emails
+type FooterLink struct {
+	Label string `json:"label"`
+	URL   string `json:"url"`
+}
+
+// FooterLinkList returns the footer links in display order
+func (b *Branding) FooterLinkList() []FooterLink {
+	links := []FooterLink{}
+	if b.FooterLinks != "" {
+		// Footer links are only ever written by SetFooterLinks
+		_ = json.Unmarshal([]byte(b.FooterLinks), &links)
+	}
+	return links
+}
+
+// SetFooterLinks sets the footer links
+func (b *Branding) SetFooterLinks(links []FooterLink) {
+	if len(links) == 0 {
+		b.FooterLinks = ""
+		return
+	}
+	data, _ := json.Marshal(links)
+	b.FooterLinks = string(data)
+}
+
+// WithDefaults returns a copy of the branding with unset fields filled in:
+// the site title falls back to siteName and the colors to the defaults
+func (b *Branding) WithDefaults(siteName string) *Branding {
+	out := *b
+	if out.SiteTitle == "" {
+		out.SiteTitle = siteName
+	}
+	if out.PrimaryColor == "" {
+		out.PrimaryColor = DefaultPrimaryColor
+	}
+	if out.AccentColor == "" {
+		out.AccentColor = DefaultAccentColor
+	}
+	return &out
+}
+
+// BrandingResponse is the response structure for branding data
+type BrandingResponse struct {
+	SiteTitle    string       `json:"site_title"`
+	LogoURL      string       `json:"logo_url,omitempty"`
+	PrimaryColor string       `json:"primary_color"`
+	AccentColor  string       `json:"accent_color"`
+	FooterLinks  []FooterLink `json:"footer_links"`
+	UpdatedAt    *time.Time   `json:"updated_at,omitempty"`
+}
+
+// ToResponse converts Branding to BrandingResponse
+func (b *Branding) ToResponse() *BrandingResponse {
+	resp := &BrandingResponse{
+		SiteTitle:    b.SiteTitle,
+		LogoURL:      b.LogoURL,
+		PrimaryColor: b.PrimaryColor,
+		AccentColor:  b.AccentColor,
+		FooterLinks:  b.FooterLinkList(),
+	}
+	if !b.UpdatedAt.IsZero() {
+		resp.UpdatedAt = &b.UpdatedAt
+	}
+	return resp
+}
@@ -0,0 +1,55 @@
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Entity types tracked in the changes table
+const (
+	ChangeEntityPost  = "post"
+	ChangeEntityUser  = "user"
+	ChangeEntityMedia = "media"
+)
+
+// Change records a single field-level modification of an entity
+type Change struct {
+	BaseModel
+	EntityType string     `gorm:"not null;size:50;index:idx_changes_entity" json:"entity_type"`
+	EntityID   uuid.UUID  `gorm:"type:uuid;not null;index:idx_changes_entity" json:"entity_id"`
+	Field      string     `gorm:"not null;size:100" json:"field"`
+	OldValue   string     `gorm:"type:text" json:"old_value"`
+	NewValue   string     `gorm:"type:text" json:"new_value"`
+	ActorID    *uuid.UUID `gorm:"type:uuid" json:"actor_id,omitempty"`
+	Country    string     `gorm:"size:2" json:"country,omitempty"`
+}
+
+// TableName returns the table name for Change model
+func (Change) TableName() string {
+	return "changes"
+}
+
+// ChangeResponse is the response structure for change data
+type ChangeResponse struct {
+	ID        uuid.UUID  `json:"id"`
+	Field     string     `json:"field"`
+	OldValue  string     `json:"old_value"`
+	NewValue  string     `json:"new_value"`
+	ActorID   *uuid.UUID `json:"actor_id,omitempty"`
+	Country   string     `json:"country,omitempty"`
+	CreatedAt time.Time  `json:"created_at"`
+}
+
+// ToResponse converts Change to ChangeResponse
+func (c *Change) ToResponse() *ChangeResponse {
+	return &ChangeResponse{
+		ID:        c.ID,
+		Field:     c.Field,
+		OldValue:  c.OldValue,
+		NewValue:  c.NewValue,
+		ActorID:   c.ActorID,
+		Country:   c.Country,
+		CreatedAt: c.CreatedAt,
+	}
+}
@@ -0,0 +1,89 @@
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// CommentStatus represents the moderation state of a comment
+type CommentStatus string
+
+const (
+	CommentStatusPending  CommentStatus = "pending"
+	CommentStatusApproved CommentStatus = "approved"
+	CommentStatusSpam     CommentStatus = "spam"
+)
+
+// Comment represents a comment on a post
+type Comment struct {
+	BaseModel
+	Content     string        `gorm:"type:text;not null" json:"content"`
+	Status      CommentStatus `gorm:"type:varchar(20);default:pending;index" json:"status"`
+	ModeratedAt *time.Time    `json:"moderated_at,omitempty"`
+
+	// Shadowed hides the comment from everyone but its author, admins and
+	// moderators. Comments written while their author is shadow-banned are
+	// shadowed.
+	Shadowed bool `gorm:"default:false;index" json:"-"`
+
+	// Foreign keys
+	PostID      uuid.UUID  `gorm:"type:uuid;not null;index" json:"post_id"`
+	UserID      uuid.UUID  `gorm:"type:uuid;not null;index" json:"user_id"`
+	ModeratedBy *uuid.UUID `gorm:"type:uuid" json:"moderated_by,omitempty"`
+
+	// Relations
+	Post *Post `gorm:"foreignKey:PostID" json:"post,omitempty"`
+	User *User `gorm:"foreignKey:UserID" json:"author,omitempty"`
+}
+
+// TableName returns the table name for Comment model
+func (Comment) TableName() string {
+	return "comments"
+}
+
+// IsApproved checks if the comment is publicly visible
+func (c *Comment) IsApproved() bool {
+	return c.Status == CommentStatusApproved
+}
+
+// CommentResponse is the response structure for comment data
+type CommentResponse struct {
+	ID          uuid.UUID     `json:"id"`
+	PostID      uuid.UUID     `json:"post_id"`
+	Content     string        `json:"content"`
+	Status      CommentStatus `json:"status"`
+	Author      *UserResponse `json:"author,omitempty"`
+	ModeratedBy *uuid.UUID    `json:"moderated_by,omitempty"`
+	ModeratedAt *time.Time    `json:"moderated_at,omitempty"`
+	CreatedAt   time.Time     `json:"created_at"`
+	UpdatedAt   time.Time     `json:"updated_at"`
+}
+
+// ToResponseFor converts Comment to CommentResponse as seen by viewer (nil
+// for anonymous viewers). Only admins and moderators can tell spam from
+// pending comments, so spammers are not told their comment was caught.
+func (c *Comment) ToResponseFor(viewer *User) *CommentResponse {
+	response := &CommentResponse{
+		ID:        c.ID,
+		PostID:    c.PostID,
+		Content:   c.Content,
+		Status:    c.Status,
+		CreatedAt: c.CreatedAt,
+		UpdatedAt: c.UpdatedAt,
+	}
+
+	staff := viewer != nil && (viewer.IsAdmin() || viewer.IsModerator())
+	if staff {
+		response.ModeratedBy = c.ModeratedBy
+		response.ModeratedAt = c.ModeratedAt
+	} else if c.Status == CommentStatusSpam {
+		response.Status = CommentStatusPending
+	}
+
+	if c.User != nil {
+		response.Author = c.User.ToResponseFor(viewer)
+	}
+
+	return response
+}
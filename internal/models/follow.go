@@ -0,0 +1,29 @@
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// UserFollow records that a user follows another user
+type UserFollow struct {
+	FollowerID uuid.UUID `gorm:"type:uuid;primaryKey" json:"follower_id"`
+	FolloweeID uuid.UUID `gorm:"type:uuid;primaryKey;index" json:"followee_id"`
+	CreatedAt  time.Time `json:"created_at"`
+
+	// Relations
+	Follower *User `gorm:"foreignKey:FollowerID" json:"-"`
+	Followee *User `gorm:"foreignKey:FolloweeID" json:"-"`
+}
+
+// TableName returns the table name for UserFollow model
+func (UserFollow) TableName() string {
+	return "user_follows"
+}
+
+// FollowResponse is the response structure for an entry of a followers or following list
+type FollowResponse struct {
+	User       *UserResponse `json:"user"`
+	FollowedAt time.Time     `json:"followed_at"`
+}
@@ -0,0 +1,29 @@
+package models
+
+import "time"
+
+// IdempotencyKey is a client-chosen key of a POST request together with the
+// response it produced, replayed when the request is retried with the same
+// key. Scope identifies the client the key belongs to, so clients cannot
+// see each other's responses by guessing keys. A StatusCode of 0 means the
+// request is still being processed.
+type IdempotencyKey struct {
+	Scope       string    `gorm:"primaryKey;size:64" json:"-"`
+	Key         string    `gorm:"column:idempotency_key;primaryKey;size:255" json:"key"`
+	RequestHash string    `gorm:"size:64" json:"-"`
+	StatusCode  int       `gorm:"not null;default:0" json:"status_code"`
+	ContentType string    `gorm:"size:255" json:"-"`
+	Body        []byte    `json:"-"`
+	CreatedAt   time.Time `json:"created_at"`
+	ExpiresAt   time.Time `gorm:"index" json:"expires_at"`
+}
+
+// TableName returns the table name for IdempotencyKey model
+func (IdempotencyKey) TableName() string {
+	return "idempotency_keys"
+}
+
+// IsComplete reports whether the response of the request was stored
+func (k *IdempotencyKey) IsComplete() bool {
+	return k.StatusCode != 0
+}
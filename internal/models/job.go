@@ -0,0 +1,104 @@
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// JobStatus is the state of a background job
+type JobStatus string
+
+const (
+	JobPending   JobStatus = "pending"
+	JobRunning   JobStatus = "running"
+	JobSucceeded JobStatus = "succeeded"
+	JobFailed    JobStatus = "failed"
+)
+
+// IsValid checks if the job status is one of the known statuses
+func (s JobStatus) IsValid() bool {
+	switch s {
+	case JobPending, JobRunning, JobSucceeded, JobFailed:
+		return true
+	}
+	return false
+}
+
+// Job is a unit of background work. Pending jobs run once RunAt has passed;
+// a running job whose lease, LockedUntil, has expired is assumed lost and
+// run again. A job is not queued while a pending job shares its UniqueKey;
+// once that job has started, changes it may have missed can be queued again.
+// RequestID and Traceparent correlate a job with the request that queued it.
+type Job struct {
+	BaseModel
+	Type        string     `gorm:"not null;size:100;index" json:"type"`
+	Payload     string     `gorm:"type:text" json:"payload"`
+	Status      JobStatus  `gorm:"type:varchar(20);not null;default:pending;index" json:"status"`
+	Attempts    int        `gorm:"default:0" json:"attempts"`
+	MaxAttempts int        `gorm:"not null" json:"max_attempts"`
+	RunAt       time.Time  `gorm:"not null;index" json:"run_at"`
+	LockedUntil *time.Time `json:"locked_until,omitempty"`
+	StartedAt   *time.Time `json:"started_at,omitempty"`
+	FinishedAt  *time.Time `gorm:"index" json:"finished_at,omitempty"`
+	LastError   string     `gorm:"size:1024" json:"last_error,omitempty"`
+	UniqueKey   string     `gorm:"size:255;index" json:"unique_key,omitempty"`
+	RequestID   string     `gorm:"size:128;index" json:"request_id,omitempty"`
+	Traceparent string     `gorm:"size:55" json:"-"`
+}
+
+// TableName returns the table name for Job model
+func (Job) TableName() string {
+	return "jobs"
+}
+
+// IsFinished reports whether the job has succeeded or failed for good
+func (j *Job) IsFinished() bool {
+	return j.Status == JobSucceeded || j.Status == JobFailed
+}
+
+// JobResponse is the response structure for job data
+type JobResponse struct {
+	ID          uuid.UUID  `json:"id"`
+	Type        string     `json:"type"`
+	Payload     string     `json:"payload,omitempty"`
+	Status      JobStatus  `json:"status"`
+	Attempts    int        `json:"attempts"`
+	MaxAttempts int        `json:"max_attempts"`
+	RunAt       time.Time  `json:"run_at"`
+	StartedAt   *time.Time `json:"started_at,omitempty"`
+	FinishedAt  *time.Time `json:"finished_at,omitempty"`
+	LastError   string     `json:"last_error,omitempty"`
+	UniqueKey   string     `json:"unique_key,omitempty"`
+	RequestID   string     `json:"request_id,omitempty"`
+	CreatedAt   time.Time  `json:"created_at"`
+	UpdatedAt   time.Time  `json:"updated_at"`
+}
+
+// ToResponse converts Job to JobResponse
+func (j *Job) ToResponse() *JobResponse {
+	return &JobResponse{
+		ID:          j.ID,
+		Type:        j.Type,
+		Payload:     j.Payload,
+		Status:      j.Status,
+		Attempts:    j.Attempts,
+		MaxAttempts: j.MaxAttempts,
+		RunAt:       j.RunAt,
+		StartedAt:   j.StartedAt,
+		FinishedAt:  j.FinishedAt,
+		LastError:   j.LastError,
+		UniqueKey:   j.UniqueKey,
+		RequestID:   j.RequestID,
+		CreatedAt:   j.CreatedAt,
+		UpdatedAt:   j.UpdatedAt,
+	}
+}
+
+// JobStats counts jobs by status
+type JobStats struct {
+	Pending   int64 `json:"pending"`
+	Running   int64 `json:"running"`
+	Succeeded int64 `json:"succeeded"`
+	Failed    int64 `json:"failed"`
+}
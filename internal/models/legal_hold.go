@@ -0,0 +1,42 @@
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// LegalHold marks a record that must be preserved, e.g. for litigation. Held
+// records cannot be deleted or anonymized until the hold is lifted.
+type LegalHold struct {
+	LegalHoldAt     *time.Time `json:"-"`
+	LegalHoldBy     *uuid.UUID `gorm:"type:uuid" json:"-"`
+	LegalHoldReason string     `gorm:"size:500" json:"-"`
+}
+
+// OnLegalHold checks if the record is under legal hold
+func (h *LegalHold) OnLegalHold() bool {
+	return h.LegalHoldAt != nil
+}
+
+// LegalHoldResponse is the response structure for a record's legal hold
+type LegalHoldResponse struct {
+	EntityType string     `json:"entity_type"`
+	EntityID   uuid.UUID  `json:"entity_id"`
+	Active     bool       `json:"active"`
+	Reason     string     `json:"reason,omitempty"`
+	PlacedBy   *uuid.UUID `json:"placed_by,omitempty"`
+	PlacedAt   *time.Time `json:"placed_at,omitempty"`
+}
+
+// ToLegalHoldResponse converts a LegalHold into its response for an entity
+func (h *LegalHold) ToLegalHoldResponse(entityType string, entityID uuid.UUID) *LegalHoldResponse {
+	return &LegalHoldResponse{
+		EntityType: entityType,
+		EntityID:   entityID,
+		Active:     h.OnLegalHold(),
+		Reason:     h.LegalHoldReason,
+		PlacedBy:   h.LegalHoldBy,
+		PlacedAt:   h.LegalHoldAt,
+	}
+}
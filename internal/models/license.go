@@ -0,0 +1,73 @@
+package models
+
+// License identifies the terms under which a post may be reused
+type License string
+
+// Supported licenses: the Creative Commons 4.0 licenses, CC0, and custom
+// terms described by the author
+const (
+	LicenseCCBY     License = "cc-by-4.0"
+	LicenseCCBYSA   License = "cc-by-sa-4.0"
+	LicenseCCBYND   License = "cc-by-nd-4.0"
+	LicenseCCBYNC   License = "cc-by-nc-4.0"
+	LicenseCCBYNCSA License = "cc-by-nc-sa-4.0"
+	LicenseCCBYNCND License = "cc-by-nc-nd-4.0"
+	LicenseCC0      License = "cc0-1.0"
+	LicenseCustom   License = "custom"
+)
+
+// Licenses lists the supported licenses
+var Licenses = []License{
+	LicenseCCBY, LicenseCCBYSA, LicenseCCBYND, LicenseCCBYNC,
+	LicenseCCBYNCSA, LicenseCCBYNCND, LicenseCC0, LicenseCustom,
+}
+
+// licenseInfo is the name and deed of a standard license
+type licenseInfo struct {
+	name string
+	url  string
+}
+
+// standardLicenses describes the licenses with published terms
+var standardLicenses = map[License]licenseInfo{
+	LicenseCCBY:     {"CC BY 4.0", "https://creativecommons.org/licenses/by/4.0/"},
+	LicenseCCBYSA:   {"CC BY-SA 4.0", "https://creativecommons.org/licenses/by-sa/4.0/"},
+	LicenseCCBYND:   {"CC BY-ND 4.0", "https://creativecommons.org/licenses/by-nd/4.0/"},
+	LicenseCCBYNC:   {"CC BY-NC 4.0", "https://creativecommons.org/licenses/by-nc/4.0/"},
+	LicenseCCBYNCSA: {"CC BY-NC-SA 4.0", "https://creativecommons.org/licenses/by-nc-sa/4.0/"},
+	LicenseCCBYNCND: {"CC BY-NC-ND 4.0", "https://creativecommons.org/licenses/by-nc-nd/4.0/"},
+	LicenseCC0:      {"CC0 1.0", "https://creativecommons.org/publicdomain/zero/1.0/"},
+}
+
+// IsValid reports whether the license is supported
+func (l License) IsValid() bool {
+	_, ok := standardLicenses[l]
+	return ok || l == LicenseCustom
+}
+
+// IsStandard reports whether the license has published terms, i.e. is not
+// custom
+func (l License) IsStandard() bool {
+	_, ok := standardLicenses[l]
+	return ok
+}
+
+// LicenseResponse is the response structure for a post's license. Custom
+// licenses are named and linked by the author.
+type LicenseResponse struct {
+	ID   License `json:"id"`
+	Name string  `json:"name"`
+	URL  string  `json:"url,omitempty"`
+}
+
+// ToResponse describes the license, with the name and URL of custom terms
+// given by the author. It returns nil for an unset license.
+func (l License) ToResponse(customName, customURL string) *LicenseResponse {
+	if l == "" {
+		return nil
+	}
+	if info, ok := standardLicenses[l]; ok {
+		return &LicenseResponse{ID: l, Name: info.name, URL: info.url}
+	}
+	return &LicenseResponse{ID: l, Name: customName, URL: customURL}
+}
@@ -0,0 +1,139 @@
+package models
+
+import (
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// MediaVariant names a resized copy of an uploaded image
+type MediaVariant string
+
+const (
+	MediaThumbnail MediaVariant = "thumbnail"
+	MediaMedium    MediaVariant = "medium"
+	MediaLarge     MediaVariant = "large"
+)
+
+// MediaVariants lists the variants generated for uploaded images
+var MediaVariants = []MediaVariant{MediaThumbnail, MediaMedium, MediaLarge}
+
+// MediaStatus represents whether uploaded media may be served
+type MediaStatus string
+
+const (
+	MediaAvailable   MediaStatus = "available"
+	MediaQuarantined MediaStatus = "quarantined"
+)
+
+// Media is a file uploaded to a user's media library. The file is kept in
+// storage under Key; ContentType is sniffed from its content rather than
+// taken from the client. Images also have variants, stored next to the file,
+// and their dimensions. Private files are only served to their owner and
+// through signed URLs. Files the malware scan found malware in, or failed to
+// scan, are quarantined: kept for review, with the scan's finding in
+// ScanResult, but never served.
+type Media struct {
+	BaseModel
+	Key         string      `gorm:"uniqueIndex;not null;size:500" json:"-"`
+	FileName    string      `gorm:"not null;size:255" json:"file_name"`
+	ContentType string      `gorm:"not null;size:100" json:"content_type"`
+	Size        int64       `gorm:"not null" json:"size"`
+	Width       int         `json:"width,omitempty"`
+	Height      int         `json:"height,omitempty"`
+	Variants    string      `gorm:"size:100" json:"-"`
+	Private     bool        `gorm:"default:false" json:"private"`
+	Status      MediaStatus `gorm:"size:20;not null;default:available;index" json:"status"`
+	ScanResult  string      `gorm:"size:255" json:"-"`
+
+	// Foreign keys
+	UserID uuid.UUID `gorm:"type:uuid;not null;index" json:"user_id"`
+}
+
+// TableName returns the table name for Media model
+func (Media) TableName() string {
+	return "media"
+}
+
+// IsImage checks if the media is an image
+func (m *Media) IsImage() bool {
+	return strings.HasPrefix(m.ContentType, "image/")
+}
+
+// IsQuarantined checks if the media was quarantined by the malware scan
+func (m *Media) IsQuarantined() bool {
+	return m.Status == MediaQuarantined
+}
+
+// VariantList returns the variants stored for the media
+func (m *Media) VariantList() []MediaVariant {
+	if m.Variants == "" {
+		return []MediaVariant{}
+	}
+	parts := strings.Split(m.Variants, ",")
+	variants := make([]MediaVariant, len(parts))
+	for i, part := range parts {
+		variants[i] = MediaVariant(part)
+	}
+	return variants
+}
+
+// SetVariants sets the variants stored for the media
+func (m *Media) SetVariants(variants []MediaVariant) {
+	parts := make([]string, len(variants))
+	for i, variant := range variants {
+		parts[i] = string(variant)
+	}
+	m.Variants = strings.Join(parts, ",")
+}
+
+// HasVariant checks if a variant is stored for the media
+func (m *Media) HasVariant(variant MediaVariant) bool {
+	for _, v := range m.VariantList() {
+		if v == variant {
+			return true
+		}
+	}
+	return false
+}
+
+// VariantKey returns the storage key of a variant
+func (m *Media) VariantKey(variant MediaVariant) string {
+	return m.Key + "-" + string(variant)
+}
+
+// MediaResponse is the response structure for media data
+type MediaResponse struct {
+	ID          uuid.UUID               `json:"id"`
+	URL         string                  `json:"url"`
+	Variants    map[MediaVariant]string `json:"variants,omitempty"`
+	FileName    string                  `json:"file_name"`
+	ContentType string                  `json:"content_type"`
+	Size        int64                   `json:"size"`
+	Width       int                     `json:"width,omitempty"`
+	Height      int                     `json:"height,omitempty"`
+	Private     bool                    `json:"private"`
+	Status      MediaStatus             `json:"status"`
+	UserID      uuid.UUID               `json:"user_id"`
+	CreatedAt   time.Time               `json:"created_at"`
+}
+
+// ToResponse converts Media to MediaResponse with the URLs the file and its
+// variants are served at
+func (m *Media) ToResponse(url string, variants map[MediaVariant]string) *MediaResponse {
+	return &MediaResponse{
+		ID:          m.ID,
+		URL:         url,
+		Variants:    variants,
+		FileName:    m.FileName,
+		ContentType: m.ContentType,
+		Size:        m.Size,
+		Width:       m.Width,
+		Height:      m.Height,
+		Private:     m.Private,
+		Status:      m.Status,
+		UserID:      m.UserID,
+		CreatedAt:   m.CreatedAt,
+	}
+}
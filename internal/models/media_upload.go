@@ -0,0 +1,54 @@
+package models
+
+import (
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// MediaUpload is a chunked upload to a user's media library in progress.
+// Chunks are appended in order at Received, the number of bytes received so
+// far, and kept in storage under the keys in Chunks until the upload is
+// completed into media. Uploads that receive no chunk before ExpiresAt are
+// abandoned and deleted.
+type MediaUpload struct {
+	ID        uuid.UUID `gorm:"type:uuid;primary_key" json:"id"`
+	FileName  string    `gorm:"not null;size:255" json:"file_name"`
+	Size      int64     `gorm:"not null" json:"size"`
+	Received  int64     `gorm:"not null;default:0" json:"offset"`
+	Chunks    string    `gorm:"type:text" json:"-"`
+	Private   bool      `gorm:"default:false" json:"private"`
+	UserID    uuid.UUID `gorm:"type:uuid;not null;index" json:"user_id"`
+	CreatedAt time.Time `json:"created_at"`
+	UpdatedAt time.Time `json:"updated_at"`
+	ExpiresAt time.Time `gorm:"index" json:"expires_at"`
+}
+
+// TableName returns the table name for MediaUpload model
+func (MediaUpload) TableName() string {
+	return "media_uploads"
+}
+
+// ChunkKeys returns the storage keys of the received chunks, in order
+func (u *MediaUpload) ChunkKeys() []string {
+	if u.Chunks == "" {
+		return []string{}
+	}
+	return strings.Split(u.Chunks, ",")
+}
+
+// AddChunk records a received chunk of size bytes stored under key
+func (u *MediaUpload) AddChunk(key string, size int64) {
+	if u.Chunks == "" {
+		u.Chunks = key
+	} else {
+		u.Chunks += "," + key
+	}
+	u.Received += size
+}
+
+// IsComplete reports whether every byte of the upload was received
+func (u *MediaUpload) IsComplete() bool {
+	return u.Received == u.Size
+}
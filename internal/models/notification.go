@@ -0,0 +1,87 @@
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// NotificationType identifies the event a notification reports
+type NotificationType string
+
+const (
+	NotificationComment         NotificationType = "comment"
+	NotificationFollow          NotificationType = "follow"
+	NotificationCommentApproved NotificationType = "comment_approved"
+	NotificationTakedown        NotificationType = "takedown"
+	NotificationReinstated      NotificationType = "reinstated"
+	NotificationAppealResolved  NotificationType = "appeal_resolved"
+)
+
+// Notification is an in-app notification of an event concerning a user
+type Notification struct {
+	BaseModel
+	Type   NotificationType `gorm:"type:varchar(30);not null" json:"type"`
+	Reason string           `gorm:"size:30" json:"reason,omitempty"`
+	ReadAt *time.Time       `json:"read_at,omitempty"`
+
+	// Foreign keys
+	UserID    uuid.UUID  `gorm:"type:uuid;not null;index" json:"user_id"`
+	ActorID   *uuid.UUID `gorm:"type:uuid" json:"actor_id,omitempty"`
+	PostID    *uuid.UUID `gorm:"type:uuid" json:"post_id,omitempty"`
+	CommentID *uuid.UUID `gorm:"type:uuid" json:"comment_id,omitempty"`
+
+	// Relations
+	User    *User    `gorm:"foreignKey:UserID" json:"-"`
+	Actor   *User    `gorm:"foreignKey:ActorID" json:"actor,omitempty"`
+	Post    *Post    `gorm:"foreignKey:PostID" json:"post,omitempty"`
+	Comment *Comment `gorm:"foreignKey:CommentID" json:"-"`
+}
+
+// TableName returns the table name for Notification model
+func (Notification) TableName() string {
+	return "notifications"
+}
+
+// IsRead checks if the notification has been read
+func (n *Notification) IsRead() bool {
+	return n.ReadAt != nil
+}
+
+// NotificationResponse is the response structure for notification data
+type NotificationResponse struct {
+	ID        uuid.UUID            `json:"id"`
+	Type      NotificationType     `json:"type"`
+	Actor     *UserResponse        `json:"actor,omitempty"`
+	Post      *PostSummaryResponse `json:"post,omitempty"`
+	CommentID *uuid.UUID           `json:"comment_id,omitempty"`
+	Reason    string               `json:"reason,omitempty"`
+	AppealURL string               `json:"appeal_url,omitempty"`
+	Read      bool                 `json:"read"`
+	ReadAt    *time.Time           `json:"read_at,omitempty"`
+	CreatedAt time.Time            `json:"created_at"`
+}
+
+// ToResponseFor converts Notification to NotificationResponse as seen by
+// its recipient
+func (n *Notification) ToResponseFor(viewer *User) *NotificationResponse {
+	response := &NotificationResponse{
+		ID:        n.ID,
+		Type:      n.Type,
+		CommentID: n.CommentID,
+		Reason:    n.Reason,
+		Read:      n.IsRead(),
+		ReadAt:    n.ReadAt,
+		CreatedAt: n.CreatedAt,
+	}
+	if n.Actor != nil {
+		response.Actor = n.Actor.ToResponseFor(viewer)
+	}
+	if n.Post != nil {
+		response.Post = n.Post.ToSummary()
+	}
+	if n.Type == NotificationTakedown && n.PostID != nil {
+		response.AppealURL = TakedownAppealPath(*n.PostID)
+	}
+	return response
+}
@@ -0,0 +1,72 @@
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// OperationType represents the kind of bulk admin operation
+type OperationType string
+
+const (
+	OperationBulkDeleteUsers  OperationType = "users.bulk_delete"
+	OperationBulkUpdateRole   OperationType = "users.bulk_update_role"
+	OperationBulkUpdateStatus OperationType = "users.bulk_update_status"
+)
+
+// SnapshotRow holds the pre-operation values of the columns an operation touched
+type SnapshotRow map[string]interface{}
+
+// AdminOperation records a bulk admin operation together with a restore point
+type AdminOperation struct {
+	BaseModel
+	Type          OperationType `gorm:"type:varchar(50);not null;index" json:"type"`
+	TargetTable   string        `gorm:"not null;size:50" json:"-"`
+	Snapshot      string        `gorm:"type:text" json:"-"`
+	AffectedCount int           `gorm:"default:0" json:"affected_count"`
+	ExpiresAt     time.Time     `gorm:"index" json:"expires_at"`
+	UndoneAt      *time.Time    `json:"undone_at,omitempty"`
+
+	// Foreign keys
+	ActorID  uuid.UUID  `gorm:"type:uuid;not null" json:"actor_id"`
+	UndoneBy *uuid.UUID `gorm:"type:uuid" json:"undone_by,omitempty"`
+}
+
+// TableName returns the table name for AdminOperation model
+func (AdminOperation) TableName() string {
+	return "admin_operations"
+}
+
+// CanUndo checks if the operation can still be undone
+func (o *AdminOperation) CanUndo() bool {
+	return o.UndoneAt == nil && time.Now().Before(o.ExpiresAt)
+}
+
+// AdminOperationResponse is the response structure for admin operation data
+type AdminOperationResponse struct {
+	ID            uuid.UUID     `json:"id"`
+	Type          OperationType `json:"type"`
+	AffectedCount int           `json:"affected_count"`
+	ActorID       uuid.UUID     `json:"actor_id"`
+	CanUndo       bool          `json:"can_undo"`
+	ExpiresAt     time.Time     `json:"expires_at"`
+	UndoneAt      *time.Time    `json:"undone_at,omitempty"`
+	UndoneBy      *uuid.UUID    `json:"undone_by,omitempty"`
+	CreatedAt     time.Time     `json:"created_at"`
+}
+
+// ToResponse converts AdminOperation to AdminOperationResponse
+func (o *AdminOperation) ToResponse() *AdminOperationResponse {
+	return &AdminOperationResponse{
+		ID:            o.ID,
+		Type:          o.Type,
+		AffectedCount: o.AffectedCount,
+		ActorID:       o.ActorID,
+		CanUndo:       o.CanUndo(),
+		ExpiresAt:     o.ExpiresAt,
+		UndoneAt:      o.UndoneAt,
+		UndoneBy:      o.UndoneBy,
+		CreatedAt:     o.CreatedAt,
+	}
+}
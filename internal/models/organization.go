@@ -0,0 +1,102 @@
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// OrgRole is the role of a member within an organization
+type OrgRole string
+
+const (
+	OrgRoleOwner  OrgRole = "owner"
+	OrgRoleAdmin  OrgRole = "admin"
+	OrgRoleMember OrgRole = "member"
+)
+
+// OrgRoles lists the valid organization roles
+var OrgRoles = []OrgRole{OrgRoleOwner, OrgRoleAdmin, OrgRoleMember}
+
+// CanManageMembers reports whether members with the role may add, change
+// and remove the organization's members
+func (r OrgRole) CanManageMembers() bool {
+	return r == OrgRoleOwner || r == OrgRoleAdmin
+}
+
+// Organization is a tenant. Posts written within an organization are only
+// visible through it, and its members are the users who may write them.
+type Organization struct {
+	BaseModel
+	Name string `gorm:"not null;size:100" json:"name"`
+	// Slug identifies the organization in the X-Organization header and as
+	// the subdomain of its site, so it must be a valid DNS label
+	Slug string `gorm:"uniqueIndex;not null;size:63" json:"slug"`
+}
+
+// TableName returns the table name for Organization model
+func (Organization) TableName() string {
+	return "organizations"
+}
+
+// OrganizationMember records that a user is a member of an organization
+type OrganizationMember struct {
+	OrganizationID uuid.UUID `gorm:"type:uuid;primaryKey" json:"organization_id"`
+	UserID         uuid.UUID `gorm:"type:uuid;primaryKey;index" json:"user_id"`
+	Role           OrgRole   `gorm:"type:varchar(20);not null;default:member" json:"role"`
+	CreatedAt      time.Time `json:"created_at"`
+	UpdatedAt      time.Time `json:"updated_at"`
+
+	// Relations
+	Organization *Organization `gorm:"foreignKey:OrganizationID" json:"-"`
+	User         *User         `gorm:"foreignKey:UserID" json:"-"`
+}
+
+// TableName returns the table name for OrganizationMember model
+func (OrganizationMember) TableName() string {
+	return "organization_members"
+}
+
+// OrganizationResponse is the response structure for organization data.
+// Role is the current user's role, when listing their organizations.
+type OrganizationResponse struct {
+	ID        uuid.UUID `json:"id"`
+	Name      string    `json:"name"`
+	Slug      string    `json:"slug"`
+	Role      OrgRole   `json:"role,omitempty"`
+	CreatedAt time.Time `json:"created_at"`
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+// ToResponse converts Organization to OrganizationResponse
+func (o *Organization) ToResponse() *OrganizationResponse {
+	return &OrganizationResponse{
+		ID:        o.ID,
+		Name:      o.Name,
+		Slug:      o.Slug,
+		CreatedAt: o.CreatedAt,
+		UpdatedAt: o.UpdatedAt,
+	}
+}
+
+// OrganizationMemberResponse is the response structure for an entry of an
+// organization's member list
+type OrganizationMemberResponse struct {
+	User     *UserResponse `json:"user,omitempty"`
+	UserID   uuid.UUID     `json:"user_id"`
+	Role     OrgRole       `json:"role"`
+	JoinedAt time.Time     `json:"joined_at"`
+}
+
+// ToResponse converts OrganizationMember to OrganizationMemberResponse
+func (m *OrganizationMember) ToResponse(viewer *User) *OrganizationMemberResponse {
+	response := &OrganizationMemberResponse{
+		UserID:   m.UserID,
+		Role:     m.Role,
+		JoinedAt: m.CreatedAt,
+	}
+	if m.User != nil {
+		response.User = m.User.ToResponseFor(viewer)
+	}
+	return response
+}
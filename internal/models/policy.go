@@ -0,0 +1,86 @@
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// PolicyKind identifies the legal document a policy version belongs to
+type PolicyKind string
+
+const (
+	PolicyTerms   PolicyKind = "terms"
+	PolicyPrivacy PolicyKind = "privacy"
+)
+
+// PolicyKinds lists the valid policy kinds
+var PolicyKinds = []PolicyKind{PolicyTerms, PolicyPrivacy}
+
+// PolicyVersion is a published version of the terms of service or the
+// privacy policy. The latest published version of each kind is the current
+// one, which users must have accepted to make changes.
+type PolicyVersion struct {
+	BaseModel
+	Kind        PolicyKind `gorm:"type:varchar(20);not null;uniqueIndex:idx_policy_versions_kind_version" json:"kind"`
+	Version     string     `gorm:"size:50;not null;uniqueIndex:idx_policy_versions_kind_version" json:"version"`
+	URL         string     `gorm:"size:2048;not null" json:"url"`
+	Summary     string     `gorm:"size:1000" json:"summary,omitempty"`
+	PublishedAt time.Time  `gorm:"not null;index" json:"published_at"`
+
+	// Foreign keys
+	PublishedBy *uuid.UUID `gorm:"type:uuid" json:"published_by,omitempty"`
+}
+
+// TableName returns the table name for PolicyVersion model
+func (PolicyVersion) TableName() string {
+	return "policy_versions"
+}
+
+// PolicyAcceptance records that a user accepted a policy version
+type PolicyAcceptance struct {
+	BaseModel
+	AcceptedAt time.Time `gorm:"not null" json:"accepted_at"`
+
+	// Foreign keys
+	UserID          uuid.UUID `gorm:"type:uuid;not null;uniqueIndex:idx_policy_acceptances_user_version" json:"user_id"`
+	PolicyVersionID uuid.UUID `gorm:"type:uuid;not null;uniqueIndex:idx_policy_acceptances_user_version;index" json:"policy_version_id"`
+}
+
+// TableName returns the table name for PolicyAcceptance model
+func (PolicyAcceptance) TableName() string {
+	return "policy_acceptances"
+}
+
+// PolicyVersionResponse is the response structure for policy version data
+type PolicyVersionResponse struct {
+	ID          uuid.UUID  `json:"id"`
+	Kind        PolicyKind `json:"kind"`
+	Version     string     `json:"version"`
+	URL         string     `json:"url"`
+	Summary     string     `json:"summary,omitempty"`
+	PublishedAt time.Time  `json:"published_at"`
+	PublishedBy *uuid.UUID `json:"published_by,omitempty"`
+}
+
+// ToResponse converts PolicyVersion to PolicyVersionResponse
+func (p *PolicyVersion) ToResponse() *PolicyVersionResponse {
+	return &PolicyVersionResponse{
+		ID:          p.ID,
+		Kind:        p.Kind,
+		Version:     p.Version,
+		URL:         p.URL,
+		Summary:     p.Summary,
+		PublishedAt: p.PublishedAt,
+		PublishedBy: p.PublishedBy,
+	}
+}
+
+// PolicyVersionResponses converts policy versions to their responses
+func PolicyVersionResponses(versions []PolicyVersion) []*PolicyVersionResponse {
+	responses := make([]*PolicyVersionResponse, len(versions))
+	for i := range versions {
+		responses[i] = versions[i].ToResponse()
+	}
+	return responses
+}
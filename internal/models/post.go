@@ -1,7 +1,12 @@
 package models
 
 import (
+	"regexp"
+	"strings"
+	"time"
+
 	"github.com/google/uuid"
+	"gorm.io/gorm"
 )
 
 // PostStatus represents post status
@@ -11,6 +16,7 @@ const (
 	PostStatusDraft     PostStatus = "draft"
 	PostStatusPublished PostStatus = "published"
 	PostStatusArchived  PostStatus = "archived"
+	PostStatusTakenDown PostStatus = "taken_down"
 )
 
 // Post represents a blog post or article
@@ -21,11 +27,45 @@ type Post struct {
 	Content     string     `gorm:"type:text" json:"content"`
 	Excerpt     string     `gorm:"size:500" json:"excerpt"`
 	FeaturedImage string   `gorm:"size:500" json:"featured_image,omitempty"`
+	FeaturedImageAlt string `gorm:"size:500" json:"featured_image_alt,omitempty"`
 	Status      PostStatus `gorm:"type:varchar(20);default:draft" json:"status"`
 	ViewCount   int        `gorm:"default:0" json:"view_count"`
+	WordCount   int        `gorm:"default:0" json:"word_count"`
+	ReadingTime int        `gorm:"default:0" json:"reading_time"`
+	Mature      bool       `gorm:"default:false;index" json:"mature"`
+	IsPinned    bool       `gorm:"default:false;index" json:"is_pinned"`
+	IsFeatured  bool       `gorm:"default:false;index" json:"is_featured"`
+
+	// License states how the post may be reused. Custom licenses are named,
+	// and optionally linked, by the author.
+	License     License    `gorm:"size:20" json:"license,omitempty"`
+	LicenseName string     `gorm:"size:200" json:"license_name,omitempty"`
+	LicenseURL  string     `gorm:"size:2048" json:"license_url,omitempty"`
+
+	// ProfilePin is the post's 1-based position among the posts pinned to
+	// its author's profile, or nil when it is not pinned
+	ProfilePin  *int       `gorm:"index" json:"profile_pin,omitempty"`
+
+	// MissingAltText counts the images of the post, the featured image
+	// included, that have no alt text
+	MissingAltText int     `gorm:"default:0;index" json:"-"`
+
+	// ImportKey identifies the source item of an imported post
+	ImportKey   string     `gorm:"size:500;index" json:"-"`
+
+	// Shadowed hides the post from everyone but its author, admins and
+	// moderators. Posts written while their author is shadow-banned are shadowed.
+	Shadowed    bool       `gorm:"default:false;index" json:"-"`
+
+	LegalHold
+	Takedown
+	Versioned
 
 	// Foreign keys
 	UserID      uuid.UUID  `gorm:"type:uuid;not null" json:"user_id"`
+	// OrgID is the organization the post was written in, or nil for posts
+	// outside any organization
+	OrgID       *uuid.UUID `gorm:"type:uuid;index" json:"org_id,omitempty"`
 
 	// Relations
 	User        *User      `gorm:"foreignKey:UserID" json:"author,omitempty"`
@@ -37,44 +77,154 @@ func (Post) TableName() string {
 	return "posts"
 }
 
+// wordsPerMinute is the reading speed used to estimate reading time
+const wordsPerMinute = 200
+
+// htmlTagRegex matches HTML tags, which are not counted as words
+var htmlTagRegex = regexp.MustCompile(`<[^>]*>`)
+
+// imgTagRegex matches the img tags of post content
+var imgTagRegex = regexp.MustCompile(`(?i)<img\b[^>]*>`)
+
+// altAttrRegex matches a non-empty alt attribute of an img tag
+var altAttrRegex = regexp.MustCompile(`(?i)\salt\s*=\s*("\s*[^"\s][^"]*"|'\s*[^'\s][^']*'|[^\s"'>]+)`)
+
+// BeforeSave hook for Post keeps the reading stats and the count of images
+// missing alt text in sync with the content
+func (p *Post) BeforeSave(tx *gorm.DB) error {
+	p.initVersion()
+	p.WordCount, p.ReadingTime = ReadingStats(p.Content)
+	p.MissingAltText = p.CountMissingAltText()
+	return nil
+}
+
+// ImagesMissingAlt returns the number of images in post content without
+// alt text. Decorative images should still be described by their context,
+// so an empty alt attribute counts as missing.
+func ImagesMissingAlt(content string) int {
+	missing := 0
+	for _, tag := range imgTagRegex.FindAllString(content, -1) {
+		if !altAttrRegex.MatchString(tag) {
+			missing++
+		}
+	}
+	return missing
+}
+
+// FeaturedImageMissingAlt reports whether the post has a featured image
+// without alt text
+func (p *Post) FeaturedImageMissingAlt() bool {
+	return p.FeaturedImage != "" && strings.TrimSpace(p.FeaturedImageAlt) == ""
+}
+
+// CountMissingAltText returns the number of images of the post, the
+// featured image included, that have no alt text
+func (p *Post) CountMissingAltText() int {
+	missing := ImagesMissingAlt(p.Content)
+	if p.FeaturedImageMissingAlt() {
+		missing++
+	}
+	return missing
+}
+
+// ReadingStats returns the word count and estimated reading time in minutes
+// of post content. Any non-empty content takes at least one minute to read.
+func ReadingStats(content string) (int, int) {
+	words := len(strings.Fields(htmlTagRegex.ReplaceAllString(content, " ")))
+	if words == 0 {
+		return 0, 0
+	}
+	return words, (words + wordsPerMinute - 1) / wordsPerMinute
+}
+
 // IsPublished checks if the post is published
 func (p *Post) IsPublished() bool {
 	return p.Status == PostStatusPublished
 }
 
+// IsVisibleTo reports whether a viewer (nil for anonymous viewers) may see
+// the post's content given its mature flag. Authors and admins always can;
+// everyone else must be a verified adult.
+func (p *Post) IsVisibleTo(viewer *User, adultAge int) bool {
+	if !p.Mature {
+		return true
+	}
+	if viewer == nil {
+		return false
+	}
+	return viewer.ID == p.UserID || viewer.IsAdmin() || viewer.IsVerifiedAdult(adultAge)
+}
+
+// IsSearchable reports whether the post belongs in the search index: it is
+// published and not shadowed
+func (p *Post) IsSearchable() bool {
+	return p.IsPublished() && !p.Shadowed
+}
+
+// IsHiddenFrom reports whether the post is shadowed and viewer (nil for
+// anonymous viewers) may not see it
+func (p *Post) IsHiddenFrom(viewer *User) bool {
+	return p.Shadowed && !canSeeShadowed(viewer, p.UserID)
+}
+
 // PostResponse is the response structure for post data
 type PostResponse struct {
-	ID            uuid.UUID     `json:"id"`
-	Title         string        `json:"title"`
-	Slug          string        `json:"slug"`
-	Content       string        `json:"content"`
-	Excerpt       string        `json:"excerpt"`
-	FeaturedImage string        `json:"featured_image,omitempty"`
-	Status        PostStatus    `json:"status"`
-	ViewCount     int           `json:"view_count"`
-	Author        *UserResponse `json:"author,omitempty"`
-	Tags          []TagResponse `json:"tags,omitempty"`
-	CreatedAt     string        `json:"created_at"`
-	UpdatedAt     string        `json:"updated_at"`
+	ID               uuid.UUID        `json:"id"`
+	Title            string           `json:"title"`
+	Slug             string           `json:"slug"`
+	Content          string           `json:"content"`
+	Excerpt          string           `json:"excerpt"`
+	FeaturedImage    string           `json:"featured_image,omitempty"`
+	FeaturedImageAlt string           `json:"featured_image_alt,omitempty"`
+	Status           PostStatus       `json:"status"`
+	ViewCount        int              `json:"view_count"`
+	WordCount        int              `json:"word_count"`
+	ReadingTime      int              `json:"reading_time"`
+	Mature           bool             `json:"mature"`
+	IsPinned         bool             `json:"is_pinned"`
+	IsFeatured       bool             `json:"is_featured"`
+	ProfilePin       *int             `json:"profile_pin,omitempty"`
+	License          *LicenseResponse `json:"license,omitempty"`
+	Author           *UserResponse    `json:"author,omitempty"`
+	Tags             []TagResponse    `json:"tags,omitempty"`
+	OrgID            *uuid.UUID       `json:"org_id,omitempty"`
+	Version          int64            `json:"version"`
+	CreatedAt        time.Time        `json:"created_at"`
+	UpdatedAt        time.Time        `json:"updated_at"`
 }
 
 // ToResponse converts Post to PostResponse
 func (p *Post) ToResponse() *PostResponse {
 	response := &PostResponse{
-		ID:            p.ID,
-		Title:         p.Title,
-		Slug:          p.Slug,
-		Content:       p.Content,
-		Excerpt:       p.Excerpt,
-		FeaturedImage: p.FeaturedImage,
-		Status:        p.Status,
-		ViewCount:     p.ViewCount,
-		CreatedAt:     p.CreatedAt.Format("2006-01-02T15:04:05Z07:00"),
-		UpdatedAt:     p.UpdatedAt.Format("2006-01-02T15:04:05Z07:00"),
+		ID:               p.ID,
+		Title:            p.Title,
+		Slug:             p.Slug,
+		Content:          p.Content,
+		Excerpt:          p.Excerpt,
+		FeaturedImage:    p.FeaturedImage,
+		FeaturedImageAlt: p.FeaturedImageAlt,
+		Status:           p.Status,
+		ViewCount:        p.ViewCount,
+		WordCount:        p.WordCount,
+		ReadingTime:      p.ReadingTime,
+		Mature:           p.Mature,
+		IsPinned:         p.IsPinned,
+		IsFeatured:       p.IsFeatured,
+		ProfilePin:       p.ProfilePin,
+		License:          p.License.ToResponse(p.LicenseName, p.LicenseURL),
+		OrgID:            p.OrgID,
+		Version:          p.Version,
+		CreatedAt:        p.CreatedAt,
+		UpdatedAt:        p.UpdatedAt,
+	}
+
+	// Posts saved before reading stats existed are computed on the fly
+	if response.WordCount == 0 && p.Content != "" {
+		response.WordCount, response.ReadingTime = ReadingStats(p.Content)
 	}
 
 	if p.User != nil {
-		response.Author = p.User.ToResponse()
+		response.Author = p.User.ToResponseFor(nil)
 	}
 
 	if len(p.Tags) > 0 {
@@ -87,6 +237,61 @@ func (p *Post) ToResponse() *PostResponse {
 	return response
 }
 
+// PostSummaryResponse is the response structure for post listings that
+// leave out the post content
+type PostSummaryResponse struct {
+	ID          uuid.UUID        `json:"id"`
+	Title       string           `json:"title"`
+	Slug        string           `json:"slug"`
+	Excerpt     string           `json:"excerpt"`
+	ReadingTime int              `json:"reading_time"`
+	Mature      bool             `json:"mature"`
+	License     *LicenseResponse `json:"license,omitempty"`
+	Tags        []TagResponse    `json:"tags,omitempty"`
+	CreatedAt   time.Time        `json:"created_at"`
+}
+
+// ToSummary converts Post to PostSummaryResponse
+func (p *Post) ToSummary() *PostSummaryResponse {
+	full := p.ToResponse()
+	return &PostSummaryResponse{
+		ID:          full.ID,
+		Title:       full.Title,
+		Slug:        full.Slug,
+		Excerpt:     full.Excerpt,
+		ReadingTime: full.ReadingTime,
+		Mature:      full.Mature,
+		License:     full.License,
+		Tags:        full.Tags,
+		CreatedAt:   full.CreatedAt,
+	}
+}
+
+// AltTextReportResponse tells an author which images of a post have no
+// alt text
+type AltTextReportResponse struct {
+	ID                      uuid.UUID  `json:"id"`
+	Title                   string     `json:"title"`
+	Slug                    string     `json:"slug"`
+	Status                  PostStatus `json:"status"`
+	MissingAltText          int        `json:"missing_alt_text"`
+	FeaturedImageMissingAlt bool       `json:"featured_image_missing_alt"`
+	UpdatedAt               time.Time  `json:"updated_at"`
+}
+
+// ToAltTextReport converts Post to AltTextReportResponse
+func (p *Post) ToAltTextReport() *AltTextReportResponse {
+	return &AltTextReportResponse{
+		ID:                      p.ID,
+		Title:                   p.Title,
+		Slug:                    p.Slug,
+		Status:                  p.Status,
+		MissingAltText:          p.MissingAltText,
+		FeaturedImageMissingAlt: p.FeaturedImageMissingAlt(),
+		UpdatedAt:               p.UpdatedAt,
+	}
+}
+
 // Tag represents a tag for categorizing posts
 type Tag struct {
 	BaseModel
@@ -96,6 +301,7 @@ type Tag struct {
 
 	// Relations
 	Posts       []Post `gorm:"many2many:post_tags;" json:"posts,omitempty"`
+	Aliases     []TagAlias `gorm:"foreignKey:TagID" json:"aliases,omitempty"`
 }
 
 // TableName returns the table name for Tag model
@@ -120,3 +326,15 @@ func (t *Tag) ToResponse() *TagResponse {
 		Description: t.Description,
 	}
 }
+
+// ToSynonymsResponse converts Tag to TagSynonymsResponse, listing its aliases
+func (t *Tag) ToSynonymsResponse() *TagSynonymsResponse {
+	response := &TagSynonymsResponse{
+		Tag:     t.ToResponse(),
+		Aliases: make([]TagAliasResponse, len(t.Aliases)),
+	}
+	for i, alias := range t.Aliases {
+		response.Aliases[i] = *alias.ToResponse()
+	}
+	return response
+}
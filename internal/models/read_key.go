@@ -0,0 +1,116 @@
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// ReadKeyTier is the service level of a read key, which sets its rate limit
+type ReadKeyTier string
+
+const (
+	ReadKeyFree    ReadKeyTier = "free"
+	ReadKeyPartner ReadKeyTier = "partner"
+)
+
+// ReadKeyTiers lists the valid read key tiers
+var ReadKeyTiers = []ReadKeyTier{ReadKeyFree, ReadKeyPartner}
+
+// ReadKey is a credential for anonymous consumers of published content, such
+// as syndication partners. It is not tied to a user account: it only
+// identifies the consumer for rate limiting and usage analytics.
+type ReadKey struct {
+	BaseModel
+	Name       string      `gorm:"not null;size:100" json:"name"`
+	Contact    string      `gorm:"size:255" json:"contact,omitempty"`
+	Tier       ReadKeyTier `gorm:"type:varchar(20);not null;default:free" json:"tier"`
+	Prefix     string      `gorm:"not null;size:16;index" json:"prefix"`
+	KeyHash    string      `gorm:"uniqueIndex;not null;size:64" json:"-"`
+	LastUsedAt *time.Time  `json:"last_used_at,omitempty"`
+	ExpiresAt  *time.Time  `json:"expires_at,omitempty"`
+	RevokedAt  *time.Time  `json:"revoked_at,omitempty"`
+
+	// Foreign keys
+	CreatedBy uuid.UUID `gorm:"type:uuid;not null" json:"created_by"`
+}
+
+// TableName returns the table name for ReadKey model
+func (ReadKey) TableName() string {
+	return "read_keys"
+}
+
+// IsValid checks if the key is neither revoked nor expired
+func (k *ReadKey) IsValid() bool {
+	if k.RevokedAt != nil {
+		return false
+	}
+	if k.ExpiresAt != nil && time.Now().After(*k.ExpiresAt) {
+		return false
+	}
+	return true
+}
+
+// ReadKeyResponse is the response structure for read key data
+type ReadKeyResponse struct {
+	ID         uuid.UUID   `json:"id"`
+	Name       string      `json:"name"`
+	Contact    string      `json:"contact,omitempty"`
+	Tier       ReadKeyTier `json:"tier"`
+	Prefix     string      `json:"prefix"`
+	CreatedBy  uuid.UUID   `json:"created_by"`
+	LastUsedAt *time.Time  `json:"last_used_at,omitempty"`
+	ExpiresAt  *time.Time  `json:"expires_at,omitempty"`
+	RevokedAt  *time.Time  `json:"revoked_at,omitempty"`
+	CreatedAt  time.Time   `json:"created_at"`
+	UpdatedAt  time.Time   `json:"updated_at"`
+}
+
+// ToResponse converts ReadKey to ReadKeyResponse
+func (k *ReadKey) ToResponse() *ReadKeyResponse {
+	return &ReadKeyResponse{
+		ID:         k.ID,
+		Name:       k.Name,
+		Contact:    k.Contact,
+		Tier:       k.Tier,
+		Prefix:     k.Prefix,
+		CreatedBy:  k.CreatedBy,
+		LastUsedAt: k.LastUsedAt,
+		ExpiresAt:  k.ExpiresAt,
+		RevokedAt:  k.RevokedAt,
+		CreatedAt:  k.CreatedAt,
+		UpdatedAt:  k.UpdatedAt,
+	}
+}
+
+// ReadKeyDailyUsage aggregates the requests made and the post views recorded
+// with a read key on a single day (UTC)
+type ReadKeyDailyUsage struct {
+	ReadKeyID uuid.UUID `gorm:"type:uuid;primaryKey" json:"read_key_id"`
+	Day       string    `gorm:"size:10;primaryKey" json:"date"`
+	Requests  int64     `gorm:"not null;default:0" json:"requests"`
+	PostViews int64     `gorm:"not null;default:0" json:"post_views"`
+	UpdatedAt time.Time `json:"-"`
+}
+
+// TableName returns the table name for ReadKeyDailyUsage model
+func (ReadKeyDailyUsage) TableName() string {
+	return "read_key_daily_usage"
+}
+
+// DailyReadKeyUsage is a read key's usage on one day
+type DailyReadKeyUsage struct {
+	Date      string `json:"date"`
+	Requests  int64  `json:"requests"`
+	PostViews int64  `json:"post_views"`
+}
+
+// ReadKeyUsage is the usage report of a read key for a date range
+type ReadKeyUsage struct {
+	ReadKeyID uuid.UUID           `json:"read_key_id"`
+	From      string              `json:"from"`
+	To        string              `json:"to"`
+	Requests  int64               `json:"requests"`
+	PostViews int64               `json:"post_views"`
+	Daily     []DailyReadKeyUsage `json:"daily"`
+}
@@ -0,0 +1,37 @@
+package models
+
+// All returns every model with a table, in the order the API server
+// migrates them
+func All() []interface{} {
+	return []interface{}{
+		&User{},
+		&Organization{},
+		&OrganizationMember{},
+		&Post{},
+		&Tag{},
+		&TagAlias{},
+		&UserFollow{},
+		&Notification{},
+		&Appeal{},
+		&Webhook{},
+		&WebhookDelivery{},
+		&APIKey{},
+		&ReadKey{},
+		&ReadKeyDailyUsage{},
+		&Change{},
+		&AdminOperation{},
+		&SlugRedirect{},
+		&PostDailyStat{},
+		&PostReferrerStat{},
+		&PostViewReader{},
+		&BackfillCheckpoint{},
+		&Comment{},
+		&Job{},
+		&Branding{},
+		&IdempotencyKey{},
+		&Media{},
+		&MediaUpload{},
+		&PolicyVersion{},
+		&PolicyAcceptance{},
+	}
+}
@@ -0,0 +1,17 @@
+package models
+
+import (
+	"github.com/google/uuid"
+)
+
+// SlugRedirect maps a previous post slug to the post that now has a different slug
+type SlugRedirect struct {
+	BaseModel
+	OldSlug string    `gorm:"uniqueIndex;not null;size:255" json:"old_slug"`
+	PostID  uuid.UUID `gorm:"type:uuid;not null;index" json:"post_id"`
+}
+
+// TableName returns the table name for SlugRedirect model
+func (SlugRedirect) TableName() string {
+	return "slug_redirects"
+}
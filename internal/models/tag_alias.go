@@ -0,0 +1,49 @@
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// TagAlias is an alternative name for a tag (e.g. golang for go). Tagging,
+// filtering and searching by an alias resolve to the canonical tag.
+type TagAlias struct {
+	BaseModel
+	Name      string     `gorm:"not null;size:100" json:"name"`
+	Slug      string     `gorm:"uniqueIndex;not null;size:100" json:"slug"`
+	TagID     uuid.UUID  `gorm:"type:uuid;not null;index" json:"tag_id"`
+	CreatedBy *uuid.UUID `gorm:"type:uuid" json:"created_by,omitempty"`
+
+	// Relations
+	Tag *Tag `gorm:"foreignKey:TagID" json:"tag,omitempty"`
+}
+
+// TableName returns the table name for TagAlias model
+func (TagAlias) TableName() string {
+	return "tag_aliases"
+}
+
+// TagAliasResponse is the response structure for tag alias data
+type TagAliasResponse struct {
+	ID        uuid.UUID `json:"id"`
+	Name      string    `json:"name"`
+	Slug      string    `json:"slug"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+// ToResponse converts TagAlias to TagAliasResponse
+func (a *TagAlias) ToResponse() *TagAliasResponse {
+	return &TagAliasResponse{
+		ID:        a.ID,
+		Name:      a.Name,
+		Slug:      a.Slug,
+		CreatedAt: a.CreatedAt,
+	}
+}
+
+// TagSynonymsResponse is the response structure for a tag and its aliases
+type TagSynonymsResponse struct {
+	Tag     *TagResponse       `json:"tag"`
+	Aliases []TagAliasResponse `json:"aliases"`
+}
@@ -0,0 +1,105 @@
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// TakedownReason is the public category of a content takedown
+type TakedownReason string
+
+const (
+	TakedownCopyright  TakedownReason = "copyright"
+	TakedownHarassment TakedownReason = "harassment"
+	TakedownHateSpeech TakedownReason = "hate_speech"
+	TakedownSpam       TakedownReason = "spam"
+	TakedownIllegal    TakedownReason = "illegal_content"
+	TakedownOther      TakedownReason = "other"
+)
+
+// TakedownReasons lists the valid takedown reasons
+var TakedownReasons = []TakedownReason{
+	TakedownCopyright, TakedownHarassment, TakedownHateSpeech, TakedownSpam, TakedownIllegal, TakedownOther,
+}
+
+// Takedown records the removal of content by an admin. Taken down content is
+// kept so the author can appeal; publicly it is replaced by a tombstone.
+type Takedown struct {
+	TakenDownAt    *time.Time     `json:"-"`
+	TakenDownBy    *uuid.UUID     `gorm:"type:uuid" json:"-"`
+	TakedownReason TakedownReason `gorm:"type:varchar(30)" json:"-"`
+	TakedownNote   string         `gorm:"size:1000" json:"-"`
+	AppealMessage  string         `gorm:"type:text" json:"-"`
+	AppealedAt     *time.Time     `json:"-"`
+}
+
+// IsTakenDown checks if the content has been taken down
+func (t *Takedown) IsTakenDown() bool {
+	return t.TakenDownAt != nil
+}
+
+// HasAppeal checks if the author has appealed the takedown
+func (t *Takedown) HasAppeal() bool {
+	return t.AppealedAt != nil
+}
+
+// TakedownAppealPath returns the API path where the author of a taken down
+// post can appeal
+func TakedownAppealPath(postID uuid.UUID) string {
+	return "/api/v1/posts/" + postID.String() + "/appeal"
+}
+
+// TombstoneResponse is the public response structure for a taken down post
+type TombstoneResponse struct {
+	ID          uuid.UUID      `json:"id"`
+	Slug        string         `json:"slug"`
+	Reason      TakedownReason `json:"reason"`
+	TakenDownAt *time.Time     `json:"taken_down_at"`
+	AppealURL   string         `json:"appeal_url,omitempty"`
+	AppealedAt  *time.Time     `json:"appealed_at,omitempty"`
+}
+
+// ToTombstone converts a taken down Post to TombstoneResponse as seen by
+// viewer (nil for anonymous viewers). Only the author learns how to appeal.
+func (p *Post) ToTombstone(viewer *User) *TombstoneResponse {
+	response := &TombstoneResponse{
+		ID:          p.ID,
+		Slug:        p.Slug,
+		Reason:      p.TakedownReason,
+		TakenDownAt: p.TakenDownAt,
+	}
+	if viewer != nil && viewer.ID == p.UserID {
+		response.AppealedAt = p.AppealedAt
+		if !p.HasAppeal() {
+			response.AppealURL = TakedownAppealPath(p.ID)
+		}
+	}
+	return response
+}
+
+// TakedownResponse is the response structure for a takedown as seen by admins
+type TakedownResponse struct {
+	Post          *PostSummaryResponse `json:"post"`
+	AuthorID      uuid.UUID            `json:"author_id"`
+	Reason        TakedownReason       `json:"reason"`
+	Note          string               `json:"note,omitempty"`
+	TakenDownBy   *uuid.UUID           `json:"taken_down_by,omitempty"`
+	TakenDownAt   *time.Time           `json:"taken_down_at"`
+	AppealMessage string               `json:"appeal_message,omitempty"`
+	AppealedAt    *time.Time           `json:"appealed_at,omitempty"`
+}
+
+// ToTakedownResponse converts a taken down Post to TakedownResponse
+func (p *Post) ToTakedownResponse() *TakedownResponse {
+	return &TakedownResponse{
+		Post:          p.ToSummary(),
+		AuthorID:      p.UserID,
+		Reason:        p.TakedownReason,
+		Note:          p.TakedownNote,
+		TakenDownBy:   p.TakenDownBy,
+		TakenDownAt:   p.TakenDownAt,
+		AppealMessage: p.AppealMessage,
+		AppealedAt:    p.AppealedAt,
+	}
+}
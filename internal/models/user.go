@@ -4,6 +4,7 @@ import (
 	"time"
 
 	"github.com/google/uuid"
+	"github.com/yourusername/go-enterprise-api/pkg/version"
 	"golang.org/x/crypto/bcrypt"
 	"gorm.io/gorm"
 )
@@ -17,6 +18,9 @@ const (
 	RoleModerator UserRole = "moderator"
 )
 
+// UserRoles lists the valid user roles
+var UserRoles = []UserRole{RoleUser, RoleAdmin, RoleModerator}
+
 // UserStatus represents user account status
 type UserStatus string
 
@@ -25,6 +29,35 @@ const (
 	StatusInactive UserStatus = "inactive"
 	StatusBanned   UserStatus = "banned"
 	StatusPending  UserStatus = "pending"
+	// StatusShadowBanned users can use their account as usual, but their new
+	// posts and comments are only visible to themselves and to staff
+	StatusShadowBanned UserStatus = "shadow_banned"
+)
+
+// UserStatuses lists the valid user statuses
+var UserStatuses = []UserStatus{StatusActive, StatusInactive, StatusBanned, StatusPending, StatusShadowBanned}
+
+// UserSortFields lists the fields user listings can be sorted by
+var UserSortFields = []string{"created_at", "email", "first_name", "last_name", "last_login_at"}
+
+// UserFilter narrows down and orders a user listing. Zero values do not
+// filter; Sort is a field of UserSortFields, prefixed with "-" to sort in
+// descending order.
+type UserFilter struct {
+	Role          UserRole
+	Status        UserStatus
+	EmailVerified *bool
+	CreatedFrom   *time.Time
+	CreatedTo     *time.Time
+	Sort          string
+}
+
+// UserType distinguishes interactive users from automation accounts
+type UserType string
+
+const (
+	UserTypeHuman   UserType = "human"
+	UserTypeService UserType = "service"
 )
 
 // User represents a user in the system
@@ -36,14 +69,39 @@ type User struct {
 	LastName        string     `gorm:"size:100" json:"last_name"`
 	Role            UserRole   `gorm:"type:varchar(20);default:user" json:"role"`
 	Status          UserStatus `gorm:"type:varchar(20);default:pending" json:"status"`
+	Type            UserType   `gorm:"type:varchar(20);default:human;index" json:"type"`
 	EmailVerifiedAt *time.Time `json:"email_verified_at,omitempty"`
 	LastLoginAt     *time.Time `json:"last_login_at,omitempty"`
+	// LastSeenAt is when the user last made an authenticated request, recorded
+	// at most once per PRESENCE_UPDATE_INTERVAL
+	LastSeenAt      *time.Time `json:"last_seen_at,omitempty"`
 	RefreshToken    string     `gorm:"size:500" json:"-"`
 
 	// Profile fields
-	Avatar      string `gorm:"size:500" json:"avatar,omitempty"`
-	Bio         string `gorm:"size:1000" json:"bio,omitempty"`
-	PhoneNumber string `gorm:"size:20" json:"phone_number,omitempty"`
+	Avatar      string     `gorm:"size:500" json:"avatar,omitempty"`
+	// AvatarKey is the storage key of the avatar the user uploaded, if any
+	AvatarKey   string     `gorm:"size:500" json:"-"`
+	Bio         string     `gorm:"size:1000" json:"bio,omitempty"`
+	PhoneNumber string     `gorm:"size:20" json:"phone_number,omitempty"`
+	Birthdate   *time.Time `gorm:"type:date" json:"birthdate,omitempty"`
+
+	// Privacy settings
+	HideEmailVerified bool `gorm:"default:false" json:"hide_email_verified"`
+	HideLastSeen      bool `gorm:"default:false" json:"hide_last_seen"`
+	HideFollowers     bool `gorm:"default:false" json:"hide_followers"`
+
+	// Dormancy lifecycle
+	DormancyNotifiedAt *time.Time `json:"-"`
+	DormantSince       *time.Time `json:"-"`
+	AnonymizedAt       *time.Time `json:"-"`
+
+	// Account deletion: the account is unusable from the request until it
+	// is erased, unless its owner logs in again to cancel the deletion
+	DeletionRequestedAt *time.Time `gorm:"index" json:"-"`
+	DeletePostsOnErasure bool      `gorm:"default:false" json:"-"`
+
+	LegalHold
+	Versioned
 
 	// Relations
 	Posts []Post `gorm:"foreignKey:UserID" json:"posts,omitempty"`
@@ -59,6 +117,7 @@ func (u *User) BeforeCreate(tx *gorm.DB) error {
 	if err := u.BaseModel.BeforeCreate(tx); err != nil {
 		return err
 	}
+	u.initVersion()
 
 	// Hash password if not already hashed
 	if len(u.Password) > 0 && len(u.Password) < 60 {
@@ -105,9 +164,29 @@ func (u *User) FullName() string {
 	return u.FirstName + " " + u.LastName
 }
 
-// IsActive checks if the user is active
+// IsActive checks if the user can use their account. Shadow-banned users
+// can, so that they do not notice the ban. Accounts whose deletion was
+// requested cannot.
 func (u *User) IsActive() bool {
-	return u.Status == StatusActive
+	return (u.Status == StatusActive || u.Status == StatusShadowBanned) && u.DeletionRequestedAt == nil
+}
+
+// DeletionPending checks if the user asked for their account to be deleted
+// and it is not erased yet
+func (u *User) DeletionPending() bool {
+	return u.DeletionRequestedAt != nil && u.AnonymizedAt == nil
+}
+
+// IsShadowBanned checks if the user is shadow-banned: their new posts and
+// comments are only visible to themselves, admins and moderators
+func (u *User) IsShadowBanned() bool {
+	return u.Status == StatusShadowBanned
+}
+
+// canSeeShadowed reports whether viewer (nil for anonymous viewers) may see
+// shadowed content by authorID
+func canSeeShadowed(viewer *User, authorID uuid.UUID) bool {
+	return viewer != nil && (viewer.ID == authorID || viewer.IsAdmin() || viewer.IsModerator())
 }
 
 // IsAdmin checks if the user is an admin
@@ -115,32 +194,106 @@ func (u *User) IsAdmin() bool {
 	return u.Role == RoleAdmin
 }
 
+// IsServiceAccount checks if the user is a non-interactive service account
+func (u *User) IsServiceAccount() bool {
+	return u.Type == UserTypeService
+}
+
+// IsModerator checks if user is a moderator
+func (u *User) IsModerator() bool {
+	return u.Role == RoleModerator
+}
+
 // IsEmailVerified checks if the user's email is verified
 func (u *User) IsEmailVerified() bool {
 	return u.EmailVerifiedAt != nil
 }
 
+// LastSeen returns when the user was last seen. Logins count too, so users
+// who have not been seen since last-seen times are recorded fall back to
+// their last login.
+func (u *User) LastSeen() *time.Time {
+	if u.LastSeenAt == nil || (u.LastLoginAt != nil && u.LastLoginAt.After(*u.LastSeenAt)) {
+		return u.LastLoginAt
+	}
+	return u.LastSeenAt
+}
+
+// IsOnline checks if the user was last seen less than window before now
+func (u *User) IsOnline(now time.Time, window time.Duration) bool {
+	seen := u.LastSeen()
+	return seen != nil && now.Sub(*seen) < window
+}
+
+// BirthdateFormat is the layout used for birthdates in requests and responses
+const BirthdateFormat = "2006-01-02"
+
+// Age returns the user's age in whole years at the given time. ok is false
+// when the user has not provided a birthdate.
+func (u *User) Age(at time.Time) (age int, ok bool) {
+	if u.Birthdate == nil {
+		return 0, false
+	}
+	return AgeAt(*u.Birthdate, at), true
+}
+
+// AgeAt returns the age in whole years at the given time of someone born on birthdate
+func AgeAt(birthdate, at time.Time) int {
+	birthdate, at = birthdate.UTC(), at.UTC()
+	age := at.Year() - birthdate.Year()
+	if at.Month() < birthdate.Month() || (at.Month() == birthdate.Month() && at.Day() < birthdate.Day()) {
+		age--
+	}
+	return age
+}
+
+// IsVerifiedAdult checks if the user has a verified email and a birthdate
+// showing they are at least adultAge years old
+func (u *User) IsVerifiedAdult(adultAge int) bool {
+	age, ok := u.Age(time.Now())
+	return ok && age >= adultAge && u.IsEmailVerified()
+}
+
 // UserResponse is the response structure for user data (without sensitive fields)
 type UserResponse struct {
-	ID              uuid.UUID  `json:"id"`
-	Email           string     `json:"email"`
-	FirstName       string     `json:"first_name"`
-	LastName        string     `json:"last_name"`
-	FullName        string     `json:"full_name"`
-	Role            UserRole   `json:"role"`
-	Status          UserStatus `json:"status"`
-	Avatar          string     `json:"avatar,omitempty"`
-	Bio             string     `json:"bio,omitempty"`
-	PhoneNumber     string     `json:"phone_number,omitempty"`
-	EmailVerifiedAt *time.Time `json:"email_verified_at,omitempty"`
-	LastLoginAt     *time.Time `json:"last_login_at,omitempty"`
-	CreatedAt       time.Time  `json:"created_at"`
-	UpdatedAt       time.Time  `json:"updated_at"`
+	ID              uuid.UUID        `json:"id"`
+	Email           string           `json:"email"`
+	FirstName       string           `json:"first_name"`
+	LastName        string           `json:"last_name"`
+	FullName        string           `json:"full_name"`
+	Role            UserRole         `json:"role"`
+	Status          UserStatus       `json:"status"`
+	Type            UserType         `json:"type"`
+	Avatar          string           `json:"avatar,omitempty"`
+	AvatarURL       string           `json:"avatar_url"`
+	Bio             string           `json:"bio,omitempty"`
+	PhoneNumber     string           `json:"phone_number,omitempty"`
+	Birthdate       string           `json:"birthdate,omitempty"`
+	EmailVerifiedAt *time.Time       `json:"email_verified_at,omitempty"`
+	LastLoginAt     *time.Time       `json:"last_login_at,omitempty"`
+	LastSeenAt      *time.Time       `json:"last_seen_at,omitempty"`
+	Privacy         *PrivacySettings `json:"privacy,omitempty"`
+	Version         int64            `json:"version"`
+	CreatedAt       time.Time        `json:"created_at"`
+	UpdatedAt       time.Time        `json:"updated_at"`
+}
+
+// PrivacySettings is the response structure for a user's privacy settings
+type PrivacySettings struct {
+	HideEmailVerified bool `json:"hide_email_verified"`
+	HideLastSeen      bool `json:"hide_last_seen"`
+	HideFollowers     bool `json:"hide_followers"`
+}
+
+// AvatarURL returns the API path serving the user's avatar, which falls back
+// to a generated image when no avatar is set
+func (u *User) AvatarURL() string {
+	return "/api/" + version.APIVersion + "/users/" + u.ID.String() + "/avatar"
 }
 
 // ToResponse converts User to UserResponse
 func (u *User) ToResponse() *UserResponse {
-	return &UserResponse{
+	response := &UserResponse{
 		ID:              u.ID,
 		Email:           u.Email,
 		FirstName:       u.FirstName,
@@ -148,12 +301,116 @@ func (u *User) ToResponse() *UserResponse {
 		FullName:        u.FullName(),
 		Role:            u.Role,
 		Status:          u.Status,
+		Type:            u.Type,
 		Avatar:          u.Avatar,
+		AvatarURL:       u.AvatarURL(),
 		Bio:             u.Bio,
 		PhoneNumber:     u.PhoneNumber,
 		EmailVerifiedAt: u.EmailVerifiedAt,
 		LastLoginAt:     u.LastLoginAt,
-		CreatedAt:       u.CreatedAt,
-		UpdatedAt:       u.UpdatedAt,
+		LastSeenAt:      u.LastSeen(),
+		Privacy: &PrivacySettings{
+			HideEmailVerified: u.HideEmailVerified,
+			HideLastSeen:      u.HideLastSeen,
+			HideFollowers:     u.HideFollowers,
+		},
+		Version:   u.Version,
+		CreatedAt: u.CreatedAt,
+		UpdatedAt: u.UpdatedAt,
+	}
+	if u.Birthdate != nil {
+		response.Birthdate = u.Birthdate.Format(BirthdateFormat)
+	}
+	return response
+}
+
+// ToResponseFor converts User to UserResponse as seen by viewer (nil for
+// anonymous viewers). Admins and moderators see everything. The user sees
+// everything but a shadow-ban, which is shown as active to everyone else too;
+// other viewers never see the birthdate nor fields hidden by the user's
+// privacy settings.
+func (u *User) ToResponseFor(viewer *User) *UserResponse {
+	response := u.ToResponse()
+	if viewer != nil && (viewer.IsAdmin() || viewer.IsModerator()) {
+		return response
+	}
+
+	if u.IsShadowBanned() {
+		response.Status = StatusActive
+	}
+	if viewer != nil && viewer.ID == u.ID {
+		return response
+	}
+
+	response.Privacy = nil
+	response.Birthdate = ""
+	if u.HideEmailVerified {
+		response.EmailVerifiedAt = nil
+	}
+	if u.HideLastSeen {
+		response.LastLoginAt = nil
+		response.LastSeenAt = nil
+	}
+
+	return response
+}
+
+// FollowsVisibleTo reports whether viewer may see the lists of the user's
+// followers and followed users. The user, admins and moderators always can.
+func (u *User) FollowsVisibleTo(viewer *User) bool {
+	if !u.HideFollowers {
+		return true
+	}
+	return viewer != nil && (viewer.ID == u.ID || viewer.IsAdmin() || viewer.IsModerator())
+}
+
+// PublicProfileResponse is the response structure for a user's public
+// profile. It never includes contact details, the role or the account status.
+type PublicProfileResponse struct {
+	ID             uuid.UUID             `json:"id"`
+	FirstName      string                `json:"first_name"`
+	LastName       string                `json:"last_name"`
+	FullName       string                `json:"full_name"`
+	AvatarURL      string                `json:"avatar_url"`
+	Bio            string                `json:"bio,omitempty"`
+	EmailVerified  *bool                 `json:"email_verified,omitempty"`
+	LastSeenAt     *time.Time            `json:"last_seen_at,omitempty"`
+	Online         *bool                 `json:"online,omitempty"`
+	JoinedAt       time.Time             `json:"joined_at"`
+	PublishedPosts int64                 `json:"published_posts"`
+	PinnedPosts    []PostSummaryResponse `json:"pinned_posts"`
+	RecentPosts    []PostSummaryResponse `json:"recent_posts"`
+}
+
+// HasPublicProfile reports whether the user's profile may be shown publicly.
+// Banned, anonymized and service accounts have no public profile.
+func (u *User) HasPublicProfile() bool {
+	return u.Status != StatusBanned && u.AnonymizedAt == nil && !u.IsServiceAccount()
+}
+
+// ToPublicProfile converts User to PublicProfileResponse, respecting the
+// user's privacy settings. Users seen within onlineWindow are shown as
+// online.
+func (u *User) ToPublicProfile(onlineWindow time.Duration) *PublicProfileResponse {
+	response := &PublicProfileResponse{
+		ID:          u.ID,
+		FirstName:   u.FirstName,
+		LastName:    u.LastName,
+		FullName:    u.FullName(),
+		AvatarURL:   u.AvatarURL(),
+		Bio:         u.Bio,
+		JoinedAt:    u.CreatedAt,
+		PinnedPosts: []PostSummaryResponse{},
+		RecentPosts: []PostSummaryResponse{},
+	}
+	if !u.HideEmailVerified {
+		verified := u.IsEmailVerified()
+		response.EmailVerified = &verified
+	}
+	if !u.HideLastSeen {
+		online := u.IsOnline(time.Now(), onlineWindow)
+		response.LastSeenAt = u.LastSeen()
+		response.Online = &online
 	}
+	return response
 }
@@ -0,0 +1,33 @@
+package models
+
+// Versioned adds optimistic locking to a model. Updates through the
+// repository increment Version and only apply while the stored version is
+// still the one the record was read with, so concurrent edits fail with a
+// conflict instead of silently overwriting each other.
+type Versioned struct {
+	Version int64 `gorm:"not null;default:1" json:"version"`
+}
+
+// VersionedModel is implemented by models embedding Versioned
+type VersionedModel interface {
+	CurrentVersion() int64
+	SetVersion(version int64)
+}
+
+// CurrentVersion returns the version the record was read with
+func (v *Versioned) CurrentVersion() int64 {
+	return v.Version
+}
+
+// SetVersion sets the version of the record
+func (v *Versioned) SetVersion(version int64) {
+	v.Version = version
+}
+
+// initVersion sets the version of a new record, which databases without
+// RETURNING would otherwise not report back
+func (v *Versioned) initVersion() {
+	if v.Version == 0 {
+		v.Version = 1
+	}
+}
@@ -0,0 +1,175 @@
+package models
+
+import (
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// WebhookEvent names a domain event webhooks can subscribe to
+type WebhookEvent string
+
+const (
+	WebhookPostPublished  WebhookEvent = "post.published"
+	WebhookPostDeleted    WebhookEvent = "post.deleted"
+	WebhookCommentCreated WebhookEvent = "comment.created"
+	WebhookUserRegistered WebhookEvent = "user.registered"
+)
+
+// WebhookEvents lists the events webhooks can subscribe to
+var WebhookEvents = []WebhookEvent{
+	WebhookPostPublished, WebhookPostDeleted, WebhookCommentCreated, WebhookUserRegistered,
+}
+
+// IsGlobalOnly checks if the event concerns no particular user's content, so
+// only global webhooks can subscribe to it
+func (e WebhookEvent) IsGlobalOnly() bool {
+	return e == WebhookUserRegistered
+}
+
+// Webhook is an HTTP endpoint notified of domain events. A user's webhook
+// receives events about their own content; global webhooks, managed by
+// admins, receive events about everyone's.
+type Webhook struct {
+	BaseModel
+	URL         string `gorm:"not null;size:2048" json:"url"`
+	Description string `gorm:"size:255" json:"description"`
+	Secret      string `gorm:"not null;size:100" json:"-"`
+	Events      string `gorm:"not null;size:500" json:"-"`
+	Global      bool   `gorm:"default:false" json:"global"`
+	Active      bool   `gorm:"default:true" json:"active"`
+
+	// Foreign keys
+	UserID uuid.UUID `gorm:"type:uuid;not null;index" json:"user_id"`
+}
+
+// TableName returns the table name for Webhook model
+func (Webhook) TableName() string {
+	return "webhooks"
+}
+
+// EventList returns the events the webhook subscribes to
+func (w *Webhook) EventList() []WebhookEvent {
+	if w.Events == "" {
+		return []WebhookEvent{}
+	}
+	parts := strings.Split(w.Events, ",")
+	events := make([]WebhookEvent, len(parts))
+	for i, part := range parts {
+		events[i] = WebhookEvent(part)
+	}
+	return events
+}
+
+// SetEvents sets the events the webhook subscribes to
+func (w *Webhook) SetEvents(events []WebhookEvent) {
+	parts := make([]string, len(events))
+	for i, event := range events {
+		parts[i] = string(event)
+	}
+	w.Events = strings.Join(parts, ",")
+}
+
+// WebhookResponse is the response structure for webhook data
+type WebhookResponse struct {
+	ID          uuid.UUID      `json:"id"`
+	URL         string         `json:"url"`
+	Description string         `json:"description,omitempty"`
+	Events      []WebhookEvent `json:"events"`
+	Global      bool           `json:"global"`
+	Active      bool           `json:"active"`
+	UserID      uuid.UUID      `json:"user_id"`
+	CreatedAt   time.Time      `json:"created_at"`
+	UpdatedAt   time.Time      `json:"updated_at"`
+}
+
+// ToResponse converts Webhook to WebhookResponse
+func (w *Webhook) ToResponse() *WebhookResponse {
+	return &WebhookResponse{
+		ID:          w.ID,
+		URL:         w.URL,
+		Description: w.Description,
+		Events:      w.EventList(),
+		Global:      w.Global,
+		Active:      w.Active,
+		UserID:      w.UserID,
+		CreatedAt:   w.CreatedAt,
+		UpdatedAt:   w.UpdatedAt,
+	}
+}
+
+// WebhookDeliveryStatus is the state of a webhook delivery
+type WebhookDeliveryStatus string
+
+const (
+	WebhookDeliveryPending   WebhookDeliveryStatus = "pending"
+	WebhookDeliverySucceeded WebhookDeliveryStatus = "succeeded"
+	WebhookDeliveryFailed    WebhookDeliveryStatus = "failed"
+)
+
+// WebhookDelivery is one event sent to a webhook, along with the outcome of
+// its latest attempt. Pending deliveries are retried at NextAttemptAt. A
+// redelivery sends the payload of an earlier delivery again.
+type WebhookDelivery struct {
+	BaseModel
+	Event          WebhookEvent          `gorm:"type:varchar(50);not null" json:"event"`
+	Payload        string                `gorm:"type:text;not null" json:"payload"`
+	Status         WebhookDeliveryStatus `gorm:"type:varchar(20);not null;default:pending;index" json:"status"`
+	Attempts       int                   `gorm:"default:0" json:"attempts"`
+	ResponseStatus int                   `json:"response_status,omitempty"`
+	ResponseBody   string                `gorm:"size:1024" json:"response_body,omitempty"`
+	Error          string                `gorm:"size:1024" json:"error,omitempty"`
+	NextAttemptAt  *time.Time            `gorm:"index" json:"next_attempt_at,omitempty"`
+	DeliveredAt    *time.Time            `json:"delivered_at,omitempty"`
+
+	// Foreign keys
+	WebhookID    uuid.UUID  `gorm:"type:uuid;not null;index" json:"webhook_id"`
+	RedeliveryOf *uuid.UUID `gorm:"type:uuid;index" json:"redelivery_of,omitempty"`
+
+	// Relations
+	Webhook *Webhook `gorm:"foreignKey:WebhookID" json:"-"`
+}
+
+// TableName returns the table name for WebhookDelivery model
+func (WebhookDelivery) TableName() string {
+	return "webhook_deliveries"
+}
+
+// WebhookDeliveryResponse is the response structure for webhook delivery data
+type WebhookDeliveryResponse struct {
+	ID             uuid.UUID             `json:"id"`
+	WebhookID      uuid.UUID             `json:"webhook_id"`
+	RedeliveryOf   *uuid.UUID            `json:"redelivery_of,omitempty"`
+	Event          WebhookEvent          `json:"event"`
+	Payload        string                `json:"payload"`
+	Status         WebhookDeliveryStatus `json:"status"`
+	Attempts       int                   `json:"attempts"`
+	ResponseStatus int                   `json:"response_status,omitempty"`
+	ResponseBody   string                `json:"response_body,omitempty"`
+	Error          string                `json:"error,omitempty"`
+	NextAttemptAt  *time.Time            `json:"next_attempt_at,omitempty"`
+	DeliveredAt    *time.Time            `json:"delivered_at,omitempty"`
+	CreatedAt      time.Time             `json:"created_at"`
+	UpdatedAt      time.Time             `json:"updated_at"`
+}
+
+// ToResponse converts WebhookDelivery to WebhookDeliveryResponse
+func (d *WebhookDelivery) ToResponse() *WebhookDeliveryResponse {
+	return &WebhookDeliveryResponse{
+		ID:             d.ID,
+		WebhookID:      d.WebhookID,
+		RedeliveryOf:   d.RedeliveryOf,
+		Event:          d.Event,
+		Payload:        d.Payload,
+		Status:         d.Status,
+		Attempts:       d.Attempts,
+		ResponseStatus: d.ResponseStatus,
+		ResponseBody:   d.ResponseBody,
+		Error:          d.Error,
+		NextAttemptAt:  d.NextAttemptAt,
+		DeliveredAt:    d.DeliveredAt,
+		CreatedAt:      d.CreatedAt,
+		UpdatedAt:      d.UpdatedAt,
+	}
+}
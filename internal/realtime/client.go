@@ -0,0 +1,200 @@
+package realtime
+
+import (
+	"context"
+	"encoding/json"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/gorilla/websocket"
+	"github.com/yourusername/go-enterprise-api/internal/models"
+	"github.com/yourusername/go-enterprise-api/pkg/logger"
+)
+
+const (
+	// sendBuffer is the number of messages queued for a client. Clients
+	// falling this far behind are disconnected.
+	sendBuffer = 64
+	// maxMessageSize bounds the messages clients send
+	maxMessageSize = 4096
+	// writeWait bounds the time taken to write a message
+	writeWait = 10 * time.Second
+)
+
+// client is a connected WebSocket client
+type client struct {
+	hub  *Hub
+	conn *websocket.Conn
+	user *models.User
+
+	// send queues the messages written by writePump
+	send chan []byte
+	// posts are the posts the client follows, guarded by hub.mu
+	posts map[uuid.UUID]struct{}
+
+	closeOnce sync.Once
+	done      chan struct{}
+	// closeCode is sent in the close message once done is closed
+	closeCode int
+
+	// windowStart and windowCount count the messages received in the
+	// current minute. They are only used by readPump.
+	windowStart time.Time
+	windowCount int
+}
+
+// newClient creates a client for a connection
+func newClient(hub *Hub, conn *websocket.Conn, user *models.User) *client {
+	return &client{
+		hub:   hub,
+		conn:  conn,
+		user:  user,
+		send:  make(chan []byte, sendBuffer),
+		posts: make(map[uuid.UUID]struct{}),
+		done:  make(chan struct{}),
+	}
+}
+
+// queue queues a message for writing. A client whose queue is full is
+// disconnected rather than slowing down the others.
+func (c *client) queue(payload []byte) {
+	select {
+	case <-c.done:
+	case c.send <- payload:
+	default:
+		logger.Warn("WebSocket client too slow, disconnecting", logger.String("user_id", c.user.ID.String()))
+		c.close()
+	}
+}
+
+// close stops writePump, which closes the connection
+func (c *client) close() {
+	c.closeWith(websocket.CloseNormalClosure)
+}
+
+// closeWith stops writePump, which sends a close message with code and
+// closes the connection
+func (c *client) closeWith(code int) {
+	c.closeOnce.Do(func() {
+		c.closeCode = code
+		close(c.done)
+	})
+}
+
+// readPump handles the messages sent by the client until the connection is
+// closed or stops answering pings
+func (c *client) readPump(ctx context.Context) {
+	pongWait := 2 * c.hub.opts.PingInterval
+	c.conn.SetReadLimit(maxMessageSize)
+	_ = c.conn.SetReadDeadline(time.Now().Add(pongWait))
+	c.conn.SetPongHandler(func(string) error {
+		return c.conn.SetReadDeadline(time.Now().Add(pongWait))
+	})
+
+	for {
+		_, payload, err := c.conn.ReadMessage()
+		if err != nil {
+			if websocket.IsUnexpectedCloseError(err, websocket.CloseNormalClosure, websocket.CloseGoingAway) {
+				logger.Debug("WebSocket connection lost", logger.String("user_id", c.user.ID.String()), logger.Err(err))
+			}
+			return
+		}
+		// Any message shows the client is alive
+		_ = c.conn.SetReadDeadline(time.Now().Add(pongWait))
+
+		if !c.allow() {
+			c.reply(&Message{Type: TypeError, Error: "Too many messages"})
+			continue
+		}
+
+		var msg Message
+		if err := json.Unmarshal(payload, &msg); err != nil {
+			c.reply(&Message{Type: TypeError, Error: "Invalid message"})
+			continue
+		}
+		c.handle(ctx, &msg)
+	}
+}
+
+// handle answers a message sent by the client
+func (c *client) handle(ctx context.Context, msg *Message) {
+	switch msg.Type {
+	case TypePing:
+		c.reply(&Message{Type: TypePong})
+	case TypeSubscribe:
+		if msg.PostID == nil {
+			c.reply(&Message{Type: TypeError, Error: "post_id is required"})
+			return
+		}
+		if err := c.hub.opts.Authorize(ctx, c.user, *msg.PostID); err != nil {
+			c.reply(&Message{Type: TypeError, PostID: msg.PostID, Error: "Post not found"})
+			return
+		}
+		if !c.hub.subscribe(c, *msg.PostID) {
+			c.reply(&Message{Type: TypeError, PostID: msg.PostID, Error: "Too many subscriptions"})
+			return
+		}
+		c.reply(&Message{Type: TypeSubscribed, PostID: msg.PostID})
+	case TypeUnsubscribe:
+		if msg.PostID == nil {
+			c.reply(&Message{Type: TypeError, Error: "post_id is required"})
+			return
+		}
+		c.hub.unsubscribe(c, *msg.PostID)
+		c.reply(&Message{Type: TypeUnsubscribed, PostID: msg.PostID})
+	default:
+		c.reply(&Message{Type: TypeError, Error: "Unknown message type"})
+	}
+}
+
+// allow reports whether the client is within its message rate
+func (c *client) allow() bool {
+	now := time.Now()
+	if now.Sub(c.windowStart) >= time.Minute {
+		c.windowStart = now
+		c.windowCount = 0
+	}
+	c.windowCount++
+	return c.windowCount <= c.hub.opts.MessageRate
+}
+
+// reply queues an answer to the client
+func (c *client) reply(msg *Message) {
+	payload, err := json.Marshal(msg)
+	if err != nil {
+		return
+	}
+	c.queue(payload)
+}
+
+// writePump writes queued messages and pings the client until the client
+// is closed, then closes the connection
+func (c *client) writePump() {
+	ticker := time.NewTicker(c.hub.opts.PingInterval)
+	defer func() {
+		ticker.Stop()
+		_ = c.conn.Close()
+	}()
+
+	for {
+		select {
+		case payload := <-c.send:
+			_ = c.conn.SetWriteDeadline(time.Now().Add(writeWait))
+			if err := c.conn.WriteMessage(websocket.TextMessage, payload); err != nil {
+				c.close()
+				return
+			}
+		case <-ticker.C:
+			_ = c.conn.SetWriteDeadline(time.Now().Add(writeWait))
+			if err := c.conn.WriteMessage(websocket.PingMessage, nil); err != nil {
+				c.close()
+				return
+			}
+		case <-c.done:
+			_ = c.conn.WriteControl(websocket.CloseMessage,
+				websocket.FormatCloseMessage(c.closeCode, ""), time.Now().Add(writeWait))
+			return
+		}
+	}
+}
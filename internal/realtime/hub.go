@@ -0,0 +1,282 @@
+// Package realtime pushes events to clients connected over WebSocket.
+// Clients receive their own notifications and subscribe to the posts whose
+// new comments they want to see.
+package realtime
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/gorilla/websocket"
+	"github.com/yourusername/go-enterprise-api/internal/models"
+	"github.com/yourusername/go-enterprise-api/pkg/logger"
+)
+
+// Message types
+const (
+	// Sent by clients
+	TypeSubscribe   = "subscribe"
+	TypeUnsubscribe = "unsubscribe"
+	TypePing        = "ping"
+
+	// Sent by the server
+	TypeSubscribed          = "subscribed"
+	TypeUnsubscribed        = "unsubscribed"
+	TypePong                = "pong"
+	TypeError               = "error"
+	TypeCommentCreated      = "comment.created"
+	TypeNotificationCreated = "notification.created"
+)
+
+// TokenProtocol is the WebSocket subprotocol browsers offer, followed by
+// their access token, to authenticate, since they cannot set headers on
+// WebSocket requests
+const TokenProtocol = "access_token"
+
+// Message is a message exchanged with a client
+type Message struct {
+	Type   string      `json:"type"`
+	PostID *uuid.UUID  `json:"post_id,omitempty"`
+	Data   interface{} `json:"data,omitempty"`
+	Error  string      `json:"error,omitempty"`
+}
+
+// Render renders a message for the user a client belongs to, or returns nil
+// when the user should not get it
+type Render func(viewer *models.User) *Message
+
+// Authorize reports whether user may follow the comments on a post
+type Authorize func(ctx context.Context, user *models.User, postID uuid.UUID) error
+
+// Options configures a hub
+type Options struct {
+	// AllowedOrigins lists the origins browsers may connect from; "*"
+	// allows any. Clients sending no Origin, such as servers, are always
+	// allowed.
+	AllowedOrigins []string
+	// PingInterval is how often clients are pinged. Clients that do not
+	// answer within twice the interval are disconnected.
+	PingInterval time.Duration
+	// MessageRate is the number of messages a client may send per minute.
+	// Clients sending more get an error instead of an answer.
+	MessageRate int
+	// MaxSubscriptions bounds the posts a client follows at once
+	MaxSubscriptions int
+	// Authorize checks subscriptions to posts
+	Authorize Authorize
+}
+
+// Hub keeps track of connected clients and of the posts they follow
+type Hub struct {
+	opts     Options
+	upgrader websocket.Upgrader
+
+	mu     sync.RWMutex
+	byUser map[uuid.UUID]map[*client]struct{}
+	byPost map[uuid.UUID]map[*client]struct{}
+	closed bool
+
+	// serving counts the connections being served
+	serving sync.WaitGroup
+}
+
+// NewHub creates a hub
+func NewHub(opts Options) *Hub {
+	h := &Hub{
+		opts:   opts,
+		byUser: make(map[uuid.UUID]map[*client]struct{}),
+		byPost: make(map[uuid.UUID]map[*client]struct{}),
+	}
+	h.upgrader = websocket.Upgrader{
+		ReadBufferSize:  1024,
+		WriteBufferSize: 1024,
+		Subprotocols:    []string{TokenProtocol},
+		CheckOrigin:     h.checkOrigin,
+	}
+	return h
+}
+
+// Serve upgrades an authenticated request to a WebSocket connection and
+// serves it until it is closed
+func (h *Hub) Serve(w http.ResponseWriter, r *http.Request, user *models.User) {
+	h.mu.Lock()
+	if h.closed {
+		h.mu.Unlock()
+		http.Error(w, "Server is shutting down", http.StatusServiceUnavailable)
+		return
+	}
+	h.serving.Add(1)
+	h.mu.Unlock()
+	defer h.serving.Done()
+
+	conn, err := h.upgrader.Upgrade(w, r, nil)
+	if err != nil {
+		// The upgrader has already answered the request
+		logger.Debug("WebSocket upgrade failed", logger.Err(err))
+		return
+	}
+
+	c := newClient(h, conn, user)
+	h.register(c)
+	defer h.unregister(c)
+
+	go c.writePump()
+	c.readPump(r.Context())
+}
+
+// Close disconnects every client with a going away close message, so they
+// reconnect to another instance, and waits until their connections are
+// closed or ctx is done. New connections are refused from then on.
+func (h *Hub) Close(ctx context.Context) error {
+	h.mu.Lock()
+	h.closed = true
+	for _, clients := range h.byUser {
+		for c := range clients {
+			c.closeWith(websocket.CloseGoingAway)
+		}
+	}
+	h.mu.Unlock()
+
+	done := make(chan struct{})
+	go func() {
+		h.serving.Wait()
+		close(done)
+	}()
+
+	select {
+	case <-done:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+// SendToUser sends a message to every connection of a user
+func (h *Hub) SendToUser(userID uuid.UUID, render Render) {
+	h.mu.RLock()
+	clients := make([]*client, 0, len(h.byUser[userID]))
+	for c := range h.byUser[userID] {
+		clients = append(clients, c)
+	}
+	h.mu.RUnlock()
+
+	h.send(clients, render)
+}
+
+// SendToPost sends a message to every connection following a post
+func (h *Hub) SendToPost(postID uuid.UUID, render Render) {
+	h.mu.RLock()
+	clients := make([]*client, 0, len(h.byPost[postID]))
+	for c := range h.byPost[postID] {
+		clients = append(clients, c)
+	}
+	h.mu.RUnlock()
+
+	h.send(clients, render)
+}
+
+// Connected reports whether a user has an open connection
+func (h *Hub) Connected(userID uuid.UUID) bool {
+	h.mu.RLock()
+	defer h.mu.RUnlock()
+	return len(h.byUser[userID]) > 0
+}
+
+// send renders a message for each client and queues it
+func (h *Hub) send(clients []*client, render Render) {
+	for _, c := range clients {
+		msg := render(c.user)
+		if msg == nil {
+			continue
+		}
+		payload, err := json.Marshal(msg)
+		if err != nil {
+			logger.Error("Failed to encode WebSocket message", logger.String("type", msg.Type), logger.Err(err))
+			continue
+		}
+		c.queue(payload)
+	}
+}
+
+// register adds a connected client
+func (h *Hub) register(c *client) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	if h.byUser[c.user.ID] == nil {
+		h.byUser[c.user.ID] = make(map[*client]struct{})
+	}
+	h.byUser[c.user.ID][c] = struct{}{}
+}
+
+// unregister removes a disconnected client along with its subscriptions
+func (h *Hub) unregister(c *client) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	delete(h.byUser[c.user.ID], c)
+	if len(h.byUser[c.user.ID]) == 0 {
+		delete(h.byUser, c.user.ID)
+	}
+	for postID := range c.posts {
+		h.removeFromPost(c, postID)
+	}
+	c.close()
+}
+
+// subscribe makes a client follow a post. It reports false when the client
+// already follows as many posts as allowed.
+func (h *Hub) subscribe(c *client, postID uuid.UUID) bool {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	if _, ok := c.posts[postID]; ok {
+		return true
+	}
+	if len(c.posts) >= h.opts.MaxSubscriptions {
+		return false
+	}
+	c.posts[postID] = struct{}{}
+	if h.byPost[postID] == nil {
+		h.byPost[postID] = make(map[*client]struct{})
+	}
+	h.byPost[postID][c] = struct{}{}
+	return true
+}
+
+// unsubscribe stops a client from following a post
+func (h *Hub) unsubscribe(c *client, postID uuid.UUID) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	delete(c.posts, postID)
+	h.removeFromPost(c, postID)
+}
+
+// removeFromPost removes a client from the followers of a post. The caller
+// holds h.mu.
+func (h *Hub) removeFromPost(c *client, postID uuid.UUID) {
+	delete(h.byPost[postID], c)
+	if len(h.byPost[postID]) == 0 {
+		delete(h.byPost, postID)
+	}
+}
+
+// checkOrigin allows browsers on the allowed origins and clients sending no
+// Origin
+func (h *Hub) checkOrigin(r *http.Request) bool {
+	origin := r.Header.Get("Origin")
+	if origin == "" {
+		return true
+	}
+	for _, allowed := range h.opts.AllowedOrigins {
+		if allowed == "*" || allowed == origin {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,87 @@
+package repository
+
+import (
+	"context"
+	"strings"
+
+	"github.com/google/uuid"
+	"github.com/yourusername/go-enterprise-api/internal/database"
+	"github.com/yourusername/go-enterprise-api/internal/models"
+	"gorm.io/gorm"
+)
+
+// AdminSearchRepository interface defines looking up records for support
+// investigations. Searches include soft-deleted and shadowed records.
+type AdminSearchRepository interface {
+	FindUsersByID(ctx context.Context, id uuid.UUID) ([]models.User, error)
+	FindUsersByEmail(ctx context.Context, email string, limit int) ([]models.User, error)
+	FindPostsByID(ctx context.Context, id uuid.UUID) ([]models.Post, error)
+	FindPostsBySlug(ctx context.Context, slug string, limit int) ([]models.Post, error)
+	FindCommentsByID(ctx context.Context, id uuid.UUID) ([]models.Comment, error)
+}
+
+// adminSearchRepository implements AdminSearchRepository
+type adminSearchRepository struct {
+	DB *gorm.DB
+}
+
+// NewAdminSearchRepository creates a new admin search repository
+func NewAdminSearchRepository(db *gorm.DB) AdminSearchRepository {
+	return &adminSearchRepository{DB: db}
+}
+
+// findByID finds the record of a table with an ID, including soft-deleted
+// ones, as a slice of zero or one records
+func findByID[T any](ctx context.Context, db *gorm.DB, id uuid.UUID) ([]T, error) {
+	var records []T
+	err := db.WithContext(ctx).Scopes(database.WithDeleted()).Where("id = ?", id).Limit(1).Find(&records).Error
+	return records, err
+}
+
+// FindUsersByID finds the user with an ID
+func (r *adminSearchRepository) FindUsersByID(ctx context.Context, id uuid.UUID) ([]models.User, error) {
+	return findByID[models.User](ctx, r.DB, id)
+}
+
+// FindUsersByEmail finds users whose email contains email, ignoring case,
+// newest first
+func (r *adminSearchRepository) FindUsersByEmail(ctx context.Context, email string, limit int) ([]models.User, error) {
+	email = strings.ToLower(email)
+	var users []models.User
+	err := r.DB.WithContext(ctx).
+		Scopes(database.WithDeleted()).
+		Where("LOWER(email) LIKE ?", "%"+email+"%").
+		Order("created_at DESC").
+		Limit(limit).
+		Find(&users).Error
+	return users, err
+}
+
+// FindPostsByID finds the post with an ID
+func (r *adminSearchRepository) FindPostsByID(ctx context.Context, id uuid.UUID) ([]models.Post, error) {
+	return findByID[models.Post](ctx, r.DB, id)
+}
+
+// FindPostsBySlug finds posts whose slug contains slug, or that previously
+// had slug as their slug, newest first
+func (r *adminSearchRepository) FindPostsBySlug(ctx context.Context, slug string, limit int) ([]models.Post, error) {
+	slug = strings.ToLower(slug)
+	previous := r.DB.Model(&models.SlugRedirect{}).
+		Scopes(database.WithDeleted()).
+		Select("post_id").
+		Where("old_slug = ?", slug)
+
+	var posts []models.Post
+	err := r.DB.WithContext(ctx).
+		Scopes(database.WithDeleted()).
+		Where("slug LIKE ? OR id IN (?)", "%"+slug+"%", previous).
+		Order("created_at DESC").
+		Limit(limit).
+		Find(&posts).Error
+	return posts, err
+}
+
+// FindCommentsByID finds the comment with an ID
+func (r *adminSearchRepository) FindCommentsByID(ctx context.Context, id uuid.UUID) ([]models.Comment, error) {
+	return findByID[models.Comment](ctx, r.DB, id)
+}
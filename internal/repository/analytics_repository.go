@@ -0,0 +1,149 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/yourusername/go-enterprise-api/internal/models"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// AnalyticsRepository interface defines post analytics repository methods
+type AnalyticsRepository interface {
+	RecordView(ctx context.Context, postID uuid.UUID, day, readerHash, referrer string) error
+	RollUpViews(ctx context.Context, limit int) (int, error)
+	FindDailyStats(ctx context.Context, postID uuid.UUID, from, to string) ([]models.PostDailyStat, error)
+	FindReferrerTotals(ctx context.Context, postID uuid.UUID, from, to string) ([]models.ReferrerViews, error)
+	FindAuthorDailyStats(ctx context.Context, userID uuid.UUID, from, to string) ([]models.AuthorAnalyticsRow, error)
+}
+
+// analyticsRepository implements AnalyticsRepository
+type analyticsRepository struct {
+	DB *gorm.DB
+}
+
+// NewAnalyticsRepository creates a new analytics repository
+func NewAnalyticsRepository(db *gorm.DB) AnalyticsRepository {
+	return &analyticsRepository{DB: db}
+}
+
+// RecordView adds a view to the daily and referrer aggregates of a post.
+// The reader is counted as unique the first time they view the post that day.
+func (r *analyticsRepository) RecordView(ctx context.Context, postID uuid.UUID, day, readerHash, referrer string) error {
+	return transaction(ctx, r.DB, func(tx *gorm.DB) error {
+		result := tx.Clauses(clause.OnConflict{DoNothing: true}).Create(&models.PostViewReader{
+			PostID:     postID,
+			Day:        day,
+			ReaderHash: readerHash,
+		})
+		if result.Error != nil {
+			return result.Error
+		}
+		unique := result.RowsAffected
+
+		now := time.Now().UTC()
+		err := tx.Clauses(clause.OnConflict{
+			Columns: []clause.Column{{Name: "post_id"}, {Name: "day"}},
+			DoUpdates: clause.Assignments(map[string]interface{}{
+				"views":          gorm.Expr("post_daily_stats.views + 1"),
+				"unique_readers": gorm.Expr("post_daily_stats.unique_readers + ?", unique),
+				"pending_views":  gorm.Expr("post_daily_stats.pending_views + 1"),
+				"updated_at":     now,
+			}),
+		}).Create(&models.PostDailyStat{
+			PostID:        postID,
+			Day:           day,
+			Views:         1,
+			UniqueReaders: unique,
+			PendingViews:  1,
+			UpdatedAt:     now,
+		}).Error
+		if err != nil {
+			return err
+		}
+
+		return tx.Clauses(clause.OnConflict{
+			Columns: []clause.Column{{Name: "post_id"}, {Name: "day"}, {Name: "referrer"}},
+			DoUpdates: clause.Assignments(map[string]interface{}{
+				"views":      gorm.Expr("post_referrer_stats.views + 1"),
+				"updated_at": now,
+			}),
+		}).Create(&models.PostReferrerStat{
+			PostID:    postID,
+			Day:       day,
+			Referrer:  referrer,
+			Views:     1,
+			UpdatedAt: now,
+		}).Error
+	})
+}
+
+// RollUpViews adds the pending views of up to limit daily aggregates to
+// their posts' view counts and returns how many aggregates were rolled up.
+// Views recorded meanwhile stay pending for the next run.
+func (r *analyticsRepository) RollUpViews(ctx context.Context, limit int) (int, error) {
+	var stats []models.PostDailyStat
+	err := r.DB.WithContext(ctx).
+		Where("pending_views > ?", 0).
+		Limit(limit).
+		Find(&stats).Error
+	if err != nil {
+		return 0, err
+	}
+
+	for _, stat := range stats {
+		err := transaction(ctx, r.DB, func(tx *gorm.DB) error {
+			result := tx.Model(&models.PostDailyStat{}).
+				Where("post_id = ? AND day = ? AND pending_views >= ?", stat.PostID, stat.Day, stat.PendingViews).
+				UpdateColumn("pending_views", gorm.Expr("pending_views - ?", stat.PendingViews))
+			if result.Error != nil || result.RowsAffected == 0 {
+				return result.Error
+			}
+
+			return tx.Model(&models.Post{}).
+				Where("id = ?", stat.PostID).
+				UpdateColumn("view_count", gorm.Expr("view_count + ?", stat.PendingViews)).Error
+		})
+		if err != nil {
+			return 0, err
+		}
+	}
+	return len(stats), nil
+}
+
+// FindDailyStats finds the daily aggregates of a post between two days (inclusive)
+func (r *analyticsRepository) FindDailyStats(ctx context.Context, postID uuid.UUID, from, to string) ([]models.PostDailyStat, error) {
+	var stats []models.PostDailyStat
+	err := r.DB.WithContext(ctx).
+		Where("post_id = ? AND day >= ? AND day <= ?", postID, from, to).
+		Order("day ASC").
+		Find(&stats).Error
+	return stats, err
+}
+
+// FindReferrerTotals sums the views of a post per referrer between two days (inclusive)
+func (r *analyticsRepository) FindReferrerTotals(ctx context.Context, postID uuid.UUID, from, to string) ([]models.ReferrerViews, error) {
+	var referrers []models.ReferrerViews
+	err := r.DB.WithContext(ctx).Model(&models.PostReferrerStat{}).
+		Select("referrer, SUM(views) AS views").
+		Where("post_id = ? AND day >= ? AND day <= ?", postID, from, to).
+		Group("referrer").
+		Order("views DESC, referrer ASC").
+		Scan(&referrers).Error
+	return referrers, err
+}
+
+// FindAuthorDailyStats finds the daily aggregates of all posts of an author between two days (inclusive)
+func (r *analyticsRepository) FindAuthorDailyStats(ctx context.Context, userID uuid.UUID, from, to string) ([]models.AuthorAnalyticsRow, error) {
+	var rows []models.AuthorAnalyticsRow
+	err := r.DB.WithContext(ctx).Table("post_daily_stats").
+		Select("post_daily_stats.day AS date, posts.id AS post_id, posts.title AS post_title, post_daily_stats.views, post_daily_stats.unique_readers").
+		Joins("JOIN posts ON posts.id = post_daily_stats.post_id").
+		Where("posts.user_id = ? AND posts.deleted_at IS NULL", userID).
+		Where("post_daily_stats.day >= ? AND post_daily_stats.day <= ?", from, to).
+		Order("post_daily_stats.day ASC, posts.title ASC").
+		Scan(&rows).Error
+	return rows, err
+}
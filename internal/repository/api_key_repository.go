@@ -0,0 +1,76 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/yourusername/go-enterprise-api/internal/models"
+	apperrors "github.com/yourusername/go-enterprise-api/pkg/errors"
+	"gorm.io/gorm"
+)
+
+// APIKeyRepository interface defines API key-specific repository methods
+type APIKeyRepository interface {
+	Repository[models.APIKey]
+	FindByHash(ctx context.Context, hash string) (*models.APIKey, error)
+	FindByUserID(ctx context.Context, userID uuid.UUID) ([]models.APIKey, error)
+	Revoke(ctx context.Context, id uuid.UUID) error
+	UpdateLastUsed(ctx context.Context, id uuid.UUID, at time.Time) error
+}
+
+// apiKeyRepository implements APIKeyRepository
+type apiKeyRepository struct {
+	*BaseRepository[models.APIKey]
+}
+
+// NewAPIKeyRepository creates a new API key repository
+func NewAPIKeyRepository(db *gorm.DB) APIKeyRepository {
+	return &apiKeyRepository{
+		BaseRepository: NewBaseRepository[models.APIKey](db),
+	}
+}
+
+// FindByHash finds an API key by its hash, including its owner
+func (r *apiKeyRepository) FindByHash(ctx context.Context, hash string) (*models.APIKey, error) {
+	var key models.APIKey
+	err := r.DB.WithContext(ctx).Preload("User").Where("key_hash = ?", hash).First(&key).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, apperrors.ErrInvalidAPIKey
+		}
+		return nil, err
+	}
+	return &key, nil
+}
+
+// FindByUserID finds all API keys owned by a user
+func (r *apiKeyRepository) FindByUserID(ctx context.Context, userID uuid.UUID) ([]models.APIKey, error) {
+	var keys []models.APIKey
+	err := r.DB.WithContext(ctx).Where("user_id = ?", userID).Order("created_at DESC").Find(&keys).Error
+	return keys, err
+}
+
+// Revoke marks an API key as revoked
+func (r *apiKeyRepository) Revoke(ctx context.Context, id uuid.UUID) error {
+	return r.DB.WithContext(ctx).Model(&models.APIKey{}).Where("id = ?", id).Update("revoked_at", time.Now().UTC()).Error
+}
+
+// UpdateLastUsed updates the key's last used timestamp
+func (r *apiKeyRepository) UpdateLastUsed(ctx context.Context, id uuid.UUID, at time.Time) error {
+	return r.DB.WithContext(ctx).Model(&models.APIKey{}).Where("id = ?", id).UpdateColumn("last_used_at", at).Error
+}
+
+// FindByID overrides base to include error handling
+func (r *apiKeyRepository) FindByID(ctx context.Context, id uuid.UUID) (*models.APIKey, error) {
+	var key models.APIKey
+	err := r.DB.WithContext(ctx).First(&key, "id = ?", id).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, apperrors.ErrNotFound.WithDetails("API key not found")
+		}
+		return nil, err
+	}
+	return &key, nil
+}
@@ -0,0 +1,78 @@
+package repository
+
+import (
+	"context"
+	"errors"
+
+	"github.com/google/uuid"
+	"github.com/yourusername/go-enterprise-api/internal/models"
+	apperrors "github.com/yourusername/go-enterprise-api/pkg/errors"
+	"gorm.io/gorm"
+)
+
+// AppealRepository interface defines appeal-specific repository methods
+type AppealRepository interface {
+	Repository[models.Appeal]
+	FindWithRelations(ctx context.Context, id uuid.UUID) (*models.Appeal, error)
+	FindQueue(ctx context.Context, status models.AppealStatus, appealType models.AppealType, page, pageSize int) ([]models.Appeal, int64, error)
+	ExistsBanAppeal(ctx context.Context, userID uuid.UUID) (bool, error)
+}
+
+// appealRepository implements AppealRepository
+type appealRepository struct {
+	*BaseRepository[models.Appeal]
+}
+
+// NewAppealRepository creates a new appeal repository
+func NewAppealRepository(db *gorm.DB) AppealRepository {
+	return &appealRepository{
+		BaseRepository: NewBaseRepository[models.Appeal](db),
+	}
+}
+
+// FindWithRelations finds an appeal along with its user and post
+func (r *appealRepository) FindWithRelations(ctx context.Context, id uuid.UUID) (*models.Appeal, error) {
+	var appeal models.Appeal
+	err := r.DB.WithContext(ctx).Preload("User").Preload("Post").First(&appeal, "id = ?", id).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, apperrors.ErrNotFound.WithDetails("Appeal not found")
+		}
+		return nil, err
+	}
+	return &appeal, nil
+}
+
+// FindQueue finds appeals, oldest first, optionally filtered by status and type
+func (r *appealRepository) FindQueue(ctx context.Context, status models.AppealStatus, appealType models.AppealType, page, pageSize int) ([]models.Appeal, int64, error) {
+	var appeals []models.Appeal
+	var total int64
+
+	query := r.DB.WithContext(ctx).Model(&models.Appeal{})
+	if status != "" {
+		query = query.Where("status = ?", status)
+	}
+	if appealType != "" {
+		query = query.Where("type = ?", appealType)
+	}
+	if err := query.Count(&total).Error; err != nil {
+		return nil, 0, err
+	}
+
+	offset := (page - 1) * pageSize
+	err := query.Preload("User").Preload("Post").
+		Order("created_at ASC").
+		Offset(offset).Limit(pageSize).
+		Find(&appeals).Error
+	return appeals, total, err
+}
+
+// ExistsBanAppeal checks if a user has a ban appeal that has not been
+// overturned, i.e. one contesting their current ban
+func (r *appealRepository) ExistsBanAppeal(ctx context.Context, userID uuid.UUID) (bool, error) {
+	var count int64
+	err := r.DB.WithContext(ctx).Model(&models.Appeal{}).
+		Where("user_id = ? AND type = ? AND status <> ?", userID, models.AppealBan, models.AppealOverturned).
+		Count(&count).Error
+	return count > 0, err
+}
@@ -0,0 +1,49 @@
+package repository
+
+import (
+	"context"
+	"errors"
+
+	"github.com/yourusername/go-enterprise-api/internal/models"
+	apperrors "github.com/yourusername/go-enterprise-api/pkg/errors"
+	"gorm.io/gorm"
+)
+
+// BackfillRepository interface defines backfill checkpoint repository methods
+type BackfillRepository interface {
+	Repository[models.BackfillCheckpoint]
+	FindByJob(ctx context.Context, job string) (*models.BackfillCheckpoint, error)
+	FindAllCheckpoints(ctx context.Context) ([]models.BackfillCheckpoint, error)
+}
+
+// backfillRepository implements BackfillRepository
+type backfillRepository struct {
+	*BaseRepository[models.BackfillCheckpoint]
+}
+
+// NewBackfillRepository creates a new backfill checkpoint repository
+func NewBackfillRepository(db *gorm.DB) BackfillRepository {
+	return &backfillRepository{
+		BaseRepository: NewBaseRepository[models.BackfillCheckpoint](db),
+	}
+}
+
+// FindByJob finds the checkpoint of a job
+func (r *backfillRepository) FindByJob(ctx context.Context, job string) (*models.BackfillCheckpoint, error) {
+	var checkpoint models.BackfillCheckpoint
+	err := r.DB.WithContext(ctx).Where("job = ?", job).First(&checkpoint).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, apperrors.ErrNotFound.WithDetails("Backfill checkpoint not found")
+		}
+		return nil, err
+	}
+	return &checkpoint, nil
+}
+
+// FindAllCheckpoints returns the checkpoints of all jobs that have run
+func (r *backfillRepository) FindAllCheckpoints(ctx context.Context) ([]models.BackfillCheckpoint, error) {
+	var checkpoints []models.BackfillCheckpoint
+	err := r.DB.WithContext(ctx).Order("job").Find(&checkpoints).Error
+	return checkpoints, err
+}
@@ -0,0 +1,48 @@
+package repository
+
+import (
+	"context"
+	"errors"
+
+	"github.com/yourusername/go-enterprise-api/internal/models"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// BrandingRepository interface defines branding repository methods
+type BrandingRepository interface {
+	Get(ctx context.Context) (*models.Branding, error)
+	Save(ctx context.Context, branding *models.Branding) error
+}
+
+// brandingRepository implements BrandingRepository
+type brandingRepository struct {
+	DB *gorm.DB
+}
+
+// NewBrandingRepository creates a new branding repository
+func NewBrandingRepository(db *gorm.DB) BrandingRepository {
+	return &brandingRepository{DB: db}
+}
+
+// Get returns the site's branding, or an empty branding when none was saved
+func (r *brandingRepository) Get(ctx context.Context) (*models.Branding, error) {
+	var branding models.Branding
+	err := r.DB.WithContext(ctx).First(&branding, "id = ?", models.SiteBrandingID).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return &models.Branding{ID: models.SiteBrandingID}, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &branding, nil
+}
+
+// Save creates or replaces the site's branding
+func (r *brandingRepository) Save(ctx context.Context, branding *models.Branding) error {
+	branding.ID = models.SiteBrandingID
+	return r.DB.WithContext(ctx).Clauses(clause.OnConflict{
+		Columns:   []clause.Column{{Name: "id"}},
+		UpdateAll: true,
+	}).Create(branding).Error
+}
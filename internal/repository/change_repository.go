@@ -0,0 +1,57 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/yourusername/go-enterprise-api/internal/models"
+	"gorm.io/gorm"
+)
+
+// ChangeRepository interface defines change-tracking repository methods
+type ChangeRepository interface {
+	Repository[models.Change]
+	CreateMany(ctx context.Context, changes []models.Change) error
+	FindByEntity(ctx context.Context, entityType string, entityID uuid.UUID, page, pageSize int) ([]models.Change, int64, error)
+}
+
+// changeRepository implements ChangeRepository
+type changeRepository struct {
+	*BaseRepository[models.Change]
+}
+
+// NewChangeRepository creates a new change repository
+func NewChangeRepository(db *gorm.DB) ChangeRepository {
+	return &changeRepository{
+		BaseRepository: NewBaseRepository[models.Change](db),
+	}
+}
+
+// CreateMany inserts several changes at once
+func (r *changeRepository) CreateMany(ctx context.Context, changes []models.Change) error {
+	if len(changes) == 0 {
+		return nil
+	}
+	return r.DB.WithContext(ctx).Create(&changes).Error
+}
+
+// FindByEntity finds the change timeline of an entity, newest first
+func (r *changeRepository) FindByEntity(ctx context.Context, entityType string, entityID uuid.UUID, page, pageSize int) ([]models.Change, int64, error) {
+	var changes []models.Change
+	var total int64
+
+	query := r.DB.WithContext(ctx).Model(&models.Change{}).
+		Where("entity_type = ? AND entity_id = ?", entityType, entityID)
+	if err := query.Count(&total).Error; err != nil {
+		return nil, 0, err
+	}
+
+	offset := (page - 1) * pageSize
+	err := r.DB.WithContext(ctx).
+		Where("entity_type = ? AND entity_id = ?", entityType, entityID).
+		Order("created_at DESC").
+		Offset(offset).Limit(pageSize).
+		Find(&changes).Error
+
+	return changes, total, err
+}
@@ -0,0 +1,106 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/yourusername/go-enterprise-api/internal/models"
+	apperrors "github.com/yourusername/go-enterprise-api/pkg/errors"
+	"gorm.io/gorm"
+)
+
+// CommentRepository interface defines comment-specific repository methods
+type CommentRepository interface {
+	Repository[models.Comment]
+	FindByPost(ctx context.Context, postID uuid.UUID, status models.CommentStatus, page, pageSize int) ([]models.Comment, int64, error)
+	FindByStatus(ctx context.Context, status models.CommentStatus, page, pageSize int) ([]models.Comment, int64, error)
+	FindByIDs(ctx context.Context, ids []uuid.UUID) ([]models.Comment, error)
+	CountByUserAndStatus(ctx context.Context, userID uuid.UUID, status models.CommentStatus) (int64, error)
+	UpdateStatus(ctx context.Context, ids []uuid.UUID, status models.CommentStatus, moderatorID uuid.UUID) (int64, error)
+}
+
+// commentRepository implements CommentRepository
+type commentRepository struct {
+	*BaseRepository[models.Comment]
+}
+
+// NewCommentRepository creates a new comment repository
+func NewCommentRepository(db *gorm.DB) CommentRepository {
+	return &commentRepository{
+		BaseRepository: NewBaseRepository[models.Comment](db),
+	}
+}
+
+// FindByID overrides base to include error handling
+func (r *commentRepository) FindByID(ctx context.Context, id uuid.UUID) (*models.Comment, error) {
+	var comment models.Comment
+	err := r.DB.WithContext(ctx).Preload("User").First(&comment, "id = ?", id).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, apperrors.ErrNotFound.WithDetails("Comment not found")
+		}
+		return nil, err
+	}
+	return &comment, nil
+}
+
+// FindByPost finds the comments on a post with a status that the viewer may
+// see, oldest first
+func (r *commentRepository) FindByPost(ctx context.Context, postID uuid.UUID, status models.CommentStatus, page, pageSize int) ([]models.Comment, int64, error) {
+	var comments []models.Comment
+	var total int64
+
+	query := r.DB.WithContext(ctx).Model(&models.Comment{}).Scopes(visibleTo(ctx)).Where("post_id = ? AND status = ?", postID, status)
+	if err := query.Count(&total).Error; err != nil {
+		return nil, 0, err
+	}
+
+	offset := (page - 1) * pageSize
+	err := query.Preload("User").Offset(offset).Limit(pageSize).Order("created_at ASC").Find(&comments).Error
+	return comments, total, err
+}
+
+// FindByStatus finds comments with a status across all posts, oldest first
+// so that moderation queues are worked in order
+func (r *commentRepository) FindByStatus(ctx context.Context, status models.CommentStatus, page, pageSize int) ([]models.Comment, int64, error) {
+	var comments []models.Comment
+	var total int64
+
+	query := r.DB.WithContext(ctx).Model(&models.Comment{}).Where("status = ?", status)
+	if err := query.Count(&total).Error; err != nil {
+		return nil, 0, err
+	}
+
+	offset := (page - 1) * pageSize
+	err := query.Preload("User").Offset(offset).Limit(pageSize).Order("created_at ASC").Find(&comments).Error
+	return comments, total, err
+}
+
+// FindByIDs finds comments by ID along with their posts
+func (r *commentRepository) FindByIDs(ctx context.Context, ids []uuid.UUID) ([]models.Comment, error) {
+	var comments []models.Comment
+	err := r.DB.WithContext(ctx).Preload("Post").Where("id IN ?", ids).Find(&comments).Error
+	return comments, err
+}
+
+// CountByUserAndStatus counts a user's comments with a status
+func (r *commentRepository) CountByUserAndStatus(ctx context.Context, userID uuid.UUID, status models.CommentStatus) (int64, error) {
+	var count int64
+	err := r.DB.WithContext(ctx).Model(&models.Comment{}).Where("user_id = ? AND status = ?", userID, status).Count(&count).Error
+	return count, err
+}
+
+// UpdateStatus moderates comments in bulk and returns the number of comments changed
+func (r *commentRepository) UpdateStatus(ctx context.Context, ids []uuid.UUID, status models.CommentStatus, moderatorID uuid.UUID) (int64, error) {
+	result := r.DB.WithContext(ctx).
+		Model(&models.Comment{}).
+		Where("id IN ? AND status <> ?", ids, status).
+		Updates(map[string]interface{}{
+			"status":       status,
+			"moderated_by": moderatorID,
+			"moderated_at": time.Now().UTC(),
+		})
+	return result.RowsAffected, result.Error
+}
@@ -0,0 +1,115 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/yourusername/go-enterprise-api/internal/models"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// FollowRepository interface defines user follow repository methods
+type FollowRepository interface {
+	Follow(ctx context.Context, followerID, followeeID uuid.UUID) (bool, error)
+	Unfollow(ctx context.Context, followerID, followeeID uuid.UUID) error
+	FindFollowers(ctx context.Context, userID uuid.UUID, page, pageSize int) ([]models.UserFollow, int64, error)
+	FindFollowing(ctx context.Context, userID uuid.UUID, page, pageSize int) ([]models.UserFollow, int64, error)
+	FindFeed(ctx context.Context, userID uuid.UUID, includeMature bool, before *FeedCursor, limit int) ([]models.Post, error)
+}
+
+// FeedCursor is the position of the last post of a feed page. Feeds are
+// ordered by creation time, then ID, newest first.
+type FeedCursor struct {
+	CreatedAt time.Time
+	ID        uuid.UUID
+}
+
+// followRepository implements FollowRepository
+type followRepository struct {
+	DB *gorm.DB
+}
+
+// NewFollowRepository creates a new follow repository
+func NewFollowRepository(db *gorm.DB) FollowRepository {
+	return &followRepository{DB: db}
+}
+
+// Follow makes a user follow another user. created is false when they
+// already did.
+func (r *followRepository) Follow(ctx context.Context, followerID, followeeID uuid.UUID) (bool, error) {
+	result := r.DB.WithContext(ctx).
+		Clauses(clause.OnConflict{DoNothing: true}).
+		Create(&models.UserFollow{FollowerID: followerID, FolloweeID: followeeID})
+	return result.RowsAffected > 0, result.Error
+}
+
+// Unfollow makes a user stop following another user
+func (r *followRepository) Unfollow(ctx context.Context, followerID, followeeID uuid.UUID) error {
+	return r.DB.WithContext(ctx).
+		Where("follower_id = ? AND followee_id = ?", followerID, followeeID).
+		Delete(&models.UserFollow{}).Error
+}
+
+// FindFollowers finds the users following a user, most recent first
+func (r *followRepository) FindFollowers(ctx context.Context, userID uuid.UUID, page, pageSize int) ([]models.UserFollow, int64, error) {
+	return r.findFollows(ctx, "followee_id", "follower_id", "Follower", userID, page, pageSize)
+}
+
+// FindFollowing finds the users a user follows, most recent first
+func (r *followRepository) FindFollowing(ctx context.Context, userID uuid.UUID, page, pageSize int) ([]models.UserFollow, int64, error) {
+	return r.findFollows(ctx, "follower_id", "followee_id", "Followee", userID, page, pageSize)
+}
+
+// findFollows lists the follows where column is userID, preloading the user
+// on the other side. Follows of deleted users are left out.
+func (r *followRepository) findFollows(ctx context.Context, column, otherColumn, relation string, userID uuid.UUID, page, pageSize int) ([]models.UserFollow, int64, error) {
+	var follows []models.UserFollow
+	var total int64
+
+	scope := func(db *gorm.DB) *gorm.DB {
+		return db.
+			Joins("JOIN users ON users.id = user_follows."+otherColumn+" AND users.deleted_at IS NULL").
+			Where("user_follows."+column+" = ?", userID)
+	}
+
+	err := r.DB.WithContext(ctx).Model(&models.UserFollow{}).Scopes(scope).Count(&total).Error
+	if err != nil {
+		return nil, 0, err
+	}
+
+	offset := (page - 1) * pageSize
+	err = r.DB.WithContext(ctx).
+		Preload(relation).
+		Scopes(scope).
+		Order("user_follows.created_at DESC").
+		Offset(offset).Limit(pageSize).
+		Find(&follows).Error
+
+	return follows, total, err
+}
+
+// FindFeed finds the latest published posts by the users a user follows,
+// starting after the before cursor. Mature posts are left out unless
+// includeMature is set.
+func (r *followRepository) FindFeed(ctx context.Context, userID uuid.UUID, includeMature bool, before *FeedCursor, limit int) ([]models.Post, error) {
+	var posts []models.Post
+
+	query := r.DB.WithContext(ctx).
+		Preload("User").
+		Preload("Tags").
+		Scopes(matureFilter(includeMature), visibleTo(ctx)).
+		Where("status = ?", models.PostStatusPublished).
+		Where("user_id IN (?)", r.DB.Model(&models.UserFollow{}).Select("followee_id").Where("follower_id = ?", userID))
+	if before != nil {
+		query = query.Where("created_at < ? OR (created_at = ? AND id < ?)", before.CreatedAt, before.CreatedAt, before.ID)
+	}
+
+	err := query.
+		Order("created_at DESC, id DESC").
+		Limit(limit).
+		Find(&posts).Error
+
+	return posts, err
+}
@@ -0,0 +1,82 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/yourusername/go-enterprise-api/internal/models"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// IdempotencyRepository interface defines idempotency key repository methods
+type IdempotencyRepository interface {
+	Acquire(ctx context.Context, key *models.IdempotencyKey, abandonedBefore time.Time) (bool, error)
+	Find(ctx context.Context, scope, key string) (*models.IdempotencyKey, error)
+	Complete(ctx context.Context, key *models.IdempotencyKey) error
+	Delete(ctx context.Context, scope, key string) error
+	DeleteExpired(ctx context.Context, now time.Time) (int64, error)
+}
+
+// idempotencyRepository implements IdempotencyRepository
+type idempotencyRepository struct {
+	DB *gorm.DB
+}
+
+// NewIdempotencyRepository creates a new idempotency key repository
+func NewIdempotencyRepository(db *gorm.DB) IdempotencyRepository {
+	return &idempotencyRepository{DB: db}
+}
+
+// Acquire stores a key that is not in use, marking its request as being
+// processed, and reports whether it did. Expired keys, and keys whose
+// request started before abandonedBefore without completing, are replaced.
+func (r *idempotencyRepository) Acquire(ctx context.Context, key *models.IdempotencyKey, abandonedBefore time.Time) (bool, error) {
+	err := r.DB.WithContext(ctx).
+		Where("scope = ? AND idempotency_key = ?", key.Scope, key.Key).
+		Where("expires_at < ? OR (status_code = 0 AND created_at < ?)", key.CreatedAt, abandonedBefore).
+		Delete(&models.IdempotencyKey{}).Error
+	if err != nil {
+		return false, err
+	}
+
+	result := r.DB.WithContext(ctx).Clauses(clause.OnConflict{DoNothing: true}).Create(key)
+	return result.RowsAffected > 0, result.Error
+}
+
+// Find finds a key, returning nil when it is not in use
+func (r *idempotencyRepository) Find(ctx context.Context, scope, key string) (*models.IdempotencyKey, error) {
+	var record models.IdempotencyKey
+	err := r.DB.WithContext(ctx).First(&record, "scope = ? AND idempotency_key = ?", scope, key).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &record, nil
+}
+
+// Complete stores the request hash and response of a key
+func (r *idempotencyRepository) Complete(ctx context.Context, key *models.IdempotencyKey) error {
+	return r.DB.WithContext(ctx).Model(&models.IdempotencyKey{}).
+		Where("scope = ? AND idempotency_key = ?", key.Scope, key.Key).
+		Updates(map[string]interface{}{
+			"request_hash": key.RequestHash,
+			"status_code":  key.StatusCode,
+			"content_type": key.ContentType,
+			"body":         key.Body,
+		}).Error
+}
+
+// Delete releases a key
+func (r *idempotencyRepository) Delete(ctx context.Context, scope, key string) error {
+	return r.DB.WithContext(ctx).Where("scope = ? AND idempotency_key = ?", scope, key).Delete(&models.IdempotencyKey{}).Error
+}
+
+// DeleteExpired deletes the keys that expired before now
+func (r *idempotencyRepository) DeleteExpired(ctx context.Context, now time.Time) (int64, error) {
+	result := r.DB.WithContext(ctx).Where("expires_at < ?", now).Delete(&models.IdempotencyKey{})
+	return result.RowsAffected, result.Error
+}
@@ -0,0 +1,189 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/yourusername/go-enterprise-api/internal/models"
+	apperrors "github.com/yourusername/go-enterprise-api/pkg/errors"
+	"gorm.io/gorm"
+)
+
+// JobRepository interface defines background job repository methods
+type JobRepository interface {
+	Repository[models.Job]
+	CreateUnique(ctx context.Context, job *models.Job) (bool, error)
+	FindDue(ctx context.Context, now time.Time, limit int) ([]models.Job, error)
+	Claim(ctx context.Context, job *models.Job, now, lockedUntil time.Time) (bool, error)
+	Finish(ctx context.Context, job *models.Job) error
+	FindJobs(ctx context.Context, status models.JobStatus, jobType string, page, pageSize int) ([]models.Job, int64, error)
+	CountByStatus(ctx context.Context) (*models.JobStats, error)
+	DeleteFinishedBefore(ctx context.Context, before time.Time) (int64, error)
+}
+
+// jobRepository implements JobRepository
+type jobRepository struct {
+	*BaseRepository[models.Job]
+}
+
+// NewJobRepository creates a new job repository
+func NewJobRepository(db *gorm.DB) JobRepository {
+	return &jobRepository{
+		BaseRepository: NewBaseRepository[models.Job](db),
+	}
+}
+
+// FindByID overrides base to report missing jobs
+func (r *jobRepository) FindByID(ctx context.Context, id uuid.UUID) (*models.Job, error) {
+	var job models.Job
+	err := r.DB.WithContext(ctx).First(&job, "id = ?", id).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, apperrors.ErrNotFound.WithDetails("Job not found")
+		}
+		return nil, err
+	}
+	return &job, nil
+}
+
+// CreateUnique creates a job unless a pending job with the same unique key
+// exists, and reports whether it was created
+func (r *jobRepository) CreateUnique(ctx context.Context, job *models.Job) (bool, error) {
+	created := false
+	err := transaction(ctx, r.DB, func(tx *gorm.DB) error {
+		var count int64
+		err := tx.Model(&models.Job{}).
+			Where("unique_key = ? AND status = ?", job.UniqueKey, models.JobPending).
+			Count(&count).Error
+		if err != nil || count > 0 {
+			return err
+		}
+
+		if err := tx.Create(job).Error; err != nil {
+			return err
+		}
+		created = true
+		return nil
+	})
+	return created, err
+}
+
+// FindDue finds jobs ready to run: pending jobs whose run time has passed
+// and running jobs whose lease has expired, oldest first
+func (r *jobRepository) FindDue(ctx context.Context, now time.Time, limit int) ([]models.Job, error) {
+	var jobs []models.Job
+	err := r.DB.WithContext(ctx).
+		Where("(status = ? AND run_at <= ?) OR (status = ? AND locked_until < ?)",
+			models.JobPending, now, models.JobRunning, now).
+		Order("run_at ASC").
+		Limit(limit).
+		Find(&jobs).Error
+	return jobs, err
+}
+
+// Claim marks a due job as running until lockedUntil and reports whether it
+// was claimed. A job claimed by another worker in the meantime is left
+// alone. On success, job reflects the claimed state.
+func (r *jobRepository) Claim(ctx context.Context, job *models.Job, now, lockedUntil time.Time) (bool, error) {
+	result := r.DB.WithContext(ctx).Model(&models.Job{}).
+		Where("id = ? AND attempts = ?", job.ID, job.Attempts).
+		Where("(status = ? AND run_at <= ?) OR (status = ? AND locked_until < ?)",
+			models.JobPending, now, models.JobRunning, now).
+		Updates(map[string]interface{}{
+			"status":       models.JobRunning,
+			"attempts":     gorm.Expr("attempts + 1"),
+			"locked_until": lockedUntil,
+			"started_at":   now,
+			"updated_at":   now,
+		})
+	if result.Error != nil {
+		return false, result.Error
+	}
+	if result.RowsAffected == 0 {
+		return false, nil
+	}
+
+	job.Status = models.JobRunning
+	job.Attempts++
+	job.LockedUntil = &lockedUntil
+	job.StartedAt = &now
+	return true, nil
+}
+
+// Finish records the outcome of a job's run. It is ignored if the job has
+// been claimed again since, e.g. after its lease expired.
+func (r *jobRepository) Finish(ctx context.Context, job *models.Job) error {
+	return r.DB.WithContext(ctx).Model(&models.Job{}).
+		Where("id = ? AND attempts = ?", job.ID, job.Attempts).
+		Updates(map[string]interface{}{
+			"status":       job.Status,
+			"run_at":       job.RunAt,
+			"locked_until": job.LockedUntil,
+			"finished_at":  job.FinishedAt,
+			"last_error":   job.LastError,
+			"updated_at":   time.Now().UTC(),
+		}).Error
+}
+
+// FindJobs finds jobs, newest first, optionally filtered by status and type
+func (r *jobRepository) FindJobs(ctx context.Context, status models.JobStatus, jobType string, page, pageSize int) ([]models.Job, int64, error) {
+	var jobs []models.Job
+	var total int64
+
+	query := r.DB.WithContext(ctx).Model(&models.Job{})
+	if status != "" {
+		query = query.Where("status = ?", status)
+	}
+	if jobType != "" {
+		query = query.Where("type = ?", jobType)
+	}
+
+	if err := query.Count(&total).Error; err != nil {
+		return nil, 0, err
+	}
+
+	offset := (page - 1) * pageSize
+	err := query.Order("created_at DESC").Offset(offset).Limit(pageSize).Find(&jobs).Error
+	return jobs, total, err
+}
+
+// CountByStatus counts jobs in each status
+func (r *jobRepository) CountByStatus(ctx context.Context) (*models.JobStats, error) {
+	var rows []struct {
+		Status models.JobStatus
+		Count  int64
+	}
+	err := r.DB.WithContext(ctx).Model(&models.Job{}).
+		Select("status, COUNT(*) AS count").
+		Group("status").
+		Scan(&rows).Error
+	if err != nil {
+		return nil, err
+	}
+
+	stats := &models.JobStats{}
+	for _, row := range rows {
+		switch row.Status {
+		case models.JobPending:
+			stats.Pending = row.Count
+		case models.JobRunning:
+			stats.Running = row.Count
+		case models.JobSucceeded:
+			stats.Succeeded = row.Count
+		case models.JobFailed:
+			stats.Failed = row.Count
+		}
+	}
+	return stats, nil
+}
+
+// DeleteFinishedBefore permanently deletes jobs that finished before the
+// given time and returns how many were deleted
+func (r *jobRepository) DeleteFinishedBefore(ctx context.Context, before time.Time) (int64, error) {
+	result := r.DB.WithContext(ctx).Unscoped().
+		Where("status IN ? AND finished_at < ?", []models.JobStatus{models.JobSucceeded, models.JobFailed}, before).
+		Delete(&models.Job{})
+	return result.RowsAffected, result.Error
+}
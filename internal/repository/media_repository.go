@@ -0,0 +1,57 @@
+package repository
+
+import (
+	"context"
+	"errors"
+
+	"github.com/google/uuid"
+	"github.com/yourusername/go-enterprise-api/internal/models"
+	apperrors "github.com/yourusername/go-enterprise-api/pkg/errors"
+	"gorm.io/gorm"
+)
+
+// MediaRepository interface defines media library repository methods
+type MediaRepository interface {
+	Repository[models.Media]
+	FindByUser(ctx context.Context, userID uuid.UUID, page, pageSize int) ([]models.Media, int64, error)
+}
+
+// mediaRepository implements MediaRepository
+type mediaRepository struct {
+	*BaseRepository[models.Media]
+}
+
+// NewMediaRepository creates a new media repository
+func NewMediaRepository(db *gorm.DB) MediaRepository {
+	return &mediaRepository{
+		BaseRepository: NewBaseRepository[models.Media](db),
+	}
+}
+
+// FindByID overrides base to report missing media
+func (r *mediaRepository) FindByID(ctx context.Context, id uuid.UUID) (*models.Media, error) {
+	var media models.Media
+	err := r.DB.WithContext(ctx).First(&media, "id = ?", id).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, apperrors.ErrNotFound.WithDetails("Media not found")
+		}
+		return nil, err
+	}
+	return &media, nil
+}
+
+// FindByUser finds a user's media, newest first
+func (r *mediaRepository) FindByUser(ctx context.Context, userID uuid.UUID, page, pageSize int) ([]models.Media, int64, error) {
+	var media []models.Media
+	var total int64
+
+	query := r.DB.WithContext(ctx).Model(&models.Media{}).Where("user_id = ?", userID)
+	if err := query.Count(&total).Error; err != nil {
+		return nil, 0, err
+	}
+
+	offset := (page - 1) * pageSize
+	err := query.Order("created_at DESC").Offset(offset).Limit(pageSize).Find(&media).Error
+	return media, total, err
+}
@@ -0,0 +1,89 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/yourusername/go-enterprise-api/internal/models"
+	apperrors "github.com/yourusername/go-enterprise-api/pkg/errors"
+	"gorm.io/gorm"
+)
+
+// MediaUploadRepository interface defines chunked media upload repository
+// methods
+type MediaUploadRepository interface {
+	Create(ctx context.Context, upload *models.MediaUpload) error
+	FindByID(ctx context.Context, id uuid.UUID) (*models.MediaUpload, error)
+	CountPending(ctx context.Context, userID uuid.UUID, now time.Time) (int64, error)
+	Append(ctx context.Context, upload *models.MediaUpload, from int64) (bool, error)
+	Delete(ctx context.Context, id uuid.UUID) (bool, error)
+	FindExpired(ctx context.Context, now time.Time, limit int) ([]models.MediaUpload, error)
+}
+
+// mediaUploadRepository implements MediaUploadRepository
+type mediaUploadRepository struct {
+	DB *gorm.DB
+}
+
+// NewMediaUploadRepository creates a new chunked media upload repository
+func NewMediaUploadRepository(db *gorm.DB) MediaUploadRepository {
+	return &mediaUploadRepository{DB: db}
+}
+
+// Create creates an upload
+func (r *mediaUploadRepository) Create(ctx context.Context, upload *models.MediaUpload) error {
+	return r.DB.WithContext(ctx).Create(upload).Error
+}
+
+// FindByID finds an upload
+func (r *mediaUploadRepository) FindByID(ctx context.Context, id uuid.UUID) (*models.MediaUpload, error) {
+	var upload models.MediaUpload
+	err := r.DB.WithContext(ctx).First(&upload, "id = ?", id).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, apperrors.ErrNotFound.WithDetails("Upload not found")
+		}
+		return nil, err
+	}
+	return &upload, nil
+}
+
+// CountPending counts a user's uploads that have not expired
+func (r *mediaUploadRepository) CountPending(ctx context.Context, userID uuid.UUID, now time.Time) (int64, error) {
+	var count int64
+	err := r.DB.WithContext(ctx).Model(&models.MediaUpload{}).
+		Where("user_id = ? AND expires_at >= ?", userID, now).
+		Count(&count).Error
+	return count, err
+}
+
+// Append saves the chunks of an upload that received a chunk at from, and
+// reports whether it did. It does not when another chunk was appended at
+// from first.
+func (r *mediaUploadRepository) Append(ctx context.Context, upload *models.MediaUpload, from int64) (bool, error) {
+	result := r.DB.WithContext(ctx).Model(&models.MediaUpload{}).
+		Where("id = ? AND received = ?", upload.ID, from).
+		Updates(map[string]interface{}{
+			"received":   upload.Received,
+			"chunks":     upload.Chunks,
+			"expires_at": upload.ExpiresAt,
+			"updated_at": time.Now().UTC(),
+		})
+	return result.RowsAffected > 0, result.Error
+}
+
+// Delete deletes an upload and reports whether it did, so that only one of
+// several requests finishing the same upload goes on
+func (r *mediaUploadRepository) Delete(ctx context.Context, id uuid.UUID) (bool, error) {
+	result := r.DB.WithContext(ctx).Where("id = ?", id).Delete(&models.MediaUpload{})
+	return result.RowsAffected > 0, result.Error
+}
+
+// FindExpired finds up to limit uploads that expired before now
+func (r *mediaUploadRepository) FindExpired(ctx context.Context, now time.Time, limit int) ([]models.MediaUpload, error) {
+	var uploads []models.MediaUpload
+	err := r.DB.WithContext(ctx).Where("expires_at < ?", now).Order("expires_at").Limit(limit).Find(&uploads).Error
+	return uploads, err
+}
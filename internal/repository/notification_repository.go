@@ -0,0 +1,107 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/yourusername/go-enterprise-api/internal/models"
+	apperrors "github.com/yourusername/go-enterprise-api/pkg/errors"
+	"gorm.io/gorm"
+)
+
+// NotificationRepository interface defines notification-specific repository methods
+type NotificationRepository interface {
+	Repository[models.Notification]
+	FindForDelivery(ctx context.Context, id uuid.UUID) (*models.Notification, error)
+	FindByUser(ctx context.Context, userID uuid.UUID, unreadOnly bool, page, pageSize int) ([]models.Notification, int64, error)
+	CountUnread(ctx context.Context, userID uuid.UUID) (int64, error)
+	MarkRead(ctx context.Context, id, userID uuid.UUID) error
+	MarkAllRead(ctx context.Context, userID uuid.UUID) (int64, error)
+}
+
+// notificationRepository implements NotificationRepository
+type notificationRepository struct {
+	*BaseRepository[models.Notification]
+}
+
+// NewNotificationRepository creates a new notification repository
+func NewNotificationRepository(db *gorm.DB) NotificationRepository {
+	return &notificationRepository{
+		BaseRepository: NewBaseRepository[models.Notification](db),
+	}
+}
+
+// FindForDelivery finds a notification along with its recipient, actor,
+// post and comment
+func (r *notificationRepository) FindForDelivery(ctx context.Context, id uuid.UUID) (*models.Notification, error) {
+	var notification models.Notification
+	err := r.DB.WithContext(ctx).
+		Preload("User").Preload("Actor").Preload("Post").Preload("Comment").
+		First(&notification, "id = ?", id).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, apperrors.ErrNotFound
+		}
+		return nil, err
+	}
+	return &notification, nil
+}
+
+// FindByUser finds a user's notifications, newest first
+func (r *notificationRepository) FindByUser(ctx context.Context, userID uuid.UUID, unreadOnly bool, page, pageSize int) ([]models.Notification, int64, error) {
+	var notifications []models.Notification
+	var total int64
+
+	query := r.DB.WithContext(ctx).Model(&models.Notification{}).Where("user_id = ?", userID)
+	if unreadOnly {
+		query = query.Where("read_at IS NULL")
+	}
+	if err := query.Count(&total).Error; err != nil {
+		return nil, 0, err
+	}
+
+	offset := (page - 1) * pageSize
+	err := query.Preload("Actor").Preload("Post").
+		Offset(offset).Limit(pageSize).
+		Order("created_at DESC").
+		Find(&notifications).Error
+	return notifications, total, err
+}
+
+// CountUnread counts a user's unread notifications
+func (r *notificationRepository) CountUnread(ctx context.Context, userID uuid.UUID) (int64, error) {
+	var total int64
+	err := r.DB.WithContext(ctx).Model(&models.Notification{}).
+		Where("user_id = ? AND read_at IS NULL", userID).
+		Count(&total).Error
+	return total, err
+}
+
+// MarkRead marks one of a user's notifications as read. Notifications of
+// other users are reported as not found.
+func (r *notificationRepository) MarkRead(ctx context.Context, id, userID uuid.UUID) error {
+	var notification models.Notification
+	err := r.DB.WithContext(ctx).Where("id = ? AND user_id = ?", id, userID).First(&notification).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return apperrors.ErrNotFound.WithDetails("Notification not found")
+		}
+		return err
+	}
+	if notification.IsRead() {
+		return nil
+	}
+
+	return r.DB.WithContext(ctx).Model(&notification).UpdateColumn("read_at", time.Now().UTC()).Error
+}
+
+// MarkAllRead marks all of a user's notifications as read and returns how
+// many were unread
+func (r *notificationRepository) MarkAllRead(ctx context.Context, userID uuid.UUID) (int64, error) {
+	result := r.DB.WithContext(ctx).Model(&models.Notification{}).
+		Where("user_id = ? AND read_at IS NULL", userID).
+		UpdateColumn("read_at", time.Now().UTC())
+	return result.RowsAffected, result.Error
+}
@@ -0,0 +1,138 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/yourusername/go-enterprise-api/internal/models"
+	apperrors "github.com/yourusername/go-enterprise-api/pkg/errors"
+	"gorm.io/gorm"
+)
+
+// OperationRepository interface defines admin operation repository methods
+type OperationRepository interface {
+	Repository[models.AdminOperation]
+	Snapshot(ctx context.Context, table string, ids []uuid.UUID, columns []string) ([]models.SnapshotRow, error)
+	Restore(ctx context.Context, table string, rows []models.SnapshotRow) error
+	MarkUndone(ctx context.Context, id, actorID uuid.UUID) error
+	FindAllRecent(ctx context.Context, page, pageSize int) ([]models.AdminOperation, int64, error)
+	InTransaction(ctx context.Context, fn func(repos OperationRepos) error) error
+}
+
+// OperationRepos are the repositories a bulk operation writes through, bound
+// to the transaction it runs in
+type OperationRepos struct {
+	Operations OperationRepository
+	Users      UserRepository
+	Changes    ChangeRepository
+}
+
+// operationRepository implements OperationRepository
+type operationRepository struct {
+	*BaseRepository[models.AdminOperation]
+}
+
+// NewOperationRepository creates a new admin operation repository
+func NewOperationRepository(db *gorm.DB) OperationRepository {
+	return &operationRepository{
+		BaseRepository: NewBaseRepository[models.AdminOperation](db),
+	}
+}
+
+// Snapshot reads the current values of the given columns for the given rows,
+// including soft-deleted rows
+func (r *operationRepository) Snapshot(ctx context.Context, table string, ids []uuid.UUID, columns []string) ([]models.SnapshotRow, error) {
+	var rows []map[string]interface{}
+	err := r.DB.WithContext(ctx).
+		Table(table).
+		Select(append([]string{"id"}, columns...)).
+		Where("id IN ?", ids).
+		Find(&rows).Error
+	if err != nil {
+		return nil, err
+	}
+
+	snapshot := make([]models.SnapshotRow, len(rows))
+	for i, row := range rows {
+		snapshot[i] = models.SnapshotRow(row)
+	}
+	return snapshot, nil
+}
+
+// Restore writes snapshotted column values back in a single transaction
+func (r *operationRepository) Restore(ctx context.Context, table string, rows []models.SnapshotRow) error {
+	return transaction(ctx, r.DB, func(tx *gorm.DB) error {
+		for _, row := range rows {
+			id, ok := row["id"]
+			if !ok {
+				continue
+			}
+
+			values := make(map[string]interface{}, len(row)-1)
+			for column, value := range row {
+				if column != "id" {
+					values[column] = value
+				}
+			}
+
+			if err := tx.Table(table).Where("id = ?", id).Updates(values).Error; err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
+// InTransaction runs fn in a transaction with repositories bound to it, so
+// an operation, the rows it changes and their audit trail are written
+// together or not at all
+func (r *operationRepository) InTransaction(ctx context.Context, fn func(repos OperationRepos) error) error {
+	return transaction(ctx, r.DB, func(tx *gorm.DB) error {
+		return fn(OperationRepos{
+			Operations: NewOperationRepository(tx),
+			Users:      NewUserRepository(tx),
+			Changes:    NewChangeRepository(tx),
+		})
+	})
+}
+
+// MarkUndone marks an operation as undone
+func (r *operationRepository) MarkUndone(ctx context.Context, id, actorID uuid.UUID) error {
+	return r.DB.WithContext(ctx).Model(&models.AdminOperation{}).Where("id = ?", id).Updates(map[string]interface{}{
+		"undone_at": time.Now().UTC(),
+		"undone_by": actorID,
+	}).Error
+}
+
+// FindAllRecent finds operations, newest first
+func (r *operationRepository) FindAllRecent(ctx context.Context, page, pageSize int) ([]models.AdminOperation, int64, error) {
+	var operations []models.AdminOperation
+	var total int64
+
+	if err := r.DB.WithContext(ctx).Model(&models.AdminOperation{}).Count(&total).Error; err != nil {
+		return nil, 0, err
+	}
+
+	offset := (page - 1) * pageSize
+	err := r.DB.WithContext(ctx).
+		Order("created_at DESC").
+		Offset(offset).Limit(pageSize).
+		Find(&operations).Error
+
+	return operations, total, err
+}
+
+// FindByID overrides base to include error handling
+func (r *operationRepository) FindByID(ctx context.Context, id uuid.UUID) (*models.AdminOperation, error) {
+	var operation models.AdminOperation
+	err := r.DB.WithContext(ctx).First(&operation, "id = ?", id).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, apperrors.ErrNotFound.WithDetails("Operation not found")
+		}
+		return nil, err
+	}
+	return &operation, nil
+}
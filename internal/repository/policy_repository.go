@@ -0,0 +1,111 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/yourusername/go-enterprise-api/internal/models"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// PolicyRepository interface defines policy version and acceptance
+// repository methods
+type PolicyRepository interface {
+	Repository[models.PolicyVersion]
+	FindCurrent(ctx context.Context) ([]models.PolicyVersion, error)
+	FindPending(ctx context.Context, userID uuid.UUID) ([]models.PolicyVersion, error)
+	FindByKind(ctx context.Context, kind models.PolicyKind, page, pageSize int) ([]models.PolicyVersion, int64, error)
+	ExistsVersion(ctx context.Context, kind models.PolicyKind, version string) (bool, error)
+	Accept(ctx context.Context, userID uuid.UUID, versionIDs []uuid.UUID, at time.Time) error
+}
+
+// policyRepository implements PolicyRepository
+type policyRepository struct {
+	*BaseRepository[models.PolicyVersion]
+}
+
+// NewPolicyRepository creates a new policy repository
+func NewPolicyRepository(db *gorm.DB) PolicyRepository {
+	return &policyRepository{
+		BaseRepository: NewBaseRepository[models.PolicyVersion](db),
+	}
+}
+
+// current limits a query to the latest published version of each kind
+func (r *policyRepository) current(db *gorm.DB) *gorm.DB {
+	latest := r.DB.Table("policy_versions AS latest").
+		Select("MAX(latest.published_at)").
+		Where("latest.kind = policy_versions.kind AND latest.deleted_at IS NULL")
+	return db.Where("policy_versions.published_at = (?)", latest)
+}
+
+// FindCurrent finds the current version of each kind of policy
+func (r *policyRepository) FindCurrent(ctx context.Context) ([]models.PolicyVersion, error) {
+	var versions []models.PolicyVersion
+	err := r.DB.WithContext(ctx).Scopes(r.current).Order("kind ASC").Find(&versions).Error
+	return versions, err
+}
+
+// FindPending finds the current policy versions a user has not accepted
+func (r *policyRepository) FindPending(ctx context.Context, userID uuid.UUID) ([]models.PolicyVersion, error) {
+	accepted := r.DB.Model(&models.PolicyAcceptance{}).
+		Select("1").
+		Where("policy_acceptances.policy_version_id = policy_versions.id AND policy_acceptances.user_id = ?", userID)
+
+	var versions []models.PolicyVersion
+	err := r.DB.WithContext(ctx).Scopes(r.current).
+		Where("NOT EXISTS (?)", accepted).
+		Order("kind ASC").
+		Find(&versions).Error
+	return versions, err
+}
+
+// FindByKind finds the versions of a kind of policy, or of every kind when
+// kind is empty, latest first
+func (r *policyRepository) FindByKind(ctx context.Context, kind models.PolicyKind, page, pageSize int) ([]models.PolicyVersion, int64, error) {
+	var versions []models.PolicyVersion
+	var total int64
+
+	query := r.DB.WithContext(ctx).Model(&models.PolicyVersion{})
+	if kind != "" {
+		query = query.Where("kind = ?", kind)
+	}
+	if err := query.Count(&total).Error; err != nil {
+		return nil, 0, err
+	}
+
+	offset := (page - 1) * pageSize
+	err := query.Order("published_at DESC").Offset(offset).Limit(pageSize).Find(&versions).Error
+	return versions, total, err
+}
+
+// ExistsVersion checks if a version of a kind of policy has been published
+func (r *policyRepository) ExistsVersion(ctx context.Context, kind models.PolicyKind, version string) (bool, error) {
+	var count int64
+	err := r.DB.WithContext(ctx).Model(&models.PolicyVersion{}).
+		Where("kind = ? AND version = ?", kind, version).
+		Count(&count).Error
+	return count > 0, err
+}
+
+// Accept records that a user accepted policy versions. Versions they
+// already accepted keep their original acceptance time.
+func (r *policyRepository) Accept(ctx context.Context, userID uuid.UUID, versionIDs []uuid.UUID, at time.Time) error {
+	if len(versionIDs) == 0 {
+		return nil
+	}
+
+	acceptances := make([]models.PolicyAcceptance, len(versionIDs))
+	for i, id := range versionIDs {
+		acceptances[i] = models.PolicyAcceptance{
+			UserID:          userID,
+			PolicyVersionID: id,
+			AcceptedAt:      at,
+		}
+	}
+	return r.DB.WithContext(ctx).
+		Clauses(clause.OnConflict{DoNothing: true}).
+		Create(&acceptances).Error
+}
@@ -3,6 +3,8 @@ package repository
 import (
 	"context"
 	"errors"
+	"strconv"
+	"strings"
 
 	"github.com/google/uuid"
 	"github.com/yourusername/go-enterprise-api/internal/database"
@@ -15,16 +17,34 @@ import (
 type PostRepository interface {
 	Repository[models.Post]
 	FindBySlug(ctx context.Context, slug string) (*models.Post, error)
+	AvailableSlug(ctx context.Context, base string, excludeID uuid.UUID) (string, error)
+	CreateWithSlug(ctx context.Context, post *models.Post, base string) error
+	UpdateWithSlug(ctx context.Context, post *models.Post, base string) error
 	FindByUserID(ctx context.Context, userID uuid.UUID, page, pageSize int) ([]models.Post, int64, error)
-	FindPublished(ctx context.Context, page, pageSize int) ([]models.Post, int64, error)
+	FindMissingAltText(ctx context.Context, userID uuid.UUID, page, pageSize int) ([]models.Post, int64, error)
+	FindPublished(ctx context.Context, includeMature bool, page, pageSize int) ([]models.Post, int64, error)
+	FindFeatured(ctx context.Context, includeMature bool, page, pageSize int) ([]models.Post, int64, error)
 	FindByStatus(ctx context.Context, status models.PostStatus, page, pageSize int) ([]models.Post, int64, error)
-	IncrementViewCount(ctx context.Context, postID uuid.UUID) error
 	FindWithAuthor(ctx context.Context, id uuid.UUID) (*models.Post, error)
 	FindAllWithAuthor(ctx context.Context, page, pageSize int) ([]models.Post, int64, error)
-	SearchPosts(ctx context.Context, query string, page, pageSize int) ([]models.Post, int64, error)
+	SearchPosts(ctx context.Context, query string, tagID *uuid.UUID, includeMature bool, page, pageSize int) ([]models.Post, int64, error)
 	AddTag(ctx context.Context, postID, tagID uuid.UUID) error
 	RemoveTag(ctx context.Context, postID, tagID uuid.UUID) error
 	FindByTag(ctx context.Context, tagSlug string, page, pageSize int) ([]models.Post, int64, error)
+	FindByTagID(ctx context.Context, tagID uuid.UUID, publishedOnly, includeMature bool, page, pageSize int) ([]models.Post, int64, error)
+	ReplaceTags(ctx context.Context, postID uuid.UUID, tags []models.Tag) error
+	FindTagsByPostIDs(ctx context.Context, postIDs []uuid.UUID) (map[uuid.UUID][]models.Tag, error)
+	FindByIDsWithAuthor(ctx context.Context, ids []uuid.UUID) ([]models.Post, error)
+	FindAllInBatches(ctx context.Context, batchSize int, fn func(posts []models.Post) error) error
+	FindInBatchesWithAuthor(ctx context.Context, userID *uuid.UUID, batchSize int, fn func(posts []models.Post) error) error
+	FindPublicInBatches(ctx context.Context, batchSize int, fn func(posts []models.Post) error) error
+	FindByImportKey(ctx context.Context, key string) (*models.Post, error)
+	CountPublishedByUser(ctx context.Context, userID uuid.UUID, includeMature bool) (int64, error)
+	FindRecentPublishedByUser(ctx context.Context, userID uuid.UUID, includeMature bool, limit int) ([]models.Post, error)
+	FindProfilePins(ctx context.Context, userID uuid.UUID) ([]models.Post, error)
+	FindTakenDown(ctx context.Context, appealedOnly bool, page, pageSize int) ([]models.Post, int64, error)
+	SetProfilePins(ctx context.Context, userID uuid.UUID, postIDs []uuid.UUID) error
+	ReassignAuthor(ctx context.Context, fromUserID, toUserID uuid.UUID) (int64, error)
 }
 
 // postRepository implements PostRepository
@@ -52,12 +72,66 @@ func (r *postRepository) FindBySlug(ctx context.Context, slug string) (*models.P
 	return &post, nil
 }
 
+// AvailableSlug returns base if no other post uses it, otherwise base with the
+// lowest free numeric suffix (e.g. "my-post-2"). Soft-deleted posts count as
+// taken since they still hold the unique index.
+func (r *postRepository) AvailableSlug(ctx context.Context, base string, excludeID uuid.UUID) (string, error) {
+	return availableSlug(r.DB.WithContext(ctx), base, excludeID)
+}
+
+// CreateWithSlug creates a post under the first available slug derived from base
+func (r *postRepository) CreateWithSlug(ctx context.Context, post *models.Post, base string) error {
+	return transaction(ctx, r.DB, func(tx *gorm.DB) error {
+		slug, err := availableSlug(tx, base, uuid.Nil)
+		if err != nil {
+			return err
+		}
+		post.Slug = slug
+		return tx.Create(post).Error
+	})
+}
+
+// UpdateWithSlug saves a post under the first available slug derived from base
+func (r *postRepository) UpdateWithSlug(ctx context.Context, post *models.Post, base string) error {
+	return transaction(ctx, r.DB, func(tx *gorm.DB) error {
+		slug, err := availableSlug(tx, base, post.ID)
+		if err != nil {
+			return err
+		}
+		post.Slug = slug
+		return updateVersioned(tx, post, post)
+	})
+}
+
+// availableSlug finds the first slug derived from base not used by another
+// post. Slugs are unique across organizations.
+func availableSlug(db *gorm.DB, base string, excludeID uuid.UUID) (string, error) {
+	var slugs []string
+	err := allTenants(db).Unscoped().Model(&models.Post{}).
+		Where("(slug = ? OR slug LIKE ?) AND id <> ?", base, base+"-%", excludeID).
+		Pluck("slug", &slugs).Error
+	if err != nil {
+		return "", err
+	}
+
+	taken := make(map[string]bool, len(slugs))
+	for _, slug := range slugs {
+		taken[slug] = true
+	}
+
+	slug := base
+	for n := 2; taken[slug]; n++ {
+		slug = base + "-" + strconv.Itoa(n)
+	}
+	return slug, nil
+}
+
 // FindByUserID finds posts by user ID
 func (r *postRepository) FindByUserID(ctx context.Context, userID uuid.UUID, page, pageSize int) ([]models.Post, int64, error) {
 	var posts []models.Post
 	var total int64
 
-	err := r.DB.WithContext(ctx).Model(&models.Post{}).Where("user_id = ?", userID).Count(&total).Error
+	err := r.DB.WithContext(ctx).Model(&models.Post{}).Scopes(visibleTo(ctx)).Where("user_id = ?", userID).Count(&total).Error
 	if err != nil {
 		return nil, 0, err
 	}
@@ -65,6 +139,7 @@ func (r *postRepository) FindByUserID(ctx context.Context, userID uuid.UUID, pag
 	offset := (page - 1) * pageSize
 	err = r.DB.WithContext(ctx).
 		Preload("Tags").
+		Scopes(visibleTo(ctx)).
 		Where("user_id = ?", userID).
 		Order("created_at DESC").
 		Offset(offset).Limit(pageSize).
@@ -73,9 +148,171 @@ func (r *postRepository) FindByUserID(ctx context.Context, userID uuid.UUID, pag
 	return posts, total, err
 }
 
-// FindPublished finds all published posts
-func (r *postRepository) FindPublished(ctx context.Context, page, pageSize int) ([]models.Post, int64, error) {
-	return r.FindByStatus(ctx, models.PostStatusPublished, page, pageSize)
+// FindMissingAltText finds a user's posts with images that have no alt
+// text, most recently updated first
+func (r *postRepository) FindMissingAltText(ctx context.Context, userID uuid.UUID, page, pageSize int) ([]models.Post, int64, error) {
+	var posts []models.Post
+	var total int64
+
+	query := r.DB.WithContext(ctx).Model(&models.Post{}).Scopes(visibleTo(ctx)).Where("user_id = ? AND missing_alt_text > 0", userID)
+	if err := query.Count(&total).Error; err != nil {
+		return nil, 0, err
+	}
+
+	offset := (page - 1) * pageSize
+	err := r.DB.WithContext(ctx).
+		Scopes(visibleTo(ctx)).
+		Where("user_id = ? AND missing_alt_text > 0", userID).
+		Order("updated_at DESC").
+		Offset(offset).Limit(pageSize).
+		Find(&posts).Error
+
+	return posts, total, err
+}
+
+// FindPublished finds all published posts, pinned ones first, leaving out mature posts unless includeMature is set
+func (r *postRepository) FindPublished(ctx context.Context, includeMature bool, page, pageSize int) ([]models.Post, int64, error) {
+	var posts []models.Post
+	var total int64
+
+	err := r.DB.WithContext(ctx).Model(&models.Post{}).
+		Scopes(matureFilter(includeMature), visibleTo(ctx)).
+		Where("status = ?", models.PostStatusPublished).
+		Count(&total).Error
+	if err != nil {
+		return nil, 0, err
+	}
+
+	offset := (page - 1) * pageSize
+	err = r.DB.WithContext(ctx).
+		Preload("User").
+		Preload("Tags").
+		Scopes(matureFilter(includeMature), visibleTo(ctx)).
+		Where("status = ?", models.PostStatusPublished).
+		Order("is_pinned DESC, created_at DESC").
+		Offset(offset).Limit(pageSize).
+		Find(&posts).Error
+
+	return posts, total, err
+}
+
+// FindFeatured finds published featured posts, pinned ones first, leaving out mature posts unless includeMature is set
+func (r *postRepository) FindFeatured(ctx context.Context, includeMature bool, page, pageSize int) ([]models.Post, int64, error) {
+	var posts []models.Post
+	var total int64
+
+	err := r.DB.WithContext(ctx).Model(&models.Post{}).
+		Scopes(matureFilter(includeMature), visibleTo(ctx)).
+		Where("status = ? AND is_featured = ?", models.PostStatusPublished, true).
+		Count(&total).Error
+	if err != nil {
+		return nil, 0, err
+	}
+
+	offset := (page - 1) * pageSize
+	err = r.DB.WithContext(ctx).
+		Preload("User").
+		Preload("Tags").
+		Scopes(matureFilter(includeMature), visibleTo(ctx)).
+		Where("status = ? AND is_featured = ?", models.PostStatusPublished, true).
+		Order("is_pinned DESC, created_at DESC").
+		Offset(offset).Limit(pageSize).
+		Find(&posts).Error
+
+	return posts, total, err
+}
+
+// CountPublishedByUser counts a user's published posts, leaving out mature posts unless includeMature is set
+func (r *postRepository) CountPublishedByUser(ctx context.Context, userID uuid.UUID, includeMature bool) (int64, error) {
+	var total int64
+	err := r.DB.WithContext(ctx).Model(&models.Post{}).
+		Scopes(matureFilter(includeMature), visibleTo(ctx)).
+		Where("user_id = ? AND status = ?", userID, models.PostStatusPublished).
+		Count(&total).Error
+	return total, err
+}
+
+// FindRecentPublishedByUser finds a user's latest published posts, leaving out mature posts unless includeMature is set
+func (r *postRepository) FindRecentPublishedByUser(ctx context.Context, userID uuid.UUID, includeMature bool, limit int) ([]models.Post, error) {
+	var posts []models.Post
+	err := r.DB.WithContext(ctx).
+		Preload("Tags").
+		Scopes(matureFilter(includeMature), visibleTo(ctx)).
+		Where("user_id = ? AND status = ?", userID, models.PostStatusPublished).
+		Order("created_at DESC").
+		Limit(limit).
+		Find(&posts).Error
+	return posts, err
+}
+
+// FindProfilePins finds the posts pinned to a user's profile in pin order
+func (r *postRepository) FindProfilePins(ctx context.Context, userID uuid.UUID) ([]models.Post, error) {
+	var posts []models.Post
+	err := r.DB.WithContext(ctx).
+		Preload("Tags").
+		Scopes(visibleTo(ctx)).
+		Where("user_id = ? AND profile_pin IS NOT NULL", userID).
+		Order("profile_pin ASC").
+		Find(&posts).Error
+	return posts, err
+}
+
+// SetProfilePins replaces the posts pinned to a user's profile with postIDs,
+// in that order. Posts not owned by the user are ignored.
+func (r *postRepository) SetProfilePins(ctx context.Context, userID uuid.UUID, postIDs []uuid.UUID) error {
+	return transaction(ctx, r.DB, func(tx *gorm.DB) error {
+		if err := tx.Model(&models.Post{}).
+			Where("user_id = ? AND profile_pin IS NOT NULL", userID).
+			UpdateColumn("profile_pin", nil).Error; err != nil {
+			return err
+		}
+
+		for i, id := range postIDs {
+			if err := tx.Model(&models.Post{}).
+				Where("id = ? AND user_id = ?", id, userID).
+				UpdateColumn("profile_pin", i+1).Error; err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
+// ReassignAuthor makes toUserID the author of the posts of fromUserID. The
+// posts are unpinned from the profile of their former author.
+func (r *postRepository) ReassignAuthor(ctx context.Context, fromUserID, toUserID uuid.UUID) (int64, error) {
+	result := r.DB.WithContext(ctx).Model(&models.Post{}).
+		Where("user_id = ?", fromUserID).
+		UpdateColumns(map[string]interface{}{"user_id": toUserID, "profile_pin": nil})
+	return result.RowsAffected, result.Error
+}
+
+// matureFilter excludes mature posts from a query unless includeMature is set
+func matureFilter(includeMature bool) func(db *gorm.DB) *gorm.DB {
+	return func(db *gorm.DB) *gorm.DB {
+		if includeMature {
+			return db
+		}
+		return db.Where("mature = ?", false)
+	}
+}
+
+// FindTakenDown finds taken down posts, most recently taken down first
+func (r *postRepository) FindTakenDown(ctx context.Context, appealedOnly bool, page, pageSize int) ([]models.Post, int64, error) {
+	var posts []models.Post
+	var total int64
+
+	query := r.DB.WithContext(ctx).Model(&models.Post{}).Where("taken_down_at IS NOT NULL")
+	if appealedOnly {
+		query = query.Where("appealed_at IS NOT NULL")
+	}
+	if err := query.Count(&total).Error; err != nil {
+		return nil, 0, err
+	}
+
+	offset := (page - 1) * pageSize
+	err := query.Order("taken_down_at DESC").Offset(offset).Limit(pageSize).Find(&posts).Error
+	return posts, total, err
 }
 
 // FindByStatus finds posts by status
@@ -100,11 +337,6 @@ func (r *postRepository) FindByStatus(ctx context.Context, status models.PostSta
 	return posts, total, err
 }
 
-// IncrementViewCount increments the view count for a post
-func (r *postRepository) IncrementViewCount(ctx context.Context, postID uuid.UUID) error {
-	return r.DB.WithContext(ctx).Model(&models.Post{}).Where("id = ?", postID).UpdateColumn("view_count", gorm.Expr("view_count + ?", 1)).Error
-}
-
 // FindWithAuthor finds a post with its author
 func (r *postRepository) FindWithAuthor(ctx context.Context, id uuid.UUID) (*models.Post, error) {
 	var post models.Post
@@ -118,7 +350,7 @@ func (r *postRepository) FindWithAuthor(ctx context.Context, id uuid.UUID) (*mod
 	return &post, nil
 }
 
-// FindAllWithAuthor finds all posts with their authors
+// FindAllWithAuthor finds all posts with their authors, pinned ones first
 func (r *postRepository) FindAllWithAuthor(ctx context.Context, page, pageSize int) ([]models.Post, int64, error) {
 	var posts []models.Post
 	var total int64
@@ -132,16 +364,17 @@ func (r *postRepository) FindAllWithAuthor(ctx context.Context, page, pageSize i
 	err = r.DB.WithContext(ctx).
 		Preload("User").
 		Preload("Tags").
-		Order("created_at DESC").
+		Order("is_pinned DESC, created_at DESC").
 		Offset(offset).Limit(pageSize).
 		Find(&posts).Error
 
 	return posts, total, err
 }
 
-// SearchPosts searches for posts by title or content
+// SearchPosts searches for posts by title or content, leaving out mature posts unless includeMature is set.
+// When tagID is set, posts with that tag match as well.
 // Uses GORM Scopes instead of raw SQL LIKE queries
-func (r *postRepository) SearchPosts(ctx context.Context, query string, page, pageSize int) ([]models.Post, int64, error) {
+func (r *postRepository) SearchPosts(ctx context.Context, query string, tagID *uuid.UUID, includeMature bool, page, pageSize int) ([]models.Post, int64, error) {
 	var posts []models.Post
 	var total int64
 
@@ -149,7 +382,7 @@ func (r *postRepository) SearchPosts(ctx context.Context, query string, page, pa
 	searchFields := []string{"title", "content"}
 
 	err := r.DB.WithContext(ctx).Model(&models.Post{}).
-		Scopes(database.Search(searchFields, query)).
+		Scopes(r.search(searchFields, query, tagID), matureFilter(includeMature), visibleTo(ctx)).
 		Count(&total).Error
 	if err != nil {
 		return nil, 0, err
@@ -159,7 +392,7 @@ func (r *postRepository) SearchPosts(ctx context.Context, query string, page, pa
 	err = r.DB.WithContext(ctx).
 		Preload("User").
 		Preload("Tags").
-		Scopes(database.Search(searchFields, query)).
+		Scopes(r.search(searchFields, query, tagID), matureFilter(includeMature), visibleTo(ctx)).
 		Order("created_at DESC").
 		Offset(offset).Limit(pageSize).
 		Find(&posts).Error
@@ -167,6 +400,23 @@ func (r *postRepository) SearchPosts(ctx context.Context, query string, page, pa
 	return posts, total, err
 }
 
+// search matches query against fields and, when tagID is set, also matches posts with that tag
+func (r *postRepository) search(fields []string, query string, tagID *uuid.UUID) func(db *gorm.DB) *gorm.DB {
+	if tagID == nil || query == "" {
+		return database.Search(fields, query)
+	}
+	return func(db *gorm.DB) *gorm.DB {
+		conditions := make([]string, len(fields))
+		args := make([]interface{}, len(fields))
+		for i, field := range fields {
+			conditions[i] = field + " LIKE ?"
+			args[i] = "%" + query + "%"
+		}
+		tagged := r.DB.Table("post_tags").Select("post_id").Where("tag_id = ?", *tagID)
+		return db.Where(strings.Join(conditions, " OR ")+" OR id IN (?)", append(args, tagged)...)
+	}
+}
+
 // AddTag adds a tag to a post using GORM Association
 // No raw SQL needed - uses GORM's built-in many-to-many support
 func (r *postRepository) AddTag(ctx context.Context, postID, tagID uuid.UUID) error {
@@ -191,10 +441,9 @@ func (r *postRepository) RemoveTag(ctx context.Context, postID, tagID uuid.UUID)
 	return r.DB.WithContext(ctx).Model(post).Association("Tags").Delete(tag)
 }
 
-// FindByTag finds posts by tag slug using GORM Association
-// No raw SQL JOIN needed - uses GORM's relationship features
+// FindByTag finds posts by tag slug, newest first. A page of post IDs is
+// selected first and its posts are then loaded with their author and tags.
 func (r *postRepository) FindByTag(ctx context.Context, tagSlug string, page, pageSize int) ([]models.Post, int64, error) {
-	var posts []models.Post
 	var total int64
 
 	// First, find the tag by slug
@@ -207,36 +456,164 @@ func (r *postRepository) FindByTag(ctx context.Context, tagSlug string, page, pa
 		return nil, 0, err
 	}
 
-	// Count total posts with this tag using Association
-	total = r.DB.WithContext(ctx).Model(&tag).Association("Posts").Count()
+	tagged := func(db *gorm.DB) *gorm.DB {
+		return db.Model(&models.Post{}).
+			Where("id IN (?)", r.DB.Table("post_tags").Select("post_id").Where("tag_id = ?", tag.ID))
+	}
+	if err := r.DB.WithContext(ctx).Scopes(tagged).Count(&total).Error; err != nil {
+		return nil, 0, err
+	}
+
+	var ids []uuid.UUID
+	offset := (page - 1) * pageSize
+	err = r.DB.WithContext(ctx).
+		Scopes(tagged).
+		Order("created_at DESC").
+		Offset(offset).Limit(pageSize).
+		Pluck("id", &ids).Error
+	if err != nil {
+		return nil, 0, err
+	}
+
+	posts := []models.Post{}
+	err = findByIDs(r.DB.WithContext(ctx).Preload("User").Preload("Tags").Order("created_at DESC"), &posts, ids)
+	return posts, total, err
+}
+
+// FindByTagID finds posts with a tag, newest first. publishedOnly leaves out
+// unpublished posts and mature posts are left out unless includeMature is set.
+func (r *postRepository) FindByTagID(ctx context.Context, tagID uuid.UUID, publishedOnly, includeMature bool, page, pageSize int) ([]models.Post, int64, error) {
+	var posts []models.Post
+	var total int64
+
+	scope := func(db *gorm.DB) *gorm.DB {
+		db = db.Where("id IN (?)", r.DB.Table("post_tags").Select("post_id").Where("tag_id = ?", tagID))
+		if publishedOnly {
+			db = db.Where("status = ?", models.PostStatusPublished)
+		}
+		return db
+	}
+
+	err := r.DB.WithContext(ctx).Model(&models.Post{}).
+		Scopes(scope, matureFilter(includeMature), visibleTo(ctx)).
+		Count(&total).Error
+	if err != nil {
+		return nil, 0, err
+	}
 
-	// Get paginated posts using Association
 	offset := (page - 1) * pageSize
 	err = r.DB.WithContext(ctx).
-		Model(&tag).
 		Preload("User").
 		Preload("Tags").
+		Scopes(scope, matureFilter(includeMature), visibleTo(ctx)).
 		Order("created_at DESC").
 		Offset(offset).Limit(pageSize).
-		Association("Posts").
-		Find(&posts)
-
-	// If Association.Find doesn't support Preload, use alternative approach
-	if len(posts) > 0 {
-		// Reload posts with relationships
-		var postIDs []uuid.UUID
-		for _, p := range posts {
-			postIDs = append(postIDs, p.ID)
+		Find(&posts).Error
+
+	return posts, total, err
+}
+
+// ReplaceTags replaces all tags of a post
+func (r *postRepository) ReplaceTags(ctx context.Context, postID uuid.UUID, tags []models.Tag) error {
+	post := &models.Post{}
+	post.ID = postID
+
+	return r.DB.WithContext(ctx).Model(post).Association("Tags").Replace(tags)
+}
+
+// FindTagsByPostIDs finds the tags of several posts at once, by post ID
+// and ordered by name. Posts without tags are left out.
+func (r *postRepository) FindTagsByPostIDs(ctx context.Context, postIDs []uuid.UUID) (map[uuid.UUID][]models.Tag, error) {
+	tags := make(map[uuid.UUID][]models.Tag, len(postIDs))
+	if len(postIDs) == 0 {
+		return tags, nil
+	}
+
+	var rows []struct {
+		PostID uuid.UUID
+		models.Tag
+	}
+	err := r.DB.WithContext(ctx).
+		Model(&models.Tag{}).
+		Select("post_tags.post_id, tags.*").
+		Joins("JOIN post_tags ON post_tags.tag_id = tags.id").
+		Where("post_tags.post_id IN ?", postIDs).
+		Order("tags.name").
+		Scan(&rows).Error
+	if err != nil {
+		return nil, err
+	}
+
+	for _, row := range rows {
+		tags[row.PostID] = append(tags[row.PostID], row.Tag)
+	}
+	return tags, nil
+}
+
+// FindByIDsWithAuthor finds posts by IDs, preserving the order of the given
+// IDs. Posts the viewer may not see are left out.
+func (r *postRepository) FindByIDsWithAuthor(ctx context.Context, ids []uuid.UUID) ([]models.Post, error) {
+	if len(ids) == 0 {
+		return []models.Post{}, nil
+	}
+
+	var found []models.Post
+	err := findByIDs(r.DB.WithContext(ctx).Preload("User").Preload("Tags").Scopes(visibleTo(ctx)), &found, ids)
+	if err != nil {
+		return nil, err
+	}
+
+	byID := make(map[uuid.UUID]models.Post, len(found))
+	for _, p := range found {
+		byID[p.ID] = p
+	}
+
+	posts := make([]models.Post, 0, len(found))
+	for _, id := range ids {
+		if p, ok := byID[id]; ok {
+			posts = append(posts, p)
 		}
-		err = r.DB.WithContext(ctx).
-			Preload("User").
-			Preload("Tags").
-			Where("id IN ?", postIDs).
-			Order("created_at DESC").
-			Find(&posts).Error
 	}
 
-	return posts, total, err
+	return posts, nil
+}
+
+// FindAllInBatches iterates over all posts with their tags in batches
+func (r *postRepository) FindAllInBatches(ctx context.Context, batchSize int, fn func(posts []models.Post) error) error {
+	var posts []models.Post
+	return r.DB.WithContext(ctx).
+		Preload("Tags").
+		FindInBatches(&posts, batchSize, func(tx *gorm.DB, batch int) error {
+			return fn(posts)
+		}).Error
+}
+
+// FindInBatchesWithAuthor iterates over the posts of a user (or all posts
+// when userID is nil) with their author and tags in batches
+func (r *postRepository) FindInBatchesWithAuthor(ctx context.Context, userID *uuid.UUID, batchSize int, fn func(posts []models.Post) error) error {
+	query := r.DB.WithContext(ctx).Preload("User").Preload("Tags")
+	if userID != nil {
+		query = query.Where("user_id = ?", *userID)
+	}
+
+	var posts []models.Post
+	return query.FindInBatches(&posts, batchSize, func(tx *gorm.DB, batch int) error {
+		return fn(posts)
+	}).Error
+}
+
+// FindPublicInBatches iterates over the posts anonymous readers may see,
+// published and neither shadowed nor mature, with their author and tags in
+// batches
+func (r *postRepository) FindPublicInBatches(ctx context.Context, batchSize int, fn func(posts []models.Post) error) error {
+	var posts []models.Post
+	return r.DB.WithContext(ctx).
+		Preload("User").
+		Preload("Tags").
+		Where("status = ? AND shadowed = ? AND mature = ?", models.PostStatusPublished, false, false).
+		FindInBatches(&posts, batchSize, func(tx *gorm.DB, batch int) error {
+			return fn(posts)
+		}).Error
 }
 
 // FindByID overrides base to include error handling
@@ -251,3 +628,16 @@ func (r *postRepository) FindByID(ctx context.Context, id uuid.UUID) (*models.Po
 	}
 	return &post, nil
 }
+
+// FindByImportKey finds a post by the key of the source item it was imported from
+func (r *postRepository) FindByImportKey(ctx context.Context, key string) (*models.Post, error) {
+	var post models.Post
+	err := r.DB.WithContext(ctx).Where("import_key = ?", key).First(&post).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, apperrors.ErrNotFound.WithDetails("Post not found")
+		}
+		return nil, err
+	}
+	return &post, nil
+}
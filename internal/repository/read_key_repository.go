@@ -0,0 +1,120 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/yourusername/go-enterprise-api/internal/models"
+	apperrors "github.com/yourusername/go-enterprise-api/pkg/errors"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// ReadKeyRepository interface defines read key repository methods
+type ReadKeyRepository interface {
+	Repository[models.ReadKey]
+	FindByHash(ctx context.Context, hash string) (*models.ReadKey, error)
+	FindReadKeys(ctx context.Context, tier models.ReadKeyTier, page, pageSize int) ([]models.ReadKey, int64, error)
+	Revoke(ctx context.Context, id uuid.UUID) error
+	UpdateLastUsed(ctx context.Context, id uuid.UUID, at time.Time) error
+	AddUsage(ctx context.Context, id uuid.UUID, day string, requests, postViews int64) error
+	FindDailyUsage(ctx context.Context, id uuid.UUID, from, to string) ([]models.ReadKeyDailyUsage, error)
+}
+
+// readKeyRepository implements ReadKeyRepository
+type readKeyRepository struct {
+	*BaseRepository[models.ReadKey]
+}
+
+// NewReadKeyRepository creates a new read key repository
+func NewReadKeyRepository(db *gorm.DB) ReadKeyRepository {
+	return &readKeyRepository{
+		BaseRepository: NewBaseRepository[models.ReadKey](db),
+	}
+}
+
+// FindByID overrides base to report missing read keys
+func (r *readKeyRepository) FindByID(ctx context.Context, id uuid.UUID) (*models.ReadKey, error) {
+	var key models.ReadKey
+	err := r.DB.WithContext(ctx).First(&key, "id = ?", id).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, apperrors.ErrNotFound.WithDetails("Read key not found")
+		}
+		return nil, err
+	}
+	return &key, nil
+}
+
+// FindByHash finds a read key by its hash
+func (r *readKeyRepository) FindByHash(ctx context.Context, hash string) (*models.ReadKey, error) {
+	var key models.ReadKey
+	err := r.DB.WithContext(ctx).Where("key_hash = ?", hash).First(&key).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, apperrors.ErrInvalidAPIKey
+		}
+		return nil, err
+	}
+	return &key, nil
+}
+
+// FindReadKeys finds read keys, newest first, optionally filtered by tier
+func (r *readKeyRepository) FindReadKeys(ctx context.Context, tier models.ReadKeyTier, page, pageSize int) ([]models.ReadKey, int64, error) {
+	var keys []models.ReadKey
+	var total int64
+
+	query := r.DB.WithContext(ctx).Model(&models.ReadKey{})
+	if tier != "" {
+		query = query.Where("tier = ?", tier)
+	}
+
+	if err := query.Count(&total).Error; err != nil {
+		return nil, 0, err
+	}
+
+	offset := (page - 1) * pageSize
+	err := query.Order("created_at DESC").Offset(offset).Limit(pageSize).Find(&keys).Error
+	return keys, total, err
+}
+
+// Revoke marks a read key as revoked
+func (r *readKeyRepository) Revoke(ctx context.Context, id uuid.UUID) error {
+	return r.DB.WithContext(ctx).Model(&models.ReadKey{}).Where("id = ?", id).Update("revoked_at", time.Now().UTC()).Error
+}
+
+// UpdateLastUsed updates the key's last used timestamp
+func (r *readKeyRepository) UpdateLastUsed(ctx context.Context, id uuid.UUID, at time.Time) error {
+	return r.DB.WithContext(ctx).Model(&models.ReadKey{}).Where("id = ?", id).UpdateColumn("last_used_at", at).Error
+}
+
+// AddUsage adds requests and post views to a read key's usage on a day
+func (r *readKeyRepository) AddUsage(ctx context.Context, id uuid.UUID, day string, requests, postViews int64) error {
+	now := time.Now().UTC()
+	return r.DB.WithContext(ctx).Clauses(clause.OnConflict{
+		Columns: []clause.Column{{Name: "read_key_id"}, {Name: "day"}},
+		DoUpdates: clause.Assignments(map[string]interface{}{
+			"requests":   gorm.Expr("read_key_daily_usage.requests + ?", requests),
+			"post_views": gorm.Expr("read_key_daily_usage.post_views + ?", postViews),
+			"updated_at": now,
+		}),
+	}).Create(&models.ReadKeyDailyUsage{
+		ReadKeyID: id,
+		Day:       day,
+		Requests:  requests,
+		PostViews: postViews,
+		UpdatedAt: now,
+	}).Error
+}
+
+// FindDailyUsage finds the daily usage of a read key between two days (inclusive)
+func (r *readKeyRepository) FindDailyUsage(ctx context.Context, id uuid.UUID, from, to string) ([]models.ReadKeyDailyUsage, error) {
+	var usage []models.ReadKeyDailyUsage
+	err := r.DB.WithContext(ctx).
+		Where("read_key_id = ? AND day >= ? AND day <= ?", id, from, to).
+		Order("day ASC").
+		Find(&usage).Error
+	return usage, err
+}
@@ -2,21 +2,59 @@ package repository
 
 import (
 	"context"
+	"errors"
 
 	"github.com/google/uuid"
+	"github.com/yourusername/go-enterprise-api/internal/models"
+	apperrors "github.com/yourusername/go-enterprise-api/pkg/errors"
 	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
 )
 
 // Repository is the base repository interface
 type Repository[T any] interface {
 	Create(ctx context.Context, entity *T) error
+	CreateInBatches(ctx context.Context, entities []T, batchSize int) error
 	FindByID(ctx context.Context, id uuid.UUID) (*T, error)
+	FindByIDs(ctx context.Context, ids []uuid.UUID) ([]T, error)
 	FindAll(ctx context.Context, page, pageSize int) ([]T, int64, error)
 	Update(ctx context.Context, entity *T) error
+	Upsert(ctx context.Context, entity *T) error
 	Delete(ctx context.Context, id uuid.UUID) error
 	Count(ctx context.Context) (int64, error)
 }
 
+// IsDuplicateKey reports whether err is a unique constraint violation
+func IsDuplicateKey(err error) bool {
+	return errors.Is(err, gorm.ErrDuplicatedKey)
+}
+
+// updateVersioned saves every field of a versioned entity and increments its
+// version, unless another update has incremented it since the entity was read
+func updateVersioned(db *gorm.DB, entity interface{}, versioned models.VersionedModel) error {
+	read := versioned.CurrentVersion()
+	versioned.SetVersion(read + 1)
+
+	result := db.Model(entity).Where("version = ?", read).Select("*").Updates(entity)
+	if result.Error == nil && result.RowsAffected == 0 {
+		result.Error = apperrors.ErrConflict.WithDetails("The record was changed by someone else; reload it and try again")
+	}
+	if result.Error != nil {
+		versioned.SetVersion(read)
+		return result.Error
+	}
+	return nil
+}
+
+// findByIDs finds the records with the given IDs into dest with a single IN
+// query. Records that are not found are skipped.
+func findByIDs(db *gorm.DB, dest interface{}, ids []uuid.UUID) error {
+	if len(ids) == 0 {
+		return nil
+	}
+	return db.Where("id IN ?", ids).Find(dest).Error
+}
+
 // BaseRepository provides common repository functionality
 type BaseRepository[T any] struct {
 	DB *gorm.DB
@@ -32,6 +70,14 @@ func (r *BaseRepository[T]) Create(ctx context.Context, entity *T) error {
 	return r.DB.WithContext(ctx).Create(entity).Error
 }
 
+// CreateInBatches creates entities with one insert per batch of batchSize
+func (r *BaseRepository[T]) CreateInBatches(ctx context.Context, entities []T, batchSize int) error {
+	if len(entities) == 0 {
+		return nil
+	}
+	return r.DB.WithContext(ctx).CreateInBatches(entities, batchSize).Error
+}
+
 // FindByID finds an entity by ID
 func (r *BaseRepository[T]) FindByID(ctx context.Context, id uuid.UUID) (*T, error) {
 	var entity T
@@ -42,6 +88,16 @@ func (r *BaseRepository[T]) FindByID(ctx context.Context, id uuid.UUID) (*T, err
 	return &entity, nil
 }
 
+// FindByIDs finds the entities with the given IDs, in no particular order.
+// IDs that are not found are skipped.
+func (r *BaseRepository[T]) FindByIDs(ctx context.Context, ids []uuid.UUID) ([]T, error) {
+	entities := []T{}
+	if err := findByIDs(r.DB.WithContext(ctx), &entities, ids); err != nil {
+		return nil, err
+	}
+	return entities, nil
+}
+
 // FindAll finds all entities with pagination
 func (r *BaseRepository[T]) FindAll(ctx context.Context, page, pageSize int) ([]T, int64, error) {
 	var entities []T
@@ -61,11 +117,25 @@ func (r *BaseRepository[T]) FindAll(ctx context.Context, page, pageSize int) ([]
 	return entities, total, nil
 }
 
-// Update updates an entity
+// Update updates an entity. Versioned entities are only updated while their
+// stored version is the one they were read with, else ErrConflict is returned.
 func (r *BaseRepository[T]) Update(ctx context.Context, entity *T) error {
+	if versioned, ok := any(entity).(models.VersionedModel); ok {
+		return updateVersioned(r.DB.WithContext(ctx), entity, versioned)
+	}
 	return r.DB.WithContext(ctx).Save(entity).Error
 }
 
+// Upsert creates an entity, or overwrites every column of the stored entity
+// with its ID. A soft deleted entity is restored. Versions are not checked,
+// so it suits imports and syncs rather than edits.
+func (r *BaseRepository[T]) Upsert(ctx context.Context, entity *T) error {
+	return r.DB.WithContext(ctx).Clauses(clause.OnConflict{
+		Columns:   []clause.Column{{Name: "id"}},
+		UpdateAll: true,
+	}).Create(entity).Error
+}
+
 // Delete soft deletes an entity
 func (r *BaseRepository[T]) Delete(ctx context.Context, id uuid.UUID) error {
 	return r.DB.WithContext(ctx).Delete(new(T), "id = ?", id).Error
@@ -109,5 +179,5 @@ func (r *BaseRepository[T]) Exists(ctx context.Context, id uuid.UUID) (bool, err
 
 // Transaction executes a function within a transaction
 func (r *BaseRepository[T]) Transaction(ctx context.Context, fn func(tx *gorm.DB) error) error {
-	return r.DB.WithContext(ctx).Transaction(fn)
+	return transaction(ctx, r.DB, fn)
 }
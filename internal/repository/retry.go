@@ -0,0 +1,299 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"database/sql/driver"
+	"errors"
+	"io"
+	"math/rand"
+	"net"
+	"strings"
+	"sync"
+	"syscall"
+	"time"
+
+	"github.com/go-sql-driver/mysql"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/mattn/go-sqlite3"
+	"github.com/yourusername/go-enterprise-api/pkg/logger"
+	"github.com/yourusername/go-enterprise-api/pkg/metrics"
+	"gorm.io/gorm"
+)
+
+// Kinds of retried operations
+const (
+	retryStatement   = "statement"
+	retryTransaction = "transaction"
+)
+
+var (
+	dbRetries = metrics.NewCounter("db_retries_total",
+		"Database operations retried after a transient error.", "kind", "reason")
+	dbRetriesExhausted = metrics.NewCounter("db_retries_exhausted_total",
+		"Database operations that still failed with a transient error after the last attempt.", "kind", "reason")
+)
+
+// transientError classifies an error worth retrying and returns a short
+// reason for it, or "" if retrying would not help. notApplied reports that
+// the database did not apply the failed work, so it can be retried even if
+// it is not idempotent; connection errors leave the outcome unknown.
+func transientError(err error) (reason string, notApplied bool) {
+	if errors.Is(err, context.Canceled) || errors.Is(err, context.DeadlineExceeded) {
+		return "", false
+	}
+
+	var pgErr *pgconn.PgError
+	if errors.As(err, &pgErr) {
+		switch {
+		case pgErr.Code == "40001":
+			return "serialization_failure", true
+		case pgErr.Code == "40P01":
+			return "deadlock", true
+		case pgErr.Code == "57P03":
+			return "connection", true
+		case strings.HasPrefix(pgErr.Code, "08"), pgErr.Code == "57P01", pgErr.Code == "57P02":
+			return "connection", false
+		}
+		return "", false
+	}
+
+	var sqliteErr sqlite3.Error
+	if errors.As(err, &sqliteErr) {
+		if sqliteErr.Code == sqlite3.ErrBusy || sqliteErr.Code == sqlite3.ErrLocked {
+			return "database_locked", true
+		}
+		return "", false
+	}
+
+	var mysqlErr *mysql.MySQLError
+	if errors.As(err, &mysqlErr) {
+		switch mysqlErr.Number {
+		case 1213: // ER_LOCK_DEADLOCK
+			return "deadlock", true
+		case 1205: // ER_LOCK_WAIT_TIMEOUT
+			return "lock_timeout", true
+		}
+		return "", false
+	}
+
+	switch {
+	case errors.Is(err, driver.ErrBadConn), pgconn.SafeToRetry(err), errors.Is(err, syscall.ECONNREFUSED):
+		return "connection", true
+	case errors.Is(err, io.EOF), errors.Is(err, io.ErrUnexpectedEOF), errors.Is(err, mysql.ErrInvalidConn),
+		errors.Is(err, syscall.ECONNRESET), errors.Is(err, syscall.EPIPE):
+		return "connection", false
+	}
+
+	var netErr net.Error
+	if errors.As(err, &netErr) {
+		return "connection", false
+	}
+	return "", false
+}
+
+// retryPool wraps the connection pool of a gorm.DB, running statements again
+// when they fail with a transient error. Statements that may have been applied
+// before their connection failed are only retried when they are reads.
+type retryPool struct {
+	db          *sql.DB
+	maxAttempts int
+	delay       time.Duration
+	// others holds the pools wrapping the read replicas
+	others sync.Map
+}
+
+// EnableRetries makes db retry statements and repository transactions that
+// fail with a transient error, up to maxAttempts attempts in total, backing
+// off exponentially from delay between attempts. A maxAttempts of one or less
+// disables retries.
+func EnableRetries(db *gorm.DB, maxAttempts int, delay time.Duration) error {
+	if maxAttempts <= 1 {
+		return nil
+	}
+
+	sqlDB, err := db.DB()
+	if err != nil {
+		return err
+	}
+
+	pool := &retryPool{db: sqlDB, maxAttempts: maxAttempts, delay: delay}
+	db.ConnPool = pool
+	db.Statement.ConnPool = pool
+
+	// With read replicas, the resolver picks the connection of each statement
+	// after it starts; wrap the connection it picked, before it is used
+	wrap := func(tx *gorm.DB) {
+		if picked, ok := tx.Statement.ConnPool.(*sql.DB); ok {
+			tx.Statement.ConnPool = pool.wrap(picked)
+		}
+	}
+	callbacks := db.Callback()
+	for _, err := range []error{
+		callbacks.Create().Before("gorm:begin_transaction").Register("retry:wrap_pool", wrap),
+		callbacks.Update().Before("gorm:begin_transaction").Register("retry:wrap_pool", wrap),
+		callbacks.Delete().Before("gorm:begin_transaction").Register("retry:wrap_pool", wrap),
+		callbacks.Query().Before("gorm:query").Register("retry:wrap_pool", wrap),
+		callbacks.Row().Before("gorm:row").Register("retry:wrap_pool", wrap),
+		callbacks.Raw().Before("gorm:raw").Register("retry:wrap_pool", wrap),
+	} {
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// wrap returns the retrying pool of a connection pool
+func (p *retryPool) wrap(sqlDB *sql.DB) *retryPool {
+	if sqlDB == p.db {
+		return p
+	}
+	if other, ok := p.others.Load(sqlDB); ok {
+		return other.(*retryPool)
+	}
+	other, _ := p.others.LoadOrStore(sqlDB, &retryPool{db: sqlDB, maxAttempts: p.maxAttempts, delay: p.delay})
+	return other.(*retryPool)
+}
+
+// PrepareContext prepares a statement, retrying transient errors
+func (p *retryPool) PrepareContext(ctx context.Context, query string) (stmt *sql.Stmt, err error) {
+	err = p.retry(ctx, retryStatement, func() (bool, error) {
+		stmt, err = p.db.PrepareContext(ctx, query)
+		return true, err
+	})
+	return stmt, err
+}
+
+// ExecContext executes a statement, retrying errors that leave it unapplied
+func (p *retryPool) ExecContext(ctx context.Context, query string, args ...interface{}) (result sql.Result, err error) {
+	err = p.retry(ctx, retryStatement, func() (bool, error) {
+		result, err = p.db.ExecContext(ctx, query, args...)
+		return false, err
+	})
+	return result, err
+}
+
+// QueryContext runs a query, retrying transient errors. Queries that write,
+// such as an INSERT ... RETURNING, are only retried when left unapplied.
+func (p *retryPool) QueryContext(ctx context.Context, query string, args ...interface{}) (rows *sql.Rows, err error) {
+	err = p.retry(ctx, retryStatement, func() (bool, error) {
+		rows, err = p.db.QueryContext(ctx, query, args...)
+		return isRead(query), err
+	})
+	return rows, err
+}
+
+// QueryRowContext runs a query returning a single row. Its error is only
+// reported when the row is scanned, so it is not retried.
+func (p *retryPool) QueryRowContext(ctx context.Context, query string, args ...interface{}) *sql.Row {
+	return p.db.QueryRowContext(ctx, query, args...)
+}
+
+// BeginTx starts a transaction, retrying transient errors
+func (p *retryPool) BeginTx(ctx context.Context, opts *sql.TxOptions) (tx *sql.Tx, err error) {
+	err = p.retry(ctx, retryStatement, func() (bool, error) {
+		tx, err = p.db.BeginTx(ctx, opts)
+		return true, err
+	})
+	return tx, err
+}
+
+// GetDBConn returns the wrapped pool, so gorm's DB() keeps working
+func (p *retryPool) GetDBConn() (*sql.DB, error) {
+	return p.db, nil
+}
+
+// retry runs fn until it succeeds, fails with an error that is not worth
+// retrying or runs out of attempts. fn reports whether it is safe to run again
+// after an error that leaves its outcome unknown, such as a lost connection.
+func (p *retryPool) retry(ctx context.Context, kind string, fn func() (idempotent bool, err error)) error {
+	for attempt := 1; ; attempt++ {
+		idempotent, err := fn()
+		if err == nil {
+			return nil
+		}
+
+		reason, notApplied := transientError(err)
+		if reason == "" || (!notApplied && !idempotent) || ctx.Err() != nil {
+			return err
+		}
+		if attempt >= p.maxAttempts {
+			dbRetriesExhausted.Inc(kind, reason)
+			return err
+		}
+
+		dbRetries.Inc(kind, reason)
+		logger.Warn("Retrying database operation after transient error",
+			logger.String("kind", kind),
+			logger.String("reason", reason),
+			logger.Int("attempt", attempt),
+			logger.Err(err),
+		)
+
+		timer := time.NewTimer(p.backoff(attempt))
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return err
+		case <-timer.C:
+		}
+	}
+}
+
+// backoff returns the delay before the next attempt: the base delay doubled
+// for every failed attempt, with jitter so that clients failing together do
+// not retry together
+func (p *retryPool) backoff(attempt int) time.Duration {
+	d := p.delay << (attempt - 1)
+	if d <= 0 {
+		return 0
+	}
+	return d/2 + time.Duration(rand.Int63n(int64(d/2)+1))
+}
+
+// isRead reports whether a query only reads
+func isRead(query string) bool {
+	query = strings.TrimSpace(query)
+	return len(query) >= 6 && strings.EqualFold(query[:6], "SELECT")
+}
+
+// transaction runs fn in a transaction. A transaction that fails with a
+// transient error is run again from the start; a failed commit is only
+// retried when the database reports the transaction as rolled back. Inside an
+// existing transaction, fn runs in a savepoint without retries.
+func transaction(ctx context.Context, db *gorm.DB, fn func(tx *gorm.DB) error) error {
+	pool, ok := db.Statement.ConnPool.(*retryPool)
+	if !ok {
+		return db.WithContext(ctx).Transaction(fn)
+	}
+
+	return pool.retry(ctx, retryTransaction, func() (bool, error) {
+		committing, err := runTransaction(ctx, db, fn)
+		// Nothing is applied until the transaction commits
+		return !committing, err
+	})
+}
+
+// runTransaction runs one attempt of a transaction and reports whether it
+// failed while committing
+func runTransaction(ctx context.Context, db *gorm.DB, fn func(tx *gorm.DB) error) (committing bool, err error) {
+	tx := db.WithContext(ctx).Begin()
+	if tx.Error != nil {
+		return false, tx.Error
+	}
+
+	defer func() {
+		// Roll back when fn fails or panics
+		if !committing {
+			tx.Rollback()
+		}
+	}()
+
+	if err := fn(tx); err != nil {
+		return false, err
+	}
+
+	committing = true
+	return true, tx.Commit().Error
+}
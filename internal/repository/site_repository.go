@@ -0,0 +1,163 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/yourusername/go-enterprise-api/internal/models"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// SiteRepository interface defines exporting and importing a whole site.
+// Reads include soft-deleted records, so references between exported
+// records always resolve.
+type SiteRepository interface {
+	EachUser(ctx context.Context, batchSize int, fn func(users []models.User) error) error
+	EachFollow(ctx context.Context, batchSize int, fn func(follows []models.UserFollow) error) error
+	EachTag(ctx context.Context, batchSize int, fn func(tags []models.Tag) error) error
+	EachTagAlias(ctx context.Context, batchSize int, fn func(aliases []models.TagAlias) error) error
+	EachPost(ctx context.Context, batchSize int, fn func(posts []models.Post) error) error
+	EachSlugRedirect(ctx context.Context, batchSize int, fn func(redirects []models.SlugRedirect) error) error
+	EachComment(ctx context.Context, batchSize int, fn func(comments []models.Comment) error) error
+	HasContent(ctx context.Context) (bool, error)
+	FindUserIDsByEmail(ctx context.Context) (map[string]uuid.UUID, error)
+	Import(ctx context.Context, fn func(repo SiteRepository) error) error
+	Insert(ctx context.Context, records interface{}) error
+	InsertPostTags(ctx context.Context, postID uuid.UUID, tagIDs []uuid.UUID) error
+}
+
+// siteRepository implements SiteRepository
+type siteRepository struct {
+	DB *gorm.DB
+}
+
+// NewSiteRepository creates a new site repository
+func NewSiteRepository(db *gorm.DB) SiteRepository {
+	return &siteRepository{DB: db}
+}
+
+// eachInBatches iterates over every record of a table, including soft-deleted
+// ones, in primary key order
+func eachInBatches[T any](ctx context.Context, db *gorm.DB, batchSize int, fn func(records []T) error) error {
+	var records []T
+	return db.WithContext(ctx).Unscoped().
+		FindInBatches(&records, batchSize, func(tx *gorm.DB, batch int) error {
+			return fn(records)
+		}).Error
+}
+
+// EachUser iterates over all users in batches
+func (r *siteRepository) EachUser(ctx context.Context, batchSize int, fn func(users []models.User) error) error {
+	return eachInBatches(ctx, r.DB, batchSize, fn)
+}
+
+// EachFollow iterates over all follows in batches
+func (r *siteRepository) EachFollow(ctx context.Context, batchSize int, fn func(follows []models.UserFollow) error) error {
+	var last *models.UserFollow
+	for {
+		var follows []models.UserFollow
+		query := r.DB.WithContext(ctx).Order("follower_id, followee_id").Limit(batchSize)
+		if last != nil {
+			query = query.Where("follower_id > ? OR (follower_id = ? AND followee_id > ?)",
+				last.FollowerID, last.FollowerID, last.FolloweeID)
+		}
+		if err := query.Find(&follows).Error; err != nil {
+			return err
+		}
+		if len(follows) == 0 {
+			return nil
+		}
+		if err := fn(follows); err != nil {
+			return err
+		}
+		if len(follows) < batchSize {
+			return nil
+		}
+		last = &follows[len(follows)-1]
+	}
+}
+
+// EachTag iterates over all tags in batches
+func (r *siteRepository) EachTag(ctx context.Context, batchSize int, fn func(tags []models.Tag) error) error {
+	return eachInBatches(ctx, r.DB, batchSize, fn)
+}
+
+// EachTagAlias iterates over all tag aliases in batches
+func (r *siteRepository) EachTagAlias(ctx context.Context, batchSize int, fn func(aliases []models.TagAlias) error) error {
+	return eachInBatches(ctx, r.DB, batchSize, fn)
+}
+
+// EachPost iterates over all posts with their tags in batches
+func (r *siteRepository) EachPost(ctx context.Context, batchSize int, fn func(posts []models.Post) error) error {
+	return eachInBatches(ctx, r.DB.Preload("Tags", func(db *gorm.DB) *gorm.DB {
+		return db.Unscoped()
+	}), batchSize, fn)
+}
+
+// EachSlugRedirect iterates over all slug redirects in batches
+func (r *siteRepository) EachSlugRedirect(ctx context.Context, batchSize int, fn func(redirects []models.SlugRedirect) error) error {
+	return eachInBatches(ctx, r.DB, batchSize, fn)
+}
+
+// EachComment iterates over all comments in batches
+func (r *siteRepository) EachComment(ctx context.Context, batchSize int, fn func(comments []models.Comment) error) error {
+	return eachInBatches(ctx, r.DB, batchSize, fn)
+}
+
+// HasContent reports whether any posts, tags, comments or follows exist,
+// including soft-deleted ones
+func (r *siteRepository) HasContent(ctx context.Context) (bool, error) {
+	for _, model := range []interface{}{&models.Post{}, &models.Tag{}, &models.Comment{}, &models.UserFollow{}} {
+		var count int64
+		if err := r.DB.WithContext(ctx).Unscoped().Model(model).Limit(1).Count(&count).Error; err != nil {
+			return false, err
+		}
+		if count > 0 {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+// FindUserIDsByEmail returns the IDs of all users, including soft-deleted
+// ones, by email
+func (r *siteRepository) FindUserIDsByEmail(ctx context.Context) (map[string]uuid.UUID, error) {
+	var users []models.User
+	if err := r.DB.WithContext(ctx).Unscoped().Select("id", "email").Find(&users).Error; err != nil {
+		return nil, err
+	}
+
+	ids := make(map[string]uuid.UUID, len(users))
+	for _, user := range users {
+		ids[user.Email] = user.ID
+	}
+	return ids, nil
+}
+
+// Import runs fn in a transaction with a repository bound to it, so an
+// import either completes or leaves nothing behind
+func (r *siteRepository) Import(ctx context.Context, fn func(repo SiteRepository) error) error {
+	return transaction(ctx, r.DB, func(tx *gorm.DB) error {
+		return fn(&siteRepository{DB: tx})
+	})
+}
+
+// Insert creates records as they are, keeping their IDs and timestamps.
+// Associations are not saved.
+func (r *siteRepository) Insert(ctx context.Context, records interface{}) error {
+	return r.DB.WithContext(ctx).Omit(clause.Associations).Create(records).Error
+}
+
+// InsertPostTags tags a post
+func (r *siteRepository) InsertPostTags(ctx context.Context, postID uuid.UUID, tagIDs []uuid.UUID) error {
+	if len(tagIDs) == 0 {
+		return nil
+	}
+
+	rows := make([]map[string]interface{}, len(tagIDs))
+	for i, tagID := range tagIDs {
+		rows[i] = map[string]interface{}{"post_id": postID, "tag_id": tagID}
+	}
+	return r.DB.WithContext(ctx).Table("post_tags").Create(rows).Error
+}
@@ -0,0 +1,59 @@
+package repository
+
+import (
+	"context"
+	"errors"
+
+	"github.com/google/uuid"
+	"github.com/yourusername/go-enterprise-api/internal/models"
+	apperrors "github.com/yourusername/go-enterprise-api/pkg/errors"
+	"gorm.io/gorm"
+)
+
+// SlugRedirectRepository interface defines slug history repository methods
+type SlugRedirectRepository interface {
+	Repository[models.SlugRedirect]
+	FindByOldSlug(ctx context.Context, slug string) (*models.SlugRedirect, error)
+	Record(ctx context.Context, oldSlug string, postID uuid.UUID) error
+	DeleteByOldSlug(ctx context.Context, slug string) error
+}
+
+// slugRedirectRepository implements SlugRedirectRepository
+type slugRedirectRepository struct {
+	*BaseRepository[models.SlugRedirect]
+}
+
+// NewSlugRedirectRepository creates a new slug redirect repository
+func NewSlugRedirectRepository(db *gorm.DB) SlugRedirectRepository {
+	return &slugRedirectRepository{
+		BaseRepository: NewBaseRepository[models.SlugRedirect](db),
+	}
+}
+
+// FindByOldSlug finds the redirect for a previous slug
+func (r *slugRedirectRepository) FindByOldSlug(ctx context.Context, slug string) (*models.SlugRedirect, error) {
+	var redirect models.SlugRedirect
+	err := r.DB.WithContext(ctx).Where("old_slug = ?", slug).First(&redirect).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, apperrors.ErrNotFound.WithDetails("Slug redirect not found")
+		}
+		return nil, err
+	}
+	return &redirect, nil
+}
+
+// Record points a previous slug at a post, replacing any existing redirect for that slug
+func (r *slugRedirectRepository) Record(ctx context.Context, oldSlug string, postID uuid.UUID) error {
+	return transaction(ctx, r.DB, func(tx *gorm.DB) error {
+		if err := tx.Unscoped().Where("old_slug = ?", oldSlug).Delete(&models.SlugRedirect{}).Error; err != nil {
+			return err
+		}
+		return tx.Create(&models.SlugRedirect{OldSlug: oldSlug, PostID: postID}).Error
+	})
+}
+
+// DeleteByOldSlug removes the redirect for a slug that is in use again
+func (r *slugRedirectRepository) DeleteByOldSlug(ctx context.Context, slug string) error {
+	return r.DB.WithContext(ctx).Unscoped().Where("old_slug = ?", slug).Delete(&models.SlugRedirect{}).Error
+}
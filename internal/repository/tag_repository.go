@@ -0,0 +1,131 @@
+package repository
+
+import (
+	"context"
+	"errors"
+
+	"github.com/google/uuid"
+	"github.com/yourusername/go-enterprise-api/internal/models"
+	apperrors "github.com/yourusername/go-enterprise-api/pkg/errors"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// TagRepository interface defines tag-specific repository methods
+type TagRepository interface {
+	Repository[models.Tag]
+	FindOrCreate(ctx context.Context, name, slug string) (*models.Tag, error)
+	FindBySlug(ctx context.Context, slug string) (*models.Tag, error)
+	FindWithAliases(ctx context.Context, id uuid.UUID) (*models.Tag, error)
+	FindAliasBySlug(ctx context.Context, slug string) (*models.TagAlias, error)
+	CreateAlias(ctx context.Context, alias *models.TagAlias, mergeTagID *uuid.UUID) ([]uuid.UUID, error)
+	DeleteAlias(ctx context.Context, id uuid.UUID) error
+}
+
+// tagRepository implements TagRepository
+type tagRepository struct {
+	*BaseRepository[models.Tag]
+}
+
+// NewTagRepository creates a new tag repository
+func NewTagRepository(db *gorm.DB) TagRepository {
+	return &tagRepository{
+		BaseRepository: NewBaseRepository[models.Tag](db),
+	}
+}
+
+// FindOrCreate returns the tag with the given slug, creating it if it doesn't exist
+func (r *tagRepository) FindOrCreate(ctx context.Context, name, slug string) (*models.Tag, error) {
+	tag := &models.Tag{Name: name, Slug: slug}
+	err := r.DB.WithContext(ctx).Clauses(clause.OnConflict{DoNothing: true}).Create(tag).Error
+	if err != nil {
+		return nil, err
+	}
+
+	var existing models.Tag
+	if err := r.DB.WithContext(ctx).Where("slug = ?", slug).First(&existing).Error; err != nil {
+		return nil, err
+	}
+	return &existing, nil
+}
+
+// FindBySlug finds a tag by slug
+func (r *tagRepository) FindBySlug(ctx context.Context, slug string) (*models.Tag, error) {
+	var tag models.Tag
+	err := r.DB.WithContext(ctx).Where("slug = ?", slug).First(&tag).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, apperrors.ErrNotFound.WithDetails("Tag not found")
+		}
+		return nil, err
+	}
+	return &tag, nil
+}
+
+// FindWithAliases finds a tag with its aliases, sorted by name
+func (r *tagRepository) FindWithAliases(ctx context.Context, id uuid.UUID) (*models.Tag, error) {
+	var tag models.Tag
+	err := r.DB.WithContext(ctx).
+		Preload("Aliases", func(db *gorm.DB) *gorm.DB {
+			return db.Order("slug ASC")
+		}).
+		First(&tag, "id = ?", id).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, apperrors.ErrNotFound.WithDetails("Tag not found")
+		}
+		return nil, err
+	}
+	return &tag, nil
+}
+
+// FindAliasBySlug finds a tag alias by slug, with its canonical tag
+func (r *tagRepository) FindAliasBySlug(ctx context.Context, slug string) (*models.TagAlias, error) {
+	var alias models.TagAlias
+	err := r.DB.WithContext(ctx).Preload("Tag").Where("slug = ?", slug).First(&alias).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, apperrors.ErrNotFound.WithDetails("Tag alias not found")
+		}
+		return nil, err
+	}
+	return &alias, nil
+}
+
+// CreateAlias creates a tag alias. When mergeTagID is set, that tag is merged
+// into the alias's canonical tag first: its posts and aliases move over and
+// the tag is removed. It returns the IDs of the posts that were retagged.
+func (r *tagRepository) CreateAlias(ctx context.Context, alias *models.TagAlias, mergeTagID *uuid.UUID) ([]uuid.UUID, error) {
+	var postIDs []uuid.UUID
+	err := transaction(ctx, r.DB, func(tx *gorm.DB) error {
+		if mergeTagID != nil {
+			if err := tx.Table("post_tags").Where("tag_id = ?", *mergeTagID).Pluck("post_id", &postIDs).Error; err != nil {
+				return err
+			}
+			err := tx.Exec(
+				"INSERT INTO post_tags (post_id, tag_id) SELECT post_id, ? FROM post_tags WHERE tag_id = ? AND post_id NOT IN (SELECT post_id FROM post_tags WHERE tag_id = ?)",
+				alias.TagID, *mergeTagID, alias.TagID,
+			).Error
+			if err != nil {
+				return err
+			}
+			if err := tx.Exec("DELETE FROM post_tags WHERE tag_id = ?", *mergeTagID).Error; err != nil {
+				return err
+			}
+			if err := tx.Model(&models.TagAlias{}).Where("tag_id = ?", *mergeTagID).Update("tag_id", alias.TagID).Error; err != nil {
+				return err
+			}
+			// Hard delete so the slug is free if the alias is removed again
+			if err := tx.Unscoped().Delete(&models.Tag{}, "id = ?", *mergeTagID).Error; err != nil {
+				return err
+			}
+		}
+		return tx.Create(alias).Error
+	})
+	return postIDs, err
+}
+
+// DeleteAlias permanently deletes a tag alias so its slug can be used again
+func (r *tagRepository) DeleteAlias(ctx context.Context, id uuid.UUID) error {
+	return r.DB.WithContext(ctx).Unscoped().Delete(&models.TagAlias{}, "id = ?", id).Error
+}
@@ -0,0 +1,123 @@
+package repository
+
+import (
+	"context"
+	"reflect"
+
+	"github.com/google/uuid"
+	"github.com/yourusername/go-enterprise-api/internal/models"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// tenantColumn is the column holding the organization of tenant scoped
+// records
+const tenantColumn = "org_id"
+
+// allTenantsSetting lifts the tenant scope from the statements of a query
+const allTenantsSetting = "tenancy:all_tenants"
+
+// tenantKey is the request context key holding the tenant scope
+type tenantKey struct{}
+
+// tenantScope is the organization queries are limited to, nil meaning
+// records outside any organization
+type tenantScope struct {
+	org *models.Organization
+}
+
+// WithTenant returns a copy of ctx whose queries only see the records of
+// org, or the records outside any organization when org is nil. Queries made
+// with a context without a tenant, such as background work, see all records.
+func WithTenant(ctx context.Context, org *models.Organization) context.Context {
+	return context.WithValue(ctx, tenantKey{}, tenantScope{org: org})
+}
+
+// TenantFromContext returns the organization ctx is scoped to. ok is false
+// when ctx is not scoped to a tenant at all.
+func TenantFromContext(ctx context.Context) (org *models.Organization, ok bool) {
+	scope, ok := ctx.Value(tenantKey{}).(tenantScope)
+	return scope.org, ok
+}
+
+// allTenants lifts the tenant scope from the queries of db, for checks that
+// must span organizations such as slug uniqueness
+func allTenants(db *gorm.DB) *gorm.DB {
+	return db.Set(allTenantsSetting, true)
+}
+
+// EnableTenancy scopes the queries of models with an org_id column to the
+// tenant of their context: reads, updates and deletes only match the
+// tenant's records, and created records are assigned to it. Raw SQL is not
+// scoped.
+func EnableTenancy(db *gorm.DB) error {
+	scope := func(tx *gorm.DB) {
+		column, tenantID, ok := tenantOf(tx)
+		if !ok {
+			return
+		}
+		tx.Statement.AddClause(clause.Where{Exprs: []clause.Expression{
+			clause.Eq{Column: clause.Column{Table: clause.CurrentTable, Name: column}, Value: tenantID},
+		}})
+	}
+	assign := func(tx *gorm.DB) {
+		if _, _, ok := tenantOf(tx); !ok {
+			return
+		}
+		org, _ := TenantFromContext(tx.Statement.Context)
+		var value *uuid.UUID
+		if org != nil {
+			id := org.ID
+			value = &id
+		}
+
+		field := tx.Statement.Schema.LookUpField(tenantColumn)
+		set := func(record reflect.Value) {
+			if err := field.Set(tx.Statement.Context, record, value); err != nil {
+				_ = tx.AddError(err)
+			}
+		}
+		switch records := tx.Statement.ReflectValue; records.Kind() {
+		case reflect.Slice, reflect.Array:
+			for i := 0; i < records.Len(); i++ {
+				set(reflect.Indirect(records.Index(i)))
+			}
+		case reflect.Struct:
+			set(records)
+		}
+	}
+
+	callbacks := db.Callback()
+	for _, err := range []error{
+		callbacks.Query().Before("gorm:query").Register("tenancy:scope", scope),
+		callbacks.Row().Before("gorm:row").Register("tenancy:scope", scope),
+		callbacks.Update().Before("gorm:update").Register("tenancy:scope", scope),
+		callbacks.Delete().Before("gorm:delete").Register("tenancy:scope", scope),
+		callbacks.Create().Before("gorm:create").Register("tenancy:assign", assign),
+	} {
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// tenantOf returns the tenant column of the statement's model and the ID of
+// the organization it is scoped to, nil for records outside any. ok is false
+// when the statement is not tenant scoped.
+func tenantOf(tx *gorm.DB) (column string, tenantID interface{}, ok bool) {
+	if tx.Statement.Schema == nil || tx.Statement.Schema.LookUpField(tenantColumn) == nil {
+		return "", nil, false
+	}
+	if all, _ := tx.Get(allTenantsSetting); all == true {
+		return "", nil, false
+	}
+	org, scoped := TenantFromContext(tx.Statement.Context)
+	if !scoped {
+		return "", nil, false
+	}
+	if org == nil {
+		return tenantColumn, nil, true
+	}
+	return tenantColumn, org.ID, true
+}
@@ -0,0 +1,96 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/yourusername/go-enterprise-api/internal/models"
+	"gorm.io/gorm"
+)
+
+// TrashRepository interface defines the permanent deletion of soft-deleted
+// records
+type TrashRepository interface {
+	PurgePosts(ctx context.Context, before time.Time, limit int) (int, error)
+	PurgeComments(ctx context.Context, before time.Time, limit int) (int, error)
+}
+
+// trashRepository implements TrashRepository
+type trashRepository struct {
+	DB *gorm.DB
+}
+
+// NewTrashRepository creates a new trash repository
+func NewTrashRepository(db *gorm.DB) TrashRepository {
+	return &trashRepository{DB: db}
+}
+
+// PurgePosts permanently deletes up to limit posts of every organization
+// soft-deleted before the given time, with their comments, notifications,
+// tags, statistics and slug redirects. Posts on legal hold are kept, and
+// appeals of purged posts lose their post.
+func (r *trashRepository) PurgePosts(ctx context.Context, before time.Time, limit int) (int, error) {
+	var ids []uuid.UUID
+	err := allTenants(r.DB.WithContext(ctx)).Unscoped().Model(&models.Post{}).
+		Where("deleted_at IS NOT NULL AND deleted_at < ? AND legal_hold_at IS NULL", before).
+		Order("deleted_at").
+		Limit(limit).
+		Pluck("id", &ids).Error
+	if err != nil || len(ids) == 0 {
+		return 0, err
+	}
+
+	err = r.DB.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		tx = allTenants(tx).Unscoped().Session(&gorm.Session{})
+		comments := tx.Model(&models.Comment{}).Select("id").Where("post_id IN ?", ids)
+		if err := tx.Where("post_id IN ? OR comment_id IN (?)", ids, comments).Delete(&models.Notification{}).Error; err != nil {
+			return err
+		}
+		if err := tx.Where("post_id IN ?", ids).Delete(&models.Comment{}).Error; err != nil {
+			return err
+		}
+		if err := tx.Exec("DELETE FROM post_tags WHERE post_id IN ?", ids).Error; err != nil {
+			return err
+		}
+		for _, model := range []interface{}{&models.PostDailyStat{}, &models.PostReferrerStat{}, &models.PostViewReader{}, &models.SlugRedirect{}} {
+			if err := tx.Where("post_id IN ?", ids).Delete(model).Error; err != nil {
+				return err
+			}
+		}
+		if err := tx.Model(&models.Appeal{}).Where("post_id IN ?", ids).Update("post_id", nil).Error; err != nil {
+			return err
+		}
+		return tx.Where("id IN ?", ids).Delete(&models.Post{}).Error
+	})
+	if err != nil {
+		return 0, err
+	}
+	return len(ids), nil
+}
+
+// PurgeComments permanently deletes up to limit comments soft-deleted before
+// the given time, with their notifications
+func (r *trashRepository) PurgeComments(ctx context.Context, before time.Time, limit int) (int, error) {
+	var ids []uuid.UUID
+	err := allTenants(r.DB.WithContext(ctx)).Unscoped().Model(&models.Comment{}).
+		Where("deleted_at IS NOT NULL AND deleted_at < ?", before).
+		Order("deleted_at").
+		Limit(limit).
+		Pluck("id", &ids).Error
+	if err != nil || len(ids) == 0 {
+		return 0, err
+	}
+
+	err = r.DB.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		tx = allTenants(tx).Unscoped().Session(&gorm.Session{})
+		if err := tx.Where("comment_id IN ?", ids).Delete(&models.Notification{}).Error; err != nil {
+			return err
+		}
+		return tx.Where("id IN ?", ids).Delete(&models.Comment{}).Error
+	})
+	if err != nil {
+		return 0, err
+	}
+	return len(ids), nil
+}
@@ -3,6 +3,7 @@ package repository
 import (
 	"context"
 	"errors"
+	"time"
 
 	"github.com/google/uuid"
 	"github.com/yourusername/go-enterprise-api/internal/database"
@@ -18,12 +19,25 @@ type UserRepository interface {
 	FindByRefreshToken(ctx context.Context, token string) (*models.User, error)
 	UpdateRefreshToken(ctx context.Context, userID uuid.UUID, token string) error
 	UpdateLastLogin(ctx context.Context, userID uuid.UUID) error
+	UpdateLastSeen(ctx context.Context, userID uuid.UUID, at time.Time) error
 	VerifyEmail(ctx context.Context, userID uuid.UUID) error
 	UpdatePassword(ctx context.Context, userID uuid.UUID, password string) error
 	UpdateStatus(ctx context.Context, userID uuid.UUID, status models.UserStatus) error
 	UpdateRole(ctx context.Context, userID uuid.UUID, role models.UserRole) error
 	ExistsByEmail(ctx context.Context, email string) (bool, error)
 	SearchUsers(ctx context.Context, query string, page, pageSize int) ([]models.User, int64, error)
+	FindFiltered(ctx context.Context, filter *models.UserFilter, page, pageSize int) ([]models.User, int64, error)
+	FindServiceAccounts(ctx context.Context, page, pageSize int) ([]models.User, int64, error)
+	FindByIDs(ctx context.Context, ids []uuid.UUID) ([]models.User, error)
+	FindLastActiveBefore(ctx context.Context, before time.Time, limit int) ([]models.User, error)
+	FindDormancyNotifiedBefore(ctx context.Context, before time.Time, limit int) ([]models.User, error)
+	FindDormantBefore(ctx context.Context, before time.Time, limit int) ([]models.User, error)
+	ClearDormancyNotice(ctx context.Context, userID uuid.UUID) error
+	FindDeletionRequestedBefore(ctx context.Context, before time.Time, limit int) ([]models.User, error)
+	CancelDeletion(ctx context.Context, userID uuid.UUID) error
+	DeleteMany(ctx context.Context, ids []uuid.UUID) (int64, error)
+	UpdateRoleMany(ctx context.Context, ids []uuid.UUID, role models.UserRole) (int64, error)
+	UpdateStatusMany(ctx context.Context, ids []uuid.UUID, status models.UserStatus) (int64, error)
 }
 
 // userRepository implements UserRepository
@@ -71,12 +85,18 @@ func (r *userRepository) UpdateRefreshToken(ctx context.Context, userID uuid.UUI
 
 // UpdateLastLogin updates the user's last login timestamp
 func (r *userRepository) UpdateLastLogin(ctx context.Context, userID uuid.UUID) error {
-	return r.DB.WithContext(ctx).Model(&models.User{}).Where("id = ?", userID).Update("last_login_at", gorm.Expr("NOW()")).Error
+	return r.DB.WithContext(ctx).Model(&models.User{}).Where("id = ?", userID).Update("last_login_at", time.Now().UTC()).Error
+}
+
+// UpdateLastSeen updates the user's last seen timestamp. It leaves
+// updated_at alone, as being seen does not change the user.
+func (r *userRepository) UpdateLastSeen(ctx context.Context, userID uuid.UUID, at time.Time) error {
+	return r.DB.WithContext(ctx).Model(&models.User{}).Where("id = ?", userID).UpdateColumn("last_seen_at", at).Error
 }
 
 // VerifyEmail marks the user's email as verified
 func (r *userRepository) VerifyEmail(ctx context.Context, userID uuid.UUID) error {
-	return r.DB.WithContext(ctx).Model(&models.User{}).Where("id = ?", userID).Update("email_verified_at", gorm.Expr("NOW()")).Error
+	return r.DB.WithContext(ctx).Model(&models.User{}).Where("id = ?", userID).Update("email_verified_at", database.Now(r.DB)).Error
 }
 
 // UpdatePassword updates the user's password
@@ -113,7 +133,7 @@ func (r *userRepository) SearchUsers(ctx context.Context, query string, page, pa
 
 	// Count total using scope
 	err := r.DB.WithContext(ctx).Model(&models.User{}).
-		Scopes(database.Search(searchFields, query)).
+		Scopes(humanUsers, database.Search(searchFields, query)).
 		Count(&total).Error
 	if err != nil {
 		return nil, 0, err
@@ -122,13 +142,192 @@ func (r *userRepository) SearchUsers(ctx context.Context, query string, page, pa
 	// Get paginated results using scope
 	offset := (page - 1) * pageSize
 	err = r.DB.WithContext(ctx).
-		Scopes(database.Search(searchFields, query)).
+		Scopes(humanUsers, database.Search(searchFields, query)).
+		Offset(offset).Limit(pageSize).
+		Find(&users).Error
+
+	return users, total, err
+}
+
+// FindAll overrides base to exclude service accounts from user listings
+func (r *userRepository) FindAll(ctx context.Context, page, pageSize int) ([]models.User, int64, error) {
+	var users []models.User
+	var total int64
+
+	if err := r.DB.WithContext(ctx).Model(&models.User{}).Scopes(humanUsers).Count(&total).Error; err != nil {
+		return nil, 0, err
+	}
+
+	offset := (page - 1) * pageSize
+	err := r.DB.WithContext(ctx).
+		Scopes(humanUsers).
+		Offset(offset).Limit(pageSize).
+		Find(&users).Error
+
+	return users, total, err
+}
+
+// FindFiltered finds the users matching a filter, in the filter's order or
+// by creation date, excluding service accounts
+func (r *userRepository) FindFiltered(ctx context.Context, filter *models.UserFilter, page, pageSize int) ([]models.User, int64, error) {
+	var users []models.User
+	var total int64
+
+	var from, to interface{}
+	if filter.CreatedFrom != nil {
+		from = *filter.CreatedFrom
+	}
+	if filter.CreatedTo != nil {
+		to = *filter.CreatedTo
+	}
+	scopes := []func(*gorm.DB) *gorm.DB{
+		humanUsers,
+		database.Role(string(filter.Role)),
+		database.Status(string(filter.Status)),
+		database.IsSet("email_verified_at", filter.EmailVerified),
+		database.DateRange("created_at", from, to),
+	}
+
+	if err := r.DB.WithContext(ctx).Model(&models.User{}).Scopes(scopes...).Count(&total).Error; err != nil {
+		return nil, 0, err
+	}
+
+	sort := filter.Sort
+	if sort == "" {
+		sort = "created_at"
+	}
+	offset := (page - 1) * pageSize
+	err := r.DB.WithContext(ctx).
+		Scopes(scopes...).
+		Scopes(database.Sort(sort, models.UserSortFields)).
 		Offset(offset).Limit(pageSize).
 		Find(&users).Error
 
 	return users, total, err
 }
 
+// FindServiceAccounts finds all service account users
+func (r *userRepository) FindServiceAccounts(ctx context.Context, page, pageSize int) ([]models.User, int64, error) {
+	var users []models.User
+	var total int64
+
+	query := r.DB.WithContext(ctx).Model(&models.User{}).Where("type = ?", models.UserTypeService)
+	if err := query.Count(&total).Error; err != nil {
+		return nil, 0, err
+	}
+
+	offset := (page - 1) * pageSize
+	err := r.DB.WithContext(ctx).
+		Where("type = ?", models.UserTypeService).
+		Order("created_at DESC").
+		Offset(offset).Limit(pageSize).
+		Find(&users).Error
+
+	return users, total, err
+}
+
+// FindByIDs finds users by IDs
+func (r *userRepository) FindByIDs(ctx context.Context, ids []uuid.UUID) ([]models.User, error) {
+	var users []models.User
+	if len(ids) == 0 {
+		return users, nil
+	}
+	err := r.DB.WithContext(ctx).Where("id IN ?", ids).Find(&users).Error
+	return users, err
+}
+
+// DeleteMany soft deletes several users at once
+func (r *userRepository) DeleteMany(ctx context.Context, ids []uuid.UUID) (int64, error) {
+	result := r.DB.WithContext(ctx).Where("id IN ?", ids).Delete(&models.User{})
+	return result.RowsAffected, result.Error
+}
+
+// UpdateRoleMany updates the role of several users at once
+func (r *userRepository) UpdateRoleMany(ctx context.Context, ids []uuid.UUID, role models.UserRole) (int64, error) {
+	result := r.DB.WithContext(ctx).Model(&models.User{}).Where("id IN ?", ids).Update("role", role)
+	return result.RowsAffected, result.Error
+}
+
+// UpdateStatusMany updates the status of several users at once
+func (r *userRepository) UpdateStatusMany(ctx context.Context, ids []uuid.UUID, status models.UserStatus) (int64, error) {
+	result := r.DB.WithContext(ctx).Model(&models.User{}).Where("id IN ?", ids).Update("status", status)
+	return result.RowsAffected, result.Error
+}
+
+// FindLastActiveBefore finds active users who have not logged in (or, if they
+// never logged in, registered) since before and have not been sent a dormancy notice
+func (r *userRepository) FindLastActiveBefore(ctx context.Context, before time.Time, limit int) ([]models.User, error) {
+	var users []models.User
+	err := r.DB.WithContext(ctx).
+		Scopes(humanUsers).
+		Where("status IN ? AND dormancy_notified_at IS NULL AND deletion_requested_at IS NULL", activeStatuses).
+		Where("COALESCE(last_login_at, created_at) < ?", before).
+		Order("created_at ASC").
+		Limit(limit).
+		Find(&users).Error
+	return users, err
+}
+
+// FindDormancyNotifiedBefore finds active users who were sent a dormancy notice before the given time
+func (r *userRepository) FindDormancyNotifiedBefore(ctx context.Context, before time.Time, limit int) ([]models.User, error) {
+	var users []models.User
+	err := r.DB.WithContext(ctx).
+		Scopes(humanUsers).
+		Where("status IN ? AND dormancy_notified_at < ? AND deletion_requested_at IS NULL", activeStatuses, before).
+		Order("dormancy_notified_at ASC").
+		Limit(limit).
+		Find(&users).Error
+	return users, err
+}
+
+// FindDormantBefore finds users deactivated for dormancy before the given time
+// who are not yet anonymized. Users under legal hold are never returned.
+func (r *userRepository) FindDormantBefore(ctx context.Context, before time.Time, limit int) ([]models.User, error) {
+	var users []models.User
+	err := r.DB.WithContext(ctx).
+		Scopes(humanUsers).
+		Where("status = ? AND dormant_since < ? AND anonymized_at IS NULL", models.StatusInactive, before).
+		Where("legal_hold_at IS NULL").
+		Order("dormant_since ASC").
+		Limit(limit).
+		Find(&users).Error
+	return users, err
+}
+
+// ClearDormancyNotice resets the dormancy notice of a user who became active again
+func (r *userRepository) ClearDormancyNotice(ctx context.Context, userID uuid.UUID) error {
+	return r.DB.WithContext(ctx).Model(&models.User{}).Where("id = ?", userID).Update("dormancy_notified_at", nil).Error
+}
+
+// FindDeletionRequestedBefore finds users who asked before the given time for
+// their account to be deleted and are not yet anonymized. Users under legal
+// hold are never returned.
+func (r *userRepository) FindDeletionRequestedBefore(ctx context.Context, before time.Time, limit int) ([]models.User, error) {
+	var users []models.User
+	err := r.DB.WithContext(ctx).
+		Where("deletion_requested_at < ? AND anonymized_at IS NULL", before).
+		Where("legal_hold_at IS NULL").
+		Order("deletion_requested_at ASC").
+		Limit(limit).
+		Find(&users).Error
+	return users, err
+}
+
+// CancelDeletion withdraws the pending deletion of a user's account
+func (r *userRepository) CancelDeletion(ctx context.Context, userID uuid.UUID) error {
+	return r.DB.WithContext(ctx).Model(&models.User{}).
+		Where("id = ? AND anonymized_at IS NULL", userID).
+		Updates(map[string]interface{}{"deletion_requested_at": nil, "delete_posts_on_erasure": false}).Error
+}
+
+// activeStatuses are the statuses of users who can use their account
+var activeStatuses = []models.UserStatus{models.StatusActive, models.StatusShadowBanned}
+
+// humanUsers excludes service accounts from a query
+func humanUsers(db *gorm.DB) *gorm.DB {
+	return db.Where("type <> ?", models.UserTypeService)
+}
+
 // FindByID overrides base to include error handling
 func (r *userRepository) FindByID(ctx context.Context, id uuid.UUID) (*models.User, error) {
 	var user models.User
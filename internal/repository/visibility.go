@@ -0,0 +1,41 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/yourusername/go-enterprise-api/internal/models"
+	"gorm.io/gorm"
+)
+
+// viewerKey is the request context key holding the user content is fetched for
+type viewerKey struct{}
+
+// WithViewer returns a copy of ctx in which content is fetched on behalf of
+// viewer, so listings include what only that viewer may see
+func WithViewer(ctx context.Context, viewer *models.User) context.Context {
+	return context.WithValue(ctx, viewerKey{}, viewer)
+}
+
+// ViewerFromContext returns the user carried by ctx or nil for anonymous
+// requests and background work
+func ViewerFromContext(ctx context.Context) *models.User {
+	viewer, _ := ctx.Value(viewerKey{}).(*models.User)
+	return viewer
+}
+
+// visibleTo hides shadowed posts or comments from everyone but their
+// authors, admins and moderators. The viewer is taken from ctx; without one,
+// all shadowed content is hidden.
+func visibleTo(ctx context.Context) func(db *gorm.DB) *gorm.DB {
+	viewer := ViewerFromContext(ctx)
+	return func(db *gorm.DB) *gorm.DB {
+		switch {
+		case viewer == nil:
+			return db.Where("shadowed = ?", false)
+		case viewer.IsAdmin() || viewer.IsModerator():
+			return db
+		default:
+			return db.Where("shadowed = ? OR user_id = ?", false, viewer.ID)
+		}
+	}
+}
@@ -0,0 +1,168 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/yourusername/go-enterprise-api/internal/models"
+	apperrors "github.com/yourusername/go-enterprise-api/pkg/errors"
+	"gorm.io/gorm"
+)
+
+// WebhookRepository interface defines webhook and delivery repository methods
+type WebhookRepository interface {
+	Repository[models.Webhook]
+	FindByUser(ctx context.Context, userID uuid.UUID, page, pageSize int) ([]models.Webhook, int64, error)
+	FindAllWebhooks(ctx context.Context, page, pageSize int) ([]models.Webhook, int64, error)
+	FindSubscribed(ctx context.Context, event models.WebhookEvent, ownerID *uuid.UUID) ([]models.Webhook, error)
+	CreateDelivery(ctx context.Context, delivery *models.WebhookDelivery) error
+	UpdateDelivery(ctx context.Context, delivery *models.WebhookDelivery) error
+	FindDelivery(ctx context.Context, id uuid.UUID) (*models.WebhookDelivery, error)
+	FindDeliveries(ctx context.Context, webhookID uuid.UUID, page, pageSize int) ([]models.WebhookDelivery, int64, error)
+	CountReplayable(ctx context.Context, webhookID uuid.UUID, from, to time.Time, statuses []models.WebhookDeliveryStatus) (int64, error)
+	EachReplayable(ctx context.Context, webhookID uuid.UUID, from, to time.Time, statuses []models.WebhookDeliveryStatus, batchSize int, fn func(deliveries []models.WebhookDelivery) error) error
+	DeleteFinishedDeliveriesBefore(ctx context.Context, before time.Time) (int64, error)
+}
+
+// webhookRepository implements WebhookRepository
+type webhookRepository struct {
+	*BaseRepository[models.Webhook]
+}
+
+// NewWebhookRepository creates a new webhook repository
+func NewWebhookRepository(db *gorm.DB) WebhookRepository {
+	return &webhookRepository{
+		BaseRepository: NewBaseRepository[models.Webhook](db),
+	}
+}
+
+// FindByID overrides base to report missing webhooks
+func (r *webhookRepository) FindByID(ctx context.Context, id uuid.UUID) (*models.Webhook, error) {
+	var webhook models.Webhook
+	err := r.DB.WithContext(ctx).First(&webhook, "id = ?", id).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, apperrors.ErrNotFound.WithDetails("Webhook not found")
+		}
+		return nil, err
+	}
+	return &webhook, nil
+}
+
+// FindByUser finds a user's webhooks, newest first
+func (r *webhookRepository) FindByUser(ctx context.Context, userID uuid.UUID, page, pageSize int) ([]models.Webhook, int64, error) {
+	return r.paginate(r.DB.WithContext(ctx).Model(&models.Webhook{}).Where("user_id = ?", userID), page, pageSize)
+}
+
+// FindAllWebhooks finds every user's webhooks, newest first
+func (r *webhookRepository) FindAllWebhooks(ctx context.Context, page, pageSize int) ([]models.Webhook, int64, error) {
+	return r.paginate(r.DB.WithContext(ctx).Model(&models.Webhook{}), page, pageSize)
+}
+
+func (r *webhookRepository) paginate(query *gorm.DB, page, pageSize int) ([]models.Webhook, int64, error) {
+	var webhooks []models.Webhook
+	var total int64
+
+	if err := query.Count(&total).Error; err != nil {
+		return nil, 0, err
+	}
+
+	offset := (page - 1) * pageSize
+	err := query.Order("created_at DESC").Offset(offset).Limit(pageSize).Find(&webhooks).Error
+	return webhooks, total, err
+}
+
+// FindSubscribed finds the active webhooks subscribed to an event: global
+// webhooks, and the webhooks of the user the event concerns when ownerID is
+// set
+func (r *webhookRepository) FindSubscribed(ctx context.Context, event models.WebhookEvent, ownerID *uuid.UUID) ([]models.Webhook, error) {
+	var webhooks []models.Webhook
+
+	query := r.DB.WithContext(ctx).
+		Where("active = ?", true).
+		Where("(',' || events || ',') LIKE ?", "%,"+string(event)+",%")
+	if ownerID != nil {
+		query = query.Where("global = ? OR user_id = ?", true, *ownerID)
+	} else {
+		query = query.Where("global = ?", true)
+	}
+
+	err := query.Find(&webhooks).Error
+	return webhooks, err
+}
+
+// CreateDelivery creates a webhook delivery
+func (r *webhookRepository) CreateDelivery(ctx context.Context, delivery *models.WebhookDelivery) error {
+	return r.DB.WithContext(ctx).Omit("Webhook").Create(delivery).Error
+}
+
+// UpdateDelivery saves a webhook delivery
+func (r *webhookRepository) UpdateDelivery(ctx context.Context, delivery *models.WebhookDelivery) error {
+	return r.DB.WithContext(ctx).Omit("Webhook").Save(delivery).Error
+}
+
+// FindDelivery finds a webhook delivery along with its webhook
+func (r *webhookRepository) FindDelivery(ctx context.Context, id uuid.UUID) (*models.WebhookDelivery, error) {
+	var delivery models.WebhookDelivery
+	err := r.DB.WithContext(ctx).Preload("Webhook").First(&delivery, "id = ?", id).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, apperrors.ErrNotFound.WithDetails("Delivery not found")
+		}
+		return nil, err
+	}
+	return &delivery, nil
+}
+
+// FindDeliveries finds a webhook's deliveries, newest first
+func (r *webhookRepository) FindDeliveries(ctx context.Context, webhookID uuid.UUID, page, pageSize int) ([]models.WebhookDelivery, int64, error) {
+	var deliveries []models.WebhookDelivery
+	var total int64
+
+	query := r.DB.WithContext(ctx).Model(&models.WebhookDelivery{}).Where("webhook_id = ?", webhookID)
+	if err := query.Count(&total).Error; err != nil {
+		return nil, 0, err
+	}
+
+	offset := (page - 1) * pageSize
+	err := query.Order("created_at DESC").Offset(offset).Limit(pageSize).Find(&deliveries).Error
+	return deliveries, total, err
+}
+
+// replayable selects a webhook's original deliveries, leaving out
+// redeliveries, created in [from, to) with one of the given statuses
+func (r *webhookRepository) replayable(ctx context.Context, webhookID uuid.UUID, from, to time.Time, statuses []models.WebhookDeliveryStatus) *gorm.DB {
+	return r.DB.WithContext(ctx).Model(&models.WebhookDelivery{}).
+		Where("webhook_id = ? AND redelivery_of IS NULL", webhookID).
+		Where("created_at >= ? AND created_at < ?", from, to).
+		Where("status IN ?", statuses)
+}
+
+// CountReplayable counts the deliveries a replay of a time range would send again
+func (r *webhookRepository) CountReplayable(ctx context.Context, webhookID uuid.UUID, from, to time.Time, statuses []models.WebhookDeliveryStatus) (int64, error) {
+	var count int64
+	err := r.replayable(ctx, webhookID, from, to, statuses).Count(&count).Error
+	return count, err
+}
+
+// EachReplayable iterates in batches over the deliveries a replay of a time
+// range sends again
+func (r *webhookRepository) EachReplayable(ctx context.Context, webhookID uuid.UUID, from, to time.Time, statuses []models.WebhookDeliveryStatus, batchSize int, fn func(deliveries []models.WebhookDelivery) error) error {
+	var deliveries []models.WebhookDelivery
+	return r.replayable(ctx, webhookID, from, to, statuses).
+		FindInBatches(&deliveries, batchSize, func(tx *gorm.DB, batch int) error {
+			return fn(deliveries)
+		}).Error
+}
+
+// DeleteFinishedDeliveriesBefore permanently deletes succeeded and failed
+// deliveries created before the given time and returns how many were deleted
+func (r *webhookRepository) DeleteFinishedDeliveriesBefore(ctx context.Context, before time.Time) (int64, error) {
+	result := r.DB.WithContext(ctx).Unscoped().
+		Where("status IN ? AND created_at < ?",
+			[]models.WebhookDeliveryStatus{models.WebhookDeliverySucceeded, models.WebhookDeliveryFailed}, before).
+		Delete(&models.WebhookDelivery{})
+	return result.RowsAffected, result.Error
+}
@@ -1,50 +1,442 @@
 package routes
 
 import (
+	"context"
 	"time"
 
 	"github.com/gin-gonic/gin"
+	"github.com/gin-gonic/gin/binding"
+	"github.com/google/uuid"
+	"github.com/yourusername/go-enterprise-api/docs"
+	"github.com/yourusername/go-enterprise-api/internal/avatar"
+	"github.com/yourusername/go-enterprise-api/internal/backfill"
 	"github.com/yourusername/go-enterprise-api/internal/config"
 	"github.com/yourusername/go-enterprise-api/internal/database"
+	"github.com/yourusername/go-enterprise-api/internal/events"
+	"github.com/yourusername/go-enterprise-api/internal/geoip"
+	"github.com/yourusername/go-enterprise-api/internal/graph"
 	"github.com/yourusername/go-enterprise-api/internal/handlers"
+	"github.com/yourusername/go-enterprise-api/internal/health"
+	"github.com/yourusername/go-enterprise-api/internal/imageproxy"
+	"github.com/yourusername/go-enterprise-api/internal/jobs"
+	"github.com/yourusername/go-enterprise-api/internal/lifecycle"
+	"github.com/yourusername/go-enterprise-api/internal/mailer"
+	"github.com/yourusername/go-enterprise-api/internal/malware"
 	"github.com/yourusername/go-enterprise-api/internal/middleware"
+	"github.com/yourusername/go-enterprise-api/internal/models"
+	"github.com/yourusername/go-enterprise-api/internal/realtime"
 	"github.com/yourusername/go-enterprise-api/internal/repository"
+	"github.com/yourusername/go-enterprise-api/internal/search"
 	"github.com/yourusername/go-enterprise-api/internal/services"
+	"github.com/yourusername/go-enterprise-api/internal/spam"
+	"github.com/yourusername/go-enterprise-api/internal/storage"
+	"github.com/yourusername/go-enterprise-api/pkg/logger"
+	"github.com/yourusername/go-enterprise-api/pkg/metrics"
+	"github.com/yourusername/go-enterprise-api/pkg/version"
 )
 
-// Setup configures all routes
-func Setup(cfg *config.Config, db *database.Database) *gin.Engine {
+// Setup configures all routes. The background components it starts are
+// registered with lc, to be stopped when the server shuts down.
+func Setup(cfg *config.Config, db *database.Database, lc *lifecycle.Manager) *gin.Engine {
 	// Set Gin mode based on environment
 	if cfg.IsProduction() {
 		gin.SetMode(gin.ReleaseMode)
 	}
 
+	// Reject unknown JSON fields in request bodies when strict mode is enabled
+	binding.EnableDecoderDisallowUnknownFields = cfg.App.StrictJSON
+
 	// Create router
 	router := gin.New()
 
 	// Global middleware
 	router.Use(middleware.Recovery())
-	router.Use(middleware.RequestLogger())
+	router.Use(middleware.RequestLogger(&cfg.Log))
 	router.Use(middleware.CORS(&cfg.CORS))
-	router.Use(middleware.RateLimit(cfg.RateLimit.Requests, cfg.RateLimit.Duration))
+	router.Use(middleware.Language())
+
+	// Give requests a deadline, cancelling their queries once it passes.
+	// Exports, imports and uploads get longer; WebSocket connections stay
+	// open.
+	if cfg.RequestTimeout.Enabled {
+		export := cfg.RequestTimeout.Export
+		router.Use(middleware.Timeout(cfg.RequestTimeout.Read, cfg.RequestTimeout.Write, map[string]time.Duration{
+			"/api/v1/posts/export":              export,
+			"/api/v1/users/me/analytics/export": export,
+			"/api/v1/users/me/avatar":           export,
+			"/api/v1/media":                     export,
+			"/api/v1/media/uploads/:id":         export,
+			"/api/v1/admin/import":              export,
+			"/api/v1/admin/site/export":         export,
+			"/api/v1/admin/site/import":         export,
+			"/api/v1/ws":                        0,
+		}))
+	}
+	if cfg.Metrics.Enabled {
+		router.Use(middleware.Metrics())
+	}
+	// With read replicas, keep reads on the primary after a request writes
+	if len(cfg.Database.ReplicaHosts) > 0 {
+		router.Use(middleware.ReadYourWrites())
+	}
+
+	// Tag requests with the client's country when GeoIP resolution is enabled
+	geoResolver, err := geoip.New(&cfg.GeoIP)
+	if err != nil {
+		logger.Fatal("Failed to initialize GeoIP resolver", logger.Err(err))
+	}
+	if geoResolver != nil {
+		router.Use(middleware.GeoIP(geoResolver))
+	}
 
 	// Initialize repositories
 	userRepo := repository.NewUserRepository(db.DB)
 	postRepo := repository.NewPostRepository(db.DB)
+	apiKeyRepo := repository.NewAPIKeyRepository(db.DB)
+	changeRepo := repository.NewChangeRepository(db.DB)
+	slugRedirectRepo := repository.NewSlugRedirectRepository(db.DB)
+	operationRepo := repository.NewOperationRepository(db.DB)
+	analyticsRepo := repository.NewAnalyticsRepository(db.DB)
+	tagRepo := repository.NewTagRepository(db.DB)
+	backfillRepo := repository.NewBackfillRepository(db.DB)
+	commentRepo := repository.NewCommentRepository(db.DB)
+	followRepo := repository.NewFollowRepository(db.DB)
+	notificationRepo := repository.NewNotificationRepository(db.DB)
+	appealRepo := repository.NewAppealRepository(db.DB)
+	webhookRepo := repository.NewWebhookRepository(db.DB)
+	jobRepo := repository.NewJobRepository(db.DB)
+	siteRepo := repository.NewSiteRepository(db.DB)
+	readKeyRepo := repository.NewReadKeyRepository(db.DB)
+	brandingRepo := repository.NewBrandingRepository(db.DB)
+	idempotencyRepo := repository.NewIdempotencyRepository(db.DB)
+	adminSearchRepo := repository.NewAdminSearchRepository(db.DB)
+	orgRepo := repository.NewOrganizationRepository(db.DB)
+	mediaRepo := repository.NewMediaRepository(db.DB)
+	mediaUploadRepo := repository.NewMediaUploadRepository(db.DB)
+	policyRepo := repository.NewPolicyRepository(db.DB)
+
+	// Initialize search backend (nil means database search)
+	indexer, err := search.New(&cfg.Search)
+	if err != nil {
+		logger.Fatal("Failed to initialize search backend", logger.Err(err))
+	}
+
+	// Initialize spam checks (nil means comments are not checked)
+	spamChecker, err := spam.New(&cfg.Spam)
+	if err != nil {
+		logger.Fatal("Failed to initialize spam checker", logger.Err(err))
+	}
+
+	// Initialize malware scanning of uploads (nil means uploads are not scanned)
+	malwareScanner, err := malware.New(&cfg.Malware)
+	if err != nil {
+		logger.Fatal("Failed to initialize malware scanner", logger.Err(err))
+	}
+
+	// Initialize email delivery (nil means no email is sent)
+	mail, err := mailer.New(&cfg.Mail, cfg.App.Name)
+	if err != nil {
+		logger.Fatal("Failed to initialize mailer", logger.Err(err))
+	}
+
+	// Initialize storage of uploaded media and generated files such as the
+	// static site
+	store, err := storage.New(&cfg.Storage)
+	if err != nil {
+		logger.Fatal("Failed to initialize storage", logger.Err(err))
+	}
+
+	// Domain events are published by services and handled in the background
+	// by the consumers subscribed below
+	bus := events.New()
+
+	// Background work such as email, webhook delivery and search indexing is
+	// queued as jobs and run by the workers started below
+	queue := jobs.New(jobRepo, &cfg.Jobs)
 
 	// Initialize services
-	authService := services.NewAuthService(userRepo, cfg)
-	userService := services.NewUserService(userRepo)
-	postService := services.NewPostService(postRepo)
+	webhookService := services.NewWebhookService(webhookRepo, queue, &cfg.Webhooks)
+	authService := services.NewAuthService(userRepo, apiKeyRepo, bus, cfg)
+	avatarService := services.NewAvatarService(userRepo, changeRepo, store, &cfg.Avatar, &cfg.Media)
+	userService := services.NewUserService(userRepo, postRepo, changeRepo, avatarService, cfg.Age.AdultAge, cfg.Presence.OnlineWindow)
+	tagService := services.NewTagService(tagRepo, postRepo, indexer)
+	postService := services.NewPostService(postRepo, changeRepo, slugRedirectRepo, tagRepo, indexer, bus, queue, cfg.Posts.MaxProfilePins, cfg.Posts.DefaultLicense, cfg.Posts.RequireAltText)
+	serviceAccountService := services.NewServiceAccountService(userRepo, apiKeyRepo)
+	operationService := services.NewOperationService(operationRepo, userRepo, cfg.Admin.UndoRetention)
+	analyticsService := services.NewAnalyticsService(analyticsRepo, postRepo, readKeyRepo)
+	previewService := services.NewPreviewService(postRepo, cfg)
+	legalHoldService := services.NewLegalHoldService(userRepo, postRepo, changeRepo)
+	accountDeletionService := services.NewAccountDeletionService(userRepo, postRepo, changeRepo, avatarService, bus, &cfg.AccountDeletion)
+	importService := services.NewImportService(userRepo, postRepo, tagRepo, indexer)
+	backfillService := services.NewBackfillService(backfillRepo, backfill.DefaultJobs(db.DB, indexer), &cfg.Backfill)
+	notificationService := services.NewNotificationService(notificationRepo, mail, queue, bus)
+	dormancyService := services.NewDormancyService(userRepo, changeRepo, avatarService, notificationService, &cfg.Dormancy)
+	takedownService := services.NewTakedownService(postRepo, changeRepo, notificationService, indexer)
+	appealService := services.NewAppealService(appealRepo, postRepo, userRepo, changeRepo, takedownService, notificationService)
+	commentService := services.NewCommentService(commentRepo, postRepo, spamChecker, notificationService, bus, &cfg.Comments, cfg.Age.AdultAge)
+	followService := services.NewFollowService(followRepo, userRepo, notificationService, cfg.Age.AdultAge)
+	jobService := services.NewJobService(jobRepo, queue)
+	siteArchiveService := services.NewSiteArchiveService(siteRepo, indexer, queue)
+	readKeyService := services.NewReadKeyService(readKeyRepo)
+	brandingService := services.NewBrandingService(brandingRepo, cfg.App.Name)
+	idempotencyService := services.NewIdempotencyService(idempotencyRepo, &cfg.Idempotency)
+	adminSearchService := services.NewAdminSearchService(adminSearchRepo)
+	staticSiteService := services.NewStaticSiteService(postRepo, store, queue, brandingService, &cfg.StaticSite)
+	orgService := services.NewOrganizationService(orgRepo, userRepo)
+	mediaService := services.NewMediaService(mediaRepo, store, malwareScanner, bus, &cfg.Media, cfg.JWTSecret)
+	mediaUploadService := services.NewMediaUploadService(mediaUploadRepo, mediaService, store, &cfg.Media)
+	policyService := services.NewPolicyService(policyRepo)
+	invitationService := services.NewInvitationService(userRepo, changeRepo, notificationService, cfg)
+
+	// Emails are rendered with the site's branding
+	if mail != nil {
+		mail.UseBranding(brandingService)
+	}
+
+	// Requests are counted in a store shared by all rate limits
+	rateLimitStore, err := middleware.NewRateLimitStore(&cfg.RateLimit)
+	if err != nil {
+		logger.Fatal("Failed to initialize rate limit store", logger.Err(err))
+	}
+
+	// Anonymous consumers identified by a read key are rate limited by the
+	// key's tier; everyone else by client IP or user
+	router.Use(middleware.ReadKeyMiddleware(readKeyService, rateLimitStore, map[models.ReadKeyTier]int{
+		models.ReadKeyFree:    cfg.ReadKeys.FreeLimit,
+		models.ReadKeyPartner: cfg.ReadKeys.PartnerLimit,
+	}, cfg.RateLimit.Duration))
+	router.Use(middleware.RateLimit(rateLimitStore, cfg.RateLimit.Requests, cfg.RateLimit.Duration))
+	if len(cfg.GeoIP.RateLimits) > 0 {
+		router.Use(middleware.GeoRateLimit(rateLimitStore, cfg.GeoIP.RateLimits, cfg.RateLimit.Duration))
+	}
+	router.Use(middleware.TimeCodec(&cfg.App))
+
+	// Scope content to the organization a request names. Admin routes work
+	// across organizations.
+	if cfg.Tenancy.Enabled {
+		router.Use(middleware.Tenant(orgService, &cfg.Tenancy, "/api/v1/admin/"))
+	}
+
+	// Reject mutating requests while read-only mode is on. Admins can still
+	// sign in and turn it off again. The GraphQL schema only has queries.
+	readOnlyMode := middleware.NewReadOnlyMode(cfg.App.ReadOnly)
+	router.Use(middleware.ReadOnly(readOnlyMode,
+		"/api/v1/auth/login",
+		"/api/v1/auth/refresh",
+		"/api/v1/auth/logout",
+		"/api/v1/admin/read-only",
+		"/api/v1/graphql",
+		"/api/v1/admin/debug/",
+		"/api/v1/admin/log-level",
+	))
+
+	// Replay the stored response to POST requests retried with the same
+	// Idempotency-Key instead of processing them again
+	if cfg.Idempotency.Enabled {
+		router.Use(middleware.Idempotency(idempotencyService))
+	}
+
+	// Subscribe event consumers
+	webhookService.Subscribe(bus)
+	notificationService.Subscribe(bus)
+	services.SubscribeSearchIndex(bus, queue, postRepo, indexer)
+	services.SubscribeAuditLog(bus, changeRepo)
+	services.SubscribeMetrics(bus)
+
+	// Push new comments and notifications to WebSocket clients
+	var hub *realtime.Hub
+	if cfg.WebSocket.Enabled {
+		hub = realtime.NewHub(realtime.Options{
+			AllowedOrigins:   cfg.CORS.AllowedOrigins,
+			PingInterval:     cfg.WebSocket.PingInterval,
+			MessageRate:      cfg.WebSocket.MessageRate,
+			MaxSubscriptions: cfg.WebSocket.MaxSubscriptions,
+			Authorize: func(ctx context.Context, user *models.User, postID uuid.UUID) error {
+				return commentService.CheckReadable(ctx, postID, user)
+			},
+		})
+		services.SubscribeRealtime(bus, hub, notificationRepo, cfg.Age.AdultAge)
+	}
+
+	// Register job handlers and start the workers
+	webhookService.RegisterJobs(queue)
+	notificationService.RegisterJobs(queue)
+	postService.RegisterJobs(queue)
+	analyticsService.RegisterJobs(queue)
+	staticSiteService.RegisterJobs(queue)
+	idempotencyService.RegisterJobs(queue)
+	mediaUploadService.RegisterJobs(queue)
+	queue.Every(services.JobRollUpViews, cfg.Jobs.ViewRollupInterval)
+	queue.Every(services.JobWebhookPurge, time.Hour)
+	queue.Every(services.JobMediaUploadPurge, time.Hour)
+	if cfg.Idempotency.Enabled {
+		queue.Every(services.JobIdempotencyPurge, time.Hour)
+	}
+
+	// Stop the background components on shutdown, newest first: the
+	// account erasure and dormancy schedulers and job workers finish what
+	// they are running, the event bus then delivers the events they
+	// published, and WebSocket clients are disconnected last
+	lc.OnShutdown("rate limit store", func(context.Context) error {
+		return rateLimitStore.Close()
+	})
+	if hub != nil {
+		lc.OnShutdown("websocket hub", hub.Close)
+	}
+	lc.OnShutdown("event bus", bus.Close)
+	lc.Go("job workers", queue.Run)
+
+	// Apply the dormant account policy in the background when enabled
+	if cfg.Dormancy.Enabled {
+		lc.Go("dormancy scheduler", dormancyService.Schedule)
+	}
+
+	// Erase the accounts whose deletion grace period has passed
+	lc.Go("account erasure scheduler", accountDeletionService.Schedule)
+
+	// Readiness checks of the components requests depend on. Only the
+	// database and local disk space are critical: the rate limiter lets
+	// requests through when Redis fails, and jobs, email and search can
+	// catch up once their backend is back.
+	checks := health.New(cfg.Health.CheckTimeout)
+	checks.Register("database", db.HealthCheck)
+	checks.Register("jobs", queue.Ping, health.NonCritical())
+	if cfg.RateLimit.Store == middleware.RateLimitStoreRedis {
+		checks.Register("redis", rateLimitStore.Ping, health.NonCritical())
+	}
+	if mail != nil && cfg.Mail.Driver != mailer.DriverLog {
+		checks.Register("email", mail.Ping, health.NonCritical(), health.CacheFor(time.Minute))
+	}
+	if indexer != nil {
+		checks.Register("search", indexer.Ping, health.NonCritical())
+	}
+	if cfg.Storage.Driver == storage.DriverLocal {
+		checks.Register("disk", health.DiskSpace(cfg.Storage.Path, uint64(cfg.Health.DiskMinFreeMB)<<20))
+	}
+
+	// Avatar URLs set on profiles are served through the image proxy
+	var imageProxy *imageproxy.Proxy
+	if cfg.ImageProxy.Enabled {
+		imageProxy = imageproxy.New(&cfg.ImageProxy)
+	}
 
 	// Initialize handlers
-	authHandler := handlers.NewAuthHandler(authService)
-	userHandler := handlers.NewUserHandler(userService)
-	postHandler := handlers.NewPostHandler(postService)
-	healthHandler := handlers.NewHealthHandler(db)
+	authHandler := handlers.NewAuthHandler(authService, policyService)
+	userHandler := handlers.NewUserHandler(userService, avatarService, avatar.NewGenerator(&cfg.Avatar), imageProxy, cfg.Media.MaxSize)
+	postHandler := handlers.NewPostHandler(postService, analyticsService, previewService, cfg.Age.AdultAge)
+	healthHandler := handlers.NewHealthHandler(db, checks)
+	serviceAccountHandler := handlers.NewServiceAccountHandler(serviceAccountService)
+	operationHandler := handlers.NewOperationHandler(operationService)
+	metaHandler := handlers.NewMetaHandler(cfg, readOnlyMode)
+	analyticsHandler := handlers.NewAnalyticsHandler(analyticsService)
+	dormancyHandler := handlers.NewDormancyHandler(dormancyService)
+	accountDeletionHandler := handlers.NewAccountDeletionHandler(accountDeletionService)
+	legalHoldHandler := handlers.NewLegalHoldHandler(legalHoldService)
+	importHandler := handlers.NewImportHandler(importService, cfg.App.MaxUploadSize)
+	backfillHandler := handlers.NewBackfillHandler(backfillService)
+	commentHandler := handlers.NewCommentHandler(commentService, cfg.Comments.MaxLength)
+	readOnlyHandler := handlers.NewReadOnlyHandler(readOnlyMode)
+	tagHandler := handlers.NewTagHandler(tagService)
+	followHandler := handlers.NewFollowHandler(followService)
+	notificationHandler := handlers.NewNotificationHandler(notificationService)
+	takedownHandler := handlers.NewTakedownHandler(takedownService)
+	appealHandler := handlers.NewAppealHandler(appealService)
+	webhookHandler := handlers.NewWebhookHandler(webhookService)
+	jobHandler := handlers.NewJobHandler(jobService)
+	siteArchiveHandler := handlers.NewSiteArchiveHandler(siteArchiveService, cfg.App.MaxUploadSize)
+	readKeyHandler := handlers.NewReadKeyHandler(readKeyService)
+	staticSiteHandler := handlers.NewStaticSiteHandler(staticSiteService)
+	brandingHandler := handlers.NewBrandingHandler(brandingService)
+	adminSearchHandler := handlers.NewAdminSearchHandler(adminSearchService)
+	organizationHandler := handlers.NewOrganizationHandler(orgService)
+	mediaHandler := handlers.NewMediaHandler(mediaService, mediaUploadService, cfg.Media.MaxSize, cfg.Media.ChunkSize)
+	debugHandler := handlers.NewDebugHandler()
+	logLevelHandler := handlers.NewLogLevelHandler()
+	policyHandler := handlers.NewPolicyHandler(policyService)
+	invitationHandler := handlers.NewInvitationHandler(invitationService)
+
+	// Application metrics for scraping
+	if cfg.Metrics.Enabled {
+		router.GET(cfg.Metrics.Path, middleware.MetricsToken(cfg.Metrics.Token), gin.WrapH(metrics.Handler()))
+	}
+
+	// Public GET responses get an ETag and the Cache-Control header of their
+	// route group. With tenancy, they vary by the organization the request
+	// names in the tenancy header.
+	var cacheVary []string
+	if cfg.Tenancy.Enabled && cfg.Tenancy.Header != "" {
+		cacheVary = append(cacheVary, cfg.Tenancy.Header)
+	}
+	httpCache := func(cacheControl string) gin.HandlerFunc {
+		if !cfg.HTTPCache.Enabled {
+			return func(c *gin.Context) { c.Next() }
+		}
+		return middleware.HTTPCache(cacheControl, cacheVary...)
+	}
 
-	// API version group
-	api := router.Group("/api/v1")
+	// Users who have not accepted the current policies can read but not make
+	// changes. Accepting them, deleting their account and publishing new
+	// versions stay available.
+	authenticated := []gin.HandlerFunc{
+		middleware.AuthMiddleware(authService),
+		middleware.RequirePolicyAcceptance(policyService,
+			"/api/v1/users/me",
+			"/api/v1/admin/policies",
+		),
+	}
+
+	// Only members write the posts of an organization; anyone may read and
+	// comment on them
+	orgMember := func(c *gin.Context) { c.Next() }
+	if cfg.Tenancy.Enabled {
+		orgMember = middleware.RequireOrgMember(orgService)
+	}
+
+	// API version groups. Every response names the version that answered it;
+	// once v1 is deprecated, its responses also carry the Deprecation and
+	// Sunset headers.
+	versionGroup := func(v string, deprecation middleware.Deprecation) *gin.RouterGroup {
+		group := router.Group("/api/"+v, middleware.APIVersion(v))
+		if !deprecation.At.IsZero() {
+			group.Use(middleware.Deprecated(deprecation))
+		}
+		return group
+	}
+	api := versionGroup(version.APIVersion, middleware.Deprecation{
+		At:     cfg.API.V1DeprecatedAt,
+		Sunset: cfg.API.V1SunsetAt,
+		Link:   cfg.API.V1DeprecationLink,
+	})
+
+	// v2 serves the routes whose responses change in breaking ways. A route
+	// moves by registering its new handler here, while v1 keeps the old one,
+	// flagged with middleware.Deprecated when only that route is retired.
+	// Routes not registered here are only served on v1.
+	if cfg.API.V2Enabled {
+		apiV2 := versionGroup(version.APIVersionNext, middleware.Deprecation{})
+		apiV2.GET("/meta", httpCache(cfg.HTTPCache.Meta), metaHandler.Meta)
+		apiV2.GET("/errors", httpCache(cfg.HTTPCache.Meta), metaHandler.Errors)
+		apiV2.GET("/health", healthHandler.Health)
+		apiV2.GET("/health/ready", healthHandler.Ready)
+		apiV2.GET("/health/live", healthHandler.Live)
+	}
+
+	// API metadata (no authentication required)
+	api.GET("/meta", httpCache(cfg.HTTPCache.Meta), metaHandler.Meta)
+	api.GET("/errors", httpCache(cfg.HTTPCache.Meta), metaHandler.Errors)
+	api.GET("/branding", httpCache(cfg.HTTPCache.Meta), brandingHandler.Get)
+
+	// OpenAPI document and Swagger UI, generated from the handlers'
+	// annotations at build time
+	if cfg.Docs.Enabled {
+		if cfg.IsProduction() && cfg.Docs.Password == "" {
+			logger.Warn("API docs are public; set DOCS_PASSWORD or DOCS_ENABLED=false")
+		}
+		docsAuth := middleware.DocsAuth(&cfg.Docs)
+		docsHandler := handlers.NewDocsHandler(docs.OpenAPI, cfg.App.Name, "/api/"+version.APIVersion+"/openapi.json")
+		api.GET("/openapi.json", docsAuth, docsHandler.OpenAPI)
+		router.GET("/docs", docsAuth, docsHandler.UI)
+	}
 
 	// Health routes (no authentication required)
 	healthRoutes := api.Group("/health")
@@ -59,11 +451,12 @@ func Setup(cfg *config.Config, db *database.Database) *gin.Engine {
 	{
 		// Public routes with stricter rate limiting
 		publicAuth := authRoutes.Group("")
-		publicAuth.Use(middleware.StrictRateLimit(10, time.Minute))
+		publicAuth.Use(middleware.StrictRateLimit(rateLimitStore, 10, time.Minute))
 		{
-			publicAuth.POST("/register", authHandler.Register)
+			publicAuth.POST("/register", middleware.BlockCountries(cfg.GeoIP.BlockedRegistration, "Registration is not available in your region"), authHandler.Register)
 			publicAuth.POST("/login", authHandler.Login)
 			publicAuth.POST("/refresh", authHandler.RefreshTokens)
+			publicAuth.POST("/set-password", invitationHandler.SetPassword)
 		}
 
 		// Protected routes
@@ -76,15 +469,60 @@ func Setup(cfg *config.Config, db *database.Database) *gin.Engine {
 		}
 	}
 
+	// Policies are public so they can be read before registering
+	api.GET("/policies", policyHandler.GetCurrent)
+
+	// Policy acceptance routes
+	policyRoutes := api.Group("/policies")
+	policyRoutes.Use(middleware.AuthMiddleware(authService))
+	{
+		policyRoutes.GET("/pending", policyHandler.GetPending)
+		policyRoutes.POST("/accept", policyHandler.Accept)
+	}
+
+	// Avatars are public so they can be used directly in <img> tags
+	api.GET("/users/:id/avatar", userHandler.Avatar)
+
+	// External images are proxied publicly so they can be used in <img> tags
+	if imageProxy != nil {
+		imageProxyHandler := handlers.NewImageProxyHandler(imageProxy)
+		api.GET("/img-proxy", imageProxyHandler.Get)
+	}
+
+	// GraphQL queries run through the same services as the REST endpoints,
+	// on behalf of the authenticated user when there is one
+	if cfg.GraphQL.Enabled {
+		resolver := graph.NewResolver(postService, userService, commentService, tagService, cfg.Age.AdultAge)
+		graphqlHandler := gin.WrapH(graph.NewHandler(resolver, cfg.GraphQL.ComplexityLimit))
+		api.GET("/graphql", middleware.OptionalAuthMiddleware(authService), graphqlHandler)
+		api.POST("/graphql", middleware.OptionalAuthMiddleware(authService), graphqlHandler)
+	}
+
+	// Real-time updates over WebSocket for authenticated users
+	if hub != nil {
+		realtimeHandler := handlers.NewRealtimeHandler(hub)
+		api.GET("/ws", middleware.WebSocketToken(), middleware.AuthMiddleware(authService), realtimeHandler.Connect)
+	}
+
+	// Public profiles can be viewed without logging in
+	api.GET("/users/:id/profile", middleware.OptionalAuthMiddleware(authService), httpCache(cfg.HTTPCache.Profiles), userHandler.GetProfile)
+
 	// User routes
 	userRoutes := api.Group("/users")
-	userRoutes.Use(middleware.AuthMiddleware(authService))
+	userRoutes.Use(authenticated...)
 	{
 		// Standard user routes
 		userRoutes.GET("", userHandler.GetAll)
 		userRoutes.GET("/search", userHandler.Search)
+		userRoutes.GET("/me/analytics/export", analyticsHandler.ExportMine)
+		userRoutes.POST("/me/avatar", userHandler.UploadAvatar)
+		userRoutes.DELETE("/me", accountDeletionHandler.Delete)
 		userRoutes.GET("/:id", userHandler.GetByID)
 		userRoutes.PUT("/:id", userHandler.Update)
+		userRoutes.POST("/:id/follow", followHandler.Follow)
+		userRoutes.DELETE("/:id/follow", followHandler.Unfollow)
+		userRoutes.GET("/:id/followers", followHandler.GetFollowers)
+		userRoutes.GET("/:id/following", followHandler.GetFollowing)
 
 		// Admin only routes
 		adminRoutes := userRoutes.Group("")
@@ -93,6 +531,7 @@ func Setup(cfg *config.Config, db *database.Database) *gin.Engine {
 			adminRoutes.DELETE("/:id", userHandler.Delete)
 			adminRoutes.PATCH("/:id/status", userHandler.UpdateStatus)
 			adminRoutes.PATCH("/:id/role", userHandler.UpdateRole)
+			adminRoutes.GET("/:id/changes", userHandler.GetChanges)
 		}
 	}
 
@@ -100,28 +539,228 @@ func Setup(cfg *config.Config, db *database.Database) *gin.Engine {
 	postRoutes := api.Group("/posts")
 	{
 		// Public routes (with optional auth for viewing drafts)
-		postRoutes.GET("", middleware.OptionalAuthMiddleware(authService), postHandler.GetAll)
-		postRoutes.GET("/search", postHandler.Search)
-		postRoutes.GET("/slug/:slug", middleware.OptionalAuthMiddleware(authService), postHandler.GetBySlug)
-		postRoutes.GET("/:id", middleware.OptionalAuthMiddleware(authService), postHandler.GetByID)
+		publicPosts := postRoutes.Group("")
+		publicPosts.Use(middleware.OptionalAuthMiddleware(authService), httpCache(cfg.HTTPCache.Posts))
+		{
+			publicPosts.GET("", postHandler.GetAll)
+			publicPosts.GET("/search", postHandler.Search)
+			publicPosts.GET("/featured", postHandler.GetFeatured)
+			publicPosts.GET("/slug/:slug", postHandler.GetBySlug)
+			publicPosts.GET("/:id", postHandler.GetByID)
+			publicPosts.GET("/:id/comments", commentHandler.GetByPost)
+		}
 
 		// Protected routes
 		protectedPosts := postRoutes.Group("")
-		protectedPosts.Use(middleware.AuthMiddleware(authService))
+		protectedPosts.Use(authenticated...)
 		{
-			protectedPosts.POST("", postHandler.Create)
+			protectedPosts.POST("", orgMember, postHandler.Create)
 			protectedPosts.GET("/my", postHandler.GetMyPosts)
-			protectedPosts.PUT("/:id", postHandler.Update)
-			protectedPosts.DELETE("/:id", postHandler.Delete)
+			protectedPosts.GET("/my/missing-alt-text", postHandler.GetMissingAltText)
+			protectedPosts.GET("/export", postHandler.Export)
+			protectedPosts.GET("/slug-check", postHandler.CheckSlug)
+			protectedPosts.PUT("/:id", orgMember, postHandler.Update)
+			protectedPosts.DELETE("/:id", orgMember, postHandler.Delete)
+			protectedPosts.GET("/:id/changes", postHandler.GetChanges)
+			protectedPosts.GET("/:id/analytics", analyticsHandler.GetPostAnalytics)
+			protectedPosts.POST("/:id/preview-token", postHandler.CreatePreviewToken)
+			protectedPosts.PATCH("/:id/flags", middleware.RequireAdminOrModerator(), postHandler.UpdateFlags)
+			protectedPosts.POST("/:id/pin-to-profile", postHandler.PinToProfile)
+			protectedPosts.DELETE("/:id/pin-to-profile", postHandler.UnpinFromProfile)
+			protectedPosts.POST("/:id/appeal", appealHandler.AppealTakedown)
+			protectedPosts.POST("/:id/comments", commentHandler.Create)
+		}
+	}
+
+	// Organization routes
+	if cfg.Tenancy.Enabled {
+		organizationRoutes := api.Group("/organizations")
+		organizationRoutes.Use(authenticated...)
+		{
+			organizationRoutes.GET("", organizationHandler.GetMine)
+			organizationRoutes.POST("", organizationHandler.Create)
+			organizationRoutes.GET("/:id", organizationHandler.GetByID)
+			organizationRoutes.PUT("/:id", organizationHandler.Update)
+			organizationRoutes.GET("/:id/members", organizationHandler.GetMembers)
+			organizationRoutes.PUT("/:id/members/:user_id", organizationHandler.SetMember)
+			organizationRoutes.DELETE("/:id/members/:user_id", organizationHandler.RemoveMember)
 		}
 	}
 
+	// Media files are served without authentication so they can be used in
+	// <img> tags; private files need their owner or a signed URL
+	api.GET("/media/:id/file", middleware.OptionalAuthMiddleware(authService), mediaHandler.Serve)
+
+	// Media library routes
+	mediaRoutes := api.Group("/media")
+	mediaRoutes.Use(authenticated...)
+	{
+		mediaRoutes.GET("", mediaHandler.GetMine)
+		mediaRoutes.POST("", mediaHandler.Upload)
+		mediaRoutes.GET("/:id", mediaHandler.GetByID)
+		mediaRoutes.DELETE("/:id", mediaHandler.Delete)
+		mediaRoutes.POST("/uploads", mediaHandler.CreateUpload)
+		mediaRoutes.GET("/uploads/:id", mediaHandler.GetUpload)
+		mediaRoutes.PATCH("/uploads/:id", mediaHandler.AppendUpload)
+		mediaRoutes.POST("/uploads/:id/complete", mediaHandler.CompleteUpload)
+		mediaRoutes.DELETE("/uploads/:id", mediaHandler.AbortUpload)
+	}
+
+	// Webhook routes
+	webhookRoutes := api.Group("/webhooks")
+	webhookRoutes.Use(authenticated...)
+	{
+		webhookRoutes.GET("", webhookHandler.GetMine)
+		webhookRoutes.POST("", webhookHandler.Create)
+		webhookRoutes.GET("/:id", webhookHandler.GetByID)
+		webhookRoutes.PUT("/:id", webhookHandler.Update)
+		webhookRoutes.DELETE("/:id", webhookHandler.Delete)
+		webhookRoutes.GET("/:id/deliveries", webhookHandler.GetDeliveries)
+		webhookRoutes.POST("/:id/redeliver/:delivery_id", webhookHandler.Redeliver)
+		webhookRoutes.POST("/:id/replay", webhookHandler.Replay)
+	}
+
+	// Feed routes
+	api.GET("/feed", middleware.AuthMiddleware(authService), followHandler.GetFeed)
+
+	// Notification routes
+	notificationRoutes := api.Group("/notifications")
+	notificationRoutes.Use(authenticated...)
+	{
+		notificationRoutes.GET("", notificationHandler.GetAll)
+		notificationRoutes.POST("/read-all", notificationHandler.MarkAllRead)
+		notificationRoutes.POST("/:id/read", notificationHandler.MarkRead)
+	}
+
+	// Tag routes
+	api.GET("/tags/:slug/aliases", httpCache(cfg.HTTPCache.Tags), tagHandler.GetSynonyms)
+
+	// Comment routes
+	commentRoutes := api.Group("/comments")
+	commentRoutes.Use(authenticated...)
+	{
+		commentRoutes.DELETE("/:id", commentHandler.Delete)
+	}
+
+	// Anyone may appeal a moderation decision. Banned users cannot log in, so
+	// ban appeals carry credentials and are rate limited like logins.
+	api.POST("/moderation/appeals", middleware.StrictRateLimit(rateLimitStore, 10, time.Minute), middleware.OptionalAuthMiddleware(authService), appealHandler.Create)
+
+	// Moderation routes
+	moderationRoutes := api.Group("/moderation")
+	moderationRoutes.Use(authenticated...)
+	moderationRoutes.Use(middleware.RequireAdminOrModerator())
+	{
+		moderationRoutes.GET("/comments", commentHandler.GetQueue)
+		moderationRoutes.POST("/comments/approve", commentHandler.Approve)
+		moderationRoutes.POST("/comments/spam", commentHandler.MarkSpam)
+	}
+
 	// Admin routes
 	adminRoutes := api.Group("/admin")
-	adminRoutes.Use(middleware.AuthMiddleware(authService))
+	adminRoutes.Use(authenticated...)
 	adminRoutes.Use(middleware.RequireAdmin())
 	{
 		adminRoutes.GET("/health/info", healthHandler.Info)
+		adminRoutes.GET("/read-only", readOnlyHandler.Get)
+		adminRoutes.PUT("/read-only", readOnlyHandler.Set)
+		adminRoutes.GET("/log-level", logLevelHandler.Get)
+		adminRoutes.PUT("/log-level", logLevelHandler.Set)
+		adminRoutes.POST("/search/reindex", postHandler.Reindex)
+
+		// Tag aliases
+		adminRoutes.POST("/tags/:slug/aliases", tagHandler.CreateAlias)
+		adminRoutes.DELETE("/tags/:slug/aliases/:alias", tagHandler.DeleteAlias)
+
+		// Service accounts
+		adminRoutes.GET("/service-accounts", serviceAccountHandler.GetAll)
+		adminRoutes.POST("/service-accounts", serviceAccountHandler.Create)
+		adminRoutes.GET("/service-accounts/:id/keys", serviceAccountHandler.ListAPIKeys)
+		adminRoutes.POST("/service-accounts/:id/keys", serviceAccountHandler.CreateAPIKey)
+		adminRoutes.DELETE("/service-accounts/:id/keys/:key_id", serviceAccountHandler.RevokeAPIKey)
+
+		// Anonymous read keys
+		adminRoutes.GET("/read-keys", readKeyHandler.GetAll)
+		adminRoutes.POST("/read-keys", readKeyHandler.Create)
+		adminRoutes.GET("/read-keys/:id", readKeyHandler.GetByID)
+		adminRoutes.PATCH("/read-keys/:id", readKeyHandler.Update)
+		adminRoutes.DELETE("/read-keys/:id", readKeyHandler.Revoke)
+		adminRoutes.GET("/read-keys/:id/usage", readKeyHandler.GetUsage)
+
+		// Accounts created by admins
+		adminRoutes.POST("/users", invitationHandler.CreateUser)
+		adminRoutes.POST("/users/:id/invite", invitationHandler.Invite)
+
+		// Bulk operations with restore points
+		adminRoutes.POST("/users/bulk-delete", operationHandler.BulkDeleteUsers)
+		adminRoutes.POST("/users/bulk-role", operationHandler.BulkUpdateRole)
+		adminRoutes.POST("/users/bulk", operationHandler.BulkUpdateUsers)
+		adminRoutes.GET("/operations", operationHandler.GetAll)
+		adminRoutes.POST("/operations/:id/undo", operationHandler.Undo)
+
+		// Dormant account policy
+		adminRoutes.POST("/dormancy/run", dormancyHandler.Run)
+
+		// Self-service account deletion
+		adminRoutes.POST("/account-deletions/run", accountDeletionHandler.Run)
+
+		// Terms of service and privacy policy versions
+		adminRoutes.GET("/policies", policyHandler.GetAll)
+		adminRoutes.POST("/policies", policyHandler.Publish)
+
+		// Legal holds
+		adminRoutes.GET("/legal-holds/users/:id", legalHoldHandler.GetUserHold)
+		adminRoutes.PUT("/legal-holds/users/:id", legalHoldHandler.PlaceUserHold)
+		adminRoutes.DELETE("/legal-holds/users/:id", legalHoldHandler.ReleaseUserHold)
+		adminRoutes.GET("/legal-holds/posts/:id", legalHoldHandler.GetPostHold)
+		adminRoutes.PUT("/legal-holds/posts/:id", legalHoldHandler.PlacePostHold)
+		adminRoutes.DELETE("/legal-holds/posts/:id", legalHoldHandler.ReleasePostHold)
+
+		// Content import
+		adminRoutes.POST("/import", importHandler.Import)
+
+		// Site export and import for migrations
+		adminRoutes.GET("/site/export", siteArchiveHandler.Export)
+		adminRoutes.POST("/site/import", siteArchiveHandler.Import)
+		adminRoutes.POST("/site/static-export", staticSiteHandler.Export)
+
+		// Site branding
+		adminRoutes.PUT("/branding", brandingHandler.Update)
+
+		// Search across all records for support investigations
+		adminRoutes.GET("/search", adminSearchHandler.Search)
+
+		// Content takedowns
+		adminRoutes.GET("/takedowns", takedownHandler.GetAll)
+		adminRoutes.POST("/posts/:id/takedown", takedownHandler.TakeDown)
+		adminRoutes.DELETE("/posts/:id/takedown", takedownHandler.Reinstate)
+
+		// Webhooks of all users
+		adminRoutes.GET("/webhooks", webhookHandler.GetAll)
+
+		// Appeals against moderation decisions
+		adminRoutes.GET("/appeals", appealHandler.GetQueue)
+		adminRoutes.GET("/appeals/:id", appealHandler.GetByID)
+		adminRoutes.PATCH("/appeals/:id", appealHandler.Review)
+
+		// Runtime diagnostics
+		if cfg.Debug.Enabled {
+			adminRoutes.GET("/debug/pprof/*profile", debugHandler.Pprof)
+			adminRoutes.POST("/debug/pprof/*profile", debugHandler.Pprof)
+			adminRoutes.POST("/debug/gc", debugHandler.GC)
+			adminRoutes.GET("/debug/goroutines", debugHandler.Goroutines)
+		}
+
+		// Backfill jobs
+		adminRoutes.GET("/backfills", backfillHandler.List)
+		adminRoutes.GET("/backfills/:name", backfillHandler.Get)
+		adminRoutes.POST("/backfills/:name/run", backfillHandler.Run)
+
+		// Background jobs
+		adminRoutes.GET("/jobs", jobHandler.GetAll)
+		adminRoutes.GET("/jobs/stats", jobHandler.Stats)
+		adminRoutes.GET("/jobs/:id", jobHandler.GetByID)
+		adminRoutes.POST("/jobs/:id/retry", jobHandler.Retry)
 	}
 
 	return router
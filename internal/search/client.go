@@ -0,0 +1,77 @@
+package search
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"time"
+)
+
+// httpClient is a minimal JSON-over-HTTP client shared by the search backends
+type httpClient struct {
+	baseURL    string
+	authHeader string
+	client     *http.Client
+}
+
+// newHTTPClient creates a new httpClient
+func newHTTPClient(baseURL, authHeader string, timeout time.Duration) *httpClient {
+	return &httpClient{
+		baseURL:    strings.TrimRight(baseURL, "/"),
+		authHeader: authHeader,
+		client:     &http.Client{Timeout: timeout},
+	}
+}
+
+// do sends a request and decodes the JSON response into out (if non-nil).
+// Status codes listed in allowed are treated as success in addition to 2xx.
+func (c *httpClient) do(ctx context.Context, method, path, contentType string, body io.Reader, out interface{}, allowed ...int) error {
+	req, err := http.NewRequestWithContext(ctx, method, c.baseURL+path, body)
+	if err != nil {
+		return err
+	}
+	if body != nil {
+		req.Header.Set("Content-Type", contentType)
+	}
+	if c.authHeader != "" {
+		req.Header.Set("Authorization", c.authHeader)
+	}
+
+	resp, err := c.client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		for _, code := range allowed {
+			if resp.StatusCode == code {
+				return nil
+			}
+		}
+		msg, _ := io.ReadAll(io.LimitReader(resp.Body, 1024))
+		return fmt.Errorf("search backend returned %d: %s", resp.StatusCode, strings.TrimSpace(string(msg)))
+	}
+
+	if out == nil {
+		return nil
+	}
+	return json.NewDecoder(resp.Body).Decode(out)
+}
+
+// doJSON encodes payload as JSON and sends it
+func (c *httpClient) doJSON(ctx context.Context, method, path string, payload, out interface{}, allowed ...int) error {
+	var body io.Reader
+	if payload != nil {
+		data, err := json.Marshal(payload)
+		if err != nil {
+			return err
+		}
+		body = bytes.NewReader(data)
+	}
+	return c.do(ctx, method, path, "application/json", body, out, allowed...)
+}
@@ -0,0 +1,118 @@
+package search
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/url"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/yourusername/go-enterprise-api/internal/models"
+)
+
+// ElasticsearchIndexer implements SearchIndexer using the Elasticsearch REST API
+type ElasticsearchIndexer struct {
+	client *httpClient
+	index  string
+}
+
+// NewElasticsearchIndexer creates a new Elasticsearch indexer
+func NewElasticsearchIndexer(baseURL, apiKey, index string, timeout time.Duration) *ElasticsearchIndexer {
+	authHeader := ""
+	if apiKey != "" {
+		authHeader = "ApiKey " + apiKey
+	}
+
+	return &ElasticsearchIndexer{
+		client: newHTTPClient(baseURL, authHeader, timeout),
+		index:  index,
+	}
+}
+
+// Name returns the backend name
+func (e *ElasticsearchIndexer) Name() string {
+	return DriverElasticsearch
+}
+
+// Ping checks the health of the cluster, which fails when it is red
+func (e *ElasticsearchIndexer) Ping(ctx context.Context) error {
+	return e.client.doJSON(ctx, http.MethodGet, "/_cluster/health?wait_for_status=yellow&timeout=1s", nil, nil)
+}
+
+// Index adds or replaces a post in the index
+func (e *ElasticsearchIndexer) Index(ctx context.Context, post *models.Post) error {
+	path := "/" + url.PathEscape(e.index) + "/_doc/" + post.ID.String()
+	return e.client.doJSON(ctx, http.MethodPut, path, NewDocument(post), nil)
+}
+
+// Delete removes a post from the index
+func (e *ElasticsearchIndexer) Delete(ctx context.Context, id uuid.UUID) error {
+	path := "/" + url.PathEscape(e.index) + "/_doc/" + id.String()
+	return e.client.doJSON(ctx, http.MethodDelete, path, nil, nil, http.StatusNotFound)
+}
+
+// IndexBatch indexes many posts using the bulk API
+func (e *ElasticsearchIndexer) IndexBatch(ctx context.Context, posts []models.Post) error {
+	if len(posts) == 0 {
+		return nil
+	}
+
+	var buf bytes.Buffer
+	enc := json.NewEncoder(&buf)
+	for i := range posts {
+		action := map[string]interface{}{
+			"index": map[string]string{
+				"_index": e.index,
+				"_id":    posts[i].ID.String(),
+			},
+		}
+		if err := enc.Encode(action); err != nil {
+			return err
+		}
+		if err := enc.Encode(NewDocument(&posts[i])); err != nil {
+			return err
+		}
+	}
+
+	return e.client.do(ctx, http.MethodPost, "/_bulk", "application/x-ndjson", &buf, nil)
+}
+
+// Search runs a multi-field match query and returns matching post IDs
+func (e *ElasticsearchIndexer) Search(ctx context.Context, query string, page, pageSize int) ([]uuid.UUID, int64, error) {
+	payload := map[string]interface{}{
+		"from":    offset(page, pageSize),
+		"size":    pageSize,
+		"_source": false,
+		"query": map[string]interface{}{
+			"multi_match": map[string]interface{}{
+				"query":  query,
+				"fields": []string{"title^3", "tags^2", "excerpt^2", "content"},
+			},
+		},
+	}
+
+	var result struct {
+		Hits struct {
+			Total struct {
+				Value int64 `json:"value"`
+			} `json:"total"`
+			Hits []struct {
+				ID string `json:"_id"`
+			} `json:"hits"`
+		} `json:"hits"`
+	}
+
+	path := "/" + url.PathEscape(e.index) + "/_search"
+	if err := e.client.doJSON(ctx, http.MethodPost, path, payload, &result); err != nil {
+		return nil, 0, err
+	}
+
+	raw := make([]string, len(result.Hits.Hits))
+	for i, hit := range result.Hits.Hits {
+		raw[i] = hit.ID
+	}
+
+	return parseIDs(raw), result.Hits.Total.Value, nil
+}
@@ -0,0 +1,98 @@
+package search
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/yourusername/go-enterprise-api/internal/models"
+)
+
+// MeilisearchIndexer implements SearchIndexer using the Meilisearch REST API
+type MeilisearchIndexer struct {
+	client *httpClient
+	index  string
+}
+
+// NewMeilisearchIndexer creates a new Meilisearch indexer
+func NewMeilisearchIndexer(baseURL, apiKey, index string, timeout time.Duration) *MeilisearchIndexer {
+	authHeader := ""
+	if apiKey != "" {
+		authHeader = "Bearer " + apiKey
+	}
+
+	return &MeilisearchIndexer{
+		client: newHTTPClient(baseURL, authHeader, timeout),
+		index:  index,
+	}
+}
+
+// Name returns the backend name
+func (m *MeilisearchIndexer) Name() string {
+	return DriverMeilisearch
+}
+
+// Ping checks that Meilisearch is available
+func (m *MeilisearchIndexer) Ping(ctx context.Context) error {
+	return m.client.doJSON(ctx, http.MethodGet, "/health", nil, nil)
+}
+
+// documentsPath returns the documents endpoint for the index
+func (m *MeilisearchIndexer) documentsPath() string {
+	return "/indexes/" + url.PathEscape(m.index) + "/documents"
+}
+
+// Index adds or replaces a post in the index
+func (m *MeilisearchIndexer) Index(ctx context.Context, post *models.Post) error {
+	return m.client.doJSON(ctx, http.MethodPost, m.documentsPath()+"?primaryKey=id", []Document{NewDocument(post)}, nil)
+}
+
+// Delete removes a post from the index
+func (m *MeilisearchIndexer) Delete(ctx context.Context, id uuid.UUID) error {
+	return m.client.doJSON(ctx, http.MethodDelete, m.documentsPath()+"/"+id.String(), nil, nil, http.StatusNotFound)
+}
+
+// IndexBatch indexes many posts in a single request
+func (m *MeilisearchIndexer) IndexBatch(ctx context.Context, posts []models.Post) error {
+	if len(posts) == 0 {
+		return nil
+	}
+
+	docs := make([]Document, len(posts))
+	for i := range posts {
+		docs[i] = NewDocument(&posts[i])
+	}
+
+	return m.client.doJSON(ctx, http.MethodPost, m.documentsPath()+"?primaryKey=id", docs, nil)
+}
+
+// Search runs a query and returns matching post IDs
+func (m *MeilisearchIndexer) Search(ctx context.Context, query string, page, pageSize int) ([]uuid.UUID, int64, error) {
+	payload := map[string]interface{}{
+		"q":                    query,
+		"offset":               offset(page, pageSize),
+		"limit":                pageSize,
+		"attributesToRetrieve": []string{"id"},
+	}
+
+	var result struct {
+		Hits []struct {
+			ID string `json:"id"`
+		} `json:"hits"`
+		EstimatedTotalHits int64 `json:"estimatedTotalHits"`
+	}
+
+	path := "/indexes/" + url.PathEscape(m.index) + "/search"
+	if err := m.client.doJSON(ctx, http.MethodPost, path, payload, &result); err != nil {
+		return nil, 0, err
+	}
+
+	raw := make([]string, len(result.Hits))
+	for i, hit := range result.Hits {
+		raw[i] = hit.ID
+	}
+
+	return parseIDs(raw), result.EstimatedTotalHits, nil
+}
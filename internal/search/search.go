@@ -0,0 +1,109 @@
+package search
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/yourusername/go-enterprise-api/internal/config"
+	"github.com/yourusername/go-enterprise-api/internal/models"
+)
+
+// Supported search drivers
+const (
+	DriverDatabase      = "database"
+	DriverElasticsearch = "elasticsearch"
+	DriverMeilisearch   = "meilisearch"
+)
+
+// SearchIndexer defines the operations an external search engine must support
+type SearchIndexer interface {
+	// Index adds or replaces a post in the search index
+	Index(ctx context.Context, post *models.Post) error
+	// Delete removes a post from the search index
+	Delete(ctx context.Context, id uuid.UUID) error
+	// Search returns the IDs of matching posts in relevance order and the total hit count
+	Search(ctx context.Context, query string, page, pageSize int) ([]uuid.UUID, int64, error)
+	// IndexBatch adds or replaces many posts at once (used for reindexing)
+	IndexBatch(ctx context.Context, posts []models.Post) error
+	// Ping checks that the search engine can be reached
+	Ping(ctx context.Context) error
+	// Name returns the backend name
+	Name() string
+}
+
+// Document is the representation of a post stored in the search index
+type Document struct {
+	ID        string   `json:"id"`
+	Title     string   `json:"title"`
+	Slug      string   `json:"slug"`
+	Excerpt   string   `json:"excerpt"`
+	Content   string   `json:"content"`
+	Tags      []string `json:"tags"`
+	AuthorID  string   `json:"author_id"`
+	Status    string   `json:"status"`
+	CreatedAt int64    `json:"created_at"`
+}
+
+// NewDocument converts a Post into a search Document
+func NewDocument(post *models.Post) Document {
+	tags := make([]string, len(post.Tags))
+	for i, tag := range post.Tags {
+		tags[i] = tag.Name
+	}
+
+	return Document{
+		ID:        post.ID.String(),
+		Title:     post.Title,
+		Slug:      post.Slug,
+		Excerpt:   post.Excerpt,
+		Content:   post.Content,
+		Tags:      tags,
+		AuthorID:  post.UserID.String(),
+		Status:    string(post.Status),
+		CreatedAt: post.CreatedAt.Unix(),
+	}
+}
+
+// New creates a SearchIndexer for the configured driver.
+// It returns nil for the database driver, in which case callers
+// should fall back to repository LIKE queries.
+func New(cfg *config.SearchConfig) (SearchIndexer, error) {
+	timeout := cfg.Timeout
+	if timeout <= 0 {
+		timeout = 5 * time.Second
+	}
+
+	switch cfg.Driver {
+	case "", DriverDatabase:
+		return nil, nil
+	case DriverElasticsearch:
+		return NewElasticsearchIndexer(cfg.URL, cfg.APIKey, cfg.Index, timeout), nil
+	case DriverMeilisearch:
+		return NewMeilisearchIndexer(cfg.URL, cfg.APIKey, cfg.Index, timeout), nil
+	default:
+		return nil, fmt.Errorf("unsupported search driver: %s", cfg.Driver)
+	}
+}
+
+// offset calculates the result offset for a page
+func offset(page, pageSize int) int {
+	if page < 1 {
+		page = 1
+	}
+	return (page - 1) * pageSize
+}
+
+// parseIDs converts string document IDs into UUIDs, skipping invalid ones
+func parseIDs(raw []string) []uuid.UUID {
+	ids := make([]uuid.UUID, 0, len(raw))
+	for _, r := range raw {
+		id, err := uuid.Parse(r)
+		if err != nil {
+			continue
+		}
+		ids = append(ids, id)
+	}
+	return ids
+}
@@ -0,0 +1,172 @@
+// Package secrets fetches secrets, such as the JWT secret and the database
+// password, from HashiCorp Vault or AWS Secrets Manager instead of the
+// environment, and refreshes them while the server runs so rotated secrets
+// are picked up without a restart.
+package secrets
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/yourusername/go-enterprise-api/pkg/logger"
+)
+
+// Supported secrets providers
+const (
+	ProviderEnv   = "env"
+	ProviderVault = "vault"
+	ProviderAWS   = "aws"
+)
+
+// Provider fetches secrets by reference. A reference names a secret and,
+// after a "#", a field of it: "go-enterprise-api#jwt_secret" is the
+// jwt_secret field of the go-enterprise-api secret.
+type Provider interface {
+	// Get returns the value of the secret a reference names
+	Get(ctx context.Context, ref string) (string, error)
+	// Put stores a new value of the secret a reference names
+	Put(ctx context.Context, ref, value string) error
+	// Name returns the provider name
+	Name() string
+}
+
+// Options holds the settings of the providers
+type Options struct {
+	VaultAddress string
+	VaultToken   string
+	// VaultMount is the mount path of the KV version 2 secrets engine
+	VaultMount string
+
+	AWSRegion string
+	// AWSEndpoint overrides the regional Secrets Manager endpoint
+	AWSEndpoint  string
+	AWSAccessKey string
+	AWSSecretKey string
+
+	Timeout time.Duration
+}
+
+// New creates a Provider. It returns nil for the env provider, in which case
+// secrets are read from the environment like any other setting.
+func New(provider string, opts Options) (Provider, error) {
+	timeout := opts.Timeout
+	if timeout <= 0 {
+		timeout = 10 * time.Second
+	}
+
+	switch provider {
+	case "", ProviderEnv:
+		return nil, nil
+	case ProviderVault:
+		return NewVaultProvider(opts.VaultAddress, opts.VaultToken, opts.VaultMount, timeout), nil
+	case ProviderAWS:
+		return NewAWSProvider(opts.AWSEndpoint, opts.AWSRegion, opts.AWSAccessKey, opts.AWSSecretKey, timeout), nil
+	default:
+		return nil, fmt.Errorf("unsupported secrets provider: %s", provider)
+	}
+}
+
+// splitRef splits a reference into the name of a secret and its field,
+// empty if the reference names none
+func splitRef(ref string) (name, field string) {
+	name, field, _ = strings.Cut(ref, "#")
+	return name, field
+}
+
+// watch is a secret kept up to date by a Refresher
+type watch struct {
+	ref   string
+	set   func(string) error
+	value string
+}
+
+// Refresher fetches secrets from a provider and hands their values to the
+// settings using them whenever they change.
+type Refresher struct {
+	provider Provider
+	interval time.Duration
+
+	mu      sync.Mutex
+	watches []*watch
+}
+
+// NewRefresher creates a refresher fetching secrets from provider every
+// interval, or only once if interval is 0
+func NewRefresher(provider Provider, interval time.Duration) *Refresher {
+	return &Refresher{provider: provider, interval: interval}
+}
+
+// Watch registers a secret to fetch. set is given its value when it is first
+// fetched and whenever it changes; a value set rejects is not used.
+func (r *Refresher) Watch(ref string, set func(string) error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.watches = append(r.watches, &watch{ref: ref, set: set})
+}
+
+// Refresh fetches every watched secret. It returns the references of the
+// secrets whose values changed, and the errors of those that could not be
+// fetched or were rejected, which keep their previous values.
+func (r *Refresher) Refresh(ctx context.Context) ([]string, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	var changed []string
+	var errs []error
+	for _, w := range r.watches {
+		value, err := r.provider.Get(ctx, w.ref)
+		if err == nil && value == "" {
+			err = errors.New("secret is empty")
+		}
+		if err == nil && value != w.value {
+			err = w.set(value)
+			if err == nil {
+				w.value = value
+				changed = append(changed, w.ref)
+			}
+		}
+		if err != nil {
+			errs = append(errs, fmt.Errorf("%s secret %s: %w", r.provider.Name(), w.ref, err))
+		}
+	}
+	return changed, errors.Join(errs...)
+}
+
+// Interval returns how often secrets are refreshed, 0 if they are not
+func (r *Refresher) Interval() time.Duration {
+	return r.interval
+}
+
+// Run refreshes the secrets every interval until ctx is done, logging the
+// secrets that were rotated and those that could not be refreshed
+//
+// Usage:
+//
+//	lc.Go("secrets refresher", refresher.Run)
+func (r *Refresher) Run(ctx context.Context) {
+	if r.interval <= 0 {
+		return
+	}
+
+	ticker := time.NewTicker(r.interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			changed, err := r.Refresh(ctx)
+			for _, ref := range changed {
+				logger.Info("Secret rotated", logger.String("provider", r.provider.Name()), logger.String("secret", ref))
+			}
+			if err != nil && ctx.Err() == nil {
+				logger.Error("Failed to refresh secrets", logger.Err(err))
+			}
+		}
+	}
+}
@@ -0,0 +1,159 @@
+package secrets
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+)
+
+// VaultProvider reads secrets from the KV version 2 secrets engine of
+// HashiCorp Vault. A reference names the path of a secret under the mount
+// and the key of its data, which is required: "go-enterprise-api#jwt_secret".
+type VaultProvider struct {
+	address string
+	token   string
+	mount   string
+	client  *http.Client
+}
+
+// NewVaultProvider creates a Vault provider reading secrets from the KV
+// engine mounted at mount, "secret" if empty
+func NewVaultProvider(address, token, mount string, timeout time.Duration) *VaultProvider {
+	if mount == "" {
+		mount = "secret"
+	}
+	return &VaultProvider{
+		address: strings.TrimRight(address, "/"),
+		token:   token,
+		mount:   strings.Trim(mount, "/"),
+		client:  &http.Client{Timeout: timeout},
+	}
+}
+
+// Name returns the provider name
+func (p *VaultProvider) Name() string {
+	return ProviderVault
+}
+
+// Get reads the latest version of a secret and returns the key the
+// reference names
+func (p *VaultProvider) Get(ctx context.Context, ref string) (string, error) {
+	name, key := splitRef(ref)
+	if name == "" || key == "" {
+		return "", fmt.Errorf("invalid reference %q: expected PATH#KEY", ref)
+	}
+
+	data, _, err := p.read(ctx, name)
+	if err != nil {
+		return "", err
+	}
+	if data == nil {
+		return "", fmt.Errorf("secret %s not found", name)
+	}
+
+	value, ok := data[key].(string)
+	if !ok {
+		return "", fmt.Errorf("secret %s has no %s key", name, key)
+	}
+	return value, nil
+}
+
+// Put writes a new version of a secret, setting the key the reference names
+// and keeping its other keys. The write fails if another version was
+// written since the secret was read.
+func (p *VaultProvider) Put(ctx context.Context, ref, value string) error {
+	name, key := splitRef(ref)
+	if name == "" || key == "" {
+		return fmt.Errorf("invalid reference %q: expected PATH#KEY", ref)
+	}
+
+	data, version, err := p.read(ctx, name)
+	if err != nil {
+		return err
+	}
+	if data == nil {
+		data = make(map[string]interface{})
+	}
+	data[key] = value
+
+	body, err := json.Marshal(map[string]interface{}{
+		"data":    data,
+		"options": map[string]int{"cas": version},
+	})
+	if err != nil {
+		return err
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, p.dataURL(name), bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("X-Vault-Token", p.token)
+
+	resp, err := p.client.Do(req)
+	if err != nil {
+		return fmt.Errorf("vault request failed: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK && resp.StatusCode != http.StatusNoContent {
+		msg, _ := io.ReadAll(io.LimitReader(resp.Body, 1024))
+		return fmt.Errorf("vault returned %d: %s", resp.StatusCode, strings.TrimSpace(string(msg)))
+	}
+	return nil
+}
+
+// read reads the data and version of the latest version of a secret. The
+// data is nil if the secret does not exist.
+func (p *VaultProvider) read(ctx context.Context, name string) (map[string]interface{}, int, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, p.dataURL(name), nil)
+	if err != nil {
+		return nil, 0, err
+	}
+	req.Header.Set("X-Vault-Token", p.token)
+
+	resp, err := p.client.Do(req)
+	if err != nil {
+		return nil, 0, fmt.Errorf("vault request failed: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode == http.StatusNotFound {
+		return nil, 0, nil
+	}
+	if resp.StatusCode != http.StatusOK {
+		msg, _ := io.ReadAll(io.LimitReader(resp.Body, 1024))
+		return nil, 0, fmt.Errorf("vault returned %d: %s", resp.StatusCode, strings.TrimSpace(string(msg)))
+	}
+
+	var body struct {
+		Data struct {
+			Data     map[string]interface{} `json:"data"`
+			Metadata struct {
+				Version int `json:"version"`
+			} `json:"metadata"`
+		} `json:"data"`
+	}
+	if err := json.NewDecoder(resp.Body).Decode(&body); err != nil {
+		return nil, 0, fmt.Errorf("invalid vault response: %w", err)
+	}
+	if body.Data.Data == nil {
+		body.Data.Data = make(map[string]interface{})
+	}
+	return body.Data.Data, body.Data.Metadata.Version, nil
+}
+
+// dataURL returns the URL of the data of a secret
+func (p *VaultProvider) dataURL(name string) string {
+	segments := strings.Split(strings.Trim(name, "/"), "/")
+	for i, segment := range segments {
+		segments[i] = url.PathEscape(segment)
+	}
+	return p.address + "/v1/" + p.mount + "/data/" + strings.Join(segments, "/")
+}
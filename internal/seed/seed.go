@@ -0,0 +1,238 @@
+// Package seed fills a database with an initial admin and, for development,
+// demo tags and posts. Seeding is idempotent: records that already exist,
+// matched by email or slug, are left as they are, so running it again does
+// not duplicate data.
+package seed
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/yourusername/go-enterprise-api/internal/models"
+	"gorm.io/gorm"
+)
+
+// Admin describes the initial admin
+type Admin struct {
+	Email     string
+	Password  string
+	FirstName string
+	LastName  string
+}
+
+// Options selects what is seeded
+type Options struct {
+	// Admin is created unless a user with its email exists. Without it,
+	// demo posts are written by the oldest existing admin.
+	Admin *Admin
+	// Demo seeds demo tags and sample posts
+	Demo bool
+}
+
+// Report counts the seeded records
+type Report struct {
+	AdminCreated  bool
+	AdminEmail    string
+	TagsCreated   int
+	TagsExisting  int
+	PostsCreated  int
+	PostsExisting int
+}
+
+// ErrNoAdmin is returned when demo posts are requested but there is no
+// admin to write them
+var ErrNoAdmin = errors.New("no admin to write the demo posts; set the seed admin")
+
+// demoTag is a tag of the demo content
+type demoTag struct {
+	name        string
+	slug        string
+	description string
+}
+
+// demoPost is a post of the demo content
+type demoPost struct {
+	title   string
+	slug    string
+	excerpt string
+	content string
+	status  models.PostStatus
+	tags    []string
+	// featured posts are shown on the front page
+	featured bool
+}
+
+var demoTags = []demoTag{
+	{name: "Go", slug: "go", description: "The Go programming language"},
+	{name: "Databases", slug: "databases", description: "Storing and querying data"},
+	{name: "DevOps", slug: "devops", description: "Building, deploying and running services"},
+	{name: "Security", slug: "security", description: "Keeping services and their users safe"},
+	{name: "Tutorials", slug: "tutorials", description: "Step-by-step guides"},
+}
+
+var demoPosts = []demoPost{
+	{
+		title:    "Welcome to the demo site",
+		slug:     "welcome-to-the-demo-site",
+		excerpt:  "A tour of what this API can do.",
+		content:  "<p>This site was filled with demo content by the seed command. Browse the posts, follow the tags and try the API with the seeded admin account.</p><p>Delete these posts once you start writing your own.</p>",
+		status:   models.PostStatusPublished,
+		tags:     []string{"tutorials"},
+		featured: true,
+	},
+	{
+		title:   "Structuring a Go web service",
+		slug:    "structuring-a-go-web-service",
+		excerpt: "Handlers, services and repositories, and why each layer exists.",
+		content: "<p>Handlers parse requests and write responses. Services hold the business rules. Repositories talk to the database.</p><p>Keeping the layers apart lets each one be changed, and tested, on its own.</p>",
+		status:  models.PostStatusPublished,
+		tags:    []string{"go", "tutorials"},
+	},
+	{
+		title:   "Indexing for the queries you run",
+		slug:    "indexing-for-the-queries-you-run",
+		excerpt: "Let the slow query log tell you which indexes you need.",
+		content: "<p>An index speeds up the reads that use it and slows down every write. Add the ones your queries need, found with the slow query log and the query plan, rather than indexing every column.</p>",
+		status:  models.PostStatusPublished,
+		tags:    []string{"databases", "devops"},
+	},
+	{
+		title:   "Rotating secrets without downtime",
+		slug:    "rotating-secrets-without-downtime",
+		excerpt: "A draft on accepting old and new secrets side by side.",
+		content: "<p>Accept both the old and the new secret while clients move over, then retire the old one.</p>",
+		status:  models.PostStatusDraft,
+		tags:    []string{"security", "devops"},
+	},
+}
+
+// Run seeds db according to opts
+func Run(ctx context.Context, db *gorm.DB, opts Options) (*Report, error) {
+	report := &Report{}
+	err := db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		var admin *models.User
+		if opts.Admin != nil {
+			var err error
+			admin, report.AdminCreated, err = seedAdmin(tx, opts.Admin)
+			if err != nil {
+				return fmt.Errorf("seed admin: %w", err)
+			}
+			report.AdminEmail = admin.Email
+		}
+		if !opts.Demo {
+			return nil
+		}
+
+		if admin == nil {
+			var existing models.User
+			err := tx.Where("role = ?", models.RoleAdmin).Order("created_at").First(&existing).Error
+			if errors.Is(err, gorm.ErrRecordNotFound) {
+				return ErrNoAdmin
+			}
+			if err != nil {
+				return err
+			}
+			admin = &existing
+		}
+
+		tags, err := seedTags(tx, report)
+		if err != nil {
+			return fmt.Errorf("seed tags: %w", err)
+		}
+		if err := seedPosts(tx, admin, tags, report); err != nil {
+			return fmt.Errorf("seed posts: %w", err)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return report, nil
+}
+
+// seedAdmin creates the admin unless a user with its email exists, which is
+// returned as it is
+func seedAdmin(tx *gorm.DB, admin *Admin) (*models.User, bool, error) {
+	var existing models.User
+	err := tx.Unscoped().Where("email = ?", admin.Email).First(&existing).Error
+	if err == nil {
+		return &existing, false, nil
+	}
+	if !errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, false, err
+	}
+
+	now := time.Now().UTC()
+	user := &models.User{
+		Email:           admin.Email,
+		Password:        admin.Password,
+		FirstName:       admin.FirstName,
+		LastName:        admin.LastName,
+		Role:            models.RoleAdmin,
+		Status:          models.StatusActive,
+		Type:            models.UserTypeHuman,
+		EmailVerifiedAt: &now,
+	}
+	if err := tx.Create(user).Error; err != nil {
+		return nil, false, err
+	}
+	return user, true, nil
+}
+
+// seedTags creates the demo tags that do not exist yet and returns all of
+// them by slug
+func seedTags(tx *gorm.DB, report *Report) (map[string]models.Tag, error) {
+	tags := make(map[string]models.Tag, len(demoTags))
+	for _, demo := range demoTags {
+		var tag models.Tag
+		err := tx.Unscoped().Where("slug = ?", demo.slug).First(&tag).Error
+		switch {
+		case err == nil:
+			report.TagsExisting++
+		case errors.Is(err, gorm.ErrRecordNotFound):
+			tag = models.Tag{Name: demo.name, Slug: demo.slug, Description: demo.description}
+			if err := tx.Create(&tag).Error; err != nil {
+				return nil, err
+			}
+			report.TagsCreated++
+		default:
+			return nil, err
+		}
+		tags[demo.slug] = tag
+	}
+	return tags, nil
+}
+
+// seedPosts creates the demo posts that do not exist yet, written by author
+func seedPosts(tx *gorm.DB, author *models.User, tags map[string]models.Tag, report *Report) error {
+	for _, demo := range demoPosts {
+		var count int64
+		if err := tx.Unscoped().Model(&models.Post{}).Where("slug = ?", demo.slug).Count(&count).Error; err != nil {
+			return err
+		}
+		if count > 0 {
+			report.PostsExisting++
+			continue
+		}
+
+		post := &models.Post{
+			Title:      demo.title,
+			Slug:       demo.slug,
+			Excerpt:    demo.excerpt,
+			Content:    demo.content,
+			Status:     demo.status,
+			IsFeatured: demo.featured,
+			UserID:     author.ID,
+		}
+		for _, slug := range demo.tags {
+			post.Tags = append(post.Tags, tags[slug])
+		}
+		if err := tx.Create(post).Error; err != nil {
+			return err
+		}
+		report.PostsCreated++
+	}
+	return nil
+}
@@ -0,0 +1,232 @@
+package services
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/yourusername/go-enterprise-api/internal/config"
+	"github.com/yourusername/go-enterprise-api/internal/events"
+	"github.com/yourusername/go-enterprise-api/internal/models"
+	"github.com/yourusername/go-enterprise-api/internal/repository"
+	apperrors "github.com/yourusername/go-enterprise-api/pkg/errors"
+	"github.com/yourusername/go-enterprise-api/pkg/logger"
+)
+
+// erasureBatchSize is the number of accounts erased per query during a run
+const erasureBatchSize = 100
+
+// ErasureReport summarizes a single run of account erasure
+type ErasureReport struct {
+	Erased          int `json:"erased"`
+	PostsDeleted    int `json:"posts_deleted"`
+	PostsReassigned int `json:"posts_reassigned"`
+}
+
+// AccountDeletionService interface defines self-service account deletion.
+// Accounts are deactivated when their owner asks for their deletion, and
+// erased once the grace period has passed. Logging in during the grace
+// period cancels the deletion.
+type AccountDeletionService interface {
+	Request(ctx context.Context, userID uuid.UUID, password string, deletePosts bool) (time.Time, error)
+	Run(ctx context.Context) (*ErasureReport, error)
+	Schedule(ctx context.Context)
+}
+
+// accountDeletionService implements AccountDeletionService
+type accountDeletionService struct {
+	userRepo   repository.UserRepository
+	postRepo   repository.PostRepository
+	changeRepo repository.ChangeRepository
+	avatars    AvatarService
+	bus        *events.Bus
+	cfg        *config.AccountDeletionConfig
+}
+
+// NewAccountDeletionService creates a new account deletion service
+func NewAccountDeletionService(userRepo repository.UserRepository, postRepo repository.PostRepository, changeRepo repository.ChangeRepository, avatars AvatarService, bus *events.Bus, cfg *config.AccountDeletionConfig) AccountDeletionService {
+	return &accountDeletionService{
+		userRepo:   userRepo,
+		postRepo:   postRepo,
+		changeRepo: changeRepo,
+		avatars:    avatars,
+		bus:        bus,
+		cfg:        cfg,
+	}
+}
+
+// Request deactivates a user's account after checking their password and
+// returns the time after which it is erased. With deletePosts, the posts of
+// the user are deleted on erasure instead of being kept.
+func (s *accountDeletionService) Request(ctx context.Context, userID uuid.UUID, password string, deletePosts bool) (time.Time, error) {
+	user, err := s.userRepo.FindByID(ctx, userID)
+	if err != nil {
+		return time.Time{}, err
+	}
+
+	// Service accounts have no password and are deleted by admins
+	if user.IsServiceAccount() {
+		return time.Time{}, apperrors.ErrForbidden.WithDetails("Service accounts cannot delete themselves")
+	}
+	if !user.CheckPassword(password) {
+		return time.Time{}, apperrors.ErrInvalidPassword
+	}
+
+	requestedAt := time.Now().UTC()
+	user.DeletionRequestedAt = &requestedAt
+	user.DeletePostsOnErasure = deletePosts
+	user.RefreshToken = ""
+	if err := s.userRepo.Update(ctx, user); err != nil {
+		logger.Error("Failed to request account deletion", logger.Err(err))
+		return time.Time{}, apperrors.ErrInternal
+	}
+
+	changes := newChangeSet(models.ChangeEntityUser, user.ID, user.ID)
+	changes.track("deletion_requested_at", "", requestedAt.Format(time.RFC3339))
+	changes.track("delete_posts_on_erasure", false, deletePosts)
+	changes.save(ctx, s.changeRepo)
+
+	eraseAt := requestedAt.AddDate(0, 0, s.cfg.GraceDays)
+	logger.Info("Account deletion requested",
+		logger.String("user_id", user.ID.String()),
+		logger.String("erase_at", eraseAt.Format(time.RFC3339)),
+	)
+	return eraseAt, nil
+}
+
+// Run erases the accounts whose grace period has passed. Accounts under
+// legal hold are erased once the hold is lifted.
+func (s *accountDeletionService) Run(ctx context.Context) (*ErasureReport, error) {
+	now := time.Now().UTC()
+	cutoff := now.AddDate(0, 0, -s.cfg.GraceDays)
+	report := &ErasureReport{}
+
+	var successor *models.User
+	if s.cfg.Successor != "" {
+		var err error
+		if successor, err = s.userRepo.FindByEmail(ctx, s.cfg.Successor); err != nil {
+			logger.Error("Failed to find the successor of erased accounts",
+				logger.String("email", s.cfg.Successor),
+				logger.Err(err),
+			)
+			return report, apperrors.ErrInternal
+		}
+	}
+
+	for {
+		users, err := s.userRepo.FindDeletionRequestedBefore(ctx, cutoff, erasureBatchSize)
+		if err != nil {
+			logger.Error("Failed to find accounts to erase", logger.Err(err))
+			return report, apperrors.ErrInternal
+		}
+
+		for i := range users {
+			if err := s.erase(ctx, &users[i], successor, now, report); err != nil {
+				logger.Error("Failed to erase account",
+					logger.String("user_id", users[i].ID.String()),
+					logger.Err(err),
+				)
+				return report, apperrors.ErrInternal
+			}
+		}
+
+		if len(users) < erasureBatchSize {
+			break
+		}
+	}
+
+	logger.Info("Account erasure applied",
+		logger.Int("erased", report.Erased),
+		logger.Int("posts_deleted", report.PostsDeleted),
+		logger.Int("posts_reassigned", report.PostsReassigned),
+	)
+	return report, nil
+}
+
+// Schedule erases accounts on the configured interval until ctx is
+// cancelled. A run in progress is finished first.
+func (s *accountDeletionService) Schedule(ctx context.Context) {
+	ticker := time.NewTicker(s.cfg.Interval)
+	defer ticker.Stop()
+
+	for {
+		if _, err := s.Run(context.WithoutCancel(ctx)); err != nil {
+			logger.Error("Scheduled account erasure failed", logger.Err(err))
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// erase deletes or reassigns the posts of a user, then anonymizes the
+// account. Posts under legal hold are never deleted. A failed erasure is
+// retried on the next run, skipping the posts already deleted.
+func (s *accountDeletionService) erase(ctx context.Context, user *models.User, successor *models.User, now time.Time, report *ErasureReport) error {
+	// The personal data of the author must not reach the subscribers of
+	// the events published below
+	var posts []models.Post
+	err := s.postRepo.FindInBatchesWithAuthor(ctx, &user.ID, erasureBatchSize, func(batch []models.Post) error {
+		for _, post := range batch {
+			post.User = nil
+			posts = append(posts, post)
+		}
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	deleted := 0
+	var kept []models.Post
+	for i := range posts {
+		post := &posts[i]
+		if !user.DeletePostsOnErasure || post.OnLegalHold() {
+			kept = append(kept, *post)
+			continue
+		}
+		if err := s.postRepo.Delete(ctx, post.ID); err != nil {
+			return err
+		}
+		s.bus.Publish(ctx, events.PostDeleted{Post: post})
+		deleted++
+	}
+
+	changes := newChangeSet(models.ChangeEntityUser, user.ID, uuid.Nil)
+	if successor != nil && successor.ID != user.ID && len(kept) > 0 {
+		if _, err := s.postRepo.ReassignAuthor(ctx, user.ID, successor.ID); err != nil {
+			return err
+		}
+		for i := range kept {
+			postChanges := newChangeSet(models.ChangeEntityPost, kept[i].ID, uuid.Nil)
+			postChanges.track("user_id", user.ID, successor.ID)
+			postChanges.save(ctx, s.changeRepo)
+			kept[i].UserID = successor.ID
+		}
+		changes.track("posts_reassigned_to", "", successor.ID)
+		report.PostsReassigned += len(kept)
+	}
+	changes.track("posts_deleted", 0, deleted)
+
+	if err := anonymizeUser(ctx, s.userRepo, s.avatars, user, now, changes); err != nil {
+		return err
+	}
+	changes.save(ctx, s.changeRepo)
+
+	// Kept posts name their new or anonymized author from now on
+	for i := range kept {
+		s.bus.Publish(ctx, events.PostSaved{Post: &kept[i]})
+	}
+
+	report.Erased++
+	report.PostsDeleted += deleted
+	logger.Info("Account erased",
+		logger.String("user_id", user.ID.String()),
+		logger.Int("posts_deleted", deleted),
+		logger.Int("posts_kept", len(kept)),
+	)
+	return nil
+}
@@ -0,0 +1,130 @@
+package services
+
+import (
+	"context"
+	"net"
+	"sort"
+	"strings"
+
+	"github.com/google/uuid"
+	"github.com/yourusername/go-enterprise-api/internal/models"
+	"github.com/yourusername/go-enterprise-api/internal/repository"
+	apperrors "github.com/yourusername/go-enterprise-api/pkg/errors"
+	"github.com/yourusername/go-enterprise-api/pkg/logger"
+)
+
+// AdminSearchService interface defines admin search methods
+type AdminSearchService interface {
+	Search(ctx context.Context, query string, types []models.SearchMatchType, limit int) ([]*models.SearchMatchResponse, error)
+}
+
+// adminSearchService implements AdminSearchService
+type adminSearchService struct {
+	searchRepo repository.AdminSearchRepository
+}
+
+// NewAdminSearchService creates a new admin search service
+func NewAdminSearchService(searchRepo repository.AdminSearchRepository) AdminSearchService {
+	return &adminSearchService{
+		searchRepo: searchRepo,
+	}
+}
+
+// Search finds users, posts and comments, soft-deleted ones included, for
+// support investigations. A UUID query finds the record with that ID of
+// every type; any other query finds users by email and posts by current or
+// previous slug. Only records of types are returned, or of every type when
+// types is empty, and at most limit of each type, exact matches first.
+func (s *adminSearchService) Search(ctx context.Context, query string, types []models.SearchMatchType, limit int) ([]*models.SearchMatchResponse, error) {
+	query = strings.TrimSpace(query)
+	if net.ParseIP(query) != nil {
+		return nil, apperrors.ErrValidation.WithDetails("IP addresses are not recorded, so records cannot be searched by IP")
+	}
+
+	wants := func(t models.SearchMatchType) bool {
+		if len(types) == 0 {
+			return true
+		}
+		for _, want := range types {
+			if want == t {
+				return true
+			}
+		}
+		return false
+	}
+
+	matches, err := s.search(ctx, query, wants, limit)
+	if err != nil {
+		logger.Error("Failed to search records", logger.Err(err))
+		return nil, apperrors.ErrInternal
+	}
+	return matches, nil
+}
+
+// search runs the lookups a query calls for
+func (s *adminSearchService) search(ctx context.Context, query string, wants func(models.SearchMatchType) bool, limit int) ([]*models.SearchMatchResponse, error) {
+	matches := []*models.SearchMatchResponse{}
+
+	if id, err := uuid.Parse(query); err == nil {
+		if wants(models.SearchMatchUser) {
+			users, err := s.searchRepo.FindUsersByID(ctx, id)
+			if err != nil {
+				return nil, err
+			}
+			for i := range users {
+				matches = append(matches, users[i].ToSearchMatch(models.SearchFieldID))
+			}
+		}
+		if wants(models.SearchMatchPost) {
+			posts, err := s.searchRepo.FindPostsByID(ctx, id)
+			if err != nil {
+				return nil, err
+			}
+			for i := range posts {
+				matches = append(matches, posts[i].ToSearchMatch(models.SearchFieldID))
+			}
+		}
+		if wants(models.SearchMatchComment) {
+			comments, err := s.searchRepo.FindCommentsByID(ctx, id)
+			if err != nil {
+				return nil, err
+			}
+			for i := range comments {
+				matches = append(matches, comments[i].ToSearchMatch(models.SearchFieldID))
+			}
+		}
+		return matches, nil
+	}
+
+	lower := strings.ToLower(query)
+	if wants(models.SearchMatchUser) {
+		users, err := s.searchRepo.FindUsersByEmail(ctx, query, limit)
+		if err != nil {
+			return nil, err
+		}
+		sort.SliceStable(users, func(i, j int) bool {
+			return strings.EqualFold(users[i].Email, query) && !strings.EqualFold(users[j].Email, query)
+		})
+		for i := range users {
+			matches = append(matches, users[i].ToSearchMatch(models.SearchFieldEmail))
+		}
+	}
+	// Slugs never contain an @, so email addresses are not looked up as slugs
+	if wants(models.SearchMatchPost) && !strings.Contains(query, "@") {
+		posts, err := s.searchRepo.FindPostsBySlug(ctx, query, limit)
+		if err != nil {
+			return nil, err
+		}
+		sort.SliceStable(posts, func(i, j int) bool {
+			return posts[i].Slug == lower && posts[j].Slug != lower
+		})
+		for i := range posts {
+			field := models.SearchFieldSlug
+			if !strings.Contains(posts[i].Slug, lower) {
+				field = models.SearchFieldPreviousSlug
+			}
+			matches = append(matches, posts[i].ToSearchMatch(field))
+		}
+	}
+	return matches, nil
+}
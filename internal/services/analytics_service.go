@@ -0,0 +1,230 @@
+package services
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/yourusername/go-enterprise-api/internal/jobs"
+	"github.com/yourusername/go-enterprise-api/internal/models"
+	"github.com/yourusername/go-enterprise-api/internal/repository"
+	apperrors "github.com/yourusername/go-enterprise-api/pkg/errors"
+	"github.com/yourusername/go-enterprise-api/pkg/logger"
+)
+
+const (
+	// defaultAnalyticsDays is the report range used when no dates are given
+	defaultAnalyticsDays = 30
+	// maxAnalyticsDays caps the length of a report range
+	maxAnalyticsDays = 366
+	// directReferrer is recorded for views without a referrer
+	directReferrer = "direct"
+	// viewRollupBatchSize bounds the number of daily aggregates rolled up per query
+	viewRollupBatchSize = 500
+)
+
+// JobRollUpViews is the recurring job adding recorded views to post view counts
+const JobRollUpViews = "posts.rollup_views"
+
+// AnalyticsService interface defines post analytics methods
+type AnalyticsService interface {
+	RecordView(ctx context.Context, postID uuid.UUID, readerKey, referrer string)
+	RegisterJobs(queue *jobs.Queue)
+	GetPostAnalytics(ctx context.Context, postID, userID uuid.UUID, isAdmin bool, from, to time.Time) (*models.PostAnalytics, error)
+	ExportAuthorAnalytics(ctx context.Context, userID uuid.UUID, from, to time.Time) ([]models.AuthorAnalyticsRow, error)
+}
+
+// analyticsService implements AnalyticsService
+type analyticsService struct {
+	analyticsRepo repository.AnalyticsRepository
+	postRepo      repository.PostRepository
+	readKeyRepo   repository.ReadKeyRepository
+}
+
+// NewAnalyticsService creates a new analytics service
+func NewAnalyticsService(analyticsRepo repository.AnalyticsRepository, postRepo repository.PostRepository, readKeyRepo repository.ReadKeyRepository) AnalyticsService {
+	return &analyticsService{
+		analyticsRepo: analyticsRepo,
+		postRepo:      postRepo,
+		readKeyRepo:   readKeyRepo,
+	}
+}
+
+// RecordView records a post view in the daily aggregates. readerKey identifies
+// the reader (user ID or client fingerprint) and is only stored hashed.
+// Views made with a read key are also counted in the key's usage.
+// Failures are logged rather than failing the request.
+func (s *analyticsService) RecordView(ctx context.Context, postID uuid.UUID, readerKey, referrer string) {
+	day := time.Now().UTC().Format(models.AnalyticsDateFormat)
+	hash := sha256.Sum256([]byte(readerKey))
+
+	if err := s.analyticsRepo.RecordView(ctx, postID, day, hex.EncodeToString(hash[:]), normalizeReferrer(referrer)); err != nil {
+		logger.Error("Failed to record post view", logger.Err(err))
+	}
+
+	if key := ReadKeyFromContext(ctx); key != nil {
+		if err := s.readKeyRepo.AddUsage(ctx, key.ID, day, 0, 1); err != nil {
+			logger.Error("Failed to record read key post view", logger.Err(err))
+		}
+	}
+}
+
+// RegisterJobs registers the job rolling recorded views up into post view
+// counts, so views do not each write to the posts table
+func (s *analyticsService) RegisterJobs(queue *jobs.Queue) {
+	queue.Register(JobRollUpViews, func(ctx context.Context, _ []byte) error {
+		return s.rollUpViews(ctx)
+	})
+}
+
+// rollUpViews adds every pending view to its post's view count
+func (s *analyticsService) rollUpViews(ctx context.Context) error {
+	total := 0
+	for {
+		count, err := s.analyticsRepo.RollUpViews(ctx, viewRollupBatchSize)
+		if err != nil {
+			return err
+		}
+		total += count
+		if count < viewRollupBatchSize {
+			break
+		}
+	}
+
+	if total > 0 {
+		logger.Info("Post views rolled up", logger.Int("aggregates", total))
+	}
+	return nil
+}
+
+// GetPostAnalytics returns the analytics report of a post (owner or admin only)
+func (s *analyticsService) GetPostAnalytics(ctx context.Context, postID, userID uuid.UUID, isAdmin bool, from, to time.Time) (*models.PostAnalytics, error) {
+	from, to, err := analyticsRange(from, to)
+	if err != nil {
+		return nil, err
+	}
+
+	post, err := s.postRepo.FindByID(ctx, postID)
+	if err != nil {
+		return nil, err
+	}
+
+	// Check ownership or admin
+	if post.UserID != userID && !isAdmin {
+		return nil, apperrors.ErrForbidden
+	}
+
+	fromDay := from.Format(models.AnalyticsDateFormat)
+	toDay := to.Format(models.AnalyticsDateFormat)
+
+	stats, err := s.analyticsRepo.FindDailyStats(ctx, postID, fromDay, toDay)
+	if err != nil {
+		logger.Error("Failed to get post analytics", logger.Err(err))
+		return nil, apperrors.ErrInternal
+	}
+
+	referrers, err := s.analyticsRepo.FindReferrerTotals(ctx, postID, fromDay, toDay)
+	if err != nil {
+		logger.Error("Failed to get post referrers", logger.Err(err))
+		return nil, apperrors.ErrInternal
+	}
+
+	byDay := make(map[string]models.PostDailyStat, len(stats))
+	for _, stat := range stats {
+		byDay[stat.Day] = stat
+	}
+
+	report := &models.PostAnalytics{
+		PostID:    postID,
+		From:      fromDay,
+		To:        toDay,
+		Daily:     make([]models.DailyViews, 0),
+		Referrers: referrers,
+	}
+	if report.Referrers == nil {
+		report.Referrers = make([]models.ReferrerViews, 0)
+	}
+
+	// Include days without views so the series has no gaps
+	for day := from; !day.After(to); day = day.AddDate(0, 0, 1) {
+		key := day.Format(models.AnalyticsDateFormat)
+		stat := byDay[key]
+		report.Daily = append(report.Daily, models.DailyViews{
+			Date:          key,
+			Views:         stat.Views,
+			UniqueReaders: stat.UniqueReaders,
+		})
+		report.TotalViews += stat.Views
+		report.UniqueReaders += stat.UniqueReaders
+	}
+
+	return report, nil
+}
+
+// ExportAuthorAnalytics returns the daily analytics of all posts of an author
+func (s *analyticsService) ExportAuthorAnalytics(ctx context.Context, userID uuid.UUID, from, to time.Time) ([]models.AuthorAnalyticsRow, error) {
+	from, to, err := analyticsRange(from, to)
+	if err != nil {
+		return nil, err
+	}
+
+	rows, err := s.analyticsRepo.FindAuthorDailyStats(ctx, userID,
+		from.Format(models.AnalyticsDateFormat), to.Format(models.AnalyticsDateFormat))
+	if err != nil {
+		logger.Error("Failed to export author analytics", logger.Err(err))
+		return nil, apperrors.ErrInternal
+	}
+
+	return rows, nil
+}
+
+// analyticsRange normalizes a report range to UTC days, defaulting to the
+// last defaultAnalyticsDays days when no dates are given
+func analyticsRange(from, to time.Time) (time.Time, time.Time, error) {
+	if to.IsZero() {
+		to = time.Now().UTC()
+	}
+	if from.IsZero() {
+		from = to.AddDate(0, 0, -(defaultAnalyticsDays - 1))
+	}
+
+	from = truncateDay(from)
+	to = truncateDay(to)
+
+	if from.After(to) {
+		return from, to, apperrors.ErrBadRequest.WithDetails("from must not be after to")
+	}
+	if to.Sub(from) >= maxAnalyticsDays*24*time.Hour {
+		return from, to, apperrors.ErrBadRequest.WithDetails("Date range must not exceed 366 days")
+	}
+
+	return from, to, nil
+}
+
+// truncateDay returns midnight UTC of the day of t
+func truncateDay(t time.Time) time.Time {
+	t = t.UTC()
+	return time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, time.UTC)
+}
+
+// normalizeReferrer reduces a Referer header to its host (e.g. "news.ycombinator.com")
+func normalizeReferrer(referrer string) string {
+	if referrer == "" {
+		return directReferrer
+	}
+
+	u, err := url.Parse(referrer)
+	if err != nil || u.Host == "" {
+		return directReferrer
+	}
+
+	host := strings.TrimPrefix(strings.ToLower(u.Hostname()), "www.")
+	if len(host) > 255 {
+		host = host[:255]
+	}
+	return host
+}
@@ -0,0 +1,279 @@
+package services
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/yourusername/go-enterprise-api/internal/models"
+	"github.com/yourusername/go-enterprise-api/internal/repository"
+	apperrors "github.com/yourusername/go-enterprise-api/pkg/errors"
+	"github.com/yourusername/go-enterprise-api/pkg/logger"
+)
+
+// CreateAppealRequest represents a request to appeal a moderation decision.
+// Banned users cannot log in, so ban appeals carry the account's credentials
+// instead.
+type CreateAppealRequest struct {
+	Type     string     `json:"type" binding:"required"`
+	PostID   *uuid.UUID `json:"post_id,omitempty"`
+	Message  string     `json:"message" binding:"notblank,max=5000" sanitize:"text"`
+	Email    string     `json:"email,omitempty" sanitize:"trim"`
+	Password string     `json:"password,omitempty"`
+}
+
+// ReviewAppealRequest represents an admin's review of an appeal
+type ReviewAppealRequest struct {
+	Status     string `json:"status" binding:"required"`
+	Resolution string `json:"resolution,omitempty" binding:"max=1000" sanitize:"text"`
+}
+
+// AppealService interface defines moderation appeal service methods
+type AppealService interface {
+	Create(ctx context.Context, user *models.User, req *CreateAppealRequest) (*models.Appeal, error)
+	GetByID(ctx context.Context, id uuid.UUID) (*models.Appeal, error)
+	GetQueue(ctx context.Context, status models.AppealStatus, appealType models.AppealType, page, pageSize int) ([]models.Appeal, int64, error)
+	Review(ctx context.Context, id, reviewerID uuid.UUID, req *ReviewAppealRequest) (*models.Appeal, error)
+}
+
+// appealService implements AppealService
+type appealService struct {
+	appealRepo      repository.AppealRepository
+	postRepo        repository.PostRepository
+	userRepo        repository.UserRepository
+	changeRepo      repository.ChangeRepository
+	takedownService TakedownService
+	notifier        NotificationService
+}
+
+// NewAppealService creates a new appeal service.
+// notifier may be nil, in which case users are not notified of resolutions.
+func NewAppealService(appealRepo repository.AppealRepository, postRepo repository.PostRepository, userRepo repository.UserRepository, changeRepo repository.ChangeRepository, takedownService TakedownService, notifier NotificationService) AppealService {
+	return &appealService{
+		appealRepo:      appealRepo,
+		postRepo:        postRepo,
+		userRepo:        userRepo,
+		changeRepo:      changeRepo,
+		takedownService: takedownService,
+		notifier:        notifier,
+	}
+}
+
+// Create files an appeal. user is the authenticated user, nil for ban
+// appeals authenticated with the request's credentials.
+func (s *appealService) Create(ctx context.Context, user *models.User, req *CreateAppealRequest) (*models.Appeal, error) {
+	switch models.AppealType(req.Type) {
+	case models.AppealTakedown:
+		return s.appealTakedown(ctx, user, req)
+	case models.AppealBan:
+		return s.appealBan(ctx, req)
+	default:
+		return nil, apperrors.ErrValidation.WithDetails("Invalid appeal type")
+	}
+}
+
+// appealTakedown files the author's appeal against the takedown of their
+// post. Each takedown can be appealed once.
+func (s *appealService) appealTakedown(ctx context.Context, user *models.User, req *CreateAppealRequest) (*models.Appeal, error) {
+	if user == nil {
+		return nil, apperrors.ErrUnauthorized.WithDetails("Log in to appeal a takedown")
+	}
+	if req.PostID == nil {
+		return nil, apperrors.ErrValidation.WithDetails("post_id is required for takedown appeals")
+	}
+
+	post, err := s.postRepo.FindByID(ctx, *req.PostID)
+	if err != nil {
+		return nil, err
+	}
+	if post.UserID != user.ID {
+		return nil, apperrors.ErrForbidden.WithDetails("Only the author can appeal a takedown")
+	}
+	if !post.IsTakenDown() {
+		return nil, apperrors.ErrConflict.WithDetails("Post has not been taken down")
+	}
+	if post.HasAppeal() {
+		return nil, apperrors.ErrConflict.WithDetails("The takedown has already been appealed")
+	}
+
+	appeal := &models.Appeal{
+		Type:    models.AppealTakedown,
+		Status:  models.AppealOpen,
+		Message: req.Message,
+		UserID:  user.ID,
+		PostID:  &post.ID,
+	}
+	if err := s.appealRepo.Create(ctx, appeal); err != nil {
+		logger.Error("Failed to create appeal", logger.Err(err))
+		return nil, apperrors.ErrInternal
+	}
+
+	// The takedown keeps a copy of the appeal for its tombstone and admin list
+	now := time.Now().UTC()
+	post.AppealMessage = req.Message
+	post.AppealedAt = &now
+	if err := s.postRepo.Update(ctx, post); err != nil {
+		logger.Error("Failed to record takedown appeal", logger.Err(err))
+		return nil, apperrors.ErrInternal
+	}
+
+	logger.Info("Takedown appealed",
+		logger.String("appeal_id", appeal.ID.String()),
+		logger.String("post_id", post.ID.String()),
+		logger.String("user_id", user.ID.String()),
+	)
+
+	appeal.Post = post
+	return appeal, nil
+}
+
+// appealBan files a banned user's appeal against their ban. A ban can be
+// appealed once.
+func (s *appealService) appealBan(ctx context.Context, req *CreateAppealRequest) (*models.Appeal, error) {
+	if req.Email == "" || req.Password == "" {
+		return nil, apperrors.ErrValidation.WithDetails("email and password are required for ban appeals")
+	}
+
+	user, err := s.userRepo.FindByEmail(ctx, req.Email)
+	if err != nil || user.IsServiceAccount() || !user.CheckPassword(req.Password) {
+		return nil, apperrors.ErrInvalidCredentials
+	}
+	if user.Status != models.StatusBanned {
+		return nil, apperrors.ErrConflict.WithDetails("Account is not banned")
+	}
+
+	exists, err := s.appealRepo.ExistsBanAppeal(ctx, user.ID)
+	if err != nil {
+		logger.Error("Failed to check ban appeals", logger.Err(err))
+		return nil, apperrors.ErrInternal
+	}
+	if exists {
+		return nil, apperrors.ErrConflict.WithDetails("The ban has already been appealed")
+	}
+
+	appeal := &models.Appeal{
+		Type:    models.AppealBan,
+		Status:  models.AppealOpen,
+		Message: req.Message,
+		UserID:  user.ID,
+	}
+	if err := s.appealRepo.Create(ctx, appeal); err != nil {
+		logger.Error("Failed to create appeal", logger.Err(err))
+		return nil, apperrors.ErrInternal
+	}
+
+	logger.Info("Ban appealed",
+		logger.String("appeal_id", appeal.ID.String()),
+		logger.String("user_id", user.ID.String()),
+	)
+	return appeal, nil
+}
+
+// GetByID retrieves an appeal
+func (s *appealService) GetByID(ctx context.Context, id uuid.UUID) (*models.Appeal, error) {
+	return s.appealRepo.FindWithRelations(ctx, id)
+}
+
+// GetQueue retrieves appeals, oldest first, optionally filtered by status
+// and type
+func (s *appealService) GetQueue(ctx context.Context, status models.AppealStatus, appealType models.AppealType, page, pageSize int) ([]models.Appeal, int64, error) {
+	if page < 1 {
+		page = 1
+	}
+	if pageSize < 1 || pageSize > 100 {
+		pageSize = 10
+	}
+
+	appeals, total, err := s.appealRepo.FindQueue(ctx, status, appealType, page, pageSize)
+	if err != nil {
+		logger.Error("Failed to get appeals", logger.Err(err))
+		return nil, 0, apperrors.ErrInternal
+	}
+	return appeals, total, nil
+}
+
+// Review moves an appeal to a new status. Overturning an appeal reverses the
+// decision: the post is reinstated or the user unbanned. The user is
+// notified once the appeal is resolved.
+func (s *appealService) Review(ctx context.Context, id, reviewerID uuid.UUID, req *ReviewAppealRequest) (*models.Appeal, error) {
+	appeal, err := s.appealRepo.FindWithRelations(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	status := models.AppealStatus(req.Status)
+	if !appeal.CanTransitionTo(status) {
+		return nil, apperrors.ErrConflict.WithDetails("Appeal cannot move from " + string(appeal.Status) + " to " + string(status))
+	}
+
+	if status == models.AppealOverturned {
+		if err := s.overturn(ctx, appeal, reviewerID); err != nil {
+			return nil, err
+		}
+	}
+
+	now := time.Now().UTC()
+	appeal.Status = status
+	appeal.ReviewerID = &reviewerID
+	if appeal.ReviewedAt == nil {
+		appeal.ReviewedAt = &now
+	}
+	if appeal.IsResolved() {
+		appeal.Resolution = req.Resolution
+		appeal.ResolvedAt = &now
+	}
+
+	if err := s.appealRepo.Update(ctx, appeal); err != nil {
+		logger.Error("Failed to update appeal", logger.Err(err))
+		return nil, apperrors.ErrInternal
+	}
+
+	logger.Info("Appeal reviewed",
+		logger.String("appeal_id", appeal.ID.String()),
+		logger.String("status", string(status)),
+		logger.String("admin_id", reviewerID.String()),
+	)
+
+	if appeal.IsResolved() && s.notifier != nil {
+		s.notifier.Notify(ctx, &models.Notification{
+			Type:   models.NotificationAppealResolved,
+			Reason: string(appeal.Status),
+			UserID: appeal.UserID,
+			PostID: appeal.PostID,
+		})
+	}
+
+	return appeal, nil
+}
+
+// overturn reverses the decision an appeal contests
+func (s *appealService) overturn(ctx context.Context, appeal *models.Appeal, reviewerID uuid.UUID) error {
+	switch appeal.Type {
+	case models.AppealTakedown:
+		// The post may have been reinstated or deleted in the meantime
+		if appeal.Post == nil || !appeal.Post.IsTakenDown() {
+			return nil
+		}
+		post, err := s.takedownService.Reinstate(ctx, appeal.Post.ID, reviewerID)
+		if err != nil {
+			return err
+		}
+		appeal.Post = post
+
+	case models.AppealBan:
+		user := appeal.User
+		if user == nil || user.Status != models.StatusBanned {
+			return nil
+		}
+		if err := s.userRepo.UpdateStatus(ctx, user.ID, models.StatusActive); err != nil {
+			logger.Error("Failed to unban user", logger.Err(err))
+			return apperrors.ErrInternal
+		}
+
+		changes := newChangeSet(models.ChangeEntityUser, user.ID, reviewerID)
+		changes.track("status", user.Status, models.StatusActive)
+		changes.save(ctx, s.changeRepo)
+		user.Status = models.StatusActive
+	}
+	return nil
+}
@@ -2,11 +2,15 @@ package services
 
 import (
 	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
 	"time"
 
 	"github.com/golang-jwt/jwt/v5"
 	"github.com/google/uuid"
 	"github.com/yourusername/go-enterprise-api/internal/config"
+	"github.com/yourusername/go-enterprise-api/internal/events"
 	"github.com/yourusername/go-enterprise-api/internal/models"
 	"github.com/yourusername/go-enterprise-api/internal/repository"
 	apperrors "github.com/yourusername/go-enterprise-api/pkg/errors"
@@ -33,10 +37,11 @@ type Claims struct {
 
 // RegisterRequest represents registration request data
 type RegisterRequest struct {
-	Email     string `json:"email" binding:"required,email"`
-	Password  string `json:"password" binding:"required,min=8"`
-	FirstName string `json:"first_name" binding:"required"`
-	LastName  string `json:"last_name" binding:"required"`
+	Email     string     `json:"email" binding:"required,email"`
+	Password  string     `json:"password" binding:"required,min=8"`
+	FirstName string     `json:"first_name" binding:"required"`
+	LastName  string     `json:"last_name" binding:"required"`
+	Birthdate *time.Time `json:"birthdate,omitempty"`
 }
 
 // LoginRequest represents login request data
@@ -54,19 +59,27 @@ type AuthService interface {
 	ValidateToken(tokenString string) (*Claims, error)
 	GetUserFromToken(ctx context.Context, claims *Claims) (*models.User, error)
 	ChangePassword(ctx context.Context, userID uuid.UUID, oldPassword, newPassword string) error
+	ResetPassword(ctx context.Context, userID uuid.UUID, newPassword string) error
+	AuthenticateAPIKey(ctx context.Context, rawKey string) (*models.User, error)
+	RecordSeen(ctx context.Context, user *models.User)
 }
 
 // authService implements AuthService
 type authService struct {
-	userRepo repository.UserRepository
-	config   *config.Config
+	userRepo   repository.UserRepository
+	apiKeyRepo repository.APIKeyRepository
+	bus        *events.Bus
+	config     *config.Config
 }
 
-// NewAuthService creates a new auth service
-func NewAuthService(userRepo repository.UserRepository, cfg *config.Config) AuthService {
+// NewAuthService creates a new auth service.
+// bus may be nil, in which case no events are published.
+func NewAuthService(userRepo repository.UserRepository, apiKeyRepo repository.APIKeyRepository, bus *events.Bus, cfg *config.Config) AuthService {
 	return &authService{
-		userRepo: userRepo,
-		config:   cfg,
+		userRepo:   userRepo,
+		apiKeyRepo: apiKeyRepo,
+		bus:        bus,
+		config:     cfg,
 	}
 }
 
@@ -82,12 +95,17 @@ func (s *authService) Register(ctx context.Context, req *RegisterRequest) (*mode
 		return nil, nil, apperrors.ErrEmailExists
 	}
 
+	if err := s.checkMinimumAge(req.Birthdate); err != nil {
+		return nil, nil, err
+	}
+
 	// Create user
 	user := &models.User{
 		Email:     req.Email,
 		Password:  req.Password,
 		FirstName: req.FirstName,
 		LastName:  req.LastName,
+		Birthdate: req.Birthdate,
 		Role:      models.RoleUser,
 		Status:    models.StatusActive, // In production, this might be StatusPending until email is verified
 	}
@@ -111,24 +129,63 @@ func (s *authService) Register(ctx context.Context, req *RegisterRequest) (*mode
 	}
 
 	logger.Info("User registered successfully", logger.String("email", user.Email))
+
+	s.bus.Publish(ctx, events.UserRegistered{User: user})
+
 	return user, tokens, nil
 }
 
+// checkMinimumAge enforces the configured minimum registration age. A
+// birthdate is required only while a minimum age is configured.
+func (s *authService) checkMinimumAge(birthdate *time.Time) error {
+	minimum := s.config.Age.MinimumAge
+	if minimum <= 0 {
+		return nil
+	}
+	if birthdate == nil {
+		return apperrors.ErrValidation.WithDetails("Birthdate is required")
+	}
+	if models.AgeAt(*birthdate, time.Now()) < minimum {
+		return apperrors.ErrForbidden.WithDetails(fmt.Sprintf("You must be at least %d years old to register", minimum))
+	}
+	return nil
+}
+
 // Login authenticates a user
 func (s *authService) Login(ctx context.Context, req *LoginRequest) (*models.User, *TokenPair, error) {
 	// Find user by email
 	user, err := s.userRepo.FindByEmail(ctx, req.Email)
 	if err != nil {
+		loginsTotal.Inc(loginFailed)
+		return nil, nil, apperrors.ErrInvalidCredentials
+	}
+
+	// Service accounts are non-interactive and cannot log in
+	if user.IsServiceAccount() {
+		loginsTotal.Inc(loginFailed)
 		return nil, nil, apperrors.ErrInvalidCredentials
 	}
 
 	// Check password
 	if !user.CheckPassword(req.Password) {
+		loginsTotal.Inc(loginFailed)
 		return nil, nil, apperrors.ErrInvalidCredentials
 	}
 
+	// Logging in during the grace period cancels a requested account deletion
+	if user.DeletionPending() {
+		if err := s.userRepo.CancelDeletion(ctx, user.ID); err != nil {
+			logger.Error("Failed to cancel account deletion", logger.Err(err))
+			return nil, nil, apperrors.ErrInternal
+		}
+		user.DeletionRequestedAt = nil
+		user.DeletePostsOnErasure = false
+		logger.Info("Account deletion cancelled", logger.String("user_id", user.ID.String()))
+	}
+
 	// Check if user is active
 	if !user.IsActive() {
+		loginsTotal.Inc(loginInactive)
 		return nil, nil, apperrors.ErrForbidden.WithDetails("Account is not active")
 	}
 
@@ -147,6 +204,14 @@ func (s *authService) Login(ctx context.Context, req *LoginRequest) (*models.Use
 		logger.Error("Failed to update last login", logger.Err(err))
 	}
 
+	// Logging in cancels a pending dormancy deactivation
+	if user.DormancyNotifiedAt != nil {
+		if err := s.userRepo.ClearDormancyNotice(ctx, user.ID); err != nil {
+			logger.Error("Failed to clear dormancy notice", logger.Err(err))
+		}
+	}
+
+	loginsTotal.Inc(loginSucceeded)
 	logger.Info("User logged in successfully", logger.String("email", user.Email))
 	return user, tokens, nil
 }
@@ -211,7 +276,7 @@ func (s *authService) ValidateToken(tokenString string) (*Claims, error) {
 		if _, ok := token.Method.(*jwt.SigningMethodHMAC); !ok {
 			return nil, apperrors.ErrInvalidToken
 		}
-		return []byte(s.config.JWT.Secret), nil
+		return []byte(s.config.JWTSecret()), nil
 	})
 
 	if err != nil {
@@ -242,6 +307,23 @@ func (s *authService) ChangePassword(ctx context.Context, userID uuid.UUID, oldP
 		return apperrors.ErrInvalidPassword
 	}
 
+	return s.setPassword(ctx, user, newPassword)
+}
+
+// ResetPassword sets a user's password without the old one, for operators
+// helping users locked out of their account
+func (s *authService) ResetPassword(ctx context.Context, userID uuid.UUID, newPassword string) error {
+	user, err := s.userRepo.FindByID(ctx, userID)
+	if err != nil {
+		return err
+	}
+	return s.setPassword(ctx, user, newPassword)
+}
+
+// setPassword replaces a user's password and ends their sessions
+func (s *authService) setPassword(ctx context.Context, user *models.User, newPassword string) error {
+	userID := user.ID
+
 	// Hash new password
 	hashedPassword, err := bcrypt.GenerateFromPassword([]byte(newPassword), bcrypt.DefaultCost)
 	if err != nil {
@@ -258,9 +340,54 @@ func (s *authService) ChangePassword(ctx context.Context, userID uuid.UUID, oldP
 		logger.Error("Failed to clear refresh token", logger.Err(err))
 	}
 
+	s.bus.Publish(ctx, events.PasswordChanged{User: user})
+
 	return nil
 }
 
+// AuthenticateAPIKey resolves the owner of an API key
+func (s *authService) AuthenticateAPIKey(ctx context.Context, rawKey string) (*models.User, error) {
+	key, err := s.apiKeyRepo.FindByHash(ctx, HashAPIKey(rawKey))
+	if err != nil {
+		return nil, apperrors.ErrInvalidAPIKey
+	}
+
+	if !key.IsValid() || key.User == nil {
+		return nil, apperrors.ErrInvalidAPIKey
+	}
+
+	// Avoid a write on every request by only recording usage once a minute
+	now := time.Now().UTC()
+	if key.LastUsedAt == nil || now.Sub(*key.LastUsedAt) > time.Minute {
+		if err := s.apiKeyRepo.UpdateLastUsed(ctx, key.ID, now); err != nil {
+			logger.Error("Failed to update API key last used", logger.Err(err))
+		}
+	}
+
+	return key.User, nil
+}
+
+// RecordSeen records that a user made a request. Like API key usage, the
+// last-seen time is only written once per PRESENCE_UPDATE_INTERVAL rather
+// than on every request.
+func (s *authService) RecordSeen(ctx context.Context, user *models.User) {
+	now := time.Now().UTC()
+	if user.LastSeenAt != nil && now.Sub(*user.LastSeenAt) < s.config.Presence.UpdateInterval {
+		return
+	}
+	if err := s.userRepo.UpdateLastSeen(ctx, user.ID, now); err != nil {
+		logger.Error("Failed to update last seen", logger.Err(err))
+		return
+	}
+	user.LastSeenAt = &now
+}
+
+// HashAPIKey returns the stored hash of a raw API key
+func HashAPIKey(rawKey string) string {
+	sum := sha256.Sum256([]byte(rawKey))
+	return hex.EncodeToString(sum[:])
+}
+
 // generateTokenPair generates access and refresh tokens
 func (s *authService) generateTokenPair(user *models.User) (*TokenPair, error) {
 	now := time.Now()
@@ -284,7 +411,7 @@ func (s *authService) generateTokenPair(user *models.User) (*TokenPair, error) {
 
 	// Generate access token
 	accessToken := jwt.NewWithClaims(jwt.SigningMethodHS256, accessClaims)
-	accessTokenString, err := accessToken.SignedString([]byte(s.config.JWT.Secret))
+	accessTokenString, err := accessToken.SignedString([]byte(s.config.JWTSecret()))
 	if err != nil {
 		return nil, err
 	}
@@ -306,7 +433,7 @@ func (s *authService) generateTokenPair(user *models.User) (*TokenPair, error) {
 
 	// Generate refresh token
 	refreshToken := jwt.NewWithClaims(jwt.SigningMethodHS256, refreshClaims)
-	refreshTokenString, err := refreshToken.SignedString([]byte(s.config.JWT.Secret))
+	refreshTokenString, err := refreshToken.SignedString([]byte(s.config.JWTSecret()))
 	if err != nil {
 		return nil, err
 	}
@@ -0,0 +1,174 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"image"
+	"io"
+	"net/http"
+	"strconv"
+
+	"github.com/google/uuid"
+	"github.com/yourusername/go-enterprise-api/internal/config"
+	"github.com/yourusername/go-enterprise-api/internal/imaging"
+	"github.com/yourusername/go-enterprise-api/internal/models"
+	"github.com/yourusername/go-enterprise-api/internal/repository"
+	"github.com/yourusername/go-enterprise-api/internal/storage"
+	apperrors "github.com/yourusername/go-enterprise-api/pkg/errors"
+	"github.com/yourusername/go-enterprise-api/pkg/logger"
+)
+
+// AvatarCrop is the square of an uploaded image, in pixels of the upright
+// image, that becomes an avatar
+type AvatarCrop struct {
+	X    int
+	Y    int
+	Size int
+}
+
+// AvatarService interface defines uploaded avatar methods
+type AvatarService interface {
+	Upload(ctx context.Context, user *models.User, file io.Reader, crop *AvatarCrop) (*models.User, error)
+	Open(ctx context.Context, user *models.User, size int) ([]byte, string, error)
+	DeleteFiles(ctx context.Context, key string)
+}
+
+// avatarService implements AvatarService
+type avatarService struct {
+	userRepo   repository.UserRepository
+	changeRepo repository.ChangeRepository
+	store      storage.Storage
+	avatar     *config.AvatarConfig
+	media      *config.MediaConfig
+}
+
+// NewAvatarService creates a new avatar service. Uploads are limited like
+// media uploads and linked to below the media base URL.
+func NewAvatarService(userRepo repository.UserRepository, changeRepo repository.ChangeRepository, store storage.Storage, avatarCfg *config.AvatarConfig, mediaCfg *config.MediaConfig) AvatarService {
+	return &avatarService{
+		userRepo:   userRepo,
+		changeRepo: changeRepo,
+		store:      store,
+		avatar:     avatarCfg,
+		media:      mediaCfg,
+	}
+}
+
+// Upload crops an uploaded image to a square, stores it in every avatar
+// size and makes it the user's avatar. The centered square is used when
+// crop is nil. The user's previous uploaded avatar is deleted.
+func (s *avatarService) Upload(ctx context.Context, user *models.User, file io.Reader, crop *AvatarCrop) (*models.User, error) {
+	data, err := io.ReadAll(io.LimitReader(file, s.media.MaxSize+1))
+	if err != nil {
+		return nil, apperrors.ErrBadRequest.WithDetails("Failed to read the uploaded file")
+	}
+	if int64(len(data)) > s.media.MaxSize {
+		return nil, apperrors.ErrBadRequest.WithDetails(fmt.Sprintf("File must be at most %d bytes", s.media.MaxSize))
+	}
+
+	img, format, err := imaging.Decode(data, s.media.MaxPixels)
+	if err != nil {
+		if errors.Is(err, imaging.ErrTooLarge) {
+			return nil, apperrors.ErrBadRequest.WithDetails(fmt.Sprintf("Images may have at most %d pixels", s.media.MaxPixels))
+		}
+		return nil, apperrors.ErrBadRequest.WithDetails("The file is not a valid JPEG, PNG or GIF image")
+	}
+
+	bounds := img.Bounds()
+	rect := imaging.CenterSquare(bounds)
+	if crop != nil {
+		rect = image.Rect(crop.X, crop.Y, crop.X+crop.Size, crop.Y+crop.Size)
+		if crop.X < 0 || crop.Y < 0 || crop.Size < 1 || !rect.In(bounds) {
+			return nil, apperrors.ErrBadRequest.WithDetails(fmt.Sprintf("The crop must be a square within the %dx%d image", bounds.Dx(), bounds.Dy()))
+		}
+	}
+	square := imaging.Crop(img, rect)
+
+	assetID := uuid.New().String()
+	key := storage.Join(s.avatar.Prefix, user.ID.String(), assetID)
+	for _, size := range s.avatar.UploadSizes {
+		sized := square
+		if square.Bounds().Dx() > size {
+			sized = imaging.Scale(square, size, size)
+		}
+		encoded, contentType, err := imaging.Encode(sized, format)
+		if err != nil {
+			logger.Error("Failed to encode avatar", logger.Err(err))
+			s.DeleteFiles(ctx, key)
+			return nil, apperrors.ErrInternal
+		}
+		if err := s.store.Put(ctx, sizeKey(key, size), encoded, contentType); err != nil {
+			logger.Error("Failed to store avatar", logger.Err(err), logger.String("key", sizeKey(key, size)))
+			s.DeleteFiles(ctx, key)
+			return nil, apperrors.ErrInternal
+		}
+	}
+
+	previousKey := user.AvatarKey
+	changes := newChangeSet(models.ChangeEntityUser, user.ID, user.ID)
+	url := s.media.BaseURL + user.AvatarURL() + "?v=" + assetID
+	changes.track("avatar", user.Avatar, url)
+	user.Avatar = url
+	user.AvatarKey = key
+
+	if err := s.userRepo.Update(ctx, user); err != nil {
+		s.DeleteFiles(ctx, key)
+		if apperrors.IsAppError(err) {
+			return nil, err
+		}
+		logger.Error("Failed to update user avatar", logger.Err(err))
+		return nil, apperrors.ErrInternal
+	}
+	changes.save(ctx, s.changeRepo)
+
+	if previousKey != "" {
+		s.DeleteFiles(ctx, previousKey)
+	}
+	return user, nil
+}
+
+// Open reads the user's uploaded avatar in the smallest stored size of at
+// least size pixels, or the largest when size is 0 or larger than any. It
+// also returns the avatar's content type.
+func (s *avatarService) Open(ctx context.Context, user *models.User, size int) ([]byte, string, error) {
+	if user.AvatarKey == "" {
+		return nil, "", apperrors.ErrNotFound.WithDetails("Avatar not found")
+	}
+
+	sizes := s.avatar.UploadSizes
+	stored := sizes[len(sizes)-1]
+	for _, candidate := range sizes {
+		if size > 0 && size <= candidate {
+			stored = candidate
+			break
+		}
+	}
+
+	key := sizeKey(user.AvatarKey, stored)
+	data, err := s.store.Get(ctx, key)
+	if err != nil {
+		if errors.Is(err, storage.ErrNotFound) {
+			logger.Warn("Avatar file is missing", logger.String("user_id", user.ID.String()), logger.String("key", key))
+			return nil, "", apperrors.ErrNotFound.WithDetails("Avatar not found")
+		}
+		logger.Error("Failed to read avatar", logger.Err(err), logger.String("key", key))
+		return nil, "", apperrors.ErrInternal
+	}
+	return data, http.DetectContentType(data), nil
+}
+
+// DeleteFiles deletes every size of an uploaded avatar from storage. Files
+// left behind are only logged, as they are no longer served.
+func (s *avatarService) DeleteFiles(ctx context.Context, key string) {
+	for _, size := range s.avatar.UploadSizes {
+		if err := s.store.Delete(ctx, sizeKey(key, size)); err != nil {
+			logger.Warn("Failed to delete avatar file", logger.Err(err), logger.String("key", sizeKey(key, size)))
+		}
+	}
+}
+
+// sizeKey returns the storage key of one size of an uploaded avatar
+func sizeKey(key string, size int) string {
+	return key + "-" + strconv.Itoa(size)
+}
@@ -0,0 +1,299 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/yourusername/go-enterprise-api/internal/backfill"
+	"github.com/yourusername/go-enterprise-api/internal/config"
+	"github.com/yourusername/go-enterprise-api/internal/models"
+	"github.com/yourusername/go-enterprise-api/internal/repository"
+	apperrors "github.com/yourusername/go-enterprise-api/pkg/errors"
+	"github.com/yourusername/go-enterprise-api/pkg/logger"
+)
+
+// BackfillOptions controls a backfill run. Zero values use the configured defaults.
+type BackfillOptions struct {
+	BatchSize  int
+	BatchDelay time.Duration
+	// Restart discards the checkpoint and starts from the first row
+	Restart bool
+	// StartedBy is the admin who started the run (nil for the CLI)
+	StartedBy *uuid.UUID
+}
+
+// BackfillJobResponse describes a backfill job and its latest checkpoint
+type BackfillJobResponse struct {
+	Name        string                             `json:"name"`
+	Description string                             `json:"description"`
+	Running     bool                               `json:"running"`
+	Checkpoint  *models.BackfillCheckpointResponse `json:"checkpoint,omitempty"`
+}
+
+// BackfillService interface defines running backfill jobs
+type BackfillService interface {
+	List(ctx context.Context) ([]BackfillJobResponse, error)
+	Get(ctx context.Context, name string) (*BackfillJobResponse, error)
+	Run(ctx context.Context, name string, opts BackfillOptions) (*models.BackfillCheckpoint, error)
+	Start(name string, opts BackfillOptions) error
+}
+
+// backfillService implements BackfillService
+type backfillService struct {
+	backfillRepo repository.BackfillRepository
+	jobs         []backfill.Job
+	cfg          *config.BackfillConfig
+
+	mu      sync.Mutex
+	running map[string]bool
+}
+
+// NewBackfillService creates a new backfill service for the given jobs
+func NewBackfillService(backfillRepo repository.BackfillRepository, jobs []backfill.Job, cfg *config.BackfillConfig) BackfillService {
+	return &backfillService{
+		backfillRepo: backfillRepo,
+		jobs:         jobs,
+		cfg:          cfg,
+		running:      make(map[string]bool),
+	}
+}
+
+// List returns all jobs with their latest checkpoints
+func (s *backfillService) List(ctx context.Context) ([]BackfillJobResponse, error) {
+	checkpoints, err := s.backfillRepo.FindAllCheckpoints(ctx)
+	if err != nil {
+		logger.Error("Failed to get backfill checkpoints", logger.Err(err))
+		return nil, apperrors.ErrInternal
+	}
+
+	byJob := make(map[string]*models.BackfillCheckpoint, len(checkpoints))
+	for i := range checkpoints {
+		byJob[checkpoints[i].Job] = &checkpoints[i]
+	}
+
+	jobs := make([]BackfillJobResponse, len(s.jobs))
+	for i, job := range s.jobs {
+		jobs[i] = s.describe(job, byJob[job.Name()])
+	}
+	return jobs, nil
+}
+
+// Get returns a job with its latest checkpoint
+func (s *backfillService) Get(ctx context.Context, name string) (*BackfillJobResponse, error) {
+	job, err := s.job(name)
+	if err != nil {
+		return nil, err
+	}
+
+	checkpoint, err := s.backfillRepo.FindByJob(ctx, name)
+	if err != nil && !errors.Is(err, apperrors.ErrNotFound) {
+		logger.Error("Failed to get backfill checkpoint", logger.Err(err))
+		return nil, apperrors.ErrInternal
+	}
+
+	response := s.describe(job, checkpoint)
+	return &response, nil
+}
+
+// Run runs a job to completion, resuming from its checkpoint unless the
+// previous run completed or opts.Restart is set. Progress is saved after
+// every batch; cancelling ctx interrupts the run, which returns ctx's error.
+func (s *backfillService) Run(ctx context.Context, name string, opts BackfillOptions) (*models.BackfillCheckpoint, error) {
+	job, err := s.job(name)
+	if err != nil {
+		return nil, err
+	}
+	if !s.acquire(name) {
+		return nil, errBackfillRunning()
+	}
+	defer s.release(name)
+
+	return s.run(ctx, job, opts)
+}
+
+// Start runs a job in the background and returns once it has been claimed
+func (s *backfillService) Start(name string, opts BackfillOptions) error {
+	job, err := s.job(name)
+	if err != nil {
+		return err
+	}
+	if !s.acquire(name) {
+		return errBackfillRunning()
+	}
+
+	go func() {
+		defer s.release(name)
+		// Errors are recorded on the checkpoint and logged by run
+		_, _ = s.run(context.Background(), job, opts)
+	}()
+	return nil
+}
+
+// errBackfillRunning returns the error for a job that is started twice
+func errBackfillRunning() error {
+	return apperrors.ErrConflict.WithDetails("Backfill job is already running")
+}
+
+// run processes batches until the job is done, ctx is cancelled or a batch fails
+func (s *backfillService) run(ctx context.Context, job backfill.Job, opts BackfillOptions) (*models.BackfillCheckpoint, error) {
+	batchSize := opts.BatchSize
+	if batchSize < 1 {
+		batchSize = s.cfg.BatchSize
+	}
+	delay := opts.BatchDelay
+	if delay <= 0 {
+		delay = s.cfg.BatchDelay
+	}
+
+	checkpoint, err := s.backfillRepo.FindByJob(ctx, job.Name())
+	if err != nil {
+		if !errors.Is(err, apperrors.ErrNotFound) {
+			logger.Error("Failed to get backfill checkpoint", logger.Err(err))
+			return nil, apperrors.ErrInternal
+		}
+		checkpoint = &models.BackfillCheckpoint{Job: job.Name()}
+	}
+
+	resumed := checkpoint.Cursor != "" && !checkpoint.IsFinished() && !opts.Restart
+	if !resumed {
+		checkpoint.Cursor = ""
+		checkpoint.Processed = 0
+		checkpoint.Batches = 0
+		checkpoint.StartedAt = time.Now().UTC()
+	}
+	checkpoint.Status = models.BackfillStatusRunning
+	checkpoint.LastError = ""
+	checkpoint.FinishedAt = nil
+	checkpoint.StartedBy = opts.StartedBy
+	if err := s.backfillRepo.Update(ctx, checkpoint); err != nil {
+		logger.Error("Failed to save backfill checkpoint", logger.Err(err))
+		return nil, apperrors.ErrInternal
+	}
+
+	logger.Info("Backfill started",
+		logger.String("job", job.Name()),
+		logger.Any("resumed", resumed),
+		logger.Int("batch_size", batchSize),
+	)
+
+	for {
+		if err := ctx.Err(); err != nil {
+			return s.finish(checkpoint, models.BackfillStatusInterrupted, err)
+		}
+
+		cursor, processed, err := job.Batch(ctx, checkpoint.Cursor, batchSize)
+		if err != nil {
+			if ctx.Err() != nil {
+				return s.finish(checkpoint, models.BackfillStatusInterrupted, ctx.Err())
+			}
+			return s.finish(checkpoint, models.BackfillStatusFailed, err)
+		}
+		if processed == 0 {
+			return s.finish(checkpoint, models.BackfillStatusCompleted, nil)
+		}
+
+		checkpoint.Cursor = cursor
+		checkpoint.Processed += int64(processed)
+		checkpoint.Batches++
+		if err := s.backfillRepo.Update(ctx, checkpoint); err != nil {
+			return s.finish(checkpoint, models.BackfillStatusFailed, err)
+		}
+
+		if processed < batchSize {
+			return s.finish(checkpoint, models.BackfillStatusCompleted, nil)
+		}
+
+		select {
+		case <-ctx.Done():
+		case <-time.After(delay):
+		}
+	}
+}
+
+// finish records the outcome of a run. The checkpoint is saved without the
+// run's context so that interrupted runs can still be resumed.
+func (s *backfillService) finish(checkpoint *models.BackfillCheckpoint, status models.BackfillStatus, runErr error) (*models.BackfillCheckpoint, error) {
+	now := time.Now().UTC()
+	checkpoint.Status = status
+	checkpoint.FinishedAt = &now
+	if runErr != nil {
+		checkpoint.LastError = truncate(runErr.Error(), 1000)
+	}
+	if status == models.BackfillStatusCompleted {
+		checkpoint.Cursor = ""
+	}
+
+	if err := s.backfillRepo.Update(context.Background(), checkpoint); err != nil {
+		logger.Error("Failed to save backfill checkpoint", logger.Err(err))
+	}
+
+	switch status {
+	case models.BackfillStatusCompleted:
+		logger.Info("Backfill completed",
+			logger.String("job", checkpoint.Job),
+			logger.Any("processed", checkpoint.Processed),
+		)
+		return checkpoint, nil
+	case models.BackfillStatusInterrupted:
+		logger.Warn("Backfill interrupted",
+			logger.String("job", checkpoint.Job),
+			logger.Any("processed", checkpoint.Processed),
+		)
+		return checkpoint, runErr
+	default:
+		logger.Error("Backfill failed",
+			logger.String("job", checkpoint.Job),
+			logger.Any("processed", checkpoint.Processed),
+			logger.Err(runErr),
+		)
+		return checkpoint, apperrors.ErrInternal
+	}
+}
+
+// job finds a registered job by name
+func (s *backfillService) job(name string) (backfill.Job, error) {
+	for _, job := range s.jobs {
+		if job.Name() == name {
+			return job, nil
+		}
+	}
+	return nil, apperrors.ErrNotFound.WithDetails("Backfill job not found")
+}
+
+// describe builds the response for a job
+func (s *backfillService) describe(job backfill.Job, checkpoint *models.BackfillCheckpoint) BackfillJobResponse {
+	s.mu.Lock()
+	running := s.running[job.Name()]
+	s.mu.Unlock()
+
+	response := BackfillJobResponse{
+		Name:        job.Name(),
+		Description: job.Description(),
+		Running:     running,
+	}
+	if checkpoint != nil {
+		response.Checkpoint = checkpoint.ToResponse()
+	}
+	return response
+}
+
+// acquire claims a job for this process, returning false if it is already running
+func (s *backfillService) acquire(name string) bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.running[name] {
+		return false
+	}
+	s.running[name] = true
+	return true
+}
+
+// release frees a job claimed with acquire
+func (s *backfillService) release(name string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	delete(s.running, name)
+}
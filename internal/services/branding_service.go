@@ -0,0 +1,132 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"regexp"
+	"strings"
+
+	"github.com/yourusername/go-enterprise-api/internal/models"
+	"github.com/yourusername/go-enterprise-api/internal/repository"
+	apperrors "github.com/yourusername/go-enterprise-api/pkg/errors"
+	"github.com/yourusername/go-enterprise-api/pkg/logger"
+)
+
+// maxFooterLinks bounds the number of links in the footer
+const maxFooterLinks = 10
+
+// hexColor matches #rgb and #rrggbb colors
+var hexColor = regexp.MustCompile(`^#([0-9a-fA-F]{3}|[0-9a-fA-F]{6})$`)
+
+// UpdateBrandingRequest represents the update branding request. It replaces
+// the whole branding; fields left empty fall back to their defaults.
+type UpdateBrandingRequest struct {
+	SiteTitle    string              `json:"site_title" binding:"max=100"`
+	LogoURL      string              `json:"logo_url" binding:"max=2048"`
+	PrimaryColor string              `json:"primary_color"`
+	AccentColor  string              `json:"accent_color"`
+	FooterLinks  []models.FooterLink `json:"footer_links"`
+}
+
+// BrandingService interface defines site branding methods
+type BrandingService interface {
+	Get(ctx context.Context) (*models.Branding, error)
+	Update(ctx context.Context, admin *models.User, req *UpdateBrandingRequest) (*models.Branding, error)
+	Current(ctx context.Context) *models.Branding
+}
+
+// brandingService implements BrandingService
+type brandingService struct {
+	brandingRepo repository.BrandingRepository
+	siteName     string
+}
+
+// NewBrandingService creates a new branding service. siteName is the site
+// title used until one is set.
+func NewBrandingService(brandingRepo repository.BrandingRepository, siteName string) BrandingService {
+	return &brandingService{
+		brandingRepo: brandingRepo,
+		siteName:     siteName,
+	}
+}
+
+// Get returns the site's branding with defaults filled in
+func (s *brandingService) Get(ctx context.Context) (*models.Branding, error) {
+	branding, err := s.brandingRepo.Get(ctx)
+	if err != nil {
+		logger.Error("Failed to load branding", logger.Err(err))
+		return nil, apperrors.ErrInternal
+	}
+	return branding.WithDefaults(s.siteName), nil
+}
+
+// Update replaces the site's branding (admin only)
+func (s *brandingService) Update(ctx context.Context, admin *models.User, req *UpdateBrandingRequest) (*models.Branding, error) {
+	if err := validateBranding(req); err != nil {
+		return nil, err
+	}
+
+	branding := &models.Branding{
+		SiteTitle:    strings.TrimSpace(req.SiteTitle),
+		LogoURL:      req.LogoURL,
+		PrimaryColor: strings.ToLower(req.PrimaryColor),
+		AccentColor:  strings.ToLower(req.AccentColor),
+		UpdatedBy:    &admin.ID,
+	}
+	branding.SetFooterLinks(req.FooterLinks)
+
+	if err := s.brandingRepo.Save(ctx, branding); err != nil {
+		logger.Error("Failed to save branding", logger.Err(err))
+		return nil, apperrors.ErrInternal
+	}
+
+	logger.Info("Branding updated", logger.String("admin_id", admin.ID.String()))
+	return branding.WithDefaults(s.siteName), nil
+}
+
+// Current returns the branding to render pages and emails with. It never
+// fails: when the branding cannot be loaded the defaults are used, so a
+// database hiccup does not hold up an email.
+func (s *brandingService) Current(ctx context.Context) *models.Branding {
+	branding, err := s.brandingRepo.Get(ctx)
+	if err != nil {
+		logger.Warn("Failed to load branding, using defaults", logger.Err(err))
+		branding = &models.Branding{}
+	}
+	return branding.WithDefaults(s.siteName)
+}
+
+// validateBranding checks the colors and links of a branding. Links are
+// shown in emails and pages, so only absolute http(s) URLs are accepted.
+func validateBranding(req *UpdateBrandingRequest) error {
+	if req.PrimaryColor != "" && !hexColor.MatchString(req.PrimaryColor) {
+		return apperrors.ErrValidation.WithDetails("primary_color must be a hex color such as #1a2b3c")
+	}
+	if req.AccentColor != "" && !hexColor.MatchString(req.AccentColor) {
+		return apperrors.ErrValidation.WithDetails("accent_color must be a hex color such as #1a2b3c")
+	}
+
+	if req.LogoURL != "" && !isWebURL(req.LogoURL) {
+		return apperrors.ErrValidation.WithDetails("logo_url must be an absolute http or https URL")
+	}
+
+	if len(req.FooterLinks) > maxFooterLinks {
+		return apperrors.ErrValidation.WithDetails(fmt.Sprintf("At most %d footer links are allowed", maxFooterLinks))
+	}
+	for i, link := range req.FooterLinks {
+		if strings.TrimSpace(link.Label) == "" || len(link.Label) > 50 {
+			return apperrors.ErrValidation.WithDetails(fmt.Sprintf("footer_links[%d].label must be 1 to 50 characters", i))
+		}
+		if len(link.URL) > 2048 || !isWebURL(link.URL) {
+			return apperrors.ErrValidation.WithDetails(fmt.Sprintf("footer_links[%d].url must be an absolute http or https URL", i))
+		}
+	}
+	return nil
+}
+
+// isWebURL reports whether raw is an absolute http or https URL
+func isWebURL(raw string) bool {
+	u, err := url.Parse(raw)
+	return err == nil && (u.Scheme == "http" || u.Scheme == "https") && u.Host != ""
+}
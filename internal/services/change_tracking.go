@@ -0,0 +1,84 @@
+package services
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/yourusername/go-enterprise-api/internal/geoip"
+	"github.com/yourusername/go-enterprise-api/internal/models"
+	"github.com/yourusername/go-enterprise-api/internal/repository"
+	apperrors "github.com/yourusername/go-enterprise-api/pkg/errors"
+	"github.com/yourusername/go-enterprise-api/pkg/logger"
+)
+
+// changeSet collects field-level changes for a single entity update
+type changeSet struct {
+	entityType string
+	entityID   uuid.UUID
+	actorID    *uuid.UUID
+	changes    []models.Change
+}
+
+// newChangeSet creates a new changeSet. actorID may be uuid.Nil for system changes.
+func newChangeSet(entityType string, entityID, actorID uuid.UUID) *changeSet {
+	cs := &changeSet{
+		entityType: entityType,
+		entityID:   entityID,
+	}
+	if actorID != uuid.Nil {
+		cs.actorID = &actorID
+	}
+	return cs
+}
+
+// track records a field change if the old and new values differ
+func (cs *changeSet) track(field string, oldValue, newValue interface{}) {
+	oldStr := fmt.Sprint(oldValue)
+	newStr := fmt.Sprint(newValue)
+	if oldStr == newStr {
+		return
+	}
+
+	cs.changes = append(cs.changes, models.Change{
+		EntityType: cs.entityType,
+		EntityID:   cs.entityID,
+		Field:      field,
+		OldValue:   oldStr,
+		NewValue:   newStr,
+		ActorID:    cs.actorID,
+	})
+}
+
+// save persists the collected changes, tagged with the request's country
+// when known. Failures are logged rather than returned because the timeline
+// must never block the update itself.
+func (cs *changeSet) save(ctx context.Context, repo repository.ChangeRepository) {
+	if err := cs.create(ctx, repo); err != nil {
+		logger.Error("Failed to record changes",
+			logger.String("entity_type", cs.entityType),
+			logger.String("entity_id", cs.entityID.String()),
+			logger.Err(err),
+		)
+	}
+}
+
+// create persists the collected changes like save, but returns failures.
+// Inside a transaction a failed insert aborts it, so it must be reported.
+func (cs *changeSet) create(ctx context.Context, repo repository.ChangeRepository) error {
+	if len(cs.changes) == 0 {
+		return nil
+	}
+	if country := geoip.CountryFromContext(ctx); country != "" {
+		for i := range cs.changes {
+			cs.changes[i].Country = country
+		}
+	}
+	return repo.CreateMany(ctx, cs.changes)
+}
+
+// errVersionConflict is returned when an edit is based on an outdated version
+// of a record, whose current version is given
+func errVersionConflict(current int64) error {
+	return apperrors.ErrConflict.WithDetails(fmt.Sprintf("The record was changed by someone else and is now at version %d; reload it and try again", current))
+}
@@ -0,0 +1,304 @@
+package services
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/yourusername/go-enterprise-api/internal/config"
+	"github.com/yourusername/go-enterprise-api/internal/events"
+	"github.com/yourusername/go-enterprise-api/internal/models"
+	"github.com/yourusername/go-enterprise-api/internal/repository"
+	"github.com/yourusername/go-enterprise-api/internal/spam"
+	apperrors "github.com/yourusername/go-enterprise-api/pkg/errors"
+	"github.com/yourusername/go-enterprise-api/pkg/logger"
+)
+
+// CreateCommentRequest represents the create comment request
+type CreateCommentRequest struct {
+	Content string `json:"content" binding:"required"`
+}
+
+// CommentOrigin describes the request a comment was submitted with, for spam checks
+type CommentOrigin struct {
+	IP        string
+	UserAgent string
+	Referrer  string
+}
+
+// CommentService interface defines comment service methods
+type CommentService interface {
+	Create(ctx context.Context, postID uuid.UUID, author *models.User, req *CreateCommentRequest, origin CommentOrigin) (*models.Comment, error)
+	GetByPost(ctx context.Context, postID uuid.UUID, viewer *models.User, page, pageSize int) ([]models.Comment, int64, error)
+	CheckReadable(ctx context.Context, postID uuid.UUID, viewer *models.User) error
+	Delete(ctx context.Context, id uuid.UUID, user *models.User) error
+	GetQueue(ctx context.Context, status models.CommentStatus, page, pageSize int) ([]models.Comment, int64, error)
+	Moderate(ctx context.Context, ids []uuid.UUID, status models.CommentStatus, moderatorID uuid.UUID) (int64, error)
+}
+
+// commentService implements CommentService
+type commentService struct {
+	commentRepo repository.CommentRepository
+	postRepo    repository.PostRepository
+	checker     spam.Checker
+	notifier    NotificationService
+	bus         *events.Bus
+	cfg         *config.CommentConfig
+	adultAge    int
+}
+
+// NewCommentService creates a new comment service.
+// checker may be nil, in which case comments are not checked for spam;
+// notifier and bus may be nil, in which case post authors are not
+// notified and no events are published.
+func NewCommentService(commentRepo repository.CommentRepository, postRepo repository.PostRepository, checker spam.Checker, notifier NotificationService, bus *events.Bus, cfg *config.CommentConfig, adultAge int) CommentService {
+	return &commentService{
+		commentRepo: commentRepo,
+		postRepo:    postRepo,
+		checker:     checker,
+		notifier:    notifier,
+		bus:         bus,
+		cfg:         cfg,
+		adultAge:    adultAge,
+	}
+}
+
+// Create adds a comment to a published post. Comments by admins and
+// moderators are approved straight away. Other comments are checked for
+// spam and, when first-time moderation is enabled, held for approval until
+// the author has an approved comment.
+func (s *commentService) Create(ctx context.Context, postID uuid.UUID, author *models.User, req *CreateCommentRequest, origin CommentOrigin) (*models.Comment, error) {
+	post, err := s.commentablePost(ctx, postID, author)
+	if err != nil {
+		return nil, err
+	}
+
+	comment := &models.Comment{
+		Content:  req.Content,
+		PostID:   post.ID,
+		UserID:   author.ID,
+		User:     author,
+		Shadowed: author.IsShadowBanned(),
+	}
+	comment.Status, err = s.initialStatus(ctx, comment, author, origin)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.commentRepo.Create(ctx, comment); err != nil {
+		logger.Error("Failed to create comment", logger.Err(err))
+		return nil, apperrors.ErrInternal
+	}
+
+	if comment.IsApproved() {
+		s.announce(ctx, comment, post)
+	}
+
+	return comment, nil
+}
+
+// initialStatus decides the moderation state of a new comment
+func (s *commentService) initialStatus(ctx context.Context, comment *models.Comment, author *models.User, origin CommentOrigin) (models.CommentStatus, error) {
+	if author.IsAdmin() || author.IsModerator() {
+		return models.CommentStatusApproved, nil
+	}
+
+	if s.checker != nil {
+		isSpam, err := s.checker.IsSpam(ctx, &spam.Content{
+			Type:        "comment",
+			Body:        comment.Content,
+			AuthorName:  author.FullName(),
+			AuthorEmail: author.Email,
+			IP:          origin.IP,
+			UserAgent:   origin.UserAgent,
+			Referrer:    origin.Referrer,
+		})
+		if err != nil {
+			// Hold the comment for a moderator rather than rejecting it or letting it through unchecked
+			logger.Error("Spam check failed",
+				logger.String("backend", s.checker.Name()),
+				logger.Err(err),
+			)
+			return models.CommentStatusPending, nil
+		}
+		if isSpam {
+			logger.Info("Comment flagged as spam",
+				logger.String("backend", s.checker.Name()),
+				logger.String("user_id", author.ID.String()),
+				logger.String("post_id", comment.PostID.String()),
+			)
+			return models.CommentStatusSpam, nil
+		}
+	}
+
+	if s.cfg.ModerateFirstTime {
+		approved, err := s.commentRepo.CountByUserAndStatus(ctx, author.ID, models.CommentStatusApproved)
+		if err != nil {
+			logger.Error("Failed to count approved comments", logger.Err(err))
+			return "", apperrors.ErrInternal
+		}
+		if approved == 0 {
+			return models.CommentStatusPending, nil
+		}
+	}
+
+	return models.CommentStatusApproved, nil
+}
+
+// GetByPost retrieves the approved comments on a post
+func (s *commentService) GetByPost(ctx context.Context, postID uuid.UUID, viewer *models.User, page, pageSize int) ([]models.Comment, int64, error) {
+	if page < 1 {
+		page = 1
+	}
+	if pageSize < 1 || pageSize > 100 {
+		pageSize = 10
+	}
+
+	post, err := s.commentablePost(ctx, postID, viewer)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	comments, total, err := s.commentRepo.FindByPost(ctx, post.ID, models.CommentStatusApproved, page, pageSize)
+	if err != nil {
+		logger.Error("Failed to get comments", logger.Err(err))
+		return nil, 0, apperrors.ErrInternal
+	}
+
+	return comments, total, nil
+}
+
+// CheckReadable reports whether viewer may read the comments on a post,
+// returning the error GetByPost would otherwise
+func (s *commentService) CheckReadable(ctx context.Context, postID uuid.UUID, viewer *models.User) error {
+	_, err := s.commentablePost(ctx, postID, viewer)
+	return err
+}
+
+// Delete deletes a comment. Authors can delete their own comments; admins
+// and moderators can delete any comment.
+func (s *commentService) Delete(ctx context.Context, id uuid.UUID, user *models.User) error {
+	comment, err := s.commentRepo.FindByID(ctx, id)
+	if err != nil {
+		return err
+	}
+
+	if comment.UserID != user.ID && !user.IsAdmin() && !user.IsModerator() {
+		return apperrors.ErrForbidden
+	}
+
+	if err := s.commentRepo.Delete(ctx, id); err != nil {
+		logger.Error("Failed to delete comment", logger.Err(err))
+		return apperrors.ErrInternal
+	}
+
+	return nil
+}
+
+// GetQueue retrieves comments awaiting moderation (or marked as spam) across all posts
+func (s *commentService) GetQueue(ctx context.Context, status models.CommentStatus, page, pageSize int) ([]models.Comment, int64, error) {
+	if page < 1 {
+		page = 1
+	}
+	if pageSize < 1 || pageSize > 100 {
+		pageSize = 10
+	}
+
+	comments, total, err := s.commentRepo.FindByStatus(ctx, status, page, pageSize)
+	if err != nil {
+		logger.Error("Failed to get moderation queue", logger.Err(err))
+		return nil, 0, apperrors.ErrInternal
+	}
+
+	return comments, total, nil
+}
+
+// Moderate sets the status of several comments and returns the number of
+// comments that changed
+func (s *commentService) Moderate(ctx context.Context, ids []uuid.UUID, status models.CommentStatus, moderatorID uuid.UUID) (int64, error) {
+	if len(ids) == 0 {
+		return 0, apperrors.ErrBadRequest.WithDetails("At least one ID is required")
+	}
+	if len(ids) > MaxBulkItems {
+		return 0, apperrors.ErrBadRequest.WithDetails("Too many IDs in a single operation")
+	}
+
+	// Comments about to be approved, whose authors are notified
+	var approved []models.Comment
+	if status == models.CommentStatusApproved && s.notifier != nil {
+		comments, err := s.commentRepo.FindByIDs(ctx, ids)
+		if err != nil {
+			logger.Error("Failed to get comments", logger.Err(err))
+			return 0, apperrors.ErrInternal
+		}
+		for _, comment := range comments {
+			if !comment.IsApproved() {
+				approved = append(approved, comment)
+			}
+		}
+	}
+
+	moderated, err := s.commentRepo.UpdateStatus(ctx, ids, status, moderatorID)
+	if err != nil {
+		logger.Error("Failed to moderate comments", logger.Err(err))
+		return 0, apperrors.ErrInternal
+	}
+
+	for i := range approved {
+		comment := &approved[i]
+		comment.Status = status
+		s.notifier.Notify(ctx, &models.Notification{
+			Type:      models.NotificationCommentApproved,
+			UserID:    comment.UserID,
+			ActorID:   &moderatorID,
+			PostID:    &comment.PostID,
+			CommentID: &comment.ID,
+		})
+		if comment.Post != nil {
+			s.announce(ctx, comment, comment.Post)
+		}
+	}
+
+	logger.Info("Comments moderated",
+		logger.String("status", string(status)),
+		logger.String("moderator_id", moderatorID.String()),
+		logger.Any("count", moderated),
+	)
+	return moderated, nil
+}
+
+// announce notifies the author of a post of a new visible comment and
+// publishes it. Shadowed comments are not announced, so they stay unnoticed.
+func (s *commentService) announce(ctx context.Context, comment *models.Comment, post *models.Post) {
+	if comment.Shadowed {
+		return
+	}
+	s.notifyPostAuthor(ctx, comment, post)
+	s.bus.Publish(ctx, events.CommentCreated{Comment: comment, Post: post})
+}
+
+// notifyPostAuthor notifies the author of a post of a new visible comment
+func (s *commentService) notifyPostAuthor(ctx context.Context, comment *models.Comment, post *models.Post) {
+	if s.notifier == nil {
+		return
+	}
+	s.notifier.Notify(ctx, &models.Notification{
+		Type:      models.NotificationComment,
+		UserID:    post.UserID,
+		ActorID:   &comment.UserID,
+		PostID:    &post.ID,
+		CommentID: &comment.ID,
+	})
+}
+
+// commentablePost loads a post whose comments viewer may read and write.
+// Unpublished posts and mature posts the viewer may not see are reported as not found.
+func (s *commentService) commentablePost(ctx context.Context, postID uuid.UUID, viewer *models.User) (*models.Post, error) {
+	post, err := s.postRepo.FindByID(ctx, postID)
+	if err != nil {
+		return nil, err
+	}
+	if !post.IsPublished() || post.IsHiddenFrom(viewer) || !post.IsVisibleTo(viewer, s.adultAge) {
+		return nil, apperrors.ErrNotFound.WithDetails("Post not found")
+	}
+	return post, nil
+}
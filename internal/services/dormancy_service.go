@@ -0,0 +1,273 @@
+package services
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/yourusername/go-enterprise-api/internal/config"
+	"github.com/yourusername/go-enterprise-api/internal/models"
+	"github.com/yourusername/go-enterprise-api/internal/repository"
+	apperrors "github.com/yourusername/go-enterprise-api/pkg/errors"
+	"github.com/yourusername/go-enterprise-api/pkg/logger"
+)
+
+// dormancyBatchSize is the number of users processed per query during a run
+const dormancyBatchSize = 100
+
+// anonymizedName replaces the names of anonymized users
+const anonymizedName = "Deleted User"
+
+// DormancyNotifier delivers the notice sent to users before their account is
+// deactivated. Users are only marked as notified once NotifyDormant succeeds.
+type DormancyNotifier interface {
+	NotifyDormant(ctx context.Context, user *models.User, deactivateAt time.Time) error
+}
+
+// DormancyReport summarizes a single run of the dormancy policy
+type DormancyReport struct {
+	Notified    int `json:"notified"`
+	Deactivated int `json:"deactivated"`
+	Anonymized  int `json:"anonymized"`
+}
+
+// DormancyService interface defines the dormant account lifecycle policy
+type DormancyService interface {
+	Run(ctx context.Context) (*DormancyReport, error)
+	Schedule(ctx context.Context)
+}
+
+// dormancyService implements DormancyService
+type dormancyService struct {
+	userRepo   repository.UserRepository
+	changeRepo repository.ChangeRepository
+	avatars    AvatarService
+	notifier   DormancyNotifier
+	cfg        *config.DormancyConfig
+}
+
+// NewDormancyService creates a new dormancy service sending notices with
+// notifier
+func NewDormancyService(userRepo repository.UserRepository, changeRepo repository.ChangeRepository, avatars AvatarService, notifier DormancyNotifier, cfg *config.DormancyConfig) DormancyService {
+	return &dormancyService{
+		userRepo:   userRepo,
+		changeRepo: changeRepo,
+		avatars:    avatars,
+		notifier:   notifier,
+		cfg:        cfg,
+	}
+}
+
+// Run applies the policy once: notifies users inactive for NoticeAfterDays,
+// deactivates notified users after a further DeactivateAfterDays and, when
+// enabled, anonymizes deactivated accounts after AnonymizeAfterDays
+func (s *dormancyService) Run(ctx context.Context) (*DormancyReport, error) {
+	now := time.Now().UTC()
+	report := &DormancyReport{}
+
+	var err error
+	if report.Notified, err = s.notify(ctx, now); err != nil {
+		logger.Error("Failed to send dormancy notices", logger.Err(err))
+		return report, apperrors.ErrInternal
+	}
+	if report.Deactivated, err = s.deactivate(ctx, now); err != nil {
+		logger.Error("Failed to deactivate dormant users", logger.Err(err))
+		return report, apperrors.ErrInternal
+	}
+	if s.cfg.AnonymizeAfterDays > 0 {
+		if report.Anonymized, err = s.anonymize(ctx, now); err != nil {
+			logger.Error("Failed to anonymize dormant users", logger.Err(err))
+			return report, apperrors.ErrInternal
+		}
+	}
+
+	logger.Info("Dormancy policy applied",
+		logger.Int("notified", report.Notified),
+		logger.Int("deactivated", report.Deactivated),
+		logger.Int("anonymized", report.Anonymized),
+	)
+	return report, nil
+}
+
+// Schedule runs the policy on the configured interval until ctx is
+// cancelled. A run in progress is finished first.
+func (s *dormancyService) Schedule(ctx context.Context) {
+	ticker := time.NewTicker(s.cfg.Interval)
+	defer ticker.Stop()
+
+	for {
+		if _, err := s.Run(context.WithoutCancel(ctx)); err != nil {
+			logger.Error("Scheduled dormancy run failed", logger.Err(err))
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// notify sends a notice to users who have been inactive for NoticeAfterDays
+func (s *dormancyService) notify(ctx context.Context, now time.Time) (int, error) {
+	cutoff := now.AddDate(0, 0, -s.cfg.NoticeAfterDays)
+	deactivateAt := now.AddDate(0, 0, s.cfg.DeactivateAfterDays)
+
+	count := 0
+	for {
+		users, err := s.userRepo.FindLastActiveBefore(ctx, cutoff, dormancyBatchSize)
+		if err != nil {
+			return count, err
+		}
+
+		marked := 0
+		for i := range users {
+			user := &users[i]
+			if err := s.notifier.NotifyDormant(ctx, user, deactivateAt); err != nil {
+				// Leave the user unmarked so the notice is retried on the next run
+				logger.Error("Failed to deliver dormancy notice",
+					logger.String("user_id", user.ID.String()),
+					logger.Err(err),
+				)
+				continue
+			}
+
+			notifiedAt := now
+			user.DormancyNotifiedAt = &notifiedAt
+			if err := s.userRepo.Update(ctx, user); err != nil {
+				return count, err
+			}
+
+			changes := newChangeSet(models.ChangeEntityUser, user.ID, uuid.Nil)
+			changes.track("dormancy_notified_at", "", notifiedAt.Format(time.RFC3339))
+			changes.save(ctx, s.changeRepo)
+			marked++
+		}
+		count += marked
+
+		// Stop when a batch made no progress so failing deliveries can't loop forever
+		if len(users) < dormancyBatchSize || marked == 0 {
+			return count, nil
+		}
+	}
+}
+
+// deactivate marks users as inactive once their notice period has passed
+func (s *dormancyService) deactivate(ctx context.Context, now time.Time) (int, error) {
+	cutoff := now.AddDate(0, 0, -s.cfg.DeactivateAfterDays)
+
+	count := 0
+	for {
+		users, err := s.userRepo.FindDormancyNotifiedBefore(ctx, cutoff, dormancyBatchSize)
+		if err != nil {
+			return count, err
+		}
+
+		for i := range users {
+			user := &users[i]
+			oldStatus := user.Status
+
+			dormantSince := now
+			user.Status = models.StatusInactive
+			user.DormantSince = &dormantSince
+			user.RefreshToken = ""
+			if err := s.userRepo.Update(ctx, user); err != nil {
+				return count, err
+			}
+
+			changes := newChangeSet(models.ChangeEntityUser, user.ID, uuid.Nil)
+			changes.track("status", oldStatus, user.Status)
+			changes.track("dormant_since", "", dormantSince.Format(time.RFC3339))
+			changes.save(ctx, s.changeRepo)
+			count++
+		}
+
+		if len(users) < dormancyBatchSize {
+			return count, nil
+		}
+	}
+}
+
+// anonymize strips personal data from accounts that stayed dormant for AnonymizeAfterDays
+func (s *dormancyService) anonymize(ctx context.Context, now time.Time) (int, error) {
+	cutoff := now.AddDate(0, 0, -s.cfg.AnonymizeAfterDays)
+
+	count := 0
+	for {
+		users, err := s.userRepo.FindDormantBefore(ctx, cutoff, dormancyBatchSize)
+		if err != nil {
+			return count, err
+		}
+
+		for i := range users {
+			user := &users[i]
+			changes := newChangeSet(models.ChangeEntityUser, user.ID, uuid.Nil)
+			if err := anonymizeUser(ctx, s.userRepo, s.avatars, user, now, changes); err != nil {
+				return count, err
+			}
+			changes.save(ctx, s.changeRepo)
+			count++
+		}
+
+		if len(users) < dormancyBatchSize {
+			return count, nil
+		}
+	}
+}
+
+// anonymizeUser replaces a user's personal data and makes the account
+// unusable. Only the names of the replaced fields are tracked in changes so
+// no personal data is retained.
+func anonymizeUser(ctx context.Context, userRepo repository.UserRepository, avatars AvatarService, user *models.User, now time.Time, changes *changeSet) error {
+	password, err := unusablePassword()
+	if err != nil {
+		return err
+	}
+
+	for _, field := range []struct{ name, value string }{
+		{"email", user.Email},
+		{"first_name", user.FirstName},
+		{"last_name", user.LastName},
+		{"bio", user.Bio},
+		{"phone_number", user.PhoneNumber},
+		{"avatar", user.Avatar},
+	} {
+		if field.value != "" {
+			changes.track(field.name, "[redacted]", "[anonymized]")
+		}
+	}
+
+	anonymizedAt := now
+	avatarKey := user.AvatarKey
+	user.Email = fmt.Sprintf("anonymized-%s@invalid", user.ID)
+	user.FirstName = anonymizedName
+	user.LastName = ""
+	user.Bio = ""
+	user.PhoneNumber = ""
+	user.Avatar = ""
+	user.AvatarKey = ""
+	user.Password = password
+	user.RefreshToken = ""
+	user.AnonymizedAt = &anonymizedAt
+	if err := userRepo.Update(ctx, user); err != nil {
+		return err
+	}
+	if avatarKey != "" {
+		avatars.DeleteFiles(ctx, avatarKey)
+	}
+
+	changes.track("anonymized_at", "", anonymizedAt.Format(time.RFC3339))
+	return nil
+}
+
+// unusablePassword returns a random password nobody knows
+func unusablePassword() (string, error) {
+	b := make([]byte, 32)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(b), nil
+}
@@ -0,0 +1,121 @@
+package services_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/yourusername/go-enterprise-api/internal/config"
+	"github.com/yourusername/go-enterprise-api/internal/events"
+	"github.com/yourusername/go-enterprise-api/internal/jobs"
+	"github.com/yourusername/go-enterprise-api/internal/mailer"
+	"github.com/yourusername/go-enterprise-api/internal/mocks"
+	"github.com/yourusername/go-enterprise-api/internal/models"
+	"github.com/yourusername/go-enterprise-api/internal/services"
+	"go.uber.org/mock/gomock"
+)
+
+// newMailer creates a mailer logging the emails it sends
+func newMailer(t *testing.T) *mailer.Mailer {
+	t.Helper()
+	mail, err := mailer.New(&config.MailConfig{Driver: mailer.DriverLog, From: "no-reply@example.com", BaseURL: "https://example.com"}, "Test")
+	if err != nil {
+		t.Fatalf("failed to create the mailer: %v", err)
+	}
+	return mail
+}
+
+func TestDormancyServiceNotifiesBeforeMarking(t *testing.T) {
+	user := models.User{BaseModel: models.BaseModel{ID: uuid.New()}, Email: "idle@example.com", FirstName: "Ida", Status: models.StatusActive}
+	cfg := &config.DormancyConfig{Enabled: true, Interval: time.Hour, NoticeAfterDays: 365, DeactivateAfterDays: 30}
+
+	tests := []struct {
+		name     string
+		mail     bool
+		queueErr error
+		notified int
+	}{
+		{"notice queued", true, nil, 1},
+		{"notice not queued", true, errors.New("database unavailable"), 0},
+		{"email not configured", false, nil, 0},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			users := mocks.NewMockUserRepository(ctrl)
+			changes := mocks.NewMockChangeRepository(ctrl)
+			jobRepo := mocks.NewMockJobRepository(ctrl)
+
+			var mail *mailer.Mailer
+			if tt.mail {
+				mail = newMailer(t)
+			}
+			bus := events.New()
+			defer bus.Close(context.Background())
+			notifications := services.NewNotificationService(mocks.NewMockNotificationRepository(ctrl), mail, jobs.New(jobRepo, &config.JobsConfig{MaxAttempts: 3}), bus)
+			dormancy := services.NewDormancyService(users, changes, nil, notifications, cfg)
+
+			users.EXPECT().FindLastActiveBefore(gomock.Any(), gomock.Any(), gomock.Any()).Return([]models.User{user}, nil)
+			users.EXPECT().FindDormancyNotifiedBefore(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil, nil)
+
+			var queued *models.Job
+			if tt.mail {
+				enqueue := jobRepo.EXPECT().Create(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, job *models.Job) error {
+					queued = job
+					return tt.queueErr
+				})
+				if tt.queueErr == nil {
+					// The user is only marked once the notice is queued
+					update := users.EXPECT().Update(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, u *models.User) error {
+						if u.DormancyNotifiedAt == nil {
+							t.Error("got the user updated without notified_at, want it set")
+						}
+						return nil
+					})
+					gomock.InOrder(enqueue, update)
+					changes.EXPECT().CreateMany(gomock.Any(), gomock.Any()).Return(nil)
+				}
+			}
+
+			report, err := dormancy.Run(context.Background())
+			if err != nil {
+				t.Fatalf("got %v, want the run to succeed", err)
+			}
+			if report.Notified != tt.notified {
+				t.Errorf("got %d users notified, want %d", report.Notified, tt.notified)
+			}
+			if tt.notified == 0 {
+				return
+			}
+
+			if queued.Type != services.JobEmailSend {
+				t.Errorf("got job type %q, want %q", queued.Type, services.JobEmailSend)
+			}
+			var payload struct {
+				To       string          `json:"to"`
+				Template mailer.Template `json:"template"`
+				Data     struct {
+					Name         string
+					LoginURL     string
+					DeactivateAt string
+				} `json:"data"`
+			}
+			if err := json.Unmarshal([]byte(queued.Payload), &payload); err != nil {
+				t.Fatalf("failed to decode payload %s: %v", queued.Payload, err)
+			}
+			if payload.To != user.Email || payload.Template != mailer.TemplateDormancy {
+				t.Errorf("got %s email to %s, want %s email to %s", payload.Template, payload.To, mailer.TemplateDormancy, user.Email)
+			}
+			want := time.Now().UTC().AddDate(0, 0, cfg.DeactivateAfterDays).Format("January 2, 2006")
+			if payload.Data.DeactivateAt != want || payload.Data.LoginURL != "https://example.com/login" {
+				t.Errorf("got %+v, want deactivation on %s and a login link", payload.Data, want)
+			}
+			if err := mail.Send(context.Background(), payload.To, payload.Template, payload.Data); err != nil {
+				t.Errorf("failed to send the queued email: %v", err)
+			}
+		})
+	}
+}
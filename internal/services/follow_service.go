@@ -0,0 +1,211 @@
+package services
+
+import (
+	"context"
+	"encoding/base64"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/yourusername/go-enterprise-api/internal/models"
+	"github.com/yourusername/go-enterprise-api/internal/repository"
+	apperrors "github.com/yourusername/go-enterprise-api/pkg/errors"
+	"github.com/yourusername/go-enterprise-api/pkg/logger"
+)
+
+// Feed page sizes
+const (
+	defaultFeedLimit = 20
+	maxFeedLimit     = 100
+)
+
+// FollowService interface defines follow and feed service methods
+type FollowService interface {
+	Follow(ctx context.Context, followerID, followeeID uuid.UUID) error
+	Unfollow(ctx context.Context, followerID, followeeID uuid.UUID) error
+	GetFollowers(ctx context.Context, viewer *models.User, userID uuid.UUID, page, pageSize int) ([]models.UserFollow, int64, error)
+	GetFollowing(ctx context.Context, viewer *models.User, userID uuid.UUID, page, pageSize int) ([]models.UserFollow, int64, error)
+	GetFeed(ctx context.Context, viewer *models.User, cursor string, limit int) ([]models.Post, string, error)
+}
+
+// followService implements FollowService
+type followService struct {
+	followRepo repository.FollowRepository
+	userRepo   repository.UserRepository
+	notifier   NotificationService
+	adultAge   int
+}
+
+// NewFollowService creates a new follow service.
+// notifier may be nil, in which case users are not notified of new followers.
+func NewFollowService(followRepo repository.FollowRepository, userRepo repository.UserRepository, notifier NotificationService, adultAge int) FollowService {
+	return &followService{
+		followRepo: followRepo,
+		userRepo:   userRepo,
+		notifier:   notifier,
+		adultAge:   adultAge,
+	}
+}
+
+// Follow makes a user follow another user. Following someone twice is not an error.
+func (s *followService) Follow(ctx context.Context, followerID, followeeID uuid.UUID) error {
+	if followerID == followeeID {
+		return apperrors.ErrBadRequest.WithDetails("You cannot follow yourself")
+	}
+	if _, err := s.followable(ctx, followeeID); err != nil {
+		return err
+	}
+
+	created, err := s.followRepo.Follow(ctx, followerID, followeeID)
+	if err != nil {
+		logger.Error("Failed to follow user", logger.Err(err))
+		return apperrors.ErrInternal
+	}
+
+	if created && s.notifier != nil {
+		s.notifier.Notify(ctx, &models.Notification{
+			Type:    models.NotificationFollow,
+			UserID:  followeeID,
+			ActorID: &followerID,
+		})
+	}
+	return nil
+}
+
+// Unfollow makes a user stop following another user. Unfollowing someone
+// not followed is not an error.
+func (s *followService) Unfollow(ctx context.Context, followerID, followeeID uuid.UUID) error {
+	if err := s.followRepo.Unfollow(ctx, followerID, followeeID); err != nil {
+		logger.Error("Failed to unfollow user", logger.Err(err))
+		return apperrors.ErrInternal
+	}
+	return nil
+}
+
+// GetFollowers retrieves the users following a user, as seen by viewer
+func (s *followService) GetFollowers(ctx context.Context, viewer *models.User, userID uuid.UUID, page, pageSize int) ([]models.UserFollow, int64, error) {
+	if page < 1 {
+		page = 1
+	}
+	if pageSize < 1 || pageSize > 100 {
+		pageSize = 10
+	}
+
+	if err := s.checkFollowsVisible(ctx, viewer, userID); err != nil {
+		return nil, 0, err
+	}
+
+	follows, total, err := s.followRepo.FindFollowers(ctx, userID, page, pageSize)
+	if err != nil {
+		logger.Error("Failed to get followers", logger.Err(err))
+		return nil, 0, apperrors.ErrInternal
+	}
+	return follows, total, nil
+}
+
+// GetFollowing retrieves the users a user follows, as seen by viewer
+func (s *followService) GetFollowing(ctx context.Context, viewer *models.User, userID uuid.UUID, page, pageSize int) ([]models.UserFollow, int64, error) {
+	if page < 1 {
+		page = 1
+	}
+	if pageSize < 1 || pageSize > 100 {
+		pageSize = 10
+	}
+
+	if err := s.checkFollowsVisible(ctx, viewer, userID); err != nil {
+		return nil, 0, err
+	}
+
+	follows, total, err := s.followRepo.FindFollowing(ctx, userID, page, pageSize)
+	if err != nil {
+		logger.Error("Failed to get followed users", logger.Err(err))
+		return nil, 0, apperrors.ErrInternal
+	}
+	return follows, total, nil
+}
+
+// GetFeed retrieves the latest published posts by the users viewer follows,
+// starting after cursor ("" for the first page). It returns the cursor of
+// the next page, which is empty on the last page.
+func (s *followService) GetFeed(ctx context.Context, viewer *models.User, cursor string, limit int) ([]models.Post, string, error) {
+	if limit < 1 || limit > maxFeedLimit {
+		limit = defaultFeedLimit
+	}
+
+	var before *repository.FeedCursor
+	if cursor != "" {
+		decoded, err := decodeFeedCursor(cursor)
+		if err != nil {
+			return nil, "", apperrors.ErrBadRequest.WithDetails("Invalid cursor")
+		}
+		before = decoded
+	}
+
+	includeMature := viewer.IsAdmin() || viewer.IsVerifiedAdult(s.adultAge)
+
+	// Fetch one extra post to know whether there is a next page
+	posts, err := s.followRepo.FindFeed(ctx, viewer.ID, includeMature, before, limit+1)
+	if err != nil {
+		logger.Error("Failed to get feed", logger.Err(err))
+		return nil, "", apperrors.ErrInternal
+	}
+
+	next := ""
+	if len(posts) > limit {
+		posts = posts[:limit]
+		last := posts[len(posts)-1]
+		next = encodeFeedCursor(&repository.FeedCursor{CreatedAt: last.CreatedAt, ID: last.ID})
+	}
+	return posts, next, nil
+}
+
+// followable finds a user that can be followed. Banned, anonymized and
+// service accounts cannot be followed and their follow lists are hidden.
+func (s *followService) followable(ctx context.Context, userID uuid.UUID) (*models.User, error) {
+	user, err := s.userRepo.FindByID(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	if !user.HasPublicProfile() {
+		return nil, apperrors.ErrUserNotFound
+	}
+	return user, nil
+}
+
+// checkFollowsVisible checks that viewer may see the follow lists of a user
+// that can be followed
+func (s *followService) checkFollowsVisible(ctx context.Context, viewer *models.User, userID uuid.UUID) error {
+	user, err := s.followable(ctx, userID)
+	if err != nil {
+		return err
+	}
+	if !user.FollowsVisibleTo(viewer) {
+		return apperrors.ErrForbidden.WithDetails("This user keeps their followers and followed users private")
+	}
+	return nil
+}
+
+// encodeFeedCursor encodes a feed position as an opaque cursor
+func encodeFeedCursor(cursor *repository.FeedCursor) string {
+	raw := cursor.CreatedAt.UTC().Format(time.RFC3339Nano) + "|" + cursor.ID.String()
+	return base64.RawURLEncoding.EncodeToString([]byte(raw))
+}
+
+// decodeFeedCursor decodes a cursor created by encodeFeedCursor
+func decodeFeedCursor(cursor string) (*repository.FeedCursor, error) {
+	raw, err := base64.RawURLEncoding.DecodeString(cursor)
+	if err != nil {
+		return nil, err
+	}
+	createdAt, id, _ := strings.Cut(string(raw), "|")
+
+	t, err := time.Parse(time.RFC3339Nano, createdAt)
+	if err != nil {
+		return nil, err
+	}
+	postID, err := uuid.Parse(id)
+	if err != nil {
+		return nil, err
+	}
+	return &repository.FeedCursor{CreatedAt: t, ID: postID}, nil
+}
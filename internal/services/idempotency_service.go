@@ -0,0 +1,111 @@
+package services
+
+import (
+	"context"
+	"time"
+
+	"github.com/yourusername/go-enterprise-api/internal/config"
+	"github.com/yourusername/go-enterprise-api/internal/jobs"
+	"github.com/yourusername/go-enterprise-api/internal/models"
+	"github.com/yourusername/go-enterprise-api/internal/repository"
+	apperrors "github.com/yourusername/go-enterprise-api/pkg/errors"
+	"github.com/yourusername/go-enterprise-api/pkg/logger"
+)
+
+// JobIdempotencyPurge deletes expired idempotency keys
+const JobIdempotencyPurge = "idempotency.purge"
+
+// idempotencyLockTimeout is how long a request may hold its idempotency key
+// without completing before the key is considered abandoned, e.g. by a
+// crashed process, and may be taken over by a retry. It is well above the
+// server's write timeout.
+const idempotencyLockTimeout = time.Minute
+
+// IdempotencyService interface defines idempotency key methods
+type IdempotencyService interface {
+	Begin(ctx context.Context, scope, key string) (*models.IdempotencyKey, error)
+	Complete(ctx context.Context, record *models.IdempotencyKey)
+	Release(ctx context.Context, scope, key string)
+	RegisterJobs(queue *jobs.Queue)
+}
+
+// idempotencyService implements IdempotencyService
+type idempotencyService struct {
+	idempotencyRepo repository.IdempotencyRepository
+	cfg             *config.IdempotencyConfig
+}
+
+// NewIdempotencyService creates a new idempotency service
+func NewIdempotencyService(idempotencyRepo repository.IdempotencyRepository, cfg *config.IdempotencyConfig) IdempotencyService {
+	return &idempotencyService{
+		idempotencyRepo: idempotencyRepo,
+		cfg:             cfg,
+	}
+}
+
+// Begin claims a key for a request about to be processed. It returns nil
+// when the key was claimed, and otherwise the key's record: a completed
+// request to replay, or one still being processed.
+func (s *idempotencyService) Begin(ctx context.Context, scope, key string) (*models.IdempotencyKey, error) {
+	now := time.Now().UTC()
+	record := &models.IdempotencyKey{
+		Scope:     scope,
+		Key:       key,
+		CreatedAt: now,
+		ExpiresAt: now.Add(s.cfg.TTL),
+	}
+
+	// The record found may be released before it is read, in which case
+	// claiming the key is tried again
+	for attempt := 0; attempt < 2; attempt++ {
+		acquired, err := s.idempotencyRepo.Acquire(ctx, record, now.Add(-idempotencyLockTimeout))
+		if err != nil {
+			logger.Error("Failed to claim idempotency key", logger.Err(err))
+			return nil, apperrors.ErrInternal
+		}
+		if acquired {
+			return nil, nil
+		}
+
+		existing, err := s.idempotencyRepo.Find(ctx, scope, key)
+		if err != nil {
+			logger.Error("Failed to find idempotency key", logger.Err(err))
+			return nil, apperrors.ErrInternal
+		}
+		if existing != nil {
+			return existing, nil
+		}
+	}
+	return nil, apperrors.ErrConflict.WithDetails("A request with this Idempotency-Key is being processed")
+}
+
+// Complete stores the response of a claimed key, so retries replay it.
+// Failures are logged; retries then find the key still being processed
+// until it is considered abandoned.
+func (s *idempotencyService) Complete(ctx context.Context, record *models.IdempotencyKey) {
+	if err := s.idempotencyRepo.Complete(ctx, record); err != nil {
+		logger.Error("Failed to store idempotent response", logger.Err(err))
+	}
+}
+
+// Release gives up a claimed key without storing a response, so the request
+// can be retried
+func (s *idempotencyService) Release(ctx context.Context, scope, key string) {
+	if err := s.idempotencyRepo.Delete(ctx, scope, key); err != nil {
+		logger.Error("Failed to release idempotency key", logger.Err(err))
+	}
+}
+
+// RegisterJobs registers the job purging expired keys
+func (s *idempotencyService) RegisterJobs(queue *jobs.Queue) {
+	queue.Register(JobIdempotencyPurge, func(ctx context.Context, _ []byte) error {
+		deleted, err := s.idempotencyRepo.DeleteExpired(ctx, time.Now().UTC())
+		if err != nil {
+			return err
+		}
+		if deleted > 0 {
+			logger.Info("Purged idempotency keys", logger.Int("deleted", int(deleted)))
+		}
+		return nil
+	})
+}
@@ -0,0 +1,320 @@
+package services
+
+import (
+	"context"
+	"io"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/google/uuid"
+	"github.com/yourusername/go-enterprise-api/internal/importer"
+	"github.com/yourusername/go-enterprise-api/internal/models"
+	"github.com/yourusername/go-enterprise-api/internal/repository"
+	"github.com/yourusername/go-enterprise-api/internal/search"
+	apperrors "github.com/yourusername/go-enterprise-api/pkg/errors"
+	"github.com/yourusername/go-enterprise-api/pkg/logger"
+	"github.com/yourusername/go-enterprise-api/pkg/validator"
+)
+
+// Import item kinds and outcomes
+const (
+	ImportKindAuthor = "author"
+	ImportKindPost   = "post"
+
+	ImportStatusCreated = "created"
+	ImportStatusMatched = "matched"
+	ImportStatusSkipped = "skipped"
+	ImportStatusFailed  = "failed"
+)
+
+// ImportItem is the outcome of importing a single author or post
+type ImportItem struct {
+	Kind   string     `json:"kind"`
+	Source string     `json:"source"`
+	Title  string     `json:"title,omitempty"`
+	Status string     `json:"status"`
+	ID     *uuid.UUID `json:"id,omitempty"`
+	Error  string     `json:"error,omitempty"`
+}
+
+// ImportReport summarizes an import
+type ImportReport struct {
+	Format  string       `json:"format"`
+	Created int          `json:"created"`
+	Matched int          `json:"matched"`
+	Skipped int          `json:"skipped"`
+	Failed  int          `json:"failed"`
+	Items   []ImportItem `json:"items"`
+}
+
+// add records the outcome of an item
+func (r *ImportReport) add(item ImportItem) {
+	switch item.Status {
+	case ImportStatusCreated:
+		r.Created++
+	case ImportStatusMatched:
+		r.Matched++
+	case ImportStatusSkipped:
+		r.Skipped++
+	case ImportStatusFailed:
+		r.Failed++
+	}
+	r.Items = append(r.Items, item)
+}
+
+// ImportService interface defines importing content exported from other platforms
+type ImportService interface {
+	Import(ctx context.Context, actorID uuid.UUID, filename, format string, file io.ReaderAt, size int64) (*ImportReport, error)
+}
+
+// importService implements ImportService
+type importService struct {
+	userRepo repository.UserRepository
+	postRepo repository.PostRepository
+	tagRepo  repository.TagRepository
+	indexer  search.SearchIndexer
+}
+
+// NewImportService creates a new import service.
+// indexer may be nil, in which case imported posts are only searchable through the database.
+func NewImportService(userRepo repository.UserRepository, postRepo repository.PostRepository, tagRepo repository.TagRepository, indexer search.SearchIndexer) ImportService {
+	return &importService{
+		userRepo: userRepo,
+		postRepo: postRepo,
+		tagRepo:  tagRepo,
+		indexer:  indexer,
+	}
+}
+
+// Import parses a WordPress WXR file or Medium export archive and creates
+// its authors and posts. Authors are matched to existing users by email and
+// otherwise created as pending accounts without a usable password; posts
+// whose author has no email are attributed to the importing admin. Posts
+// that were imported before are skipped, so an export can be re-imported.
+// format may be empty to detect it from the file.
+func (s *importService) Import(ctx context.Context, actorID uuid.UUID, filename, format string, file io.ReaderAt, size int64) (*ImportReport, error) {
+	if format == "" {
+		head := make([]byte, 512)
+		n, err := file.ReadAt(head, 0)
+		if err != nil && err != io.EOF {
+			logger.Error("Failed to read import file", logger.Err(err))
+			return nil, apperrors.ErrInternal
+		}
+		if format, err = importer.DetectFormat(filename, head[:n]); err != nil {
+			return nil, apperrors.ErrBadRequest.WithDetails(err.Error())
+		}
+	}
+
+	var (
+		archive *importer.Archive
+		err     error
+	)
+	switch format {
+	case importer.FormatWXR:
+		archive, err = importer.ParseWXR(io.NewSectionReader(file, 0, size))
+	case importer.FormatMedium:
+		archive, err = importer.ParseMedium(file, size)
+	default:
+		return nil, apperrors.ErrBadRequest.WithDetails(importer.ErrUnknownFormat.Error())
+	}
+	if err != nil {
+		return nil, apperrors.ErrBadRequest.WithDetails(err.Error())
+	}
+
+	report := &ImportReport{Format: archive.Format, Items: []ImportItem{}}
+
+	authors := make(map[string]uuid.UUID, len(archive.Authors))
+	for _, author := range archive.Authors {
+		item := s.importAuthor(ctx, author)
+		if item.ID != nil {
+			authors[author.Key] = *item.ID
+		}
+		report.add(item)
+	}
+
+	tags := make(map[string]*models.Tag)
+	for _, post := range archive.Posts {
+		authorID, ok := authors[post.AuthorKey]
+		if !ok {
+			authorID = actorID
+		}
+		report.add(s.importPost(ctx, post, authorID, tags))
+	}
+
+	logger.Info("Content imported",
+		logger.String("format", report.Format),
+		logger.String("actor_id", actorID.String()),
+		logger.Int("created", report.Created),
+		logger.Int("matched", report.Matched),
+		logger.Int("skipped", report.Skipped+archive.Skipped),
+		logger.Int("failed", report.Failed),
+	)
+	return report, nil
+}
+
+// importAuthor matches an author to an existing user or creates a pending one
+func (s *importService) importAuthor(ctx context.Context, author importer.Author) ImportItem {
+	item := ImportItem{Kind: ImportKindAuthor, Source: author.Key, Title: author.DisplayName}
+
+	email := strings.ToLower(author.Email)
+	if email == "" {
+		item.Status = ImportStatusSkipped
+		item.Error = "Author has no email address; posts are attributed to the importing user"
+		return item
+	}
+	if !validator.ValidateEmail(email) {
+		item.Status = ImportStatusFailed
+		item.Error = "Invalid email address; posts are attributed to the importing user"
+		return item
+	}
+
+	if existing, err := s.userRepo.FindByEmail(ctx, email); err == nil {
+		item.Status = ImportStatusMatched
+		item.ID = &existing.ID
+		return item
+	}
+
+	password, err := unusablePassword()
+	if err != nil {
+		return failedImport(item, "Failed to create author", err)
+	}
+
+	firstName, lastName := author.FirstName, author.LastName
+	if firstName == "" && lastName == "" {
+		firstName = firstNonEmpty(author.DisplayName, author.Key)
+	}
+
+	user := &models.User{
+		Email:     email,
+		Password:  password,
+		FirstName: truncate(firstName, 100),
+		LastName:  truncate(lastName, 100),
+		Role:      models.RoleUser,
+		Status:    models.StatusPending,
+	}
+	if err := s.userRepo.Create(ctx, user); err != nil {
+		return failedImport(item, "Failed to create author", err)
+	}
+
+	item.Status = ImportStatusCreated
+	item.ID = &user.ID
+	return item
+}
+
+// importPost creates a post unless it was imported before
+func (s *importService) importPost(ctx context.Context, source importer.Post, authorID uuid.UUID, tags map[string]*models.Tag) ImportItem {
+	item := ImportItem{Kind: ImportKindPost, Source: source.SourceID, Title: source.Title}
+
+	if strings.TrimSpace(source.Title) == "" {
+		item.Status = ImportStatusFailed
+		item.Error = "Post has no title"
+		return item
+	}
+
+	if existing, err := s.postRepo.FindByImportKey(ctx, source.SourceID); err == nil {
+		item.Status = ImportStatusSkipped
+		item.ID = &existing.ID
+		item.Error = "Post was already imported"
+		return item
+	}
+
+	status := models.PostStatusDraft
+	if source.Published {
+		status = models.PostStatusPublished
+	}
+
+	post := &models.Post{
+		Title:     truncate(source.Title, 255),
+		Content:   source.Content,
+		Excerpt:   truncate(source.Excerpt, 500),
+		Status:    status,
+		UserID:    authorID,
+		ImportKey: source.SourceID,
+	}
+	if !source.PublishedAt.IsZero() {
+		post.CreatedAt = source.PublishedAt
+	}
+
+	seen := make(map[uuid.UUID]bool, len(source.Tags))
+	for _, name := range source.Tags {
+		tag, err := s.findOrCreateTag(ctx, name, tags)
+		if err != nil {
+			return failedImport(item, "Failed to create tag", err)
+		}
+		// Aliases of the same tag are applied once
+		if tag != nil && !seen[tag.ID] {
+			seen[tag.ID] = true
+			post.Tags = append(post.Tags, *tag)
+		}
+	}
+
+	slug := generateSlug(firstNonEmpty(source.Slug, source.Title))
+	if err := s.postRepo.CreateWithSlug(ctx, post, slug); err != nil {
+		return failedImport(item, "Failed to create post", err)
+	}
+
+	if s.indexer != nil && post.IsSearchable() {
+		if err := s.indexer.Index(ctx, post); err != nil {
+			logger.Error("Failed to sync post with search index",
+				logger.String("post_id", post.ID.String()),
+				logger.Err(err),
+			)
+		}
+	}
+
+	item.Status = ImportStatusCreated
+	item.ID = &post.ID
+	return item
+}
+
+// findOrCreateTag resolves a tag name through aliases, caching tags for the
+// rest of the import. Names without any characters usable in a slug are ignored.
+func (s *importService) findOrCreateTag(ctx context.Context, name string, tags map[string]*models.Tag) (*models.Tag, error) {
+	slug := tagSlug(name)
+	if slug == "" {
+		return nil, nil
+	}
+	if tag, ok := tags[slug]; ok {
+		return tag, nil
+	}
+
+	tag, err := findOrCreateTag(ctx, s.tagRepo, name)
+	if err != nil {
+		return nil, err
+	}
+	tags[slug] = tag
+	return tag, nil
+}
+
+// failedImport logs an unexpected error and marks the item as failed
+func failedImport(item ImportItem, message string, err error) ImportItem {
+	logger.Error(message,
+		logger.String("kind", item.Kind),
+		logger.String("source", item.Source),
+		logger.Err(err),
+	)
+	item.Status = ImportStatusFailed
+	item.Error = message
+	return item
+}
+
+// firstNonEmpty returns the first non-empty value
+func firstNonEmpty(values ...string) string {
+	for _, v := range values {
+		if v != "" {
+			return v
+		}
+	}
+	return ""
+}
+
+// truncate shortens s to at most max bytes without splitting a UTF-8 character
+func truncate(s string, max int) string {
+	if len(s) <= max {
+		return s
+	}
+	for max > 0 && !utf8.RuneStart(s[max]) {
+		max--
+	}
+	return s[:max]
+}
@@ -0,0 +1,88 @@
+package services
+
+import (
+	"context"
+	"errors"
+
+	"github.com/google/uuid"
+	"github.com/yourusername/go-enterprise-api/internal/jobs"
+	"github.com/yourusername/go-enterprise-api/internal/models"
+	"github.com/yourusername/go-enterprise-api/internal/repository"
+	apperrors "github.com/yourusername/go-enterprise-api/pkg/errors"
+	"github.com/yourusername/go-enterprise-api/pkg/logger"
+)
+
+// JobService interface defines background job administration methods
+type JobService interface {
+	GetAll(ctx context.Context, status models.JobStatus, jobType string, page, pageSize int) ([]models.Job, int64, error)
+	GetStats(ctx context.Context) (*models.JobStats, error)
+	GetByID(ctx context.Context, id uuid.UUID) (*models.Job, error)
+	Retry(ctx context.Context, id uuid.UUID) (*models.Job, error)
+}
+
+// jobService implements JobService
+type jobService struct {
+	jobRepo repository.JobRepository
+	queue   *jobs.Queue
+}
+
+// NewJobService creates a new job service
+func NewJobService(jobRepo repository.JobRepository, queue *jobs.Queue) JobService {
+	return &jobService{
+		jobRepo: jobRepo,
+		queue:   queue,
+	}
+}
+
+// GetAll retrieves jobs, newest first, optionally filtered by status and type
+func (s *jobService) GetAll(ctx context.Context, status models.JobStatus, jobType string, page, pageSize int) ([]models.Job, int64, error) {
+	if page < 1 {
+		page = 1
+	}
+	if pageSize < 1 || pageSize > 100 {
+		pageSize = 10
+	}
+
+	list, total, err := s.jobRepo.FindJobs(ctx, status, jobType, page, pageSize)
+	if err != nil {
+		logger.Error("Failed to get jobs", logger.Err(err))
+		return nil, 0, apperrors.ErrInternal
+	}
+	return list, total, nil
+}
+
+// GetStats counts jobs in each status
+func (s *jobService) GetStats(ctx context.Context) (*models.JobStats, error) {
+	stats, err := s.jobRepo.CountByStatus(ctx)
+	if err != nil {
+		logger.Error("Failed to count jobs", logger.Err(err))
+		return nil, apperrors.ErrInternal
+	}
+	return stats, nil
+}
+
+// GetByID retrieves a job
+func (s *jobService) GetByID(ctx context.Context, id uuid.UUID) (*models.Job, error) {
+	return s.jobRepo.FindByID(ctx, id)
+}
+
+// Retry queues a failed job to run again
+func (s *jobService) Retry(ctx context.Context, id uuid.UUID) (*models.Job, error) {
+	job, err := s.queue.Retry(ctx, id)
+	if err != nil {
+		if apperrors.IsAppError(err) {
+			return nil, err
+		}
+		if errors.Is(err, jobs.ErrNotRetryable) {
+			return nil, apperrors.ErrConflict.WithDetails("Only failed jobs can be retried")
+		}
+		logger.Error("Failed to retry job", logger.String("job_id", id.String()), logger.Err(err))
+		return nil, apperrors.ErrInternal
+	}
+
+	logger.Info("Job queued for retry",
+		logger.String("job_id", job.ID.String()),
+		logger.String("type", job.Type),
+	)
+	return job, nil
+}
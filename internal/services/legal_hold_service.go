@@ -0,0 +1,213 @@
+package services
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/yourusername/go-enterprise-api/internal/models"
+	"github.com/yourusername/go-enterprise-api/internal/repository"
+	apperrors "github.com/yourusername/go-enterprise-api/pkg/errors"
+	"github.com/yourusername/go-enterprise-api/pkg/logger"
+)
+
+// LegalHoldService interface defines legal hold management for users and posts
+type LegalHoldService interface {
+	GetUserHold(ctx context.Context, userID uuid.UUID) (*models.LegalHoldResponse, error)
+	PlaceUserHold(ctx context.Context, userID, actorID uuid.UUID, reason string) (*models.LegalHoldResponse, error)
+	ReleaseUserHold(ctx context.Context, userID, actorID uuid.UUID) error
+	GetPostHold(ctx context.Context, postID uuid.UUID) (*models.LegalHoldResponse, error)
+	PlacePostHold(ctx context.Context, postID, actorID uuid.UUID, reason string) (*models.LegalHoldResponse, error)
+	ReleasePostHold(ctx context.Context, postID, actorID uuid.UUID) error
+}
+
+// legalHoldService implements LegalHoldService
+type legalHoldService struct {
+	userRepo   repository.UserRepository
+	postRepo   repository.PostRepository
+	changeRepo repository.ChangeRepository
+}
+
+// NewLegalHoldService creates a new legal hold service
+func NewLegalHoldService(userRepo repository.UserRepository, postRepo repository.PostRepository, changeRepo repository.ChangeRepository) LegalHoldService {
+	return &legalHoldService{
+		userRepo:   userRepo,
+		postRepo:   postRepo,
+		changeRepo: changeRepo,
+	}
+}
+
+// GetUserHold retrieves the legal hold status of a user
+func (s *legalHoldService) GetUserHold(ctx context.Context, userID uuid.UUID) (*models.LegalHoldResponse, error) {
+	user, err := s.userRepo.FindByID(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	return user.ToLegalHoldResponse(models.ChangeEntityUser, user.ID), nil
+}
+
+// PlaceUserHold places a user under legal hold
+func (s *legalHoldService) PlaceUserHold(ctx context.Context, userID, actorID uuid.UUID, reason string) (*models.LegalHoldResponse, error) {
+	user, err := s.userRepo.FindByID(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	changes, err := placeHold(&user.LegalHold, models.ChangeEntityUser, user.ID, actorID, reason)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.userRepo.Update(ctx, user); err != nil {
+		if apperrors.IsAppError(err) {
+			return nil, err
+		}
+		logger.Error("Failed to place legal hold", logger.Err(err))
+		return nil, apperrors.ErrInternal
+	}
+
+	changes.save(ctx, s.changeRepo)
+	logLegalHold("Legal hold placed", models.ChangeEntityUser, user.ID, actorID)
+
+	return user.ToLegalHoldResponse(models.ChangeEntityUser, user.ID), nil
+}
+
+// ReleaseUserHold lifts a user's legal hold
+func (s *legalHoldService) ReleaseUserHold(ctx context.Context, userID, actorID uuid.UUID) error {
+	user, err := s.userRepo.FindByID(ctx, userID)
+	if err != nil {
+		return err
+	}
+
+	changes, err := releaseHold(&user.LegalHold, models.ChangeEntityUser, user.ID, actorID)
+	if err != nil {
+		return err
+	}
+
+	if err := s.userRepo.Update(ctx, user); err != nil {
+		if apperrors.IsAppError(err) {
+			return err
+		}
+		logger.Error("Failed to release legal hold", logger.Err(err))
+		return apperrors.ErrInternal
+	}
+
+	changes.save(ctx, s.changeRepo)
+	logLegalHold("Legal hold released", models.ChangeEntityUser, user.ID, actorID)
+
+	return nil
+}
+
+// GetPostHold retrieves the legal hold status of a post
+func (s *legalHoldService) GetPostHold(ctx context.Context, postID uuid.UUID) (*models.LegalHoldResponse, error) {
+	post, err := s.postRepo.FindByID(ctx, postID)
+	if err != nil {
+		return nil, err
+	}
+	return post.ToLegalHoldResponse(models.ChangeEntityPost, post.ID), nil
+}
+
+// PlacePostHold places a post under legal hold
+func (s *legalHoldService) PlacePostHold(ctx context.Context, postID, actorID uuid.UUID, reason string) (*models.LegalHoldResponse, error) {
+	post, err := s.postRepo.FindByID(ctx, postID)
+	if err != nil {
+		return nil, err
+	}
+
+	changes, err := placeHold(&post.LegalHold, models.ChangeEntityPost, post.ID, actorID, reason)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.postRepo.Update(ctx, post); err != nil {
+		if apperrors.IsAppError(err) {
+			return nil, err
+		}
+		logger.Error("Failed to place legal hold", logger.Err(err))
+		return nil, apperrors.ErrInternal
+	}
+
+	changes.save(ctx, s.changeRepo)
+	logLegalHold("Legal hold placed", models.ChangeEntityPost, post.ID, actorID)
+
+	return post.ToLegalHoldResponse(models.ChangeEntityPost, post.ID), nil
+}
+
+// ReleasePostHold lifts a post's legal hold
+func (s *legalHoldService) ReleasePostHold(ctx context.Context, postID, actorID uuid.UUID) error {
+	post, err := s.postRepo.FindByID(ctx, postID)
+	if err != nil {
+		return err
+	}
+
+	changes, err := releaseHold(&post.LegalHold, models.ChangeEntityPost, post.ID, actorID)
+	if err != nil {
+		return err
+	}
+
+	if err := s.postRepo.Update(ctx, post); err != nil {
+		if apperrors.IsAppError(err) {
+			return err
+		}
+		logger.Error("Failed to release legal hold", logger.Err(err))
+		return apperrors.ErrInternal
+	}
+
+	changes.save(ctx, s.changeRepo)
+	logLegalHold("Legal hold released", models.ChangeEntityPost, post.ID, actorID)
+
+	return nil
+}
+
+// placeHold sets a hold and returns the changes to record
+func placeHold(hold *models.LegalHold, entityType string, entityID, actorID uuid.UUID, reason string) (*changeSet, error) {
+	if hold.OnLegalHold() {
+		return nil, apperrors.ErrConflict.WithDetails("A legal hold is already in place")
+	}
+
+	now := time.Now().UTC()
+	changes := newChangeSet(entityType, entityID, actorID)
+	changes.track("legal_hold", false, true)
+	changes.track("legal_hold_reason", hold.LegalHoldReason, reason)
+
+	hold.LegalHoldAt = &now
+	hold.LegalHoldBy = &actorID
+	hold.LegalHoldReason = reason
+	return changes, nil
+}
+
+// releaseHold clears a hold and returns the changes to record
+func releaseHold(hold *models.LegalHold, entityType string, entityID, actorID uuid.UUID) (*changeSet, error) {
+	if !hold.OnLegalHold() {
+		return nil, apperrors.ErrConflict.WithDetails("No legal hold is in place")
+	}
+
+	changes := newChangeSet(entityType, entityID, actorID)
+	changes.track("legal_hold", true, false)
+	changes.track("legal_hold_reason", hold.LegalHoldReason, "")
+
+	hold.LegalHoldAt = nil
+	hold.LegalHoldBy = nil
+	hold.LegalHoldReason = ""
+	return changes, nil
+}
+
+// logLegalHold logs a legal hold event
+func logLegalHold(message, entityType string, entityID, actorID uuid.UUID) {
+	logger.Info(message,
+		logger.String("entity_type", entityType),
+		logger.String("entity_id", entityID.String()),
+		logger.String("actor_id", actorID.String()),
+	)
+}
+
+// errLegalHold logs a destructive action blocked by a legal hold and returns
+// the error reported to the caller. action is a past participle such as "deleted".
+func errLegalHold(action, entityType string, entityID uuid.UUID) error {
+	logger.Warn("Action blocked by legal hold",
+		logger.String("action", action),
+		logger.String("entity_type", entityType),
+		logger.String("entity_id", entityID.String()),
+	)
+	return apperrors.ErrLegalHold.WithDetails("This " + entityType + " is under legal hold and cannot be " + action + " until the hold is lifted")
+}
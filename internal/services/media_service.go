@@ -0,0 +1,410 @@
+package services
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"image"
+	"io"
+	"mime"
+	"net/http"
+	"net/url"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/yourusername/go-enterprise-api/internal/config"
+	"github.com/yourusername/go-enterprise-api/internal/events"
+	"github.com/yourusername/go-enterprise-api/internal/imaging"
+	"github.com/yourusername/go-enterprise-api/internal/malware"
+	"github.com/yourusername/go-enterprise-api/internal/models"
+	"github.com/yourusername/go-enterprise-api/internal/repository"
+	"github.com/yourusername/go-enterprise-api/internal/storage"
+	apperrors "github.com/yourusername/go-enterprise-api/pkg/errors"
+	"github.com/yourusername/go-enterprise-api/pkg/logger"
+)
+
+// mediaFilePath is the path media files are served at
+const mediaFilePath = "/api/v1/media/%s/file"
+
+// MediaService interface defines media library methods
+type MediaService interface {
+	Upload(ctx context.Context, ownerID uuid.UUID, fileName string, file io.Reader, private bool) (*models.Media, error)
+	GetByID(ctx context.Context, id uuid.UUID, viewer *models.User) (*models.Media, error)
+	GetByUser(ctx context.Context, userID uuid.UUID, page, pageSize int) ([]models.Media, int64, error)
+	Delete(ctx context.Context, id uuid.UUID, actor *models.User) error
+	Open(ctx context.Context, id uuid.UUID, viewer *models.User, variant models.MediaVariant, expires, signature string) (*models.Media, []byte, string, error)
+	URL(media *models.Media, variant models.MediaVariant) string
+}
+
+// mediaService implements MediaService
+type mediaService struct {
+	mediaRepo repository.MediaRepository
+	store     storage.Storage
+	scanner   malware.Scanner
+	bus       *events.Bus
+	config    *config.MediaConfig
+	secret    func() string
+}
+
+// NewMediaService creates a new media service. Uploads are scanned with
+// scanner unless it is nil. URLs of private files are signed with the
+// current secret, so they stop working when it is rotated.
+func NewMediaService(mediaRepo repository.MediaRepository, store storage.Storage, scanner malware.Scanner, bus *events.Bus, cfg *config.MediaConfig, secret func() string) MediaService {
+	return &mediaService{
+		mediaRepo: mediaRepo,
+		store:     store,
+		scanner:   scanner,
+		bus:       bus,
+		config:    cfg,
+		secret:    secret,
+	}
+}
+
+// Upload stores a file in the owner's media library. Files larger than the
+// configured size, or whose sniffed content type is not allowed, are
+// rejected. Files are then scanned for malware, and quarantined instead of
+// stored when malware is found or the scan fails. Images must decode as
+// JPEG, PNG or GIF; they are stored without their metadata, along with their
+// variants.
+func (s *mediaService) Upload(ctx context.Context, ownerID uuid.UUID, fileName string, file io.Reader, private bool) (*models.Media, error) {
+	data, err := io.ReadAll(io.LimitReader(file, s.config.MaxSize+1))
+	if err != nil {
+		return nil, apperrors.ErrBadRequest.WithDetails("Failed to read the uploaded file")
+	}
+	if len(data) == 0 {
+		return nil, apperrors.ErrBadRequest.WithDetails("The uploaded file is empty")
+	}
+	if int64(len(data)) > s.config.MaxSize {
+		return nil, apperrors.ErrBadRequest.WithDetails(fmt.Sprintf("File must be at most %d bytes", s.config.MaxSize))
+	}
+
+	contentType, _, _ := mime.ParseMediaType(http.DetectContentType(data))
+	if !s.allowed(contentType) {
+		return nil, apperrors.ErrBadRequest.WithDetails(fmt.Sprintf("Files of type %s are not allowed", contentType))
+	}
+
+	media := &models.Media{
+		FileName:    cleanFileName(fileName),
+		ContentType: contentType,
+		Size:        int64(len(data)),
+		Private:     private,
+		Status:      models.MediaAvailable,
+		UserID:      ownerID,
+	}
+	media.ID = uuid.New()
+	media.Key = storage.Join(s.config.Prefix, ownerID.String(), media.ID.String())
+
+	if err := s.scan(ctx, media, data); err != nil {
+		return nil, err
+	}
+
+	var variants map[models.MediaVariant][]byte
+	if media.IsImage() {
+		data, variants, err = s.processImage(media, data)
+		if err != nil {
+			return nil, err
+		}
+		media.Size = int64(len(data))
+	}
+
+	if err := s.store.Put(ctx, media.Key, data, media.ContentType); err != nil {
+		logger.Error("Failed to store media file", logger.Err(err), logger.String("key", media.Key))
+		return nil, apperrors.ErrInternal
+	}
+	for _, variant := range media.VariantList() {
+		key := media.VariantKey(variant)
+		if err := s.store.Put(ctx, key, variants[variant], http.DetectContentType(variants[variant])); err != nil {
+			logger.Error("Failed to store media variant", logger.Err(err), logger.String("key", key))
+			s.deleteFiles(ctx, media)
+			return nil, apperrors.ErrInternal
+		}
+	}
+
+	if err := s.mediaRepo.Create(ctx, media); err != nil {
+		logger.Error("Failed to create media", logger.Err(err))
+		s.deleteFiles(ctx, media)
+		return nil, apperrors.ErrInternal
+	}
+
+	logger.Info("Media uploaded",
+		logger.String("media_id", media.ID.String()),
+		logger.String("user_id", ownerID.String()),
+		logger.String("content_type", media.ContentType),
+		logger.Any("size", media.Size),
+	)
+	return media, nil
+}
+
+// GetByID retrieves media visible to the viewer: public files, and private
+// files of the viewer's own (or anyone's for admins)
+func (s *mediaService) GetByID(ctx context.Context, id uuid.UUID, viewer *models.User) (*models.Media, error) {
+	media, err := s.mediaRepo.FindByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if (media.Private || media.IsQuarantined()) && !ownsMedia(media, viewer) {
+		return nil, apperrors.ErrNotFound.WithDetails("Media not found")
+	}
+	return media, nil
+}
+
+// GetByUser retrieves a user's media library
+func (s *mediaService) GetByUser(ctx context.Context, userID uuid.UUID, page, pageSize int) ([]models.Media, int64, error) {
+	if page < 1 {
+		page = 1
+	}
+	if pageSize < 1 || pageSize > 100 {
+		pageSize = 10
+	}
+
+	media, total, err := s.mediaRepo.FindByUser(ctx, userID, page, pageSize)
+	if err != nil {
+		logger.Error("Failed to get media", logger.Err(err))
+		return nil, 0, apperrors.ErrInternal
+	}
+	return media, total, nil
+}
+
+// Delete removes media and its file (owner or admin only)
+func (s *mediaService) Delete(ctx context.Context, id uuid.UUID, actor *models.User) error {
+	media, err := s.GetByID(ctx, id, actor)
+	if err != nil {
+		return err
+	}
+	if !ownsMedia(media, actor) {
+		return apperrors.ErrForbidden
+	}
+
+	if err := s.mediaRepo.Delete(ctx, id); err != nil {
+		logger.Error("Failed to delete media", logger.Err(err))
+		return apperrors.ErrInternal
+	}
+
+	// Files left behind are only wasted space, so the deletion stands
+	s.deleteFiles(ctx, media)
+	return nil
+}
+
+// Open reads a media file, or one of its variants, for the viewer, who may
+// be nil. Private files are only opened for their owner, admins, or with an
+// unexpired signature from a signed URL. It also returns the content type
+// of the file read.
+func (s *mediaService) Open(ctx context.Context, id uuid.UUID, viewer *models.User, variant models.MediaVariant, expires, signature string) (*models.Media, []byte, string, error) {
+	media, err := s.mediaRepo.FindByID(ctx, id)
+	if err != nil {
+		return nil, nil, "", err
+	}
+
+	if media.Private && !ownsMedia(media, viewer) {
+		if signature == "" {
+			return nil, nil, "", apperrors.ErrNotFound.WithDetails("Media not found")
+		}
+		if !s.validSignature(media.ID, expires, signature) {
+			return nil, nil, "", apperrors.ErrForbidden.WithDetails("The signed URL is invalid or has expired")
+		}
+	}
+	if media.IsQuarantined() {
+		if !ownsMedia(media, viewer) {
+			return nil, nil, "", apperrors.ErrNotFound.WithDetails("Media not found")
+		}
+		return nil, nil, "", apperrors.ErrForbidden.WithDetails("The file was quarantined by the malware scan")
+	}
+
+	key := media.Key
+	if variant != "" {
+		if !media.HasVariant(variant) {
+			return nil, nil, "", apperrors.ErrNotFound.WithDetails("Media variant not found")
+		}
+		key = media.VariantKey(variant)
+	}
+
+	data, err := s.store.Get(ctx, key)
+	if err != nil {
+		if errors.Is(err, storage.ErrNotFound) {
+			logger.Warn("Media file is missing", logger.String("media_id", media.ID.String()), logger.String("key", key))
+			return nil, nil, "", apperrors.ErrNotFound.WithDetails("Media not found")
+		}
+		logger.Error("Failed to read media file", logger.Err(err), logger.String("key", key))
+		return nil, nil, "", apperrors.ErrInternal
+	}
+
+	// Variants are encoded in a format of their own
+	contentType := media.ContentType
+	if variant != "" {
+		contentType = http.DetectContentType(data)
+	}
+	return media, data, contentType, nil
+}
+
+// URL returns the URL a media file, or one of its variants when variant is
+// set, is served at. URLs of private files are signed and expire after the
+// configured TTL.
+func (s *mediaService) URL(media *models.Media, variant models.MediaVariant) string {
+	path := s.config.BaseURL + fmt.Sprintf(mediaFilePath, media.ID)
+	query := url.Values{}
+	if variant != "" {
+		query.Set("variant", string(variant))
+	}
+	if media.Private {
+		expires := strconv.FormatInt(time.Now().Add(s.config.URLTTL).Unix(), 10)
+		query.Set("expires", expires)
+		query.Set("signature", s.sign(media.ID, expires))
+	}
+
+	if len(query) == 0 {
+		return path
+	}
+	return path + "?" + query.Encode()
+}
+
+// processImage decodes an uploaded image and encodes its variants. JPEG and
+// PNG images are re-encoded, upright and without metadata such as EXIF
+// data, and the re-encoded image is returned to be stored instead of the
+// upload. GIF images are kept as they are, so animations survive; they
+// carry no EXIF data.
+func (s *mediaService) processImage(media *models.Media, data []byte) ([]byte, map[models.MediaVariant][]byte, error) {
+	img, format, err := imaging.Decode(data, s.config.MaxPixels)
+	if err != nil {
+		if errors.Is(err, imaging.ErrTooLarge) {
+			return nil, nil, apperrors.ErrBadRequest.WithDetails(fmt.Sprintf("Images may have at most %d pixels", s.config.MaxPixels))
+		}
+		return nil, nil, apperrors.ErrBadRequest.WithDetails("The file is not a valid JPEG, PNG or GIF image")
+	}
+	media.Width = img.Bounds().Dx()
+	media.Height = img.Bounds().Dy()
+
+	if format != imaging.FormatGIF {
+		data, media.ContentType, err = imaging.Encode(img, format)
+		if err != nil {
+			logger.Error("Failed to encode image", logger.Err(err))
+			return nil, nil, apperrors.ErrInternal
+		}
+	}
+
+	variants := map[models.MediaVariant][]byte{}
+	for variant, scaled := range map[models.MediaVariant]*image.RGBA{
+		models.MediaThumbnail: imaging.Square(img, s.config.ThumbnailSize),
+		models.MediaMedium:    imaging.Resize(img, s.config.MediumWidth),
+		models.MediaLarge:     imaging.Resize(img, s.config.LargeWidth),
+	} {
+		encoded, _, err := imaging.Encode(scaled, format)
+		if err != nil {
+			logger.Error("Failed to encode image variant", logger.Err(err))
+			return nil, nil, apperrors.ErrInternal
+		}
+		variants[variant] = encoded
+	}
+	media.SetVariants(models.MediaVariants)
+
+	return data, variants, nil
+}
+
+// scan scans an upload for malware. Uploads the scan finds malware in, or
+// fails to scan, are stored under a quarantine key instead, recorded as
+// quarantined for review and reported in an audit event; the error returned
+// then rejects the upload.
+func (s *mediaService) scan(ctx context.Context, media *models.Media, data []byte) error {
+	if s.scanner == nil {
+		return nil
+	}
+
+	threat, err := s.scanner.Scan(ctx, data)
+	if err == nil && threat == "" {
+		return nil
+	}
+
+	var rejection error
+	if err != nil {
+		logger.Error("Malware scan failed", logger.String("backend", s.scanner.Name()), logger.Err(err))
+		media.ScanResult = truncate("scan failed: "+err.Error(), 255)
+		rejection = apperrors.ErrBadGateway.WithDetails("The file could not be scanned for malware and was held for review")
+	} else {
+		media.ScanResult = truncate("malware found: "+threat, 255)
+		rejection = apperrors.ErrBadRequest.WithDetails("The file was rejected because it contains malware")
+	}
+	media.Status = models.MediaQuarantined
+	media.Key = storage.Join(s.config.Prefix, "quarantine", media.UserID.String(), media.ID.String())
+
+	if err := s.store.Put(ctx, media.Key, data, media.ContentType); err != nil {
+		logger.Error("Failed to store quarantined media file", logger.Err(err), logger.String("key", media.Key))
+		return apperrors.ErrInternal
+	}
+	if err := s.mediaRepo.Create(ctx, media); err != nil {
+		logger.Error("Failed to create quarantined media", logger.Err(err))
+		s.deleteFiles(ctx, media)
+		return apperrors.ErrInternal
+	}
+
+	logger.Warn("Media quarantined",
+		logger.String("media_id", media.ID.String()),
+		logger.String("user_id", media.UserID.String()),
+		logger.String("scan_result", media.ScanResult),
+	)
+	s.bus.Publish(ctx, events.MediaQuarantined{Media: media})
+	return rejection
+}
+
+// deleteFiles deletes the file of media and its variants from storage
+func (s *mediaService) deleteFiles(ctx context.Context, media *models.Media) {
+	keys := []string{media.Key}
+	for _, variant := range media.VariantList() {
+		keys = append(keys, media.VariantKey(variant))
+	}
+	for _, key := range keys {
+		if err := s.store.Delete(ctx, key); err != nil {
+			logger.Warn("Failed to delete media file", logger.Err(err), logger.String("key", key))
+		}
+	}
+}
+
+// sign computes the signature of a signed URL: the hex-encoded HMAC-SHA256
+// of the media ID and expiry time
+func (s *mediaService) sign(id uuid.UUID, expires string) string {
+	mac := hmac.New(sha256.New, []byte(s.secret()))
+	mac.Write([]byte("media:" + id.String() + ":" + expires))
+	return hex.EncodeToString(mac.Sum(nil))
+}
+
+// validSignature reports whether a signed URL's signature matches and it
+// has not expired
+func (s *mediaService) validSignature(id uuid.UUID, expires, signature string) bool {
+	expiresAt, err := strconv.ParseInt(expires, 10, 64)
+	if err != nil || time.Now().Unix() > expiresAt {
+		return false
+	}
+	return hmac.Equal([]byte(signature), []byte(s.sign(id, expires)))
+}
+
+// allowed reports whether files of the content type may be uploaded
+func (s *mediaService) allowed(contentType string) bool {
+	for _, t := range s.config.AllowedTypes {
+		if t == contentType {
+			return true
+		}
+	}
+	return false
+}
+
+// ownsMedia reports whether the user owns the media or is an admin
+func ownsMedia(media *models.Media, user *models.User) bool {
+	return user != nil && (media.UserID == user.ID || user.IsAdmin())
+}
+
+// cleanFileName keeps the base name of an uploaded file's name, which
+// clients may send as a full path
+func cleanFileName(name string) string {
+	name = strings.TrimSpace(filepath.Base(strings.ReplaceAll(name, "\\", "/")))
+	if name == "." || name == "/" || name == "" {
+		return "file"
+	}
+	if len(name) > 255 {
+		name = strings.ToValidUTF8(name[:255], "")
+	}
+	return name
+}
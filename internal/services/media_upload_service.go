@@ -0,0 +1,273 @@
+package services
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"strconv"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/yourusername/go-enterprise-api/internal/config"
+	"github.com/yourusername/go-enterprise-api/internal/jobs"
+	"github.com/yourusername/go-enterprise-api/internal/models"
+	"github.com/yourusername/go-enterprise-api/internal/repository"
+	"github.com/yourusername/go-enterprise-api/internal/storage"
+	apperrors "github.com/yourusername/go-enterprise-api/pkg/errors"
+	"github.com/yourusername/go-enterprise-api/pkg/logger"
+)
+
+// JobMediaUploadPurge deletes abandoned chunked uploads and their chunks
+const JobMediaUploadPurge = "media.purge_uploads"
+
+const (
+	// maxPendingMediaUploads is how many unfinished chunked uploads a user
+	// may have at once
+	maxPendingMediaUploads = 10
+
+	// mediaUploadPurgeBatch is how many abandoned uploads are deleted per
+	// query while purging
+	mediaUploadPurgeBatch = 100
+)
+
+// CreateMediaUploadRequest represents the start of a chunked upload
+type CreateMediaUploadRequest struct {
+	FileName string `json:"file_name" binding:"notblank,max=255"`
+	Size     int64  `json:"size" binding:"gt=0"`
+	Private  bool   `json:"private,omitempty"`
+}
+
+// MediaUploadService interface defines chunked media upload methods
+type MediaUploadService interface {
+	Create(ctx context.Context, ownerID uuid.UUID, req *CreateMediaUploadRequest) (*models.MediaUpload, error)
+	GetByID(ctx context.Context, id uuid.UUID, viewer *models.User) (*models.MediaUpload, error)
+	Append(ctx context.Context, id uuid.UUID, viewer *models.User, offset int64, chunk io.Reader) (*models.MediaUpload, error)
+	Complete(ctx context.Context, id uuid.UUID, viewer *models.User) (*models.Media, error)
+	Abort(ctx context.Context, id uuid.UUID, viewer *models.User) error
+	RegisterJobs(queue *jobs.Queue)
+}
+
+// mediaUploadService implements MediaUploadService
+type mediaUploadService struct {
+	uploadRepo   repository.MediaUploadRepository
+	mediaService MediaService
+	store        storage.Storage
+	config       *config.MediaConfig
+}
+
+// NewMediaUploadService creates a new chunked media upload service. Complete
+// uploads are added to media libraries through mediaService.
+func NewMediaUploadService(uploadRepo repository.MediaUploadRepository, mediaService MediaService, store storage.Storage, cfg *config.MediaConfig) MediaUploadService {
+	return &mediaUploadService{
+		uploadRepo:   uploadRepo,
+		mediaService: mediaService,
+		store:        store,
+		config:       cfg,
+	}
+}
+
+// Create starts a chunked upload of a file of req.Size bytes
+func (s *mediaUploadService) Create(ctx context.Context, ownerID uuid.UUID, req *CreateMediaUploadRequest) (*models.MediaUpload, error) {
+	if req.Size > s.config.MaxSize {
+		return nil, apperrors.ErrBadRequest.WithDetails(fmt.Sprintf("File must be at most %d bytes", s.config.MaxSize))
+	}
+
+	now := time.Now().UTC()
+	pending, err := s.uploadRepo.CountPending(ctx, ownerID, now)
+	if err != nil {
+		logger.Error("Failed to count media uploads", logger.Err(err))
+		return nil, apperrors.ErrInternal
+	}
+	if pending >= maxPendingMediaUploads {
+		return nil, apperrors.ErrTooManyRequests.WithDetails(fmt.Sprintf("You may have at most %d unfinished uploads; complete or cancel one first", maxPendingMediaUploads))
+	}
+
+	upload := &models.MediaUpload{
+		ID:        uuid.New(),
+		FileName:  cleanFileName(req.FileName),
+		Size:      req.Size,
+		Private:   req.Private,
+		UserID:    ownerID,
+		ExpiresAt: now.Add(s.config.UploadTTL),
+	}
+	if err := s.uploadRepo.Create(ctx, upload); err != nil {
+		logger.Error("Failed to create media upload", logger.Err(err))
+		return nil, apperrors.ErrInternal
+	}
+	return upload, nil
+}
+
+// GetByID retrieves one of the viewer's uploads, for clients to find the
+// offset to resume from
+func (s *mediaUploadService) GetByID(ctx context.Context, id uuid.UUID, viewer *models.User) (*models.MediaUpload, error) {
+	upload, err := s.uploadRepo.FindByID(ctx, id)
+	if err != nil {
+		if apperrors.IsAppError(err) {
+			return nil, err
+		}
+		logger.Error("Failed to get media upload", logger.Err(err))
+		return nil, apperrors.ErrInternal
+	}
+	if upload.UserID != viewer.ID || !upload.ExpiresAt.After(time.Now()) {
+		return nil, apperrors.ErrNotFound.WithDetails("Upload not found")
+	}
+	return upload, nil
+}
+
+// Append stores a chunk received at offset, which must be the number of
+// bytes received so far; a chunk sent again after a lost response is
+// rejected with the current offset. Each chunk extends the upload's expiry.
+func (s *mediaUploadService) Append(ctx context.Context, id uuid.UUID, viewer *models.User, offset int64, chunk io.Reader) (*models.MediaUpload, error) {
+	upload, err := s.GetByID(ctx, id, viewer)
+	if err != nil {
+		return nil, err
+	}
+	if offset != upload.Received {
+		return nil, errUploadOffset(upload.Received)
+	}
+
+	data, err := io.ReadAll(io.LimitReader(chunk, s.config.ChunkSize+1))
+	if err != nil {
+		return nil, apperrors.ErrBadRequest.WithDetails("Failed to read the chunk")
+	}
+	if len(data) == 0 {
+		return nil, apperrors.ErrBadRequest.WithDetails("The chunk is empty")
+	}
+	if int64(len(data)) > s.config.ChunkSize {
+		return nil, apperrors.ErrBadRequest.WithDetails(fmt.Sprintf("Chunks must be at most %d bytes", s.config.ChunkSize))
+	}
+	if offset+int64(len(data)) > upload.Size {
+		return nil, apperrors.ErrBadRequest.WithDetails(fmt.Sprintf("The chunk ends past the upload's size of %d bytes", upload.Size))
+	}
+
+	// Chunks sent concurrently at the same offset are stored under keys of
+	// their own, so the one that loses cannot overwrite the one recorded
+	key := storage.Join(s.config.Prefix, "uploads", upload.ID.String(), strconv.FormatInt(offset, 10)+"-"+uuid.New().String())
+	if err := s.store.Put(ctx, key, data, "application/octet-stream"); err != nil {
+		logger.Error("Failed to store upload chunk", logger.Err(err), logger.String("key", key))
+		return nil, apperrors.ErrInternal
+	}
+
+	upload.AddChunk(key, int64(len(data)))
+	upload.ExpiresAt = time.Now().UTC().Add(s.config.UploadTTL)
+	appended, err := s.uploadRepo.Append(ctx, upload, offset)
+	if err != nil || !appended {
+		s.deleteChunks(ctx, []string{key})
+		if err != nil {
+			logger.Error("Failed to append upload chunk", logger.Err(err))
+			return nil, apperrors.ErrInternal
+		}
+		return nil, apperrors.ErrConflict.WithDetails("Another chunk was received at this offset; get the upload to find where to resume")
+	}
+	return upload, nil
+}
+
+// Complete adds a fully received upload to the viewer's media library, with
+// the same checks as a direct upload. The upload is finished either way:
+// an upload whose file is rejected has to be started again.
+func (s *mediaUploadService) Complete(ctx context.Context, id uuid.UUID, viewer *models.User) (*models.Media, error) {
+	upload, err := s.GetByID(ctx, id, viewer)
+	if err != nil {
+		return nil, err
+	}
+	if !upload.IsComplete() {
+		return nil, apperrors.ErrBadRequest.WithDetails(fmt.Sprintf("The upload is incomplete: %d of %d bytes received", upload.Received, upload.Size))
+	}
+
+	data := make([]byte, 0, upload.Size)
+	for _, key := range upload.ChunkKeys() {
+		chunk, err := s.store.Get(ctx, key)
+		if err != nil {
+			logger.Error("Failed to read upload chunk", logger.Err(err), logger.String("key", key))
+			return nil, apperrors.ErrInternal
+		}
+		data = append(data, chunk...)
+	}
+
+	// Only the request deleting the upload adds it, so completing it twice
+	// at once does not add the file twice
+	deleted, err := s.uploadRepo.Delete(ctx, upload.ID)
+	if err != nil {
+		logger.Error("Failed to delete media upload", logger.Err(err))
+		return nil, apperrors.ErrInternal
+	}
+	if !deleted {
+		return nil, apperrors.ErrNotFound.WithDetails("Upload not found")
+	}
+	defer s.deleteChunks(ctx, upload.ChunkKeys())
+
+	return s.mediaService.Upload(ctx, upload.UserID, upload.FileName, bytes.NewReader(data), upload.Private)
+}
+
+// Abort cancels one of the viewer's uploads and deletes its chunks
+func (s *mediaUploadService) Abort(ctx context.Context, id uuid.UUID, viewer *models.User) error {
+	upload, err := s.GetByID(ctx, id, viewer)
+	if err != nil {
+		return err
+	}
+
+	deleted, err := s.uploadRepo.Delete(ctx, upload.ID)
+	if err != nil {
+		logger.Error("Failed to delete media upload", logger.Err(err))
+		return apperrors.ErrInternal
+	}
+	if !deleted {
+		return apperrors.ErrNotFound.WithDetails("Upload not found")
+	}
+	s.deleteChunks(ctx, upload.ChunkKeys())
+	return nil
+}
+
+// RegisterJobs registers the job purging abandoned uploads
+func (s *mediaUploadService) RegisterJobs(queue *jobs.Queue) {
+	queue.Register(JobMediaUploadPurge, func(ctx context.Context, _ []byte) error {
+		return s.purge(ctx)
+	})
+}
+
+// purge deletes the uploads that expired without being completed, along
+// with their chunks
+func (s *mediaUploadService) purge(ctx context.Context) error {
+	purged := 0
+	for {
+		uploads, err := s.uploadRepo.FindExpired(ctx, time.Now().UTC(), mediaUploadPurgeBatch)
+		if err != nil {
+			return err
+		}
+		for i := range uploads {
+			deleted, err := s.uploadRepo.Delete(ctx, uploads[i].ID)
+			if err != nil {
+				return err
+			}
+			if deleted {
+				s.deleteChunks(ctx, uploads[i].ChunkKeys())
+				purged++
+			}
+		}
+		if len(uploads) < mediaUploadPurgeBatch {
+			break
+		}
+	}
+
+	if purged > 0 {
+		logger.Info("Purged abandoned media uploads", logger.Int("deleted", purged))
+	}
+	return nil
+}
+
+// deleteChunks deletes chunks of an upload from storage. Chunks left behind
+// are only logged, as nothing refers to them any more.
+func (s *mediaUploadService) deleteChunks(ctx context.Context, keys []string) {
+	for _, key := range keys {
+		if err := s.store.Delete(ctx, key); err != nil {
+			logger.Warn("Failed to delete upload chunk", logger.Err(err), logger.String("key", key))
+		}
+	}
+}
+
+// errUploadOffset is returned for a chunk that does not start where the
+// upload left off
+func errUploadOffset(received int64) error {
+	return apperrors.ErrConflict.WithDetails(fmt.Sprintf("The upload is at offset %d; resume from there", received))
+}
@@ -0,0 +1,51 @@
+package services
+
+import (
+	"context"
+	"time"
+
+	"github.com/yourusername/go-enterprise-api/internal/events"
+	"github.com/yourusername/go-enterprise-api/pkg/metrics"
+)
+
+// Login results
+const (
+	loginSucceeded = "success"
+	loginFailed    = "failure"
+	loginInactive  = "inactive"
+)
+
+// searchBackendDatabase labels searches answered by the database
+const searchBackendDatabase = "database"
+
+// Business metrics, tracking product health alongside the HTTP metrics
+var (
+	registrationsTotal = metrics.NewCounter("app_user_registrations_total",
+		"Users registered.")
+	loginsTotal = metrics.NewCounter("app_logins_total",
+		"Login attempts by result (success, failure or inactive).", "result")
+	postsPublishedTotal = metrics.NewCounter("app_posts_published_total",
+		"Posts published.")
+	searchesTotal = metrics.NewCounter("app_post_searches_total",
+		"Post searches by the backend that answered them.", "backend")
+	searchDuration = metrics.NewHistogram("app_post_search_duration_seconds",
+		"Post search duration in seconds by the backend that answered them.", nil, "backend")
+)
+
+// SubscribeMetrics counts registrations and published posts as they happen
+func SubscribeMetrics(bus *events.Bus) {
+	events.Subscribe(bus, "metrics", func(ctx context.Context, e events.UserRegistered) error {
+		registrationsTotal.Inc()
+		return nil
+	})
+	events.Subscribe(bus, "metrics", func(ctx context.Context, e events.PostPublished) error {
+		postsPublishedTotal.Inc()
+		return nil
+	})
+}
+
+// observeSearch records a post search answered by backend since start
+func observeSearch(backend string, start time.Time) {
+	searchesTotal.Inc(backend)
+	searchDuration.Observe(time.Since(start).Seconds(), backend)
+}
@@ -0,0 +1,257 @@
+package services
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/url"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/yourusername/go-enterprise-api/internal/events"
+	"github.com/yourusername/go-enterprise-api/internal/jobs"
+	"github.com/yourusername/go-enterprise-api/internal/mailer"
+	"github.com/yourusername/go-enterprise-api/internal/models"
+	"github.com/yourusername/go-enterprise-api/internal/repository"
+	apperrors "github.com/yourusername/go-enterprise-api/pkg/errors"
+	"github.com/yourusername/go-enterprise-api/pkg/logger"
+)
+
+// Job types sending email
+const (
+	JobEmailSend    = "email.send"
+	JobEmailComment = "email.comment"
+)
+
+// NotificationService interface defines notification service methods
+type NotificationService interface {
+	Notify(ctx context.Context, notification *models.Notification)
+	Subscribe(bus *events.Bus)
+	RegisterJobs(queue *jobs.Queue)
+	EmailEnabled() bool
+	EmailInvitation(ctx context.Context, user, inviter *models.User, token string, expiresAt time.Time) error
+	NotifyDormant(ctx context.Context, user *models.User, deactivateAt time.Time) error
+	GetByUser(ctx context.Context, userID uuid.UUID, unreadOnly bool, page, pageSize int) ([]models.Notification, int64, int64, error)
+	MarkRead(ctx context.Context, id, userID uuid.UUID) error
+	MarkAllRead(ctx context.Context, userID uuid.UUID) (int64, error)
+}
+
+// notificationService implements NotificationService
+type notificationService struct {
+	notificationRepo repository.NotificationRepository
+	mailer           *mailer.Mailer
+	queue            *jobs.Queue
+	bus              *events.Bus
+}
+
+// NewNotificationService creates a new notification service. Emails are sent
+// by jobs on queue. mailer may be nil, in which case notifications are not
+// emailed. Recorded notifications are published on bus.
+func NewNotificationService(notificationRepo repository.NotificationRepository, mailer *mailer.Mailer, queue *jobs.Queue, bus *events.Bus) NotificationService {
+	return &notificationService{
+		notificationRepo: notificationRepo,
+		mailer:           mailer,
+		queue:            queue,
+		bus:              bus,
+	}
+}
+
+// emailJob is the payload of a JobEmailSend job. Data is rendered into the
+// template as decoded JSON, so templates see the same fields as with the
+// original struct.
+type emailJob struct {
+	To       string          `json:"to"`
+	Template mailer.Template `json:"template"`
+	Data     json.RawMessage `json:"data"`
+}
+
+// commentEmailJob is the payload of a JobEmailComment job
+type commentEmailJob struct {
+	NotificationID uuid.UUID `json:"notification_id"`
+}
+
+// Notify records a notification. Users are not notified of their own
+// actions. Notifications are best effort: failures are logged and do not
+// fail the action that triggered them.
+func (s *notificationService) Notify(ctx context.Context, notification *models.Notification) {
+	if notification.ActorID != nil && *notification.ActorID == notification.UserID {
+		return
+	}
+
+	if err := s.notificationRepo.Create(ctx, notification); err != nil {
+		logger.Error("Failed to create notification",
+			logger.String("type", string(notification.Type)),
+			logger.String("user_id", notification.UserID.String()),
+			logger.Err(err),
+		)
+		return
+	}
+
+	s.bus.Publish(ctx, events.NotificationCreated{Notification: notification})
+
+	if s.mailer != nil && notification.Type == models.NotificationComment {
+		_, err := s.queue.Enqueue(ctx, JobEmailComment, commentEmailJob{NotificationID: notification.ID})
+		if err != nil {
+			logger.Error("Failed to enqueue comment email",
+				logger.String("notification_id", notification.ID.String()),
+				logger.Err(err),
+			)
+		}
+	}
+}
+
+// RegisterJobs registers the jobs sending email. Without a mailer, there is
+// nothing to register.
+func (s *notificationService) RegisterJobs(queue *jobs.Queue) {
+	if s.mailer == nil {
+		return
+	}
+	queue.Register(JobEmailSend, jobs.Handle(s.sendEmail))
+	queue.Register(JobEmailComment, jobs.Handle(s.emailComment))
+}
+
+// queueEmail queues a job sending an email rendered from a template
+func (s *notificationService) queueEmail(ctx context.Context, to string, tmpl mailer.Template, data interface{}) error {
+	encoded, err := json.Marshal(data)
+	if err != nil {
+		return err
+	}
+	_, err = s.queue.Enqueue(ctx, JobEmailSend, emailJob{To: to, Template: tmpl, Data: encoded})
+	return err
+}
+
+// sendEmail sends an email queued by queueEmail
+func (s *notificationService) sendEmail(ctx context.Context, job emailJob) error {
+	var data map[string]interface{}
+	if err := json.Unmarshal(job.Data, &data); err != nil {
+		return jobs.Permanent(err)
+	}
+	return s.mailer.Send(ctx, job.To, job.Template, data)
+}
+
+// emailComment emails the author of a post about a new comment
+func (s *notificationService) emailComment(ctx context.Context, job commentEmailJob) error {
+	notification, err := s.notificationRepo.FindForDelivery(ctx, job.NotificationID)
+	if err != nil {
+		if errors.Is(err, apperrors.ErrNotFound) {
+			return jobs.Permanent(err)
+		}
+		return err
+	}
+
+	recipient := notification.User
+	if recipient == nil || !recipient.IsActive() || notification.Actor == nil || notification.Post == nil || notification.Comment == nil {
+		return nil
+	}
+
+	commenter := notification.Actor.FullName()
+	if commenter == "" {
+		commenter = "Someone"
+	}
+
+	return s.mailer.Send(ctx, recipient.Email, mailer.TemplateComment, mailer.CommentEmail{
+		Name:          recipient.FullName(),
+		CommenterName: commenter,
+		PostTitle:     notification.Post.Title,
+		PostURL:       s.mailer.URL("/posts/" + notification.Post.Slug),
+		Comment:       notification.Comment.Content,
+	})
+}
+
+// Subscribe emails users about security-relevant changes to their account
+func (s *notificationService) Subscribe(bus *events.Bus) {
+	if s.mailer == nil {
+		return
+	}
+	events.Subscribe(bus, "email", s.emailPasswordChanged)
+}
+
+// emailPasswordChanged tells a user that their password was changed, so they
+// notice if someone else did it
+func (s *notificationService) emailPasswordChanged(ctx context.Context, e events.PasswordChanged) error {
+	return s.queueEmail(ctx, e.User.Email, mailer.TemplatePasswordChanged, mailer.PasswordChangedEmail{
+		Name:      e.User.FullName(),
+		ChangedAt: time.Now().UTC().Format("January 2, 2006 at 15:04 UTC"),
+	})
+}
+
+// EmailEnabled reports whether emails can be sent
+func (s *notificationService) EmailEnabled() bool {
+	return s.mailer != nil
+}
+
+// EmailInvitation emails a user created by an admin a link to choose their
+// password with token
+func (s *notificationService) EmailInvitation(ctx context.Context, user, inviter *models.User, token string, expiresAt time.Time) error {
+	if s.mailer == nil {
+		return apperrors.ErrBadRequest.WithDetails("Email is not configured, so invitations cannot be sent")
+	}
+	return s.queueEmail(ctx, user.Email, mailer.TemplateInvitation, mailer.InvitationEmail{
+		Name:        user.FullName(),
+		InviterName: inviter.FullName(),
+		URL:         s.mailer.URL("/set-password?token=" + url.QueryEscape(token)),
+		ExpiresAt:   expiresAt.UTC().Format("January 2, 2006 at 15:04 UTC"),
+	})
+}
+
+// NotifyDormant emails an inactive user that their account will be
+// deactivated at deactivateAt unless they sign in. It implements
+// DormancyNotifier: the notice counts as sent once its email is queued.
+func (s *notificationService) NotifyDormant(ctx context.Context, user *models.User, deactivateAt time.Time) error {
+	if s.mailer == nil {
+		return errors.New("email is not configured, so dormancy notices cannot be sent")
+	}
+	return s.queueEmail(ctx, user.Email, mailer.TemplateDormancy, mailer.DormancyEmail{
+		Name:         user.FullName(),
+		LoginURL:     s.mailer.URL("/login"),
+		DeactivateAt: deactivateAt.UTC().Format("January 2, 2006"),
+	})
+}
+
+// GetByUser retrieves a user's notifications, newest first, along with the
+// number of unread notifications
+func (s *notificationService) GetByUser(ctx context.Context, userID uuid.UUID, unreadOnly bool, page, pageSize int) ([]models.Notification, int64, int64, error) {
+	if page < 1 {
+		page = 1
+	}
+	if pageSize < 1 || pageSize > 100 {
+		pageSize = 10
+	}
+
+	notifications, total, err := s.notificationRepo.FindByUser(ctx, userID, unreadOnly, page, pageSize)
+	if err != nil {
+		logger.Error("Failed to get notifications", logger.Err(err))
+		return nil, 0, 0, apperrors.ErrInternal
+	}
+
+	unread, err := s.notificationRepo.CountUnread(ctx, userID)
+	if err != nil {
+		logger.Error("Failed to count unread notifications", logger.Err(err))
+		return nil, 0, 0, apperrors.ErrInternal
+	}
+
+	return notifications, total, unread, nil
+}
+
+// MarkRead marks one of a user's notifications as read
+func (s *notificationService) MarkRead(ctx context.Context, id, userID uuid.UUID) error {
+	if err := s.notificationRepo.MarkRead(ctx, id, userID); err != nil {
+		if apperrors.IsAppError(err) {
+			return err
+		}
+		logger.Error("Failed to mark notification as read", logger.Err(err))
+		return apperrors.ErrInternal
+	}
+	return nil
+}
+
+// MarkAllRead marks all of a user's notifications as read and returns how
+// many were unread
+func (s *notificationService) MarkAllRead(ctx context.Context, userID uuid.UUID) (int64, error) {
+	marked, err := s.notificationRepo.MarkAllRead(ctx, userID)
+	if err != nil {
+		logger.Error("Failed to mark notifications as read", logger.Err(err))
+		return 0, apperrors.ErrInternal
+	}
+	return marked, nil
+}
@@ -0,0 +1,396 @@
+package services
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/yourusername/go-enterprise-api/internal/models"
+	"github.com/yourusername/go-enterprise-api/internal/repository"
+	apperrors "github.com/yourusername/go-enterprise-api/pkg/errors"
+	"github.com/yourusername/go-enterprise-api/pkg/logger"
+)
+
+// MaxBulkItems caps the number of rows a single bulk operation may touch
+const MaxBulkItems = 500
+
+// BulkUserAction is what a bulk user operation does to each user
+type BulkUserAction string
+
+const (
+	BulkUserSetStatus BulkUserAction = "set_status"
+	BulkUserSetRole   BulkUserAction = "set_role"
+	BulkUserDelete    BulkUserAction = "delete"
+)
+
+// BulkUserActions lists the valid bulk user actions
+var BulkUserActions = []BulkUserAction{BulkUserSetStatus, BulkUserSetRole, BulkUserDelete}
+
+// BulkUsersRequest represents an action applied to several users at once.
+// Status is required to set the status, and role to set the role.
+type BulkUsersRequest struct {
+	Action string      `json:"action" binding:"required"`
+	IDs    []uuid.UUID `json:"ids" binding:"required"`
+	Status string      `json:"status,omitempty"`
+	Role   string      `json:"role,omitempty"`
+}
+
+// Outcomes of a bulk operation for a single record
+const (
+	BulkItemApplied   = "applied"
+	BulkItemUnchanged = "unchanged"
+	BulkItemFailed    = "failed"
+)
+
+// BulkItemResult is the outcome of a bulk operation for a single record.
+// Code and Error tell why a record failed.
+type BulkItemResult struct {
+	ID     uuid.UUID `json:"id"`
+	Result string    `json:"result"`
+	Code   int       `json:"code,omitempty"`
+	Error  string    `json:"error,omitempty"`
+}
+
+// BulkUsersResult is the outcome of a bulk user operation. Operation is nil
+// when no user was changed.
+type BulkUsersResult struct {
+	Operation *models.AdminOperation
+	Items     []BulkItemResult
+}
+
+// OperationService interface defines bulk admin operations with undo support
+type OperationService interface {
+	BulkDeleteUsers(ctx context.Context, actorID uuid.UUID, ids []uuid.UUID) (*models.AdminOperation, error)
+	BulkUpdateRole(ctx context.Context, actorID uuid.UUID, ids []uuid.UUID, role models.UserRole) (*models.AdminOperation, error)
+	BulkUpdateUsers(ctx context.Context, actorID uuid.UUID, req *BulkUsersRequest) (*BulkUsersResult, error)
+	GetAll(ctx context.Context, page, pageSize int) ([]models.AdminOperation, int64, error)
+	Undo(ctx context.Context, id, actorID uuid.UUID) (*models.AdminOperation, error)
+}
+
+// operationService implements OperationService
+type operationService struct {
+	operationRepo repository.OperationRepository
+	userRepo      repository.UserRepository
+	retention     time.Duration
+}
+
+// NewOperationService creates a new operation service.
+// retention is how long a bulk operation can be undone.
+func NewOperationService(operationRepo repository.OperationRepository, userRepo repository.UserRepository, retention time.Duration) OperationService {
+	return &operationService{
+		operationRepo: operationRepo,
+		userRepo:      userRepo,
+		retention:     retention,
+	}
+}
+
+// BulkDeleteUsers soft deletes several users after taking a restore point
+func (s *operationService) BulkDeleteUsers(ctx context.Context, actorID uuid.UUID, ids []uuid.UUID) (*models.AdminOperation, error) {
+	if err := validateBulkIDs(actorID, ids); err != nil {
+		return nil, err
+	}
+
+	users, err := s.userRepo.FindByIDs(ctx, ids)
+	if err != nil {
+		logger.Error("Failed to load users", logger.Err(err))
+		return nil, apperrors.ErrInternal
+	}
+	for _, user := range users {
+		if user.OnLegalHold() {
+			return nil, errLegalHold("deleted", models.ChangeEntityUser, user.ID)
+		}
+	}
+
+	var operation *models.AdminOperation
+	err = s.atomically(ctx, func(repos repository.OperationRepos) error {
+		var err error
+		operation, err = s.run(ctx, repos, actorID, models.OperationBulkDeleteUsers, "users", ids, []string{"deleted_at"}, func() (int64, error) {
+			return repos.Users.DeleteMany(ctx, ids)
+		})
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	return operation, nil
+}
+
+// BulkUpdateRole changes the role of several users after taking a restore point
+func (s *operationService) BulkUpdateRole(ctx context.Context, actorID uuid.UUID, ids []uuid.UUID, role models.UserRole) (*models.AdminOperation, error) {
+	if err := validateBulkIDs(actorID, ids); err != nil {
+		return nil, err
+	}
+
+	var operation *models.AdminOperation
+	err := s.atomically(ctx, func(repos repository.OperationRepos) error {
+		var err error
+		operation, err = s.run(ctx, repos, actorID, models.OperationBulkUpdateRole, "users", ids, []string{"role"}, func() (int64, error) {
+			return repos.Users.UpdateRoleMany(ctx, ids, role)
+		})
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	return operation, nil
+}
+
+// BulkUpdateUsers changes the status or role of several users, or deletes
+// them, after taking a restore point. Users that cannot be changed are
+// reported as failed and the others are still changed; those already in the
+// requested state are left unchanged. The restore point, the changed users
+// and their timelines are written in a single transaction, so either all of
+// them or none are.
+func (s *operationService) BulkUpdateUsers(ctx context.Context, actorID uuid.UUID, req *BulkUsersRequest) (*BulkUsersResult, error) {
+	ids := uniqueIDs(req.IDs)
+	if len(ids) == 0 {
+		return nil, apperrors.ErrBadRequest.WithDetails("At least one ID is required")
+	}
+	if len(ids) > MaxBulkItems {
+		return nil, apperrors.ErrBadRequest.WithDetails("Too many IDs in a single operation")
+	}
+
+	users, err := s.userRepo.FindByIDs(ctx, ids)
+	if err != nil {
+		logger.Error("Failed to load users", logger.Err(err))
+		return nil, apperrors.ErrInternal
+	}
+	byID := make(map[uuid.UUID]*models.User, len(users))
+	for i := range users {
+		byID[users[i].ID] = &users[i]
+	}
+
+	action := BulkUserAction(req.Action)
+	status := models.UserStatus(req.Status)
+	role := models.UserRole(req.Role)
+
+	result := &BulkUsersResult{Items: make([]BulkItemResult, len(ids))}
+	var changed []*models.User
+	for i, id := range ids {
+		item := BulkItemResult{ID: id, Result: BulkItemApplied}
+		user := byID[id]
+		switch {
+		case user == nil:
+			item = failedItem(id, apperrors.CodeUserNotFound, "User not found")
+		case id == actorID:
+			item = failedItem(id, apperrors.CodeBadRequest, "You cannot include your own account in a bulk operation")
+		case action == BulkUserDelete && user.OnLegalHold():
+			item = failedItem(id, apperrors.CodeLegalHold, "User is under legal hold and cannot be deleted until the hold is lifted")
+		case action == BulkUserSetStatus && user.Status == status,
+			action == BulkUserSetRole && user.Role == role:
+			item.Result = BulkItemUnchanged
+		default:
+			changed = append(changed, user)
+		}
+		result.Items[i] = item
+	}
+	if len(changed) == 0 {
+		return result, nil
+	}
+
+	changedIDs := make([]uuid.UUID, len(changed))
+	for i, user := range changed {
+		changedIDs[i] = user.ID
+	}
+
+	err = s.atomically(ctx, func(repos repository.OperationRepos) error {
+		var err error
+		switch action {
+		case BulkUserSetStatus:
+			result.Operation, err = s.run(ctx, repos, actorID, models.OperationBulkUpdateStatus, "users", changedIDs, []string{"status"}, func() (int64, error) {
+				return repos.Users.UpdateStatusMany(ctx, changedIDs, status)
+			})
+		case BulkUserSetRole:
+			result.Operation, err = s.run(ctx, repos, actorID, models.OperationBulkUpdateRole, "users", changedIDs, []string{"role"}, func() (int64, error) {
+				return repos.Users.UpdateRoleMany(ctx, changedIDs, role)
+			})
+		case BulkUserDelete:
+			result.Operation, err = s.run(ctx, repos, actorID, models.OperationBulkDeleteUsers, "users", changedIDs, []string{"deleted_at"}, func() (int64, error) {
+				return repos.Users.DeleteMany(ctx, changedIDs)
+			})
+		default:
+			return apperrors.ErrValidation.WithDetails("Invalid bulk action")
+		}
+		if err != nil {
+			return err
+		}
+
+		// Each user's timeline records the change, as individual updates do
+		for _, user := range changed {
+			changes := newChangeSet(models.ChangeEntityUser, user.ID, actorID)
+			switch action {
+			case BulkUserSetStatus:
+				changes.track("status", user.Status, status)
+			case BulkUserSetRole:
+				changes.track("role", user.Role, role)
+			case BulkUserDelete:
+				changes.track("deleted", false, true)
+			}
+			if err := changes.create(ctx, repos.Changes); err != nil {
+				logger.Error("Failed to record changes",
+					logger.String("user_id", user.ID.String()),
+					logger.Err(err),
+				)
+				return apperrors.ErrInternal
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
+
+// GetAll retrieves recent operations with pagination
+func (s *operationService) GetAll(ctx context.Context, page, pageSize int) ([]models.AdminOperation, int64, error) {
+	if page < 1 {
+		page = 1
+	}
+	if pageSize < 1 || pageSize > 100 {
+		pageSize = 10
+	}
+
+	operations, total, err := s.operationRepo.FindAllRecent(ctx, page, pageSize)
+	if err != nil {
+		logger.Error("Failed to get operations", logger.Err(err))
+		return nil, 0, apperrors.ErrInternal
+	}
+	return operations, total, nil
+}
+
+// Undo restores the rows affected by an operation from its snapshot
+func (s *operationService) Undo(ctx context.Context, id, actorID uuid.UUID) (*models.AdminOperation, error) {
+	operation, err := s.operationRepo.FindByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	if operation.UndoneAt != nil {
+		return nil, apperrors.ErrConflict.WithDetails("Operation has already been undone")
+	}
+	if !operation.CanUndo() {
+		return nil, apperrors.ErrBadRequest.WithDetails("Undo window for this operation has expired")
+	}
+
+	var rows []models.SnapshotRow
+	if err := json.Unmarshal([]byte(operation.Snapshot), &rows); err != nil {
+		logger.Error("Failed to decode operation snapshot", logger.Err(err))
+		return nil, apperrors.ErrInternal
+	}
+
+	if err := s.operationRepo.Restore(ctx, operation.TargetTable, rows); err != nil {
+		logger.Error("Failed to restore operation snapshot", logger.Err(err))
+		return nil, apperrors.ErrInternal
+	}
+
+	if err := s.operationRepo.MarkUndone(ctx, id, actorID); err != nil {
+		logger.Error("Failed to mark operation undone", logger.Err(err))
+		return nil, apperrors.ErrInternal
+	}
+
+	logger.Info("Admin operation undone",
+		logger.String("operation_id", id.String()),
+		logger.String("actor_id", actorID.String()),
+	)
+
+	return s.operationRepo.FindByID(ctx, id)
+}
+
+// atomically runs fn in a transaction with repositories bound to it. Errors
+// fn returns are passed through; failing to commit is an internal error.
+func (s *operationService) atomically(ctx context.Context, fn func(repos repository.OperationRepos) error) error {
+	err := s.operationRepo.InTransaction(ctx, fn)
+	if err != nil && !apperrors.IsAppError(err) {
+		logger.Error("Failed to commit bulk operation", logger.Err(err))
+		return apperrors.ErrInternal
+	}
+	return err
+}
+
+// run snapshots the affected rows, records the operation and then executes
+// it, through repos, which must be bound to a transaction so a failure
+// leaves nothing behind
+func (s *operationService) run(ctx context.Context, repos repository.OperationRepos, actorID uuid.UUID, opType models.OperationType, table string, ids []uuid.UUID, columns []string, execute func() (int64, error)) (*models.AdminOperation, error) {
+	rows, err := repos.Operations.Snapshot(ctx, table, ids, columns)
+	if err != nil {
+		logger.Error("Failed to snapshot rows", logger.Err(err))
+		return nil, apperrors.ErrInternal
+	}
+
+	snapshot, err := json.Marshal(rows)
+	if err != nil {
+		logger.Error("Failed to encode snapshot", logger.Err(err))
+		return nil, apperrors.ErrInternal
+	}
+
+	operation := &models.AdminOperation{
+		Type:        opType,
+		TargetTable: table,
+		Snapshot:    string(snapshot),
+		ActorID:     actorID,
+		ExpiresAt:   time.Now().UTC().Add(s.retention),
+	}
+
+	// Record the restore point before touching any data
+	if err := repos.Operations.Create(ctx, operation); err != nil {
+		logger.Error("Failed to record operation", logger.Err(err))
+		return nil, apperrors.ErrInternal
+	}
+
+	affected, err := execute()
+	if err != nil {
+		logger.Error("Bulk operation failed", logger.String("type", string(opType)), logger.Err(err))
+		return nil, apperrors.ErrInternal
+	}
+
+	operation.AffectedCount = int(affected)
+	if err := repos.Operations.Update(ctx, operation); err != nil {
+		logger.Error("Failed to update operation", logger.Err(err))
+		return nil, apperrors.ErrInternal
+	}
+
+	logger.Info("Bulk admin operation executed",
+		logger.String("type", string(opType)),
+		logger.String("operation_id", operation.ID.String()),
+		logger.Int("affected", int(affected)),
+	)
+
+	return operation, nil
+}
+
+// failedItem reports a record a bulk operation could not change
+func failedItem(id uuid.UUID, code int, message string) BulkItemResult {
+	return BulkItemResult{ID: id, Result: BulkItemFailed, Code: code, Error: message}
+}
+
+// uniqueIDs returns ids without duplicates, in their original order
+func uniqueIDs(ids []uuid.UUID) []uuid.UUID {
+	seen := make(map[uuid.UUID]bool, len(ids))
+	unique := make([]uuid.UUID, 0, len(ids))
+	for _, id := range ids {
+		if !seen[id] {
+			seen[id] = true
+			unique = append(unique, id)
+		}
+	}
+	return unique
+}
+
+// validateBulkIDs checks the size of a bulk request and that admins don't target themselves
+func validateBulkIDs(actorID uuid.UUID, ids []uuid.UUID) error {
+	if len(ids) == 0 {
+		return apperrors.ErrBadRequest.WithDetails("At least one ID is required")
+	}
+	if len(ids) > MaxBulkItems {
+		return apperrors.ErrBadRequest.WithDetails("Too many IDs in a single operation")
+	}
+	for _, id := range ids {
+		if id == actorID {
+			return apperrors.ErrBadRequest.WithDetails("You cannot include your own account in a bulk operation")
+		}
+	}
+	return nil
+}
@@ -0,0 +1,278 @@
+package services
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/yourusername/go-enterprise-api/internal/models"
+	"github.com/yourusername/go-enterprise-api/internal/repository"
+	apperrors "github.com/yourusername/go-enterprise-api/pkg/errors"
+	"github.com/yourusername/go-enterprise-api/pkg/logger"
+)
+
+// CreateOrganizationRequest represents the create organization request
+type CreateOrganizationRequest struct {
+	Name string `json:"name" binding:"notblank,max=100" sanitize:"text,striptags"`
+	Slug string `json:"slug" binding:"required,slug,max=63" sanitize:"trim"`
+}
+
+// UpdateOrganizationRequest represents the update organization request
+type UpdateOrganizationRequest struct {
+	Name string `json:"name" binding:"notblank,max=100" sanitize:"text,striptags"`
+}
+
+// SetMemberRequest represents the add or change member request
+type SetMemberRequest struct {
+	Role string `json:"role" binding:"required"`
+}
+
+// OrganizationService interface defines organization and membership methods
+type OrganizationService interface {
+	Create(ctx context.Context, owner *models.User, req *CreateOrganizationRequest) (*models.Organization, error)
+	GetByID(ctx context.Context, id uuid.UUID, viewer *models.User) (*models.Organization, error)
+	Resolve(ctx context.Context, ref string) (*models.Organization, error)
+	GetMine(ctx context.Context, userID uuid.UUID) ([]models.OrganizationMember, error)
+	Update(ctx context.Context, id uuid.UUID, actor *models.User, req *UpdateOrganizationRequest) (*models.Organization, error)
+	GetMembers(ctx context.Context, id uuid.UUID, viewer *models.User, page, pageSize int) ([]models.OrganizationMember, int64, error)
+	SetMember(ctx context.Context, id uuid.UUID, actor *models.User, userID uuid.UUID, role models.OrgRole) (*models.OrganizationMember, error)
+	RemoveMember(ctx context.Context, id uuid.UUID, actor *models.User, userID uuid.UUID) error
+	Authorize(ctx context.Context, org *models.Organization, user *models.User, roles ...models.OrgRole) error
+}
+
+// organizationService implements OrganizationService
+type organizationService struct {
+	orgRepo  repository.OrganizationRepository
+	userRepo repository.UserRepository
+}
+
+// NewOrganizationService creates a new organization service
+func NewOrganizationService(orgRepo repository.OrganizationRepository, userRepo repository.UserRepository) OrganizationService {
+	return &organizationService{
+		orgRepo:  orgRepo,
+		userRepo: userRepo,
+	}
+}
+
+// Create creates an organization owned by owner
+func (s *organizationService) Create(ctx context.Context, owner *models.User, req *CreateOrganizationRequest) (*models.Organization, error) {
+	if _, err := s.orgRepo.FindBySlug(ctx, req.Slug); err == nil {
+		return nil, apperrors.ErrConflict.WithDetails("Organization slug is already taken")
+	}
+
+	org := &models.Organization{Name: req.Name, Slug: req.Slug}
+	if err := s.orgRepo.CreateWithOwner(ctx, org, owner.ID); err != nil {
+		if repository.IsDuplicateKey(err) {
+			return nil, apperrors.ErrConflict.WithDetails("Organization slug is already taken")
+		}
+		logger.Error("Failed to create organization", logger.Err(err))
+		return nil, apperrors.ErrInternal
+	}
+
+	logger.Info("Organization created",
+		logger.String("organization_id", org.ID.String()),
+		logger.String("slug", org.Slug),
+		logger.String("owner_id", owner.ID.String()),
+	)
+	return org, nil
+}
+
+// GetByID retrieves an organization the viewer is a member of
+func (s *organizationService) GetByID(ctx context.Context, id uuid.UUID, viewer *models.User) (*models.Organization, error) {
+	org, err := s.orgRepo.FindByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.Authorize(ctx, org, viewer); err != nil {
+		return nil, err
+	}
+	return org, nil
+}
+
+// Resolve finds the organization a request is made for by its slug or ID
+func (s *organizationService) Resolve(ctx context.Context, ref string) (*models.Organization, error) {
+	if id, err := uuid.Parse(ref); err == nil {
+		return s.orgRepo.FindByID(ctx, id)
+	}
+	return s.orgRepo.FindBySlug(ctx, ref)
+}
+
+// GetMine retrieves the memberships of a user with their organizations
+func (s *organizationService) GetMine(ctx context.Context, userID uuid.UUID) ([]models.OrganizationMember, error) {
+	members, err := s.orgRepo.FindMemberships(ctx, userID)
+	if err != nil {
+		logger.Error("Failed to get organizations", logger.Err(err))
+		return nil, apperrors.ErrInternal
+	}
+	return members, nil
+}
+
+// Update renames an organization. Its slug cannot change, as clients and
+// subdomains refer to it.
+func (s *organizationService) Update(ctx context.Context, id uuid.UUID, actor *models.User, req *UpdateOrganizationRequest) (*models.Organization, error) {
+	org, err := s.orgRepo.FindByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.Authorize(ctx, org, actor, models.OrgRoleOwner, models.OrgRoleAdmin); err != nil {
+		return nil, err
+	}
+
+	org.Name = req.Name
+	if err := s.orgRepo.Update(ctx, org); err != nil {
+		logger.Error("Failed to update organization", logger.Err(err))
+		return nil, apperrors.ErrInternal
+	}
+	return org, nil
+}
+
+// GetMembers retrieves the members of an organization the viewer is a
+// member of
+func (s *organizationService) GetMembers(ctx context.Context, id uuid.UUID, viewer *models.User, page, pageSize int) ([]models.OrganizationMember, int64, error) {
+	if page < 1 {
+		page = 1
+	}
+	if pageSize < 1 || pageSize > 100 {
+		pageSize = 10
+	}
+
+	if _, err := s.GetByID(ctx, id, viewer); err != nil {
+		return nil, 0, err
+	}
+
+	members, total, err := s.orgRepo.FindMembers(ctx, id, page, pageSize)
+	if err != nil {
+		logger.Error("Failed to get organization members", logger.Err(err))
+		return nil, 0, apperrors.ErrInternal
+	}
+	return members, total, nil
+}
+
+// SetMember adds a user to an organization or changes their role. Owners and
+// admins manage members, but only owners may make or unmake owners, and the
+// last owner cannot step down.
+func (s *organizationService) SetMember(ctx context.Context, id uuid.UUID, actor *models.User, userID uuid.UUID, role models.OrgRole) (*models.OrganizationMember, error) {
+	org, err := s.orgRepo.FindByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.Authorize(ctx, org, actor, models.OrgRoleOwner, models.OrgRoleAdmin); err != nil {
+		return nil, err
+	}
+
+	user, err := s.userRepo.FindByID(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	if !user.IsActive() {
+		return nil, apperrors.ErrBadRequest.WithDetails("Only active users can be members")
+	}
+
+	// current is nil when the user is not a member yet
+	current, err := s.orgRepo.FindMember(ctx, id, userID)
+	if err != nil && !apperrors.IsAppError(err) {
+		logger.Error("Failed to get organization member", logger.Err(err))
+		return nil, apperrors.ErrInternal
+	}
+
+	if role == models.OrgRoleOwner || (current != nil && current.Role == models.OrgRoleOwner) {
+		if err := s.Authorize(ctx, org, actor, models.OrgRoleOwner); err != nil {
+			return nil, apperrors.ErrForbidden.WithDetails("Only owners can add or change owners")
+		}
+	}
+	if current != nil && current.Role == models.OrgRoleOwner && role != models.OrgRoleOwner {
+		if err := s.keepOwner(ctx, id); err != nil {
+			return nil, err
+		}
+	}
+
+	member := &models.OrganizationMember{OrganizationID: id, UserID: userID, Role: role}
+	if err := s.orgRepo.SaveMember(ctx, member); err != nil {
+		logger.Error("Failed to save organization member", logger.Err(err))
+		return nil, apperrors.ErrInternal
+	}
+
+	saved, err := s.orgRepo.FindMember(ctx, id, userID)
+	if err != nil {
+		logger.Error("Failed to get organization member", logger.Err(err))
+		return nil, apperrors.ErrInternal
+	}
+	saved.User = user
+	return saved, nil
+}
+
+// RemoveMember removes a user from an organization. Owners and admins
+// remove members, only owners remove owners, and anyone may leave, except
+// the last owner.
+func (s *organizationService) RemoveMember(ctx context.Context, id uuid.UUID, actor *models.User, userID uuid.UUID) error {
+	org, err := s.orgRepo.FindByID(ctx, id)
+	if err != nil {
+		return err
+	}
+
+	member, err := s.orgRepo.FindMember(ctx, id, userID)
+	if err != nil {
+		return err
+	}
+
+	if userID != actor.ID {
+		required := []models.OrgRole{models.OrgRoleOwner, models.OrgRoleAdmin}
+		if member.Role == models.OrgRoleOwner {
+			required = []models.OrgRole{models.OrgRoleOwner}
+		}
+		if err := s.Authorize(ctx, org, actor, required...); err != nil {
+			return err
+		}
+	}
+	if member.Role == models.OrgRoleOwner {
+		if err := s.keepOwner(ctx, id); err != nil {
+			return err
+		}
+	}
+
+	if err := s.orgRepo.DeleteMember(ctx, id, userID); err != nil {
+		logger.Error("Failed to remove organization member", logger.Err(err))
+		return apperrors.ErrInternal
+	}
+	return nil
+}
+
+// Authorize checks that user is a member of org with one of roles, or with
+// any role when none are given. Site admins are always authorized.
+func (s *organizationService) Authorize(ctx context.Context, org *models.Organization, user *models.User, roles ...models.OrgRole) error {
+	if user.IsAdmin() {
+		return nil
+	}
+
+	member, err := s.orgRepo.FindMember(ctx, org.ID, user.ID)
+	if err != nil {
+		if apperrors.IsAppError(err) {
+			return apperrors.ErrForbidden.WithDetails("You are not a member of this organization")
+		}
+		logger.Error("Failed to get organization member", logger.Err(err))
+		return apperrors.ErrInternal
+	}
+
+	if len(roles) == 0 {
+		return nil
+	}
+	for _, role := range roles {
+		if member.Role == role {
+			return nil
+		}
+	}
+	return apperrors.ErrForbidden.WithDetails("Your role in this organization does not allow this")
+}
+
+// keepOwner refuses to demote or remove an owner of an organization that
+// has no other owner
+func (s *organizationService) keepOwner(ctx context.Context, id uuid.UUID) error {
+	owners, err := s.orgRepo.CountOwners(ctx, id)
+	if err != nil {
+		logger.Error("Failed to count organization owners", logger.Err(err))
+		return apperrors.ErrInternal
+	}
+	if owners <= 1 {
+		return apperrors.ErrBadRequest.WithDetails("An organization must keep at least one owner")
+	}
+	return nil
+}
@@ -0,0 +1,148 @@
+package services
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/yourusername/go-enterprise-api/internal/models"
+	"github.com/yourusername/go-enterprise-api/internal/repository"
+	apperrors "github.com/yourusername/go-enterprise-api/pkg/errors"
+	"github.com/yourusername/go-enterprise-api/pkg/logger"
+)
+
+// PublishPolicyRequest represents an admin's publication of a new version
+// of the terms of service or the privacy policy
+type PublishPolicyRequest struct {
+	Kind    string `json:"kind" binding:"required"`
+	Version string `json:"version" binding:"notblank,max=50" sanitize:"trim"`
+	URL     string `json:"url" binding:"required,max=2048" sanitize:"trim"`
+	Summary string `json:"summary,omitempty" binding:"max=1000" sanitize:"text"`
+}
+
+// PolicyService interface defines terms of service and privacy policy
+// service methods. Users accept the current versions when they register, and
+// again whenever admins publish a new version.
+type PolicyService interface {
+	Publish(ctx context.Context, publisherID uuid.UUID, req *PublishPolicyRequest) (*models.PolicyVersion, error)
+	GetCurrent(ctx context.Context) ([]models.PolicyVersion, error)
+	GetAll(ctx context.Context, kind models.PolicyKind, page, pageSize int) ([]models.PolicyVersion, int64, error)
+	GetPending(ctx context.Context, userID uuid.UUID) ([]models.PolicyVersion, error)
+	Accept(ctx context.Context, userID uuid.UUID, versionIDs []uuid.UUID) error
+}
+
+// policyService implements PolicyService
+type policyService struct {
+	policyRepo repository.PolicyRepository
+}
+
+// NewPolicyService creates a new policy service
+func NewPolicyService(policyRepo repository.PolicyRepository) PolicyService {
+	return &policyService{
+		policyRepo: policyRepo,
+	}
+}
+
+// Publish publishes a new version of a policy, which becomes the current
+// one. Users who have not accepted it cannot make changes until they do.
+func (s *policyService) Publish(ctx context.Context, publisherID uuid.UUID, req *PublishPolicyRequest) (*models.PolicyVersion, error) {
+	kind := models.PolicyKind(req.Kind)
+	exists, err := s.policyRepo.ExistsVersion(ctx, kind, req.Version)
+	if err != nil {
+		logger.Error("Failed to check policy version", logger.Err(err))
+		return nil, apperrors.ErrInternal
+	}
+	if exists {
+		return nil, apperrors.ErrConflict.WithDetails("This version of the policy has already been published")
+	}
+
+	version := &models.PolicyVersion{
+		Kind:        kind,
+		Version:     req.Version,
+		URL:         req.URL,
+		Summary:     req.Summary,
+		PublishedAt: time.Now().UTC(),
+		PublishedBy: &publisherID,
+	}
+	if err := s.policyRepo.Create(ctx, version); err != nil {
+		logger.Error("Failed to publish policy version", logger.Err(err))
+		return nil, apperrors.ErrInternal
+	}
+
+	logger.Info("Policy version published",
+		logger.String("policy_version_id", version.ID.String()),
+		logger.String("kind", string(version.Kind)),
+		logger.String("version", version.Version),
+		logger.String("published_by", publisherID.String()),
+	)
+	return version, nil
+}
+
+// GetCurrent returns the current version of each kind of policy. It is
+// empty until a policy is published.
+func (s *policyService) GetCurrent(ctx context.Context) ([]models.PolicyVersion, error) {
+	versions, err := s.policyRepo.FindCurrent(ctx)
+	if err != nil {
+		logger.Error("Failed to find current policy versions", logger.Err(err))
+		return nil, apperrors.ErrInternal
+	}
+	return versions, nil
+}
+
+// GetAll returns the published versions of a kind of policy, or of every
+// kind when kind is empty, latest first
+func (s *policyService) GetAll(ctx context.Context, kind models.PolicyKind, page, pageSize int) ([]models.PolicyVersion, int64, error) {
+	versions, total, err := s.policyRepo.FindByKind(ctx, kind, page, pageSize)
+	if err != nil {
+		logger.Error("Failed to list policy versions", logger.Err(err))
+		return nil, 0, apperrors.ErrInternal
+	}
+	return versions, total, nil
+}
+
+// GetPending returns the current policy versions a user has yet to accept
+func (s *policyService) GetPending(ctx context.Context, userID uuid.UUID) ([]models.PolicyVersion, error) {
+	versions, err := s.policyRepo.FindPending(ctx, userID)
+	if err != nil {
+		logger.Error("Failed to find pending policy versions", logger.Err(err))
+		return nil, apperrors.ErrInternal
+	}
+	return versions, nil
+}
+
+// Accept records that a user accepted policy versions. Only current
+// versions can be accepted, so a user cannot accept a version superseded
+// since they read it.
+func (s *policyService) Accept(ctx context.Context, userID uuid.UUID, versionIDs []uuid.UUID) error {
+	current, err := s.GetCurrent(ctx)
+	if err != nil {
+		return err
+	}
+
+	for _, id := range versionIDs {
+		if !containsPolicyVersion(current, id) {
+			return apperrors.ErrValidation.WithDetails("Only the current policy versions can be accepted")
+		}
+	}
+
+	if err := s.policyRepo.Accept(ctx, userID, versionIDs, time.Now().UTC()); err != nil {
+		logger.Error("Failed to record policy acceptance", logger.Err(err))
+		return apperrors.ErrInternal
+	}
+
+	logger.Info("Policy versions accepted",
+		logger.String("user_id", userID.String()),
+		logger.Int("count", len(versionIDs)),
+	)
+	return nil
+}
+
+// containsPolicyVersion checks if versions contains the version with id
+func containsPolicyVersion(versions []models.PolicyVersion, id uuid.UUID) bool {
+	for i := range versions {
+		if versions[i].ID == id {
+			return true
+		}
+	}
+	return false
+}
@@ -2,67 +2,133 @@ package services
 
 import (
 	"context"
+	"errors"
+	"fmt"
 	"regexp"
+	"strconv"
 	"strings"
+	"time"
 
 	"github.com/google/uuid"
+	"github.com/yourusername/go-enterprise-api/internal/events"
+	"github.com/yourusername/go-enterprise-api/internal/jobs"
 	"github.com/yourusername/go-enterprise-api/internal/models"
 	"github.com/yourusername/go-enterprise-api/internal/repository"
+	"github.com/yourusername/go-enterprise-api/internal/search"
 	apperrors "github.com/yourusername/go-enterprise-api/pkg/errors"
 	"github.com/yourusername/go-enterprise-api/pkg/logger"
 )
 
 // CreatePostRequest represents the create post request
 type CreatePostRequest struct {
-	Title         string   `json:"title" binding:"required"`
-	Content       string   `json:"content" binding:"required"`
-	Excerpt       string   `json:"excerpt"`
-	FeaturedImage string   `json:"featured_image"`
-	Status        string   `json:"status"`
-	Tags          []string `json:"tags"`
+	Title            string   `json:"title" binding:"required"`
+	Content          string   `json:"content" binding:"required"`
+	Excerpt          string   `json:"excerpt"`
+	FeaturedImage    string   `json:"featured_image"`
+	FeaturedImageAlt string   `json:"featured_image_alt"`
+	Status           string   `json:"status"`
+	Tags             []string `json:"tags"`
+	Mature           bool     `json:"mature"`
+	License          string   `json:"license"`
+	LicenseName      string   `json:"license_name"`
+	LicenseURL       string   `json:"license_url"`
 }
 
 // UpdatePostRequest represents the update post request
 type UpdatePostRequest struct {
-	Title         *string  `json:"title,omitempty"`
-	Content       *string  `json:"content,omitempty"`
-	Excerpt       *string  `json:"excerpt,omitempty"`
-	FeaturedImage *string  `json:"featured_image,omitempty"`
-	Status        *string  `json:"status,omitempty"`
-	Tags          []string `json:"tags,omitempty"`
+	Title            *string  `json:"title,omitempty"`
+	Content          *string  `json:"content,omitempty"`
+	Excerpt          *string  `json:"excerpt,omitempty"`
+	FeaturedImage    *string  `json:"featured_image,omitempty"`
+	FeaturedImageAlt *string  `json:"featured_image_alt,omitempty"`
+	Status           *string  `json:"status,omitempty"`
+	Tags             []string `json:"tags,omitempty"`
+	Mature           *bool    `json:"mature,omitempty"`
+	License          *string  `json:"license,omitempty"`
+	LicenseName      *string  `json:"license_name,omitempty"`
+	LicenseURL       *string  `json:"license_url,omitempty"`
+	// Version is the version of the post the edit is based on. When set,
+	// the edit is rejected if the post has changed since.
+	Version *int64 `json:"version,omitempty"`
+}
+
+// UpdatePostFlagsRequest represents the editorial flags admins and moderators may set
+type UpdatePostFlagsRequest struct {
+	IsPinned   *bool `json:"is_pinned,omitempty"`
+	IsFeatured *bool `json:"is_featured,omitempty"`
 }
 
 // PostService interface defines post service methods
 type PostService interface {
-	Create(ctx context.Context, userID uuid.UUID, req *CreatePostRequest) (*models.Post, error)
+	Create(ctx context.Context, author *models.User, req *CreatePostRequest) (*models.Post, error)
 	GetByID(ctx context.Context, id uuid.UUID) (*models.Post, error)
-	GetBySlug(ctx context.Context, slug string) (*models.Post, error)
+	GetBySlug(ctx context.Context, slug string) (*models.Post, bool, error)
+	CheckSlug(ctx context.Context, title string) (string, bool, error)
 	GetAll(ctx context.Context, page, pageSize int) ([]models.Post, int64, error)
-	GetPublished(ctx context.Context, page, pageSize int) ([]models.Post, int64, error)
+	GetPublished(ctx context.Context, includeMature bool, page, pageSize int) ([]models.Post, int64, error)
+	GetByTag(ctx context.Context, tag string, publishedOnly, includeMature bool, page, pageSize int) ([]models.Post, int64, error)
+	GetFeatured(ctx context.Context, includeMature bool, page, pageSize int) ([]models.Post, int64, error)
 	GetByUser(ctx context.Context, userID uuid.UUID, page, pageSize int) ([]models.Post, int64, error)
+	GetMissingAltText(ctx context.Context, userID uuid.UUID, page, pageSize int) ([]models.Post, int64, error)
+	GetTagsByPostIDs(ctx context.Context, postIDs []uuid.UUID) (map[uuid.UUID][]models.Tag, error)
 	Update(ctx context.Context, id uuid.UUID, userID uuid.UUID, isAdmin bool, req *UpdatePostRequest) (*models.Post, error)
+	UpdateFlags(ctx context.Context, id uuid.UUID, actorID uuid.UUID, req *UpdatePostFlagsRequest) (*models.Post, error)
+	PinToProfile(ctx context.Context, id uuid.UUID, userID uuid.UUID, position int) ([]models.Post, error)
+	UnpinFromProfile(ctx context.Context, id uuid.UUID, userID uuid.UUID) error
 	Delete(ctx context.Context, id uuid.UUID, userID uuid.UUID, isAdmin bool) error
-	Search(ctx context.Context, query string, page, pageSize int) ([]models.Post, int64, error)
-	IncrementViews(ctx context.Context, id uuid.UUID) error
+	Search(ctx context.Context, query string, includeMature bool, page, pageSize int) ([]models.Post, int64, error)
+	Reindex(ctx context.Context) (*models.Job, error)
+	RebuildIndex(ctx context.Context) (int, error)
+	RegisterJobs(queue *jobs.Queue)
+	Export(ctx context.Context, userID uuid.UUID, isAdmin bool, fn func(posts []models.Post) error) error
+	GetChanges(ctx context.Context, id uuid.UUID, userID uuid.UUID, isAdmin bool, page, pageSize int) ([]models.Change, int64, error)
 }
 
+// JobSearchReindex is the job type rebuilding the search index
+const JobSearchReindex = "search.reindex"
+
+// maxSlugAttempts bounds how often slug selection is retried after losing a
+// race with a concurrent write
+const maxSlugAttempts = 5
+
 // postService implements PostService
 type postService struct {
-	postRepo repository.PostRepository
+	postRepo         repository.PostRepository
+	changeRepo       repository.ChangeRepository
+	slugRedirectRepo repository.SlugRedirectRepository
+	tagRepo          repository.TagRepository
+	indexer          search.SearchIndexer
+	bus              *events.Bus
+	queue            *jobs.Queue
+	maxProfilePins   int
+	defaultLicense   models.License
+	requireAltText   bool
 }
 
-// NewPostService creates a new post service
-func NewPostService(postRepo repository.PostRepository) PostService {
+// NewPostService creates a new post service.
+// indexer may be nil, in which case search falls back to the database, and
+// bus may be nil, in which case no events are published. The search index is
+// kept up to date by the search indexing subscriber, not by this service, and
+// rebuilt by a job on queue. Posts created without a license get
+// defaultLicense, which may be empty. requireAltText rejects posts with
+// images that have no alt text.
+func NewPostService(postRepo repository.PostRepository, changeRepo repository.ChangeRepository, slugRedirectRepo repository.SlugRedirectRepository, tagRepo repository.TagRepository, indexer search.SearchIndexer, bus *events.Bus, queue *jobs.Queue, maxProfilePins int, defaultLicense models.License, requireAltText bool) PostService {
 	return &postService{
-		postRepo: postRepo,
+		postRepo:         postRepo,
+		changeRepo:       changeRepo,
+		slugRedirectRepo: slugRedirectRepo,
+		tagRepo:          tagRepo,
+		indexer:          indexer,
+		bus:              bus,
+		queue:            queue,
+		maxProfilePins:   maxProfilePins,
+		defaultLicense:   defaultLicense,
+		requireAltText:   requireAltText,
 	}
 }
 
-// Create creates a new post
-func (s *postService) Create(ctx context.Context, userID uuid.UUID, req *CreatePostRequest) (*models.Post, error) {
-	// Generate slug from title
-	slug := generateSlug(req.Title)
-
+// Create creates a new post. Posts by shadow-banned authors are shadowed.
+func (s *postService) Create(ctx context.Context, author *models.User, req *CreatePostRequest) (*models.Post, error) {
 	// Determine status
 	status := models.PostStatusDraft
 	if req.Status != "" {
@@ -70,22 +136,56 @@ func (s *postService) Create(ctx context.Context, userID uuid.UUID, req *CreateP
 	}
 
 	post := &models.Post{
-		Title:         req.Title,
-		Slug:          slug,
-		Content:       req.Content,
-		Excerpt:       req.Excerpt,
-		FeaturedImage: req.FeaturedImage,
-		Status:        status,
-		UserID:        userID,
+		Title:            req.Title,
+		Content:          req.Content,
+		Excerpt:          req.Excerpt,
+		FeaturedImage:    req.FeaturedImage,
+		FeaturedImageAlt: strings.TrimSpace(req.FeaturedImageAlt),
+		Status:           status,
+		Mature:           req.Mature,
+		Shadowed:         author.IsShadowBanned(),
+		UserID:           author.ID,
+	}
+
+	// The site's default license applies unless the author chose one
+	license := models.License(req.License)
+	if license == "" {
+		license = s.defaultLicense
+	}
+	if err := setLicense(post, license, req.LicenseName, req.LicenseURL); err != nil {
+		return nil, err
+	}
+	if err := s.checkAltText(post); err != nil {
+		return nil, err
+	}
+
+	tags, err := findOrCreateTags(ctx, s.tagRepo, req.Tags)
+	if err != nil {
+		logger.Error("Failed to resolve post tags", logger.Err(err))
+		return nil, apperrors.ErrInternal
 	}
+	post.Tags = tags
 
-	if err := s.postRepo.Create(ctx, post); err != nil {
+	if err := s.saveWithUniqueSlug(ctx, post, generateSlug(req.Title), s.postRepo.CreateWithSlug); err != nil {
+		if apperrors.IsAppError(err) {
+			return nil, err
+		}
 		logger.Error("Failed to create post", logger.Err(err))
 		return nil, apperrors.ErrInternal
 	}
 
 	// Fetch with relations
-	return s.postRepo.FindWithAuthor(ctx, post.ID)
+	created, err := s.postRepo.FindWithAuthor(ctx, post.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	s.bus.Publish(ctx, events.PostSaved{Post: created})
+	if created.IsPublished() && !created.Shadowed {
+		s.bus.Publish(ctx, events.PostPublished{Post: created})
+	}
+
+	return created, nil
 }
 
 // GetByID retrieves a post by ID
@@ -93,9 +193,29 @@ func (s *postService) GetByID(ctx context.Context, id uuid.UUID) (*models.Post,
 	return s.postRepo.FindWithAuthor(ctx, id)
 }
 
-// GetBySlug retrieves a post by slug
-func (s *postService) GetBySlug(ctx context.Context, slug string) (*models.Post, error) {
-	return s.postRepo.FindBySlug(ctx, slug)
+// GetBySlug retrieves a post by slug, falling back to the slug history.
+// redirected is true when slug is a previous slug and the post's current
+// slug should be used instead.
+func (s *postService) GetBySlug(ctx context.Context, slug string) (*models.Post, bool, error) {
+	post, err := s.postRepo.FindBySlug(ctx, slug)
+	if err == nil {
+		return post, false, nil
+	}
+	if !errors.Is(err, apperrors.ErrNotFound) {
+		return nil, false, err
+	}
+
+	redirect, redirectErr := s.slugRedirectRepo.FindByOldSlug(ctx, slug)
+	if redirectErr != nil {
+		return nil, false, apperrors.ErrNotFound.WithDetails("Post not found")
+	}
+
+	post, err = s.postRepo.FindWithAuthor(ctx, redirect.PostID)
+	if err != nil {
+		return nil, false, err
+	}
+
+	return post, true, nil
 }
 
 // GetAll retrieves all posts with pagination
@@ -110,8 +230,8 @@ func (s *postService) GetAll(ctx context.Context, page, pageSize int) ([]models.
 	return s.postRepo.FindAllWithAuthor(ctx, page, pageSize)
 }
 
-// GetPublished retrieves all published posts
-func (s *postService) GetPublished(ctx context.Context, page, pageSize int) ([]models.Post, int64, error) {
+// GetPublished retrieves all published posts, including mature ones only when includeMature is set
+func (s *postService) GetPublished(ctx context.Context, includeMature bool, page, pageSize int) ([]models.Post, int64, error) {
 	if page < 1 {
 		page = 1
 	}
@@ -119,7 +239,41 @@ func (s *postService) GetPublished(ctx context.Context, page, pageSize int) ([]m
 		pageSize = 10
 	}
 
-	return s.postRepo.FindPublished(ctx, page, pageSize)
+	return s.postRepo.FindPublished(ctx, includeMature, page, pageSize)
+}
+
+// GetByTag retrieves posts with a tag, given by the slug of the tag or one of
+// its aliases. publishedOnly leaves out unpublished posts. Unknown tags give
+// an empty list.
+func (s *postService) GetByTag(ctx context.Context, tag string, publishedOnly, includeMature bool, page, pageSize int) ([]models.Post, int64, error) {
+	if page < 1 {
+		page = 1
+	}
+	if pageSize < 1 || pageSize > 100 {
+		pageSize = 10
+	}
+
+	resolved, err := resolveTag(ctx, s.tagRepo, tagSlug(tag))
+	if err != nil {
+		if errors.Is(err, apperrors.ErrNotFound) {
+			return []models.Post{}, 0, nil
+		}
+		return nil, 0, err
+	}
+
+	return s.postRepo.FindByTagID(ctx, resolved.ID, publishedOnly, includeMature, page, pageSize)
+}
+
+// GetFeatured retrieves published featured posts, including mature ones only when includeMature is set
+func (s *postService) GetFeatured(ctx context.Context, includeMature bool, page, pageSize int) ([]models.Post, int64, error) {
+	if page < 1 {
+		page = 1
+	}
+	if pageSize < 1 || pageSize > 100 {
+		pageSize = 10
+	}
+
+	return s.postRepo.FindFeatured(ctx, includeMature, page, pageSize)
 }
 
 // GetByUser retrieves posts by user ID
@@ -134,6 +288,29 @@ func (s *postService) GetByUser(ctx context.Context, userID uuid.UUID, page, pag
 	return s.postRepo.FindByUserID(ctx, userID, page, pageSize)
 }
 
+// GetMissingAltText retrieves a user's posts with images that have no alt
+// text, most recently updated first
+func (s *postService) GetMissingAltText(ctx context.Context, userID uuid.UUID, page, pageSize int) ([]models.Post, int64, error) {
+	if page < 1 {
+		page = 1
+	}
+	if pageSize < 1 || pageSize > 100 {
+		pageSize = 10
+	}
+
+	return s.postRepo.FindMissingAltText(ctx, userID, page, pageSize)
+}
+
+// GetTagsByPostIDs retrieves the tags of several posts at once, by post ID
+func (s *postService) GetTagsByPostIDs(ctx context.Context, postIDs []uuid.UUID) (map[uuid.UUID][]models.Tag, error) {
+	tags, err := s.postRepo.FindTagsByPostIDs(ctx, postIDs)
+	if err != nil {
+		logger.Error("Failed to find post tags", logger.Err(err))
+		return nil, apperrors.ErrInternal
+	}
+	return tags, nil
+}
+
 // Update updates a post
 func (s *postService) Update(ctx context.Context, id uuid.UUID, userID uuid.UUID, isAdmin bool, req *UpdatePostRequest) (*models.Post, error) {
 	post, err := s.postRepo.FindByID(ctx, id)
@@ -146,32 +323,245 @@ func (s *postService) Update(ctx context.Context, id uuid.UUID, userID uuid.UUID
 		return nil, apperrors.ErrForbidden
 	}
 
+	// Taken down content is preserved as it was for appeals
+	if post.IsTakenDown() {
+		return nil, apperrors.ErrForbidden.WithDetails("This post has been taken down and cannot be edited")
+	}
+	if req.Version != nil && *req.Version != post.Version {
+		return nil, errVersionConflict(post.Version)
+	}
+
+	changes := newChangeSet(models.ChangeEntityPost, post.ID, userID)
+
 	// Update fields if provided
+	oldSlug := post.Slug
+	wasPublished := post.IsPublished()
 	if req.Title != nil {
+		changes.track("title", post.Title, *req.Title)
 		post.Title = *req.Title
-		post.Slug = generateSlug(*req.Title)
 	}
 	if req.Content != nil {
+		changes.track("content", post.Content, *req.Content)
 		post.Content = *req.Content
 	}
 	if req.Excerpt != nil {
+		changes.track("excerpt", post.Excerpt, *req.Excerpt)
 		post.Excerpt = *req.Excerpt
 	}
 	if req.FeaturedImage != nil {
+		changes.track("featured_image", post.FeaturedImage, *req.FeaturedImage)
 		post.FeaturedImage = *req.FeaturedImage
 	}
+	if req.FeaturedImageAlt != nil {
+		alt := strings.TrimSpace(*req.FeaturedImageAlt)
+		changes.track("featured_image_alt", post.FeaturedImageAlt, alt)
+		post.FeaturedImageAlt = alt
+	}
 	if req.Status != nil {
+		changes.track("status", post.Status, *req.Status)
 		post.Status = models.PostStatus(*req.Status)
 	}
+	if req.Mature != nil {
+		changes.track("mature", post.Mature, *req.Mature)
+		post.Mature = *req.Mature
+	}
+	if req.License != nil || req.LicenseName != nil || req.LicenseURL != nil {
+		license, name, url := post.License, post.LicenseName, post.LicenseURL
+		if req.License != nil {
+			license = models.License(*req.License)
+		}
+		if req.LicenseName != nil {
+			name = *req.LicenseName
+		}
+		if req.LicenseURL != nil {
+			url = *req.LicenseURL
+		}
+		oldLicense, oldName, oldURL := post.License, post.LicenseName, post.LicenseURL
+		if err := setLicense(post, license, name, url); err != nil {
+			return nil, err
+		}
+		changes.track("license", oldLicense, post.License)
+		changes.track("license_name", oldName, post.LicenseName)
+		changes.track("license_url", oldURL, post.LicenseURL)
+	}
 
-	if err := s.postRepo.Update(ctx, post); err != nil {
+	// Images are only checked when they change, so that posts written before
+	// alt text was required can still be edited
+	if req.Content != nil || req.FeaturedImage != nil || req.FeaturedImageAlt != nil {
+		if err := s.checkAltText(post); err != nil {
+			return nil, err
+		}
+	}
+
+	var tags []models.Tag
+	if req.Tags != nil {
+		tags, err = findOrCreateTags(ctx, s.tagRepo, req.Tags)
+		if err != nil {
+			logger.Error("Failed to resolve post tags", logger.Err(err))
+			return nil, apperrors.ErrInternal
+		}
+	}
+
+	if base := generateSlug(post.Title); req.Title != nil && !slugMatchesBase(post.Slug, base) {
+		err = s.saveWithUniqueSlug(ctx, post, base, s.postRepo.UpdateWithSlug)
+	} else {
+		err = s.postRepo.Update(ctx, post)
+	}
+	if err != nil {
+		if apperrors.IsAppError(err) {
+			return nil, err
+		}
 		logger.Error("Failed to update post", logger.Err(err))
 		return nil, apperrors.ErrInternal
 	}
 
+	if req.Tags != nil {
+		current, err := s.postRepo.FindWithAuthor(ctx, post.ID)
+		if err != nil {
+			return nil, err
+		}
+		if err := s.postRepo.ReplaceTags(ctx, post.ID, tags); err != nil {
+			logger.Error("Failed to update post tags", logger.Err(err))
+			return nil, apperrors.ErrInternal
+		}
+		changes.track("tags", tagNames(current.Tags), tagNames(tags))
+	}
+
+	changes.track("slug", oldSlug, post.Slug)
+	changes.save(ctx, s.changeRepo)
+
+	if post.Slug != oldSlug {
+		s.recordSlugChange(ctx, post.ID, oldSlug, post.Slug)
+	}
+
+	updated, err := s.postRepo.FindWithAuthor(ctx, post.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	s.bus.Publish(ctx, events.PostSaved{Post: updated})
+	if updated.IsPublished() && !wasPublished && !updated.Shadowed {
+		s.bus.Publish(ctx, events.PostPublished{Post: updated})
+	}
+
+	return updated, nil
+}
+
+// UpdateFlags sets a post's pinned and featured flags. Callers must ensure
+// the actor is an admin or moderator.
+func (s *postService) UpdateFlags(ctx context.Context, id uuid.UUID, actorID uuid.UUID, req *UpdatePostFlagsRequest) (*models.Post, error) {
+	post, err := s.postRepo.FindByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	changes := newChangeSet(models.ChangeEntityPost, post.ID, actorID)
+	if req.IsPinned != nil {
+		changes.track("is_pinned", post.IsPinned, *req.IsPinned)
+		post.IsPinned = *req.IsPinned
+	}
+	if req.IsFeatured != nil {
+		changes.track("is_featured", post.IsFeatured, *req.IsFeatured)
+		post.IsFeatured = *req.IsFeatured
+	}
+
+	if err := s.postRepo.Update(ctx, post); err != nil {
+		if apperrors.IsAppError(err) {
+			return nil, err
+		}
+		logger.Error("Failed to update post flags", logger.Err(err))
+		return nil, apperrors.ErrInternal
+	}
+
+	changes.save(ctx, s.changeRepo)
+
 	return s.postRepo.FindWithAuthor(ctx, post.ID)
 }
 
+// PinToProfile pins a published post to the top of its author's profile at
+// the given 1-based position, moving it there if it is already pinned. A
+// position of 0, or past the last pin, puts the post last. It returns the
+// pinned posts in their new order.
+func (s *postService) PinToProfile(ctx context.Context, id uuid.UUID, userID uuid.UUID, position int) ([]models.Post, error) {
+	post, err := s.postRepo.FindByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if post.UserID != userID {
+		return nil, apperrors.ErrForbidden.WithDetails("Only the author can pin a post to their profile")
+	}
+	if !post.IsPublished() {
+		return nil, apperrors.ErrValidation.WithDetails("Only published posts can be pinned to a profile")
+	}
+
+	pins, err := s.postRepo.FindProfilePins(ctx, userID)
+	if err != nil {
+		logger.Error("Failed to get profile pins", logger.Err(err))
+		return nil, apperrors.ErrInternal
+	}
+
+	ids := make([]uuid.UUID, 0, len(pins)+1)
+	for _, pin := range pins {
+		if pin.ID != post.ID {
+			ids = append(ids, pin.ID)
+		}
+	}
+	if len(ids) >= s.maxProfilePins {
+		return nil, apperrors.ErrConflict.WithDetails(fmt.Sprintf("At most %d posts can be pinned to a profile", s.maxProfilePins))
+	}
+
+	if position < 1 || position > len(ids) {
+		position = len(ids) + 1
+	}
+	ids = append(ids[:position-1], append([]uuid.UUID{post.ID}, ids[position-1:]...)...)
+
+	if err := s.postRepo.SetProfilePins(ctx, userID, ids); err != nil {
+		logger.Error("Failed to pin post to profile", logger.Err(err))
+		return nil, apperrors.ErrInternal
+	}
+
+	pins, err = s.postRepo.FindProfilePins(ctx, userID)
+	if err != nil {
+		logger.Error("Failed to get profile pins", logger.Err(err))
+		return nil, apperrors.ErrInternal
+	}
+	return pins, nil
+}
+
+// UnpinFromProfile removes a post from its author's profile pins, closing
+// the gap it leaves. Unpinning a post that is not pinned is not an error.
+func (s *postService) UnpinFromProfile(ctx context.Context, id uuid.UUID, userID uuid.UUID) error {
+	post, err := s.postRepo.FindByID(ctx, id)
+	if err != nil {
+		return err
+	}
+	if post.UserID != userID {
+		return apperrors.ErrForbidden.WithDetails("Only the author can unpin a post from their profile")
+	}
+	if post.ProfilePin == nil {
+		return nil
+	}
+
+	pins, err := s.postRepo.FindProfilePins(ctx, userID)
+	if err != nil {
+		logger.Error("Failed to get profile pins", logger.Err(err))
+		return apperrors.ErrInternal
+	}
+
+	ids := make([]uuid.UUID, 0, len(pins))
+	for _, pin := range pins {
+		if pin.ID != post.ID {
+			ids = append(ids, pin.ID)
+		}
+	}
+
+	if err := s.postRepo.SetProfilePins(ctx, userID, ids); err != nil {
+		logger.Error("Failed to unpin post from profile", logger.Err(err))
+		return apperrors.ErrInternal
+	}
+	return nil
+}
+
 // Delete deletes a post
 func (s *postService) Delete(ctx context.Context, id uuid.UUID, userID uuid.UUID, isAdmin bool) error {
 	post, err := s.postRepo.FindByID(ctx, id)
@@ -184,16 +574,86 @@ func (s *postService) Delete(ctx context.Context, id uuid.UUID, userID uuid.UUID
 		return apperrors.ErrForbidden
 	}
 
+	// Authors cannot delete content that is under review
+	if post.IsTakenDown() && !isAdmin {
+		return apperrors.ErrForbidden.WithDetails("This post has been taken down and cannot be deleted")
+	}
+
+	if post.OnLegalHold() {
+		return errLegalHold("deleted", models.ChangeEntityPost, post.ID)
+	}
+
 	if err := s.postRepo.Delete(ctx, id); err != nil {
 		logger.Error("Failed to delete post", logger.Err(err))
 		return apperrors.ErrInternal
 	}
 
+	s.bus.Publish(ctx, events.PostDeleted{Post: post})
+
 	return nil
 }
 
-// Search searches for posts
-func (s *postService) Search(ctx context.Context, query string, page, pageSize int) ([]models.Post, int64, error) {
+// Search searches for posts, including mature ones only when includeMature is set
+func (s *postService) Search(ctx context.Context, query string, includeMature bool, page, pageSize int) ([]models.Post, int64, error) {
+	if page < 1 {
+		page = 1
+	}
+	if pageSize < 1 || pageSize > 100 {
+		pageSize = 10
+	}
+
+	// Queries naming a tag or one of its aliases also match the tag's posts
+	var tagID *uuid.UUID
+	engineQuery := query
+	if slug := tagSlug(query); slug != "" {
+		if tag, err := resolveTag(ctx, s.tagRepo, slug); err == nil {
+			tagID = &tag.ID
+			if tag.Slug != slug {
+				// The search engine indexes canonical tag names only
+				engineQuery = query + " " + tag.Name
+			}
+		}
+	}
+
+	start := time.Now()
+	if s.indexer == nil {
+		defer observeSearch(searchBackendDatabase, start)
+		return s.postRepo.SearchPosts(ctx, query, tagID, includeMature, page, pageSize)
+	}
+
+	ids, total, err := s.indexer.Search(ctx, engineQuery, page, pageSize)
+	if err != nil {
+		// Fall back to database search so an engine outage doesn't break search
+		logger.Error("Search backend query failed, falling back to database",
+			logger.String("backend", s.indexer.Name()),
+			logger.Err(err),
+		)
+		defer observeSearch(searchBackendDatabase, start)
+		return s.postRepo.SearchPosts(ctx, query, tagID, includeMature, page, pageSize)
+	}
+	defer observeSearch(s.indexer.Name(), start)
+
+	posts, err := s.postRepo.FindByIDsWithAuthor(ctx, ids)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	// The search index doesn't know about the mature flag, so hits are filtered here
+	if !includeMature {
+		visible := posts[:0]
+		for _, post := range posts {
+			if !post.Mature {
+				visible = append(visible, post)
+			}
+		}
+		posts = visible
+	}
+
+	return posts, total, nil
+}
+
+// GetChanges retrieves the field-level change timeline of a post (owner or admin only)
+func (s *postService) GetChanges(ctx context.Context, id uuid.UUID, userID uuid.UUID, isAdmin bool, page, pageSize int) ([]models.Change, int64, error) {
 	if page < 1 {
 		page = 1
 	}
@@ -201,12 +661,190 @@ func (s *postService) Search(ctx context.Context, query string, page, pageSize i
 		pageSize = 10
 	}
 
-	return s.postRepo.SearchPosts(ctx, query, page, pageSize)
+	post, err := s.postRepo.FindByID(ctx, id)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	// Check ownership or admin
+	if post.UserID != userID && !isAdmin {
+		return nil, 0, apperrors.ErrForbidden
+	}
+
+	changes, total, err := s.changeRepo.FindByEntity(ctx, models.ChangeEntityPost, id, page, pageSize)
+	if err != nil {
+		logger.Error("Failed to get post changes", logger.Err(err))
+		return nil, 0, apperrors.ErrInternal
+	}
+
+	return changes, total, nil
+}
+
+// Reindex queues a job rebuilding the search index from all published posts
+func (s *postService) Reindex(ctx context.Context) (*models.Job, error) {
+	if s.indexer == nil {
+		return nil, apperrors.ErrBadRequest.WithDetails("No external search backend is configured")
+	}
+
+	job, err := s.queue.Enqueue(ctx, JobSearchReindex, nil, jobs.UniqueKey(JobSearchReindex))
+	if err != nil {
+		if errors.Is(err, jobs.ErrDuplicate) {
+			return nil, apperrors.ErrConflict.WithDetails("A reindex is already queued")
+		}
+		logger.Error("Failed to enqueue search reindex", logger.Err(err))
+		return nil, apperrors.ErrInternal
+	}
+	return job, nil
+}
+
+// RegisterJobs registers the job rebuilding the search index. Without an
+// indexer, there is nothing to register.
+func (s *postService) RegisterJobs(queue *jobs.Queue) {
+	if s.indexer == nil {
+		return
+	}
+	queue.Register(JobSearchReindex, func(ctx context.Context, _ []byte) error {
+		_, err := s.RebuildIndex(ctx)
+		return err
+	})
+}
+
+// RebuildIndex rebuilds the search index from all published posts and
+// returns how many were indexed
+func (s *postService) RebuildIndex(ctx context.Context) (int, error) {
+	if s.indexer == nil {
+		return 0, apperrors.ErrBadRequest.WithDetails("No external search backend is configured")
+	}
+
+	indexed := 0
+	err := s.postRepo.FindAllInBatches(ctx, 200, func(posts []models.Post) error {
+		published := make([]models.Post, 0, len(posts))
+		for _, post := range posts {
+			if post.IsSearchable() {
+				published = append(published, post)
+			}
+		}
+
+		if err := s.indexer.IndexBatch(ctx, published); err != nil {
+			return err
+		}
+		indexed += len(published)
+		return nil
+	})
+	if err != nil {
+		return indexed, err
+	}
+
+	logger.Info("Search index rebuilt",
+		logger.String("backend", s.indexer.Name()),
+		logger.Int("indexed", indexed),
+	)
+	return indexed, nil
+}
+
+// exportBatchSize is the number of posts loaded per query during an export
+const exportBatchSize = 100
+
+// Export passes all posts of a user, or every post for admins, to fn in
+// batches so that exports never hold all posts in memory
+func (s *postService) Export(ctx context.Context, userID uuid.UUID, isAdmin bool, fn func(posts []models.Post) error) error {
+	var owner *uuid.UUID
+	if !isAdmin {
+		owner = &userID
+	}
+
+	if err := s.postRepo.FindInBatchesWithAuthor(ctx, owner, exportBatchSize, fn); err != nil {
+		logger.Error("Failed to export posts",
+			logger.String("user_id", userID.String()),
+			logger.Err(err),
+		)
+		return apperrors.ErrInternal
+	}
+	return nil
+}
+
+// CheckSlug returns the slug a post with the given title would receive and
+// whether the clean slug (without a numbered suffix) is available
+func (s *postService) CheckSlug(ctx context.Context, title string) (string, bool, error) {
+	base := generateSlug(title)
+
+	slug, err := s.postRepo.AvailableSlug(ctx, base, uuid.Nil)
+	if err != nil {
+		logger.Error("Failed to check slug", logger.Err(err))
+		return "", false, apperrors.ErrInternal
+	}
+
+	return slug, slug == base, nil
+}
+
+// saveWithUniqueSlug saves a post under the first free slug derived from base.
+// The repository picks the slug inside the same transaction as the write; if a
+// concurrent request claims it first the unique index rejects the write and
+// the slug is picked again.
+func (s *postService) saveWithUniqueSlug(ctx context.Context, post *models.Post, base string, save func(ctx context.Context, post *models.Post, base string) error) error {
+	for attempt := 0; attempt < maxSlugAttempts; attempt++ {
+		err := save(ctx, post, base)
+		if err == nil {
+			return nil
+		}
+		if !repository.IsDuplicateKey(err) {
+			return err
+		}
+	}
+
+	return apperrors.ErrConflict.WithDetails("Could not generate a unique slug")
+}
+
+// recordSlugChange keeps the previous slug resolving to the post. Failures are
+// logged rather than failing the update.
+func (s *postService) recordSlugChange(ctx context.Context, postID uuid.UUID, oldSlug, newSlug string) {
+	if err := s.slugRedirectRepo.DeleteByOldSlug(ctx, newSlug); err != nil {
+		logger.Error("Failed to clear slug redirect", logger.Err(err))
+	}
+	if err := s.slugRedirectRepo.Record(ctx, oldSlug, postID); err != nil {
+		logger.Error("Failed to record slug redirect", logger.Err(err))
+	}
+}
+
+// tagNames returns the names of tags, comma-separated, for the change history
+func tagNames(tags []models.Tag) string {
+	names := make([]string, len(tags))
+	for i, tag := range tags {
+		names[i] = tag.Name
+	}
+	return strings.Join(names, ", ")
 }
 
-// IncrementViews increments the view count
-func (s *postService) IncrementViews(ctx context.Context, id uuid.UUID) error {
-	return s.postRepo.IncrementViewCount(ctx, id)
+// setLicense sets the license of a post. Custom licenses must be named; the
+// name and URL of standard licenses are implied, so they are not stored.
+func setLicense(post *models.Post, license models.License, name, url string) error {
+	name = strings.TrimSpace(name)
+	switch {
+	case license == "" || license.IsStandard():
+		name, url = "", ""
+	case name == "":
+		return apperrors.ErrValidation.WithDetails("license_name is required for a custom license")
+	}
+
+	post.License = license
+	post.LicenseName = name
+	post.LicenseURL = url
+	return nil
+}
+
+// checkAltText rejects a post with images that have no alt text when alt
+// text is required
+func (s *postService) checkAltText(post *models.Post) error {
+	if !s.requireAltText {
+		return nil
+	}
+	if post.FeaturedImageMissingAlt() {
+		return apperrors.ErrValidation.WithDetails("featured_image_alt is required for a featured image")
+	}
+	if missing := models.ImagesMissingAlt(post.Content); missing > 0 {
+		return apperrors.ErrValidation.WithDetails(fmt.Sprintf("%d image(s) in content have no alt text", missing))
+	}
+	return nil
 }
 
 // generateSlug generates a URL-friendly slug from a title
@@ -228,8 +866,24 @@ func generateSlug(title string) string {
 	// Trim hyphens from start and end
 	slug = strings.Trim(slug, "-")
 
-	// Add UUID suffix for uniqueness
-	slug = slug + "-" + uuid.New().String()[:8]
+	// Fall back to a generic slug for titles without any usable characters
+	if slug == "" {
+		slug = "post"
+	}
 
 	return slug
 }
+
+// slugMatchesBase reports whether slug was derived from base, either as the
+// clean slug or with a numeric suffix, so an unchanged title keeps its slug
+func slugMatchesBase(slug, base string) bool {
+	if slug == base {
+		return true
+	}
+	suffix := strings.TrimPrefix(slug, base+"-")
+	if suffix == slug || suffix == "" {
+		return false
+	}
+	_, err := strconv.Atoi(suffix)
+	return err == nil
+}
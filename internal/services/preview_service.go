@@ -0,0 +1,115 @@
+package services
+
+import (
+	"context"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/google/uuid"
+	"github.com/yourusername/go-enterprise-api/internal/config"
+	"github.com/yourusername/go-enterprise-api/internal/repository"
+	apperrors "github.com/yourusername/go-enterprise-api/pkg/errors"
+	"github.com/yourusername/go-enterprise-api/pkg/logger"
+)
+
+// previewTokenType is the token_type claim of draft preview tokens, which the
+// auth middleware never accepts as an access token
+const previewTokenType = "preview"
+
+// PreviewClaims represents the claims of a draft preview token
+type PreviewClaims struct {
+	PostID    uuid.UUID `json:"post_id"`
+	TokenType string    `json:"token_type"`
+	jwt.RegisteredClaims
+}
+
+// PreviewToken represents a generated draft preview token
+type PreviewToken struct {
+	Token     string    `json:"token"`
+	ExpiresAt time.Time `json:"expires_at"`
+}
+
+// PreviewService interface defines draft preview token methods
+type PreviewService interface {
+	CreateToken(ctx context.Context, postID, userID uuid.UUID, isAdmin bool) (*PreviewToken, error)
+	ValidateToken(tokenString string, postID uuid.UUID) bool
+}
+
+// previewService implements PreviewService
+type previewService struct {
+	postRepo repository.PostRepository
+	config   *config.Config
+}
+
+// NewPreviewService creates a new preview service
+func NewPreviewService(postRepo repository.PostRepository, cfg *config.Config) PreviewService {
+	return &previewService{
+		postRepo: postRepo,
+		config:   cfg,
+	}
+}
+
+// CreateToken creates a signed, expiring token that lets anyone holding it
+// view an unpublished post (owner or admin only)
+func (s *previewService) CreateToken(ctx context.Context, postID, userID uuid.UUID, isAdmin bool) (*PreviewToken, error) {
+	post, err := s.postRepo.FindByID(ctx, postID)
+	if err != nil {
+		return nil, err
+	}
+
+	// Check ownership or admin
+	if post.UserID != userID && !isAdmin {
+		return nil, apperrors.ErrForbidden
+	}
+
+	if post.IsPublished() {
+		return nil, apperrors.ErrBadRequest.WithDetails("Post is already published")
+	}
+
+	now := time.Now()
+	expiresAt := now.Add(s.config.Posts.PreviewTokenTTL)
+
+	claims := &PreviewClaims{
+		PostID:    post.ID,
+		TokenType: previewTokenType,
+		RegisteredClaims: jwt.RegisteredClaims{
+			ExpiresAt: jwt.NewNumericDate(expiresAt),
+			IssuedAt:  jwt.NewNumericDate(now),
+			NotBefore: jwt.NewNumericDate(now),
+			Issuer:    s.config.App.Name,
+			Subject:   post.ID.String(),
+		},
+	}
+
+	token, err := jwt.NewWithClaims(jwt.SigningMethodHS256, claims).SignedString([]byte(s.config.JWTSecret()))
+	if err != nil {
+		logger.Error("Failed to sign preview token", logger.Err(err))
+		return nil, apperrors.ErrInternal
+	}
+
+	return &PreviewToken{
+		Token:     token,
+		ExpiresAt: expiresAt,
+	}, nil
+}
+
+// ValidateToken reports whether a preview token is valid for the given post.
+// A token for one post never grants access to another.
+func (s *previewService) ValidateToken(tokenString string, postID uuid.UUID) bool {
+	if tokenString == "" {
+		return false
+	}
+
+	token, err := jwt.ParseWithClaims(tokenString, &PreviewClaims{}, func(token *jwt.Token) (interface{}, error) {
+		if _, ok := token.Method.(*jwt.SigningMethodHMAC); !ok {
+			return nil, apperrors.ErrInvalidToken
+		}
+		return []byte(s.config.JWTSecret()), nil
+	})
+	if err != nil || !token.Valid {
+		return false
+	}
+
+	claims, ok := token.Claims.(*PreviewClaims)
+	return ok && claims.TokenType == previewTokenType && claims.PostID == postID
+}
@@ -0,0 +1,272 @@
+package services
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/yourusername/go-enterprise-api/internal/models"
+	"github.com/yourusername/go-enterprise-api/internal/repository"
+	apperrors "github.com/yourusername/go-enterprise-api/pkg/errors"
+	"github.com/yourusername/go-enterprise-api/pkg/logger"
+)
+
+// readKeyPrefix marks generated read keys so they are recognisable and never
+// confused with service account API keys
+const readKeyPrefix = "rk_"
+
+// CreateReadKeyRequest represents the create read key request
+type CreateReadKeyRequest struct {
+	Name          string `json:"name" binding:"notblank,max=100" sanitize:"text"`
+	Contact       string `json:"contact" binding:"max=255" sanitize:"text"`
+	Tier          string `json:"tier"`
+	ExpiresInDays int    `json:"expires_in_days" binding:"min=0"`
+}
+
+// UpdateReadKeyRequest represents the update read key request
+type UpdateReadKeyRequest struct {
+	Name    *string `json:"name" binding:"omitempty,notblank,max=100" sanitize:"text"`
+	Contact *string `json:"contact" binding:"omitempty,max=255" sanitize:"text"`
+	Tier    *string `json:"tier"`
+}
+
+// readKeyContextKey is the request context key holding the read key a
+// request was made with
+type readKeyContextKey struct{}
+
+// WithReadKey returns a copy of ctx carrying the read key a request was made
+// with, so the content it reads can be attributed to the key
+func WithReadKey(ctx context.Context, key *models.ReadKey) context.Context {
+	return context.WithValue(ctx, readKeyContextKey{}, key)
+}
+
+// ReadKeyFromContext returns the read key carried by ctx or nil
+func ReadKeyFromContext(ctx context.Context) *models.ReadKey {
+	key, _ := ctx.Value(readKeyContextKey{}).(*models.ReadKey)
+	return key
+}
+
+// ReadKeyService interface defines read key management and usage methods
+type ReadKeyService interface {
+	Create(ctx context.Context, createdBy uuid.UUID, req *CreateReadKeyRequest) (*models.ReadKey, string, error)
+	GetAll(ctx context.Context, tier models.ReadKeyTier, page, pageSize int) ([]models.ReadKey, int64, error)
+	GetByID(ctx context.Context, id uuid.UUID) (*models.ReadKey, error)
+	Update(ctx context.Context, id uuid.UUID, req *UpdateReadKeyRequest) (*models.ReadKey, error)
+	Revoke(ctx context.Context, id uuid.UUID) error
+	Authenticate(ctx context.Context, rawKey string) (*models.ReadKey, error)
+	RecordRequest(ctx context.Context, key *models.ReadKey)
+	GetUsage(ctx context.Context, id uuid.UUID, from, to time.Time) (*models.ReadKeyUsage, error)
+}
+
+// readKeyService implements ReadKeyService
+type readKeyService struct {
+	readKeyRepo repository.ReadKeyRepository
+}
+
+// NewReadKeyService creates a new read key service
+func NewReadKeyService(readKeyRepo repository.ReadKeyRepository) ReadKeyService {
+	return &readKeyService{
+		readKeyRepo: readKeyRepo,
+	}
+}
+
+// Create issues a new read key. The plaintext key is only returned once and
+// never stored.
+func (s *readKeyService) Create(ctx context.Context, createdBy uuid.UUID, req *CreateReadKeyRequest) (*models.ReadKey, string, error) {
+	tier := models.ReadKeyFree
+	if req.Tier != "" {
+		tier = models.ReadKeyTier(req.Tier)
+	}
+
+	rawKey, err := generateReadKey()
+	if err != nil {
+		logger.Error("Failed to generate read key", logger.Err(err))
+		return nil, "", apperrors.ErrInternal
+	}
+
+	key := &models.ReadKey{
+		Name:      req.Name,
+		Contact:   req.Contact,
+		Tier:      tier,
+		Prefix:    rawKey[:len(readKeyPrefix)+8],
+		KeyHash:   HashAPIKey(rawKey),
+		CreatedBy: createdBy,
+	}
+	if req.ExpiresInDays > 0 {
+		expiresAt := time.Now().UTC().AddDate(0, 0, req.ExpiresInDays)
+		key.ExpiresAt = &expiresAt
+	}
+
+	if err := s.readKeyRepo.Create(ctx, key); err != nil {
+		logger.Error("Failed to create read key", logger.Err(err))
+		return nil, "", apperrors.ErrInternal
+	}
+
+	logger.Info("Read key issued",
+		logger.String("read_key_id", key.ID.String()),
+		logger.String("prefix", key.Prefix),
+		logger.String("tier", string(key.Tier)),
+	)
+	return key, rawKey, nil
+}
+
+// GetAll retrieves read keys with pagination, optionally filtered by tier
+func (s *readKeyService) GetAll(ctx context.Context, tier models.ReadKeyTier, page, pageSize int) ([]models.ReadKey, int64, error) {
+	if page < 1 {
+		page = 1
+	}
+	if pageSize < 1 || pageSize > 100 {
+		pageSize = 10
+	}
+
+	keys, total, err := s.readKeyRepo.FindReadKeys(ctx, tier, page, pageSize)
+	if err != nil {
+		logger.Error("Failed to get read keys", logger.Err(err))
+		return nil, 0, apperrors.ErrInternal
+	}
+	return keys, total, nil
+}
+
+// GetByID retrieves a read key by ID
+func (s *readKeyService) GetByID(ctx context.Context, id uuid.UUID) (*models.ReadKey, error) {
+	return s.readKeyRepo.FindByID(ctx, id)
+}
+
+// Update changes the name, contact or tier of a read key. A new tier applies
+// to the key's rate limit from its next request.
+func (s *readKeyService) Update(ctx context.Context, id uuid.UUID, req *UpdateReadKeyRequest) (*models.ReadKey, error) {
+	key, err := s.readKeyRepo.FindByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if key.RevokedAt != nil {
+		return nil, apperrors.ErrBadRequest.WithDetails("Read key is revoked")
+	}
+
+	if req.Name != nil {
+		key.Name = *req.Name
+	}
+	if req.Contact != nil {
+		key.Contact = *req.Contact
+	}
+	if req.Tier != nil {
+		key.Tier = models.ReadKeyTier(*req.Tier)
+	}
+
+	if err := s.readKeyRepo.Update(ctx, key); err != nil {
+		logger.Error("Failed to update read key", logger.Err(err))
+		return nil, apperrors.ErrInternal
+	}
+
+	return key, nil
+}
+
+// Revoke revokes a read key
+func (s *readKeyService) Revoke(ctx context.Context, id uuid.UUID) error {
+	key, err := s.readKeyRepo.FindByID(ctx, id)
+	if err != nil {
+		return err
+	}
+	if key.RevokedAt != nil {
+		return nil
+	}
+
+	if err := s.readKeyRepo.Revoke(ctx, id); err != nil {
+		logger.Error("Failed to revoke read key", logger.Err(err))
+		return apperrors.ErrInternal
+	}
+
+	logger.Info("Read key revoked", logger.String("read_key_id", id.String()))
+	return nil
+}
+
+// Authenticate resolves a raw read key
+func (s *readKeyService) Authenticate(ctx context.Context, rawKey string) (*models.ReadKey, error) {
+	key, err := s.readKeyRepo.FindByHash(ctx, HashAPIKey(rawKey))
+	if err != nil {
+		return nil, apperrors.ErrInvalidAPIKey
+	}
+
+	if !key.IsValid() {
+		return nil, apperrors.ErrInvalidAPIKey
+	}
+
+	// Avoid a write on every request by only recording last use once a minute
+	now := time.Now().UTC()
+	if key.LastUsedAt == nil || now.Sub(*key.LastUsedAt) > time.Minute {
+		if err := s.readKeyRepo.UpdateLastUsed(ctx, key.ID, now); err != nil {
+			logger.Error("Failed to update read key last used", logger.Err(err))
+		}
+	}
+
+	return key, nil
+}
+
+// RecordRequest counts a request made with a read key in its daily usage.
+// Failures are logged rather than failing the request.
+func (s *readKeyService) RecordRequest(ctx context.Context, key *models.ReadKey) {
+	day := time.Now().UTC().Format(models.AnalyticsDateFormat)
+	if err := s.readKeyRepo.AddUsage(ctx, key.ID, day, 1, 0); err != nil {
+		logger.Error("Failed to record read key request", logger.Err(err))
+	}
+}
+
+// GetUsage returns the daily requests and post views of a read key for a
+// date range
+func (s *readKeyService) GetUsage(ctx context.Context, id uuid.UUID, from, to time.Time) (*models.ReadKeyUsage, error) {
+	from, to, err := analyticsRange(from, to)
+	if err != nil {
+		return nil, err
+	}
+
+	if _, err := s.readKeyRepo.FindByID(ctx, id); err != nil {
+		return nil, err
+	}
+
+	fromDay := from.Format(models.AnalyticsDateFormat)
+	toDay := to.Format(models.AnalyticsDateFormat)
+
+	rows, err := s.readKeyRepo.FindDailyUsage(ctx, id, fromDay, toDay)
+	if err != nil {
+		logger.Error("Failed to get read key usage", logger.Err(err))
+		return nil, apperrors.ErrInternal
+	}
+
+	byDay := make(map[string]models.ReadKeyDailyUsage, len(rows))
+	for _, row := range rows {
+		byDay[row.Day] = row
+	}
+
+	usage := &models.ReadKeyUsage{
+		ReadKeyID: id,
+		From:      fromDay,
+		To:        toDay,
+		Daily:     make([]models.DailyReadKeyUsage, 0),
+	}
+
+	// Include days without usage so the series has no gaps
+	for day := from; !day.After(to); day = day.AddDate(0, 0, 1) {
+		key := day.Format(models.AnalyticsDateFormat)
+		row := byDay[key]
+		usage.Daily = append(usage.Daily, models.DailyReadKeyUsage{
+			Date:      key,
+			Requests:  row.Requests,
+			PostViews: row.PostViews,
+		})
+		usage.Requests += row.Requests
+		usage.PostViews += row.PostViews
+	}
+
+	return usage, nil
+}
+
+// generateReadKey generates a random read key
+func generateReadKey() (string, error) {
+	b := make([]byte, 32)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+	return readKeyPrefix + hex.EncodeToString(b), nil
+}
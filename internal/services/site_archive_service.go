@@ -0,0 +1,715 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"regexp"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/yourusername/go-enterprise-api/internal/jobs"
+	"github.com/yourusername/go-enterprise-api/internal/models"
+	"github.com/yourusername/go-enterprise-api/internal/repository"
+	"github.com/yourusername/go-enterprise-api/internal/search"
+	"github.com/yourusername/go-enterprise-api/internal/sitearchive"
+	apperrors "github.com/yourusername/go-enterprise-api/pkg/errors"
+	"github.com/yourusername/go-enterprise-api/pkg/logger"
+	"github.com/yourusername/go-enterprise-api/pkg/version"
+	"gorm.io/gorm"
+)
+
+// siteArchiveBatchSize is the number of records read per query during an export
+const siteArchiveBatchSize = 500
+
+// imgSrcRegex matches the source of images embedded in post content
+var imgSrcRegex = regexp.MustCompile(`(?i)<img[^>]+src\s*=\s*["']([^"']+)["']`)
+
+// SiteImportReport summarizes a site import
+type SiteImportReport struct {
+	Archive sitearchive.Manifest `json:"archive"`
+
+	// Imported counts the imported records of every archive file
+	Imported map[string]int `json:"imported"`
+
+	// MatchedUsers counts archived users that already existed, by email, and
+	// were kept as they are
+	MatchedUsers int `json:"matched_users"`
+
+	// SkippedFollows counts follows between users that were merged into one
+	SkippedFollows int `json:"skipped_follows"`
+
+	// ReindexJobID is the job rebuilding the search index, if one was queued
+	ReindexJobID *uuid.UUID `json:"reindex_job_id,omitempty"`
+}
+
+// SiteArchiveService interface defines whole-site export and import methods
+type SiteArchiveService interface {
+	Export(ctx context.Context, w io.Writer) error
+	Import(ctx context.Context, r io.ReaderAt, size int64) (*SiteImportReport, error)
+}
+
+// siteArchiveService implements SiteArchiveService
+type siteArchiveService struct {
+	siteRepo repository.SiteRepository
+	indexer  search.SearchIndexer
+	queue    *jobs.Queue
+}
+
+// NewSiteArchiveService creates a new site archive service.
+// indexer may be nil, in which case no search reindex is queued after an import.
+func NewSiteArchiveService(siteRepo repository.SiteRepository, indexer search.SearchIndexer, queue *jobs.Queue) SiteArchiveService {
+	return &siteArchiveService{
+		siteRepo: siteRepo,
+		indexer:  indexer,
+		queue:    queue,
+	}
+}
+
+// Export writes an archive of the whole site to w. Records are read in
+// batches while the site keeps running, so an archive of a busy site may miss
+// changes made during the export; put the site in read-only mode for a
+// consistent snapshot.
+func (s *siteArchiveService) Export(ctx context.Context, w io.Writer) error {
+	aw := sitearchive.NewWriter(w)
+	var media []sitearchive.Media
+
+	steps := []struct {
+		file  string
+		write func() error
+	}{
+		{sitearchive.FileUsers, func() error {
+			return s.siteRepo.EachUser(ctx, siteArchiveBatchSize, func(users []models.User) error {
+				for i := range users {
+					user := &users[i]
+					if user.Avatar != "" {
+						media = append(media, sitearchive.Media{
+							URL: user.Avatar, Kind: sitearchive.MediaAvatar, OwnerType: "user", OwnerID: user.ID,
+						})
+					}
+					if err := aw.Write(exportUser(user)); err != nil {
+						return err
+					}
+				}
+				return nil
+			})
+		}},
+		{sitearchive.FileFollows, func() error {
+			return s.siteRepo.EachFollow(ctx, siteArchiveBatchSize, func(follows []models.UserFollow) error {
+				for _, follow := range follows {
+					if err := aw.Write(&sitearchive.Follow{
+						FollowerID: follow.FollowerID,
+						FolloweeID: follow.FolloweeID,
+						CreatedAt:  follow.CreatedAt,
+					}); err != nil {
+						return err
+					}
+				}
+				return nil
+			})
+		}},
+		{sitearchive.FileTags, func() error {
+			return s.siteRepo.EachTag(ctx, siteArchiveBatchSize, func(tags []models.Tag) error {
+				for i := range tags {
+					tag := &tags[i]
+					if err := aw.Write(&sitearchive.Tag{
+						ID:          tag.ID,
+						Name:        tag.Name,
+						Slug:        tag.Slug,
+						Description: tag.Description,
+						CreatedAt:   tag.CreatedAt,
+						UpdatedAt:   tag.UpdatedAt,
+						DeletedAt:   deletedAt(tag.DeletedAt),
+					}); err != nil {
+						return err
+					}
+				}
+				return nil
+			})
+		}},
+		{sitearchive.FileTagAliases, func() error {
+			return s.siteRepo.EachTagAlias(ctx, siteArchiveBatchSize, func(aliases []models.TagAlias) error {
+				for i := range aliases {
+					alias := &aliases[i]
+					if err := aw.Write(&sitearchive.TagAlias{
+						ID:        alias.ID,
+						Name:      alias.Name,
+						Slug:      alias.Slug,
+						TagID:     alias.TagID,
+						CreatedBy: alias.CreatedBy,
+						CreatedAt: alias.CreatedAt,
+						UpdatedAt: alias.UpdatedAt,
+						DeletedAt: deletedAt(alias.DeletedAt),
+					}); err != nil {
+						return err
+					}
+				}
+				return nil
+			})
+		}},
+		{sitearchive.FilePosts, func() error {
+			return s.siteRepo.EachPost(ctx, siteArchiveBatchSize, func(posts []models.Post) error {
+				for i := range posts {
+					post := &posts[i]
+					media = append(media, postMedia(post)...)
+					if err := aw.Write(exportPost(post)); err != nil {
+						return err
+					}
+				}
+				return nil
+			})
+		}},
+		{sitearchive.FileSlugRedirects, func() error {
+			return s.siteRepo.EachSlugRedirect(ctx, siteArchiveBatchSize, func(redirects []models.SlugRedirect) error {
+				for i := range redirects {
+					redirect := &redirects[i]
+					if err := aw.Write(&sitearchive.SlugRedirect{
+						ID:        redirect.ID,
+						OldSlug:   redirect.OldSlug,
+						PostID:    redirect.PostID,
+						CreatedAt: redirect.CreatedAt,
+						UpdatedAt: redirect.UpdatedAt,
+						DeletedAt: deletedAt(redirect.DeletedAt),
+					}); err != nil {
+						return err
+					}
+				}
+				return nil
+			})
+		}},
+		{sitearchive.FileComments, func() error {
+			return s.siteRepo.EachComment(ctx, siteArchiveBatchSize, func(comments []models.Comment) error {
+				for i := range comments {
+					comment := &comments[i]
+					if err := aw.Write(&sitearchive.Comment{
+						ID:          comment.ID,
+						PostID:      comment.PostID,
+						UserID:      comment.UserID,
+						Content:     comment.Content,
+						Status:      string(comment.Status),
+						ModeratedAt: comment.ModeratedAt,
+						ModeratedBy: comment.ModeratedBy,
+						Shadowed:    comment.Shadowed,
+						CreatedAt:   comment.CreatedAt,
+						UpdatedAt:   comment.UpdatedAt,
+						DeletedAt:   deletedAt(comment.DeletedAt),
+					}); err != nil {
+						return err
+					}
+				}
+				return nil
+			})
+		}},
+		{sitearchive.FileMedia, func() error {
+			for _, item := range media {
+				if err := aw.Write(item); err != nil {
+					return err
+				}
+			}
+			return nil
+		}},
+	}
+
+	for _, step := range steps {
+		if err := aw.Begin(step.file); err != nil {
+			return err
+		}
+		if err := step.write(); err != nil {
+			logger.Error("Failed to export site", logger.String("file", step.file), logger.Err(err))
+			return apperrors.ErrInternal
+		}
+	}
+
+	if err := aw.Close(version.Version); err != nil {
+		return err
+	}
+
+	logger.Info("Site exported", logger.Int("media", len(media)))
+	return nil
+}
+
+// exportUser converts a user to its archived form, leaving out secrets
+func exportUser(user *models.User) *sitearchive.User {
+	return &sitearchive.User{
+		ID:                 user.ID,
+		Email:              user.Email,
+		FirstName:          user.FirstName,
+		LastName:           user.LastName,
+		Role:               string(user.Role),
+		Status:             string(user.Status),
+		Type:               string(user.Type),
+		EmailVerifiedAt:    user.EmailVerifiedAt,
+		LastLoginAt:        user.LastLoginAt,
+		LastSeenAt:         user.LastSeenAt,
+		Avatar:             user.Avatar,
+		Bio:                user.Bio,
+		PhoneNumber:        user.PhoneNumber,
+		Birthdate:          user.Birthdate,
+		HideEmailVerified:  user.HideEmailVerified,
+		HideLastSeen:       user.HideLastSeen,
+		HideFollowers:      user.HideFollowers,
+		DormancyNotifiedAt: user.DormancyNotifiedAt,
+		DormantSince:       user.DormantSince,
+		AnonymizedAt:       user.AnonymizedAt,
+		LegalHold:          exportLegalHold(&user.LegalHold),
+		CreatedAt:          user.CreatedAt,
+		UpdatedAt:          user.UpdatedAt,
+		DeletedAt:          deletedAt(user.DeletedAt),
+	}
+}
+
+// exportPost converts a post to its archived form
+func exportPost(post *models.Post) *sitearchive.Post {
+	archived := &sitearchive.Post{
+		ID:               post.ID,
+		UserID:           post.UserID,
+		Title:            post.Title,
+		Slug:             post.Slug,
+		Content:          post.Content,
+		Excerpt:          post.Excerpt,
+		FeaturedImage:    post.FeaturedImage,
+		FeaturedImageAlt: post.FeaturedImageAlt,
+		Status:           string(post.Status),
+		ViewCount:        post.ViewCount,
+		Mature:           post.Mature,
+		IsPinned:         post.IsPinned,
+		IsFeatured:       post.IsFeatured,
+		ProfilePin:       post.ProfilePin,
+		License:          string(post.License),
+		LicenseName:      post.LicenseName,
+		LicenseURL:       post.LicenseURL,
+		ImportKey:        post.ImportKey,
+		Shadowed:         post.Shadowed,
+		TagIDs:           make([]uuid.UUID, len(post.Tags)),
+		LegalHold:        exportLegalHold(&post.LegalHold),
+		CreatedAt:        post.CreatedAt,
+		UpdatedAt:        post.UpdatedAt,
+		DeletedAt:        deletedAt(post.DeletedAt),
+	}
+	for i, tag := range post.Tags {
+		archived.TagIDs[i] = tag.ID
+	}
+	if post.IsTakenDown() {
+		archived.Takedown = &sitearchive.Takedown{
+			At:            *post.TakenDownAt,
+			By:            post.TakenDownBy,
+			Reason:        string(post.TakedownReason),
+			Note:          post.TakedownNote,
+			AppealMessage: post.AppealMessage,
+			AppealedAt:    post.AppealedAt,
+		}
+	}
+	return archived
+}
+
+// exportLegalHold converts a legal hold to its archived form, or nil if the
+// record is not on hold
+func exportLegalHold(hold *models.LegalHold) *sitearchive.LegalHold {
+	if !hold.OnLegalHold() {
+		return nil
+	}
+	return &sitearchive.LegalHold{
+		At:     *hold.LegalHoldAt,
+		By:     hold.LegalHoldBy,
+		Reason: hold.LegalHoldReason,
+	}
+}
+
+// postMedia lists the media referenced by a post: its featured image and the
+// images embedded in its content
+func postMedia(post *models.Post) []sitearchive.Media {
+	var media []sitearchive.Media
+	if post.FeaturedImage != "" {
+		media = append(media, sitearchive.Media{
+			URL: post.FeaturedImage, Kind: sitearchive.MediaFeaturedImage, OwnerType: "post", OwnerID: post.ID,
+		})
+	}
+
+	seen := make(map[string]bool)
+	for _, match := range imgSrcRegex.FindAllStringSubmatch(post.Content, -1) {
+		if seen[match[1]] {
+			continue
+		}
+		seen[match[1]] = true
+		media = append(media, sitearchive.Media{
+			URL: match[1], Kind: sitearchive.MediaPostImage, OwnerType: "post", OwnerID: post.ID,
+		})
+	}
+	return media
+}
+
+// deletedAt returns when a record was soft-deleted, or nil
+func deletedAt(d gorm.DeletedAt) *time.Time {
+	if !d.Valid {
+		return nil
+	}
+	return &d.Time
+}
+
+// Import imports an archive into a site without content. Users are matched
+// to existing users by email; everything else is created with its archived
+// ID. The import runs in a single transaction, so a failed import leaves
+// nothing behind.
+func (s *siteArchiveService) Import(ctx context.Context, r io.ReaderAt, size int64) (*SiteImportReport, error) {
+	archive, err := sitearchive.NewReader(r, size)
+	if err != nil {
+		return nil, siteArchiveError(err)
+	}
+
+	hasContent, err := s.siteRepo.HasContent(ctx)
+	if err != nil {
+		logger.Error("Failed to check for existing content", logger.Err(err))
+		return nil, apperrors.ErrInternal
+	}
+	if hasContent {
+		return nil, apperrors.ErrConflict.WithDetails("A site archive can only be imported into a site without posts, tags, comments or follows")
+	}
+
+	existing, err := s.siteRepo.FindUserIDsByEmail(ctx)
+	if err != nil {
+		logger.Error("Failed to load existing users", logger.Err(err))
+		return nil, apperrors.ErrInternal
+	}
+
+	report := &SiteImportReport{
+		Archive:  archive.Manifest,
+		Imported: make(map[string]int),
+	}
+
+	err = s.siteRepo.Import(ctx, func(repo repository.SiteRepository) error {
+		imp := &siteImport{repo: repo, archive: archive, report: report, userIDs: make(map[uuid.UUID]uuid.UUID)}
+		return imp.run(ctx, existing)
+	})
+	if err != nil {
+		if apperrors.IsAppError(err) {
+			return nil, err
+		}
+		var archiveErr *siteArchiveRecordError
+		if errors.As(err, &archiveErr) {
+			return nil, apperrors.ErrBadRequest.WithDetails(archiveErr.Error())
+		}
+		logger.Error("Failed to import site", logger.Err(err))
+		return nil, apperrors.ErrInternal
+	}
+
+	if s.indexer != nil {
+		job, err := s.queue.Enqueue(ctx, JobSearchReindex, nil, jobs.UniqueKey(JobSearchReindex))
+		switch {
+		case err == nil:
+			report.ReindexJobID = &job.ID
+		case !errors.Is(err, jobs.ErrDuplicate):
+			// The content is in; the index can be rebuilt by hand
+			logger.Error("Failed to enqueue search reindex after site import", logger.Err(err))
+		}
+	}
+
+	logger.Info("Site imported",
+		logger.String("app_version", archive.Manifest.AppVersion),
+		logger.Int("users", report.Imported[sitearchive.FileUsers]),
+		logger.Int("matched_users", report.MatchedUsers),
+		logger.Int("posts", report.Imported[sitearchive.FilePosts]),
+		logger.Int("comments", report.Imported[sitearchive.FileComments]),
+	)
+	return report, nil
+}
+
+// siteArchiveRecordError reports a malformed or inconsistent archive
+type siteArchiveRecordError struct {
+	err error
+}
+
+func (e *siteArchiveRecordError) Error() string { return "Invalid site archive: " + e.err.Error() }
+func (e *siteArchiveRecordError) Unwrap() error { return e.err }
+
+// siteArchiveError maps an error opening an archive to an application error
+func siteArchiveError(err error) error {
+	switch {
+	case errors.Is(err, sitearchive.ErrUnsupportedVersion):
+		return apperrors.ErrBadRequest.WithDetails(fmt.Sprintf("The site archive was written by a newer version; this version reads archives up to version %d", sitearchive.Version))
+	case errors.Is(err, sitearchive.ErrInvalidArchive):
+		return apperrors.ErrBadRequest.WithDetails("The file is not a site archive")
+	default:
+		logger.Error("Failed to open site archive", logger.Err(err))
+		return apperrors.ErrInternal
+	}
+}
+
+// siteImport holds the state of an import in progress
+type siteImport struct {
+	repo    repository.SiteRepository
+	archive *sitearchive.Reader
+	report  *SiteImportReport
+
+	// userIDs maps archived user IDs to the IDs of the matching users
+	userIDs map[uuid.UUID]uuid.UUID
+}
+
+// run imports the files of the archive in dependency order
+func (imp *siteImport) run(ctx context.Context, existing map[string]uuid.UUID) error {
+	err := eachArchived(imp, sitearchive.FileUsers, func(user *sitearchive.User) error {
+		if id, ok := existing[user.Email]; ok {
+			imp.userIDs[user.ID] = id
+			imp.report.MatchedUsers++
+			return nil
+		}
+		imp.userIDs[user.ID] = user.ID
+
+		password, err := unusablePassword()
+		if err != nil {
+			return err
+		}
+		return imp.insert(ctx, sitearchive.FileUsers, importUser(user, password))
+	})
+	if err != nil {
+		return err
+	}
+
+	follows := make(map[[2]uuid.UUID]bool)
+	err = eachArchived(imp, sitearchive.FileFollows, func(follow *sitearchive.Follow) error {
+		followerID, err := imp.userID(follow.FollowerID)
+		if err != nil {
+			return err
+		}
+		followeeID, err := imp.userID(follow.FolloweeID)
+		if err != nil {
+			return err
+		}
+		key := [2]uuid.UUID{followerID, followeeID}
+		if followerID == followeeID || follows[key] {
+			imp.report.SkippedFollows++
+			return nil
+		}
+		follows[key] = true
+
+		return imp.insert(ctx, sitearchive.FileFollows, &models.UserFollow{
+			FollowerID: followerID,
+			FolloweeID: followeeID,
+			CreatedAt:  follow.CreatedAt,
+		})
+	})
+	if err != nil {
+		return err
+	}
+
+	err = eachArchived(imp, sitearchive.FileTags, func(tag *sitearchive.Tag) error {
+		return imp.insert(ctx, sitearchive.FileTags, &models.Tag{
+			BaseModel:   importBaseModel(tag.ID, tag.CreatedAt, tag.UpdatedAt, tag.DeletedAt),
+			Name:        tag.Name,
+			Slug:        tag.Slug,
+			Description: tag.Description,
+		})
+	})
+	if err != nil {
+		return err
+	}
+
+	err = eachArchived(imp, sitearchive.FileTagAliases, func(alias *sitearchive.TagAlias) error {
+		createdBy, err := imp.optionalUserID(alias.CreatedBy)
+		if err != nil {
+			return err
+		}
+		return imp.insert(ctx, sitearchive.FileTagAliases, &models.TagAlias{
+			BaseModel: importBaseModel(alias.ID, alias.CreatedAt, alias.UpdatedAt, alias.DeletedAt),
+			Name:      alias.Name,
+			Slug:      alias.Slug,
+			TagID:     alias.TagID,
+			CreatedBy: createdBy,
+		})
+	})
+	if err != nil {
+		return err
+	}
+
+	err = eachArchived(imp, sitearchive.FilePosts, func(archived *sitearchive.Post) error {
+		post, err := imp.importPost(archived)
+		if err != nil {
+			return err
+		}
+		if err := imp.insert(ctx, sitearchive.FilePosts, post); err != nil {
+			return err
+		}
+		return imp.repo.InsertPostTags(ctx, post.ID, archived.TagIDs)
+	})
+	if err != nil {
+		return err
+	}
+
+	err = eachArchived(imp, sitearchive.FileSlugRedirects, func(redirect *sitearchive.SlugRedirect) error {
+		return imp.insert(ctx, sitearchive.FileSlugRedirects, &models.SlugRedirect{
+			BaseModel: importBaseModel(redirect.ID, redirect.CreatedAt, redirect.UpdatedAt, redirect.DeletedAt),
+			OldSlug:   redirect.OldSlug,
+			PostID:    redirect.PostID,
+		})
+	})
+	if err != nil {
+		return err
+	}
+
+	return eachArchived(imp, sitearchive.FileComments, func(comment *sitearchive.Comment) error {
+		userID, err := imp.userID(comment.UserID)
+		if err != nil {
+			return err
+		}
+		moderatedBy, err := imp.optionalUserID(comment.ModeratedBy)
+		if err != nil {
+			return err
+		}
+		return imp.insert(ctx, sitearchive.FileComments, &models.Comment{
+			BaseModel:   importBaseModel(comment.ID, comment.CreatedAt, comment.UpdatedAt, comment.DeletedAt),
+			Content:     comment.Content,
+			Status:      models.CommentStatus(comment.Status),
+			ModeratedAt: comment.ModeratedAt,
+			Shadowed:    comment.Shadowed,
+			PostID:      comment.PostID,
+			UserID:      userID,
+			ModeratedBy: moderatedBy,
+		})
+	})
+}
+
+// eachArchived calls fn with each record of an archive file, reporting
+// malformed records as archive errors
+func eachArchived[T any](imp *siteImport, name string, fn func(record *T) error) error {
+	var fnErr error
+	err := sitearchive.Each(imp.archive, name, func(record *T) error {
+		fnErr = fn(record)
+		return fnErr
+	})
+	if err != nil && fnErr == nil {
+		return &siteArchiveRecordError{err: err}
+	}
+	return err
+}
+
+// insert creates a record and counts it as imported from file
+func (imp *siteImport) insert(ctx context.Context, file string, record interface{}) error {
+	if err := imp.repo.Insert(ctx, record); err != nil {
+		return &siteArchiveRecordError{err: fmt.Errorf("%s: record %d: %w", file, imp.report.Imported[file]+1, err)}
+	}
+	imp.report.Imported[file]++
+	return nil
+}
+
+// userID maps an archived user ID to the ID of the imported or matched user
+func (imp *siteImport) userID(id uuid.UUID) (uuid.UUID, error) {
+	mapped, ok := imp.userIDs[id]
+	if !ok {
+		return uuid.Nil, &siteArchiveRecordError{err: fmt.Errorf("unknown user %s", id)}
+	}
+	return mapped, nil
+}
+
+// optionalUserID maps an optional archived user ID. References to users
+// missing from the archive, e.g. purged admins, are dropped.
+func (imp *siteImport) optionalUserID(id *uuid.UUID) (*uuid.UUID, error) {
+	if id == nil {
+		return nil, nil
+	}
+	mapped, ok := imp.userIDs[*id]
+	if !ok {
+		return nil, nil
+	}
+	return &mapped, nil
+}
+
+// importPost converts an archived post back into a post
+func (imp *siteImport) importPost(archived *sitearchive.Post) (*models.Post, error) {
+	userID, err := imp.userID(archived.UserID)
+	if err != nil {
+		return nil, err
+	}
+
+	post := &models.Post{
+		BaseModel:        importBaseModel(archived.ID, archived.CreatedAt, archived.UpdatedAt, archived.DeletedAt),
+		Title:            archived.Title,
+		Slug:             archived.Slug,
+		Content:          archived.Content,
+		Excerpt:          archived.Excerpt,
+		FeaturedImage:    archived.FeaturedImage,
+		FeaturedImageAlt: archived.FeaturedImageAlt,
+		Status:           models.PostStatus(archived.Status),
+		ViewCount:        archived.ViewCount,
+		Mature:           archived.Mature,
+		IsPinned:         archived.IsPinned,
+		IsFeatured:       archived.IsFeatured,
+		ProfilePin:       archived.ProfilePin,
+		License:          models.License(archived.License),
+		LicenseName:      archived.LicenseName,
+		LicenseURL:       archived.LicenseURL,
+		ImportKey:        archived.ImportKey,
+		Shadowed:         archived.Shadowed,
+		UserID:           userID,
+	}
+	if archived.LegalHold != nil {
+		by, _ := imp.optionalUserID(archived.LegalHold.By)
+		post.LegalHold = importLegalHold(archived.LegalHold, by)
+	}
+	if archived.Takedown != nil {
+		by, _ := imp.optionalUserID(archived.Takedown.By)
+		at := archived.Takedown.At
+		post.Takedown = models.Takedown{
+			TakenDownAt:    &at,
+			TakenDownBy:    by,
+			TakedownReason: models.TakedownReason(archived.Takedown.Reason),
+			TakedownNote:   archived.Takedown.Note,
+			AppealMessage:  archived.Takedown.AppealMessage,
+			AppealedAt:     archived.Takedown.AppealedAt,
+		}
+	}
+	return post, nil
+}
+
+// importUser converts an archived user back into a user with the given
+// password. Legal holds placed by users missing from the archive keep no
+// author.
+func importUser(archived *sitearchive.User, password string) *models.User {
+	user := &models.User{
+		BaseModel:          importBaseModel(archived.ID, archived.CreatedAt, archived.UpdatedAt, archived.DeletedAt),
+		Email:              archived.Email,
+		Password:           password,
+		FirstName:          archived.FirstName,
+		LastName:           archived.LastName,
+		Role:               models.UserRole(archived.Role),
+		Status:             models.UserStatus(archived.Status),
+		Type:               models.UserType(archived.Type),
+		EmailVerifiedAt:    archived.EmailVerifiedAt,
+		LastLoginAt:        archived.LastLoginAt,
+		LastSeenAt:         archived.LastSeenAt,
+		Avatar:             archived.Avatar,
+		Bio:                archived.Bio,
+		PhoneNumber:        archived.PhoneNumber,
+		Birthdate:          archived.Birthdate,
+		HideEmailVerified:  archived.HideEmailVerified,
+		HideLastSeen:       archived.HideLastSeen,
+		HideFollowers:      archived.HideFollowers,
+		DormancyNotifiedAt: archived.DormancyNotifiedAt,
+		DormantSince:       archived.DormantSince,
+		AnonymizedAt:       archived.AnonymizedAt,
+	}
+	if archived.LegalHold != nil {
+		// Users are imported in archive order, so the admin who placed the
+		// hold may not be known yet; the hold's author is kept as archived
+		user.LegalHold = importLegalHold(archived.LegalHold, archived.LegalHold.By)
+	}
+	return user
+}
+
+// importLegalHold converts an archived legal hold back into a legal hold
+func importLegalHold(archived *sitearchive.LegalHold, by *uuid.UUID) models.LegalHold {
+	at := archived.At
+	return models.LegalHold{
+		LegalHoldAt:     &at,
+		LegalHoldBy:     by,
+		LegalHoldReason: archived.Reason,
+	}
+}
+
+// importBaseModel restores the ID and timestamps of an archived record
+func importBaseModel(id uuid.UUID, createdAt, updatedAt time.Time, deleted *time.Time) models.BaseModel {
+	base := models.BaseModel{ID: id, CreatedAt: createdAt, UpdatedAt: updatedAt}
+	if deleted != nil {
+		base.DeletedAt = gorm.DeletedAt{Time: *deleted, Valid: true}
+	}
+	return base
+}
@@ -0,0 +1,106 @@
+package services
+
+import (
+	"context"
+	"errors"
+
+	"github.com/yourusername/go-enterprise-api/internal/config"
+	"github.com/yourusername/go-enterprise-api/internal/jobs"
+	"github.com/yourusername/go-enterprise-api/internal/models"
+	"github.com/yourusername/go-enterprise-api/internal/repository"
+	"github.com/yourusername/go-enterprise-api/internal/staticsite"
+	"github.com/yourusername/go-enterprise-api/internal/storage"
+	apperrors "github.com/yourusername/go-enterprise-api/pkg/errors"
+	"github.com/yourusername/go-enterprise-api/pkg/logger"
+)
+
+// JobStaticSiteExport is the job type exporting the static site
+const JobStaticSiteExport = "site.static_export"
+
+// staticSiteBatchSize is the number of posts read per query during an export
+const staticSiteBatchSize = 200
+
+// StaticSiteService interface defines static site export methods
+type StaticSiteService interface {
+	Export(ctx context.Context) (*staticsite.Report, error)
+	QueueExport(ctx context.Context) (*models.Job, error)
+	RegisterJobs(queue *jobs.Queue)
+}
+
+// staticSiteService implements StaticSiteService
+type staticSiteService struct {
+	postRepo repository.PostRepository
+	store    storage.Storage
+	queue    *jobs.Queue
+	branding BrandingService
+	cfg      *config.StaticSiteConfig
+}
+
+// NewStaticSiteService creates a new static site service. queue may be nil
+// when exports are only run directly, as by the command line tool.
+func NewStaticSiteService(postRepo repository.PostRepository, store storage.Storage, queue *jobs.Queue, branding BrandingService, cfg *config.StaticSiteConfig) StaticSiteService {
+	return &staticSiteService{
+		postRepo: postRepo,
+		store:    store,
+		queue:    queue,
+		branding: branding,
+		cfg:      cfg,
+	}
+}
+
+// Export renders every post anonymous readers may see, with tag and author
+// pages, and stores the site in the storage backend with the site's current
+// branding. Pages of posts that are no longer public are removed.
+func (s *staticSiteService) Export(ctx context.Context) (*staticsite.Report, error) {
+	builder, err := staticsite.NewBuilder(s.store, s.cfg.Prefix, s.branding.Current(ctx), s.cfg.PageSize)
+	if err != nil {
+		return nil, err
+	}
+
+	err = s.postRepo.FindPublicInBatches(ctx, staticSiteBatchSize, func(posts []models.Post) error {
+		for i := range posts {
+			if err := builder.AddPost(ctx, &posts[i]); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	report, err := builder.Finish(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	logger.Info("Static site exported",
+		logger.String("storage", report.Storage),
+		logger.String("prefix", report.Prefix),
+		logger.Int("posts", report.Posts),
+		logger.Int("files", report.Files),
+		logger.Int("removed", report.Removed),
+	)
+	return report, nil
+}
+
+// QueueExport queues a job exporting the static site
+func (s *staticSiteService) QueueExport(ctx context.Context) (*models.Job, error) {
+	job, err := s.queue.Enqueue(ctx, JobStaticSiteExport, nil, jobs.UniqueKey(JobStaticSiteExport))
+	if err != nil {
+		if errors.Is(err, jobs.ErrDuplicate) {
+			return nil, apperrors.ErrConflict.WithDetails("A static site export is already queued")
+		}
+		logger.Error("Failed to enqueue static site export", logger.Err(err))
+		return nil, apperrors.ErrInternal
+	}
+	return job, nil
+}
+
+// RegisterJobs registers the job exporting the static site
+func (s *staticSiteService) RegisterJobs(queue *jobs.Queue) {
+	queue.Register(JobStaticSiteExport, func(ctx context.Context, _ []byte) error {
+		_, err := s.Export(ctx)
+		return err
+	})
+}
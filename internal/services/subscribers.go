@@ -0,0 +1,148 @@
+package services
+
+import (
+	"context"
+	"errors"
+
+	"github.com/google/uuid"
+	"github.com/yourusername/go-enterprise-api/internal/events"
+	"github.com/yourusername/go-enterprise-api/internal/jobs"
+	"github.com/yourusername/go-enterprise-api/internal/models"
+	"github.com/yourusername/go-enterprise-api/internal/realtime"
+	"github.com/yourusername/go-enterprise-api/internal/repository"
+	"github.com/yourusername/go-enterprise-api/internal/search"
+	apperrors "github.com/yourusername/go-enterprise-api/pkg/errors"
+	"github.com/yourusername/go-enterprise-api/pkg/logger"
+)
+
+// JobSearchSyncPost is the job type bringing a post's search index entry in
+// line with the database
+const JobSearchSyncPost = "search.sync_post"
+
+// syncPostJob is the payload of a JobSearchSyncPost job
+type syncPostJob struct {
+	PostID uuid.UUID `json:"post_id"`
+}
+
+// SubscribeSearchIndex keeps the search index in line with posts: whenever a
+// post is saved or deleted, a job indexes it if it is searchable and removes
+// it from the index otherwise. The job reads the post afresh, so jobs
+// running late or out of order still leave the index up to date. indexer may
+// be nil, in which case nothing is subscribed.
+func SubscribeSearchIndex(bus *events.Bus, queue *jobs.Queue, postRepo repository.PostRepository, indexer search.SearchIndexer) {
+	if indexer == nil {
+		return
+	}
+
+	queue.Register(JobSearchSyncPost, jobs.Handle(func(ctx context.Context, job syncPostJob) error {
+		post, err := postRepo.FindWithAuthor(ctx, job.PostID)
+		if err != nil {
+			if errors.Is(err, apperrors.ErrNotFound) {
+				return indexer.Delete(ctx, job.PostID)
+			}
+			return err
+		}
+		if post.IsSearchable() {
+			return indexer.Index(ctx, post)
+		}
+		return indexer.Delete(ctx, post.ID)
+	}))
+
+	sync := func(ctx context.Context, postID uuid.UUID) error {
+		_, err := queue.Enqueue(ctx, JobSearchSyncPost, syncPostJob{PostID: postID},
+			jobs.UniqueKey(JobSearchSyncPost+":"+postID.String()))
+		if errors.Is(err, jobs.ErrDuplicate) {
+			return nil
+		}
+		return err
+	}
+	events.Subscribe(bus, "search", func(ctx context.Context, e events.PostSaved) error {
+		return sync(ctx, e.Post.ID)
+	})
+	events.Subscribe(bus, "search", func(ctx context.Context, e events.PostDeleted) error {
+		return sync(ctx, e.Post.ID)
+	})
+}
+
+// SubscribeRealtime pushes new comments to the WebSocket clients following
+// their post and new notifications to their recipient's clients. Comments
+// are only pushed to clients that may still see the post.
+func SubscribeRealtime(bus *events.Bus, hub *realtime.Hub, notificationRepo repository.NotificationRepository, adultAge int) {
+	events.Subscribe(bus, "realtime", func(ctx context.Context, e events.CommentCreated) error {
+		hub.SendToPost(e.Post.ID, func(viewer *models.User) *realtime.Message {
+			if !e.Post.IsPublished() || e.Post.IsHiddenFrom(viewer) || !e.Post.IsVisibleTo(viewer, adultAge) {
+				return nil
+			}
+			return &realtime.Message{Type: realtime.TypeCommentCreated, PostID: &e.Post.ID, Data: e.Comment.ToResponseFor(viewer)}
+		})
+		return nil
+	})
+
+	events.Subscribe(bus, "realtime", func(ctx context.Context, e events.NotificationCreated) error {
+		if !hub.Connected(e.Notification.UserID) {
+			return nil
+		}
+
+		// Load the actor and post the notification refers to
+		notification, err := notificationRepo.FindForDelivery(ctx, e.Notification.ID)
+		if err != nil {
+			if errors.Is(err, apperrors.ErrNotFound) {
+				return nil
+			}
+			return err
+		}
+		hub.SendToUser(notification.UserID, func(viewer *models.User) *realtime.Message {
+			return &realtime.Message{Type: realtime.TypeNotificationCreated, Data: notification.ToResponseFor(viewer)}
+		})
+		return nil
+	})
+}
+
+// SubscribeAuditLog logs every domain event and records password changes in
+// the user's change history. Only the field name is recorded, never the
+// password.
+func SubscribeAuditLog(bus *events.Bus, changeRepo repository.ChangeRepository) {
+	bus.SubscribeAll("audit", func(ctx context.Context, e events.Event) error {
+		key, id := eventSubject(e)
+		logger.Info("Domain event", logger.String("event", e.Name()), logger.String(key, id))
+		return nil
+	})
+
+	events.Subscribe(bus, "audit", func(ctx context.Context, e events.PasswordChanged) error {
+		changes := newChangeSet(models.ChangeEntityUser, e.User.ID, e.User.ID)
+		changes.track("password", "[redacted]", "[changed]")
+		changes.save(ctx, changeRepo)
+		return nil
+	})
+
+	events.Subscribe(bus, "audit", func(ctx context.Context, e events.MediaQuarantined) error {
+		changes := newChangeSet(models.ChangeEntityMedia, e.Media.ID, uuid.Nil)
+		changes.track("status", "", e.Media.Status)
+		changes.track("scan_result", "", e.Media.ScanResult)
+		changes.save(ctx, changeRepo)
+		return nil
+	})
+}
+
+// eventSubject returns the log field identifying what an event is about
+func eventSubject(e events.Event) (string, string) {
+	switch e := e.(type) {
+	case events.UserRegistered:
+		return "user_id", e.User.ID.String()
+	case events.PasswordChanged:
+		return "user_id", e.User.ID.String()
+	case events.PostSaved:
+		return "post_id", e.Post.ID.String()
+	case events.PostPublished:
+		return "post_id", e.Post.ID.String()
+	case events.PostDeleted:
+		return "post_id", e.Post.ID.String()
+	case events.CommentCreated:
+		return "comment_id", e.Comment.ID.String()
+	case events.NotificationCreated:
+		return "notification_id", e.Notification.ID.String()
+	case events.MediaQuarantined:
+		return "media_id", e.Media.ID.String()
+	}
+	return "subject", ""
+}
@@ -0,0 +1,238 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"strings"
+
+	"github.com/google/uuid"
+	"github.com/yourusername/go-enterprise-api/internal/models"
+	"github.com/yourusername/go-enterprise-api/internal/repository"
+	"github.com/yourusername/go-enterprise-api/internal/search"
+	apperrors "github.com/yourusername/go-enterprise-api/pkg/errors"
+	"github.com/yourusername/go-enterprise-api/pkg/logger"
+)
+
+// TagService interface defines tag service methods
+type TagService interface {
+	GetSynonyms(ctx context.Context, slug string) (*models.Tag, error)
+	CreateAlias(ctx context.Context, actorID uuid.UUID, slug, name string) (*models.TagAlias, error)
+	DeleteAlias(ctx context.Context, actorID uuid.UUID, slug, aliasSlug string) error
+}
+
+// tagService implements TagService
+type tagService struct {
+	tagRepo  repository.TagRepository
+	postRepo repository.PostRepository
+	indexer  search.SearchIndexer
+}
+
+// NewTagService creates a new tag service
+func NewTagService(tagRepo repository.TagRepository, postRepo repository.PostRepository, indexer search.SearchIndexer) TagService {
+	return &tagService{
+		tagRepo:  tagRepo,
+		postRepo: postRepo,
+		indexer:  indexer,
+	}
+}
+
+// GetSynonyms retrieves a tag and its aliases. slug may be the slug of the
+// tag or of one of its aliases.
+func (s *tagService) GetSynonyms(ctx context.Context, slug string) (*models.Tag, error) {
+	tag, err := resolveTag(ctx, s.tagRepo, slug)
+	if err != nil {
+		return nil, err
+	}
+	return s.tagRepo.FindWithAliases(ctx, tag.ID)
+}
+
+// CreateAlias makes name an alias of the tag with the given slug. An
+// existing tag with the alias's slug is merged into the canonical tag.
+func (s *tagService) CreateAlias(ctx context.Context, actorID uuid.UUID, slug, name string) (*models.TagAlias, error) {
+	aliasSlug := tagSlug(name)
+	if aliasSlug == "" {
+		return nil, apperrors.ErrValidation.WithDetails("Alias must contain at least one letter or digit")
+	}
+
+	tag, err := resolveTag(ctx, s.tagRepo, slug)
+	if err != nil {
+		return nil, err
+	}
+	if aliasSlug == tag.Slug {
+		return nil, apperrors.ErrValidation.WithDetails("A tag cannot be an alias of itself")
+	}
+
+	if _, err := s.tagRepo.FindAliasBySlug(ctx, aliasSlug); err == nil {
+		return nil, apperrors.ErrConflict.WithDetails("Alias already exists")
+	} else if !errors.Is(err, apperrors.ErrNotFound) {
+		logger.Error("Failed to find tag alias", logger.Err(err))
+		return nil, apperrors.ErrInternal
+	}
+
+	var mergeTagID *uuid.UUID
+	if existing, err := s.tagRepo.FindBySlug(ctx, aliasSlug); err == nil {
+		mergeTagID = &existing.ID
+	} else if !errors.Is(err, apperrors.ErrNotFound) {
+		logger.Error("Failed to find tag", logger.Err(err))
+		return nil, apperrors.ErrInternal
+	}
+
+	alias := &models.TagAlias{
+		Name:      truncate(strings.TrimSpace(name), 100),
+		Slug:      aliasSlug,
+		TagID:     tag.ID,
+		CreatedBy: &actorID,
+	}
+	postIDs, err := s.tagRepo.CreateAlias(ctx, alias, mergeTagID)
+	if err != nil {
+		if repository.IsDuplicateKey(err) {
+			return nil, apperrors.ErrConflict.WithDetails("Alias already exists")
+		}
+		logger.Error("Failed to create tag alias", logger.Err(err))
+		return nil, apperrors.ErrInternal
+	}
+
+	logger.Info("Tag alias created",
+		logger.String("tag", tag.Slug),
+		logger.String("alias", alias.Slug),
+		logger.Int("merged_posts", len(postIDs)),
+		logger.String("admin_id", actorID.String()),
+	)
+
+	s.reindex(ctx, postIDs)
+
+	return alias, nil
+}
+
+// DeleteAlias removes an alias of the tag with the given slug. Posts merged
+// into the tag when the alias was created keep the canonical tag.
+func (s *tagService) DeleteAlias(ctx context.Context, actorID uuid.UUID, slug, aliasSlug string) error {
+	tag, err := s.tagRepo.FindBySlug(ctx, slug)
+	if err != nil {
+		return err
+	}
+
+	alias, err := s.tagRepo.FindAliasBySlug(ctx, aliasSlug)
+	if err != nil {
+		return err
+	}
+	if alias.TagID != tag.ID {
+		return apperrors.ErrNotFound.WithDetails("Tag alias not found")
+	}
+
+	if err := s.tagRepo.DeleteAlias(ctx, alias.ID); err != nil {
+		logger.Error("Failed to delete tag alias", logger.Err(err))
+		return apperrors.ErrInternal
+	}
+
+	logger.Info("Tag alias deleted",
+		logger.String("tag", tag.Slug),
+		logger.String("alias", alias.Slug),
+		logger.String("admin_id", actorID.String()),
+	)
+	return nil
+}
+
+// reindex refreshes the search documents of retagged posts
+func (s *tagService) reindex(ctx context.Context, postIDs []uuid.UUID) {
+	if s.indexer == nil || len(postIDs) == 0 {
+		return
+	}
+
+	for start := 0; start < len(postIDs); start += exportBatchSize {
+		end := start + exportBatchSize
+		if end > len(postIDs) {
+			end = len(postIDs)
+		}
+
+		posts, err := s.postRepo.FindByIDsWithAuthor(ctx, postIDs[start:end])
+		if err != nil {
+			logger.Error("Failed to load retagged posts", logger.Err(err))
+			return
+		}
+
+		published := posts[:0]
+		for _, post := range posts {
+			if post.IsSearchable() {
+				published = append(published, post)
+			}
+		}
+		if len(published) == 0 {
+			continue
+		}
+		if err := s.indexer.IndexBatch(ctx, published); err != nil {
+			logger.Error("Failed to sync retagged posts with search index", logger.Err(err))
+			return
+		}
+	}
+}
+
+// tagSlug returns the slug of a tag name, or "" when the name has no
+// characters usable in a slug
+func tagSlug(name string) string {
+	if !strings.ContainsAny(strings.ToLower(name), "abcdefghijklmnopqrstuvwxyz0123456789") {
+		return ""
+	}
+	return truncate(generateSlug(name), 100)
+}
+
+// resolveTag finds the tag with the given slug, following aliases to their
+// canonical tag
+func resolveTag(ctx context.Context, tagRepo repository.TagRepository, slug string) (*models.Tag, error) {
+	alias, err := tagRepo.FindAliasBySlug(ctx, slug)
+	if err == nil && alias.Tag != nil {
+		return alias.Tag, nil
+	}
+	if err != nil && !errors.Is(err, apperrors.ErrNotFound) {
+		logger.Error("Failed to find tag alias", logger.Err(err))
+		return nil, apperrors.ErrInternal
+	}
+
+	tag, err := tagRepo.FindBySlug(ctx, slug)
+	if err != nil {
+		if apperrors.IsAppError(err) {
+			return nil, err
+		}
+		logger.Error("Failed to find tag", logger.Err(err))
+		return nil, apperrors.ErrInternal
+	}
+	return tag, nil
+}
+
+// findOrCreateTag resolves a tag name through aliases, creating the tag when
+// neither a tag nor an alias uses its slug. Names without any characters
+// usable in a slug give a nil tag.
+func findOrCreateTag(ctx context.Context, tagRepo repository.TagRepository, name string) (*models.Tag, error) {
+	slug := tagSlug(name)
+	if slug == "" {
+		return nil, nil
+	}
+
+	alias, err := tagRepo.FindAliasBySlug(ctx, slug)
+	if err == nil && alias.Tag != nil {
+		return alias.Tag, nil
+	}
+	if err != nil && !errors.Is(err, apperrors.ErrNotFound) {
+		return nil, err
+	}
+
+	return tagRepo.FindOrCreate(ctx, truncate(strings.TrimSpace(name), 100), slug)
+}
+
+// findOrCreateTags resolves tag names with findOrCreateTag, dropping
+// duplicates so aliases of the same tag are only applied once
+func findOrCreateTags(ctx context.Context, tagRepo repository.TagRepository, names []string) ([]models.Tag, error) {
+	tags := make([]models.Tag, 0, len(names))
+	seen := make(map[uuid.UUID]bool, len(names))
+	for _, name := range names {
+		tag, err := findOrCreateTag(ctx, tagRepo, name)
+		if err != nil {
+			return nil, err
+		}
+		if tag != nil && !seen[tag.ID] {
+			seen[tag.ID] = true
+			tags = append(tags, *tag)
+		}
+	}
+	return tags, nil
+}
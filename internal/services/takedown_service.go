@@ -0,0 +1,172 @@
+package services
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/yourusername/go-enterprise-api/internal/models"
+	"github.com/yourusername/go-enterprise-api/internal/repository"
+	"github.com/yourusername/go-enterprise-api/internal/search"
+	apperrors "github.com/yourusername/go-enterprise-api/pkg/errors"
+	"github.com/yourusername/go-enterprise-api/pkg/logger"
+)
+
+// TakedownRequest represents a request to take down a post
+type TakedownRequest struct {
+	Reason string `json:"reason" binding:"required"`
+	Note   string `json:"note,omitempty" binding:"max=1000" sanitize:"text"`
+}
+
+// TakedownService interface defines content takedown service methods
+type TakedownService interface {
+	TakeDown(ctx context.Context, postID, actorID uuid.UUID, req *TakedownRequest) (*models.Post, error)
+	Reinstate(ctx context.Context, postID, actorID uuid.UUID) (*models.Post, error)
+	GetTakedowns(ctx context.Context, appealedOnly bool, page, pageSize int) ([]models.Post, int64, error)
+}
+
+// takedownService implements TakedownService
+type takedownService struct {
+	postRepo   repository.PostRepository
+	changeRepo repository.ChangeRepository
+	notifier   NotificationService
+	indexer    search.SearchIndexer
+}
+
+// NewTakedownService creates a new takedown service.
+// notifier and indexer may be nil.
+func NewTakedownService(postRepo repository.PostRepository, changeRepo repository.ChangeRepository, notifier NotificationService, indexer search.SearchIndexer) TakedownService {
+	return &takedownService{
+		postRepo:   postRepo,
+		changeRepo: changeRepo,
+		notifier:   notifier,
+		indexer:    indexer,
+	}
+}
+
+// TakeDown removes a published post from public view. The content is kept
+// for appeals and the post's URLs answer with a tombstone instead.
+func (s *takedownService) TakeDown(ctx context.Context, postID, actorID uuid.UUID, req *TakedownRequest) (*models.Post, error) {
+	post, err := s.postRepo.FindByID(ctx, postID)
+	if err != nil {
+		return nil, err
+	}
+	if post.IsTakenDown() {
+		return nil, apperrors.ErrConflict.WithDetails("Post has already been taken down")
+	}
+	if !post.IsPublished() {
+		return nil, apperrors.ErrValidation.WithDetails("Only published posts can be taken down")
+	}
+
+	now := time.Now().UTC()
+	changes := newChangeSet(models.ChangeEntityPost, post.ID, actorID)
+	changes.track("status", post.Status, models.PostStatusTakenDown)
+	changes.track("takedown_reason", "", req.Reason)
+
+	post.Status = models.PostStatusTakenDown
+	post.Takedown = models.Takedown{
+		TakenDownAt:    &now,
+		TakenDownBy:    &actorID,
+		TakedownReason: models.TakedownReason(req.Reason),
+		TakedownNote:   req.Note,
+	}
+
+	if err := s.postRepo.Update(ctx, post); err != nil {
+		if apperrors.IsAppError(err) {
+			return nil, err
+		}
+		logger.Error("Failed to take down post", logger.Err(err))
+		return nil, apperrors.ErrInternal
+	}
+
+	changes.save(ctx, s.changeRepo)
+	logger.Info("Post taken down",
+		logger.String("post_id", post.ID.String()),
+		logger.String("reason", req.Reason),
+		logger.String("admin_id", actorID.String()),
+	)
+
+	if s.indexer != nil {
+		if err := s.indexer.Delete(ctx, post.ID); err != nil {
+			logger.Error("Failed to remove post from search index", logger.Err(err))
+		}
+	}
+
+	if s.notifier != nil {
+		s.notifier.Notify(ctx, &models.Notification{
+			Type:   models.NotificationTakedown,
+			Reason: req.Reason,
+			UserID: post.UserID,
+			PostID: &post.ID,
+		})
+	}
+
+	return post, nil
+}
+
+// Reinstate republishes a taken down post, e.g. after a successful appeal
+func (s *takedownService) Reinstate(ctx context.Context, postID, actorID uuid.UUID) (*models.Post, error) {
+	post, err := s.postRepo.FindByID(ctx, postID)
+	if err != nil {
+		return nil, err
+	}
+	if !post.IsTakenDown() {
+		return nil, apperrors.ErrConflict.WithDetails("Post has not been taken down")
+	}
+
+	changes := newChangeSet(models.ChangeEntityPost, post.ID, actorID)
+	changes.track("status", post.Status, models.PostStatusPublished)
+	changes.track("takedown_reason", post.TakedownReason, "")
+
+	post.Status = models.PostStatusPublished
+	post.Takedown = models.Takedown{}
+
+	if err := s.postRepo.Update(ctx, post); err != nil {
+		if apperrors.IsAppError(err) {
+			return nil, err
+		}
+		logger.Error("Failed to reinstate post", logger.Err(err))
+		return nil, apperrors.ErrInternal
+	}
+
+	changes.save(ctx, s.changeRepo)
+	logger.Info("Post reinstated",
+		logger.String("post_id", post.ID.String()),
+		logger.String("admin_id", actorID.String()),
+	)
+
+	if s.indexer != nil {
+		if indexed, err := s.postRepo.FindWithAuthor(ctx, post.ID); err == nil && indexed.IsSearchable() {
+			if err := s.indexer.Index(ctx, indexed); err != nil {
+				logger.Error("Failed to sync post with search index", logger.Err(err))
+			}
+		}
+	}
+
+	if s.notifier != nil {
+		s.notifier.Notify(ctx, &models.Notification{
+			Type:   models.NotificationReinstated,
+			UserID: post.UserID,
+			PostID: &post.ID,
+		})
+	}
+
+	return post, nil
+}
+
+// GetTakedowns retrieves taken down posts, optionally only those under appeal
+func (s *takedownService) GetTakedowns(ctx context.Context, appealedOnly bool, page, pageSize int) ([]models.Post, int64, error) {
+	if page < 1 {
+		page = 1
+	}
+	if pageSize < 1 || pageSize > 100 {
+		pageSize = 10
+	}
+
+	posts, total, err := s.postRepo.FindTakenDown(ctx, appealedOnly, page, pageSize)
+	if err != nil {
+		logger.Error("Failed to get takedowns", logger.Err(err))
+		return nil, 0, apperrors.ErrInternal
+	}
+	return posts, total, nil
+}
@@ -0,0 +1,70 @@
+package services
+
+import (
+	"context"
+	"time"
+
+	"github.com/yourusername/go-enterprise-api/internal/repository"
+	"github.com/yourusername/go-enterprise-api/pkg/logger"
+)
+
+// trashPurgeBatch is how many records are deleted per transaction while
+// purging the trash
+const trashPurgeBatch = 100
+
+// TrashPurgeReport counts the records purged from the trash
+type TrashPurgeReport struct {
+	Before   time.Time `json:"before"`
+	Posts    int       `json:"posts"`
+	Comments int       `json:"comments"`
+}
+
+// TrashService interface defines emptying the trash of soft-deleted posts
+// and comments
+type TrashService interface {
+	Purge(ctx context.Context, olderThan time.Duration) (*TrashPurgeReport, error)
+}
+
+// trashService implements TrashService
+type trashService struct {
+	trashRepo repository.TrashRepository
+}
+
+// NewTrashService creates a new trash service
+func NewTrashService(trashRepo repository.TrashRepository) TrashService {
+	return &trashService{trashRepo: trashRepo}
+}
+
+// Purge permanently deletes the posts and comments deleted more than
+// olderThan ago. Posts on legal hold stay in the trash. An interrupted purge
+// keeps the batches it finished.
+func (s *trashService) Purge(ctx context.Context, olderThan time.Duration) (*TrashPurgeReport, error) {
+	report := &TrashPurgeReport{Before: time.Now().UTC().Add(-olderThan)}
+
+	for {
+		purged, err := s.trashRepo.PurgeComments(ctx, report.Before, trashPurgeBatch)
+		report.Comments += purged
+		if err != nil {
+			return report, err
+		}
+		if purged < trashPurgeBatch {
+			break
+		}
+	}
+	for {
+		purged, err := s.trashRepo.PurgePosts(ctx, report.Before, trashPurgeBatch)
+		report.Posts += purged
+		if err != nil {
+			return report, err
+		}
+		if purged < trashPurgeBatch {
+			break
+		}
+	}
+
+	logger.Info("Purged the trash",
+		logger.Int("posts", report.Posts),
+		logger.Int("comments", report.Comments),
+	)
+	return report, nil
+}
@@ -2,6 +2,7 @@ package services
 
 import (
 	"context"
+	"time"
 
 	"github.com/google/uuid"
 	"github.com/yourusername/go-enterprise-api/internal/models"
@@ -12,34 +13,66 @@ import (
 
 // UpdateUserRequest represents the update user request
 type UpdateUserRequest struct {
-	FirstName   *string `json:"first_name,omitempty"`
-	LastName    *string `json:"last_name,omitempty"`
-	Bio         *string `json:"bio,omitempty"`
-	PhoneNumber *string `json:"phone_number,omitempty"`
-	Avatar      *string `json:"avatar,omitempty"`
+	FirstName   *string `json:"first_name,omitempty" sanitize:"text,striptags"`
+	LastName    *string `json:"last_name,omitempty" sanitize:"text,striptags"`
+	Bio         *string `json:"bio,omitempty" sanitize:"text,striptags"`
+	PhoneNumber *string `json:"phone_number,omitempty" sanitize:"trim"`
+	Avatar      *string `json:"avatar,omitempty" binding:"omitempty,max=2048" sanitize:"trim"`
+	Birthdate   *string `json:"birthdate,omitempty" sanitize:"trim"`
+
+	// Privacy settings
+	HideEmailVerified *bool `json:"hide_email_verified,omitempty"`
+	HideLastSeen      *bool `json:"hide_last_seen,omitempty"`
+	HideFollowers     *bool `json:"hide_followers,omitempty"`
+
+	// Version is the version of the user the edit is based on. When set,
+	// the edit is rejected if the user has changed since.
+	Version *int64 `json:"version,omitempty"`
 }
 
 // UserService interface defines user service methods
 type UserService interface {
 	GetByID(ctx context.Context, id uuid.UUID) (*models.User, error)
-	GetAll(ctx context.Context, page, pageSize int) ([]models.User, int64, error)
-	Update(ctx context.Context, id uuid.UUID, req *UpdateUserRequest) (*models.User, error)
+	GetByIDs(ctx context.Context, ids []uuid.UUID) ([]models.User, error)
+	GetAll(ctx context.Context, filter *models.UserFilter, page, pageSize int) ([]models.User, int64, error)
+	Export(ctx context.Context, filter *models.UserFilter, fn func(users []models.User) error) error
+	Update(ctx context.Context, id uuid.UUID, actorID uuid.UUID, req *UpdateUserRequest) (*models.User, error)
 	Delete(ctx context.Context, id uuid.UUID) error
 	Search(ctx context.Context, query string, page, pageSize int) ([]models.User, int64, error)
-	UpdateStatus(ctx context.Context, id uuid.UUID, status models.UserStatus) error
-	UpdateRole(ctx context.Context, id uuid.UUID, role models.UserRole) error
+	UpdateStatus(ctx context.Context, id uuid.UUID, actorID uuid.UUID, status models.UserStatus) error
+	UpdateRole(ctx context.Context, id uuid.UUID, actorID uuid.UUID, role models.UserRole) error
 	GetByEmail(ctx context.Context, email string) (*models.User, error)
+	GetChanges(ctx context.Context, id uuid.UUID, page, pageSize int) ([]models.Change, int64, error)
+	GetProfile(ctx context.Context, id uuid.UUID, viewer *models.User) (*models.PublicProfileResponse, error)
 }
 
+const (
+	// profileRecentPosts is the number of recent posts shown on a public profile
+	profileRecentPosts = 5
+	// userExportBatchSize is the number of users loaded per query during an export
+	userExportBatchSize = 500
+)
+
 // userService implements UserService
 type userService struct {
-	userRepo repository.UserRepository
+	userRepo     repository.UserRepository
+	postRepo     repository.PostRepository
+	changeRepo   repository.ChangeRepository
+	avatars      AvatarService
+	adultAge     int
+	onlineWindow time.Duration
 }
 
-// NewUserService creates a new user service
-func NewUserService(userRepo repository.UserRepository) UserService {
+// NewUserService creates a new user service. Profiles show users seen within
+// onlineWindow as online.
+func NewUserService(userRepo repository.UserRepository, postRepo repository.PostRepository, changeRepo repository.ChangeRepository, avatars AvatarService, adultAge int, onlineWindow time.Duration) UserService {
 	return &userService{
-		userRepo: userRepo,
+		userRepo:     userRepo,
+		postRepo:     postRepo,
+		changeRepo:   changeRepo,
+		avatars:      avatars,
+		adultAge:     adultAge,
+		onlineWindow: onlineWindow,
 	}
 }
 
@@ -52,8 +85,18 @@ func (s *userService) GetByID(ctx context.Context, id uuid.UUID) (*models.User,
 	return user, nil
 }
 
-// GetAll retrieves all users with pagination
-func (s *userService) GetAll(ctx context.Context, page, pageSize int) ([]models.User, int64, error) {
+// GetByIDs retrieves several users at once. Unknown IDs are left out.
+func (s *userService) GetByIDs(ctx context.Context, ids []uuid.UUID) ([]models.User, error) {
+	users, err := s.userRepo.FindByIDs(ctx, ids)
+	if err != nil {
+		logger.Error("Failed to find users", logger.Err(err))
+		return nil, apperrors.ErrInternal
+	}
+	return users, nil
+}
+
+// GetAll retrieves the users matching a filter with pagination
+func (s *userService) GetAll(ctx context.Context, filter *models.UserFilter, page, pageSize int) ([]models.User, int64, error) {
 	if page < 1 {
 		page = 1
 	}
@@ -61,7 +104,7 @@ func (s *userService) GetAll(ctx context.Context, page, pageSize int) ([]models.
 		pageSize = 10
 	}
 
-	users, total, err := s.userRepo.FindAll(ctx, page, pageSize)
+	users, total, err := s.userRepo.FindFiltered(ctx, filter, page, pageSize)
 	if err != nil {
 		logger.Error("Failed to get users", logger.Err(err))
 		return nil, 0, apperrors.ErrInternal
@@ -69,46 +112,123 @@ func (s *userService) GetAll(ctx context.Context, page, pageSize int) ([]models.
 	return users, total, nil
 }
 
+// Export calls fn with every user matching a filter, in batches and in the
+// filter's order
+func (s *userService) Export(ctx context.Context, filter *models.UserFilter, fn func(users []models.User) error) error {
+	for page := 1; ; page++ {
+		users, _, err := s.userRepo.FindFiltered(ctx, filter, page, userExportBatchSize)
+		if err != nil {
+			logger.Error("Failed to export users", logger.Err(err))
+			return apperrors.ErrInternal
+		}
+		if len(users) > 0 {
+			if err := fn(users); err != nil {
+				return err
+			}
+		}
+		if len(users) < userExportBatchSize {
+			return nil
+		}
+	}
+}
+
 // Update updates a user
-func (s *userService) Update(ctx context.Context, id uuid.UUID, req *UpdateUserRequest) (*models.User, error) {
+func (s *userService) Update(ctx context.Context, id uuid.UUID, actorID uuid.UUID, req *UpdateUserRequest) (*models.User, error) {
 	user, err := s.userRepo.FindByID(ctx, id)
 	if err != nil {
 		return nil, err
 	}
 
+	if req.Version != nil && *req.Version != user.Version {
+		return nil, errVersionConflict(user.Version)
+	}
+
+	changes := newChangeSet(models.ChangeEntityUser, user.ID, actorID)
+
 	// Update fields if provided
 	if req.FirstName != nil {
+		changes.track("first_name", user.FirstName, *req.FirstName)
 		user.FirstName = *req.FirstName
 	}
 	if req.LastName != nil {
+		changes.track("last_name", user.LastName, *req.LastName)
 		user.LastName = *req.LastName
 	}
 	if req.Bio != nil {
+		changes.track("bio", user.Bio, *req.Bio)
 		user.Bio = *req.Bio
 	}
 	if req.PhoneNumber != nil {
+		changes.track("phone_number", user.PhoneNumber, *req.PhoneNumber)
 		user.PhoneNumber = *req.PhoneNumber
 	}
+	// An uploaded avatar replaced by another is no longer served
+	var replacedAvatarKey string
 	if req.Avatar != nil {
+		changes.track("avatar", user.Avatar, *req.Avatar)
+		if *req.Avatar != user.Avatar {
+			replacedAvatarKey = user.AvatarKey
+			user.AvatarKey = ""
+		}
 		user.Avatar = *req.Avatar
 	}
+	if req.Birthdate != nil {
+		birthdate, err := time.Parse(models.BirthdateFormat, *req.Birthdate)
+		if err != nil {
+			return nil, apperrors.ErrValidation.WithDetails("Birthdate must be a date in YYYY-MM-DD format")
+		}
+		// Users may set their birthdate once; corrections go through an admin
+		if user.Birthdate != nil && actorID == user.ID && !user.Birthdate.Equal(birthdate) {
+			return nil, apperrors.ErrForbidden.WithDetails("Birthdate can only be changed by an admin")
+		}
+		oldBirthdate := ""
+		if user.Birthdate != nil {
+			oldBirthdate = user.Birthdate.Format(models.BirthdateFormat)
+		}
+		changes.track("birthdate", oldBirthdate, birthdate.Format(models.BirthdateFormat))
+		user.Birthdate = &birthdate
+	}
+	if req.HideEmailVerified != nil {
+		changes.track("hide_email_verified", user.HideEmailVerified, *req.HideEmailVerified)
+		user.HideEmailVerified = *req.HideEmailVerified
+	}
+	if req.HideLastSeen != nil {
+		changes.track("hide_last_seen", user.HideLastSeen, *req.HideLastSeen)
+		user.HideLastSeen = *req.HideLastSeen
+	}
+	if req.HideFollowers != nil {
+		changes.track("hide_followers", user.HideFollowers, *req.HideFollowers)
+		user.HideFollowers = *req.HideFollowers
+	}
 
 	if err := s.userRepo.Update(ctx, user); err != nil {
+		if apperrors.IsAppError(err) {
+			return nil, err
+		}
 		logger.Error("Failed to update user", logger.Err(err))
 		return nil, apperrors.ErrInternal
 	}
 
+	changes.save(ctx, s.changeRepo)
+	if replacedAvatarKey != "" {
+		s.avatars.DeleteFiles(ctx, replacedAvatarKey)
+	}
+
 	return user, nil
 }
 
 // Delete deletes a user
 func (s *userService) Delete(ctx context.Context, id uuid.UUID) error {
 	// Check if user exists
-	_, err := s.userRepo.FindByID(ctx, id)
+	user, err := s.userRepo.FindByID(ctx, id)
 	if err != nil {
 		return err
 	}
 
+	if user.OnLegalHold() {
+		return errLegalHold("deleted", models.ChangeEntityUser, user.ID)
+	}
+
 	if err := s.userRepo.Delete(ctx, id); err != nil {
 		logger.Error("Failed to delete user", logger.Err(err))
 		return apperrors.ErrInternal
@@ -136,9 +256,9 @@ func (s *userService) Search(ctx context.Context, query string, page, pageSize i
 }
 
 // UpdateStatus updates a user's status
-func (s *userService) UpdateStatus(ctx context.Context, id uuid.UUID, status models.UserStatus) error {
+func (s *userService) UpdateStatus(ctx context.Context, id uuid.UUID, actorID uuid.UUID, status models.UserStatus) error {
 	// Verify user exists
-	_, err := s.userRepo.FindByID(ctx, id)
+	user, err := s.userRepo.FindByID(ctx, id)
 	if err != nil {
 		return err
 	}
@@ -148,13 +268,17 @@ func (s *userService) UpdateStatus(ctx context.Context, id uuid.UUID, status mod
 		return apperrors.ErrInternal
 	}
 
+	changes := newChangeSet(models.ChangeEntityUser, id, actorID)
+	changes.track("status", user.Status, status)
+	changes.save(ctx, s.changeRepo)
+
 	return nil
 }
 
 // UpdateRole updates a user's role
-func (s *userService) UpdateRole(ctx context.Context, id uuid.UUID, role models.UserRole) error {
+func (s *userService) UpdateRole(ctx context.Context, id uuid.UUID, actorID uuid.UUID, role models.UserRole) error {
 	// Verify user exists
-	_, err := s.userRepo.FindByID(ctx, id)
+	user, err := s.userRepo.FindByID(ctx, id)
 	if err != nil {
 		return err
 	}
@@ -164,6 +288,10 @@ func (s *userService) UpdateRole(ctx context.Context, id uuid.UUID, role models.
 		return apperrors.ErrInternal
 	}
 
+	changes := newChangeSet(models.ChangeEntityUser, id, actorID)
+	changes.track("role", user.Role, role)
+	changes.save(ctx, s.changeRepo)
+
 	return nil
 }
 
@@ -171,3 +299,70 @@ func (s *userService) UpdateRole(ctx context.Context, id uuid.UUID, role models.
 func (s *userService) GetByEmail(ctx context.Context, email string) (*models.User, error) {
 	return s.userRepo.FindByEmail(ctx, email)
 }
+
+// GetChanges retrieves the field-level change timeline of a user
+func (s *userService) GetChanges(ctx context.Context, id uuid.UUID, page, pageSize int) ([]models.Change, int64, error) {
+	if page < 1 {
+		page = 1
+	}
+	if pageSize < 1 || pageSize > 100 {
+		pageSize = 10
+	}
+
+	// Verify user exists
+	if _, err := s.userRepo.FindByID(ctx, id); err != nil {
+		return nil, 0, err
+	}
+
+	changes, total, err := s.changeRepo.FindByEntity(ctx, models.ChangeEntityUser, id, page, pageSize)
+	if err != nil {
+		logger.Error("Failed to get user changes", logger.Err(err))
+		return nil, 0, apperrors.ErrInternal
+	}
+
+	return changes, total, nil
+}
+
+// GetProfile retrieves a user's public profile as seen by viewer (nil for
+// anonymous viewers). Mature posts are only counted and listed for viewers
+// allowed to see them.
+func (s *userService) GetProfile(ctx context.Context, id uuid.UUID, viewer *models.User) (*models.PublicProfileResponse, error) {
+	user, err := s.userRepo.FindByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if !user.HasPublicProfile() {
+		return nil, apperrors.ErrUserNotFound
+	}
+
+	includeMature := viewer != nil && (viewer.ID == user.ID || viewer.IsAdmin() || viewer.IsVerifiedAdult(s.adultAge))
+
+	profile := user.ToPublicProfile(s.onlineWindow)
+	profile.PublishedPosts, err = s.postRepo.CountPublishedByUser(ctx, user.ID, includeMature)
+	if err != nil {
+		logger.Error("Failed to count published posts", logger.Err(err))
+		return nil, apperrors.ErrInternal
+	}
+
+	pins, err := s.postRepo.FindProfilePins(ctx, user.ID)
+	if err != nil {
+		logger.Error("Failed to get profile pins", logger.Err(err))
+		return nil, apperrors.ErrInternal
+	}
+	for i := range pins {
+		if pins[i].IsPublished() && (includeMature || !pins[i].Mature) {
+			profile.PinnedPosts = append(profile.PinnedPosts, *pins[i].ToSummary())
+		}
+	}
+
+	posts, err := s.postRepo.FindRecentPublishedByUser(ctx, user.ID, includeMature, profileRecentPosts)
+	if err != nil {
+		logger.Error("Failed to get recent posts", logger.Err(err))
+		return nil, apperrors.ErrInternal
+	}
+	for i := range posts {
+		profile.RecentPosts = append(profile.RecentPosts, *posts[i].ToSummary())
+	}
+
+	return profile, nil
+}
@@ -0,0 +1,634 @@
+package services
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/yourusername/go-enterprise-api/internal/config"
+	"github.com/yourusername/go-enterprise-api/internal/events"
+	"github.com/yourusername/go-enterprise-api/internal/jobs"
+	"github.com/yourusername/go-enterprise-api/internal/models"
+	"github.com/yourusername/go-enterprise-api/internal/repository"
+	"github.com/yourusername/go-enterprise-api/internal/webhook"
+	apperrors "github.com/yourusername/go-enterprise-api/pkg/errors"
+	"github.com/yourusername/go-enterprise-api/pkg/logger"
+)
+
+// webhookSecretPrefix marks generated webhook secrets so they are recognisable
+const webhookSecretPrefix = "whsec_"
+
+// Webhook job types
+const (
+	// JobWebhookDeliver sends a webhook delivery
+	JobWebhookDeliver = "webhook.deliver"
+
+	// JobWebhookReplay redelivers a webhook's deliveries from a time range
+	JobWebhookReplay = "webhook.replay"
+
+	// JobWebhookPurge deletes finished deliveries past their retention
+	JobWebhookPurge = "webhook.purge_deliveries"
+)
+
+// webhookReplayBatchSize is the number of deliveries redelivered per batch
+// of a replay
+const webhookReplayBatchSize = 200
+
+// maxWebhookRetryDelay caps the backoff between delivery attempts
+const maxWebhookRetryDelay = 24 * time.Hour
+
+// CreateWebhookRequest represents a webhook registration
+type CreateWebhookRequest struct {
+	URL         string   `json:"url" binding:"required,httpurl,max=2048" sanitize:"trim"`
+	Description string   `json:"description,omitempty" binding:"max=255" sanitize:"text"`
+	Events      []string `json:"events" binding:"required"`
+	Global      bool     `json:"global,omitempty"`
+}
+
+// UpdateWebhookRequest represents a webhook update
+type UpdateWebhookRequest struct {
+	URL         *string  `json:"url,omitempty" binding:"omitempty,httpurl,max=2048" sanitize:"trim"`
+	Description *string  `json:"description,omitempty" binding:"omitempty,max=255" sanitize:"text"`
+	Events      []string `json:"events,omitempty"`
+	Active      *bool    `json:"active,omitempty"`
+}
+
+// WebhookService interface defines webhook service methods
+type WebhookService interface {
+	Subscribe(bus *events.Bus)
+	RegisterJobs(queue *jobs.Queue)
+	Create(ctx context.Context, user *models.User, req *CreateWebhookRequest) (*models.Webhook, string, error)
+	GetByUser(ctx context.Context, userID uuid.UUID, page, pageSize int) ([]models.Webhook, int64, error)
+	GetAll(ctx context.Context, page, pageSize int) ([]models.Webhook, int64, error)
+	GetByID(ctx context.Context, id uuid.UUID, user *models.User) (*models.Webhook, error)
+	Update(ctx context.Context, id uuid.UUID, user *models.User, req *UpdateWebhookRequest) (*models.Webhook, error)
+	Delete(ctx context.Context, id uuid.UUID, user *models.User) error
+	GetDeliveries(ctx context.Context, id uuid.UUID, user *models.User, page, pageSize int) ([]models.WebhookDelivery, int64, error)
+	Redeliver(ctx context.Context, id, deliveryID uuid.UUID, user *models.User) (*models.WebhookDelivery, error)
+	Replay(ctx context.Context, id uuid.UUID, user *models.User, from, to time.Time, status models.WebhookDeliveryStatus) (*models.Job, int64, error)
+}
+
+// webhookService implements WebhookService
+type webhookService struct {
+	webhookRepo repository.WebhookRepository
+	client      *webhook.Client
+	queue       *jobs.Queue
+	cfg         *config.WebhookConfig
+}
+
+// NewWebhookService creates a new webhook service. Deliveries are sent by
+// jobs on queue.
+func NewWebhookService(webhookRepo repository.WebhookRepository, queue *jobs.Queue, cfg *config.WebhookConfig) WebhookService {
+	return &webhookService{
+		webhookRepo: webhookRepo,
+		client:      webhook.NewClient(cfg.Timeout, cfg.AllowPrivate),
+		queue:       queue,
+		cfg:         cfg,
+	}
+}
+
+// deliverJob is the payload of a JobWebhookDeliver job
+type deliverJob struct {
+	DeliveryID uuid.UUID `json:"delivery_id"`
+}
+
+// replayJob is the payload of a JobWebhookReplay job
+type replayJob struct {
+	WebhookID uuid.UUID                      `json:"webhook_id"`
+	From      time.Time                      `json:"from"`
+	To        time.Time                      `json:"to"`
+	Statuses  []models.WebhookDeliveryStatus `json:"statuses"`
+}
+
+// webhookPayload is the body of every webhook request. ID identifies the
+// event and is the same for every webhook it is sent to.
+type webhookPayload struct {
+	ID        uuid.UUID           `json:"id"`
+	Event     models.WebhookEvent `json:"event"`
+	CreatedAt time.Time           `json:"created_at"`
+	Data      interface{}         `json:"data"`
+}
+
+// Create registers a webhook and returns it along with its signing secret,
+// which is only shown once. Only admins can register global webhooks, and
+// only global webhooks can subscribe to events about no particular user.
+func (s *webhookService) Create(ctx context.Context, user *models.User, req *CreateWebhookRequest) (*models.Webhook, string, error) {
+	if req.Global && !user.IsAdmin() {
+		return nil, "", apperrors.ErrForbidden.WithDetails("Only admins can create global webhooks")
+	}
+
+	events, err := webhookEvents(req.Events, req.Global)
+	if err != nil {
+		return nil, "", err
+	}
+
+	secret, err := generateWebhookSecret()
+	if err != nil {
+		logger.Error("Failed to generate webhook secret", logger.Err(err))
+		return nil, "", apperrors.ErrInternal
+	}
+
+	hook := &models.Webhook{
+		URL:         req.URL,
+		Description: req.Description,
+		Secret:      secret,
+		Global:      req.Global,
+		Active:      true,
+		UserID:      user.ID,
+	}
+	hook.SetEvents(events)
+
+	if err := s.webhookRepo.Create(ctx, hook); err != nil {
+		logger.Error("Failed to create webhook", logger.Err(err))
+		return nil, "", apperrors.ErrInternal
+	}
+
+	logger.Info("Webhook created",
+		logger.String("webhook_id", hook.ID.String()),
+		logger.String("user_id", user.ID.String()),
+		logger.Any("events", events),
+	)
+	return hook, secret, nil
+}
+
+// GetByUser retrieves a user's webhooks
+func (s *webhookService) GetByUser(ctx context.Context, userID uuid.UUID, page, pageSize int) ([]models.Webhook, int64, error) {
+	if page < 1 {
+		page = 1
+	}
+	if pageSize < 1 || pageSize > 100 {
+		pageSize = 10
+	}
+
+	webhooks, total, err := s.webhookRepo.FindByUser(ctx, userID, page, pageSize)
+	if err != nil {
+		logger.Error("Failed to get webhooks", logger.Err(err))
+		return nil, 0, apperrors.ErrInternal
+	}
+	return webhooks, total, nil
+}
+
+// GetAll retrieves every user's webhooks
+func (s *webhookService) GetAll(ctx context.Context, page, pageSize int) ([]models.Webhook, int64, error) {
+	if page < 1 {
+		page = 1
+	}
+	if pageSize < 1 || pageSize > 100 {
+		pageSize = 10
+	}
+
+	webhooks, total, err := s.webhookRepo.FindAllWebhooks(ctx, page, pageSize)
+	if err != nil {
+		logger.Error("Failed to get webhooks", logger.Err(err))
+		return nil, 0, apperrors.ErrInternal
+	}
+	return webhooks, total, nil
+}
+
+// GetByID retrieves a webhook managed by user
+func (s *webhookService) GetByID(ctx context.Context, id uuid.UUID, user *models.User) (*models.Webhook, error) {
+	hook, err := s.webhookRepo.FindByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	// Other users' webhooks are reported as not found
+	if hook.UserID != user.ID && !user.IsAdmin() {
+		return nil, apperrors.ErrNotFound.WithDetails("Webhook not found")
+	}
+	return hook, nil
+}
+
+// Update updates a webhook
+func (s *webhookService) Update(ctx context.Context, id uuid.UUID, user *models.User, req *UpdateWebhookRequest) (*models.Webhook, error) {
+	hook, err := s.GetByID(ctx, id, user)
+	if err != nil {
+		return nil, err
+	}
+
+	if req.URL != nil {
+		hook.URL = *req.URL
+	}
+	if req.Description != nil {
+		hook.Description = *req.Description
+	}
+	if req.Events != nil {
+		events, err := webhookEvents(req.Events, hook.Global)
+		if err != nil {
+			return nil, err
+		}
+		hook.SetEvents(events)
+	}
+	if req.Active != nil {
+		hook.Active = *req.Active
+	}
+
+	if err := s.webhookRepo.Update(ctx, hook); err != nil {
+		logger.Error("Failed to update webhook", logger.Err(err))
+		return nil, apperrors.ErrInternal
+	}
+	return hook, nil
+}
+
+// Delete deletes a webhook. Its pending deliveries are abandoned.
+func (s *webhookService) Delete(ctx context.Context, id uuid.UUID, user *models.User) error {
+	hook, err := s.GetByID(ctx, id, user)
+	if err != nil {
+		return err
+	}
+
+	if err := s.webhookRepo.Delete(ctx, hook.ID); err != nil {
+		logger.Error("Failed to delete webhook", logger.Err(err))
+		return apperrors.ErrInternal
+	}
+
+	logger.Info("Webhook deleted",
+		logger.String("webhook_id", hook.ID.String()),
+		logger.String("user_id", user.ID.String()),
+	)
+	return nil
+}
+
+// GetDeliveries retrieves the delivery log of a webhook, newest first
+func (s *webhookService) GetDeliveries(ctx context.Context, id uuid.UUID, user *models.User, page, pageSize int) ([]models.WebhookDelivery, int64, error) {
+	if _, err := s.GetByID(ctx, id, user); err != nil {
+		return nil, 0, err
+	}
+
+	if page < 1 {
+		page = 1
+	}
+	if pageSize < 1 || pageSize > 100 {
+		pageSize = 10
+	}
+
+	deliveries, total, err := s.webhookRepo.FindDeliveries(ctx, id, page, pageSize)
+	if err != nil {
+		logger.Error("Failed to get webhook deliveries", logger.Err(err))
+		return nil, 0, apperrors.ErrInternal
+	}
+	return deliveries, total, nil
+}
+
+// Redeliver sends the payload of one of a webhook's deliveries again, as a
+// new delivery. The payload keeps its event ID, so receivers can tell it
+// apart from new events.
+func (s *webhookService) Redeliver(ctx context.Context, id, deliveryID uuid.UUID, user *models.User) (*models.WebhookDelivery, error) {
+	hook, err := s.replayableWebhook(ctx, id, user)
+	if err != nil {
+		return nil, err
+	}
+
+	original, err := s.webhookRepo.FindDelivery(ctx, deliveryID)
+	if err != nil {
+		return nil, err
+	}
+	if original.WebhookID != hook.ID {
+		return nil, apperrors.ErrNotFound.WithDetails("Delivery not found")
+	}
+
+	delivery, err := s.redeliver(ctx, original)
+	if err != nil {
+		logger.Error("Failed to create webhook redelivery", logger.String("delivery_id", deliveryID.String()), logger.Err(err))
+		return nil, apperrors.ErrInternal
+	}
+
+	logger.Info("Webhook delivery redelivered",
+		logger.String("webhook_id", hook.ID.String()),
+		logger.String("delivery_id", deliveryID.String()),
+		logger.String("redelivery_id", delivery.ID.String()),
+		logger.String("user_id", user.ID.String()),
+	)
+	return delivery, nil
+}
+
+// Replay queues a job redelivering a webhook's deliveries created in
+// [from, to) with the given status, or every finished delivery when status
+// is empty. Redeliveries are not replayed again. It returns the job, nil when
+// there is nothing to replay, and the number of deliveries it redelivers.
+func (s *webhookService) Replay(ctx context.Context, id uuid.UUID, user *models.User, from, to time.Time, status models.WebhookDeliveryStatus) (*models.Job, int64, error) {
+	hook, err := s.replayableWebhook(ctx, id, user)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	statuses := []models.WebhookDeliveryStatus{models.WebhookDeliverySucceeded, models.WebhookDeliveryFailed}
+	if status != "" {
+		statuses = []models.WebhookDeliveryStatus{status}
+	}
+
+	count, err := s.webhookRepo.CountReplayable(ctx, hook.ID, from, to, statuses)
+	if err != nil {
+		logger.Error("Failed to count webhook deliveries", logger.Err(err))
+		return nil, 0, apperrors.ErrInternal
+	}
+	if count == 0 {
+		return nil, 0, nil
+	}
+
+	job, err := s.queue.Enqueue(ctx, JobWebhookReplay, replayJob{
+		WebhookID: hook.ID,
+		From:      from,
+		To:        to,
+		Statuses:  statuses,
+	})
+	if err != nil {
+		logger.Error("Failed to enqueue webhook replay", logger.String("webhook_id", hook.ID.String()), logger.Err(err))
+		return nil, 0, apperrors.ErrInternal
+	}
+
+	logger.Info("Webhook replay queued",
+		logger.String("webhook_id", hook.ID.String()),
+		logger.String("job_id", job.ID.String()),
+		logger.Int("deliveries", int(count)),
+		logger.String("user_id", user.ID.String()),
+	)
+	return job, count, nil
+}
+
+// replayableWebhook retrieves a webhook managed by user that can be sent
+// deliveries
+func (s *webhookService) replayableWebhook(ctx context.Context, id uuid.UUID, user *models.User) (*models.Webhook, error) {
+	hook, err := s.GetByID(ctx, id, user)
+	if err != nil {
+		return nil, err
+	}
+	if !hook.Active {
+		return nil, apperrors.ErrConflict.WithDetails("Webhook is disabled; enable it before redelivering")
+	}
+	return hook, nil
+}
+
+// redeliver records a new delivery of an earlier delivery's payload and
+// queues a job sending it now
+func (s *webhookService) redeliver(ctx context.Context, original *models.WebhookDelivery) (*models.WebhookDelivery, error) {
+	redeliveryOf := original.ID
+	if original.RedeliveryOf != nil {
+		redeliveryOf = *original.RedeliveryOf
+	}
+
+	delivery := &models.WebhookDelivery{
+		Event:        original.Event,
+		Payload:      original.Payload,
+		Status:       models.WebhookDeliveryPending,
+		WebhookID:    original.WebhookID,
+		RedeliveryOf: &redeliveryOf,
+	}
+	if err := s.webhookRepo.CreateDelivery(ctx, delivery); err != nil {
+		return nil, err
+	}
+
+	s.enqueueDelivery(ctx, delivery, time.Now())
+	return delivery, nil
+}
+
+// Subscribe delivers the domain events webhooks can subscribe to
+func (s *webhookService) Subscribe(bus *events.Bus) {
+	events.Subscribe(bus, "webhooks", func(ctx context.Context, e events.UserRegistered) error {
+		s.publish(ctx, models.WebhookUserRegistered, nil, e.User.ToResponse())
+		return nil
+	})
+	events.Subscribe(bus, "webhooks", func(ctx context.Context, e events.PostPublished) error {
+		s.publish(ctx, models.WebhookPostPublished, &e.Post.UserID, e.Post.ToResponse())
+		return nil
+	})
+	events.Subscribe(bus, "webhooks", func(ctx context.Context, e events.PostDeleted) error {
+		s.publish(ctx, models.WebhookPostDeleted, &e.Post.UserID, e.Post.ToSummary())
+		return nil
+	})
+	events.Subscribe(bus, "webhooks", func(ctx context.Context, e events.CommentCreated) error {
+		s.publish(ctx, models.WebhookCommentCreated, &e.Post.UserID, e.Comment.ToResponseFor(nil))
+		return nil
+	})
+}
+
+// RegisterJobs registers the jobs sending, replaying and purging webhook
+// deliveries
+func (s *webhookService) RegisterJobs(queue *jobs.Queue) {
+	queue.Register(JobWebhookDeliver, jobs.Handle(s.deliver))
+	queue.Register(JobWebhookReplay, jobs.Handle(s.replay))
+	queue.Register(JobWebhookPurge, func(ctx context.Context, _ []byte) error {
+		return s.purge(ctx)
+	})
+}
+
+// publish records a delivery of an event concerning the content of ownerID,
+// nil for events concerning no particular user, for every subscribed webhook
+// and queues a job sending each. Failures are logged and do not fail the
+// action that triggered the event.
+func (s *webhookService) publish(ctx context.Context, event models.WebhookEvent, ownerID *uuid.UUID, data interface{}) {
+	webhooks, err := s.webhookRepo.FindSubscribed(ctx, event, ownerID)
+	if err != nil {
+		logger.Error("Failed to find webhooks", logger.String("event", string(event)), logger.Err(err))
+		return
+	}
+	if len(webhooks) == 0 {
+		return
+	}
+
+	payload, err := json.Marshal(webhookPayload{
+		ID:        uuid.New(),
+		Event:     event,
+		CreatedAt: time.Now().UTC(),
+		Data:      data,
+	})
+	if err != nil {
+		logger.Error("Failed to encode webhook payload", logger.String("event", string(event)), logger.Err(err))
+		return
+	}
+
+	for _, hook := range webhooks {
+		delivery := &models.WebhookDelivery{
+			Event:     event,
+			Payload:   string(payload),
+			Status:    models.WebhookDeliveryPending,
+			WebhookID: hook.ID,
+		}
+		if err := s.webhookRepo.CreateDelivery(ctx, delivery); err != nil {
+			logger.Error("Failed to create webhook delivery",
+				logger.String("webhook_id", hook.ID.String()),
+				logger.Err(err),
+			)
+			continue
+		}
+
+		s.enqueueDelivery(ctx, delivery, time.Now())
+	}
+}
+
+// enqueueDelivery queues a job sending a delivery at the given time
+func (s *webhookService) enqueueDelivery(ctx context.Context, delivery *models.WebhookDelivery, at time.Time) {
+	_, err := s.queue.Enqueue(ctx, JobWebhookDeliver, deliverJob{DeliveryID: delivery.ID}, jobs.RunAt(at))
+	if err != nil {
+		logger.Error("Failed to enqueue webhook delivery", logger.String("delivery_id", delivery.ID.String()), logger.Err(err))
+	}
+}
+
+// deliver sends a pending delivery. Failed deliveries are queued again for
+// their next attempt, so a delivery's retries are recorded in its log rather
+// than as job failures.
+func (s *webhookService) deliver(ctx context.Context, job deliverJob) error {
+	delivery, err := s.webhookRepo.FindDelivery(ctx, job.DeliveryID)
+	if err != nil {
+		if errors.Is(err, apperrors.ErrNotFound) {
+			return jobs.Permanent(err)
+		}
+		return err
+	}
+	if delivery.Status != models.WebhookDeliveryPending {
+		return nil
+	}
+	if delivery.Webhook == nil || !delivery.Webhook.Active {
+		s.abandon(ctx, delivery, "webhook was deleted or disabled")
+		return nil
+	}
+
+	s.attempt(ctx, delivery, delivery.Webhook)
+	if delivery.Status == models.WebhookDeliveryPending && delivery.NextAttemptAt != nil {
+		s.enqueueDelivery(ctx, delivery, *delivery.NextAttemptAt)
+	}
+	return nil
+}
+
+// replay redelivers the deliveries of a replay job. A replay interrupted by
+// a crash and run again redelivers its deliveries from the start.
+func (s *webhookService) replay(ctx context.Context, job replayJob) error {
+	replayed := 0
+	err := s.webhookRepo.EachReplayable(ctx, job.WebhookID, job.From, job.To, job.Statuses, webhookReplayBatchSize, func(deliveries []models.WebhookDelivery) error {
+		for i := range deliveries {
+			if _, err := s.redeliver(ctx, &deliveries[i]); err != nil {
+				return err
+			}
+			replayed++
+		}
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	logger.Info("Webhook replay completed",
+		logger.String("webhook_id", job.WebhookID.String()),
+		logger.Int("deliveries", replayed),
+	)
+	return nil
+}
+
+// purge deletes finished deliveries older than the retention window
+func (s *webhookService) purge(ctx context.Context) error {
+	deleted, err := s.webhookRepo.DeleteFinishedDeliveriesBefore(ctx, time.Now().Add(-s.cfg.Retention))
+	if err != nil {
+		return err
+	}
+	if deleted > 0 {
+		logger.Info("Purged webhook deliveries", logger.Int("deleted", int(deleted)))
+	}
+	return nil
+}
+
+// attempt sends a delivery once and records the outcome. Failed deliveries
+// are scheduled for a retry with exponential backoff until MaxAttempts is
+// reached.
+func (s *webhookService) attempt(ctx context.Context, delivery *models.WebhookDelivery, hook *models.Webhook) {
+	delivery.Attempts++
+
+	resp, err := s.client.Send(ctx, &webhook.Request{
+		URL:        hook.URL,
+		Secret:     hook.Secret,
+		Event:      string(delivery.Event),
+		DeliveryID: delivery.ID.String(),
+		Body:       []byte(delivery.Payload),
+	})
+
+	now := time.Now().UTC()
+	delivery.ResponseStatus = 0
+	delivery.ResponseBody = ""
+	if resp != nil {
+		delivery.ResponseStatus = resp.StatusCode
+		delivery.ResponseBody = resp.Body
+	}
+
+	switch {
+	case err == nil:
+		delivery.Status = models.WebhookDeliverySucceeded
+		delivery.Error = ""
+		delivery.DeliveredAt = &now
+		delivery.NextAttemptAt = nil
+	case delivery.Attempts >= s.cfg.MaxAttempts:
+		delivery.Status = models.WebhookDeliveryFailed
+		delivery.Error = truncate(err.Error(), 1024)
+		delivery.NextAttemptAt = nil
+	default:
+		next := now.Add(s.retryDelay(delivery.Attempts))
+		delivery.Error = truncate(err.Error(), 1024)
+		delivery.NextAttemptAt = &next
+	}
+
+	if err != nil {
+		logger.Warn("Webhook delivery failed",
+			logger.String("delivery_id", delivery.ID.String()),
+			logger.String("webhook_id", hook.ID.String()),
+			logger.Int("attempt", delivery.Attempts),
+			logger.Err(err),
+		)
+	}
+
+	if err := s.webhookRepo.UpdateDelivery(ctx, delivery); err != nil {
+		logger.Error("Failed to update webhook delivery", logger.String("delivery_id", delivery.ID.String()), logger.Err(err))
+	}
+}
+
+// abandon marks a delivery that can no longer be sent as failed
+func (s *webhookService) abandon(ctx context.Context, delivery *models.WebhookDelivery, reason string) {
+	delivery.Status = models.WebhookDeliveryFailed
+	delivery.Error = reason
+	delivery.NextAttemptAt = nil
+	if err := s.webhookRepo.UpdateDelivery(ctx, delivery); err != nil {
+		logger.Error("Failed to update webhook delivery", logger.String("delivery_id", delivery.ID.String()), logger.Err(err))
+	}
+}
+
+// retryDelay returns how long to wait after the given number of failed
+// attempts: RetryDelay, doubling with each further attempt
+func (s *webhookService) retryDelay(attempts int) time.Duration {
+	delay := s.cfg.RetryDelay
+	for i := 1; i < attempts && delay < maxWebhookRetryDelay; i++ {
+		delay *= 2
+	}
+	if delay > maxWebhookRetryDelay {
+		delay = maxWebhookRetryDelay
+	}
+	return delay
+}
+
+// webhookEvents checks the events a webhook subscribes to
+func webhookEvents(names []string, global bool) ([]models.WebhookEvent, error) {
+	if len(names) == 0 {
+		return nil, apperrors.ErrValidation.WithDetails("At least one event is required")
+	}
+
+	seen := make(map[models.WebhookEvent]bool, len(names))
+	events := make([]models.WebhookEvent, 0, len(names))
+	for _, name := range names {
+		event := models.WebhookEvent(name)
+		if event.IsGlobalOnly() && !global {
+			return nil, apperrors.ErrValidation.WithDetails("Only global webhooks can subscribe to " + name)
+		}
+		if !seen[event] {
+			seen[event] = true
+			events = append(events, event)
+		}
+	}
+	return events, nil
+}
+
+func generateWebhookSecret() (string, error) {
+	b := make([]byte, 32)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+	return webhookSecretPrefix + hex.EncodeToString(b), nil
+}
@@ -0,0 +1,342 @@
+// Package sitearchive reads and writes site archives: versioned zip files
+// holding the content of a whole site, used to move a site to a new instance
+// and to rehearse disaster recovery.
+//
+// An archive holds a manifest.json describing it and one JSON lines file per
+// kind of record. Records carry their original IDs, so references between
+// them survive the move. Passwords, tokens and other secrets are never
+// exported.
+package sitearchive
+
+import (
+	"archive/zip"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Format identifies site archives in their manifest
+const Format = "go-enterprise-api/site-archive"
+
+// Version is the archive layout written by this build. Archives written by
+// older builds can be read; newer ones are rejected.
+const Version = 1
+
+// Files of an archive, in the order they are written and imported
+const (
+	FileManifest      = "manifest.json"
+	FileUsers         = "users.jsonl"
+	FileFollows       = "follows.jsonl"
+	FileTags          = "tags.jsonl"
+	FileTagAliases    = "tag_aliases.jsonl"
+	FilePosts         = "posts.jsonl"
+	FileSlugRedirects = "slug_redirects.jsonl"
+	FileComments      = "comments.jsonl"
+	FileMedia         = "media.jsonl"
+)
+
+// maxManifestSize bounds the size of the manifest read from an archive
+const maxManifestSize = 1 << 20
+
+// maxRecordSize bounds the size of a single record read from an archive
+const maxRecordSize = 32 << 20
+
+var (
+	// ErrInvalidArchive is returned for files that are not site archives
+	ErrInvalidArchive = errors.New("not a site archive")
+
+	// ErrUnsupportedVersion is returned for archives written by a newer build
+	ErrUnsupportedVersion = fmt.Errorf("site archive version is newer than %d", Version)
+)
+
+// Manifest describes an archive
+type Manifest struct {
+	Format     string         `json:"format"`
+	Version    int            `json:"version"`
+	AppVersion string         `json:"app_version"`
+	ExportedAt time.Time      `json:"exported_at"`
+	Counts     map[string]int `json:"counts"`
+}
+
+// User is an exported user. Imported users get a password no one knows.
+type User struct {
+	ID                 uuid.UUID  `json:"id"`
+	Email              string     `json:"email"`
+	FirstName          string     `json:"first_name"`
+	LastName           string     `json:"last_name"`
+	Role               string     `json:"role"`
+	Status             string     `json:"status"`
+	Type               string     `json:"type"`
+	EmailVerifiedAt    *time.Time `json:"email_verified_at,omitempty"`
+	LastLoginAt        *time.Time `json:"last_login_at,omitempty"`
+	LastSeenAt         *time.Time `json:"last_seen_at,omitempty"`
+	Avatar             string     `json:"avatar,omitempty"`
+	Bio                string     `json:"bio,omitempty"`
+	PhoneNumber        string     `json:"phone_number,omitempty"`
+	Birthdate          *time.Time `json:"birthdate,omitempty"`
+	HideEmailVerified  bool       `json:"hide_email_verified"`
+	HideLastSeen       bool       `json:"hide_last_seen"`
+	HideFollowers      bool       `json:"hide_followers"`
+	DormancyNotifiedAt *time.Time `json:"dormancy_notified_at,omitempty"`
+	DormantSince       *time.Time `json:"dormant_since,omitempty"`
+	AnonymizedAt       *time.Time `json:"anonymized_at,omitempty"`
+	LegalHold          *LegalHold `json:"legal_hold,omitempty"`
+	CreatedAt          time.Time  `json:"created_at"`
+	UpdatedAt          time.Time  `json:"updated_at"`
+	DeletedAt          *time.Time `json:"deleted_at,omitempty"`
+}
+
+// LegalHold is the legal hold of an exported user or post
+type LegalHold struct {
+	At     time.Time  `json:"at"`
+	By     *uuid.UUID `json:"by,omitempty"`
+	Reason string     `json:"reason"`
+}
+
+// Follow is an exported follow between two users
+type Follow struct {
+	FollowerID uuid.UUID `json:"follower_id"`
+	FolloweeID uuid.UUID `json:"followee_id"`
+	CreatedAt  time.Time `json:"created_at"`
+}
+
+// Tag is an exported tag
+type Tag struct {
+	ID          uuid.UUID  `json:"id"`
+	Name        string     `json:"name"`
+	Slug        string     `json:"slug"`
+	Description string     `json:"description,omitempty"`
+	CreatedAt   time.Time  `json:"created_at"`
+	UpdatedAt   time.Time  `json:"updated_at"`
+	DeletedAt   *time.Time `json:"deleted_at,omitempty"`
+}
+
+// TagAlias is an exported tag alias
+type TagAlias struct {
+	ID        uuid.UUID  `json:"id"`
+	Name      string     `json:"name"`
+	Slug      string     `json:"slug"`
+	TagID     uuid.UUID  `json:"tag_id"`
+	CreatedBy *uuid.UUID `json:"created_by,omitempty"`
+	CreatedAt time.Time  `json:"created_at"`
+	UpdatedAt time.Time  `json:"updated_at"`
+	DeletedAt *time.Time `json:"deleted_at,omitempty"`
+}
+
+// Post is an exported post
+type Post struct {
+	ID               uuid.UUID   `json:"id"`
+	UserID           uuid.UUID   `json:"user_id"`
+	Title            string      `json:"title"`
+	Slug             string      `json:"slug"`
+	Content          string      `json:"content"`
+	Excerpt          string      `json:"excerpt,omitempty"`
+	FeaturedImage    string      `json:"featured_image,omitempty"`
+	FeaturedImageAlt string      `json:"featured_image_alt,omitempty"`
+	Status           string      `json:"status"`
+	ViewCount        int         `json:"view_count"`
+	Mature           bool        `json:"mature"`
+	IsPinned         bool        `json:"is_pinned"`
+	IsFeatured       bool        `json:"is_featured"`
+	ProfilePin       *int        `json:"profile_pin,omitempty"`
+	License          string      `json:"license,omitempty"`
+	LicenseName      string      `json:"license_name,omitempty"`
+	LicenseURL       string      `json:"license_url,omitempty"`
+	ImportKey        string      `json:"import_key,omitempty"`
+	Shadowed         bool        `json:"shadowed"`
+	TagIDs           []uuid.UUID `json:"tag_ids"`
+	LegalHold        *LegalHold  `json:"legal_hold,omitempty"`
+	Takedown         *Takedown   `json:"takedown,omitempty"`
+	CreatedAt        time.Time   `json:"created_at"`
+	UpdatedAt        time.Time   `json:"updated_at"`
+	DeletedAt        *time.Time  `json:"deleted_at,omitempty"`
+}
+
+// Takedown is the takedown of an exported post
+type Takedown struct {
+	At            time.Time  `json:"at"`
+	By            *uuid.UUID `json:"by,omitempty"`
+	Reason        string     `json:"reason"`
+	Note          string     `json:"note,omitempty"`
+	AppealMessage string     `json:"appeal_message,omitempty"`
+	AppealedAt    *time.Time `json:"appealed_at,omitempty"`
+}
+
+// SlugRedirect is an exported redirect from a previous post slug
+type SlugRedirect struct {
+	ID        uuid.UUID  `json:"id"`
+	OldSlug   string     `json:"old_slug"`
+	PostID    uuid.UUID  `json:"post_id"`
+	CreatedAt time.Time  `json:"created_at"`
+	UpdatedAt time.Time  `json:"updated_at"`
+	DeletedAt *time.Time `json:"deleted_at,omitempty"`
+}
+
+// Comment is an exported comment
+type Comment struct {
+	ID          uuid.UUID  `json:"id"`
+	PostID      uuid.UUID  `json:"post_id"`
+	UserID      uuid.UUID  `json:"user_id"`
+	Content     string     `json:"content"`
+	Status      string     `json:"status"`
+	ModeratedAt *time.Time `json:"moderated_at,omitempty"`
+	ModeratedBy *uuid.UUID `json:"moderated_by,omitempty"`
+	Shadowed    bool       `json:"shadowed"`
+	CreatedAt   time.Time  `json:"created_at"`
+	UpdatedAt   time.Time  `json:"updated_at"`
+	DeletedAt   *time.Time `json:"deleted_at,omitempty"`
+}
+
+// Media kinds
+const (
+	MediaAvatar        = "avatar"
+	MediaFeaturedImage = "featured_image"
+	MediaPostImage     = "post_image"
+)
+
+// Media is a media file referenced by the exported content. Files are not
+// included in the archive; the manifest lists what has to be copied or
+// rehosted along with it.
+type Media struct {
+	URL       string    `json:"url"`
+	Kind      string    `json:"kind"`
+	OwnerType string    `json:"owner_type"`
+	OwnerID   uuid.UUID `json:"owner_id"`
+}
+
+// Writer writes an archive. Files are written one at a time: Begin starts a
+// file and Write appends records to it until the next Begin or Close.
+type Writer struct {
+	zw      *zip.Writer
+	enc     *json.Encoder
+	current string
+	counts  map[string]int
+}
+
+// NewWriter creates a writer of an archive to w
+func NewWriter(w io.Writer) *Writer {
+	return &Writer{zw: zip.NewWriter(w), counts: make(map[string]int)}
+}
+
+// Begin starts the next file of the archive
+func (w *Writer) Begin(name string) error {
+	out, err := w.zw.CreateHeader(&zip.FileHeader{
+		Name:     name,
+		Method:   zip.Deflate,
+		Modified: time.Now().UTC(),
+	})
+	if err != nil {
+		return err
+	}
+	w.enc = json.NewEncoder(out)
+	w.enc.SetEscapeHTML(false)
+	w.current = name
+	w.counts[name] = 0
+	return nil
+}
+
+// Write appends a record to the current file
+func (w *Writer) Write(record interface{}) error {
+	if w.enc == nil {
+		return errors.New("sitearchive: Write called before Begin")
+	}
+	if err := w.enc.Encode(record); err != nil {
+		return err
+	}
+	w.counts[w.current]++
+	return nil
+}
+
+// Close writes the manifest, counting the records of every file, and
+// finishes the archive
+func (w *Writer) Close(appVersion string) error {
+	manifest := Manifest{
+		Format:     Format,
+		Version:    Version,
+		AppVersion: appVersion,
+		ExportedAt: time.Now().UTC(),
+		Counts:     w.counts,
+	}
+	if err := w.Begin(FileManifest); err != nil {
+		return err
+	}
+	delete(w.counts, FileManifest)
+	w.enc.SetIndent("", "  ")
+	if err := w.enc.Encode(manifest); err != nil {
+		return err
+	}
+	return w.zw.Close()
+}
+
+// Reader reads an archive
+type Reader struct {
+	Manifest Manifest
+	files    map[string]*zip.File
+}
+
+// NewReader opens an archive and checks its manifest
+func NewReader(r io.ReaderAt, size int64) (*Reader, error) {
+	zr, err := zip.NewReader(r, size)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidArchive, err)
+	}
+
+	reader := &Reader{files: make(map[string]*zip.File, len(zr.File))}
+	for _, file := range zr.File {
+		reader.files[file.Name] = file
+	}
+
+	manifest, ok := reader.files[FileManifest]
+	if !ok {
+		return nil, fmt.Errorf("%w: %s is missing", ErrInvalidArchive, FileManifest)
+	}
+	rc, err := manifest.Open()
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidArchive, err)
+	}
+	defer rc.Close()
+
+	if err := json.NewDecoder(io.LimitReader(rc, maxManifestSize)).Decode(&reader.Manifest); err != nil {
+		return nil, fmt.Errorf("%w: invalid %s: %v", ErrInvalidArchive, FileManifest, err)
+	}
+	if reader.Manifest.Format != Format {
+		return nil, fmt.Errorf("%w: unexpected format %q", ErrInvalidArchive, reader.Manifest.Format)
+	}
+	if reader.Manifest.Version > Version {
+		return nil, ErrUnsupportedVersion
+	}
+	return reader, nil
+}
+
+// Each decodes the records of a file in order and calls fn with each. A file
+// missing from the archive holds no records.
+func Each[T any](r *Reader, name string, fn func(record *T) error) error {
+	file, ok := r.files[name]
+	if !ok {
+		return nil
+	}
+
+	rc, err := file.Open()
+	if err != nil {
+		return fmt.Errorf("%s: %w", name, err)
+	}
+	defer rc.Close()
+
+	dec := json.NewDecoder(io.LimitReader(rc, int64(r.Manifest.Counts[name]+1)*maxRecordSize))
+	for line := 1; ; line++ {
+		var record T
+		if err := dec.Decode(&record); err != nil {
+			if err == io.EOF {
+				return nil
+			}
+			return fmt.Errorf("%s: record %d: %w", name, line, err)
+		}
+		if err := fn(&record); err != nil {
+			return err
+		}
+	}
+}
@@ -0,0 +1,81 @@
+package spam
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+)
+
+// AkismetChecker implements Checker using the Akismet comment-check API
+type AkismetChecker struct {
+	baseURL string
+	apiKey  string
+	siteURL string
+	client  *http.Client
+}
+
+// NewAkismetChecker creates a new Akismet checker. siteURL is the site
+// registered with Akismet ("blog" in Akismet's API).
+func NewAkismetChecker(baseURL, apiKey, siteURL string, timeout time.Duration) *AkismetChecker {
+	return &AkismetChecker{
+		baseURL: strings.TrimRight(baseURL, "/"),
+		apiKey:  apiKey,
+		siteURL: siteURL,
+		client:  &http.Client{Timeout: timeout},
+	}
+}
+
+// Name returns the backend name
+func (a *AkismetChecker) Name() string {
+	return DriverAkismet
+}
+
+// IsSpam asks Akismet whether content is spam
+func (a *AkismetChecker) IsSpam(ctx context.Context, content *Content) (bool, error) {
+	form := url.Values{
+		"api_key":              {a.apiKey},
+		"blog":                 {a.siteURL},
+		"user_ip":              {content.IP},
+		"user_agent":           {content.UserAgent},
+		"referrer":             {content.Referrer},
+		"permalink":            {content.Permalink},
+		"comment_type":         {content.Type},
+		"comment_author":       {content.AuthorName},
+		"comment_author_email": {content.AuthorEmail},
+		"comment_content":      {content.Body},
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, a.baseURL+"/1.1/comment-check", strings.NewReader(form.Encode()))
+	if err != nil {
+		return false, err
+	}
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+
+	resp, err := a.client.Do(req)
+	if err != nil {
+		return false, fmt.Errorf("akismet request failed: %w", err)
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(io.LimitReader(resp.Body, 1024))
+	if err != nil {
+		return false, fmt.Errorf("akismet response could not be read: %w", err)
+	}
+
+	switch strings.TrimSpace(string(body)) {
+	case "true":
+		return true, nil
+	case "false":
+		return false, nil
+	default:
+		// Akismet answers "invalid" and explains why in a debug header
+		if help := resp.Header.Get("X-akismet-debug-help"); help != "" {
+			return false, fmt.Errorf("akismet returned status %d: %s", resp.StatusCode, help)
+		}
+		return false, fmt.Errorf("akismet returned status %d: %s", resp.StatusCode, strings.TrimSpace(string(body)))
+	}
+}
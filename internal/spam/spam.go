@@ -0,0 +1,57 @@
+// Package spam checks user submitted content against spam filtering services
+package spam
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/yourusername/go-enterprise-api/internal/config"
+)
+
+// Supported spam drivers
+const (
+	DriverNone    = "none"
+	DriverAkismet = "akismet"
+)
+
+// Content is a submission to check, with the context spam services use to classify it
+type Content struct {
+	// Type is the kind of content, e.g. "comment"
+	Type        string
+	Body        string
+	AuthorName  string
+	AuthorEmail string
+	// IP, UserAgent and Referrer describe the request that submitted the content
+	IP        string
+	UserAgent string
+	Referrer  string
+	// Permalink is the URL of the page the content was submitted to
+	Permalink string
+}
+
+// Checker classifies content as spam or not
+type Checker interface {
+	// IsSpam reports whether content is spam
+	IsSpam(ctx context.Context, content *Content) (bool, error)
+	// Name returns the backend name
+	Name() string
+}
+
+// New creates a Checker for the configured driver.
+// It returns nil for the none driver, in which case no spam checks are made.
+func New(cfg *config.SpamConfig) (Checker, error) {
+	timeout := cfg.Timeout
+	if timeout <= 0 {
+		timeout = 5 * time.Second
+	}
+
+	switch cfg.Driver {
+	case "", DriverNone:
+		return nil, nil
+	case DriverAkismet:
+		return NewAkismetChecker(cfg.URL, cfg.APIKey, cfg.SiteURL, timeout), nil
+	default:
+		return nil, fmt.Errorf("unsupported spam driver: %s", cfg.Driver)
+	}
+}
@@ -0,0 +1,123 @@
+package staticsite
+
+import (
+	"html"
+	"net/url"
+	"strings"
+
+	xhtml "golang.org/x/net/html"
+)
+
+// allowedTags are the elements kept in post content, with the attributes
+// kept on each
+var allowedTags = map[string][]string{
+	"p": nil, "br": nil, "hr": nil, "div": nil, "span": nil,
+	"h1": nil, "h2": nil, "h3": nil, "h4": nil, "h5": nil, "h6": nil,
+	"strong": nil, "b": nil, "em": nil, "i": nil, "u": nil, "s": nil, "del": nil,
+	"sub": nil, "sup": nil, "blockquote": nil, "pre": nil, "code": nil,
+	"ul": nil, "ol": nil, "li": nil,
+	"figure": nil, "figcaption": nil,
+	"table": nil, "thead": nil, "tbody": nil, "tr": nil,
+	"th":  {"colspan", "rowspan"},
+	"td":  {"colspan", "rowspan"},
+	"a":   {"href", "title"},
+	"img": {"src", "alt", "title", "width", "height"},
+}
+
+// droppedTags are removed along with everything inside them
+var droppedTags = map[string]bool{
+	"script": true, "style": true, "iframe": true, "object": true, "embed": true,
+	"template": true, "noscript": true, "svg": true, "math": true, "form": true,
+}
+
+// urlAttributes hold URLs, which must use an allowed scheme
+var urlAttributes = map[string]bool{"href": true, "src": true}
+
+// allowedSchemes are the URL schemes kept in links and images; URLs without
+// a scheme are relative and always kept
+var allowedSchemes = map[string]bool{"http": true, "https": true, "mailto": true}
+
+// sanitize reduces post content to a safe subset of HTML: scripts, styles,
+// event handlers and URLs with other schemes are removed, and links are
+// marked nofollow. The static site is served without the API in front of
+// it, so content cannot be trusted to have been cleaned on the way in.
+func sanitize(content string) string {
+	var b strings.Builder
+	z := xhtml.NewTokenizer(strings.NewReader(content))
+	skipping := ""
+	depth := 0
+
+	for {
+		tt := z.Next()
+		if tt == xhtml.ErrorToken {
+			// io.EOF or malformed input; either way the content ends here
+			return b.String()
+		}
+		token := z.Token()
+
+		if skipping != "" {
+			switch {
+			case tt == xhtml.StartTagToken && token.Data == skipping:
+				depth++
+			case tt == xhtml.EndTagToken && token.Data == skipping:
+				depth--
+				if depth == 0 {
+					skipping = ""
+				}
+			}
+			continue
+		}
+
+		switch tt {
+		case xhtml.TextToken:
+			b.WriteString(html.EscapeString(token.Data))
+		case xhtml.StartTagToken, xhtml.SelfClosingTagToken:
+			if droppedTags[token.Data] {
+				if tt == xhtml.StartTagToken {
+					skipping, depth = token.Data, 1
+				}
+				continue
+			}
+			attrs, ok := allowedTags[token.Data]
+			if !ok {
+				continue
+			}
+			b.WriteString("<" + token.Data)
+			for _, attr := range token.Attr {
+				if attr.Namespace != "" || !contains(attrs, attr.Key) {
+					continue
+				}
+				if urlAttributes[attr.Key] && !safeURL(attr.Val) {
+					continue
+				}
+				b.WriteString(" " + attr.Key + `="` + html.EscapeString(attr.Val) + `"`)
+			}
+			if token.Data == "a" {
+				b.WriteString(` rel="nofollow noopener"`)
+			}
+			b.WriteString(">")
+		case xhtml.EndTagToken:
+			if _, ok := allowedTags[token.Data]; ok {
+				b.WriteString("</" + token.Data + ">")
+			}
+		}
+	}
+}
+
+// safeURL reports whether a URL is relative or uses an allowed scheme
+func safeURL(raw string) bool {
+	u, err := url.Parse(strings.TrimSpace(raw))
+	if err != nil {
+		return false
+	}
+	return u.Scheme == "" || allowedSchemes[strings.ToLower(u.Scheme)]
+}
+
+func contains(values []string, value string) bool {
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,379 @@
+// Package staticsite renders published content into a static website: a
+// paginated home page, one page per post and a page per tag and author. The
+// pages link to each other with relative URLs, so the site works from any
+// directory of any static file host, or straight from disk.
+//
+// A manifest.json lists the files of the site, so that files of an earlier
+// export that are no longer part of the site can be removed.
+package staticsite
+
+import (
+	"bytes"
+	"context"
+	"embed"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"html/template"
+	"sort"
+	"strconv"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/yourusername/go-enterprise-api/internal/models"
+	"github.com/yourusername/go-enterprise-api/internal/storage"
+)
+
+// FileManifest is the file listing the files of the site
+const FileManifest = "manifest.json"
+
+// Page templates
+const (
+	templateIndex  = "index"
+	templatePost   = "post"
+	templateTag    = "tag"
+	templateAuthor = "author"
+)
+
+// pageRoot is the path from a page below the home page back to the root of
+// the site; all such pages are two directories deep
+const pageRoot = "../../"
+
+//go:embed templates/*.html
+var templateFS embed.FS
+
+// Manifest lists the files of an exported site
+type Manifest struct {
+	GeneratedAt time.Time `json:"generated_at"`
+	Files       []string  `json:"files"`
+}
+
+// Report summarizes an export
+type Report struct {
+	Prefix      string    `json:"prefix"`
+	Storage     string    `json:"storage"`
+	Posts       int       `json:"posts"`
+	Tags        int       `json:"tags"`
+	Authors     int       `json:"authors"`
+	Files       int       `json:"files"`
+	Removed     int       `json:"removed"`
+	GeneratedAt time.Time `json:"generated_at"`
+}
+
+// PostSummary is a post as listed on the home, tag and author pages
+type PostSummary struct {
+	Title       string
+	Slug        string
+	Excerpt     string
+	ReadingTime int
+	CreatedAt   time.Time
+	Author      *AuthorLink
+	Tags        []TagLink
+}
+
+// AuthorLink links to an author page
+type AuthorLink struct {
+	ID   uuid.UUID
+	Name string
+}
+
+// TagLink links to a tag page
+type TagLink struct {
+	Name string
+	Slug string
+}
+
+// indexPage is the data of a home page
+type indexPage struct {
+	Page    int
+	Posts   []*PostSummary
+	PrevURL string
+	NextURL string
+}
+
+// postPage is the data of a post page
+type postPage struct {
+	Summary          *PostSummary
+	FeaturedImage    string
+	FeaturedImageAlt string
+	Content          template.HTML
+	License          *models.LicenseResponse
+}
+
+// tagPage is the data of a tag page
+type tagPage struct {
+	Name        string
+	Slug        string
+	Description string
+	Posts       []*PostSummary
+}
+
+// authorPage is the data of an author page
+type authorPage struct {
+	Name  string
+	Bio   string
+	Posts []*PostSummary
+}
+
+// pageData is what templates are executed with. Root is the relative path
+// from the page to the root of the site; SiteName is the site title of the
+// branding.
+type pageData struct {
+	SiteName    string
+	Brand       *models.Branding
+	Root        string
+	GeneratedAt time.Time
+	Data        interface{}
+}
+
+// Builder renders a site and stores its files under a prefix. Posts are
+// rendered as they are added, so only their summaries are kept in memory;
+// the listing pages are rendered by Finish.
+type Builder struct {
+	store       storage.Storage
+	prefix      string
+	brand       *models.Branding
+	pageSize    int
+	generatedAt time.Time
+	templates   map[string]*template.Template
+
+	posts   []*PostSummary
+	tags    map[uuid.UUID]*tagPage
+	authors map[uuid.UUID]*authorPage
+	files   []string
+}
+
+// NewBuilder creates a builder storing the site under prefix in store, with
+// the title, logo, colors and footer links of brand. The home page lists
+// pageSize posts per page.
+func NewBuilder(store storage.Storage, prefix string, brand *models.Branding, pageSize int) (*Builder, error) {
+	templates, err := parseTemplates()
+	if err != nil {
+		return nil, err
+	}
+	return &Builder{
+		store:       store,
+		prefix:      prefix,
+		brand:       brand,
+		pageSize:    pageSize,
+		generatedAt: time.Now().UTC(),
+		templates:   templates,
+		tags:        make(map[uuid.UUID]*tagPage),
+		authors:     make(map[uuid.UUID]*authorPage),
+	}, nil
+}
+
+// parseTemplates parses the built-in page templates
+func parseTemplates() (map[string]*template.Template, error) {
+	funcs := template.FuncMap{
+		"date": func(t time.Time) string {
+			return t.Format("January 2, 2006")
+		},
+		"meta": func(root string, post *PostSummary) map[string]interface{} {
+			return map[string]interface{}{"Root": root, "Post": post}
+		},
+		"summaries": func(root string, posts []*PostSummary) map[string]interface{} {
+			return map[string]interface{}{"Root": root, "Posts": posts}
+		},
+	}
+
+	templates := make(map[string]*template.Template)
+	for _, name := range []string{templateIndex, templatePost, templateTag, templateAuthor} {
+		tmpl, err := template.New(name).Funcs(funcs).ParseFS(templateFS, "templates/layout.html", "templates/"+name+".html")
+		if err != nil {
+			return nil, fmt.Errorf("parse %s template: %w", name, err)
+		}
+		templates[name] = tmpl
+	}
+	return templates, nil
+}
+
+// AddPost renders the page of a post and adds it to the listings. The post
+// must be loaded with its author and tags; the author is only linked when
+// their profile is public.
+func (b *Builder) AddPost(ctx context.Context, post *models.Post) error {
+	summary := &PostSummary{
+		Title:       post.Title,
+		Slug:        post.Slug,
+		Excerpt:     post.Excerpt,
+		ReadingTime: post.ReadingTime,
+		CreatedAt:   post.CreatedAt,
+	}
+
+	if author := post.User; author != nil && author.HasPublicProfile() {
+		summary.Author = &AuthorLink{ID: author.ID, Name: author.FullName()}
+		page, ok := b.authors[author.ID]
+		if !ok {
+			page = &authorPage{Name: author.FullName(), Bio: author.Bio}
+			b.authors[author.ID] = page
+		}
+		page.Posts = append(page.Posts, summary)
+	}
+
+	for _, tag := range post.Tags {
+		summary.Tags = append(summary.Tags, TagLink{Name: tag.Name, Slug: tag.Slug})
+		page, ok := b.tags[tag.ID]
+		if !ok {
+			page = &tagPage{Name: tag.Name, Slug: tag.Slug, Description: tag.Description}
+			b.tags[tag.ID] = page
+		}
+		page.Posts = append(page.Posts, summary)
+	}
+
+	b.posts = append(b.posts, summary)
+	return b.render(ctx, "posts/"+post.Slug+"/index.html", templatePost, pageRoot, &postPage{
+		Summary:          summary,
+		FeaturedImage:    post.FeaturedImage,
+		FeaturedImageAlt: post.FeaturedImageAlt,
+		// The content is cleaned to a safe subset of HTML before it is trusted
+		Content: template.HTML(sanitize(post.Content)),
+		License: post.License.ToResponse(post.LicenseName, post.LicenseURL),
+	})
+}
+
+// Finish renders the home, tag and author pages, stores the manifest and
+// removes the files of the previous export that are no longer part of the
+// site
+func (b *Builder) Finish(ctx context.Context) (*Report, error) {
+	sortNewestFirst(b.posts)
+
+	pages := (len(b.posts) + b.pageSize - 1) / b.pageSize
+	if pages == 0 {
+		pages = 1
+	}
+	for page := 1; page <= pages; page++ {
+		start := (page - 1) * b.pageSize
+		end := start + b.pageSize
+		if end > len(b.posts) {
+			end = len(b.posts)
+		}
+
+		data := &indexPage{Page: page, Posts: b.posts[start:end]}
+		if page > 1 {
+			data.PrevURL = indexPath(page - 1)
+		}
+		if page < pages {
+			data.NextURL = indexPath(page + 1)
+		}
+
+		root := pageRoot
+		if page == 1 {
+			root = ""
+		}
+		if err := b.render(ctx, indexPath(page), templateIndex, root, data); err != nil {
+			return nil, err
+		}
+	}
+
+	for _, tag := range b.tags {
+		sortNewestFirst(tag.Posts)
+		if err := b.render(ctx, "tags/"+tag.Slug+"/index.html", templateTag, pageRoot, tag); err != nil {
+			return nil, err
+		}
+	}
+
+	for id, author := range b.authors {
+		sortNewestFirst(author.Posts)
+		if err := b.render(ctx, "authors/"+id.String()+"/index.html", templateAuthor, pageRoot, author); err != nil {
+			return nil, err
+		}
+	}
+
+	removed, err := b.replaceManifest(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Report{
+		Prefix:      b.prefix,
+		Storage:     b.store.Name(),
+		Posts:       len(b.posts),
+		Tags:        len(b.tags),
+		Authors:     len(b.authors),
+		Files:       len(b.files),
+		Removed:     removed,
+		GeneratedAt: b.generatedAt,
+	}, nil
+}
+
+// replaceManifest removes the files listed by the previous manifest that
+// were not written again and stores the manifest of this export. The old
+// manifest is only replaced once its stale files are gone, so a failed
+// cleanup is finished by the next export. It returns how many files were
+// removed.
+func (b *Builder) replaceManifest(ctx context.Context) (int, error) {
+	var previous Manifest
+	data, err := b.store.Get(ctx, b.key(FileManifest))
+	switch {
+	case errors.Is(err, storage.ErrNotFound):
+	case err != nil:
+		return 0, fmt.Errorf("read previous manifest: %w", err)
+	default:
+		if err := json.Unmarshal(data, &previous); err != nil {
+			return 0, fmt.Errorf("read previous manifest: %w", err)
+		}
+	}
+
+	current := make(map[string]bool, len(b.files))
+	for _, file := range b.files {
+		current[file] = true
+	}
+	removed := 0
+	for _, file := range previous.Files {
+		if current[file] {
+			continue
+		}
+		if err := b.store.Delete(ctx, b.key(file)); err != nil {
+			return removed, fmt.Errorf("remove %s: %w", file, err)
+		}
+		removed++
+	}
+
+	data, err = json.MarshalIndent(Manifest{GeneratedAt: b.generatedAt, Files: b.files}, "", "  ")
+	if err != nil {
+		return removed, err
+	}
+	return removed, b.store.Put(ctx, b.key(FileManifest), data, "application/json")
+}
+
+// render executes a page template and stores the page at path, relative to
+// the root of the site
+func (b *Builder) render(ctx context.Context, path, name, root string, data interface{}) error {
+	var buf bytes.Buffer
+	err := b.templates[name].ExecuteTemplate(&buf, "layout", pageData{
+		SiteName:    b.brand.SiteTitle,
+		Brand:       b.brand,
+		Root:        root,
+		GeneratedAt: b.generatedAt,
+		Data:        data,
+	})
+	if err != nil {
+		return fmt.Errorf("render %s: %w", path, err)
+	}
+
+	if err := b.store.Put(ctx, b.key(path), buf.Bytes(), "text/html; charset=utf-8"); err != nil {
+		return fmt.Errorf("store %s: %w", path, err)
+	}
+	b.files = append(b.files, path)
+	return nil
+}
+
+// key returns the storage key of a file of the site
+func (b *Builder) key(path string) string {
+	return storage.Join(b.prefix, path)
+}
+
+// indexPath returns the path of a page of the home page
+func indexPath(page int) string {
+	if page == 1 {
+		return "index.html"
+	}
+	return "page/" + strconv.Itoa(page) + "/index.html"
+}
+
+// sortNewestFirst orders posts by creation time, newest first
+func sortNewestFirst(posts []*PostSummary) {
+	sort.SliceStable(posts, func(i, j int) bool {
+		return posts[i].CreatedAt.After(posts[j].CreatedAt)
+	})
+}
@@ -0,0 +1,139 @@
+package storage
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/yourusername/go-enterprise-api/pkg/awsv4"
+)
+
+// maxS3ObjectSize bounds the size of an object read back from the bucket
+const maxS3ObjectSize = 64 << 20
+
+// S3Storage stores files as objects in an S3 bucket. Objects are addressed
+// path-style, so S3-compatible services such as MinIO or Cloudflare R2 work
+// when given their endpoint.
+type S3Storage struct {
+	endpoint string
+	bucket   string
+	region   string
+	creds    awsv4.Credentials
+	client   *http.Client
+}
+
+// NewS3Storage creates a new S3 storage. An empty endpoint uses the regional
+// S3 API.
+func NewS3Storage(endpoint, bucket, region, accessKey, secretKey string, timeout time.Duration) *S3Storage {
+	if endpoint == "" {
+		endpoint = "https://s3." + region + ".amazonaws.com"
+	}
+	return &S3Storage{
+		endpoint: strings.TrimRight(endpoint, "/"),
+		bucket:   bucket,
+		region:   region,
+		creds:    awsv4.Credentials{AccessKey: accessKey, SecretKey: secretKey},
+		client:   &http.Client{Timeout: timeout},
+	}
+}
+
+// Name returns the driver name
+func (s *S3Storage) Name() string {
+	return DriverS3
+}
+
+// Put uploads data as the object for key
+func (s *S3Storage) Put(ctx context.Context, key string, data []byte, contentType string) error {
+	resp, err := s.do(ctx, http.MethodPut, key, data, contentType)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	return checkS3Response(resp)
+}
+
+// Get downloads the object for key
+func (s *S3Storage) Get(ctx context.Context, key string) ([]byte, error) {
+	resp, err := s.do(ctx, http.MethodGet, key, nil, "")
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode == http.StatusNotFound {
+		return nil, ErrNotFound
+	}
+	if err := checkS3Response(resp); err != nil {
+		return nil, err
+	}
+	return io.ReadAll(io.LimitReader(resp.Body, maxS3ObjectSize))
+}
+
+// Delete removes the object for key. S3 reports success for missing objects.
+func (s *S3Storage) Delete(ctx context.Context, key string) error {
+	resp, err := s.do(ctx, http.MethodDelete, key, nil, "")
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode == http.StatusNotFound {
+		return nil
+	}
+	return checkS3Response(resp)
+}
+
+// do sends a signed request for the object under key
+func (s *S3Storage) do(ctx context.Context, method, key string, body []byte, contentType string) (*http.Response, error) {
+	if err := checkKey(key); err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, method, s.endpoint+"/"+escapeS3Path(s.bucket)+"/"+escapeS3Path(key), bytes.NewReader(body))
+	if err != nil {
+		return nil, err
+	}
+	if contentType == "" {
+		contentType = "application/octet-stream"
+	}
+	req.Header.Set("Content-Type", contentType)
+	if err := awsv4.Sign(req, body, "s3", s.region, s.creds, time.Now().UTC()); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("s3 request failed: %w", err)
+	}
+	return resp, nil
+}
+
+// checkS3Response turns an unsuccessful response into an error
+func checkS3Response(resp *http.Response) error {
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		msg, _ := io.ReadAll(io.LimitReader(resp.Body, 1024))
+		return fmt.Errorf("s3 returned %d: %s", resp.StatusCode, strings.TrimSpace(string(msg)))
+	}
+	return nil
+}
+
+// escapeS3Path percent-encodes everything but unreserved characters and
+// slashes, matching the canonical URI S3 signs
+func escapeS3Path(p string) string {
+	var b strings.Builder
+	for i := 0; i < len(p); i++ {
+		c := p[i]
+		switch {
+		case 'a' <= c && c <= 'z', 'A' <= c && c <= 'Z', '0' <= c && c <= '9',
+			c == '-', c == '_', c == '.', c == '~', c == '/':
+			b.WriteByte(c)
+		default:
+			fmt.Fprintf(&b, "%%%02X", c)
+		}
+	}
+	return b.String()
+}
@@ -0,0 +1,65 @@
+// Package storage stores generated files, such as static site pages, on the
+// local filesystem or in an S3-compatible bucket.
+package storage
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"path"
+	"strings"
+
+	"github.com/yourusername/go-enterprise-api/internal/config"
+)
+
+// Supported storage drivers
+const (
+	DriverLocal = "local"
+	DriverS3    = "s3"
+)
+
+var (
+	// ErrNotFound is returned when reading a key that holds no file
+	ErrNotFound = errors.New("file not found")
+
+	// ErrInvalidKey is returned for keys that are empty, absolute or
+	// escape their root with ".." segments
+	ErrInvalidKey = errors.New("invalid storage key")
+)
+
+// Storage stores files under slash-separated keys
+type Storage interface {
+	// Put stores data under key, replacing any file already there
+	Put(ctx context.Context, key string, data []byte, contentType string) error
+	// Get reads the file under key, returning ErrNotFound if there is none
+	Get(ctx context.Context, key string) ([]byte, error)
+	// Delete removes the file under key; deleting a missing file succeeds
+	Delete(ctx context.Context, key string) error
+	// Name returns the driver name
+	Name() string
+}
+
+// New creates a Storage for the configured driver
+func New(cfg *config.StorageConfig) (Storage, error) {
+	switch cfg.Driver {
+	case "", DriverLocal:
+		return NewLocalStorage(cfg.Path), nil
+	case DriverS3:
+		return NewS3Storage(cfg.S3Endpoint, cfg.S3Bucket, cfg.S3Region, cfg.S3AccessKey, cfg.S3SecretKey, cfg.Timeout), nil
+	default:
+		return nil, fmt.Errorf("unsupported storage driver: %s", cfg.Driver)
+	}
+}
+
+// Join joins key segments with slashes
+func Join(segments ...string) string {
+	return path.Join(segments...)
+}
+
+// checkKey rejects keys that could address files outside the storage root
+func checkKey(key string) error {
+	if key == "" || strings.HasPrefix(key, "/") || path.Clean(key) != key || key == ".." || strings.HasPrefix(key, "../") {
+		return fmt.Errorf("%w: %q", ErrInvalidKey, key)
+	}
+	return nil
+}
@@ -0,0 +1,249 @@
+//go:build integration
+
+package testsupport_test
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/yourusername/go-enterprise-api/internal/testsupport"
+	"github.com/yourusername/go-enterprise-api/internal/testsupport/factory"
+	apperrors "github.com/yourusername/go-enterprise-api/pkg/errors"
+)
+
+// tokens is the token pair of auth responses
+type tokens struct {
+	Tokens struct {
+		AccessToken  string `json:"access_token"`
+		RefreshToken string `json:"refresh_token"`
+	} `json:"tokens"`
+}
+
+func TestRegister(t *testing.T) {
+	env := testsupport.Start(t, testsupport.Options{})
+
+	tests := []struct {
+		name   string
+		body   map[string]interface{}
+		status int
+		code   int
+	}{
+		{
+			name:   "valid",
+			body:   map[string]interface{}{"email": "new@example.com", "password": factory.Password, "first_name": "New", "last_name": "User"},
+			status: http.StatusCreated,
+		},
+		{
+			name:   "existing email",
+			body:   map[string]interface{}{"email": testsupport.UserEmail, "password": factory.Password, "first_name": "Uma", "last_name": "User"},
+			status: http.StatusConflict,
+			code:   apperrors.CodeEmailExists,
+		},
+		{
+			name:   "weak password",
+			body:   map[string]interface{}{"email": "weak@example.com", "password": "password", "first_name": "Weak", "last_name": "User"},
+			status: http.StatusBadRequest,
+			code:   apperrors.CodeValidationError,
+		},
+		{
+			name:   "missing name",
+			body:   map[string]interface{}{"email": "nameless@example.com", "password": factory.Password},
+			status: http.StatusBadRequest,
+			code:   apperrors.CodeValidationError,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			resp := env.Do(t, testsupport.Request{Method: http.MethodPost, Path: "/api/v1/auth/register", Body: tt.body})
+			if resp.Status != tt.status {
+				t.Fatalf("got %d, want %d: %+v", resp.Status, tt.status, resp.Error)
+			}
+			if tt.code != 0 && (resp.Error == nil || resp.Error.Code != tt.code) {
+				t.Errorf("got error %+v, want code %d", resp.Error, tt.code)
+			}
+		})
+	}
+
+	// The new account can sign in right away
+	env.Login(t, "new@example.com", factory.Password)
+}
+
+func TestLogin(t *testing.T) {
+	env := testsupport.Start(t, testsupport.Options{})
+
+	tests := []struct {
+		name     string
+		email    string
+		password string
+		status   int
+		code     int
+	}{
+		{"valid", testsupport.UserEmail, testsupport.FixturePassword, http.StatusOK, 0},
+		{"wrong password", testsupport.UserEmail, "Wrong-password1", http.StatusUnauthorized, apperrors.CodeInvalidCredentials},
+		{"unknown email", "nobody@example.com", testsupport.FixturePassword, http.StatusUnauthorized, apperrors.CodeInvalidCredentials},
+		{"invalid email", "not-an-email", testsupport.FixturePassword, http.StatusBadRequest, apperrors.CodeValidationError},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			resp := env.Do(t, testsupport.Request{
+				Method: http.MethodPost,
+				Path:   "/api/v1/auth/login",
+				Body:   map[string]string{"email": tt.email, "password": tt.password},
+			})
+			if resp.Status != tt.status {
+				t.Fatalf("got %d, want %d: %+v", resp.Status, tt.status, resp.Error)
+			}
+			if tt.code != 0 && (resp.Error == nil || resp.Error.Code != tt.code) {
+				t.Errorf("got error %+v, want code %d", resp.Error, tt.code)
+			}
+			if tt.status == http.StatusOK {
+				var data tokens
+				resp.DecodeData(t, &data)
+				if data.Tokens.AccessToken == "" || data.Tokens.RefreshToken == "" {
+					t.Errorf("got tokens %+v, want an access and a refresh token", data.Tokens)
+				}
+			}
+		})
+	}
+}
+
+func TestTokenLifecycle(t *testing.T) {
+	env := testsupport.Start(t, testsupport.Options{})
+
+	resp := env.Do(t, testsupport.Request{
+		Method: http.MethodPost,
+		Path:   "/api/v1/auth/login",
+		Body:   map[string]string{"email": testsupport.UserEmail, "password": testsupport.FixturePassword},
+	})
+	if resp.Status != http.StatusOK {
+		t.Fatalf("login failed with %d: %+v", resp.Status, resp.Error)
+	}
+	var login tokens
+	resp.DecodeData(t, &login)
+
+	var refreshed tokens
+	steps := []struct {
+		name   string
+		method string
+		path   string
+		token  func() string
+		body   func() interface{}
+		status int
+		after  func(resp *testsupport.Response)
+	}{
+		{
+			name:   "me without token",
+			method: http.MethodGet,
+			path:   "/api/v1/auth/me",
+			token:  func() string { return "" },
+			status: http.StatusUnauthorized,
+		},
+		{
+			name:   "me with malformed token",
+			method: http.MethodGet,
+			path:   "/api/v1/auth/me",
+			token:  func() string { return "not-a-token" },
+			status: http.StatusUnauthorized,
+		},
+		{
+			name:   "refresh token used as access token",
+			method: http.MethodGet,
+			path:   "/api/v1/auth/me",
+			token:  func() string { return login.Tokens.RefreshToken },
+			status: http.StatusUnauthorized,
+		},
+		{
+			name:   "me",
+			method: http.MethodGet,
+			path:   "/api/v1/auth/me",
+			token:  func() string { return login.Tokens.AccessToken },
+			status: http.StatusOK,
+			after: func(resp *testsupport.Response) {
+				var data struct {
+					User struct {
+						Email string `json:"email"`
+					} `json:"user"`
+				}
+				resp.DecodeData(t, &data)
+				if data.User.Email != testsupport.UserEmail {
+					t.Errorf("got user %q, want %q", data.User.Email, testsupport.UserEmail)
+				}
+			},
+		},
+		{
+			name:   "refresh",
+			method: http.MethodPost,
+			path:   "/api/v1/auth/refresh",
+			token:  func() string { return "" },
+			body:   func() interface{} { return map[string]string{"refresh_token": login.Tokens.RefreshToken} },
+			status: http.StatusOK,
+			after:  func(resp *testsupport.Response) { resp.DecodeData(t, &refreshed) },
+		},
+		{
+			name:   "logout",
+			method: http.MethodPost,
+			path:   "/api/v1/auth/logout",
+			token:  func() string { return refreshed.Tokens.AccessToken },
+			status: http.StatusOK,
+		},
+		{
+			name:   "refresh after logout",
+			method: http.MethodPost,
+			path:   "/api/v1/auth/refresh",
+			token:  func() string { return "" },
+			body:   func() interface{} { return map[string]string{"refresh_token": refreshed.Tokens.RefreshToken} },
+			status: http.StatusUnauthorized,
+		},
+	}
+	// The steps share the tokens, so they run in order and stop at the first
+	// failure
+	for _, step := range steps {
+		req := testsupport.Request{Method: step.method, Path: step.path, Token: step.token()}
+		if step.body != nil {
+			req.Body = step.body()
+		}
+		resp := env.Do(t, req)
+		if resp.Status != step.status {
+			t.Fatalf("%s: got %d, want %d: %+v", step.name, resp.Status, step.status, resp.Error)
+		}
+		if step.after != nil {
+			step.after(resp)
+		}
+	}
+}
+
+func TestChangePassword(t *testing.T) {
+	env := testsupport.Start(t, testsupport.Options{})
+	user := factory.New(t, env.DB.DB).User()
+	token := env.Login(t, user.Email, factory.Password)
+
+	tests := []struct {
+		name        string
+		oldPassword string
+		newPassword string
+		status      int
+		code        int
+	}{
+		{"wrong old password", "Wrong-password1", "N3w-password!", http.StatusBadRequest, apperrors.CodeInvalidPassword},
+		{"weak new password", factory.Password, "password", http.StatusBadRequest, apperrors.CodeValidationError},
+		{"valid", factory.Password, "N3w-password!", http.StatusOK, 0},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			resp := env.Do(t, testsupport.Request{
+				Method: http.MethodPost,
+				Path:   "/api/v1/auth/change-password",
+				Token:  token,
+				Body:   map[string]string{"old_password": tt.oldPassword, "new_password": tt.newPassword},
+			})
+			if resp.Status != tt.status {
+				t.Fatalf("got %d, want %d: %+v", resp.Status, tt.status, resp.Error)
+			}
+			if tt.code != 0 && (resp.Error == nil || resp.Error.Code != tt.code) {
+				t.Errorf("got error %+v, want code %d", resp.Error, tt.code)
+			}
+		})
+	}
+
+	env.Login(t, user.Email, "N3w-password!")
+}
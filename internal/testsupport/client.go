@@ -0,0 +1,110 @@
+package testsupport
+
+import (
+	"bytes"
+	"encoding/json"
+	"io"
+	"net/http"
+	"testing"
+
+	"github.com/yourusername/go-enterprise-api/pkg/response"
+)
+
+// Response is a decoded response of the API
+type Response struct {
+	Status  int
+	Header  http.Header
+	Success bool
+	Message string
+	// Data is decoded by DecodeData
+	Data  json.RawMessage
+	Error *response.ErrorInfo
+}
+
+// Request describes a request to the API. Body, unless nil, is sent as
+// JSON, and Token as a bearer token.
+type Request struct {
+	Method string
+	Path   string
+	Token  string
+	Body   interface{}
+	Header http.Header
+}
+
+// Do sends a request to the API and decodes its response. Responses that
+// are not JSON, such as the metrics, leave the decoded fields empty.
+func (e *Env) Do(t *testing.T, req Request) *Response {
+	t.Helper()
+
+	var body io.Reader
+	if req.Body != nil {
+		encoded, err := json.Marshal(req.Body)
+		if err != nil {
+			t.Fatalf("failed to encode the request body: %v", err)
+		}
+		body = bytes.NewReader(encoded)
+	}
+
+	httpReq, err := http.NewRequest(req.Method, e.URL(req.Path), body)
+	if err != nil {
+		t.Fatalf("failed to create the request: %v", err)
+	}
+	for key, values := range req.Header {
+		httpReq.Header[key] = values
+	}
+	if req.Body != nil {
+		httpReq.Header.Set("Content-Type", "application/json")
+	}
+	if req.Token != "" {
+		httpReq.Header.Set("Authorization", "Bearer "+req.Token)
+	}
+
+	resp, err := e.Server.Client().Do(httpReq)
+	if err != nil {
+		t.Fatalf("%s %s failed: %v", req.Method, req.Path, err)
+	}
+	defer resp.Body.Close()
+
+	raw, err := io.ReadAll(resp.Body)
+	if err != nil {
+		t.Fatalf("failed to read the response of %s %s: %v", req.Method, req.Path, err)
+	}
+
+	decoded := &Response{Status: resp.StatusCode, Header: resp.Header}
+	if len(raw) > 0 && json.Valid(raw) {
+		if err := json.Unmarshal(raw, decoded); err != nil {
+			t.Fatalf("failed to decode the response of %s %s: %v", req.Method, req.Path, err)
+		}
+	}
+	return decoded
+}
+
+// DecodeData decodes the data of a response into v
+func (r *Response) DecodeData(t *testing.T, v interface{}) {
+	t.Helper()
+	if err := json.Unmarshal(r.Data, v); err != nil {
+		t.Fatalf("failed to decode the response data %s: %v", r.Data, err)
+	}
+}
+
+// Login logs in with an email and password and returns the access token
+func (e *Env) Login(t *testing.T, email, password string) string {
+	t.Helper()
+
+	resp := e.Do(t, Request{
+		Method: http.MethodPost,
+		Path:   "/api/v1/auth/login",
+		Body:   map[string]string{"email": email, "password": password},
+	})
+	if resp.Status != http.StatusOK {
+		t.Fatalf("login of %s failed with %d: %+v", email, resp.Status, resp.Error)
+	}
+
+	var data struct {
+		Tokens struct {
+			AccessToken string `json:"access_token"`
+		} `json:"tokens"`
+	}
+	resp.DecodeData(t, &data)
+	return data.Tokens.AccessToken
+}
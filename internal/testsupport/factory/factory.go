@@ -0,0 +1,320 @@
+// Package factory creates valid records for tests, so each test states only
+// the fields it is about. Every record is persisted when it is created, and
+// unique fields get random values, so records of different tests sharing a
+// database do not collide.
+//
+// Usage:
+//
+//	f := factory.New(t, env.DB.DB)
+//	author := f.User(factory.WithRole(models.RoleModerator))
+//	post := f.Post(factory.WithStatus(models.PostStatusPublished), factory.WithAuthor(author))
+package factory
+
+import (
+	"context"
+	"fmt"
+	"math/rand"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/yourusername/go-enterprise-api/internal/models"
+	"gorm.io/gorm"
+)
+
+// Password is the password of the users created by a factory
+const Password = "Password123!"
+
+// words are the words random titles, names and content are made of
+var words = []string{
+	"amber", "bridge", "cedar", "delta", "ember", "falcon", "garden", "harbor",
+	"island", "juniper", "kettle", "lantern", "meadow", "nectar", "orchid", "pepper",
+	"quartz", "river", "summit", "timber", "umber", "valley", "willow", "zephyr",
+}
+
+// Factory creates records in a database, failing the test when a record
+// cannot be created
+type Factory struct {
+	t   testing.TB
+	db  *gorm.DB
+	ctx context.Context
+}
+
+// New creates a factory writing to db on behalf of t
+func New(t testing.TB, db *gorm.DB) *Factory {
+	return &Factory{t: t, db: db, ctx: context.Background()}
+}
+
+// WithContext returns a factory creating records with ctx. With tenancy
+// enabled, records of tenant scoped models created with a context from
+// repository.WithTenant belong to its organization.
+func (f *Factory) WithContext(ctx context.Context) *Factory {
+	return &Factory{t: f.t, db: f.db, ctx: ctx}
+}
+
+// UserOption overrides a default of a user
+type UserOption func(user *models.User)
+
+// WithEmail sets the email of a user
+func WithEmail(email string) UserOption {
+	return func(user *models.User) {
+		user.Email = email
+	}
+}
+
+// WithPassword sets the plain password of a user
+func WithPassword(password string) UserOption {
+	return func(user *models.User) {
+		user.Password = password
+	}
+}
+
+// WithName sets the first and last name of a user
+func WithName(firstName, lastName string) UserOption {
+	return func(user *models.User) {
+		user.FirstName = firstName
+		user.LastName = lastName
+	}
+}
+
+// WithRole sets the role of a user
+func WithRole(role models.UserRole) UserOption {
+	return func(user *models.User) {
+		user.Role = role
+	}
+}
+
+// WithUserStatus sets the status of a user
+func WithUserStatus(status models.UserStatus) UserOption {
+	return func(user *models.User) {
+		user.Status = status
+	}
+}
+
+// Unverified leaves the email of a user unverified
+func Unverified() UserOption {
+	return func(user *models.User) {
+		user.EmailVerifiedAt = nil
+	}
+}
+
+// User creates a user. By default it is an active, verified, human user
+// with a random name and email, and Password as password.
+func (f *Factory) User(opts ...UserOption) *models.User {
+	f.t.Helper()
+
+	now := time.Now().UTC()
+	firstName, lastName := title(word()), title(word())
+	user := &models.User{
+		Email:           fmt.Sprintf("%s.%s.%s@example.com", strings.ToLower(firstName), strings.ToLower(lastName), suffix()),
+		Password:        Password,
+		FirstName:       firstName,
+		LastName:        lastName,
+		Role:            models.RoleUser,
+		Status:          models.StatusActive,
+		Type:            models.UserTypeHuman,
+		EmailVerifiedAt: &now,
+	}
+	for _, opt := range opts {
+		opt(user)
+	}
+	f.create(user)
+	return user
+}
+
+// PostOption overrides a default of a post
+type PostOption func(post *models.Post)
+
+// WithStatus sets the status of a post
+func WithStatus(status models.PostStatus) PostOption {
+	return func(post *models.Post) {
+		post.Status = status
+	}
+}
+
+// WithAuthor sets the author of a post
+func WithAuthor(author *models.User) PostOption {
+	return func(post *models.Post) {
+		post.UserID = author.ID
+		post.User = author
+	}
+}
+
+// WithTitle sets the title of a post, and the slug derived from it
+func WithTitle(title string) PostOption {
+	return func(post *models.Post) {
+		post.Title = title
+		post.Slug = fmt.Sprintf("%s-%s", slugify(title), suffix())
+	}
+}
+
+// WithContent sets the content of a post
+func WithContent(content string) PostOption {
+	return func(post *models.Post) {
+		post.Content = content
+	}
+}
+
+// WithTags sets the tags of a post
+func WithTags(tags ...*models.Tag) PostOption {
+	return func(post *models.Post) {
+		post.Tags = post.Tags[:0]
+		for _, tag := range tags {
+			post.Tags = append(post.Tags, *tag)
+		}
+	}
+}
+
+// Post creates a post. By default it is a draft with a random title and
+// content, written by a new user.
+func (f *Factory) Post(opts ...PostOption) *models.Post {
+	f.t.Helper()
+
+	post := &models.Post{
+		Content: sentence(40),
+		Excerpt: sentence(12),
+		Status:  models.PostStatusDraft,
+	}
+	WithTitle(title(sentence(4)))(post)
+	for _, opt := range opts {
+		opt(post)
+	}
+	if post.User == nil && post.UserID == uuid.Nil {
+		WithAuthor(f.User())(post)
+	}
+
+	// The author is already persisted; only the tags are linked
+	author := post.User
+	post.User = nil
+	f.create(post)
+	post.User = author
+	return post
+}
+
+// TagOption overrides a default of a tag
+type TagOption func(tag *models.Tag)
+
+// WithTagName sets the name of a tag, and the slug derived from it
+func WithTagName(name string) TagOption {
+	return func(tag *models.Tag) {
+		tag.Name = name
+		tag.Slug = slugify(name)
+	}
+}
+
+// Tag creates a tag with a random name
+func (f *Factory) Tag(opts ...TagOption) *models.Tag {
+	f.t.Helper()
+
+	tag := &models.Tag{Description: sentence(8)}
+	WithTagName(fmt.Sprintf("%s %s", word(), suffix()))(tag)
+	for _, opt := range opts {
+		opt(tag)
+	}
+	f.create(tag)
+	return tag
+}
+
+// CommentOption overrides a default of a comment
+type CommentOption func(comment *models.Comment)
+
+// OnPost sets the post a comment is on
+func OnPost(post *models.Post) CommentOption {
+	return func(comment *models.Comment) {
+		comment.PostID = post.ID
+	}
+}
+
+// WithCommenter sets the author of a comment
+func WithCommenter(user *models.User) CommentOption {
+	return func(comment *models.Comment) {
+		comment.UserID = user.ID
+	}
+}
+
+// WithCommentStatus sets the moderation status of a comment
+func WithCommentStatus(status models.CommentStatus) CommentOption {
+	return func(comment *models.Comment) {
+		comment.Status = status
+	}
+}
+
+// Comment creates a comment. By default it is an approved comment with
+// random content by a new user on a new published post.
+func (f *Factory) Comment(opts ...CommentOption) *models.Comment {
+	f.t.Helper()
+
+	comment := &models.Comment{
+		Content: sentence(15),
+		Status:  models.CommentStatusApproved,
+	}
+	for _, opt := range opts {
+		opt(comment)
+	}
+	if comment.PostID == uuid.Nil {
+		comment.PostID = f.Post(WithStatus(models.PostStatusPublished)).ID
+	}
+	if comment.UserID == uuid.Nil {
+		comment.UserID = f.User().ID
+	}
+	if comment.Status != models.CommentStatusPending && comment.ModeratedAt == nil {
+		now := time.Now().UTC()
+		comment.ModeratedAt = &now
+	}
+	f.create(comment)
+	return comment
+}
+
+// create persists a record or fails the test
+func (f *Factory) create(record interface{}) {
+	f.t.Helper()
+	if err := f.db.WithContext(f.ctx).Create(record).Error; err != nil {
+		f.t.Fatalf("factory: failed to create %T: %v", record, err)
+	}
+}
+
+// word returns a random word
+func word() string {
+	return words[rand.Intn(len(words))]
+}
+
+// sentence returns n random words
+func sentence(n int) string {
+	parts := make([]string, n)
+	for i := range parts {
+		parts[i] = word()
+	}
+	return strings.Join(parts, " ")
+}
+
+// title capitalizes the first letter of s
+func title(s string) string {
+	if s == "" {
+		return s
+	}
+	return strings.ToUpper(s[:1]) + s[1:]
+}
+
+// suffix returns a random suffix making a value unique
+func suffix() string {
+	return strconv.FormatInt(rand.Int63n(1<<40), 36)
+}
+
+// slugify turns text into a slug
+func slugify(text string) string {
+	var b strings.Builder
+	dash := false
+	for _, r := range strings.ToLower(text) {
+		switch {
+		case r >= 'a' && r <= 'z', r >= '0' && r <= '9':
+			b.WriteRune(r)
+			dash = false
+		case !dash && b.Len() > 0:
+			b.WriteByte('-')
+			dash = true
+		}
+	}
+	return strings.TrimSuffix(b.String(), "-")
+}
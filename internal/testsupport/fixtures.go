@@ -0,0 +1,85 @@
+package testsupport
+
+import (
+	"context"
+	"time"
+
+	"github.com/yourusername/go-enterprise-api/internal/database"
+	"github.com/yourusername/go-enterprise-api/internal/models"
+	"github.com/yourusername/go-enterprise-api/internal/seed"
+	"github.com/yourusername/go-enterprise-api/internal/testsupport/factory"
+)
+
+// FixturePassword is the password of the fixture users, the same as the
+// password of the users created by factories
+const FixturePassword = factory.Password
+
+// Emails of the fixture users
+const (
+	AdminEmail = "admin@example.com"
+	UserEmail  = "user@example.com"
+)
+
+// Fixtures are the records every test environment starts with: an admin,
+// who wrote the demo posts, a regular user, and the demo tags and posts of
+// the seed command
+type Fixtures struct {
+	Admin *models.User
+	User  *models.User
+	Tags  []models.Tag
+	Posts []models.Post
+}
+
+// seedFixtures creates the fixtures
+func seedFixtures(ctx context.Context, db *database.Database) (*Fixtures, error) {
+	_, err := seed.Run(ctx, db.DB, seed.Options{
+		Admin: &seed.Admin{
+			Email:     AdminEmail,
+			Password:  FixturePassword,
+			FirstName: "Ada",
+			LastName:  "Admin",
+		},
+		Demo: true,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now().UTC()
+	user := &models.User{
+		Email:           UserEmail,
+		Password:        FixturePassword,
+		FirstName:       "Uma",
+		LastName:        "User",
+		Role:            models.RoleUser,
+		Status:          models.StatusActive,
+		Type:            models.UserTypeHuman,
+		EmailVerifiedAt: &now,
+	}
+	if err := db.DB.WithContext(ctx).Create(user).Error; err != nil {
+		return nil, err
+	}
+
+	fixtures := &Fixtures{User: user, Admin: &models.User{}}
+	tx := db.DB.WithContext(ctx)
+	if err := tx.Where("email = ?", AdminEmail).First(fixtures.Admin).Error; err != nil {
+		return nil, err
+	}
+	if err := tx.Order("slug").Find(&fixtures.Tags).Error; err != nil {
+		return nil, err
+	}
+	if err := tx.Preload("Tags").Order("slug").Find(&fixtures.Posts).Error; err != nil {
+		return nil, err
+	}
+	return fixtures, nil
+}
+
+// Post returns the fixture post with the given slug, or nil
+func (f *Fixtures) Post(slug string) *models.Post {
+	for i := range f.Posts {
+		if f.Posts[i].Slug == slug {
+			return &f.Posts[i]
+		}
+	}
+	return nil
+}
@@ -0,0 +1,143 @@
+//go:build integration
+
+package testsupport_test
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/yourusername/go-enterprise-api/internal/models"
+	"github.com/yourusername/go-enterprise-api/internal/testsupport"
+	"github.com/yourusername/go-enterprise-api/internal/testsupport/factory"
+	apperrors "github.com/yourusername/go-enterprise-api/pkg/errors"
+)
+
+func TestCreatePost(t *testing.T) {
+	env := testsupport.Start(t, testsupport.Options{})
+	token := env.Login(t, testsupport.UserEmail, testsupport.FixturePassword)
+
+	tests := []struct {
+		name   string
+		token  string
+		body   map[string]interface{}
+		status int
+		code   int
+	}{
+		{"anonymous", "", map[string]interface{}{"title": "Hello", "content": "Some content"}, http.StatusUnauthorized, apperrors.CodeUnauthorized},
+		{"missing title", token, map[string]interface{}{"content": "Some content"}, http.StatusBadRequest, apperrors.CodeValidationError},
+		{"blank content", token, map[string]interface{}{"title": "Hello", "content": "   "}, http.StatusBadRequest, apperrors.CodeValidationError},
+		{"unknown status", token, map[string]interface{}{"title": "Hello", "content": "Some content", "status": "secret"}, http.StatusBadRequest, apperrors.CodeValidationError},
+		{"draft", token, map[string]interface{}{"title": "A draft", "content": "Some content"}, http.StatusCreated, 0},
+		{"published", token, map[string]interface{}{"title": "Hello", "content": "Some content", "status": "published", "tags": []string{"go"}}, http.StatusCreated, 0},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			resp := env.Do(t, testsupport.Request{Method: http.MethodPost, Path: "/api/v1/posts", Token: tt.token, Body: tt.body})
+			if resp.Status != tt.status {
+				t.Fatalf("got %d, want %d: %+v", resp.Status, tt.status, resp.Error)
+			}
+			if tt.code != 0 && (resp.Error == nil || resp.Error.Code != tt.code) {
+				t.Errorf("got error %+v, want code %d", resp.Error, tt.code)
+			}
+			if tt.status != http.StatusCreated {
+				return
+			}
+
+			var data struct {
+				Post struct {
+					ID     uuid.UUID `json:"id"`
+					Title  string    `json:"title"`
+					Status string    `json:"status"`
+					Slug   string    `json:"slug"`
+				} `json:"post"`
+			}
+			resp.DecodeData(t, &data)
+			if data.Post.Title != tt.body["title"] || data.Post.Slug == "" {
+				t.Errorf("got post %+v, want the title %q and a slug", data.Post, tt.body["title"])
+			}
+			if want, ok := tt.body["status"]; ok && data.Post.Status != want {
+				t.Errorf("got status %q, want %q", data.Post.Status, want)
+			}
+		})
+	}
+}
+
+func TestViewPost(t *testing.T) {
+	env := testsupport.Start(t, testsupport.Options{})
+	f := factory.New(t, env.DB.DB)
+	author := f.User()
+	draft := f.Post(factory.WithAuthor(author))
+	published := f.Post(factory.WithAuthor(author), factory.WithStatus(models.PostStatusPublished))
+
+	authorToken := env.Login(t, author.Email, factory.Password)
+	userToken := env.Login(t, testsupport.UserEmail, testsupport.FixturePassword)
+	adminToken := env.Login(t, testsupport.AdminEmail, testsupport.FixturePassword)
+
+	tests := []struct {
+		name   string
+		path   string
+		token  string
+		status int
+	}{
+		{"published by anonymous", "/api/v1/posts/" + published.ID.String(), "", http.StatusOK},
+		{"published by slug", "/api/v1/posts/slug/" + published.Slug, "", http.StatusOK},
+		{"draft by anonymous", "/api/v1/posts/" + draft.ID.String(), "", http.StatusNotFound},
+		{"draft by another user", "/api/v1/posts/" + draft.ID.String(), userToken, http.StatusNotFound},
+		{"draft by author", "/api/v1/posts/" + draft.ID.String(), authorToken, http.StatusOK},
+		{"draft by admin", "/api/v1/posts/" + draft.ID.String(), adminToken, http.StatusOK},
+		{"unknown post", "/api/v1/posts/" + uuid.NewString(), "", http.StatusNotFound},
+		{"invalid id", "/api/v1/posts/not-a-uuid", "", http.StatusBadRequest},
+		{"unknown slug", "/api/v1/posts/slug/no-such-post", "", http.StatusNotFound},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			resp := env.Do(t, testsupport.Request{Method: http.MethodGet, Path: tt.path, Token: tt.token})
+			if resp.Status != tt.status {
+				t.Errorf("got %d, want %d: %+v", resp.Status, tt.status, resp.Error)
+			}
+		})
+	}
+}
+
+func TestUpdateAndDeletePost(t *testing.T) {
+	env := testsupport.Start(t, testsupport.Options{})
+	f := factory.New(t, env.DB.DB)
+	author := f.User()
+	post := f.Post(factory.WithAuthor(author))
+	path := "/api/v1/posts/" + post.ID.String()
+
+	authorToken := env.Login(t, author.Email, factory.Password)
+	userToken := env.Login(t, testsupport.UserEmail, testsupport.FixturePassword)
+	adminToken := env.Login(t, testsupport.AdminEmail, testsupport.FixturePassword)
+
+	// The steps change the same post, so they run in order and stop at the
+	// first failure
+	steps := []struct {
+		name   string
+		method string
+		token  string
+		body   map[string]interface{}
+		status int
+	}{
+		{"update by anonymous", http.MethodPut, "", map[string]interface{}{"title": "Stolen"}, http.StatusUnauthorized},
+		{"update by another user", http.MethodPut, userToken, map[string]interface{}{"title": "Stolen"}, http.StatusForbidden},
+		{"publish by author", http.MethodPut, authorToken, map[string]interface{}{"title": "Renamed", "status": "published"}, http.StatusOK},
+		{"update by admin", http.MethodPut, adminToken, map[string]interface{}{"excerpt": "Edited by an admin"}, http.StatusOK},
+		{"read", http.MethodGet, "", nil, http.StatusOK},
+		{"delete by another user", http.MethodDelete, userToken, nil, http.StatusForbidden},
+		{"delete by author", http.MethodDelete, authorToken, nil, http.StatusNoContent},
+		{"read after delete", http.MethodGet, "", nil, http.StatusNotFound},
+		{"delete again", http.MethodDelete, authorToken, nil, http.StatusNotFound},
+	}
+	for _, step := range steps {
+		req := testsupport.Request{Method: step.method, Path: path, Token: step.token}
+		if step.body != nil {
+			req.Body = step.body
+		}
+		resp := env.Do(t, req)
+		if resp.Status != step.status {
+			t.Fatalf("%s: got %d, want %d: %+v", step.name, resp.Status, step.status, resp.Error)
+		}
+	}
+}
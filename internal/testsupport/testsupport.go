@@ -0,0 +1,210 @@
+// Package testsupport runs the full API against real dependencies for
+// integration tests. Start launches Postgres, and Redis when asked, in
+// containers, migrates the schema, seeds fixtures and serves the router of
+// the API from an httptest server. Tests are skipped in short mode and when
+// Docker is not available.
+//
+// Start configures the API through environment variables, so tests using it
+// cannot run in parallel.
+package testsupport
+
+import (
+	"context"
+	"fmt"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/testcontainers/testcontainers-go"
+	"github.com/testcontainers/testcontainers-go/modules/postgres"
+	"github.com/testcontainers/testcontainers-go/modules/redis"
+	"github.com/testcontainers/testcontainers-go/wait"
+	"github.com/yourusername/go-enterprise-api/internal/config"
+	"github.com/yourusername/go-enterprise-api/internal/database"
+	"github.com/yourusername/go-enterprise-api/internal/lifecycle"
+	"github.com/yourusername/go-enterprise-api/internal/models"
+	"github.com/yourusername/go-enterprise-api/internal/repository"
+	"github.com/yourusername/go-enterprise-api/internal/routes"
+	"github.com/yourusername/go-enterprise-api/pkg/logger"
+)
+
+// Container images of the dependencies
+const (
+	postgresImage = "postgres:16-alpine"
+	redisImage    = "redis:7-alpine"
+)
+
+// startupTimeout is how long a container may take to accept connections
+const startupTimeout = time.Minute
+
+// Options selects the dependencies and settings of a test environment
+type Options struct {
+	// Redis starts a Redis container as the rate limit store
+	Redis bool
+	// Settings overrides settings of the API, by environment variable name
+	Settings map[string]string
+}
+
+// Env is a running API with its dependencies. Everything is stopped when
+// the test that started it ends.
+type Env struct {
+	Config   *config.Config
+	DB       *database.Database
+	Server   *httptest.Server
+	Fixtures *Fixtures
+}
+
+// Start starts the dependencies and the API, and seeds the fixtures
+func Start(t *testing.T, opts Options) *Env {
+	t.Helper()
+	if testing.Short() {
+		t.Skip("integration test skipped in short mode")
+	}
+	testcontainers.SkipIfProviderIsNotHealthy(t)
+	ctx := context.Background()
+
+	settings := map[string]string{
+		"APP_ENV":    "test",
+		"APP_DEBUG":  "false",
+		"LOG_LEVEL":  "error",
+		"JWT_SECRET": "integration-test-secret-0123456789abcdef",
+		// Requests of a test all come from the same address
+		"RATE_LIMIT_REQUESTS": "100000",
+		"STORAGE_PATH":        t.TempDir(),
+	}
+	for key, value := range startPostgres(ctx, t) {
+		settings[key] = value
+	}
+	if opts.Redis {
+		settings["RATE_LIMIT_STORE"] = "redis"
+		settings["RATE_LIMIT_REDIS_URL"] = startRedis(ctx, t)
+	}
+	for key, value := range opts.Settings {
+		settings[key] = value
+	}
+	for key, value := range settings {
+		t.Setenv(key, value)
+	}
+
+	cfg, err := config.Load()
+	if err != nil {
+		t.Fatalf("failed to load configuration: %v", err)
+	}
+	logger.Init(logger.Config{
+		Level:  cfg.Log.Level,
+		Format: cfg.Log.Format,
+		Debug:  cfg.App.Debug,
+	})
+
+	db, err := database.New(cfg)
+	if err != nil {
+		t.Fatalf("failed to connect to database: %v", err)
+	}
+	t.Cleanup(func() {
+		if err := db.Close(); err != nil {
+			t.Logf("failed to close database connection: %v", err)
+		}
+	})
+	if err := repository.EnableRetries(db.DB, cfg.Database.RetryMaxAttempts, cfg.Database.RetryDelay); err != nil {
+		t.Fatalf("failed to enable database retries: %v", err)
+	}
+	if cfg.Tenancy.Enabled {
+		if err := repository.EnableTenancy(db.DB); err != nil {
+			t.Fatalf("failed to enable tenancy: %v", err)
+		}
+	}
+	if err := db.Migrate(models.All()...); err != nil {
+		t.Fatalf("failed to run migrations: %v", err)
+	}
+
+	fixtures, err := seedFixtures(ctx, db)
+	if err != nil {
+		t.Fatalf("failed to seed fixtures: %v", err)
+	}
+
+	gin.SetMode(gin.TestMode)
+	lc := lifecycle.New()
+	t.Cleanup(func() {
+		ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+		defer cancel()
+		if err := lc.Shutdown(ctx); err != nil {
+			t.Logf("background components forced to stop: %v", err)
+		}
+	})
+	server := httptest.NewServer(routes.Setup(cfg, db, lc))
+	t.Cleanup(server.Close)
+
+	return &Env{Config: cfg, DB: db, Server: server, Fixtures: fixtures}
+}
+
+// startPostgres starts a Postgres container and returns the settings
+// connecting to it
+func startPostgres(ctx context.Context, t *testing.T) map[string]string {
+	t.Helper()
+
+	container, err := postgres.RunContainer(ctx,
+		testcontainers.WithImage(postgresImage),
+		postgres.WithDatabase("api"),
+		postgres.WithUsername("api"),
+		postgres.WithPassword("api"),
+		// Postgres restarts once after initializing the database
+		testcontainers.WithWaitStrategy(wait.ForLog("database system is ready to accept connections").
+			WithOccurrence(2).
+			WithStartupTimeout(startupTimeout)),
+	)
+	if err != nil {
+		t.Fatalf("failed to start postgres: %v", err)
+	}
+	t.Cleanup(func() { terminate(t, container) })
+
+	host, err := container.Host(ctx)
+	if err != nil {
+		t.Fatalf("failed to get the postgres host: %v", err)
+	}
+	port, err := container.MappedPort(ctx, "5432/tcp")
+	if err != nil {
+		t.Fatalf("failed to get the postgres port: %v", err)
+	}
+
+	return map[string]string{
+		"DB_DRIVER":   "postgres",
+		"DB_HOST":     host,
+		"DB_PORT":     port.Port(),
+		"DB_NAME":     "api",
+		"DB_USER":     "api",
+		"DB_PASSWORD": "api",
+		"DB_SSL_MODE": "disable",
+	}
+}
+
+// startRedis starts a Redis container and returns its URL
+func startRedis(ctx context.Context, t *testing.T) string {
+	t.Helper()
+
+	container, err := redis.RunContainer(ctx, testcontainers.WithImage(redisImage))
+	if err != nil {
+		t.Fatalf("failed to start redis: %v", err)
+	}
+	t.Cleanup(func() { terminate(t, container) })
+
+	url, err := container.ConnectionString(ctx)
+	if err != nil {
+		t.Fatalf("failed to get the redis URL: %v", err)
+	}
+	return url
+}
+
+// terminate stops and removes a container
+func terminate(t *testing.T, container testcontainers.Container) {
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	defer cancel()
+	if err := container.Terminate(ctx); err != nil {
+		t.Logf("failed to terminate container: %v", err)
+	}
+}
+
+// URL returns the URL of a path of the API, e.g. /api/v1/posts
+func (e *Env) URL(path string) string {
+	return fmt.Sprintf("%s%s", e.Server.URL, path)
+}
@@ -1,11 +1,19 @@
 package errors
 
 import (
+	"errors"
 	"fmt"
 	"net/http"
+	"runtime"
+	"sort"
+	"strings"
 )
 
-// AppError represents an application error
+// maxStackDepth bounds the number of frames captured for an error
+const maxStackDepth = 32
+
+// AppError represents an application error. Errors created by Wrap carry
+// the stack they were wrapped at, so failures can be traced to a code path.
 type AppError struct {
 	Code       int         `json:"code"`
 	Message    string      `json:"message"`
@@ -13,6 +21,8 @@ type AppError struct {
 	Err        error       `json:"-"`
 	Data       interface{} `json:"data,omitempty"`
 	StatusCode int         `json:"-"`
+
+	stack []uintptr
 }
 
 // Error implements the error interface
@@ -28,6 +38,69 @@ func (e *AppError) Unwrap() error {
 	return e.Err
 }
 
+// Wrap wraps err with a message describing what failed, capturing the
+// caller's stack. An AppError keeps its code, status and client-facing
+// message; any other error becomes an internal server error, whose cause is
+// only logged.
+//
+// Usage:
+//
+//	if err := s.repo.Update(ctx, post); err != nil {
+//		return nil, apperrors.Wrap(err, "update post")
+//	}
+func Wrap(err error, message string) *AppError {
+	if err == nil {
+		return nil
+	}
+
+	var appErr *AppError
+	if !errors.As(err, &appErr) {
+		return &AppError{
+			Code:       CodeInternalError,
+			Message:    ErrInternal.Message,
+			Err:        fmt.Errorf("%s: %w", message, err),
+			StatusCode: http.StatusInternalServerError,
+			stack:      callers(),
+		}
+	}
+
+	wrapped := *appErr
+	if appErr.Err != nil {
+		wrapped.Err = fmt.Errorf("%s: %w", message, appErr.Err)
+	} else {
+		wrapped.Err = errors.New(message)
+	}
+	if wrapped.stack == nil {
+		wrapped.stack = callers()
+	}
+	return &wrapped
+}
+
+// callers captures the stack of the function calling the function calling
+// callers
+func callers() []uintptr {
+	pcs := make([]uintptr, maxStackDepth)
+	return pcs[:runtime.Callers(3, pcs)]
+}
+
+// Stack returns the stack captured when the error was wrapped, one
+// "function (file:line)" frame per line, or "" if none was captured
+func (e *AppError) Stack() string {
+	if len(e.stack) == 0 {
+		return ""
+	}
+	var b strings.Builder
+	frames := runtime.CallersFrames(e.stack)
+	for {
+		frame, more := frames.Next()
+		fmt.Fprintf(&b, "%s (%s:%d)\n", frame.Function, frame.File, frame.Line)
+		if !more {
+			break
+		}
+	}
+	return b.String()
+}
+
 // NewAppError creates a new AppError
 func NewAppError(statusCode int, code int, message string) *AppError {
 	return &AppError{
@@ -64,6 +137,12 @@ const (
 	CodeBadRequest       = 1003
 	CodeConflict         = 1004
 	CodeTooManyRequests  = 1005
+	CodeLegalHold        = 1006
+	CodeReadOnly         = 1007
+	CodeBadGateway       = 1008
+	CodeGone             = 1009
+	CodeIdempotencyMismatch = 1010
+	CodeTimeout          = 1011
 
 	// Authentication errors (2000-2999)
 	CodeUnauthorized     = 2000
@@ -71,6 +150,8 @@ const (
 	CodeTokenExpired     = 2002
 	CodeInvalidCredentials = 2003
 	CodeForbidden        = 2004
+	CodeInvalidAPIKey    = 2005
+	CodePolicyAcceptanceRequired = 2006
 
 	// User errors (3000-3999)
 	CodeUserNotFound     = 3000
@@ -84,33 +165,70 @@ const (
 	CodeDuplicateEntry   = 4002
 )
 
+// catalog lists the predefined errors, in the order they are defined
+var catalog []*AppError
+
+// define creates a predefined error and adds it to the catalog
+func define(statusCode int, code int, message string) *AppError {
+	err := NewAppError(statusCode, code, message)
+	catalog = append(catalog, err)
+	return err
+}
+
+// CatalogEntry describes a predefined error for clients
+type CatalogEntry struct {
+	Code    int    `json:"code"`
+	Message string `json:"message"`
+	Status  int    `json:"status"`
+}
+
+// Catalog returns the predefined errors ordered by code
+func Catalog() []CatalogEntry {
+	entries := make([]CatalogEntry, len(catalog))
+	for i, err := range catalog {
+		entries[i] = CatalogEntry{Code: err.Code, Message: err.Message, Status: err.StatusCode}
+	}
+	sort.Slice(entries, func(i, j int) bool {
+		return entries[i].Code < entries[j].Code
+	})
+	return entries
+}
+
 // Predefined errors
 var (
 	// General errors
-	ErrInternal = NewAppError(http.StatusInternalServerError, CodeInternalError, "Internal server error")
-	ErrNotFound = NewAppError(http.StatusNotFound, CodeNotFound, "Resource not found")
-	ErrBadRequest = NewAppError(http.StatusBadRequest, CodeBadRequest, "Bad request")
-	ErrValidation = NewAppError(http.StatusBadRequest, CodeValidationError, "Validation error")
-	ErrConflict = NewAppError(http.StatusConflict, CodeConflict, "Resource conflict")
-	ErrTooManyRequests = NewAppError(http.StatusTooManyRequests, CodeTooManyRequests, "Too many requests")
+	ErrInternal = define(http.StatusInternalServerError, CodeInternalError, "Internal server error")
+	ErrNotFound = define(http.StatusNotFound, CodeNotFound, "Resource not found")
+	ErrBadRequest = define(http.StatusBadRequest, CodeBadRequest, "Bad request")
+	ErrValidation = define(http.StatusBadRequest, CodeValidationError, "Validation error")
+	ErrConflict = define(http.StatusConflict, CodeConflict, "Resource conflict")
+	ErrTooManyRequests = define(http.StatusTooManyRequests, CodeTooManyRequests, "Too many requests")
+	ErrLegalHold = define(http.StatusConflict, CodeLegalHold, "Resource is under legal hold")
+	ErrReadOnly = define(http.StatusServiceUnavailable, CodeReadOnly, "API is in read-only mode")
+	ErrBadGateway = define(http.StatusBadGateway, CodeBadGateway, "Upstream request failed")
+	ErrGone = define(http.StatusGone, CodeGone, "Resource is gone")
+	ErrTimeout = define(http.StatusGatewayTimeout, CodeTimeout, "Request timed out")
+	ErrIdempotencyMismatch = define(http.StatusUnprocessableEntity, CodeIdempotencyMismatch, "Idempotency key was used for a different request")
 
 	// Authentication errors
-	ErrUnauthorized = NewAppError(http.StatusUnauthorized, CodeUnauthorized, "Unauthorized")
-	ErrInvalidToken = NewAppError(http.StatusUnauthorized, CodeInvalidToken, "Invalid token")
-	ErrTokenExpired = NewAppError(http.StatusUnauthorized, CodeTokenExpired, "Token expired")
-	ErrInvalidCredentials = NewAppError(http.StatusUnauthorized, CodeInvalidCredentials, "Invalid credentials")
-	ErrForbidden = NewAppError(http.StatusForbidden, CodeForbidden, "Forbidden")
+	ErrUnauthorized = define(http.StatusUnauthorized, CodeUnauthorized, "Unauthorized")
+	ErrInvalidToken = define(http.StatusUnauthorized, CodeInvalidToken, "Invalid token")
+	ErrTokenExpired = define(http.StatusUnauthorized, CodeTokenExpired, "Token expired")
+	ErrInvalidCredentials = define(http.StatusUnauthorized, CodeInvalidCredentials, "Invalid credentials")
+	ErrForbidden = define(http.StatusForbidden, CodeForbidden, "Forbidden")
+	ErrInvalidAPIKey = define(http.StatusUnauthorized, CodeInvalidAPIKey, "Invalid API key")
+	ErrPolicyAcceptanceRequired = define(http.StatusForbidden, CodePolicyAcceptanceRequired, "New policy versions must be accepted")
 
 	// User errors
-	ErrUserNotFound = NewAppError(http.StatusNotFound, CodeUserNotFound, "User not found")
-	ErrUserExists = NewAppError(http.StatusConflict, CodeUserExists, "User already exists")
-	ErrEmailExists = NewAppError(http.StatusConflict, CodeEmailExists, "Email already exists")
-	ErrInvalidPassword = NewAppError(http.StatusBadRequest, CodeInvalidPassword, "Invalid password")
+	ErrUserNotFound = define(http.StatusNotFound, CodeUserNotFound, "User not found")
+	ErrUserExists = define(http.StatusConflict, CodeUserExists, "User already exists")
+	ErrEmailExists = define(http.StatusConflict, CodeEmailExists, "Email already exists")
+	ErrInvalidPassword = define(http.StatusBadRequest, CodeInvalidPassword, "Invalid password")
 
 	// Database errors
-	ErrDatabase = NewAppError(http.StatusInternalServerError, CodeDatabaseError, "Database error")
-	ErrRecordNotFound = NewAppError(http.StatusNotFound, CodeRecordNotFound, "Record not found")
-	ErrDuplicateEntry = NewAppError(http.StatusConflict, CodeDuplicateEntry, "Duplicate entry")
+	ErrDatabase = define(http.StatusInternalServerError, CodeDatabaseError, "Database error")
+	ErrRecordNotFound = define(http.StatusNotFound, CodeRecordNotFound, "Record not found")
+	ErrDuplicateEntry = define(http.StatusConflict, CodeDuplicateEntry, "Duplicate entry")
 )
 
 // IsAppError checks if an error is an AppError
@@ -119,12 +237,19 @@ func IsAppError(err error) bool {
 	return ok
 }
 
-// GetAppError converts an error to AppError
+// GetAppError converts an error to AppError. Other errors become internal
+// server errors carrying the stack of the caller.
 func GetAppError(err error) *AppError {
 	if appErr, ok := err.(*AppError); ok {
 		return appErr
 	}
-	return ErrInternal.WithError(err)
+	return &AppError{
+		Code:       CodeInternalError,
+		Message:    ErrInternal.Message,
+		Err:        err,
+		StatusCode: http.StatusInternalServerError,
+		stack:      callers(),
+	}
 }
 
 // ValidationErrors holds multiple validation errors
@@ -132,10 +257,13 @@ type ValidationErrors struct {
 	Errors []ValidationError `json:"errors"`
 }
 
-// ValidationError represents a single validation error
+// ValidationError represents a single validation error. Messages added with
+// Addf keep their format and arguments, so they can be translated.
 type ValidationError struct {
-	Field   string `json:"field"`
-	Message string `json:"message"`
+	Field   string        `json:"field"`
+	Message string        `json:"message"`
+	Format  string        `json:"-"`
+	Args    []interface{} `json:"-"`
 }
 
 // NewValidationErrors creates a new ValidationErrors
@@ -153,6 +281,16 @@ func (v *ValidationErrors) Add(field, message string) {
 	})
 }
 
+// Addf adds a validation error with a message formatted from format and args
+func (v *ValidationErrors) Addf(field, format string, args ...interface{}) {
+	v.Errors = append(v.Errors, ValidationError{
+		Field:   field,
+		Message: fmt.Sprintf(format, args...),
+		Format:  format,
+		Args:    args,
+	})
+}
+
 // HasErrors returns true if there are validation errors
 func (v *ValidationErrors) HasErrors() bool {
 	return len(v.Errors) > 0
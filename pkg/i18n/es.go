@@ -0,0 +1,112 @@
+package i18n
+
+// spanish is the Spanish catalog
+var spanish = map[string]string{
+	// Errors
+	"Internal server error":                            "Error interno del servidor",
+	"Resource not found":                               "Recurso no encontrado",
+	"Bad request":                                      "Solicitud incorrecta",
+	"Validation error":                                 "Error de validación",
+	"Validation failed":                                "La validación ha fallado",
+	"Resource conflict":                                "Conflicto con el recurso",
+	"Too many requests":                                "Demasiadas solicitudes",
+	"Resource is under legal hold":                     "El recurso está bajo retención legal",
+	"API is in read-only mode":                         "La API está en modo de solo lectura",
+	"Upstream request failed":                          "La solicitud al servicio externo ha fallado",
+	"Resource is gone":                                 "El recurso ya no existe",
+	"Request timed out":                                "La solicitud ha superado el tiempo máximo",
+	"Idempotency key was used for a different request": "La clave de idempotencia se usó en otra solicitud",
+	"Unauthorized":                                     "No autorizado",
+	"Invalid token":                                    "Token no válido",
+	"Token expired":                                    "Token caducado",
+	"Invalid credentials":                              "Credenciales no válidas",
+	"Forbidden":                                        "Prohibido",
+	"Invalid API key":                                  "Clave de API no válida",
+	"New policy versions must be accepted":             "Deben aceptarse las nuevas versiones de las políticas",
+	"User not found":                                   "Usuario no encontrado",
+	"User already exists":                              "El usuario ya existe",
+	"Email already exists":                             "El correo electrónico ya existe",
+	"Invalid password":                                 "Contraseña no válida",
+	"Database error":                                   "Error de base de datos",
+	"Record not found":                                 "Registro no encontrado",
+	"Duplicate entry":                                  "Entrada duplicada",
+
+	// Rate limits
+	"Rate limit exceeded. Please try again later.":                   "Se ha superado el límite de solicitudes. Inténtelo de nuevo más tarde.",
+	"Too many requests to this endpoint. Please try again later.":    "Demasiadas solicitudes a este endpoint. Inténtelo de nuevo más tarde.",
+	"Rate limit exceeded for this read key. Please try again later.": "Se ha superado el límite de solicitudes de esta clave de lectura. Inténtelo de nuevo más tarde.",
+	"Rate limit exceeded for your region. Please try again later.":   "Se ha superado el límite de solicitudes de su región. Inténtelo de nuevo más tarde.",
+
+	// Common error details
+	"Authentication required":             "Se requiere autenticación",
+	"Authorization header is required":    "Se requiere la cabecera Authorization",
+	"Invalid authorization header format": "Formato de la cabecera Authorization no válido",
+	"Insufficient permissions":            "Permisos insuficientes",
+	"Account is not active":               "La cuenta no está activa",
+	"Invalid post ID":                     "ID de publicación no válido",
+	"Invalid user ID":                     "ID de usuario no válido",
+	"Invalid media ID":                    "ID de archivo no válido",
+	"Post not found":                      "Publicación no encontrada",
+	"Media not found":                     "Archivo no encontrado",
+	"Organization not found":              "Organización no encontrada",
+	"A file is required":                  "Se requiere un archivo",
+
+	// Request bodies
+	"request body is required":                                "el cuerpo de la solicitud es obligatorio",
+	"request body is not valid JSON: unexpected end of input": "el cuerpo de la solicitud no es JSON válido: fin de la entrada inesperado",
+	"request body is not valid JSON at position %d":           "el cuerpo de la solicitud no es JSON válido en la posición %d",
+	"invalid request body":                                    "cuerpo de la solicitud no válido",
+	"invalid number %q":                                       "número no válido %q",
+	"unknown field":                                           "campo desconocido",
+	"must be a string, got %s":                                "debe ser una cadena, se recibió %s",
+	"must be a boolean, got %s":                               "debe ser un booleano, se recibió %s",
+	"must be an integer, got %s":                              "debe ser un número entero, se recibió %s",
+	"must be a number, got %s":                                "debe ser un número, se recibió %s",
+	"must be an array, got %s":                                "debe ser un array, se recibió %s",
+	"must be an object, got %s":                               "debe ser un objeto, se recibió %s",
+	"must be a valid value, got %s":                           "debe ser un valor válido, se recibió %s",
+
+	// Validation rules
+	"%s is required":                                              "%s es obligatorio",
+	"%s is required when %s is %s":                                "%s es obligatorio cuando %s es %s",
+	"%s is required unless %s is %s":                              "%s es obligatorio salvo que %s sea %s",
+	"%s must be at least %s":                                      "%s debe ser como mínimo %s",
+	"%s must be at least %s characters":                           "%s debe tener al menos %s caracteres",
+	"%s must be at least %s items":                                "%s debe tener al menos %s elementos",
+	"%s must be at most %s":                                       "%s debe ser como máximo %s",
+	"%s must be at most %s characters":                            "%s debe tener como máximo %s caracteres",
+	"%s must be at most %s items":                                 "%s debe tener como máximo %s elementos",
+	"%s must be greater than %s":                                  "%s debe ser mayor que %s",
+	"%s must be greater than %s characters":                       "%s debe tener más de %s caracteres",
+	"%s must be greater than %s items":                            "%s debe tener más de %s elementos",
+	"%s must be less than %s":                                     "%s debe ser menor que %s",
+	"%s must be less than %s characters":                          "%s debe tener menos de %s caracteres",
+	"%s must be less than %s items":                               "%s debe tener menos de %s elementos",
+	"%s must be exactly %s":                                       "%s debe ser exactamente %s",
+	"%s must be exactly %s characters":                            "%s debe tener exactamente %s caracteres",
+	"%s must be exactly %s items":                                 "%s debe tener exactamente %s elementos",
+	"%s must be between %d and %d":                                "%s debe estar entre %d y %d",
+	"%s must be one of: %s":                                       "%s debe ser uno de: %s",
+	"%s must be a valid email address":                            "%s debe ser una dirección de correo electrónico válida",
+	"%s must be a valid URL":                                      "%s debe ser una URL válida",
+	"%s must be a valid http or https URL":                        "%s debe ser una URL http o https válida",
+	"%s must be a valid UUID":                                     "%s debe ser un UUID válido",
+	"%s must be a valid E.164 phone number":                       "%s debe ser un número de teléfono E.164 válido",
+	"%s must be an ISO-8601 date":                                 "%s debe ser una fecha ISO-8601",
+	"%s must be a valid date in the past":                         "%s debe ser una fecha válida en el pasado",
+	"%s must not be after %s":                                     "%s no debe ser posterior a %s",
+	"%s and %s do not match":                                      "%s y %s no coinciden",
+	"%s failed %s validation":                                     "%s no superó la validación %s",
+	"Invalid email format":                                        "Formato de correo electrónico no válido",
+	"Invalid UUID format":                                         "Formato de UUID no válido",
+	"The terms of service and privacy policy must be accepted":    "Deben aceptarse las condiciones del servicio y la política de privacidad",
+	"Invited users choose their own password":                     "Los usuarios invitados eligen su propia contraseña",
+	"%s must contain only lowercase letters, numbers and hyphens": "%s solo puede contener letras minúsculas, números y guiones",
+
+	// Passwords
+	"Password must be at least 8 characters":               "La contraseña debe tener al menos 8 caracteres",
+	"Password must contain at least one uppercase letter":  "La contraseña debe contener al menos una letra mayúscula",
+	"Password must contain at least one lowercase letter":  "La contraseña debe contener al menos una letra minúscula",
+	"Password must contain at least one number":            "La contraseña debe contener al menos un número",
+	"Password must contain at least one special character": "La contraseña debe contener al menos un carácter especial",
+}
@@ -0,0 +1,112 @@
+package i18n
+
+// french is the French catalog
+var french = map[string]string{
+	// Errors
+	"Internal server error":                            "Erreur interne du serveur",
+	"Resource not found":                               "Ressource introuvable",
+	"Bad request":                                      "Requête invalide",
+	"Validation error":                                 "Erreur de validation",
+	"Validation failed":                                "Échec de la validation",
+	"Resource conflict":                                "Conflit de ressource",
+	"Too many requests":                                "Trop de requêtes",
+	"Resource is under legal hold":                     "La ressource fait l'objet d'une conservation légale",
+	"API is in read-only mode":                         "L'API est en mode lecture seule",
+	"Upstream request failed":                          "La requête vers le service externe a échoué",
+	"Resource is gone":                                 "La ressource n'existe plus",
+	"Request timed out":                                "Le délai de la requête a expiré",
+	"Idempotency key was used for a different request": "La clé d'idempotence a été utilisée pour une autre requête",
+	"Unauthorized":                                     "Non autorisé",
+	"Invalid token":                                    "Jeton invalide",
+	"Token expired":                                    "Jeton expiré",
+	"Invalid credentials":                              "Identifiants invalides",
+	"Forbidden":                                        "Interdit",
+	"Invalid API key":                                  "Clé d'API invalide",
+	"New policy versions must be accepted":             "Les nouvelles versions des politiques doivent être acceptées",
+	"User not found":                                   "Utilisateur introuvable",
+	"User already exists":                              "L'utilisateur existe déjà",
+	"Email already exists":                             "L'adresse e-mail existe déjà",
+	"Invalid password":                                 "Mot de passe invalide",
+	"Database error":                                   "Erreur de base de données",
+	"Record not found":                                 "Enregistrement introuvable",
+	"Duplicate entry":                                  "Entrée en double",
+
+	// Rate limits
+	"Rate limit exceeded. Please try again later.":                   "Limite de requêtes dépassée. Veuillez réessayer plus tard.",
+	"Too many requests to this endpoint. Please try again later.":    "Trop de requêtes vers ce point d'accès. Veuillez réessayer plus tard.",
+	"Rate limit exceeded for this read key. Please try again later.": "Limite de requêtes dépassée pour cette clé de lecture. Veuillez réessayer plus tard.",
+	"Rate limit exceeded for your region. Please try again later.":   "Limite de requêtes dépassée pour votre région. Veuillez réessayer plus tard.",
+
+	// Common error details
+	"Authentication required":             "Authentification requise",
+	"Authorization header is required":    "L'en-tête Authorization est obligatoire",
+	"Invalid authorization header format": "Format de l'en-tête Authorization invalide",
+	"Insufficient permissions":            "Permissions insuffisantes",
+	"Account is not active":               "Le compte n'est pas actif",
+	"Invalid post ID":                     "ID de publication invalide",
+	"Invalid user ID":                     "ID d'utilisateur invalide",
+	"Invalid media ID":                    "ID de média invalide",
+	"Post not found":                      "Publication introuvable",
+	"Media not found":                     "Média introuvable",
+	"Organization not found":              "Organisation introuvable",
+	"A file is required":                  "Un fichier est requis",
+
+	// Request bodies
+	"request body is required":                                "le corps de la requête est obligatoire",
+	"request body is not valid JSON: unexpected end of input": "le corps de la requête n'est pas un JSON valide : fin de saisie inattendue",
+	"request body is not valid JSON at position %d":           "le corps de la requête n'est pas un JSON valide à la position %d",
+	"invalid request body":                                    "corps de la requête invalide",
+	"invalid number %q":                                       "nombre invalide %q",
+	"unknown field":                                           "champ inconnu",
+	"must be a string, got %s":                                "doit être une chaîne, reçu %s",
+	"must be a boolean, got %s":                               "doit être un booléen, reçu %s",
+	"must be an integer, got %s":                              "doit être un entier, reçu %s",
+	"must be a number, got %s":                                "doit être un nombre, reçu %s",
+	"must be an array, got %s":                                "doit être un tableau, reçu %s",
+	"must be an object, got %s":                               "doit être un objet, reçu %s",
+	"must be a valid value, got %s":                           "doit être une valeur valide, reçu %s",
+
+	// Validation rules
+	"%s is required":                                              "%s est obligatoire",
+	"%s is required when %s is %s":                                "%s est obligatoire lorsque %s vaut %s",
+	"%s is required unless %s is %s":                              "%s est obligatoire sauf si %s vaut %s",
+	"%s must be at least %s":                                      "%s doit être au moins %s",
+	"%s must be at least %s characters":                           "%s doit contenir au moins %s caractères",
+	"%s must be at least %s items":                                "%s doit contenir au moins %s éléments",
+	"%s must be at most %s":                                       "%s doit être au plus %s",
+	"%s must be at most %s characters":                            "%s doit contenir au plus %s caractères",
+	"%s must be at most %s items":                                 "%s doit contenir au plus %s éléments",
+	"%s must be greater than %s":                                  "%s doit être supérieur à %s",
+	"%s must be greater than %s characters":                       "%s doit contenir plus de %s caractères",
+	"%s must be greater than %s items":                            "%s doit contenir plus de %s éléments",
+	"%s must be less than %s":                                     "%s doit être inférieur à %s",
+	"%s must be less than %s characters":                          "%s doit contenir moins de %s caractères",
+	"%s must be less than %s items":                               "%s doit contenir moins de %s éléments",
+	"%s must be exactly %s":                                       "%s doit être exactement %s",
+	"%s must be exactly %s characters":                            "%s doit contenir exactement %s caractères",
+	"%s must be exactly %s items":                                 "%s doit contenir exactement %s éléments",
+	"%s must be between %d and %d":                                "%s doit être compris entre %d et %d",
+	"%s must be one of: %s":                                       "%s doit être l'une des valeurs suivantes : %s",
+	"%s must be a valid email address":                            "%s doit être une adresse e-mail valide",
+	"%s must be a valid URL":                                      "%s doit être une URL valide",
+	"%s must be a valid http or https URL":                        "%s doit être une URL http ou https valide",
+	"%s must be a valid UUID":                                     "%s doit être un UUID valide",
+	"%s must be a valid E.164 phone number":                       "%s doit être un numéro de téléphone E.164 valide",
+	"%s must be an ISO-8601 date":                                 "%s doit être une date ISO-8601",
+	"%s must be a valid date in the past":                         "%s doit être une date valide dans le passé",
+	"%s must not be after %s":                                     "%s ne doit pas être postérieur à %s",
+	"%s and %s do not match":                                      "%s et %s ne correspondent pas",
+	"%s failed %s validation":                                     "%s n'a pas passé la validation %s",
+	"Invalid email format":                                        "Format d'adresse e-mail invalide",
+	"Invalid UUID format":                                         "Format d'UUID invalide",
+	"The terms of service and privacy policy must be accepted":    "Les conditions d'utilisation et la politique de confidentialité doivent être acceptées",
+	"Invited users choose their own password":                     "Les utilisateurs invités choisissent leur propre mot de passe",
+	"%s must contain only lowercase letters, numbers and hyphens": "%s ne peut contenir que des lettres minuscules, des chiffres et des tirets",
+
+	// Passwords
+	"Password must be at least 8 characters":               "Le mot de passe doit contenir au moins 8 caractères",
+	"Password must contain at least one uppercase letter":  "Le mot de passe doit contenir au moins une lettre majuscule",
+	"Password must contain at least one lowercase letter":  "Le mot de passe doit contenir au moins une lettre minuscule",
+	"Password must contain at least one number":            "Le mot de passe doit contenir au moins un chiffre",
+	"Password must contain at least one special character": "Le mot de passe doit contenir au moins un caractère spécial",
+}
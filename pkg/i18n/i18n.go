@@ -0,0 +1,108 @@
+// Package i18n translates the messages of API responses. Messages are
+// looked up by their English text, so English needs no catalog and a message
+// missing from a catalog is served in English.
+package i18n
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// Supported languages
+const (
+	English = "en"
+	Spanish = "es"
+	French  = "fr"
+)
+
+// Default is the language served when a request accepts none of the others
+const Default = English
+
+// catalogs maps each language other than English to its translations,
+// keyed by the English message or format
+var catalogs = map[string]map[string]string{
+	Spanish: spanish,
+	French:  french,
+}
+
+// Languages lists the supported languages
+func Languages() []string {
+	return []string{English, Spanish, French}
+}
+
+// IsSupported reports whether lang is a supported language
+func IsSupported(lang string) bool {
+	return lang == English || catalogs[lang] != nil
+}
+
+// T translates message into lang. When args are given, message is a format
+// and the translation is formatted with them.
+func T(lang, message string, args ...interface{}) string {
+	if translated, ok := catalogs[lang][message]; ok {
+		message = translated
+	}
+	if len(args) == 0 {
+		return message
+	}
+	return fmt.Sprintf(message, args...)
+}
+
+// Negotiate picks the supported language a client prefers from an
+// Accept-Language header such as "fr-CA, es;q=0.8". Regional variants match
+// their base language. Default is returned when no supported language is
+// acceptable.
+func Negotiate(header string) string {
+	type candidate struct {
+		lang    string
+		quality float64
+	}
+
+	var candidates []candidate
+	for _, part := range strings.Split(header, ",") {
+		tag, params, _ := strings.Cut(strings.TrimSpace(part), ";")
+		quality := 1.0
+		if q, ok := strings.CutPrefix(strings.TrimSpace(params), "q="); ok {
+			parsed, err := strconv.ParseFloat(q, 64)
+			if err != nil {
+				continue
+			}
+			quality = parsed
+		}
+
+		base, _, _ := strings.Cut(strings.ToLower(strings.TrimSpace(tag)), "-")
+		if base == "*" {
+			base = Default
+		}
+		if quality > 0 && IsSupported(base) {
+			candidates = append(candidates, candidate{lang: base, quality: quality})
+		}
+	}
+	if len(candidates) == 0 {
+		return Default
+	}
+
+	// Stable, so languages of equal quality keep the client's order
+	sort.SliceStable(candidates, func(i, j int) bool {
+		return candidates[i].quality > candidates[j].quality
+	})
+	return candidates[0].lang
+}
+
+type contextKey struct{}
+
+// WithLanguage returns a context carrying the language of a request
+func WithLanguage(ctx context.Context, lang string) context.Context {
+	return context.WithValue(ctx, contextKey{}, lang)
+}
+
+// FromContext returns the language carried by ctx, or Default if there is
+// none
+func FromContext(ctx context.Context) string {
+	if lang, ok := ctx.Value(contextKey{}).(string); ok {
+		return lang
+	}
+	return Default
+}
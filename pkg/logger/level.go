@@ -0,0 +1,101 @@
+package logger
+
+import (
+	"fmt"
+	"sync"
+	"time"
+
+	"go.uber.org/zap"
+	"go.uber.org/zap/zapcore"
+)
+
+var (
+	// atomicLevel is the level of the application and access logs
+	atomicLevel = zap.NewAtomicLevelAt(zapcore.InfoLevel)
+	// defaultLevel is the configured level, which temporary levels revert to
+	defaultLevel = zapcore.InfoLevel
+
+	levelMu     sync.Mutex
+	revertTimer *time.Timer
+	revertAt    time.Time
+)
+
+// Levels lists the levels SetLevel accepts
+var Levels = []string{"debug", "info", "warn", "error"}
+
+// LevelStatus describes the current log level
+type LevelStatus struct {
+	Level        string     `json:"level"`
+	DefaultLevel string     `json:"default_level"`
+	RevertAt     *time.Time `json:"revert_at,omitempty"`
+}
+
+// CurrentLevel returns the current log level, and when it reverts to the
+// configured level if it was set temporarily
+func CurrentLevel() LevelStatus {
+	levelMu.Lock()
+	defer levelMu.Unlock()
+	return levelStatus()
+}
+
+// SetLevel changes the log level at runtime. With a positive revertAfter the
+// level reverts to the configured one after that long; otherwise it stays
+// until changed again. Setting a level cancels any pending revert.
+func SetLevel(name string, revertAfter time.Duration) (LevelStatus, error) {
+	if !isSettableLevel(name) {
+		return LevelStatus{}, fmt.Errorf("unknown log level %q", name)
+	}
+	level := parseLevel(name)
+
+	levelMu.Lock()
+	defer levelMu.Unlock()
+
+	if revertTimer != nil {
+		revertTimer.Stop()
+		revertTimer = nil
+		revertAt = time.Time{}
+	}
+	atomicLevel.SetLevel(level)
+
+	if revertAfter > 0 {
+		var timer *time.Timer
+		timer = time.AfterFunc(revertAfter, func() {
+			levelMu.Lock()
+			defer levelMu.Unlock()
+			// A later SetLevel replaced this timer
+			if revertTimer != timer {
+				return
+			}
+			revertTimer = nil
+			revertAt = time.Time{}
+			atomicLevel.SetLevel(defaultLevel)
+			Info("Log level reverted", String("log_level", defaultLevel.String()))
+		})
+		revertTimer = timer
+		revertAt = time.Now().Add(revertAfter)
+	}
+	return levelStatus(), nil
+}
+
+// levelStatus describes the current log level. The caller holds levelMu.
+func levelStatus() LevelStatus {
+	status := LevelStatus{
+		Level:        atomicLevel.Level().String(),
+		DefaultLevel: defaultLevel.String(),
+	}
+	if revertTimer != nil {
+		at := revertAt
+		status.RevertAt = &at
+	}
+	return status
+}
+
+// isSettableLevel reports whether name is one of Levels
+func isSettableLevel(name string) bool {
+	for _, level := range Levels {
+		if level == name {
+			return true
+		}
+	}
+	return false
+}
@@ -9,8 +9,15 @@ import (
 )
 
 var (
-	log  *zap.Logger
-	once sync.Once
+	log    *zap.Logger
+	access *zap.Logger
+	once   sync.Once
+)
+
+// Outputs
+const (
+	OutputStdout = "stdout"
+	OutputFile   = "file"
 )
 
 // Config holds logger configuration
@@ -18,19 +25,35 @@ type Config struct {
 	Level  string
 	Format string
 	Debug  bool
+
+	// Outputs lists where application logs are written: OutputStdout,
+	// OutputFile (to File) or both. Logs go to stdout when it is empty.
+	Outputs []string
+	File    string
+	// AccessOutputs lists where request logs are written, to AccessFile for
+	// OutputFile. Request logs go with the application logs when it is empty.
+	AccessOutputs []string
+	AccessFile    string
+	// Rotation applies to the log files
+	Rotation Rotation
 }
 
 // Init initializes the logger
 func Init(cfg Config) {
 	once.Do(func() {
-		log = newLogger(cfg)
+		defaultLevel = parseLevel(cfg.Level)
+		atomicLevel.SetLevel(defaultLevel)
+		log = newLogger(cfg, cfg.Outputs, cfg.File)
+		if len(cfg.AccessOutputs) > 0 {
+			access = newLogger(cfg, cfg.AccessOutputs, cfg.AccessFile)
+		}
 	})
 }
 
-// newLogger creates a new zap logger instance
-func newLogger(cfg Config) *zap.Logger {
-	// Parse log level
-	level := parseLevel(cfg.Level)
+// newLogger creates a new zap logger instance writing to outputs. Its level
+// is the shared level SetLevel changes.
+func newLogger(cfg Config, outputs []string, file string) *zap.Logger {
+	level := atomicLevel
 
 	// Create encoder config
 	encoderConfig := zapcore.EncoderConfig{
@@ -48,21 +71,20 @@ func newLogger(cfg Config) *zap.Logger {
 		EncodeCaller:   zapcore.ShortCallerEncoder,
 	}
 
-	// Choose encoder based on format
-	var encoder zapcore.Encoder
-	if cfg.Format == "json" {
-		encoder = zapcore.NewJSONEncoder(encoderConfig)
-	} else {
-		encoderConfig.EncodeLevel = zapcore.CapitalColorLevelEncoder
-		encoder = zapcore.NewConsoleEncoder(encoderConfig)
+	// Create a core per output
+	if len(outputs) == 0 {
+		outputs = []string{OutputStdout}
 	}
-
-	// Create core
-	core := zapcore.NewCore(
-		encoder,
-		zapcore.AddSync(os.Stdout),
-		level,
-	)
+	cores := make([]zapcore.Core, 0, len(outputs))
+	for _, output := range outputs {
+		switch output {
+		case OutputStdout:
+			cores = append(cores, zapcore.NewCore(newEncoder(cfg.Format, encoderConfig, true), zapcore.AddSync(os.Stdout), level))
+		case OutputFile:
+			cores = append(cores, zapcore.NewCore(newEncoder(cfg.Format, encoderConfig, false), zapcore.AddSync(newRotatingFile(file, cfg.Rotation)), level))
+		}
+	}
+	core := zapcore.NewTee(cores...)
 
 	// Build logger with options
 	opts := []zap.Option{
@@ -77,6 +99,20 @@ func newLogger(cfg Config) *zap.Logger {
 	return zap.New(core, opts...)
 }
 
+// newEncoder chooses the encoder based on format. Console logs are only
+// colored on stdout.
+func newEncoder(format string, encoderConfig zapcore.EncoderConfig, color bool) zapcore.Encoder {
+	if format == "json" {
+		return zapcore.NewJSONEncoder(encoderConfig)
+	}
+	if color {
+		encoderConfig.EncodeLevel = zapcore.CapitalColorLevelEncoder
+	} else {
+		encoderConfig.EncodeLevel = zapcore.CapitalLevelEncoder
+	}
+	return zapcore.NewConsoleEncoder(encoderConfig)
+}
+
 // parseLevel parses string level to zapcore.Level
 func parseLevel(level string) zapcore.Level {
 	switch level {
@@ -106,6 +142,9 @@ func Get() *zap.Logger {
 
 // Sync flushes any buffered log entries
 func Sync() error {
+	if access != nil {
+		_ = access.Sync()
+	}
 	if log != nil {
 		return log.Sync()
 	}
@@ -137,6 +176,18 @@ func Fatal(msg string, fields ...zap.Field) {
 	Get().Fatal(msg, fields...)
 }
 
+// Access logs a request at level to the access log, or with the application
+// logs when no separate access log is configured
+func Access(level zapcore.Level, msg string, fields ...zap.Field) {
+	l := access
+	if l == nil {
+		l = Get()
+	}
+	if entry := l.Check(level, msg); entry != nil {
+		entry.Write(fields...)
+	}
+}
+
 // With creates a child logger with additional fields
 func With(fields ...zap.Field) *zap.Logger {
 	return Get().With(fields...)
@@ -0,0 +1,46 @@
+package logger
+
+import (
+	"time"
+
+	"gopkg.in/natefinch/lumberjack.v2"
+)
+
+// Rotation configures the rotation of log files. A file is rotated when it
+// grows past MaxSizeMB and, with a positive Interval, at every multiple of
+// Interval (every midnight UTC for 24h). MaxBackups and MaxAgeDays bound the
+// rotated files kept; 0 keeps them all.
+type Rotation struct {
+	MaxSizeMB  int
+	MaxBackups int
+	MaxAgeDays int
+	Compress   bool
+	Interval   time.Duration
+}
+
+// newRotatingFile opens a log file rotated according to rotation
+func newRotatingFile(path string, rotation Rotation) *lumberjack.Logger {
+	file := &lumberjack.Logger{
+		Filename:   path,
+		MaxSize:    rotation.MaxSizeMB,
+		MaxBackups: rotation.MaxBackups,
+		MaxAge:     rotation.MaxAgeDays,
+		Compress:   rotation.Compress,
+	}
+	if rotation.Interval > 0 {
+		go rotateEvery(file, rotation.Interval)
+	}
+	return file
+}
+
+// rotateEvery rotates a file at every multiple of interval for the lifetime
+// of the process
+func rotateEvery(file *lumberjack.Logger, interval time.Duration) {
+	for {
+		now := time.Now()
+		time.Sleep(now.Truncate(interval).Add(interval).Sub(now))
+		if err := file.Rotate(); err != nil {
+			Get().Error("Failed to rotate log file", String("file", file.Filename), Err(err))
+		}
+	}
+}
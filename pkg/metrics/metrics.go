@@ -0,0 +1,79 @@
+// Package metrics is a small facade over the Prometheus client. Packages
+// declare the counters and histograms they emit as package variables; every
+// metric is registered in a process-wide registry, which is served in the
+// Prometheus text format.
+package metrics
+
+import (
+	"net/http"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+)
+
+// DefaultBuckets are histogram buckets suited to request and query
+// durations in seconds
+var DefaultBuckets = prometheus.DefBuckets
+
+// registry is the process-wide registry used by NewCounter, NewHistogram
+// and Handler. It holds the application's metrics only, not the Go runtime
+// collectors of the Prometheus default registry.
+var registry = prometheus.NewRegistry()
+
+// Handler returns an http.Handler serving the registered metrics
+func Handler() http.Handler {
+	return promhttp.HandlerFor(registry, promhttp.HandlerOpts{})
+}
+
+// Counter is a monotonically increasing count, partitioned by labels
+type Counter struct {
+	vec *prometheus.CounterVec
+}
+
+// NewCounter creates and registers a counter with the given label names.
+// Metric names are declared in code, so a duplicate name panics.
+func NewCounter(name, help string, labels ...string) *Counter {
+	c := &Counter{vec: prometheus.NewCounterVec(prometheus.CounterOpts{Name: name, Help: help}, labels)}
+	registry.MustRegister(c.vec)
+	// A counter without labels has a single series, reported from zero
+	if len(labels) == 0 {
+		c.vec.WithLabelValues()
+	}
+	return c
+}
+
+// Inc adds one to the series with the given label values
+func (c *Counter) Inc(labelValues ...string) {
+	c.vec.WithLabelValues(labelValues...).Inc()
+}
+
+// Add adds delta, which must not be negative, to the series with the given
+// label values
+func (c *Counter) Add(delta float64, labelValues ...string) {
+	if delta < 0 {
+		return
+	}
+	c.vec.WithLabelValues(labelValues...).Add(delta)
+}
+
+// Histogram counts observations, such as durations, in buckets,
+// partitioned by labels
+type Histogram struct {
+	vec *prometheus.HistogramVec
+}
+
+// NewHistogram creates and registers a histogram with the given bucket upper
+// bounds and label names. Nil buckets mean DefaultBuckets.
+func NewHistogram(name, help string, buckets []float64, labels ...string) *Histogram {
+	if buckets == nil {
+		buckets = DefaultBuckets
+	}
+	h := &Histogram{vec: prometheus.NewHistogramVec(prometheus.HistogramOpts{Name: name, Help: help, Buckets: buckets}, labels)}
+	registry.MustRegister(h.vec)
+	return h
+}
+
+// Observe records a value in the series with the given label values
+func (h *Histogram) Observe(value float64, labelValues ...string) {
+	h.vec.WithLabelValues(labelValues...).Observe(value)
+}
@@ -0,0 +1,52 @@
+package metrics
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+func TestHandler(t *testing.T) {
+	events := NewCounter("test_events_total", "Events by kind.", "kind")
+	events.Inc("created")
+	events.Add(2, "created")
+	events.Add(-1, "created")
+	NewCounter("test_starts_total", "Starts.")
+	durations := NewHistogram("test_duration_seconds", "Durations.", []float64{0.1, 1})
+	durations.Observe(0.05)
+	durations.Observe(0.5)
+
+	w := httptest.NewRecorder()
+	Handler().ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/metrics", nil))
+	if w.Code != http.StatusOK {
+		t.Fatalf("got %d, want %d", w.Code, http.StatusOK)
+	}
+
+	body := w.Body.String()
+	for _, want := range []string{
+		"# HELP test_events_total Events by kind.\n# TYPE test_events_total counter\n",
+		`test_events_total{kind="created"} 3` + "\n",
+		"test_starts_total 0\n",
+		"# TYPE test_duration_seconds histogram\n",
+		`test_duration_seconds_bucket{le="0.1"} 1` + "\n",
+		`test_duration_seconds_bucket{le="1"} 2` + "\n",
+		`test_duration_seconds_bucket{le="+Inf"} 2` + "\n",
+		"test_duration_seconds_sum 0.55\n",
+		"test_duration_seconds_count 2\n",
+	} {
+		if !strings.Contains(body, want) {
+			t.Errorf("got:\n%s\nwant it to contain %q", body, want)
+		}
+	}
+}
+
+func TestNewCounterDuplicate(t *testing.T) {
+	NewCounter("test_duplicate_total", "Registered once.")
+	defer func() {
+		if recover() == nil {
+			t.Error("got a second counter with the same name, want a panic")
+		}
+	}()
+	NewCounter("test_duplicate_total", "Registered twice.")
+}
@@ -0,0 +1,148 @@
+// Package requestid carries the correlation ID of a request, and the W3C
+// trace context it was received with, through contexts and into outbound
+// requests, so one operation can be followed across services.
+package requestid
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"net/http"
+	"strings"
+
+	"github.com/google/uuid"
+)
+
+const (
+	// Header carries the request ID
+	Header = "X-Request-ID"
+	// TraceparentHeader carries the W3C trace context
+	TraceparentHeader = "traceparent"
+
+	// maxLength bounds the length of request IDs accepted from clients
+	maxLength = 128
+)
+
+type contextKey struct{}
+
+// value is stored in contexts
+type value struct {
+	id          string
+	traceparent string
+}
+
+// New generates a request ID
+func New() string {
+	return uuid.New().String()
+}
+
+// FromRequest returns the ID a request should be known by: its X-Request-ID
+// when it is valid, else the trace ID of its traceparent when that is valid,
+// else a new ID. It also returns the request's valid traceparent, if any.
+func FromRequest(r *http.Request) (string, string) {
+	traceparent := r.Header.Get(TraceparentHeader)
+	traceID, ok := parseTraceparent(traceparent)
+	if !ok {
+		traceparent = ""
+	}
+
+	if id := r.Header.Get(Header); IsValid(id) {
+		return id, traceparent
+	}
+	if traceID != "" {
+		return traceID, traceparent
+	}
+	return New(), traceparent
+}
+
+// IsValid reports whether a request ID received from a client is safe to
+// use: up to 128 letters, digits, dots, underscores, colons and hyphens, so
+// it cannot inject anything into logs or headers
+func IsValid(id string) bool {
+	if id == "" || len(id) > maxLength {
+		return false
+	}
+	for _, r := range id {
+		switch {
+		case r >= 'a' && r <= 'z', r >= 'A' && r <= 'Z', r >= '0' && r <= '9':
+		case r == '.', r == '_', r == ':', r == '-':
+		default:
+			return false
+		}
+	}
+	return true
+}
+
+// WithContext returns a context carrying a request ID and traceparent
+func WithContext(ctx context.Context, id, traceparent string) context.Context {
+	return context.WithValue(ctx, contextKey{}, value{id: id, traceparent: traceparent})
+}
+
+// FromContext returns the request ID carried by ctx, or "" if there is none
+func FromContext(ctx context.Context) string {
+	v, _ := ctx.Value(contextKey{}).(value)
+	return v.id
+}
+
+// TraceparentFromContext returns the traceparent carried by ctx, or "" if
+// there is none
+func TraceparentFromContext(ctx context.Context) string {
+	v, _ := ctx.Value(contextKey{}).(value)
+	return v.traceparent
+}
+
+// SetHeaders adds the request ID and trace context carried by ctx to an
+// outbound request. The trace keeps its trace ID and flags but gets a new
+// parent ID, as the outbound request is a new step of the trace.
+func SetHeaders(ctx context.Context, req *http.Request) {
+	v, _ := ctx.Value(contextKey{}).(value)
+	if v.id != "" {
+		req.Header.Set(Header, v.id)
+	}
+	if v.traceparent != "" {
+		parts := strings.Split(v.traceparent, "-")
+		req.Header.Set(TraceparentHeader, strings.Join([]string{parts[0], parts[1], newParentID(), parts[3]}, "-"))
+	}
+}
+
+// parseTraceparent validates a version 00 traceparent header and returns its
+// trace ID
+func parseTraceparent(header string) (string, bool) {
+	parts := strings.Split(header, "-")
+	if len(parts) != 4 || parts[0] != "00" {
+		return "", false
+	}
+	traceID, parentID, flags := parts[1], parts[2], parts[3]
+	if !isHex(traceID, 32) || !isHex(parentID, 16) || !isHex(flags, 2) {
+		return "", false
+	}
+	// All-zero IDs are invalid
+	if strings.Trim(traceID, "0") == "" || strings.Trim(parentID, "0") == "" {
+		return "", false
+	}
+	return traceID, true
+}
+
+// isHex reports whether s is n lowercase hexadecimal digits
+func isHex(s string, n int) bool {
+	if len(s) != n {
+		return false
+	}
+	for _, r := range s {
+		if !(r >= '0' && r <= '9' || r >= 'a' && r <= 'f') {
+			return false
+		}
+	}
+	return true
+}
+
+// newParentID generates a random parent ID for a traceparent
+func newParentID() string {
+	b := make([]byte, 8)
+	_, _ = rand.Read(b)
+	id := hex.EncodeToString(b)
+	if strings.Trim(id, "0") == "" {
+		return "0000000000000001"
+	}
+	return id
+}
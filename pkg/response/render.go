@@ -0,0 +1,273 @@
+package response
+
+import (
+	"bytes"
+	"encoding/json"
+	"encoding/xml"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+	"unicode"
+
+	"github.com/gin-gonic/gin"
+	"github.com/gin-gonic/gin/render"
+)
+
+// Media types responses can be rendered in, besides JSON. Both names in use
+// for MessagePack are accepted, and the one the client asked for is sent.
+const (
+	MIMEXML      = "application/xml"
+	MIMETextXML  = "text/xml"
+	MIMEMsgPack  = "application/msgpack"
+	MIMEXMsgPack = "application/x-msgpack"
+)
+
+// offeredFormats lists the media types responses are offered in, JSON first
+// so it is picked for clients accepting anything
+var offeredFormats = []string{gin.MIMEJSON, MIMEXML, MIMETextXML, MIMEMsgPack, MIMEXMsgPack}
+
+// Format returns the media type a response to the request is rendered in,
+// negotiated from its Accept header, quality values included. Each offered
+// type takes the quality of the most specific range matching it, and ties
+// go to JSON. JSON is also used when the client accepts none of them.
+func Format(c *gin.Context) string {
+	header := c.GetHeader("Accept")
+	if header == "" {
+		return gin.MIMEJSON
+	}
+
+	type mediaRange struct {
+		typ, subtype string
+		quality      float64
+	}
+	var ranges []mediaRange
+	for _, part := range strings.Split(header, ",") {
+		mediaType, params, _ := strings.Cut(strings.TrimSpace(part), ";")
+		typ, subtype, ok := strings.Cut(strings.ToLower(strings.TrimSpace(mediaType)), "/")
+		if !ok {
+			continue
+		}
+		quality := 1.0
+		for _, param := range strings.Split(params, ";") {
+			if q, ok := strings.CutPrefix(strings.TrimSpace(param), "q="); ok {
+				if parsed, err := strconv.ParseFloat(q, 64); err == nil {
+					quality = parsed
+				}
+			}
+		}
+		ranges = append(ranges, mediaRange{typ: typ, subtype: subtype, quality: quality})
+	}
+
+	best, bestQuality := gin.MIMEJSON, 0.0
+	for _, offered := range offeredFormats {
+		typ, subtype, _ := strings.Cut(offered, "/")
+		quality, specificity := 0.0, -1
+		for _, r := range ranges {
+			var s int
+			switch {
+			case r.typ == typ && r.subtype == subtype:
+				s = 2
+			case r.typ == typ && r.subtype == "*":
+				s = 1
+			case r.typ == "*" && r.subtype == "*":
+				s = 0
+			default:
+				continue
+			}
+			if s > specificity {
+				quality, specificity = r.quality, s
+			}
+		}
+		if quality > bestQuality {
+			best, bestQuality = offered, quality
+		}
+	}
+	return best
+}
+
+// Render sends a response in the format negotiated for the request
+func Render(c *gin.Context, status int, resp Response) {
+	c.Render(status, renderer(c, resp))
+}
+
+// Abort sends a response in the format negotiated for the request and stops
+// the handler chain
+func Abort(c *gin.Context, status int, resp Response) {
+	c.Abort()
+	Render(c, status, resp)
+}
+
+// renderer returns the gin renderer of a response in the format negotiated
+// for the request. XML and MessagePack are encoded from the JSON encoding,
+// so they carry the same fields, names and timestamps; should that fail,
+// the response is sent as JSON.
+func renderer(c *gin.Context, resp Response) render.Render {
+	format := Format(c)
+	if format == gin.MIMEJSON {
+		return render.JSON{Data: resp}
+	}
+
+	raw, err := json.Marshal(resp)
+	if err != nil {
+		return render.JSON{Data: resp}
+	}
+	value, err := decodeOrdered(raw)
+	if err != nil {
+		return render.JSON{Data: resp}
+	}
+
+	if format == MIMEXML || format == MIMETextXML {
+		return negotiated{renderer: render.XML{Data: xmlDocument{value: value}}, contentType: format + "; charset=utf-8"}
+	}
+	return negotiated{renderer: render.MsgPack{Data: value}, contentType: format}
+}
+
+// negotiated renders with a gin renderer under the negotiated media type
+// rather than the renderer's own
+type negotiated struct {
+	renderer    render.Render
+	contentType string
+}
+
+// Render writes the response
+func (r negotiated) Render(w http.ResponseWriter) error {
+	r.WriteContentType(w)
+	return r.renderer.Render(w)
+}
+
+// WriteContentType sets the negotiated content type unless the handler set
+// one
+func (r negotiated) WriteContentType(w http.ResponseWriter) {
+	header := w.Header()
+	if header.Get("Content-Type") == "" {
+		header.Set("Content-Type", r.contentType)
+	}
+}
+
+// object is a JSON object as alternating keys and values. Keys keep their
+// order, so responses encode the same way every time and their ETags are
+// stable; MessagePack encodes it as a map.
+type object []interface{}
+
+// MapBySlice makes the MessagePack codec encode an object as a map
+func (object) MapBySlice() {}
+
+// decodeOrdered decodes a JSON document into nil, bool, int64, float64,
+// string, []interface{} and object values
+func decodeOrdered(data []byte) (interface{}, error) {
+	dec := json.NewDecoder(bytes.NewReader(data))
+	dec.UseNumber()
+	return decodeValue(dec)
+}
+
+// decodeValue decodes the next JSON value of dec
+func decodeValue(dec *json.Decoder) (interface{}, error) {
+	tok, err := dec.Token()
+	if err != nil {
+		return nil, err
+	}
+
+	switch tok := tok.(type) {
+	case json.Delim:
+		if tok == '{' {
+			obj := object{}
+			for dec.More() {
+				key, err := dec.Token()
+				if err != nil {
+					return nil, err
+				}
+				value, err := decodeValue(dec)
+				if err != nil {
+					return nil, err
+				}
+				obj = append(obj, key, value)
+			}
+			_, err := dec.Token()
+			return obj, err
+		}
+		items := []interface{}{}
+		for dec.More() {
+			value, err := decodeValue(dec)
+			if err != nil {
+				return nil, err
+			}
+			items = append(items, value)
+		}
+		_, err := dec.Token()
+		return items, err
+	case json.Number:
+		if n, err := tok.Int64(); err == nil {
+			return n, nil
+		}
+		return tok.Float64()
+	}
+	return tok, nil
+}
+
+// xmlDocument is a decoded response as an XML document. The envelope is a
+// <response> element; object keys become child elements, array items <item>
+// elements and nulls empty elements. Keys that are not valid XML names, such
+// as IDs, become <entry key="..."> elements.
+type xmlDocument struct {
+	value interface{}
+}
+
+// MarshalXML writes the XML declaration and the response element
+func (d xmlDocument) MarshalXML(e *xml.Encoder, _ xml.StartElement) error {
+	if err := e.EncodeToken(xml.ProcInst{Target: "xml", Inst: []byte(`version="1.0" encoding="UTF-8"`)}); err != nil {
+		return err
+	}
+	return encodeXMLElement(e, "response", d.value)
+}
+
+// encodeXMLElement writes a value as an element named after its key
+func encodeXMLElement(e *xml.Encoder, key string, value interface{}) error {
+	start := xml.StartElement{Name: xml.Name{Local: key}}
+	if !isXMLName(key) {
+		start = xml.StartElement{
+			Name: xml.Name{Local: "entry"},
+			Attr: []xml.Attr{{Name: xml.Name{Local: "key"}, Value: key}},
+		}
+	}
+	if err := e.EncodeToken(start); err != nil {
+		return err
+	}
+
+	switch v := value.(type) {
+	case object:
+		for i := 0; i < len(v); i += 2 {
+			if err := encodeXMLElement(e, v[i].(string), v[i+1]); err != nil {
+				return err
+			}
+		}
+	case []interface{}:
+		for _, item := range v {
+			if err := encodeXMLElement(e, "item", item); err != nil {
+				return err
+			}
+		}
+	case nil:
+	default:
+		if err := e.EncodeToken(xml.CharData(fmt.Sprint(v))); err != nil {
+			return err
+		}
+	}
+	return e.EncodeToken(start.End())
+}
+
+// isXMLName reports whether a key can be used as an element name as is
+func isXMLName(key string) bool {
+	if key == "" || strings.HasPrefix(strings.ToLower(key), "xml") {
+		return false
+	}
+	for i, r := range key {
+		switch {
+		case r == '_' || unicode.IsLetter(r):
+		case i > 0 && (r == '-' || r == '.' || unicode.IsDigit(r)):
+		default:
+			return false
+		}
+	}
+	return true
+}
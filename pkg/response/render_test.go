@@ -0,0 +1,264 @@
+package response_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"encoding/xml"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/ugorji/go/codec"
+	"github.com/yourusername/go-enterprise-api/pkg/response"
+)
+
+func init() {
+	gin.SetMode(gin.TestMode)
+}
+
+// createdAt is the timestamp of the test data, with a time zone
+var createdAt = time.Date(2024, 3, 9, 14, 30, 15, 0, time.FixedZone("CET", 3600))
+
+// renderedAt is createdAt as rendered by the default time codec
+const renderedAt = "2024-03-09T13:30:15Z"
+
+// testData has nested maps, slices, nulls, numbers and a time, and a key that
+// is not a valid XML name
+func testData() gin.H {
+	return gin.H{
+		"user": gin.H{
+			"name":    "Ada <admin> & co",
+			"roles":   []string{"admin", "editor"},
+			"profile": map[string]interface{}{"bio": nil, "links": []string{}},
+		},
+		"count":      3,
+		"negative":   -40000,
+		"ratio":      0.5,
+		"nothing":    nil,
+		"created_at": createdAt,
+		"by_id":      map[string]int{"7c1f3a52-b8b4-4a8e-9f57-0d1b2a3c4d5e": 1},
+		"matrix":     [][]int{{1, 2}, {3}},
+	}
+}
+
+// get sends a request with an Accept header to a handler answering with the
+// test data, and returns the response
+func get(t *testing.T, accept string) *httptest.ResponseRecorder {
+	t.Helper()
+
+	router := gin.New()
+	router.GET("/", func(c *gin.Context) {
+		response.SuccessWithMessage(c, "Loaded", testData())
+	})
+	req := httptest.NewRequest(http.MethodGet, "/", nil)
+	if accept != "" {
+		req.Header.Set("Accept", accept)
+	}
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+	if w.Code != http.StatusOK {
+		t.Fatalf("got %d, want %d: %s", w.Code, http.StatusOK, w.Body)
+	}
+	return w
+}
+
+// decodeJSON decodes a JSON document into generic values
+func decodeJSON(t *testing.T, data []byte) interface{} {
+	t.Helper()
+	var value interface{}
+	if err := json.Unmarshal(data, &value); err != nil {
+		t.Fatalf("failed to decode JSON %s: %v", data, err)
+	}
+	return value
+}
+
+func TestFormat(t *testing.T) {
+	tests := []struct {
+		accept string
+		want   string
+	}{
+		{"", gin.MIMEJSON},
+		{"*/*", gin.MIMEJSON},
+		{"application/json", gin.MIMEJSON},
+		{"application/xml", response.MIMEXML},
+		{"text/xml", response.MIMETextXML},
+		{"application/msgpack", response.MIMEMsgPack},
+		{"application/x-msgpack", response.MIMEXMsgPack},
+		{"text/html", gin.MIMEJSON},
+		{"application/xml;q=0.5, application/json;q=0.9", gin.MIMEJSON},
+		{"application/json;q=0.5, application/msgpack", response.MIMEMsgPack},
+		{"application/*;q=0.2, application/xml;q=0.8", response.MIMEXML},
+		{"application/json;q=0, application/xml;q=0", gin.MIMEJSON},
+	}
+	for _, tt := range tests {
+		t.Run(tt.accept, func(t *testing.T) {
+			c, _ := gin.CreateTestContext(httptest.NewRecorder())
+			c.Request = httptest.NewRequest(http.MethodGet, "/", nil)
+			c.Request.Header.Set("Accept", tt.accept)
+			if got := response.Format(c); got != tt.want {
+				t.Errorf("got %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestRenderMsgPack(t *testing.T) {
+	for _, accept := range []string{response.MIMEMsgPack, response.MIMEXMsgPack} {
+		t.Run(accept, func(t *testing.T) {
+			w := get(t, accept)
+			if got := w.Header().Get("Content-Type"); got != accept {
+				t.Errorf("got content type %q, want %q", got, accept)
+			}
+
+			var decoded interface{}
+			handle := &codec.MsgpackHandle{}
+			handle.RawToString = true
+			handle.MapType = reflect.TypeOf(map[string]interface{}(nil))
+			if err := codec.NewDecoderBytes(w.Body.Bytes(), handle).Decode(&decoded); err != nil {
+				t.Fatalf("failed to decode MessagePack: %v", err)
+			}
+
+			// The document holds the same values as the JSON one, timestamps
+			// included
+			asJSON, err := json.Marshal(decoded)
+			if err != nil {
+				t.Fatalf("failed to encode the decoded document: %v", err)
+			}
+			got := decodeJSON(t, asJSON)
+			want := decodeJSON(t, get(t, gin.MIMEJSON).Body.Bytes())
+			if !reflect.DeepEqual(got, want) {
+				t.Errorf("got %v, want %v", got, want)
+			}
+
+			data := decoded.(map[string]interface{})["data"].(map[string]interface{})
+			if data["created_at"] != renderedAt {
+				t.Errorf("got created_at %v, want %q", data["created_at"], renderedAt)
+			}
+			if data["nothing"] != nil {
+				t.Errorf("got nothing %v, want nil", data["nothing"])
+			}
+			if count, ok := data["count"].(int64); !ok || count != 3 {
+				t.Errorf("got count %#v, want the integer 3", data["count"])
+			}
+		})
+	}
+}
+
+func TestRenderMsgPackIsStable(t *testing.T) {
+	first := get(t, response.MIMEMsgPack).Body.Bytes()
+	for i := 0; i < 10; i++ {
+		if next := get(t, response.MIMEMsgPack).Body.Bytes(); !bytes.Equal(first, next) {
+			t.Fatalf("encodings differ:\n%x\n%x", first, next)
+		}
+	}
+}
+
+// xmlNode is a decoded XML element
+type xmlNode struct {
+	XMLName  xml.Name
+	Key      string    `xml:"key,attr"`
+	Text     string    `xml:",chardata"`
+	Children []xmlNode `xml:",any"`
+}
+
+// child returns the child element with a name, and the key attribute of
+// <entry> elements
+func (n xmlNode) child(t *testing.T, name string) xmlNode {
+	t.Helper()
+	for _, c := range n.Children {
+		if c.XMLName.Local == name || (c.XMLName.Local == "entry" && c.Key == name) {
+			return c
+		}
+	}
+	t.Fatalf("<%s> has no child %q", n.XMLName.Local, name)
+	return xmlNode{}
+}
+
+// texts returns the text of the children of an element
+func (n xmlNode) texts() []string {
+	texts := []string{}
+	for _, c := range n.Children {
+		texts = append(texts, c.Text)
+	}
+	return texts
+}
+
+func TestRenderXML(t *testing.T) {
+	for _, accept := range []string{response.MIMEXML, response.MIMETextXML} {
+		t.Run(accept, func(t *testing.T) {
+			w := get(t, accept)
+			if got, want := w.Header().Get("Content-Type"), accept+"; charset=utf-8"; got != want {
+				t.Errorf("got content type %q, want %q", got, want)
+			}
+			if !bytes.HasPrefix(w.Body.Bytes(), []byte(`<?xml version="1.0" encoding="UTF-8"?>`)) {
+				t.Errorf("got %s, want an XML declaration first", w.Body)
+			}
+
+			var root xmlNode
+			if err := xml.NewDecoder(io.NopCloser(w.Body)).Decode(&root); err != nil {
+				t.Fatalf("failed to decode XML %s: %v", w.Body, err)
+			}
+			if root.XMLName.Local != "response" {
+				t.Fatalf("got root <%s>, want <response>", root.XMLName.Local)
+			}
+			data := root.child(t, "data")
+			user := data.child(t, "user")
+
+			tests := []struct {
+				name string
+				got  interface{}
+				want interface{}
+			}{
+				{"success", root.child(t, "success").Text, "true"},
+				{"message", root.child(t, "message").Text, "Loaded"},
+				{"escaped text", user.child(t, "name").Text, "Ada <admin> & co"},
+				{"slice", user.child(t, "roles").texts(), []string{"admin", "editor"}},
+				{"nested null", len(user.child(t, "profile").child(t, "bio").Children), 0},
+				{"empty slice", len(user.child(t, "profile").child(t, "links").Children), 0},
+				{"integer", data.child(t, "count").Text, "3"},
+				{"negative integer", data.child(t, "negative").Text, "-40000"},
+				{"float", data.child(t, "ratio").Text, "0.5"},
+				{"null", data.child(t, "nothing").Text, ""},
+				{"time", data.child(t, "created_at").Text, renderedAt},
+				{"key that is not a name", data.child(t, "by_id").child(t, "7c1f3a52-b8b4-4a8e-9f57-0d1b2a3c4d5e").Text, "1"},
+				{"nested slices", len(data.child(t, "matrix").Children), 2},
+				{"inner slice", data.child(t, "matrix").Children[0].texts(), []string{"1", "2"}},
+			}
+			for _, tt := range tests {
+				if !reflect.DeepEqual(tt.got, tt.want) {
+					t.Errorf("%s: got %#v, want %#v", tt.name, tt.got, tt.want)
+				}
+			}
+		})
+	}
+}
+
+func TestRenderJSON(t *testing.T) {
+	for _, accept := range []string{"", "application/json", "text/html"} {
+		t.Run(accept, func(t *testing.T) {
+			w := get(t, accept)
+			if got := w.Header().Get("Content-Type"); got != "application/json; charset=utf-8" {
+				t.Errorf("got content type %q, want JSON", got)
+			}
+
+			var resp struct {
+				Success bool `json:"success"`
+				Data    struct {
+					CreatedAt time.Time       `json:"created_at"`
+					Nothing   *string         `json:"nothing"`
+					User      json.RawMessage `json:"user"`
+				} `json:"data"`
+			}
+			if err := json.Unmarshal(w.Body.Bytes(), &resp); err != nil {
+				t.Fatalf("failed to decode JSON %s: %v", w.Body, err)
+			}
+			if !resp.Success || !resp.Data.CreatedAt.Equal(createdAt) || resp.Data.Nothing != nil || len(resp.Data.User) == 0 {
+				t.Errorf("got %+v, want the test data", resp)
+			}
+		})
+	}
+}
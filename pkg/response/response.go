@@ -1,10 +1,15 @@
 package response
 
 import (
+	"encoding/csv"
 	"net/http"
+	"strings"
 
 	"github.com/gin-gonic/gin"
 	apperrors "github.com/yourusername/go-enterprise-api/pkg/errors"
+	"github.com/yourusername/go-enterprise-api/pkg/i18n"
+	"github.com/yourusername/go-enterprise-api/pkg/requestid"
+	"github.com/yourusername/go-enterprise-api/pkg/validator"
 )
 
 // Response represents a standard API response
@@ -16,48 +21,85 @@ type Response struct {
 	Meta    *Meta       `json:"meta,omitempty"`
 }
 
-// ErrorInfo represents error information in response
+// ErrorInfo represents error information in response. RequestID lets
+// clients quote the failed request when reporting a problem.
 type ErrorInfo struct {
-	Code    int         `json:"code"`
-	Message string      `json:"message"`
-	Details string      `json:"details,omitempty"`
-	Data    interface{} `json:"data,omitempty"`
+	Code      int         `json:"code"`
+	Message   string      `json:"message"`
+	Details   string      `json:"details,omitempty"`
+	Data      interface{} `json:"data,omitempty"`
+	RequestID string      `json:"request_id,omitempty"`
 }
 
 // Meta represents pagination or additional metadata
 type Meta struct {
-	Page       int   `json:"page,omitempty"`
-	PerPage    int   `json:"per_page,omitempty"`
-	Total      int64 `json:"total,omitempty"`
-	TotalPages int   `json:"total_pages,omitempty"`
+	Page       int    `json:"page,omitempty"`
+	PerPage    int    `json:"per_page,omitempty"`
+	Total      int64  `json:"total,omitempty"`
+	TotalPages int    `json:"total_pages,omitempty"`
+	NextCursor string `json:"next_cursor,omitempty"`
+}
+
+// RequestID returns the ID of the request being answered
+func RequestID(c *gin.Context) string {
+	return requestid.FromContext(c.Request.Context())
 }
 
 // Success sends a success response
 func Success(c *gin.Context, data interface{}) {
-	c.JSON(http.StatusOK, Response{
+	Render(c, http.StatusOK, Response{
 		Success: true,
-		Data:    data,
+		Data:    encodeData(c, data),
 	})
 }
 
 // SuccessWithMessage sends a success response with a message
 func SuccessWithMessage(c *gin.Context, message string, data interface{}) {
-	c.JSON(http.StatusOK, Response{
+	Render(c, http.StatusOK, Response{
 		Success: true,
 		Message: message,
-		Data:    data,
+		Data:    encodeData(c, data),
 	})
 }
 
 // Created sends a 201 created response
 func Created(c *gin.Context, data interface{}) {
-	c.JSON(http.StatusCreated, Response{
+	Render(c, http.StatusCreated, Response{
 		Success: true,
 		Message: "Resource created successfully",
-		Data:    data,
+		Data:    encodeData(c, data),
+	})
+}
+
+// MovedPermanently sends a 301 response pointing the client at the canonical location
+func MovedPermanently(c *gin.Context, location string, data interface{}) {
+	c.Header("Location", location)
+	Render(c, http.StatusMovedPermanently, Response{
+		Success: true,
+		Message: "Resource moved permanently",
+		Data:    encodeData(c, data),
 	})
 }
 
+// CSV sends records as a CSV file attachment. Cells that a spreadsheet would
+// evaluate as formulas are prefixed with a quote.
+func CSV(c *gin.Context, filename string, records [][]string) {
+	c.Header("Content-Disposition", `attachment; filename="`+filename+`"`)
+	c.Header("Content-Type", "text/csv; charset=utf-8")
+	c.Status(http.StatusOK)
+
+	w := csv.NewWriter(c.Writer)
+	for _, record := range records {
+		for i, cell := range record {
+			if cell != "" && strings.ContainsRune("=+-@\t\r", rune(cell[0])) {
+				record[i] = "'" + cell
+			}
+		}
+		_ = w.Write(record)
+	}
+	w.Flush()
+}
+
 // NoContent sends a 204 no content response
 func NoContent(c *gin.Context) {
 	c.Status(http.StatusNoContent)
@@ -70,9 +112,9 @@ func Paginated(c *gin.Context, data interface{}, page, perPage int, total int64)
 		totalPages++
 	}
 
-	c.JSON(http.StatusOK, Response{
+	Render(c, http.StatusOK, Response{
 		Success: true,
-		Data:    data,
+		Data:    encodeData(c, data),
 		Meta: &Meta{
 			Page:       page,
 			PerPage:    perPage,
@@ -82,84 +124,147 @@ func Paginated(c *gin.Context, data interface{}, page, perPage int, total int64)
 	})
 }
 
-// Error sends an error response
+// CursorPaginated sends a cursor-paginated response. An empty nextCursor
+// marks the last page.
+func CursorPaginated(c *gin.Context, data interface{}, perPage int, nextCursor string) {
+	Render(c, http.StatusOK, Response{
+		Success: true,
+		Data:    encodeData(c, data),
+		Meta: &Meta{
+			PerPage:    perPage,
+			NextCursor: nextCursor,
+		},
+	})
+}
+
+// Error sends an error response. Server errors are attached to the
+// request, so the request log records their cause and stack.
 func Error(c *gin.Context, err error) {
 	appErr := apperrors.GetAppError(err)
+	if appErr.StatusCode >= http.StatusInternalServerError {
+		_ = c.Error(appErr)
+	}
 
-	c.JSON(appErr.StatusCode, Response{
+	Render(c, appErr.StatusCode, Response{
 		Success: false,
-		Error: &ErrorInfo{
+		Error: Localize(c, &ErrorInfo{
 			Code:    appErr.Code,
 			Message: appErr.Message,
 			Details: appErr.Details,
 			Data:    appErr.Data,
-		},
+		}),
 	})
 }
 
+// Localize translates an error into the language negotiated for the
+// request, which it names in the Content-Language header, and adds the
+// request ID
+func Localize(c *gin.Context, info *ErrorInfo) *ErrorInfo {
+	lang := i18n.FromContext(c.Request.Context())
+	c.Header("Content-Language", lang)
+	info.Message = i18n.T(lang, info.Message)
+	info.Details = i18n.T(lang, info.Details)
+	info.RequestID = RequestID(c)
+	return info
+}
+
+// translateValidationErrors translates validation errors into the language
+// negotiated for the request. Messages added with a format are translated
+// from it, so catalogs hold one entry per rule rather than per field.
+func translateValidationErrors(c *gin.Context, errs []apperrors.ValidationError) []apperrors.ValidationError {
+	lang := i18n.FromContext(c.Request.Context())
+	translated := make([]apperrors.ValidationError, len(errs))
+	for i, e := range errs {
+		translated[i] = e
+		if e.Format != "" {
+			translated[i].Message = i18n.T(lang, e.Format, e.Args...)
+		} else {
+			translated[i].Message = i18n.T(lang, e.Message)
+		}
+	}
+	return translated
+}
+
 // ValidationError sends a validation error response
 func ValidationError(c *gin.Context, errors *apperrors.ValidationErrors) {
-	c.JSON(http.StatusBadRequest, Response{
+	Render(c, http.StatusBadRequest, Response{
 		Success: false,
-		Error: &ErrorInfo{
+		Error: Localize(c, &ErrorInfo{
 			Code:    apperrors.CodeValidationError,
 			Message: "Validation failed",
-			Data:    errors.Errors,
-		},
+			Data:    translateValidationErrors(c, errors.Errors),
+		}),
 	})
 }
 
+// BindingError sends a validation error response describing why the request body could not be bound
+func BindingError(c *gin.Context, err error) {
+	ValidationError(c, validator.BindingErrors(err))
+}
+
 // BadRequest sends a 400 bad request response
 func BadRequest(c *gin.Context, message string) {
-	c.JSON(http.StatusBadRequest, Response{
+	Render(c, http.StatusBadRequest, Response{
 		Success: false,
-		Error: &ErrorInfo{
+		Error: Localize(c, &ErrorInfo{
 			Code:    apperrors.CodeBadRequest,
 			Message: message,
-		},
+		}),
 	})
 }
 
 // Unauthorized sends a 401 unauthorized response
 func Unauthorized(c *gin.Context, message string) {
-	c.JSON(http.StatusUnauthorized, Response{
+	Render(c, http.StatusUnauthorized, Response{
 		Success: false,
-		Error: &ErrorInfo{
+		Error: Localize(c, &ErrorInfo{
 			Code:    apperrors.CodeUnauthorized,
 			Message: message,
-		},
+		}),
 	})
 }
 
 // Forbidden sends a 403 forbidden response
 func Forbidden(c *gin.Context, message string) {
-	c.JSON(http.StatusForbidden, Response{
+	Render(c, http.StatusForbidden, Response{
 		Success: false,
-		Error: &ErrorInfo{
+		Error: Localize(c, &ErrorInfo{
 			Code:    apperrors.CodeForbidden,
 			Message: message,
-		},
+		}),
 	})
 }
 
 // NotFound sends a 404 not found response
 func NotFound(c *gin.Context, message string) {
-	c.JSON(http.StatusNotFound, Response{
+	Render(c, http.StatusNotFound, Response{
 		Success: false,
-		Error: &ErrorInfo{
+		Error: Localize(c, &ErrorInfo{
 			Code:    apperrors.CodeNotFound,
 			Message: message,
-		},
+		}),
+	})
+}
+
+// Gone sends a 410 gone response with a tombstone describing the removed resource
+func Gone(c *gin.Context, message string, tombstone interface{}) {
+	Render(c, http.StatusGone, Response{
+		Success: false,
+		Data:    encodeData(c, tombstone),
+		Error: Localize(c, &ErrorInfo{
+			Code:    apperrors.CodeGone,
+			Message: message,
+		}),
 	})
 }
 
 // InternalServerError sends a 500 internal server error response
 func InternalServerError(c *gin.Context, message string) {
-	c.JSON(http.StatusInternalServerError, Response{
+	Render(c, http.StatusInternalServerError, Response{
 		Success: false,
-		Error: &ErrorInfo{
+		Error: Localize(c, &ErrorInfo{
 			Code:    apperrors.CodeInternalError,
 			Message: message,
-		},
+		}),
 	})
 }
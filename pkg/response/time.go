@@ -0,0 +1,217 @@
+package response
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"io"
+	"strings"
+	"time"
+
+	"github.com/gin-gonic/gin"
+)
+
+// TimeCodecKey is the context key for the request's time codec
+const TimeCodecKey = "time_codec"
+
+// TimeFormat represents how timestamps are rendered in responses
+type TimeFormat string
+
+const (
+	TimeFormatRFC3339   TimeFormat = "rfc3339"
+	TimeFormatUnix      TimeFormat = "unix"
+	TimeFormatUnixMilli TimeFormat = "unix_ms"
+)
+
+// TimeCodec renders timestamps in a timezone and format
+type TimeCodec struct {
+	Location *time.Location
+	Format   TimeFormat
+}
+
+// DefaultTimeCodec renders timestamps as RFC3339 in UTC
+var DefaultTimeCodec = TimeCodec{
+	Location: time.UTC,
+	Format:   TimeFormatRFC3339,
+}
+
+// ErrInvalidTimeFormat is returned when a time format is not supported
+var ErrInvalidTimeFormat = errors.New("time format must be one of: rfc3339, unix, unix_ms")
+
+// NewTimeCodec creates a time codec from a timezone name and format
+func NewTimeCodec(timezone, format string) (TimeCodec, error) {
+	codec := DefaultTimeCodec
+
+	if timezone != "" {
+		loc, err := time.LoadLocation(timezone)
+		if err != nil {
+			return codec, err
+		}
+		codec.Location = loc
+	}
+
+	if format != "" {
+		switch f := TimeFormat(strings.ToLower(format)); f {
+		case TimeFormatRFC3339, TimeFormatUnix, TimeFormatUnixMilli:
+			codec.Format = f
+		default:
+			return codec, ErrInvalidTimeFormat
+		}
+	}
+
+	return codec, nil
+}
+
+// Encode returns the JSON value for a timestamp
+func (tc TimeCodec) Encode(t time.Time) interface{} {
+	switch tc.Format {
+	case TimeFormatUnix:
+		return t.Unix()
+	case TimeFormatUnixMilli:
+		return t.UnixMilli()
+	default:
+		return t.In(tc.Location).Format(time.RFC3339)
+	}
+}
+
+// SetTimeCodec sets the time codec used for the request's responses
+func SetTimeCodec(c *gin.Context, codec TimeCodec) {
+	c.Set(TimeCodecKey, codec)
+}
+
+// GetTimeCodec gets the time codec for the request, falling back to the default
+func GetTimeCodec(c *gin.Context) TimeCodec {
+	if codec, exists := c.Get(TimeCodecKey); exists {
+		if tc, ok := codec.(TimeCodec); ok {
+			return tc
+		}
+	}
+	return DefaultTimeCodec
+}
+
+// encodeData marshals response data, rendering timestamps with the request's time codec
+func encodeData(c *gin.Context, data interface{}) interface{} {
+	if data == nil {
+		return nil
+	}
+
+	raw, err := json.Marshal(data)
+	if err != nil {
+		return data
+	}
+
+	encoded, err := rewriteTimes(raw, GetTimeCodec(c))
+	if err != nil {
+		return data
+	}
+
+	return json.RawMessage(encoded)
+}
+
+// Marshal encodes data as JSON the way responses render it, for handlers that
+// stream their own output
+func Marshal(c *gin.Context, data interface{}) ([]byte, error) {
+	raw, err := json.Marshal(data)
+	if err != nil {
+		return nil, err
+	}
+	return rewriteTimes(raw, GetTimeCodec(c))
+}
+
+// isTimeKey reports whether an object key holds a timestamp (e.g. created_at)
+func isTimeKey(key string) bool {
+	return strings.HasSuffix(key, "_at")
+}
+
+// jsonFrame tracks the state of an open JSON object or array
+type jsonFrame struct {
+	object    bool
+	first     bool
+	expectKey bool
+	key       string
+}
+
+// rewriteTimes re-encodes a JSON document, preserving key order, and renders
+// RFC3339 strings stored under timestamp keys with the given codec
+func rewriteTimes(data []byte, codec TimeCodec) ([]byte, error) {
+	dec := json.NewDecoder(bytes.NewReader(data))
+	dec.UseNumber()
+
+	var buf bytes.Buffer
+	var stack []*jsonFrame
+
+	for {
+		tok, err := dec.Token()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		if d, ok := tok.(json.Delim); ok && (d == '}' || d == ']') {
+			buf.WriteByte(byte(d))
+			stack = stack[:len(stack)-1]
+			continue
+		}
+
+		var top *jsonFrame
+		if len(stack) > 0 {
+			top = stack[len(stack)-1]
+		}
+
+		if top != nil {
+			if !top.first && (!top.object || top.expectKey) {
+				buf.WriteByte(',')
+			}
+			top.first = false
+
+			if top.object && top.expectKey {
+				key, _ := tok.(string)
+				if err := writeJSON(&buf, key); err != nil {
+					return nil, err
+				}
+				buf.WriteByte(':')
+				top.key = key
+				top.expectKey = false
+				continue
+			}
+		}
+
+		switch v := tok.(type) {
+		case json.Delim:
+			buf.WriteByte(byte(v))
+			stack = append(stack, &jsonFrame{object: v == '{', first: true, expectKey: v == '{'})
+		case string:
+			var value interface{} = v
+			if top != nil && top.object && isTimeKey(top.key) {
+				if t, err := time.Parse(time.RFC3339Nano, v); err == nil {
+					value = codec.Encode(t)
+				}
+			}
+			if err := writeJSON(&buf, value); err != nil {
+				return nil, err
+			}
+		default:
+			if err := writeJSON(&buf, v); err != nil {
+				return nil, err
+			}
+		}
+
+		if top != nil && top.object {
+			top.expectKey = true
+		}
+	}
+
+	return buf.Bytes(), nil
+}
+
+// writeJSON writes the JSON encoding of a scalar value
+func writeJSON(buf *bytes.Buffer, v interface{}) error {
+	b, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+	buf.Write(b)
+	return nil
+}
@@ -0,0 +1,202 @@
+// Package sanitize cleans free-text request fields before they are
+// validated, according to their sanitize struct tag:
+//
+//	Title string `json:"title" sanitize:"text,striptags"`
+//
+// The rules are:
+//
+//   - trim: removes leading and trailing whitespace
+//   - nfc: normalizes unicode to NFC, so visually equal text compares equal
+//   - nocontrol: removes control characters other than newlines and tabs,
+//     and the bidirectional overrides that can disguise text
+//   - striptags: removes HTML tags, keeping their text as written, and the
+//     content of scripts and styles
+//   - escape: escapes HTML special characters
+//   - text: shorthand for nfc,nocontrol,trim
+//
+// Rules run in the order listed above whatever the order in the tag, so
+// trimming also removes whitespace left by stripped tags.
+package sanitize
+
+import (
+	"html"
+	"reflect"
+	"strings"
+	"unicode"
+
+	xhtml "golang.org/x/net/html"
+	"golang.org/x/text/unicode/norm"
+)
+
+// TagName is the struct tag holding a field's rules
+const TagName = "sanitize"
+
+// rules is a parsed sanitize tag
+type rules struct {
+	nfc, nocontrol, striptags, escape, trim bool
+}
+
+// parseRules parses a sanitize tag. Unknown rules panic, as they are a
+// programming error.
+func parseRules(tag string) rules {
+	var r rules
+	for _, name := range strings.Split(tag, ",") {
+		switch strings.TrimSpace(name) {
+		case "text":
+			r.nfc, r.nocontrol, r.trim = true, true, true
+		case "trim":
+			r.trim = true
+		case "nfc":
+			r.nfc = true
+		case "nocontrol":
+			r.nocontrol = true
+		case "striptags":
+			r.striptags = true
+		case "escape":
+			r.escape = true
+		case "":
+		default:
+			panic("sanitize: unknown rule " + name)
+		}
+	}
+	return r
+}
+
+// apply sanitizes a value according to r
+func (r rules) apply(value string) string {
+	if r.nfc {
+		value = norm.NFC.String(value)
+	}
+	if r.nocontrol {
+		value = StripControl(value)
+	}
+	if r.striptags {
+		value = StripTags(value)
+	}
+	if r.escape {
+		value = html.EscapeString(value)
+	}
+	if r.trim {
+		value = strings.TrimSpace(value)
+	}
+	return value
+}
+
+// String sanitizes a value with the rules of a sanitize tag
+func String(value, tag string) string {
+	return parseRules(tag).apply(value)
+}
+
+// Struct sanitizes the tagged fields of the struct ptr points to, in place.
+// Tagged fields may be strings, pointers to strings or slices of strings;
+// nested structs, and pointers and slices of them, are sanitized too. Values
+// other than pointers to structs are left alone.
+func Struct(ptr interface{}) {
+	v := reflect.ValueOf(ptr)
+	if v.Kind() != reflect.Pointer || v.IsNil() || v.Elem().Kind() != reflect.Struct {
+		return
+	}
+	sanitizeStruct(v.Elem())
+}
+
+// sanitizeStruct sanitizes the fields of an addressable struct value
+func sanitizeStruct(v reflect.Value) {
+	t := v.Type()
+	for i := 0; i < t.NumField(); i++ {
+		field := t.Field(i)
+		if !field.IsExported() {
+			continue
+		}
+		value := v.Field(i)
+		if tag, ok := field.Tag.Lookup(TagName); ok {
+			sanitizeValue(value, parseRules(tag))
+			continue
+		}
+		sanitizeNested(value)
+	}
+}
+
+// sanitizeValue sanitizes a tagged string, pointer to a string or slice of
+// strings
+func sanitizeValue(v reflect.Value, r rules) {
+	switch v.Kind() {
+	case reflect.String:
+		v.SetString(r.apply(v.String()))
+	case reflect.Pointer:
+		if !v.IsNil() {
+			sanitizeValue(v.Elem(), r)
+		}
+	case reflect.Slice:
+		for i := 0; i < v.Len(); i++ {
+			sanitizeValue(v.Index(i), r)
+		}
+	}
+}
+
+// sanitizeNested sanitizes the structs held by an untagged field
+func sanitizeNested(v reflect.Value) {
+	switch v.Kind() {
+	case reflect.Struct:
+		sanitizeStruct(v)
+	case reflect.Pointer:
+		if !v.IsNil() && v.Elem().Kind() == reflect.Struct {
+			sanitizeStruct(v.Elem())
+		}
+	case reflect.Slice:
+		for i := 0; i < v.Len(); i++ {
+			sanitizeNested(v.Index(i))
+		}
+	}
+}
+
+// StripControl removes control characters other than newlines and tabs,
+// and the bidirectional overrides and isolates (U+202A-U+202E and
+// U+2066-U+2069) that can make text display differently from what it is
+func StripControl(value string) string {
+	return strings.Map(func(r rune) rune {
+		switch {
+		case r == '\n' || r == '\t':
+			return r
+		case unicode.IsControl(r):
+			return -1
+		case r >= '\u202a' && r <= '\u202e', r >= '\u2066' && r <= '\u2069':
+			return -1
+		}
+		return r
+	}, value)
+}
+
+// StripTags removes HTML tags from a value, keeping their text as written.
+// Entities are not decoded, so escaped markup such as &lt;b&gt; stays text
+// instead of becoming a tag. The content of scripts and styles is removed too.
+func StripTags(value string) string {
+	if !strings.Contains(value, "<") {
+		return value
+	}
+
+	var b strings.Builder
+	z := xhtml.NewTokenizer(strings.NewReader(value))
+	skipping := ""
+	for {
+		tt := z.Next()
+		if tt == xhtml.ErrorToken {
+			return b.String()
+		}
+		if tt == xhtml.TextToken {
+			// Raw must be read before Token, which decodes the text in place
+			if skipping == "" {
+				b.Write(z.Raw())
+			}
+			continue
+		}
+		token := z.Token()
+		switch {
+		case skipping != "":
+			if tt == xhtml.EndTagToken && token.Data == skipping {
+				skipping = ""
+			}
+		case tt == xhtml.StartTagToken && (token.Data == "script" || token.Data == "style"):
+			skipping = token.Data
+		}
+	}
+}
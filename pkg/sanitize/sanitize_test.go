@@ -0,0 +1,59 @@
+package sanitize_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/yourusername/go-enterprise-api/pkg/sanitize"
+)
+
+func TestStripTags(t *testing.T) {
+	tests := []struct {
+		name  string
+		value string
+		want  string
+	}{
+		{"plain text", "Hello, world", "Hello, world"},
+		{"tags", "<b>Hello</b>, <i>world</i>", "Hello, world"},
+		{"script", "Hi<script>alert(1)</script>!", "Hi!"},
+		{"style", "<style>p { color: red }</style>Hi", "Hi"},
+		{"ampersand", "Tom & Jerry", "Tom & Jerry"},
+		{"entities kept", "AT&amp;T <b>rocks</b>", "AT&amp;T rocks"},
+		{"entity-encoded script", "&lt;script&gt;alert(1)&lt;/script&gt;", "&lt;script&gt;alert(1)&lt;/script&gt;"},
+		{"entity-encoded tag in a tag", "<p>&lt;img src=x onerror=alert(1)&gt;</p>", "&lt;img src=x onerror=alert(1)&gt;"},
+		{"numeric entities", "&#60;b&#62;bold&#x3C;/b&#x3E;", "&#60;b&#62;bold&#x3C;/b&#x3E;"},
+		{"unclosed tag", "Hi <b", "Hi "},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := sanitize.StripTags(tt.value)
+			if got != tt.want {
+				t.Errorf("got %q, want %q", got, tt.want)
+			}
+			if strings.Contains(got, "<") {
+				t.Errorf("got %q, want no markup left", got)
+			}
+		})
+	}
+}
+
+func TestString(t *testing.T) {
+	tests := []struct {
+		name  string
+		value string
+		tag   string
+		want  string
+	}{
+		{"text", "  Café‮\x00 ", "text", "Café"},
+		{"striptags then trim", " <b>Title</b> ", "text,striptags", "Title"},
+		{"escaped markup stays text", "&lt;b&gt;Title&lt;/b&gt;", "text,striptags", "&lt;b&gt;Title&lt;/b&gt;"},
+		{"escape", "<b>", "escape", "&lt;b&gt;"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := sanitize.String(tt.value, tt.tag); got != tt.want {
+				t.Errorf("got %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
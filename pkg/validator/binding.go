@@ -0,0 +1,183 @@
+package validator
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"reflect"
+	"strconv"
+	"strings"
+
+	"github.com/gin-gonic/gin/binding"
+	playground "github.com/go-playground/validator/v10"
+	apperrors "github.com/yourusername/go-enterprise-api/pkg/errors"
+	"github.com/yourusername/go-enterprise-api/pkg/sanitize"
+)
+
+func init() {
+	if engine, ok := binding.Validator.Engine().(*playground.Validate); ok {
+		// Report binding errors using JSON field names instead of Go struct field names
+		engine.RegisterTagNameFunc(func(field reflect.StructField) string {
+			name := strings.SplitN(field.Tag.Get("json"), ",", 2)[0]
+			if name == "-" {
+				return ""
+			}
+			if name == "" {
+				return field.Name
+			}
+			return name
+		})
+		registerTags(engine)
+	}
+	binding.Validator = sanitizingValidator{binding.Validator}
+}
+
+// sanitizingValidator sanitizes the fields of bound requests according to
+// their sanitize tags before validating them, so every handler binding a
+// request gets the same cleanup
+type sanitizingValidator struct {
+	binding.StructValidator
+}
+
+// ValidateStruct sanitizes and then validates a bound request
+func (v sanitizingValidator) ValidateStruct(obj interface{}) error {
+	sanitize.Struct(obj)
+	return v.StructValidator.ValidateStruct(obj)
+}
+
+// BindingErrors translates a gin/JSON binding error into field-level
+// validation errors, with the messages the Validator methods use for the
+// same rules
+func BindingErrors(err error) *apperrors.ValidationErrors {
+	errs := apperrors.NewValidationErrors()
+
+	var (
+		syntaxErr *json.SyntaxError
+		typeErr   *json.UnmarshalTypeError
+		fieldErrs playground.ValidationErrors
+		numErr    *strconv.NumError
+	)
+
+	switch {
+	case errors.Is(err, io.EOF):
+		errs.Add("body", "request body is required")
+	case errors.Is(err, io.ErrUnexpectedEOF):
+		errs.Add("body", "request body is not valid JSON: unexpected end of input")
+	case errors.As(err, &syntaxErr):
+		errs.Addf("body", "request body is not valid JSON at position %d", syntaxErr.Offset)
+	case errors.As(err, &typeErr):
+		field := typeErr.Field
+		if field == "" {
+			field = "body"
+		}
+		errs.Addf(field, typeMismatchFormat(typeErr.Type.Kind()), typeErr.Value)
+	case errors.As(err, &fieldErrs):
+		for _, fe := range fieldErrs {
+			// A weak password breaks one or more rules, each reported on its own
+			if fe.Tag() == "password" {
+				for _, message := range passwordProblems(fmt.Sprint(fe.Value())) {
+					errs.Add(fieldPath(fe), message)
+				}
+				continue
+			}
+			format, args := fieldMessage(fe)
+			errs.Addf(fieldPath(fe), format, args...)
+		}
+	case errors.As(err, &numErr):
+		errs.Addf("body", "invalid number %q", numErr.Num)
+	case strings.HasPrefix(err.Error(), "json: unknown field "):
+		field := strings.Trim(strings.TrimPrefix(err.Error(), "json: unknown field "), `"`)
+		errs.Add(field, "unknown field")
+	default:
+		errs.Add("body", "invalid request body")
+	}
+
+	return errs
+}
+
+// fieldPath returns the JSON path of a failed field without the root struct name
+func fieldPath(fe playground.FieldError) string {
+	ns := fe.Namespace()
+	if i := strings.Index(ns, "."); i >= 0 {
+		return ns[i+1:]
+	}
+	return fe.Field()
+}
+
+// fieldMessage returns a human readable message format, and its arguments,
+// for a failed binding tag
+func fieldMessage(fe playground.FieldError) (string, []interface{}) {
+	field := fieldPath(fe)
+	withParam := []interface{}{field, fe.Param()}
+	switch fe.Tag() {
+	case "required", "notblank":
+		return "%s is required", []interface{}{field}
+	case "email":
+		return "%s must be a valid email address", []interface{}{field}
+	case "min", "gte":
+		return "%s must be at least %s" + sizeUnit(fe), withParam
+	case "max", "lte":
+		return "%s must be at most %s" + sizeUnit(fe), withParam
+	case "gt":
+		return "%s must be greater than %s" + sizeUnit(fe), withParam
+	case "lt":
+		return "%s must be less than %s" + sizeUnit(fe), withParam
+	case "len":
+		return "%s must be exactly %s" + sizeUnit(fe), withParam
+	case "oneof":
+		return "%s must be one of: %s", []interface{}{field, strings.Join(strings.Fields(fe.Param()), ", ")}
+	case "url":
+		return "%s must be a valid URL", []interface{}{field}
+	case "httpurl":
+		return "%s must be a valid http or https URL", []interface{}{field}
+	case "uuid":
+		return "%s must be a valid UUID", []interface{}{field}
+	case "slug":
+		return "%s must contain only lowercase letters, numbers and hyphens", []interface{}{field}
+	case "phone":
+		return "%s must be a valid E.164 phone number", []interface{}{field}
+	case "date":
+		return "%s must be an ISO-8601 date", []interface{}{field}
+	case "birthdate":
+		return "%s must be a valid date in the past", []interface{}{field}
+	default:
+		return "%s failed %s validation", []interface{}{field, fe.Tag()}
+	}
+}
+
+// sizeUnit returns the unit a size tag's parameter counts for the failed
+// field: characters of strings and items of collections. Numbers compare
+// by value and have none.
+func sizeUnit(fe playground.FieldError) string {
+	switch fe.Kind() {
+	case reflect.String:
+		return " characters"
+	case reflect.Slice, reflect.Array, reflect.Map:
+		return " items"
+	default:
+		return ""
+	}
+}
+
+// typeMismatchFormat returns the message format for a JSON value of the
+// wrong type for a field of a Go kind. The format takes the type received.
+func typeMismatchFormat(kind reflect.Kind) string {
+	switch kind {
+	case reflect.String:
+		return "must be a string, got %s"
+	case reflect.Bool:
+		return "must be a boolean, got %s"
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return "must be an integer, got %s"
+	case reflect.Float32, reflect.Float64:
+		return "must be a number, got %s"
+	case reflect.Slice, reflect.Array:
+		return "must be an array, got %s"
+	case reflect.Map, reflect.Struct:
+		return "must be an object, got %s"
+	default:
+		return "must be a valid value, got %s"
+	}
+}
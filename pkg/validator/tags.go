@@ -0,0 +1,39 @@
+package validator
+
+import (
+	"strings"
+
+	playground "github.com/go-playground/validator/v10"
+)
+
+// customTags are the validation tags registered on top of the built-in ones,
+// so request structs can declare the same rules as the Validator methods:
+//
+//	Password string `json:"password" binding:"required,password"`
+//	Name     string `json:"name" binding:"notblank,max=100"`
+//
+// uuid replaces the built-in tag, which only accepts lowercase UUIDs.
+var customTags = map[string]func(value string) bool{
+	"notblank":  func(value string) bool { return strings.TrimSpace(value) != "" },
+	"password":  ValidatePassword,
+	"slug":      slugRegex.MatchString,
+	"uuid":      uuidRegex.MatchString,
+	"httpurl":   ValidateURL,
+	"phone":     phoneRegex.MatchString,
+	"date":      func(value string) bool { _, ok := ParseDate(value); return ok },
+	"birthdate": ValidateBirthdate,
+}
+
+// registerTags registers the custom tags on engine. They apply to string
+// fields, and to pointers to strings that are set.
+func registerTags(engine *playground.Validate) {
+	for tag, valid := range customTags {
+		valid := valid
+		err := engine.RegisterValidation(tag, func(fl playground.FieldLevel) bool {
+			return valid(fl.Field().String())
+		})
+		if err != nil {
+			panic("validator: registering tag " + tag + ": " + err.Error())
+		}
+	}
+}
@@ -1,16 +1,35 @@
 package validator
 
 import (
+	"fmt"
+	"net/url"
 	"regexp"
+	"strconv"
 	"strings"
+	"time"
 	"unicode"
 
 	apperrors "github.com/yourusername/go-enterprise-api/pkg/errors"
 )
 
+var (
+	phoneRegex = regexp.MustCompile(`^\+[1-9][0-9]{1,14}$`)
+	slugRegex  = regexp.MustCompile(`^[a-z0-9]+(?:-[a-z0-9]+)*$`)
+	uuidRegex  = regexp.MustCompile(`^[0-9a-fA-F]{8}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{12}$`)
+)
+
 // Validator provides validation utilities
 type Validator struct {
 	errors *apperrors.ValidationErrors
+	prefix string
+	// skip discards the errors of rules applied under a false When
+	skip bool
+}
+
+// Validatable is implemented by types that can validate their own fields,
+// allowing them to be validated as nested structs or slice elements
+type Validatable interface {
+	ValidateWith(v *Validator)
 }
 
 // New creates a new Validator
@@ -35,16 +54,121 @@ func (v *Validator) HasErrors() bool {
 
 // AddError adds a validation error
 func (v *Validator) AddError(field, message string) {
-	v.errors.Add(field, message)
+	if v.skip {
+		return
+	}
+	v.errors.Add(v.path(field), message)
+}
+
+// fail adds message, or when it is empty the rule's default message
+// formatted from format and args. Default messages keep their format so
+// they can be translated.
+func (v *Validator) fail(field, message, format string, args ...interface{}) {
+	if v.skip {
+		return
+	}
+	if message != "" {
+		v.AddError(field, message)
+		return
+	}
+	v.errors.Addf(v.path(field), format, args...)
+}
+
+// path returns the full field path including any nesting prefix
+func (v *Validator) path(field string) string {
+	switch {
+	case v.prefix == "":
+		return field
+	case field == "":
+		return v.prefix
+	case strings.HasPrefix(field, "["):
+		return v.prefix + field
+	default:
+		return v.prefix + "." + field
+	}
+}
+
+// nested returns a validator sharing the same errors with a field path prefix
+func (v *Validator) nested(field string) *Validator {
+	return &Validator{
+		errors: v.errors,
+		prefix: v.path(field),
+		skip:   v.skip,
+	}
+}
+
+// When returns a validator whose rules only apply if condition is true. It
+// shares the errors of v, so rules outside the chain are unaffected.
+//
+// Usage:
+//
+//	v.When(req.Tier != "").InSlice("tier", req.Tier, tiers, "")
+//
+// The rule's arguments are evaluated either way, so fields behind pointers
+// still need an if block.
+func (v *Validator) When(condition bool) *Validator {
+	return &Validator{
+		errors: v.errors,
+		prefix: v.prefix,
+		skip:   v.skip || !condition,
+	}
+}
+
+// Struct validates a nested struct, prefixing its field names with field
+func (v *Validator) Struct(field string, value Validatable) *Validator {
+	if value != nil {
+		value.ValidateWith(v.nested(field))
+	}
+	return v
+}
+
+// Each validates every element of a slice, prefixing field names with field[i]
+//
+// Usage:
+//
+//	validator.Each(v, "tags", req.Tags, func(v *validator.Validator, tag string) {
+//		v.Required("", tag, "").MaxLength("", tag, 100, "")
+//	})
+func Each[T any](v *Validator, field string, items []T, fn func(v *Validator, item T)) *Validator {
+	for i, item := range items {
+		fn(v.nested(field+"["+strconv.Itoa(i)+"]"), item)
+	}
+	return v
+}
+
+// EachStruct validates every element of a slice of Validatable values
+func EachStruct[T Validatable](v *Validator, field string, items []T) *Validator {
+	return Each(v, field, items, func(v *Validator, item T) {
+		item.ValidateWith(v)
+	})
 }
 
 // Required validates that a field is not empty
 func (v *Validator) Required(field, value, message string) *Validator {
 	if strings.TrimSpace(value) == "" {
-		if message == "" {
-			message = field + " is required"
-		}
-		v.errors.Add(field, message)
+		v.fail(field, message, "%s is required", v.path(field))
+	}
+	return v
+}
+
+// RequiredIf validates that a field is not empty when another field has
+// the expected value
+//
+// Usage:
+//
+//	v.RequiredIf("excerpt", req.Excerpt, "status", req.Status, "published", "")
+func (v *Validator) RequiredIf(field, value, otherField, otherValue, expected, message string) *Validator {
+	if otherValue == expected && strings.TrimSpace(value) == "" {
+		v.fail(field, message, "%s is required when %s is %s", v.path(field), v.path(otherField), expected)
+	}
+	return v
+}
+
+// RequiredUnless validates that a field is not empty unless another field
+// has the expected value
+func (v *Validator) RequiredUnless(field, value, otherField, otherValue, expected, message string) *Validator {
+	if otherValue != expected && strings.TrimSpace(value) == "" {
+		v.fail(field, message, "%s is required unless %s is %s", v.path(field), v.path(otherField), expected)
 	}
 	return v
 }
@@ -52,10 +176,7 @@ func (v *Validator) Required(field, value, message string) *Validator {
 // MinLength validates minimum string length
 func (v *Validator) MinLength(field, value string, min int, message string) *Validator {
 	if len(value) < min {
-		if message == "" {
-			message = field + " must be at least " + string(rune(min)) + " characters"
-		}
-		v.errors.Add(field, message)
+		v.fail(field, message, "%s must be at least %s characters", v.path(field), strconv.Itoa(min))
 	}
 	return v
 }
@@ -63,10 +184,7 @@ func (v *Validator) MinLength(field, value string, min int, message string) *Val
 // MaxLength validates maximum string length
 func (v *Validator) MaxLength(field, value string, max int, message string) *Validator {
 	if len(value) > max {
-		if message == "" {
-			message = field + " must be at most " + string(rune(max)) + " characters"
-		}
-		v.errors.Add(field, message)
+		v.fail(field, message, "%s must be at most %s characters", v.path(field), strconv.Itoa(max))
 	}
 	return v
 }
@@ -75,75 +193,31 @@ func (v *Validator) MaxLength(field, value string, max int, message string) *Val
 func (v *Validator) Email(field, value, message string) *Validator {
 	emailRegex := regexp.MustCompile(`^[a-zA-Z0-9._%+-]+@[a-zA-Z0-9.-]+\.[a-zA-Z]{2,}$`)
 	if !emailRegex.MatchString(value) {
-		if message == "" {
-			message = "Invalid email format"
-		}
-		v.errors.Add(field, message)
+		v.fail(field, message, "Invalid email format")
 	}
 	return v
 }
 
 // Password validates password strength
 func (v *Validator) Password(field, value string) *Validator {
-	var (
-		hasMinLen  = len(value) >= 8
-		hasUpper   = false
-		hasLower   = false
-		hasNumber  = false
-		hasSpecial = false
-	)
-
-	for _, char := range value {
-		switch {
-		case unicode.IsUpper(char):
-			hasUpper = true
-		case unicode.IsLower(char):
-			hasLower = true
-		case unicode.IsDigit(char):
-			hasNumber = true
-		case unicode.IsPunct(char) || unicode.IsSymbol(char):
-			hasSpecial = true
-		}
-	}
-
-	if !hasMinLen {
-		v.errors.Add(field, "Password must be at least 8 characters")
-	}
-	if !hasUpper {
-		v.errors.Add(field, "Password must contain at least one uppercase letter")
-	}
-	if !hasLower {
-		v.errors.Add(field, "Password must contain at least one lowercase letter")
-	}
-	if !hasNumber {
-		v.errors.Add(field, "Password must contain at least one number")
-	}
-	if !hasSpecial {
-		v.errors.Add(field, "Password must contain at least one special character")
+	for _, message := range passwordProblems(value) {
+		v.AddError(field, message)
 	}
-
 	return v
 }
 
 // Match validates that two values match
 func (v *Validator) Match(field1, value1, field2, value2, message string) *Validator {
 	if value1 != value2 {
-		if message == "" {
-			message = field1 + " and " + field2 + " do not match"
-		}
-		v.errors.Add(field1, message)
+		v.fail(field1, message, "%s and %s do not match", v.path(field1), v.path(field2))
 	}
 	return v
 }
 
 // UUID validates UUID format
 func (v *Validator) UUID(field, value, message string) *Validator {
-	uuidRegex := regexp.MustCompile(`^[0-9a-fA-F]{8}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{12}$`)
 	if !uuidRegex.MatchString(value) {
-		if message == "" {
-			message = "Invalid UUID format"
-		}
-		v.errors.Add(field, message)
+		v.fail(field, message, "Invalid UUID format")
 	}
 	return v
 }
@@ -155,17 +229,118 @@ func (v *Validator) InSlice(field, value string, allowed []string, message strin
 			return v
 		}
 	}
-	if message == "" {
-		message = field + " must be one of: " + strings.Join(allowed, ", ")
+	v.fail(field, message, "%s must be one of: %s", v.path(field), strings.Join(allowed, ", "))
+	return v
+}
+
+// OneOf validates that a typed enum value is one of the allowed values
+//
+// Usage:
+//
+//	validator.OneOf(v, "status", models.PostStatus(req.Status), "", models.PostStatusDraft, models.PostStatusPublished)
+func OneOf[T ~string](v *Validator, field string, value T, message string, allowed ...T) *Validator {
+	values := make([]string, len(allowed))
+	for i, a := range allowed {
+		values[i] = string(a)
+	}
+	return v.InSlice(field, string(value), values, message)
+}
+
+// URL validates an absolute http(s) URL
+func (v *Validator) URL(field, value, message string) *Validator {
+	if !ValidateURL(value) {
+		v.fail(field, message, "%s must be a valid http or https URL", v.path(field))
+	}
+	return v
+}
+
+// Phone validates an E.164 phone number (e.g. +14155552671)
+func (v *Validator) Phone(field, value, message string) *Validator {
+	if !phoneRegex.MatchString(value) {
+		v.fail(field, message, "%s must be a valid E.164 phone number", v.path(field))
+	}
+	return v
+}
+
+// Slug validates a lowercase, hyphen-separated slug
+func (v *Validator) Slug(field, value, message string) *Validator {
+	if !slugRegex.MatchString(value) {
+		v.fail(field, message, "%s must contain only lowercase letters, numbers and hyphens", v.path(field))
+	}
+	return v
+}
+
+// Date validates an ISO-8601 date (2006-01-02) or RFC 3339 timestamp
+func (v *Validator) Date(field, value, message string) *Validator {
+	if _, ok := ParseDate(value); !ok {
+		v.fail(field, message, "%s must be an ISO-8601 date", v.path(field))
+	}
+	return v
+}
+
+// Birthdate validates that a value is an ISO-8601 date in the past and
+// within a plausible human lifespan
+func (v *Validator) Birthdate(field, value, message string) *Validator {
+	if !ValidateBirthdate(value) {
+		v.fail(field, message, "%s must be a valid date in the past", v.path(field))
+	}
+	return v
+}
+
+// DateRange validates that two ISO-8601 dates are valid and start is not after end
+func (v *Validator) DateRange(startField, start, endField, end, message string) *Validator {
+	startTime, startOK := ParseDate(start)
+	endTime, endOK := ParseDate(end)
+	if !startOK || !endOK {
+		v.Date(startField, start, "")
+		v.Date(endField, end, "")
+		return v
+	}
+	if startTime.After(endTime) {
+		v.fail(startField, message, "%s must not be after %s", v.path(startField), v.path(endField))
+	}
+	return v
+}
+
+// Range validates that a number is between min and max (inclusive)
+func (v *Validator) Range(field string, value, min, max int, message string) *Validator {
+	if value < min || value > max {
+		v.fail(field, message, "%s must be between %d and %d", v.path(field), min, max)
+	}
+	return v
+}
+
+// Number is implemented by the numeric types Min and Max accept
+type Number interface {
+	~int | ~int8 | ~int16 | ~int32 | ~int64 |
+		~uint | ~uint8 | ~uint16 | ~uint32 | ~uint64 |
+		~float32 | ~float64
+}
+
+// Min validates that a number is at least min
+//
+// Usage:
+//
+//	validator.Min(v, "crop_size", crop.Size, 1, "")
+func Min[T Number](v *Validator, field string, value, min T, message string) *Validator {
+	if value < min {
+		v.fail(field, message, "%s must be at least %s", v.path(field), fmt.Sprint(min))
+	}
+	return v
+}
+
+// Max validates that a number is at most max
+func Max[T Number](v *Validator, field string, value, max T, message string) *Validator {
+	if value > max {
+		v.fail(field, message, "%s must be at most %s", v.path(field), fmt.Sprint(max))
 	}
-	v.errors.Add(field, message)
 	return v
 }
 
 // Custom adds a custom validation
 func (v *Validator) Custom(field string, valid bool, message string) *Validator {
 	if !valid {
-		v.errors.Add(field, message)
+		v.AddError(field, message)
 	}
 	return v
 }
@@ -178,10 +353,12 @@ func ValidateEmail(email string) bool {
 
 // ValidatePassword validates password strength and returns bool
 func ValidatePassword(password string) bool {
-	if len(password) < 8 {
-		return false
-	}
+	return len(passwordProblems(password)) == 0
+}
 
+// passwordProblems returns a message for every strength rule a password
+// breaks
+func passwordProblems(password string) []string {
 	var hasUpper, hasLower, hasNumber, hasSpecial bool
 	for _, char := range password {
 		switch {
@@ -196,5 +373,49 @@ func ValidatePassword(password string) bool {
 		}
 	}
 
-	return hasUpper && hasLower && hasNumber && hasSpecial
+	var problems []string
+	if len(password) < 8 {
+		problems = append(problems, "Password must be at least 8 characters")
+	}
+	if !hasUpper {
+		problems = append(problems, "Password must contain at least one uppercase letter")
+	}
+	if !hasLower {
+		problems = append(problems, "Password must contain at least one lowercase letter")
+	}
+	if !hasNumber {
+		problems = append(problems, "Password must contain at least one number")
+	}
+	if !hasSpecial {
+		problems = append(problems, "Password must contain at least one special character")
+	}
+	return problems
+}
+
+// ValidateURL validates an absolute http(s) URL and returns bool
+func ValidateURL(value string) bool {
+	u, err := url.ParseRequestURI(value)
+	if err != nil {
+		return false
+	}
+	return (u.Scheme == "http" || u.Scheme == "https") && u.Host != ""
+}
+
+// ValidateBirthdate validates that a value is an ISO-8601 date in the past
+// and within a plausible human lifespan, and returns bool
+func ValidateBirthdate(value string) bool {
+	t, ok := ParseDate(value)
+	now := time.Now()
+	return ok && !t.After(now) && !t.Before(now.AddDate(-150, 0, 0))
+}
+
+// ParseDate parses an ISO-8601 date (2006-01-02) or RFC 3339 timestamp
+func ParseDate(value string) (time.Time, bool) {
+	if t, err := time.Parse("2006-01-02", value); err == nil {
+		return t, true
+	}
+	if t, err := time.Parse(time.RFC3339, value); err == nil {
+		return t, true
+	}
+	return time.Time{}, false
 }
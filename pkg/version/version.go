@@ -0,0 +1,21 @@
+package version
+
+// Build information, overridden at build time via -ldflags, e.g.
+//
+//	go build -ldflags "-X github.com/yourusername/go-enterprise-api/pkg/version.Commit=$(git rev-parse --short HEAD)"
+var (
+	// Version is the API release version
+	Version = "1.0.0"
+	// Commit is the VCS commit the binary was built from
+	Commit = "unknown"
+	// BuildTime is the time the binary was built
+	BuildTime = "unknown"
+)
+
+// API versions, the version segment of the public API routes. APIVersion is
+// the stable version links and clients default to; APIVersionNext is the
+// version breaking response changes are rolled out on.
+const (
+	APIVersion     = "v1"
+	APIVersionNext = "v2"
+)